@@ -4,12 +4,14 @@
 package acctest
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
 	"testing"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -957,6 +959,19 @@ func init() {
 	}
 }
 
+// TestAccProtoV5ProviderFactories serve the SDKv2 provider muxed with the
+// plugin framework provider, for the tests of the resources written with the
+// plugin framework.
+var TestAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"ibm": func() (tfprotov5.ProviderServer, error) {
+		server, err := provider.ProviderServer(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return server(), nil
+	},
+}
+
 func TestProvider(t *testing.T) {
 	if err := provider.Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
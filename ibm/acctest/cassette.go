@@ -0,0 +1,464 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	jwt "github.com/golang-jwt/jwt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+// CassetteMode tells a Cassette whether to replay recorded interactions or to
+// record new ones against the live APIs.
+type CassetteMode string
+
+const (
+	CassetteReplay CassetteMode = "replay"
+	CassetteRecord CassetteMode = "record"
+)
+
+// CassetteModeEnv selects the mode of the cassette tests, replay by default.
+const CassetteModeEnv = "IBM_CASSETTE_MODE"
+
+// CassetteDir is where the cassettes are kept, relative to the test package.
+const CassetteDir = "testdata/cassettes"
+
+// Interaction is a recorded HTTP request and the response it got.
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is the part of a request kept in a cassette. Headers are
+// never recorded, so credentials sent in them do not end up in the fixtures.
+type CassetteRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded response. Bodies that are not valid UTF-8 are
+// kept base64 encoded.
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	Encoding   string      `json:"encoding,omitempty"`
+}
+
+// Cassette is an http.RoundTripper which records the interactions with the
+// APIs to a file, or replays them from it.
+//
+// Requests are matched on their method and URL. The requests changing state,
+// all but GET and HEAD, are replayed in the order they were recorded. A GET or
+// HEAD request is answered with the interactions recorded between the last
+// change replayed and the next one, in order, and the last of them is
+// repeated once they are used up. Extra polls of a waiter or refreshes of
+// Terraform still get the state the APIs were in at that point of the
+// recording, and never the one after a later change, such as the 404 after a
+// delete. A request without any recorded interaction gets a 501 response,
+// which none of the clients retry, and is reported by Misses.
+type Cassette struct {
+	Path string       `json:"-"`
+	Mode CassetteMode `json:"-"`
+
+	// Transport sends the requests while recording. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper `json:"-"`
+
+	mu           sync.Mutex
+	Interactions []*Interaction `json:"interactions"`
+	next         map[string]int
+	replayed     map[int]bool
+	changed      int
+	misses       []string
+}
+
+// NewCassette opens the cassette at path. In replay mode the file must exist.
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{
+		Path:     path,
+		Mode:     mode,
+		next:     map[string]int{},
+		replayed: map[int]bool{},
+		changed:  -1,
+	}
+	if mode == CassetteRecord {
+		return c, nil
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading cassette %s: %s", path, err)
+	}
+	if err := json.Unmarshal(content, c); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing cassette %s: %s", path, err)
+	}
+	return c, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.Mode == CassetteRecord {
+		return c.record(req, body)
+	}
+	return c.replay(req)
+}
+
+// Misses returns the requests which had no recorded interaction.
+func (c *Cassette) Misses() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.misses...)
+}
+
+// Save writes the recorded interactions to the cassette file.
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.Path, append(content, '\n'), 0644)
+}
+
+func (c *Cassette) record(req *http.Request, body []byte) (*http.Response, error) {
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &Interaction{
+		Request: CassetteRequest{
+			Method: req.Method,
+			URL:    cassetteURL(req.URL),
+			Body:   string(body),
+		},
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+		},
+	}
+	interaction.Response.Header.Del("Set-Cookie")
+	if isTokenRequest(req.URL) {
+		// The token request carries the API key and the response the live
+		// tokens, keep neither.
+		interaction.Request.Body = ""
+		respBody = redactToken(respBody)
+	} else {
+		// Credentials, passwords and secret payloads are redacted with the
+		// rules of the audit log.
+		interaction.Request.Body = string(conns.RedactBody(req.Header.Get("Content-Type"), body))
+	}
+	if utf8.Valid(respBody) {
+		interaction.Response.Body = string(respBody)
+		if !isTokenRequest(req.URL) {
+			interaction.Response.Body = string(conns.RedactBody(resp.Header.Get("Content-Type"), respBody))
+		}
+	} else {
+		interaction.Response.Body = base64.StdEncoding.EncodeToString(respBody)
+		interaction.Response.Encoding = "base64"
+	}
+
+	c.mu.Lock()
+	c.Interactions = append(c.Interactions, interaction)
+	c.mu.Unlock()
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+	resp.ContentLength = int64(len(respBody))
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	key := req.Method + " " + cassetteURL(req.URL)
+
+	c.mu.Lock()
+	n := c.match(req, key)
+	if n < 0 {
+		c.misses = append(c.misses, key)
+		c.mu.Unlock()
+		log.Printf("[ERROR] Cassette %s has no interaction for %s", c.Path, key)
+		return &http.Response{
+			StatusCode: http.StatusNotImplemented,
+			Status:     fmt.Sprintf("%d %s", http.StatusNotImplemented, http.StatusText(http.StatusNotImplemented)),
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{"Content-Type": {"text/plain"}},
+			Body:       ioutil.NopCloser(strings.NewReader(fmt.Sprintf("no recorded interaction for %s", key))),
+			Request:    req,
+		}, nil
+	}
+	recorded := c.Interactions[n].Response
+	c.mu.Unlock()
+
+	body := []byte(recorded.Body)
+	if recorded.Encoding == "base64" {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(recorded.Body); err != nil {
+			return nil, fmt.Errorf("[ERROR] Error decoding the recorded response for %s: %s", key, err)
+		}
+	}
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		StatusCode:    recorded.StatusCode,
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// match returns the index of the interaction replayed for the request, or -1.
+// It is called with c.mu held.
+func (c *Cassette) match(req *http.Request, key string) int {
+	var matched []int
+	for i, interaction := range c.Interactions {
+		if interaction.Request.Method+" "+interaction.Request.URL == key {
+			matched = append(matched, i)
+		}
+	}
+	if len(matched) == 0 {
+		return -1
+	}
+
+	switch {
+	case isTokenRequest(req.URL):
+		// Every configuration of the provider gets a token, however many
+		// were recorded.
+		n := c.next[key]
+		if n < len(matched)-1 {
+			c.next[key] = n + 1
+		} else {
+			n = len(matched) - 1
+		}
+		return matched[n]
+	case !isCassetteRead(req.Method):
+		for _, i := range matched {
+			if !c.replayed[i] {
+				c.replayed[i] = true
+				if i > c.changed {
+					c.changed = i
+				}
+				return i
+			}
+		}
+		return matched[len(matched)-1]
+	}
+
+	// The reads are answered from the interactions recorded after the last
+	// change replayed and before the next one.
+	next := len(c.Interactions)
+	for i, interaction := range c.Interactions {
+		if !c.replayed[i] && !isCassetteRead(interaction.Request.Method) && !isTokenURL(interaction.Request.URL) {
+			next = i
+			break
+		}
+	}
+	for _, i := range matched {
+		if i > c.changed && i < next && !c.replayed[i] {
+			c.replayed[i] = true
+			return i
+		}
+	}
+	for j := len(matched) - 1; j >= 0; j-- {
+		if matched[j] < next {
+			return matched[j]
+		}
+	}
+	return matched[0]
+}
+
+func isCassetteRead(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// readBody reads the request body and puts back a copy for the transport.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// cassetteURL normalises the URL of a request, sorting the query parameters
+// so they match regardless of the order the clients add them in.
+func cassetteURL(u *url.URL) string {
+	normalised := *u
+	normalised.RawQuery = u.Query().Encode()
+	normalised.Fragment = ""
+	return normalised.String()
+}
+
+func isTokenRequest(u *url.URL) bool {
+	return strings.HasSuffix(u.Path, "/identity/token") || strings.HasSuffix(u.Path, "/oauth/token")
+}
+
+func isTokenURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	return err == nil && isTokenRequest(u)
+}
+
+// redactToken replaces the tokens of an IAM token response. The access token
+// is re-signed with a dummy key, keeping the claims the provider reads the
+// user details from, and the expiry is pushed out so the replayed token is
+// never refreshed.
+func redactToken(body []byte) []byte {
+	var token map[string]interface{}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil
+	}
+	expiration := time.Now().AddDate(100, 0, 0).Unix()
+	if access, ok := token["access_token"].(string); ok {
+		claims := jwt.MapClaims{}
+		parser := &jwt.Parser{}
+		if _, _, err := parser.ParseUnverified(access, claims); err == nil {
+			kept := jwt.MapClaims{"exp": expiration}
+			for _, k := range []string{"id", "iam_id", "sub", "email", "iss", "account"} {
+				if v, ok := claims[k]; ok {
+					kept[k] = v
+				}
+			}
+			token["access_token"], _ = jwt.NewWithClaims(jwt.SigningMethodHS256, kept).SignedString([]byte("cassette"))
+		} else {
+			token["access_token"] = "redacted"
+		}
+	}
+	if _, ok := token["refresh_token"]; ok {
+		token["refresh_token"] = "redacted"
+	}
+	if _, ok := token["uaa_token"]; ok {
+		token["uaa_token"] = "redacted"
+	}
+	if _, ok := token["uaa_refresh_token"]; ok {
+		token["uaa_refresh_token"] = "redacted"
+	}
+	if _, ok := token["expiration"]; ok {
+		token["expiration"] = expiration
+	}
+	redacted, _ := json.Marshal(token)
+	return redacted
+}
+
+// CassetteTest runs the steps of an acceptance test case under
+// resource.UnitTest, with the API traffic of the provider going through the
+// cassette testdata/cassettes/<name>.json of the test package.
+//
+// By default the recorded interactions are replayed, no credentials or
+// network are needed and the PreCheck of the test case is skipped. With
+// IBM_CASSETTE_MODE=record the test runs against the live APIs, like an
+// acceptance test, and the cassette is written when it passes.
+//
+// Replay only matches requests on their method and URL, so the test case
+// must not use random resource names, and the region, zone and other
+// settings read from the environment must be the same when replaying as
+// when recording. Terraform itself is still run: in replay mode the test is
+// skipped unless TF_ACC_TERRAFORM_PATH or the PATH has a Terraform binary, so
+// it never needs the network to download one.
+func CassetteTest(t *testing.T, name string, c resource.TestCase) {
+	t.Helper()
+
+	mode := CassetteMode(os.Getenv(CassetteModeEnv))
+	if mode == "" {
+		mode = CassetteReplay
+	}
+	if mode != CassetteReplay && mode != CassetteRecord {
+		t.Fatalf("%s must be %q or %q, got %q", CassetteModeEnv, CassetteReplay, CassetteRecord, mode)
+	}
+	cassette, err := NewCassette(filepath.Join(CassetteDir, name+".json"), mode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mode == CassetteReplay {
+		if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" {
+			if _, err := exec.LookPath("terraform"); err != nil {
+				t.Skip("Terraform is needed to replay the cassette, set TF_ACC_TERRAFORM_PATH or add terraform to the PATH")
+			}
+		}
+		c.PreCheck = nil
+		// The client session needs credentials to configure the clients,
+		// the token request using them is answered from the cassette.
+		if os.Getenv("IC_API_KEY") == "" && os.Getenv("IBMCLOUD_API_KEY") == "" {
+			setTestEnv(t, "IC_API_KEY", "cassette")
+		}
+	}
+
+	configure := TestAccProvider.ConfigureFunc
+	TestAccProvider.ConfigureFunc = provider.ConfigureWithTransport(cassette)
+	defer func() {
+		TestAccProvider.ConfigureFunc = configure
+	}()
+
+	resource.UnitTest(t, c)
+
+	for _, miss := range cassette.Misses() {
+		t.Errorf("cassette %s has no interaction for %s, record it again with %s=%s", cassette.Path, miss, CassetteModeEnv, CassetteRecord)
+	}
+	if mode == CassetteRecord && !t.Failed() {
+		if err := cassette.Save(); err != nil {
+			t.Fatalf("Error saving cassette %s: %s", cassette.Path, err)
+		}
+	}
+}
+
+func setTestEnv(t *testing.T, key, value string) {
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
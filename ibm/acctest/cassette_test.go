@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	jwt "github.com/golang-jwt/jwt"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"id":      "IBMid-test",
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"account": map[string]interface{}{"bss": "test-account"},
+		"secret":  "not-kept",
+	}).SignedString([]byte("live"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/identity/token":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token":  accessToken,
+				"refresh_token": "live-refresh-token",
+				"expiration":    1,
+			})
+		case "/v1/vpcs/r006-1":
+			polls++
+			fmt.Fprintf(w, `{"status":"poll-%d"}`, polls)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "test.json")
+	recorder, err := NewCassette(path, CassetteRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: recorder}
+	if _, err := client.Post(server.URL+"/identity/token", "application/x-www-form-urlencoded", strings.NewReader("apikey=live-api-key")); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := client.Get(server.URL + "/v1/vpcs/r006-1?version=2022-06-01&generation=2"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"live-api-key", "live-refresh-token", accessToken} {
+		if strings.Contains(string(content), secret) {
+			t.Fatalf("cassette contains %q", secret)
+		}
+	}
+
+	server.Close()
+	player, err := NewCassette(path, CassetteReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = &http.Client{Transport: player}
+
+	resp, err := client.Post(server.URL+"/identity/token", "application/x-www-form-urlencoded", strings.NewReader("apikey=other-api-key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var token map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		t.Fatal(err)
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := (&jwt.Parser{}).ParseUnverified(token["access_token"].(string), claims); err != nil {
+		t.Fatalf("replayed access token is not a JWT: %s", err)
+	}
+	if claims["account"].(map[string]interface{})["bss"] != "test-account" {
+		t.Fatalf("expected the account claim to be kept, got %v", claims["account"])
+	}
+	if _, ok := claims["secret"]; ok {
+		t.Fatal("expected the other claims to be dropped")
+	}
+
+	// The query parameters are matched regardless of their order, and the
+	// last interaction is repeated once the recorded ones are used up.
+	for _, want := range []string{"poll-1", "poll-2", "poll-2"} {
+		resp, err := client.Get(server.URL + "/v1/vpcs/r006-1?generation=2&version=2022-06-01")
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		if !strings.Contains(string(body), want) {
+			t.Fatalf("expected %s, got %s", want, body)
+		}
+	}
+
+	resp, err = client.Get(server.URL + "/v1/subnets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("expected status 501 for an unrecorded request, got %d", resp.StatusCode)
+	}
+	if misses := player.Misses(); len(misses) != 1 || misses[0] != "GET "+server.URL+"/v1/subnets" {
+		t.Fatalf("unexpected misses %v", misses)
+	}
+}
+
+func TestCassetteReplayMissingFile(t *testing.T) {
+	if _, err := NewCassette(filepath.Join(t.TempDir(), "missing.json"), CassetteReplay); err == nil {
+		t.Fatal("expected an error for a missing cassette")
+	}
+}
+
+func TestCassetteRecordRedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"name":"admin","password":"live-password","credentials":{"apikey":"live-key-credential"},"id":"r006-1"}`)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "test.json")
+	recorder, err := NewCassette(path, CassetteRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: recorder}
+	resp, err := client.Post(server.URL+"/v2/resource_keys", "application/json", strings.NewReader(`{"name":"key","parameters":{"secret_data":"live-secret-data"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "live-password") {
+		t.Fatalf("expected the live response to be returned while recording, got %s", body)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"live-password", "live-key-credential", "live-secret-data"} {
+		if strings.Contains(string(content), secret) {
+			t.Fatalf("cassette contains %q", secret)
+		}
+	}
+	if !strings.Contains(string(content), "r006-1") {
+		t.Fatalf("expected the other fields to be kept, got %s", content)
+	}
+}
+
+func TestCassetteReplayReadsBetweenChanges(t *testing.T) {
+	interaction := func(method, url string, status int, body string) *Interaction {
+		return &Interaction{
+			Request:  CassetteRequest{Method: method, URL: url},
+			Response: CassetteResponse{StatusCode: status, Body: body},
+		}
+	}
+	key := "https://us-south.iaas.cloud.ibm.com/v1/keys/r006-1"
+	player := &Cassette{
+		Mode: CassetteReplay,
+		Interactions: []*Interaction{
+			interaction(http.MethodPost, "https://us-south.iaas.cloud.ibm.com/v1/keys", 201, "created"),
+			interaction(http.MethodGet, key, 200, "found-1"),
+			interaction(http.MethodGet, key, 200, "found-2"),
+			interaction(http.MethodDelete, key, 204, ""),
+			interaction(http.MethodGet, key, 404, "not-found"),
+		},
+		next:     map[string]int{},
+		replayed: map[int]bool{},
+		changed:  -1,
+	}
+	client := &http.Client{Transport: player}
+	send := func(method, url string) string {
+		req, _ := http.NewRequest(method, url, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	// More reads than recorded before the delete keep getting the state
+	// before the delete, not the 404 recorded after it.
+	send(http.MethodPost, "https://us-south.iaas.cloud.ibm.com/v1/keys")
+	for _, want := range []string{"found-1", "found-2", "found-2", "found-2"} {
+		if got := send(http.MethodGet, key); got != want {
+			t.Fatalf("expected %s, got %s", want, got)
+		}
+	}
+	send(http.MethodDelete, key)
+	for i := 0; i < 2; i++ {
+		if got := send(http.MethodGet, key); got != "not-found" {
+			t.Fatalf("expected not-found after the delete, got %s", got)
+		}
+	}
+}
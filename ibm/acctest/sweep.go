@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// The sweepers delete the resources left behind by failed acceptance tests.
+// They are run from the acctest package, e.g.
+//
+//	go test ./ibm/acctest -v -sweep=us-south -sweep-run=ibm_is_vpc
+//
+// with the credentials of the acceptance tests. Only the resources whose name
+// starts with one of the prefixes in IBM_SWEEP_PREFIX (comma separated) are
+// deleted, the sweepers don't run without it: the sweep is account wide and
+// no default prefix is narrow enough not to match the resources of users. With
+// IBM_SWEEP_DRY_RUN=true the matching resources are only listed.
+const (
+	SweepPrefixEnv = "IBM_SWEEP_PREFIX"
+	SweepDryRunEnv = "IBM_SWEEP_DRY_RUN"
+)
+
+// Sweepable is a resource found by a sweeper.
+type Sweepable struct {
+	ID   string
+	Name string
+	// Delete deletes the resource. It returns once the resource is gone when
+	// the sweepers depending on this one need it to be.
+	Delete func() error
+}
+
+// SweepListFunc lists the resources of one type in a region.
+type SweepListFunc func(client conns.ClientSession, region string) ([]Sweepable, error)
+
+// Sweepers are the sweepers registered by AddSweeper, by name.
+var Sweepers = map[string]*resource.Sweeper{}
+
+// AddSweeper registers the sweeper of a resource type. The sweepers listed in
+// dependencies are run first, for the resources which have to be deleted
+// before the ones of this type can be.
+func AddSweeper(name string, dependencies []string, list SweepListFunc) {
+	sweeper := &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		F: func(region string) error {
+			client, err := SweeperClientSession(region)
+			if err != nil {
+				return err
+			}
+			return Sweep(client, name, region, list)
+		},
+	}
+	Sweepers[name] = sweeper
+	resource.AddTestSweepers(name, sweeper)
+}
+
+// Sweep deletes the resources returned by list whose name has one of the
+// sweep prefixes, or only logs them in dry-run mode. A failed deletion does
+// not stop the others, the errors are returned together.
+func Sweep(client conns.ClientSession, name, region string, list SweepListFunc) error {
+	if len(SweepPrefixes()) == 0 {
+		return fmt.Errorf("[ERROR] Error sweeping %s resources in %s: %s must be set to the name prefixes of the resources to delete, e.g. tf-acc-", name, region, SweepPrefixEnv)
+	}
+	resources, err := list(client, region)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing %s resources in %s: %s", name, region, err)
+	}
+	dryRun := SweepDryRun()
+	var errs []string
+	for _, r := range resources {
+		if !IsSweepable(r.Name) {
+			continue
+		}
+		if dryRun {
+			log.Printf("[INFO] Sweeper %s would delete %s (%s)", name, r.Name, r.ID)
+			continue
+		}
+		log.Printf("[INFO] Sweeper %s deleting %s (%s)", name, r.Name, r.ID)
+		if err := r.Delete(); err != nil {
+			errs = append(errs, fmt.Sprintf("%s (%s): %s", r.Name, r.ID, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("[ERROR] Error sweeping %s resources in %s:\n%s", name, region, strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// SweepPrefixes returns the name prefixes of the resources to sweep, set in
+// IBM_SWEEP_PREFIX.
+func SweepPrefixes() []string {
+	var prefixes []string
+	for _, p := range strings.Split(os.Getenv(SweepPrefixEnv), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			prefixes = append(prefixes, p)
+		}
+	}
+	return prefixes
+}
+
+// IsSweepable tells whether a resource name has one of the sweep prefixes.
+func IsSweepable(name string) bool {
+	for _, p := range SweepPrefixes() {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// SweepDryRun tells whether the sweepers only list the resources.
+func SweepDryRun() bool {
+	v := strings.ToLower(os.Getenv(SweepDryRunEnv))
+	return v == "true" || v == "1"
+}
+
+var (
+	sweeperSessionsMu sync.Mutex
+	sweeperSessions   = map[string]conns.ClientSession{}
+)
+
+// SweeperClientSession returns the client session of the sweepers for a
+// region, configured from the environment like the provider.
+func SweeperClientSession(region string) (conns.ClientSession, error) {
+	sweeperSessionsMu.Lock()
+	defer sweeperSessionsMu.Unlock()
+	if sess, ok := sweeperSessions[region]; ok {
+		return sess, nil
+	}
+	config := &conns.Config{
+		BluemixAPIKey:   conns.EnvFallBack([]string{"IC_API_KEY", "IBMCLOUD_API_KEY"}, ""),
+		IAMToken:        conns.EnvFallBack([]string{"IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN"}, ""),
+		IAMRefreshToken: conns.EnvFallBack([]string{"IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN"}, ""),
+		Region:          region,
+		Zone:            conns.EnvFallBack([]string{"IC_ZONE", "IBMCLOUD_ZONE"}, ""),
+		ResourceGroup:   conns.EnvFallBack([]string{"IC_RESOURCE_GROUP", "IBMCLOUD_RESOURCE_GROUP"}, ""),
+		BluemixTimeout:  60 * time.Second,
+		RetryCount:      3,
+		RetryDelay:      conns.RetryAPIDelay,
+		Visibility:      "public",
+	}
+	sess, err := config.ClientSession()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error configuring the sweeper client session: %s", err)
+	}
+	sweeperSessions[region] = sess.(conns.ClientSession)
+	return sweeperSessions[region], nil
+}
+
+// waitForSwept polls gone until it reports the resource deleted.
+func waitForSwept(timeout time.Duration, gone func() (bool, error)) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		done, err := gone()
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if !done {
+			return resource.RetryableError(fmt.Errorf("still being deleted"))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func init() {
+	AddSweeper("ibm_cis_dns_record", nil, sweepCISDNSRecords)
+}
+
+// sweepCISDNSRecords deletes the DNS records of the zones of the CIS instance
+// set in IBM_CIS_INSTANCE.
+func sweepCISDNSRecords(client conns.ClientSession, region string) ([]Sweepable, error) {
+	if CisInstance == "" {
+		log.Printf("[INFO] Skipping the ibm_cis_dns_record sweeper, IBM_CIS_INSTANCE is not set")
+		return nil, nil
+	}
+	instances, err := listResourceInstances(client, CisInstance)
+	if err != nil {
+		return nil, err
+	}
+	if len(instances) == 0 {
+		return nil, fmt.Errorf("CIS instance %s not found", CisInstance)
+	}
+	crn := *instances[0].CRN
+
+	zonesClient, err := client.CisZonesV1ClientSession()
+	if err != nil {
+		return nil, err
+	}
+	zonesClient.Crn = core.StringPtr(crn)
+	zones, _, err := zonesClient.ListZones(zonesClient.NewListZonesOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	dnsClient, err := client.CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	for _, zone := range zones.Result {
+		zoneID := *zone.ID
+		dnsClient.Crn = core.StringPtr(crn)
+		dnsClient.ZoneIdentifier = core.StringPtr(zoneID)
+		for page := int64(1); ; page++ {
+			opt := dnsClient.NewListAllDnsRecordsOptions()
+			opt.SetPage(page)
+			opt.SetPerPage(1000)
+			records, _, err := dnsClient.ListAllDnsRecords(opt)
+			if err != nil {
+				return nil, err
+			}
+			for _, record := range records.Result {
+				recordID := *record.ID
+				resources = append(resources, Sweepable{
+					ID:   recordID,
+					Name: *record.Name,
+					Delete: func() error {
+						dnsClient.Crn = core.StringPtr(crn)
+						dnsClient.ZoneIdentifier = core.StringPtr(zoneID)
+						_, _, err := dnsClient.DeleteDnsRecord(dnsClient.NewDeleteDnsRecordOptions(recordID))
+						return err
+					},
+				})
+			}
+			if len(records.Result) == 0 || records.ResultInfo == nil || records.ResultInfo.TotalCount == nil ||
+				page*1000 >= *records.ResultInfo.TotalCount {
+				break
+			}
+		}
+	}
+	return resources, nil
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cos"
+)
+
+func init() {
+	AddSweeper("ibm_cos_bucket", nil, sweepCOSBuckets)
+}
+
+// sweepCOSBuckets empties and deletes the regional buckets of the COS instance
+// set in IBM_COS_CRN.
+func sweepCOSBuckets(client conns.ClientSession, region string) ([]Sweepable, error) {
+	if CosCRN == "" {
+		log.Printf("[INFO] Skipping the ibm_cos_bucket sweeper, IBM_COS_CRN is not set")
+		return nil, nil
+	}
+	bxSession, err := client.BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	apiKey := bxSession.Config.BluemixAPIKey
+	if apiKey == "" {
+		return nil, fmt.Errorf("IC_API_KEY is required to sweep COS buckets")
+	}
+	authEndpoint, err := bxSession.Config.EndpointLocator.IAMEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	apiEndpoint, _, _ := cos.SelectCosApi("rl", region)
+	s3Conf := aws.NewConfig().WithHTTPClient(bxSession.Config.HTTPClient).WithEndpoint(conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig().WithHTTPClient(bxSession.Config.HTTPClient), authEndpoint+"/identity/token", apiKey, CosCRN)).WithS3ForcePathStyle(true)
+	s3Client := s3.New(session.Must(session.NewSession()), s3Conf)
+
+	buckets, err := s3Client.ListBucketsExtended(&s3.ListBucketsExtendedInput{
+		IBMServiceInstanceId: aws.String(CosCRN),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	for _, bucket := range buckets.Buckets {
+		if !strings.HasPrefix(aws.StringValue(bucket.LocationConstraint), region+"-") {
+			continue
+		}
+		name := aws.StringValue(bucket.Name)
+		resources = append(resources, Sweepable{
+			ID:   name,
+			Name: name,
+			Delete: func() error {
+				return deleteCOSBucket(s3Client, name)
+			},
+		})
+	}
+	return resources, nil
+}
+
+func deleteCOSBucket(s3Client *s3.S3, bucket string) error {
+	var deleteErr error
+	err := s3Client.ListObjectVersionsPages(&s3.ListObjectVersionsInput{Bucket: aws.String(bucket)}, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		var objects []*s3.ObjectIdentifier
+		for _, version := range page.Versions {
+			objects = append(objects, &s3.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, marker := range page.DeleteMarkers {
+			objects = append(objects, &s3.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+		}
+		if len(objects) == 0 {
+			return true
+		}
+		_, deleteErr = s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		return deleteErr == nil
+	})
+	if err != nil {
+		return err
+	}
+	if deleteErr != nil {
+		return deleteErr
+	}
+	_, err = s3Client.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(bucket)})
+	return err
+}
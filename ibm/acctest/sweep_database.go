@@ -0,0 +1,16 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func init() {
+	AddSweeper("ibm_database", nil, sweepDatabases)
+}
+
+func sweepDatabases(client conns.ClientSession, region string) ([]Sweepable, error) {
+	return resourceInstanceSweepables(client, region, isDatabaseService)
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+const clusterSweepTimeout = 90 * time.Minute
+
+func init() {
+	AddSweeper("ibm_container_cluster", nil, sweepContainerClusters)
+	AddSweeper("ibm_container_vpc_cluster", nil, sweepContainerVPCClusters)
+}
+
+func sweepContainerClusters(client conns.ClientSession, region string) ([]Sweepable, error) {
+	csClient, err := client.ContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := client.BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	target := containerv1.ClusterTargetHeader{
+		AccountID: userDetails.UserAccount,
+		Region:    region,
+	}
+	clusters, err := csClient.Clusters().List(target)
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	for _, cluster := range clusters {
+		id := cluster.ID
+		resources = append(resources, Sweepable{
+			ID:   id,
+			Name: cluster.Name,
+			Delete: func() error {
+				return csClient.Clusters().Delete(id, target, true)
+			},
+		})
+	}
+	return resources, nil
+}
+
+// sweepContainerVPCClusters waits for the clusters to be deleted, as their
+// workers are attached to the subnets of the VPC sweepers.
+func sweepContainerVPCClusters(client conns.ClientSession, region string) ([]Sweepable, error) {
+	csClient, err := client.VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := client.BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	target := containerv2.ClusterTargetHeader{
+		AccountID: userDetails.UserAccount,
+		Provider:  "vpc-gen2",
+	}
+	clusters, err := csClient.Clusters().List(target)
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	for _, cluster := range clusters {
+		if cluster.Region != "" && cluster.Region != region {
+			continue
+		}
+		id := cluster.ID
+		resources = append(resources, Sweepable{
+			ID:   id,
+			Name: cluster.Name,
+			Delete: func() error {
+				if err := csClient.Clusters().Delete(id, target, true); err != nil {
+					return err
+				}
+				return waitForSwept(clusterSweepTimeout, func() (bool, error) {
+					_, err := csClient.Clusters().GetCluster(id, target)
+					if err != nil {
+						if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+							return true, nil
+						}
+						return false, err
+					}
+					return false, nil
+				})
+			},
+		})
+	}
+	return resources, nil
+}
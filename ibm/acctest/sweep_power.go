@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+const powerSweepTimeout = 30 * time.Minute
+
+func init() {
+	AddSweeper("ibm_pi_instance", nil, sweepPIInstances)
+	AddSweeper("ibm_pi_network", []string{"ibm_pi_instance"}, sweepPINetworks)
+	AddSweeper("ibm_pi_volume", []string{"ibm_pi_instance"}, sweepPIVolumes)
+}
+
+// powerCloudInstanceID returns the workspace of the Power sweepers. It is only
+// taken from PI_CLOUDINSTANCE_ID, the tests default is not swept.
+func powerCloudInstanceID(sweeper string) string {
+	id := os.Getenv("PI_CLOUDINSTANCE_ID")
+	if id == "" {
+		log.Printf("[INFO] Skipping the %s sweeper, PI_CLOUDINSTANCE_ID is not set", sweeper)
+	}
+	return id
+}
+
+// sweepPIInstances waits for the instances to be deleted, as their networks
+// and volumes can't be deleted before.
+func sweepPIInstances(client conns.ClientSession, region string) ([]Sweepable, error) {
+	cloudInstanceID := powerCloudInstanceID("ibm_pi_instance")
+	if cloudInstanceID == "" {
+		return nil, nil
+	}
+	sess, err := client.IBMPISession()
+	if err != nil {
+		return nil, err
+	}
+	instanceC := instance.NewIBMPIInstanceClient(context.Background(), sess, cloudInstanceID)
+	instances, err := instanceC.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	for _, pvm := range instances.PvmInstances {
+		id := *pvm.PvmInstanceID
+		resources = append(resources, Sweepable{
+			ID:   id,
+			Name: *pvm.ServerName,
+			Delete: func() error {
+				if err := instanceC.Delete(id); err != nil {
+					return err
+				}
+				return waitForSwept(powerSweepTimeout, func() (bool, error) {
+					instances, err := instanceC.GetAll()
+					if err != nil {
+						return false, err
+					}
+					for _, pvm := range instances.PvmInstances {
+						if *pvm.PvmInstanceID == id {
+							return false, nil
+						}
+					}
+					return true, nil
+				})
+			},
+		})
+	}
+	return resources, nil
+}
+
+func sweepPINetworks(client conns.ClientSession, region string) ([]Sweepable, error) {
+	cloudInstanceID := powerCloudInstanceID("ibm_pi_network")
+	if cloudInstanceID == "" {
+		return nil, nil
+	}
+	sess, err := client.IBMPISession()
+	if err != nil {
+		return nil, err
+	}
+	networkC := instance.NewIBMPINetworkClient(context.Background(), sess, cloudInstanceID)
+	networks, err := networkC.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	for _, network := range networks.Networks {
+		id := *network.NetworkID
+		resources = append(resources, Sweepable{
+			ID:   id,
+			Name: *network.Name,
+			Delete: func() error {
+				return networkC.Delete(id)
+			},
+		})
+	}
+	return resources, nil
+}
+
+func sweepPIVolumes(client conns.ClientSession, region string) ([]Sweepable, error) {
+	cloudInstanceID := powerCloudInstanceID("ibm_pi_volume")
+	if cloudInstanceID == "" {
+		return nil, nil
+	}
+	sess, err := client.IBMPISession()
+	if err != nil {
+		return nil, err
+	}
+	volumeC := instance.NewIBMPIVolumeClient(context.Background(), sess, cloudInstanceID)
+	volumes, err := volumeC.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	for _, volume := range volumes.Volumes {
+		id := *volume.VolumeID
+		resources = append(resources, Sweepable{
+			ID:   id,
+			Name: *volume.Name,
+			Delete: func() error {
+				return volumeC.DeleteVolume(id)
+			},
+		})
+	}
+	return resources, nil
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func init() {
+	AddSweeper("ibm_resource_instance", []string{
+		"ibm_database",
+		"ibm_cos_bucket",
+		"ibm_cis_dns_record",
+		"ibm_container_cluster",
+		"ibm_container_vpc_cluster",
+		"ibm_pi_instance",
+		"ibm_pi_network",
+		"ibm_pi_volume",
+	}, sweepResourceInstances)
+}
+
+// resourceInstanceService returns the service name of a resource instance CRN.
+func resourceInstanceService(crn string) string {
+	parts := strings.Split(crn, ":")
+	if len(parts) < 5 {
+		return ""
+	}
+	return parts[4]
+}
+
+// isDatabaseService tells whether a service is one of the cloud databases,
+// swept by the ibm_database sweeper.
+func isDatabaseService(service string) bool {
+	return strings.HasPrefix(service, "databases-for-") || service == "messages-for-rabbitmq"
+}
+
+// listResourceInstances lists the active resource instances with the given
+// name, or all of them if name is empty.
+func listResourceInstances(client conns.ClientSession, name string) ([]rc.ResourceInstance, error) {
+	rsConClient, err := client.ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+	var instances []rc.ResourceInstance
+	start := ""
+	for {
+		options := &rc.ListResourceInstancesOptions{
+			State: core.StringPtr(rc.ListResourceInstancesOptionsStateActiveConst),
+		}
+		if name != "" {
+			options.Name = &name
+		}
+		if start != "" {
+			options.Start = &start
+		}
+		list, _, err := rsConClient.ListResourceInstances(options)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, list.Resources...)
+		start = ""
+		if list.NextURL != nil {
+			if u, err := url.Parse(*list.NextURL); err == nil {
+				start = u.Query().Get("start")
+			}
+		}
+		if start == "" {
+			break
+		}
+	}
+	return instances, nil
+}
+
+// resourceInstanceSweepables returns the instances of the region, or global,
+// accepted by filter.
+func resourceInstanceSweepables(client conns.ClientSession, region string, filter func(service string) bool) ([]Sweepable, error) {
+	rsConClient, err := client.ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+	instances, err := listResourceInstances(client, "")
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	for _, instance := range instances {
+		if instance.RegionID != nil && *instance.RegionID != region && *instance.RegionID != "global" {
+			continue
+		}
+		if instance.CRN == nil || !filter(resourceInstanceService(*instance.CRN)) {
+			continue
+		}
+		id := *instance.ID
+		resources = append(resources, Sweepable{
+			ID:   id,
+			Name: *instance.Name,
+			Delete: func() error {
+				_, err := rsConClient.DeleteResourceInstance(&rc.DeleteResourceInstanceOptions{
+					ID:        &id,
+					Recursive: core.BoolPtr(true),
+				})
+				return err
+			},
+		})
+	}
+	return resources, nil
+}
+
+// sweepResourceInstances deletes the service instances not handled by the
+// sweeper of a more specific resource type.
+func sweepResourceInstances(client conns.ClientSession, region string) ([]Sweepable, error) {
+	return resourceInstanceSweepables(client, region, func(service string) bool {
+		return !isDatabaseService(service) && service != "containers-kubernetes" && service != "is"
+	})
+}
@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	jwt "github.com/golang-jwt/jwt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestSweeperDependencies(t *testing.T) {
+	for _, name := range []string{
+		"ibm_is_vpc",
+		"ibm_container_vpc_cluster",
+		"ibm_cos_bucket",
+		"ibm_cis_dns_record",
+		"ibm_database",
+		"ibm_pi_instance",
+		"ibm_resource_instance",
+	} {
+		if _, ok := Sweepers[name]; !ok {
+			t.Errorf("expected a sweeper for %s", name)
+		}
+	}
+
+	visiting := map[string]bool{}
+	visited := map[string]bool{}
+	var visit func(name string, path []string)
+	visit = func(name string, path []string) {
+		if visiting[name] {
+			t.Fatalf("dependency cycle %s", strings.Join(append(path, name), " -> "))
+		}
+		if visited[name] {
+			return
+		}
+		visiting[name] = true
+		for _, dep := range Sweepers[name].Dependencies {
+			if _, ok := Sweepers[dep]; !ok {
+				t.Fatalf("sweeper %s depends on unknown sweeper %s", name, dep)
+			}
+			visit(dep, append(path, name))
+		}
+		visiting[name] = false
+		visited[name] = true
+	}
+	for name := range Sweepers {
+		visit(name, nil)
+	}
+}
+
+func TestSweepPrefixAndDryRun(t *testing.T) {
+	var deleted []string
+	list := func(client conns.ClientSession, region string) ([]Sweepable, error) {
+		var resources []Sweepable
+		for _, name := range []string{"tf-vpc", "acc-subnet", "production", "tf-broken"} {
+			name := name
+			resources = append(resources, Sweepable{
+				ID:   name + "-id",
+				Name: name,
+				Delete: func() error {
+					deleted = append(deleted, name)
+					if name == "tf-broken" {
+						return errors.New("conflict")
+					}
+					return nil
+				},
+			})
+		}
+		return resources, nil
+	}
+
+	setTestEnv(t, SweepPrefixEnv, " ,")
+	if err := Sweep(nil, "test", "us-south", list); err == nil || !strings.Contains(err.Error(), SweepPrefixEnv+" must be set") {
+		t.Fatalf("expected the sweep to need a prefix, got %v", err)
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("expected no deletion without a prefix, got %v", deleted)
+	}
+
+	setTestEnv(t, SweepPrefixEnv, "tf-, acc-")
+	setTestEnv(t, SweepDryRunEnv, "true")
+	if err := Sweep(nil, "test", "us-south", list); err != nil {
+		t.Fatalf("dry run failed: %s", err)
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("expected no deletion in dry-run mode, got %v", deleted)
+	}
+
+	setTestEnv(t, SweepDryRunEnv, "")
+	err := Sweep(nil, "test", "us-south", list)
+	if err == nil || !strings.Contains(err.Error(), "tf-broken (tf-broken-id): conflict") {
+		t.Fatalf("expected the failed deletion to be reported, got %v", err)
+	}
+	if got := strings.Join(deleted, ","); got != "tf-vpc,acc-subnet,tf-broken" {
+		t.Fatalf("expected the prefixed resources to be deleted, got %s", got)
+	}
+}
+
+// fakeVPCServer serves the IAM token and a few VPC subnets, deleted subnets
+// are gone on the next GET.
+type fakeVPCServer struct {
+	mu      sync.Mutex
+	subnets map[string]string
+	deletes []string
+}
+
+func (f *fakeVPCServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.URL.Path == "/identity/token":
+		token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"id":      "IBMid-test",
+			"iss":     "https://iam.cloud.ibm.com/identity",
+			"account": map[string]interface{}{"bss": "test-account"},
+		}).SignedString([]byte("secret"))
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  token,
+			"refresh_token": "refresh",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"expiration":    4102444800,
+		})
+	case r.URL.Path == "/v1/subnets" && r.Method == http.MethodGet:
+		var subnets []map[string]interface{}
+		for id, name := range f.subnets {
+			subnets = append(subnets, map[string]interface{}{"id": id, "name": name})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"subnets": subnets, "limit": 50})
+	case strings.HasPrefix(r.URL.Path, "/v1/subnets/"):
+		id := strings.TrimPrefix(r.URL.Path, "/v1/subnets/")
+		name, ok := f.subnets[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors":[{"code":"not_found","message":"Subnet not found"}]}`)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			f.deletes = append(f.deletes, id)
+			delete(f.subnets, id)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": id, "name": name})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestVPCSubnetSweeper(t *testing.T) {
+	fake := &fakeVPCServer{subnets: map[string]string{
+		"0717-1": "tf-subnet-1",
+		"0717-2": "production-subnet",
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	setTestEnv(t, "IC_API_KEY", "test-api-key")
+	setTestEnv(t, "IBMCLOUD_IAM_API_ENDPOINT", server.URL)
+	setTestEnv(t, "IBMCLOUD_IS_NG_API_ENDPOINT", server.URL+"/v1")
+	setTestEnv(t, SweepPrefixEnv, "tf-")
+
+	client, err := SweeperClientSession("us-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	setTestEnv(t, SweepDryRunEnv, "true")
+	if err := Sweep(client, "ibm_is_subnet", "us-test", sweepISSubnets); err != nil {
+		t.Fatalf("dry run failed: %s", err)
+	}
+	if len(fake.deletes) != 0 {
+		t.Fatalf("expected no deletion in dry-run mode, got %v", fake.deletes)
+	}
+
+	setTestEnv(t, SweepDryRunEnv, "")
+	if err := Sweep(client, "ibm_is_subnet", "us-test", sweepISSubnets); err != nil {
+		t.Fatalf("sweep failed: %s", err)
+	}
+	if len(fake.deletes) != 1 || fake.deletes[0] != "0717-1" {
+		t.Fatalf("expected only tf-subnet-1 to be deleted, got %v", fake.deletes)
+	}
+	if _, ok := fake.subnets["0717-2"]; !ok {
+		t.Fatal("expected production-subnet to be kept")
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const vpcSweepTimeout = 30 * time.Minute
+
+func init() {
+	AddSweeper("ibm_is_instance", nil, sweepISInstances)
+	AddSweeper("ibm_is_subnet", []string{"ibm_is_instance", "ibm_container_vpc_cluster"}, sweepISSubnets)
+	AddSweeper("ibm_is_public_gateway", []string{"ibm_is_subnet"}, sweepISPublicGateways)
+	AddSweeper("ibm_is_vpc", []string{"ibm_is_instance", "ibm_is_subnet", "ibm_is_public_gateway", "ibm_container_vpc_cluster"}, sweepISVPCs)
+}
+
+// waitForVPCResourceSwept waits for get to return a 404.
+func waitForVPCResourceSwept(get func() (*core.DetailedResponse, error)) error {
+	return waitForSwept(vpcSweepTimeout, func() (bool, error) {
+		response, err := get()
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+}
+
+func sweepISInstances(client conns.ClientSession, region string) ([]Sweepable, error) {
+	sess, err := client.VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	start := ""
+	for {
+		options := &vpcv1.ListInstancesOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		instances, _, err := sess.ListInstances(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range instances.Instances {
+			id := *instance.ID
+			resources = append(resources, Sweepable{
+				ID:   id,
+				Name: *instance.Name,
+				Delete: func() error {
+					if _, err := sess.DeleteInstance(&vpcv1.DeleteInstanceOptions{ID: &id}); err != nil {
+						return err
+					}
+					return waitForVPCResourceSwept(func() (*core.DetailedResponse, error) {
+						_, response, err := sess.GetInstance(&vpcv1.GetInstanceOptions{ID: &id})
+						return response, err
+					})
+				},
+			})
+		}
+		if start = flex.GetNext(instances.Next); start == "" {
+			break
+		}
+	}
+	return resources, nil
+}
+
+func sweepISSubnets(client conns.ClientSession, region string) ([]Sweepable, error) {
+	sess, err := client.VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	start := ""
+	for {
+		options := &vpcv1.ListSubnetsOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		subnets, _, err := sess.ListSubnets(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, subnet := range subnets.Subnets {
+			id := *subnet.ID
+			resources = append(resources, Sweepable{
+				ID:   id,
+				Name: *subnet.Name,
+				Delete: func() error {
+					if _, err := sess.DeleteSubnet(&vpcv1.DeleteSubnetOptions{ID: &id}); err != nil {
+						return err
+					}
+					return waitForVPCResourceSwept(func() (*core.DetailedResponse, error) {
+						_, response, err := sess.GetSubnet(&vpcv1.GetSubnetOptions{ID: &id})
+						return response, err
+					})
+				},
+			})
+		}
+		if start = flex.GetNext(subnets.Next); start == "" {
+			break
+		}
+	}
+	return resources, nil
+}
+
+func sweepISPublicGateways(client conns.ClientSession, region string) ([]Sweepable, error) {
+	sess, err := client.VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	start := ""
+	for {
+		options := &vpcv1.ListPublicGatewaysOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		gateways, _, err := sess.ListPublicGateways(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, gateway := range gateways.PublicGateways {
+			id := *gateway.ID
+			resources = append(resources, Sweepable{
+				ID:   id,
+				Name: *gateway.Name,
+				Delete: func() error {
+					if _, err := sess.DeletePublicGateway(&vpcv1.DeletePublicGatewayOptions{ID: &id}); err != nil {
+						return err
+					}
+					return waitForVPCResourceSwept(func() (*core.DetailedResponse, error) {
+						_, response, err := sess.GetPublicGateway(&vpcv1.GetPublicGatewayOptions{ID: &id})
+						return response, err
+					})
+				},
+			})
+		}
+		if start = flex.GetNext(gateways.Next); start == "" {
+			break
+		}
+	}
+	return resources, nil
+}
+
+func sweepISVPCs(client conns.ClientSession, region string) ([]Sweepable, error) {
+	sess, err := client.VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	var resources []Sweepable
+	start := ""
+	for {
+		options := &vpcv1.ListVpcsOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		vpcs, _, err := sess.ListVpcs(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, vpc := range vpcs.Vpcs {
+			id := *vpc.ID
+			resources = append(resources, Sweepable{
+				ID:   id,
+				Name: *vpc.Name,
+				Delete: func() error {
+					_, err := sess.DeleteVPC(&vpcv1.DeleteVPCOptions{ID: &id})
+					return err
+				},
+			})
+		}
+		if start = flex.GetNext(vpcs.Next); start == "" {
+			break
+		}
+	}
+	return resources, nil
+}
@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// auditBodyLimit is the size of the largest body written to the audit log.
+const auditBodyLimit = 64 * 1024
+
+const auditRedacted = "[REDACTED]"
+
+// auditSecretKeys match the names of the fields and query parameters whose
+// value is redacted from the audit log.
+var auditSecretKeys = regexp.MustCompile(`(?i)(password|passphrase|(^|_)secret$|secret_data|(^|_)token$|api_?key$|private_?key|authorization|^credentials$|^payload$|^key_material$)`)
+
+// auditRequestIDHeaders are the headers carrying the ID of a request, by
+// order of preference.
+var auditRequestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "X-Global-Transaction-Id", "X-Transaction-Id", "Transaction-Id", "Softlayer-Request-Id"}
+
+// auditRegion matches the region, zone and endpoint type labels of the API hosts.
+var auditRegion = regexp.MustCompile(`^([a-z]{2}-[a-z]+(-[0-9])?|private|direct|global|global-search-tagging)$`)
+
+type resourceKey struct{}
+
+// auditResource is the Terraform resource or data source whose operation
+// sends the requests made with a context.
+type auditResource struct {
+	Type string
+	ID   string
+}
+
+// WithResource returns a context carrying the type of the Terraform resource
+// or data source whose operation sends the requests made with it, e.g.
+// ibm_is_vpc or data.ibm_is_vpc, and its ID once known, written to the audit
+// log. Terraform doesn't send the name of the resource in the configuration to
+// the provider.
+func WithResource(ctx context.Context, resourceType, id string) context.Context {
+	return context.WithValue(ctx, resourceKey{}, auditResource{Type: resourceType, ID: id})
+}
+
+// auditEntry is a line of the audit log.
+type auditEntry struct {
+	Time         time.Time   `json:"time"`
+	Resource     string      `json:"resource,omitempty"`
+	ResourceID   string      `json:"resource_id,omitempty"`
+	Service      string      `json:"service"`
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	Status       int         `json:"status,omitempty"`
+	LatencyMS    int64       `json:"latency_ms"`
+	RequestID    string      `json:"request_id,omitempty"`
+	Error        string      `json:"error,omitempty"`
+	RequestBody  interface{} `json:"request_body,omitempty"`
+	ResponseBody interface{} `json:"response_body,omitempty"`
+}
+
+// auditLog writes the entries of the audit log of a run.
+type auditLog struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+var (
+	auditLogsMu sync.Mutex
+	auditLogs   = map[string]*auditLog{}
+)
+
+// openAuditLog returns the audit log of this run in dir, shared by the
+// provider configurations using the same directory.
+func openAuditLog(dir string) (*auditLog, error) {
+	auditLogsMu.Lock()
+	defer auditLogsMu.Unlock()
+	if l, ok := auditLogs[dir]; ok {
+		return l, nil
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error creating the audit log directory %s: %s", dir, err)
+	}
+	name := fmt.Sprintf("terraform-provider-ibm-%s-%d.jsonl", time.Now().UTC().Format("20060102T150405Z"), os.Getpid())
+	f, err := os.OpenFile(filepath.Join(dir, name), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error creating the audit log: %s", err)
+	}
+	l := &auditLog{file: f, enc: json.NewEncoder(f)}
+	auditLogs[dir] = l
+	return l, nil
+}
+
+func (l *auditLog) write(entry *auditEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.enc.Encode(entry)
+}
+
+// auditTransport writes every request sent with base to the audit log.
+type auditTransport struct {
+	base gohttp.RoundTripper
+	log  *auditLog
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *auditTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	r, _ := req.Context().Value(resourceKey{}).(auditResource)
+	entry := &auditEntry{
+		Time:       time.Now().UTC(),
+		Resource:   r.Type,
+		ResourceID: r.ID,
+		Service:    auditService(req.URL.Hostname()),
+		Method:     req.Method,
+		URL:        redactURL(req.URL),
+	}
+	if req.GetBody != nil && req.ContentLength <= auditBodyLimit {
+		if body, err := req.GetBody(); err == nil {
+			// The length of a streamed body is unknown, -1.
+			content, _ := ioutil.ReadAll(io.LimitReader(body, auditBodyLimit+1))
+			body.Close()
+			if len(content) <= auditBodyLimit {
+				entry.RequestBody = redactBody(req.Header.Get("Content-Type"), content)
+			}
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	entry.LatencyMS = time.Since(entry.Time).Milliseconds()
+	if err != nil {
+		entry.Error = err.Error()
+		t.log.write(entry)
+		return resp, err
+	}
+	entry.Status = resp.StatusCode
+	entry.RequestID = requestID(req, resp)
+	if resp.Body != nil && resp.ContentLength <= auditBodyLimit && isAuditedContent(resp.Header.Get("Content-Type")) {
+		content, _ := ioutil.ReadAll(io.LimitReader(resp.Body, auditBodyLimit+1))
+		resp.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(content), resp.Body), Closer: resp.Body}
+		if len(content) <= auditBodyLimit {
+			entry.ResponseBody = redactBody(resp.Header.Get("Content-Type"), content)
+		}
+	}
+	t.log.write(entry)
+	return resp, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// auditService returns the name of the service of an API host, the host
+// without its region and domain, e.g. iaas for us-south.iaas.cloud.ibm.com.
+func auditService(host string) string {
+	if strings.HasSuffix(host, ".softlayer.com") {
+		return "softlayer"
+	}
+	for _, domain := range []string{".cloud.ibm.com", ".appdomain.cloud", ".bluemix.net"} {
+		if strings.HasSuffix(host, domain) {
+			host = strings.TrimSuffix(host, domain)
+			break
+		}
+	}
+	var labels []string
+	for _, label := range strings.Split(host, ".") {
+		if !auditRegion.MatchString(label) {
+			labels = append(labels, label)
+		}
+	}
+	if len(labels) == 0 {
+		return host
+	}
+	return strings.Join(labels, ".")
+}
+
+func isAuditedContent(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return strings.HasSuffix(mediaType, "json") || mediaType == "application/x-www-form-urlencoded"
+}
+
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	query := redacted.Query()
+	for key := range query {
+		if auditSecretKeys.MatchString(key) {
+			query.Set(key, auditRedacted)
+		}
+	}
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// redactBody returns a JSON or form body with its secret fields redacted,
+// or its size for the other content types.
+func redactBody(contentType string, content []byte) interface{} {
+	if len(content) == 0 {
+		return nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		form, err := url.ParseQuery(string(content))
+		if err == nil {
+			redacted := map[string]interface{}{}
+			for key := range form {
+				redacted[key] = redactValue(key, form.Get(key))
+			}
+			return redacted
+		}
+	}
+	var value interface{}
+	if err := json.Unmarshal(content, &value); err != nil {
+		return fmt.Sprintf("<%d bytes>", len(content))
+	}
+	return redactValue("", value)
+}
+
+// RedactBody returns a JSON or form body with the secret fields redacted as in
+// the audit log, keeping its format, and the other bodies unchanged.
+func RedactBody(contentType string, content []byte) []byte {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		form, err := url.ParseQuery(string(content))
+		if err != nil {
+			return content
+		}
+		for key := range form {
+			if auditSecretKeys.MatchString(key) {
+				form.Set(key, auditRedacted)
+			}
+		}
+		return []byte(form.Encode())
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return content
+	}
+	redacted, err := json.Marshal(redactValue("", value))
+	if err != nil {
+		return content
+	}
+	return redacted
+}
+
+func redactValue(key string, value interface{}) interface{} {
+	if key != "" && auditSecretKeys.MatchString(key) {
+		return auditRedacted
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			v[k] = redactValue(k, item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue("", item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAuditTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-1")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"crn:1","credentials":{"apikey":"live-key"},"users":[{"name":"admin","adminpassword":"live-password"}]}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	log, err := openAuditLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: &auditTransport{base: http.DefaultTransport, log: log}}
+
+	ctx := WithResource(context.Background(), "ibm_database", "crn:1")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v4/deployments?refresh_token=live-token&limit=1", strings.NewReader(`{"name":"db","adminpassword":"live-password"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), "live-key") {
+		t.Fatalf("expected the response body to be left unchanged, got %s", body)
+	}
+
+	files, _ := ioutil.ReadDir(dir)
+	if len(files) != 1 {
+		t.Fatalf("expected one audit log, got %d", len(files))
+	}
+	content, err := ioutil.ReadFile(dir + "/" + files[0].Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"live-key", "live-password", "live-token"} {
+		if strings.Contains(string(content), secret) {
+			t.Fatalf("audit log contains %q: %s", secret, content)
+		}
+	}
+	var entry auditEntry
+	if err := json.Unmarshal(content, &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Resource != "ibm_database" || entry.ResourceID != "crn:1" || entry.Method != http.MethodPost || entry.Status != http.StatusCreated || entry.RequestID != "req-1" {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+	if !strings.Contains(entry.URL, "limit=1") || entry.RequestBody.(map[string]interface{})["name"] != "db" {
+		t.Fatalf("expected the other values to be kept, got %s", content)
+	}
+
+	if same, _ := openAuditLog(dir); same != log {
+		t.Fatal("expected the audit log of the run to be shared")
+	}
+}
+
+func TestAuditService(t *testing.T) {
+	cases := map[string]string{
+		"us-south.iaas.cloud.ibm.com":                      "iaas",
+		"iam.cloud.ibm.com":                                "iam",
+		"private.eu-de.containers.cloud.ibm.com":           "containers",
+		"s3.us-south.cloud-object-storage.appdomain.cloud": "s3.cloud-object-storage",
+		"api.softlayer.com":                                "softlayer",
+		"127.0.0.1":                                        "127.0.0.1",
+	}
+	for host, want := range cases {
+		if got := auditService(host); got != want {
+			t.Errorf("%s: expected %s, got %s", host, want, got)
+		}
+	}
+}
+
+func TestAuditTransportSkipsLargeBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	log, err := openAuditLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: &auditTransport{base: http.DefaultTransport, log: log}}
+
+	// A streamed body has an unknown length.
+	payload := `{"data":"` + strings.Repeat("x", auditBodyLimit) + `"}`
+	req, _ := http.NewRequest(http.MethodPut, server.URL+"/objects/large", strings.NewReader(payload))
+	req.ContentLength = -1
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	files, _ := ioutil.ReadDir(dir)
+	content, err := ioutil.ReadFile(dir + "/" + files[0].Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entry auditEntry
+	if err := json.Unmarshal(content, &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Method != http.MethodPut || entry.RequestBody != nil {
+		t.Fatalf("expected the body over the limit to be left out, got %+v", entry)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// cacheBodyLimit is the size of the largest response body cached.
+	cacheBodyLimit = 8 << 20
+	// cacheSizeLimit is the size of the response bodies cached by a session
+	// after which no more responses are cached.
+	cacheSizeLimit = 256 << 20
+)
+
+// responseCache caches the responses of the GET requests sent by the clients
+// of a session, for the resources and data sources reading the same API
+// objects and lists. The responses are cached by service, URL and account,
+// for ttl.
+//
+// A mutating request to a service invalidates its cached responses, and no
+// more responses of the service are cached, as the changed resources are
+// then polled for their status.
+type responseCache struct {
+	ttl time.Duration
+
+	mu       sync.Mutex
+	entries  map[string]*cachedResponse
+	inFlight map[string]*sync.WaitGroup
+	mutated  map[string]bool
+	size     int
+}
+
+type cachedResponse struct {
+	service string
+	status  int
+	header  gohttp.Header
+	body    []byte
+	expires time.Time
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:      ttl,
+		entries:  map[string]*cachedResponse{},
+		inFlight: map[string]*sync.WaitGroup{},
+		mutated:  map[string]bool{},
+	}
+}
+
+// get returns the cached response of key, if any, or starts the request of
+// key, which must be done once sent. Until then, the requests of key wait for
+// its response.
+func (c *responseCache) get(service, key string) (*cachedResponse, func(*cachedResponse)) {
+	c.mu.Lock()
+	for {
+		if c.mutated[service] {
+			c.mu.Unlock()
+			return nil, func(*cachedResponse) {}
+		}
+		if entry, ok := c.entries[key]; ok {
+			if time.Now().Before(entry.expires) {
+				c.mu.Unlock()
+				return entry, nil
+			}
+			c.remove(key)
+		}
+		wg, ok := c.inFlight[key]
+		if !ok {
+			break
+		}
+		c.mu.Unlock()
+		wg.Wait()
+		c.mu.Lock()
+	}
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	c.inFlight[key] = wg
+	c.mu.Unlock()
+
+	return nil, func(entry *cachedResponse) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		delete(c.inFlight, key)
+		wg.Done()
+		if entry != nil && !c.mutated[service] && c.size+len(entry.body) <= cacheSizeLimit {
+			entry.expires = time.Now().Add(c.ttl)
+			c.entries[key] = entry
+			c.size += len(entry.body)
+		}
+	}
+}
+
+// invalidate drops the cached responses of service and stops caching them.
+func (c *responseCache) invalidate(service string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.mutated[service] {
+		log.Printf("[DEBUG] Invalidating the cached responses of %s", service)
+	}
+	c.mutated[service] = true
+	for key, entry := range c.entries {
+		if entry.service == service {
+			c.remove(key)
+		}
+	}
+}
+
+func (c *responseCache) remove(key string) {
+	c.size -= len(c.entries[key].body)
+	delete(c.entries, key)
+}
+
+type cacheTransport struct {
+	base  gohttp.RoundTripper
+	cache *responseCache
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *cacheTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	service := auditService(req.URL.Hostname())
+	switch {
+	case req.Method == gohttp.MethodGet && (req.Body == nil || req.Body == gohttp.NoBody):
+	case req.Method == gohttp.MethodHead || req.Method == gohttp.MethodOptions || isTokenRequest(req):
+		return t.base.RoundTrip(req)
+	default:
+		t.cache.invalidate(service)
+		resp, err := t.base.RoundTrip(req)
+		// The responses of the requests sent meanwhile may predate the change.
+		t.cache.invalidate(service)
+		return resp, err
+	}
+
+	key := strings.Join([]string{service, cacheAccount(req), req.Header.Get("Accept"), req.URL.String()}, "\n")
+	entry, done := t.cache.get(service, key)
+	if entry != nil {
+		log.Printf("[DEBUG] Using the cached response of GET %s", req.URL.Redacted())
+		return entry.response(req), nil
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != gohttp.StatusOK || resp.ContentLength > cacheBodyLimit ||
+		strings.Contains(resp.Header.Get("Cache-Control"), "no-store") {
+		done(nil)
+		return resp, err
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, cacheBodyLimit+1))
+	if err != nil || len(body) > cacheBodyLimit {
+		done(nil)
+		resp.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), resp.Body), Closer: resp.Body}
+		return resp, nil
+	}
+	resp.Body.Close()
+	entry = &cachedResponse{
+		service: service,
+		status:  resp.StatusCode,
+		header:  resp.Header.Clone(),
+		body:    body,
+	}
+	done(entry)
+	return entry.response(req), nil
+}
+
+// response returns a copy of the cached response for req.
+func (r *cachedResponse) response(req *gohttp.Request) *gohttp.Response {
+	return &gohttp.Response{
+		Status:        gohttp.StatusText(r.status),
+		StatusCode:    r.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
+
+// isTokenRequest tells whether req requests an IAM token, which changes no
+// resource.
+func isTokenRequest(req *gohttp.Request) bool {
+	return strings.HasSuffix(req.URL.Path, "/identity/token")
+}
+
+// cacheAccount returns the identity a request is sent with: the account and
+// IAM ID of an IAM token, which are the same for the refreshed tokens, or a
+// hash of the Authorization header.
+func cacheAccount(req *gohttp.Request) string {
+	authorization := req.Header.Get("Authorization")
+	if authorization == "" {
+		return ""
+	}
+	if parts := strings.Split(strings.TrimPrefix(authorization, "Bearer "), "."); len(parts) == 3 {
+		if payload, err := base64.RawURLEncoding.DecodeString(parts[1]); err == nil {
+			var claims struct {
+				IAMID   string `json:"iam_id"`
+				Account struct {
+					BSS string `json:"bss"`
+				} `json:"account"`
+			}
+			if json.Unmarshal(payload, &claims) == nil && claims.IAMID != "" {
+				return claims.Account.BSS + "/" + claims.IAMID
+			}
+		}
+	}
+	sum := sha256.Sum256([]byte(authorization))
+	return hex.EncodeToString(sum[:])
+}
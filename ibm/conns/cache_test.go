@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testToken returns an unsigned IAM token of account and iamID.
+func testToken(account, iamID string, exp int64) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"iam_id":%q,"account":{"bss":%q},"exp":%d}`, iamID, account, exp)))
+	return "Bearer eyJhbGciOiJub25lIn0." + payload + ".signature"
+}
+
+func TestResponseCache(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	c := &Config{
+		cache: newResponseCache(time.Minute),
+		HTTPTransport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			calls[req.Method+" "+req.URL.String()]++
+			n := calls[req.Method+" "+req.URL.String()]
+			mu.Unlock()
+			status := http.StatusOK
+			if strings.HasSuffix(req.URL.Path, "/missing") {
+				status = http.StatusNotFound
+			}
+			return &http.Response{
+				StatusCode: status,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(strings.NewReader(fmt.Sprintf(`{"call":%d}`, n))),
+				Request:    req,
+			}, nil
+		}),
+	}
+	client := &http.Client{Transport: c.transport()}
+	send := func(method, url, authorization string) string {
+		req, _ := http.NewRequest(method, url, nil)
+		req.Header.Set("Authorization", authorization)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s failed: %s", method, url, err)
+		}
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		return string(body)
+	}
+	alice := testToken("a1", "IBMid-alice", 1)
+	aliceRefreshed := testToken("a1", "IBMid-alice", 2)
+	bob := testToken("a1", "IBMid-bob", 1)
+	images := "https://us-south.iaas.cloud.ibm.com/v1/images?version=2022-06-14&limit=50"
+	roles := "https://iam.cloud.ibm.com/v2/roles"
+
+	// The reads of the same identity share the response.
+	if got := send("GET", images, alice); got != `{"call":1}` {
+		t.Fatalf("expected the first response, got %s", got)
+	}
+	if got := send("GET", images, aliceRefreshed); got != `{"call":1}` {
+		t.Fatalf("expected the cached response, got %s", got)
+	}
+	if got := send("GET", images, bob); got != `{"call":2}` {
+		t.Fatalf("expected the responses not to be shared between identities, got %s", got)
+	}
+	send("GET", roles, alice)
+
+	// The errors aren't cached.
+	send("GET", "https://us-south.iaas.cloud.ibm.com/v1/images/missing", alice)
+	send("GET", "https://us-south.iaas.cloud.ibm.com/v1/images/missing", alice)
+	if n := calls["GET https://us-south.iaas.cloud.ibm.com/v1/images/missing"]; n != 2 {
+		t.Fatalf("expected the errors not to be cached, got %d calls", n)
+	}
+
+	// The IAM token requests don't invalidate the responses of IAM.
+	send("POST", "https://iam.cloud.ibm.com/identity/token", "")
+	if got := send("GET", roles, alice); got != `{"call":1}` {
+		t.Fatalf("expected the cached response, got %s", got)
+	}
+
+	// A change invalidates the responses of the service, and no more of them
+	// are cached, but not the responses of other services.
+	send("POST", "https://us-south.iaas.cloud.ibm.com/v1/images?version=2022-06-14", alice)
+	if got := send("GET", images, alice); got != `{"call":3}` {
+		t.Fatalf("expected the cached response to be invalidated, got %s", got)
+	}
+	if got := send("GET", images, alice); got != `{"call":4}` {
+		t.Fatalf("expected the responses not to be cached after a change, got %s", got)
+	}
+	if got := send("GET", roles, alice); got != `{"call":1}` {
+		t.Fatalf("expected the responses of the other services to be kept, got %s", got)
+	}
+}
+
+func TestResponseCacheExpiry(t *testing.T) {
+	cache := newResponseCache(time.Millisecond)
+	_, done := cache.get("iaas", "key")
+	done(&cachedResponse{service: "iaas", status: http.StatusOK, body: []byte("{}")})
+	if entry, _ := cache.get("iaas", "key"); entry == nil {
+		t.Fatalf("expected the cached response")
+	}
+	time.Sleep(5 * time.Millisecond)
+	if entry, done := cache.get("iaas", "key"); entry != nil || done == nil {
+		t.Fatalf("expected the cached response to expire")
+	}
+	if cache.size != 0 {
+		t.Fatalf("expected the expired response to be dropped, got a size of %d", cache.size)
+	}
+}
+
+func TestResponseCacheConcurrentReads(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	release := make(chan struct{})
+	c := &Config{
+		cache: newResponseCache(time.Minute),
+		HTTPTransport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			calls++
+			mu.Unlock()
+			<-release
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"profiles":[]}`)),
+				Request:    req,
+			}, nil
+		}),
+	}
+	client := &http.Client{Transport: c.transport()}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get("https://us-south.iaas.cloud.ibm.com/v1/instance/profiles")
+			if err != nil {
+				t.Errorf("request failed: %s", err)
+				return
+			}
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if string(body) != `{"profiles":[]}` {
+				t.Errorf("unexpected response %s", body)
+			}
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if calls != 1 {
+		t.Fatalf("expected the concurrent reads to share one call, got %d", calls)
+	}
+}
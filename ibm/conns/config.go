@@ -5,15 +5,13 @@ package conns
 
 import (
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"net"
 	gohttp "net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	// Added code for the Power Colo Offering
@@ -81,6 +79,7 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
 	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/apache/openwhisk-client-go/whisk"
+	httptransport "github.com/go-openapi/runtime/client"
 	jwt "github.com/golang-jwt/jwt"
 	slsession "github.com/softlayer/softlayer-go/session"
 
@@ -103,7 +102,6 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev2/managementv2"
 	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/bluemix-go/authentication"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/rest"
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
@@ -161,12 +159,25 @@ type Config struct {
 	SoftLayerAPIKey string
 
 	//Retry Count for API calls
-	//Unexposed in the schema at this point as they are used only during session creation for a few calls
-	//When sdk implements it we an expose them for expected behaviour
-	//https://github.com/softlayer/softlayer-go/issues/41
 	RetryCount int
 	//Constant Retry Delay for API calls
 	RetryDelay time.Duration
+	//Bounds of the exponential backoff between two retries of a request
+	RetryMinDelay time.Duration
+	RetryMaxDelay time.Duration
+
+	// RateLimits limit the requests sent to the API hosts by all the clients.
+	RateLimits  []RateLimit
+	rateLimiter *rateLimiter
+
+	// AuditLogDir, when set, is the directory of the audit log of the run,
+	// listing every API request sent.
+	AuditLogDir string
+	auditLog    *auditLog
+
+	// failedRequests are the last failed requests sent without the context
+	// of an operation.
+	failedRequests *failedRequestLog
 
 	// FunctionNameSpace ...
 	FunctionNameSpace string
@@ -183,6 +194,24 @@ type Config struct {
 	//TrustedProfileToken Token
 	IAMTrustedProfileID string
 
+	// CRTokenFile is the compute resource token file exchanged for the IAM
+	// token of IAMTrustedProfileID when no IAMToken is set.
+	CRTokenFile string
+
+	// MetadataServiceEndpoint is the VPC instance metadata service endpoint,
+	// used to log in with IAMTrustedProfileID without a compute resource token
+	// file.
+	MetadataServiceEndpoint string
+
+	// trustedProfile refreshes the IAM token of IAMTrustedProfileID.
+	trustedProfile tokenAuthenticator
+
+	// tokens refreshes the IAM token shared by the clients of the session.
+	tokens *tokenManager
+
+	// Tags are the default and ignored tags of the taggable resources.
+	Tags TagConfig
+
 	//IAM Refresh Token
 	IAMRefreshToken string
 
@@ -190,6 +219,32 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// Endpoints overrides the endpoints of services, by the Key of
+	// ServiceEndpoints. They take precedence over the environment variables and
+	// the endpoints file.
+	Endpoints map[string]string
+	// endpointsFile is the content of the endpoints file, loaded when the
+	// ClientSession is configured.
+	endpointsFile endpointsFile
+
+	// ResponseCacheTTL, when set, caches the responses of the GET requests
+	// for this long. See responseCache.
+	ResponseCacheTTL time.Duration
+	cache            *responseCache
+
+	// Network holds the proxy and TLS settings of the requests.
+	Network NetworkConfig
+	network *network
+
+	// HTTPTransport, when set, carries the requests of every client created
+	// from the session. Tests use it to record and replay API traffic.
+	HTTPTransport gohttp.RoundTripper
+
+	// Locker, when set, holds the locks of the MutexKV of the ClientSession
+	// across processes. A lock is waited for at most LockTimeout, if set.
+	Locker      Locker
+	LockTimeout time.Duration
 }
 
 //Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -208,6 +263,11 @@ type ClientSession interface {
 	BluemixAcccountAPI() (accountv2.AccountServiceAPI, error)
 	BluemixAcccountv1API() (accountv1.AccountServiceAPI, error)
 	BluemixUserDetails() (*UserConfig, error)
+	TagConfig() TagConfig
+	MutexKV() *MutexKV
+	ServiceEndpoint(key, defaultURL string) string
+	EndpointOverride(key string) (string, string)
+	DefaultEndpoint(key string) string
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
 	VpcContainerAPI() (containerv2.ContainerServiceAPI, error)
 	ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error)
@@ -296,548 +356,718 @@ type ClientSession interface {
 
 type clientSession struct {
 	session *Session
+	config  *Config
+	mutexKV *MutexKV
+
+	// iamOnce guards the IAM login shared by all the clients, see iamLogin
+	iamOnce       sync.Once
+	iamErr        error
+	authenticator core.Authenticator
 
-	appidErr error
-	appidAPI *appid.AppIDManagementV4
+	cfOnce sync.Once
 
-	apigatewayErr error
-	apigatewayAPI *apigateway.ApiGatewayControllerApiV1
+	appidErr     error
+	appIDAPIOnce sync.Once
+	appidAPI     *appid.AppIDManagementV4
 
-	accountConfigErr     error
-	bmxAccountServiceAPI accountv2.AccountServiceAPI
+	apigatewayErr  error
+	apiGatewayOnce sync.Once
+	apigatewayAPI  *apigateway.ApiGatewayControllerApiV1
 
-	accountV1ConfigErr     error
-	bmxAccountv1ServiceAPI accountv1.AccountServiceAPI
+	accountConfigErr       error
+	bluemixAcccountAPIOnce sync.Once
+	bmxAccountServiceAPI   accountv2.AccountServiceAPI
+
+	accountV1ConfigErr       error
+	bluemixAcccountv1APIOnce sync.Once
+	bmxAccountv1ServiceAPI   accountv1.AccountServiceAPI
 
 	bmxUserDetails  *UserConfig
 	bmxUserFetchErr error
 
-	csConfigErr  error
-	csServiceAPI containerv1.ContainerServiceAPI
+	csConfigErr      error
+	containerAPIOnce sync.Once
+	csServiceAPI     containerv1.ContainerServiceAPI
 
-	csv2ConfigErr  error
-	csv2ServiceAPI containerv2.ContainerServiceAPI
+	csv2ConfigErr       error
+	vpcContainerAPIOnce sync.Once
+	csv2ServiceAPI      containerv2.ContainerServiceAPI
 
 	containerRegistryClientErr error
+	containerRegistryV1Once    sync.Once
 	containerRegistryClient    *containerregistryv1.ContainerRegistryV1
 
-	certManagementErr error
-	certManagementAPI certificatemanager.CertificateManagerServiceAPI
+	certManagementErr         error
+	certificateManagerAPIOnce sync.Once
+	certManagementAPI         certificatemanager.CertificateManagerServiceAPI
 
 	cfConfigErr  error
+	mccpAPIOnce  sync.Once
 	cfServiceAPI mccpv2.MccpServiceAPI
 
 	cisConfigErr  error
 	cisServiceAPI cisv1.CisServiceAPI
 
-	functionConfigErr error
-	functionClient    *whisk.Client
+	functionConfigErr  error
+	functionClientOnce sync.Once
+	functionClient     *whisk.Client
 
 	globalSearchConfigErr  error
+	globalSearchAPIOnce    sync.Once
 	globalSearchServiceAPI globalsearchv2.GlobalSearchServiceAPI
 
 	globalTaggingConfigErr  error
+	globalTaggingAPIOnce    sync.Once
 	globalTaggingServiceAPI globaltaggingv3.GlobalTaggingServiceAPI
 
 	globalTaggingConfigErrV1  error
+	globalTaggingAPIv1Once    sync.Once
 	globalTaggingServiceAPIV1 globaltaggingv1.GlobalTaggingV1
 
 	ibmCloudShellClient    *ibmcloudshellv1.IBMCloudShellV1
 	ibmCloudShellClientErr error
+	ibmCloudShellV1Once    sync.Once
 
-	userManagementErr error
-	userManagementAPI usermanagementv2.UserManagementAPI
+	userManagementErr     error
+	userManagementAPIOnce sync.Once
+	userManagementAPI     usermanagementv2.UserManagementAPI
 
 	icdConfigErr  error
+	icdAPIOnce    sync.Once
 	icdServiceAPI icdv4.ICDServiceAPI
 
 	cloudDatabasesClientErr error
+	cloudDatabasesV5Once    sync.Once
 	cloudDatabasesClient    *clouddatabasesv5.CloudDatabasesV5
 
 	resourceControllerConfigErr  error
+	resourceControllerAPIOnce    sync.Once
 	resourceControllerServiceAPI controller.ResourceControllerAPI
 
 	resourceControllerConfigErrv2  error
+	resourceControllerAPIV2Once    sync.Once
 	resourceControllerServiceAPIv2 controllerv2.ResourceControllerAPIV2
 
 	resourceManagementConfigErrv2  error
+	resourceManagementAPIv2Once    sync.Once
 	resourceManagementServiceAPIv2 managementv2.ResourceManagementAPIv2
 
 	resourceCatalogConfigErr  error
+	resourceCatalogAPIOnce    sync.Once
 	resourceCatalogServiceAPI catalog.ResourceCatalogAPI
 
-	ibmpiConfigErr error
-	ibmpiSession   *ibmpisession.IBMPISession
+	ibmpiConfigErr   error
+	ibmpiSessionOnce sync.Once
+	ibmpiSession     *ibmpisession.IBMPISession
 
-	kpErr error
-	kpAPI *kp.API
+	kpErr             error
+	keyProtectAPIOnce sync.Once
+	kpAPI             *kp.API
 
-	kmsErr error
-	kmsAPI *kp.API
+	kmsErr               error
+	keyManagementAPIOnce sync.Once
+	kmsAPI               *kp.API
 
-	hpcsEndpointErr error
-	hpcsEndpointAPI hpcs.HPCSV2
+	hpcsEndpointErr     error
+	hpcsEndpointAPIOnce sync.Once
+	hpcsEndpointAPI     hpcs.HPCSV2
 
 	ukoClient    *ukov4.UkoV4
 	ukoClientErr error
+	ukoV4Once    sync.Once
 
-	pDNSClient *dns.DnsSvcsV1
-	pDNSErr    error
-
-	bluemixSessionErr error
+	pDNSClient                  *dns.DnsSvcsV1
+	pDNSErr                     error
+	privateDNSClientSessionOnce sync.Once
 
 	pushServiceClient    *pushservicev1.PushServiceV1
 	pushServiceClientErr error
+	pushServiceV1Once    sync.Once
 
 	eventNotificationsApiClient    *eventnotificationsv1.EventNotificationsV1
 	eventNotificationsApiClientErr error
+	eventNotificationsApiV1Once    sync.Once
 
 	appConfigurationClient    *appconfigurationv1.AppConfigurationV1
 	appConfigurationClientErr error
+	appConfigurationV1Once    sync.Once
 
-	vpcErr error
-	vpcAPI *vpc.VpcV1
+	vpcErr       error
+	vpcV1APIOnce sync.Once
+	vpcAPI       *vpc.VpcV1
 
-	directlinkAPI *dl.DirectLinkV1
-	directlinkErr error
-	dlProviderAPI *dlProviderV2.DirectLinkProviderV2
-	dlProviderErr error
+	directlinkAPI               *dl.DirectLinkV1
+	directlinkErr               error
+	directlinkV1APIOnce         sync.Once
+	dlProviderAPI               *dlProviderV2.DirectLinkProviderV2
+	dlProviderErr               error
+	directlinkProviderV2APIOnce sync.Once
 
-	cosConfigErr error
-	cosConfigAPI *cosconfig.ResourceConfigurationV1
+	cosConfigErr       error
+	cosConfigV1APIOnce sync.Once
+	cosConfigAPI       *cosconfig.ResourceConfigurationV1
 
-	transitgatewayAPI *tg.TransitGatewayApisV1
-	transitgatewayErr error
+	transitgatewayAPI       *tg.TransitGatewayApisV1
+	transitgatewayErr       error
+	transitGatewayV1APIOnce sync.Once
 
-	functionIAMNamespaceAPI functions.FunctionServiceAPI
-	functionIAMNamespaceErr error
+	functionIAMNamespaceAPI     functions.FunctionServiceAPI
+	functionIAMNamespaceErr     error
+	functionIAMNamespaceAPIOnce sync.Once
 
 	// CIS Zones
-	cisZonesErr      error
-	cisZonesV1Client *ciszonesv1.ZonesV1
+	cisZonesErr                 error
+	cisZonesV1ClientSessionOnce sync.Once
+	cisZonesV1Client            *ciszonesv1.ZonesV1
 
 	// CIS Alerts
-	cisAlertsClient *cisalertsv1.AlertsV1
-	cisAlertsErr    error
+	cisAlertsClient      *cisalertsv1.AlertsV1
+	cisAlertsErr         error
+	cisAlertsSessionOnce sync.Once
 
 	// CIS dns service options
-	cisDNSErr           error
-	cisDNSRecordsClient *cisdnsrecordsv1.DnsRecordsV1
+	cisDNSErr                     error
+	cisDNSRecordClientSessionOnce sync.Once
+	cisDNSRecordsClient           *cisdnsrecordsv1.DnsRecordsV1
 
 	// CIS dns bulk service options
-	cisDNSBulkErr          error
-	cisDNSRecordBulkClient *cisdnsbulkv1.DnsRecordBulkV1
+	cisDNSBulkErr                     error
+	cisDNSRecordBulkClientSessionOnce sync.Once
+	cisDNSRecordBulkClient            *cisdnsbulkv1.DnsRecordBulkV1
 
 	// CIS Global Load Balancer Pool service options
-	cisGLBPoolErr    error
-	cisGLBPoolClient *cisglbpoolv0.GlobalLoadBalancerPoolsV0
+	cisGLBPoolErr               error
+	cisGLBPoolClientSessionOnce sync.Once
+	cisGLBPoolClient            *cisglbpoolv0.GlobalLoadBalancerPoolsV0
 
 	// CIS GLB service options
-	cisGLBErr    error
-	cisGLBClient *cisglbv1.GlobalLoadBalancerV1
+	cisGLBErr               error
+	cisGLBClientSessionOnce sync.Once
+	cisGLBClient            *cisglbv1.GlobalLoadBalancerV1
 
 	// CIS GLB health check service options
-	cisGLBHealthCheckErr    error
-	cisGLBHealthCheckClient *cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1
+	cisGLBHealthCheckErr               error
+	cisGLBHealthCheckClientSessionOnce sync.Once
+	cisGLBHealthCheckClient            *cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1
 
 	// CIS IP service options
-	cisIPErr    error
-	cisIPClient *cisipv1.CisIpApiV1
+	cisIPErr               error
+	cisIPClientSessionOnce sync.Once
+	cisIPClient            *cisipv1.CisIpApiV1
 
 	// CIS Zone Rate Limits service options
-	cisRLErr    error
-	cisRLClient *cisratelimitv1.ZoneRateLimitsV1
+	cisRLErr               error
+	cisRLClientSessionOnce sync.Once
+	cisRLClient            *cisratelimitv1.ZoneRateLimitsV1
 
 	// CIS Page Rules service options
-	cisPageRuleErr    error
-	cisPageRuleClient *cispagerulev1.PageRuleApiV1
+	cisPageRuleErr               error
+	cisPageRuleClientSessionOnce sync.Once
+	cisPageRuleClient            *cispagerulev1.PageRuleApiV1
 
 	// CIS Edge Functions service options
-	cisEdgeFunctionErr    error
-	cisEdgeFunctionClient *cisedgefunctionv1.EdgeFunctionsApiV1
+	cisEdgeFunctionErr               error
+	cisEdgeFunctionClientSessionOnce sync.Once
+	cisEdgeFunctionClient            *cisedgefunctionv1.EdgeFunctionsApiV1
 
 	// CIS SSL certificate service options
-	cisSSLErr    error
-	cisSSLClient *cissslv1.SslCertificateApiV1
+	cisSSLErr               error
+	cisSSLClientSessionOnce sync.Once
+	cisSSLClient            *cissslv1.SslCertificateApiV1
 
 	// CIS WAF Package service options
-	cisWAFPackageErr    error
-	cisWAFPackageClient *ciswafpackagev1.WafRulePackagesApiV1
+	cisWAFPackageErr               error
+	cisWAFPackageClientSessionOnce sync.Once
+	cisWAFPackageClient            *ciswafpackagev1.WafRulePackagesApiV1
 
 	// CIS Zone Setting service options
-	cisDomainSettingsErr    error
-	cisDomainSettingsClient *cisdomainsettingsv1.ZonesSettingsV1
+	cisDomainSettingsErr               error
+	cisDomainSettingsClientSessionOnce sync.Once
+	cisDomainSettingsClient            *cisdomainsettingsv1.ZonesSettingsV1
 
 	// CIS Routing service options
-	cisRoutingErr    error
-	cisRoutingClient *cisroutingv1.RoutingV1
+	cisRoutingErr               error
+	cisRoutingClientSessionOnce sync.Once
+	cisRoutingClient            *cisroutingv1.RoutingV1
 
 	// CIS WAF Group service options
-	cisWAFGroupErr    error
-	cisWAFGroupClient *ciswafgroupv1.WafRuleGroupsApiV1
+	cisWAFGroupErr               error
+	cisWAFGroupClientSessionOnce sync.Once
+	cisWAFGroupClient            *ciswafgroupv1.WafRuleGroupsApiV1
 
 	// CIS Caching service options
-	cisCacheErr    error
-	cisCacheClient *ciscachev1.CachingApiV1
+	cisCacheErr               error
+	cisCacheClientSessionOnce sync.Once
+	cisCacheClient            *ciscachev1.CachingApiV1
 
 	// CIS Custom Pages service options
-	cisCustomPageErr    error
-	cisCustomPageClient *ciscustompagev1.CustomPagesV1
+	cisCustomPageErr               error
+	cisCustomPageClientSessionOnce sync.Once
+	cisCustomPageClient            *ciscustompagev1.CustomPagesV1
 
 	// CIS Firewall Access rule service option
-	cisAccessRuleErr    error
-	cisAccessRuleClient *cisaccessrulev1.ZoneFirewallAccessRulesV1
+	cisAccessRuleErr               error
+	cisAccessRuleClientSessionOnce sync.Once
+	cisAccessRuleClient            *cisaccessrulev1.ZoneFirewallAccessRulesV1
 
 	// CIS User Agent Blocking Rule service option
-	cisUARuleErr    error
-	cisUARuleClient *cisuarulev1.UserAgentBlockingRulesV1
+	cisUARuleErr               error
+	cisUARuleClientSessionOnce sync.Once
+	cisUARuleClient            *cisuarulev1.UserAgentBlockingRulesV1
 
 	// CIS Firewall Lockdwon Rule service option
-	cisLockdownErr    error
-	cisLockdownClient *cislockdownv1.ZoneLockdownV1
+	cisLockdownErr               error
+	cisLockdownClientSessionOnce sync.Once
+	cisLockdownClient            *cislockdownv1.ZoneLockdownV1
 
 	// CIS LogpushJobs service option
-	cisLogpushJobsClient *cislogpushjobsapiv1.LogpushJobsApiV1
-	cisLogpushJobsErr    error
+	cisLogpushJobsClient      *cislogpushjobsapiv1.LogpushJobsApiV1
+	cisLogpushJobsErr         error
+	cisLogpushJobsSessionOnce sync.Once
 
 	// CIS Range app service option
-	cisRangeAppErr    error
-	cisRangeAppClient *cisrangeappv1.RangeApplicationsV1
+	cisRangeAppErr               error
+	cisRangeAppClientSessionOnce sync.Once
+	cisRangeAppClient            *cisrangeappv1.RangeApplicationsV1
 
 	// CIS WAF rule service options
-	cisWAFRuleErr    error
-	cisWAFRuleClient *ciswafrulev1.WafRulesApiV1
+	cisWAFRuleErr               error
+	cisWAFRuleClientSessionOnce sync.Once
+	cisWAFRuleClient            *ciswafrulev1.WafRulesApiV1
 	//IAM Identity Option
-	iamIdentityErr error
-	iamIdentityAPI *iamidentity.IamIdentityV1
+	iamIdentityErr       error
+	iamIdentityV1APIOnce sync.Once
+	iamIdentityAPI       *iamidentity.IamIdentityV1
 
 	//Resource Manager Option
-	resourceManagerErr error
-	resourceManagerAPI *resourcemanager.ResourceManagerV2
+	resourceManagerErr       error
+	resourceManagerV2APIOnce sync.Once
+	resourceManagerAPI       *resourcemanager.ResourceManagerV2
 
 	//Catalog Management Option
 	catalogManagementClient    *catalogmanagementv1.CatalogManagementV1
 	catalogManagementClientErr error
+	catalogManagementV1Once    sync.Once
 
 	enterpriseManagementClient    *enterprisemanagementv1.EnterpriseManagementV1
 	enterpriseManagementClientErr error
+	enterpriseManagementV1Once    sync.Once
 
 	//Resource Controller Option
-	resourceControllerErr   error
-	resourceControllerAPI   *resourcecontroller.ResourceControllerV2
-	secretsManagerClient    *secretsmanagerv1.SecretsManagerV1
-	secretsManagerClientErr error
+	resourceControllerErr       error
+	resourceControllerV2APIOnce sync.Once
+	resourceControllerAPI       *resourcecontroller.ResourceControllerV2
+	secretsManagerClient        *secretsmanagerv1.SecretsManagerV1
+	secretsManagerClientErr     error
+	secretsManagerV1Once        sync.Once
 
 	// Schematics service options
 	schematicsClient    *schematicsv1.SchematicsV1
 	schematicsClientErr error
+	schematicsV1Once    sync.Once
 
 	//Satellite service
-	satelliteClient    *kubernetesserviceapiv1.KubernetesServiceApiV1
-	satelliteClientErr error
+	satelliteClient            *kubernetesserviceapiv1.KubernetesServiceApiV1
+	satelliteClientErr         error
+	satelliteClientSessionOnce sync.Once
 
 	//IAM Policy Management
-	iamPolicyManagementErr error
-	iamPolicyManagementAPI *iampolicymanagement.IamPolicyManagementV1
+	iamPolicyManagementErr       error
+	iamPolicyManagementV1APIOnce sync.Once
+	iamPolicyManagementAPI       *iampolicymanagement.IamPolicyManagementV1
 
 	//IAM Access Groups
-	iamAccessGroupsErr error
-	iamAccessGroupsAPI *iamaccessgroups.IamAccessGroupsV2
+	iamAccessGroupsErr    error
+	iamAccessGroupsV2Once sync.Once
+	iamAccessGroupsAPI    *iamaccessgroups.IamAccessGroupsV2
 
 	// MTLS Session options
-	cisMtlsClient *cismtlsv1.MtlsV1
-	cisMtlsErr    error
+	cisMtlsClient      *cismtlsv1.MtlsV1
+	cisMtlsErr         error
+	cisMtlsSessionOnce sync.Once
 
 	// CIS Webhooks options
-	cisWebhooksClient *ciswebhooksv1.WebhooksV1
-	cisWebhooksErr    error
+	cisWebhooksClient     *ciswebhooksv1.WebhooksV1
+	cisWebhooksErr        error
+	cisWebhookSessionOnce sync.Once
 
 	// CIS Filters options
-	cisFiltersClient *cisfiltersv1.FiltersV1
-	cisFiltersErr    error
+	cisFiltersClient      *cisfiltersv1.FiltersV1
+	cisFiltersErr         error
+	cisFiltersSessionOnce sync.Once
 
 	// CIS FirewallRules options
-	cisFirewallRulesClient *cisfirewallrulesv1.FirewallRulesV1
-	cisFirewallRulesErr    error
+	cisFirewallRulesClient      *cisfirewallrulesv1.FirewallRulesV1
+	cisFirewallRulesErr         error
+	cisFirewallRulesSessionOnce sync.Once
 
 	//Atracker
 	atrackerClient    *atrackerv1.AtrackerV1
 	atrackerClientErr error
+	atrackerV1Once    sync.Once
 
 	atrackerClientV2    *atrackerv2.AtrackerV2
 	atrackerClientV2Err error
+	atrackerV2Once      sync.Once
 
 	//Satellite link service
-	satelliteLinkClient    *satellitelinkv1.SatelliteLinkV1
-	satelliteLinkClientErr error
+	satelliteLinkClient           *satellitelinkv1.SatelliteLinkV1
+	satelliteLinkClientErr        error
+	satellitLinkClientSessionOnce sync.Once
 
-	esSchemaRegistryClient *schemaregistryv1.SchemaregistryV1
-	esSchemaRegistryErr    error
+	esSchemaRegistryClient      *schemaregistryv1.SchemaregistryV1
+	esSchemaRegistryErr         error
+	esSchemaRegistrySessionOnce sync.Once
 
 	// Security and Compliance Center (SCC)
 	findingsClient    *findingsv1.FindingsV1
 	findingsClientErr error
+	findingsV1Once    sync.Once
 
 	// Security and Compliance Center (SCC) Admin
 	adminServiceApiClient    *adminserviceapiv1.AdminServiceApiV1
 	adminServiceApiClientErr error
+	adminServiceApiV1Once    sync.Once
 
 	// Security and Compliance Center (SCC) Governance
-	configServiceApiClient    *configurationgovernancev1.ConfigurationGovernanceV1
-	configServiceApiClientErr error
+	configServiceApiClient        *configurationgovernancev1.ConfigurationGovernanceV1
+	configServiceApiClientErr     error
+	configurationGovernanceV1Once sync.Once
 
 	//Security and Compliance Center (SCC) Compliance posture
 	postureManagementClientErr error
+	postureManagementV1Once    sync.Once
 	postureManagementClient    *posturemanagementv1.PostureManagementV1
 
 	//Security and Compliance Center (SCC) Compliance posture v2
 	postureManagementClientv2    *posturemanagementv2.PostureManagementV2
 	postureManagementClientErrv2 error
+	postureManagementV2Once      sync.Once
 
 	// context Based Restrictions (CBR)
 	contextBasedRestrictionsClient    *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
 	contextBasedRestrictionsClientErr error
+	contextBasedRestrictionsV1Once    sync.Once
 
 	// CD Toolchain
 	cdToolchainClient    *cdtoolchainv2.CdToolchainV2
 	cdToolchainClientErr error
+	cdToolchainV2Once    sync.Once
 
 	// CD Tekton Pipeline
 	cdTektonPipelineClient    *cdtektonpipelinev2.CdTektonPipelineV2
 	cdTektonPipelineClientErr error
+	cdTektonPipelineV2Once    sync.Once
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.appIDAPIOnce.Do(session.configureAppIDAPI)
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.catalogManagementV1Once.Do(session.configureCatalogManagementV1)
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.bluemixAcccountAPIOnce.Do(sess.configureBluemixAcccountAPI)
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.bluemixAcccountv1APIOnce.Do(sess.configureBluemixAcccountv1API)
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
-	return sess.session.BluemixSession, sess.bluemixSessionErr
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
+	err := sess.iamLogin()
+	return sess.config.tokens.session(sess.session.BluemixSession), err
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
+	sess.iamLogin()
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
+// TagConfig returns the default and ignored tags of the taggable resources.
+func (sess *clientSession) TagConfig() TagConfig {
+	return sess.config.Tags
+}
+
+// MutexKV returns the locks serializing the changes to a resource. They are
+// shared in process with the other configurations of the provider, and held
+// across processes by the Locker of this one.
+func (sess *clientSession) MutexKV() *MutexKV {
+	return sess.mutexKV
+}
+
+// ServiceEndpoint returns the endpoint of the service with the environment
+// variable and endpoints file key, or defaultURL if it isn't overridden.
+func (sess *clientSession) ServiceEndpoint(key, defaultURL string) string {
+	return sess.config.endpoint(key, defaultURL)
+}
+
+// EndpointOverride returns the endpoint overriding the default endpoint of the
+// service with the environment variable and endpoints file key, and its source.
+func (sess *clientSession) EndpointOverride(key string) (string, string) {
+	return sess.config.EndpointOverride(key)
+}
+
+// DefaultEndpoint returns the endpoint of the service with the environment
+// variable and endpoints file key when it isn't overridden.
+func (sess *clientSession) DefaultEndpoint(key string) string {
+	return sess.config.DefaultEndpoint(key)
+}
+
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.containerAPIOnce.Do(sess.configureContainerAPI)
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.vpcContainerAPIOnce.Do(sess.configureVpcContainerAPI)
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.containerRegistryV1Once.Do(session.configureContainerRegistryV1)
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.schematicsV1Once.Do(sess.configureSchematicsV1)
 	return sess.schematicsClient, sess.schematicsClientErr
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.functionClientOnce.Do(sess.configureFunctionClient)
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.globalSearchAPIOnce.Do(sess.configureGlobalSearchAPI)
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.globalTaggingAPIOnce.Do(sess.configureGlobalTaggingAPI)
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.globalTaggingAPIv1Once.Do(sess.configureGlobalTaggingAPIv1)
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.hpcsEndpointAPIOnce.Do(sess.configureHpcsEndpointAPI)
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UKO
-func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
+func (session *clientSession) UkoV4() (*ukov4.UkoV4, error) {
+	session.ukoV4Once.Do(session.configureUkoV4)
 	return session.ukoClient, session.ukoClientErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.userManagementAPIOnce.Do(sess.configureUserManagementAPI)
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.iamPolicyManagementV1APIOnce.Do(sess.configureIAMPolicyManagementV1API)
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.iamAccessGroupsV2Once.Do(sess.configureIAMAccessGroupsV2)
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.ibmCloudShellV1Once.Do(session.configureIBMCloudShellV1)
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.icdAPIOnce.Do(sess.configureICDAPI)
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.cloudDatabasesV5Once.Do(session.configureCloudDatabasesV5)
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.mccpAPIOnce.Do(sess.configureMccpAPI)
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.resourceCatalogAPIOnce.Do(sess.configureResourceCatalogAPI)
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.resourceManagementAPIv2Once.Do(sess.configureResourceManagementAPIv2)
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.resourceControllerAPIOnce.Do(sess.configureResourceControllerAPI)
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.resourceControllerAPIV2Once.Do(sess.configureResourceControllerAPIV2)
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
+	if sess.session.SoftLayerSession.IAMToken != "" {
+		// The SoftLayer session picks up the IAM tokens once they are refreshed
+		sess.iamLogin()
+	}
 	return sess.session.SoftLayerSession
 }
 
 // CertManagementAPI provides Certificate  management APIs ...
-func (sess clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+func (sess *clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+	sess.certificateManagerAPIOnce.Do(sess.configureCertificateManagerAPI)
 	return sess.certManagementAPI, sess.certManagementErr
 }
 
 //apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.apiGatewayOnce.Do(sess.configureAPIGateway)
 	return sess.apigatewayAPI, sess.apigatewayErr
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.pushServiceV1Once.Do(session.configurePushServiceV1)
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.eventNotificationsApiV1Once.Do(session.configureEventNotificationsApiV1)
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.appConfigurationV1Once.Do(session.configureAppConfigurationV1)
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.keyProtectAPIOnce.Do(sess.configureKeyProtectAPI)
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.keyManagementAPIOnce.Do(sess.configureKeyManagementAPI)
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:  EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
+				BaseURL:  sess.kmsAPI.Config.BaseURL,
 				APIKey:   sess.kmsAPI.Config.APIKey, //pragma: allowlist secret
 				Verbose:  kp.VerboseFailOnly,
 				TokenURL: sess.kmsAPI.Config.TokenURL,
 			}
 		} else {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
+				BaseURL:       sess.kmsAPI.Config.BaseURL,
 				Authorization: sess.session.BluemixSession.Config.IAMAccessToken, //pragma: allowlist secret
 				Verbose:       kp.VerboseFailOnly,
 				TokenURL:      sess.kmsAPI.Config.TokenURL,
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, sess.config.transport())
 		if err != nil {
-			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			return nil, fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
 		return kpClient, nil
 	}
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.vpcV1APIOnce.Do(sess.configureVpcV1API)
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.directlinkV1APIOnce.Do(sess.configureDirectlinkV1API)
 	return sess.directlinkAPI, sess.directlinkErr
 }
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.directlinkProviderV2APIOnce.Do(sess.configureDirectlinkProviderV2API)
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.cosConfigV1APIOnce.Do(sess.configureCosConfigV1API)
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.transitGatewayV1APIOnce.Do(sess.configureTransitGatewayV1API)
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.ibmpiSessionOnce.Do(sess.configureIBMPISession)
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.privateDNSClientSessionOnce.Do(sess.configurePrivateDNSClientSession)
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.functionIAMNamespaceAPIOnce.Do(sess.configureFunctionIAMNamespaceAPI)
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.cisZonesV1ClientSessionOnce.Do(sess.configureCisZonesV1ClientSession)
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -845,7 +1075,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.cisDNSRecordClientSessionOnce.Do(sess.configureCisDNSRecordClientSession)
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -853,7 +1084,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.cisDNSRecordBulkClientSessionOnce.Do(sess.configureCisDNSRecordBulkClientSession)
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -861,7 +1093,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.cisGLBPoolClientSessionOnce.Do(sess.configureCisGLBPoolClientSession)
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -869,7 +1102,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.cisGLBClientSessionOnce.Do(sess.configureCisGLBClientSession)
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -877,7 +1111,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.cisGLBHealthCheckClientSessionOnce.Do(sess.configureCisGLBHealthCheckClientSession)
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -885,7 +1120,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.cisRLClientSessionOnce.Do(sess.configureCisRLClientSession)
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -893,7 +1129,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.cisIPClientSessionOnce.Do(sess.configureCisIPClientSession)
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -901,7 +1138,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.cisPageRuleClientSessionOnce.Do(sess.configureCisPageRuleClientSession)
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -909,7 +1147,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.cisEdgeFunctionClientSessionOnce.Do(sess.configureCisEdgeFunctionClientSession)
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -917,7 +1156,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.cisSSLClientSessionOnce.Do(sess.configureCisSSLClientSession)
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -925,7 +1165,8 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.cisWAFPackageClientSessionOnce.Do(sess.configureCisWAFPackageClientSession)
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -933,7 +1174,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.cisDomainSettingsClientSessionOnce.Do(sess.configureCisDomainSettingsClientSession)
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -941,7 +1183,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.cisAlertsSessionOnce.Do(sess.configureCisAlertsSession)
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -949,7 +1192,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.cisRoutingClientSessionOnce.Do(sess.configureCisRoutingClientSession)
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -957,7 +1201,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.cisWAFGroupClientSessionOnce.Do(sess.configureCisWAFGroupClientSession)
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -965,7 +1210,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.cisCacheClientSessionOnce.Do(sess.configureCisCacheClientSession)
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -973,7 +1219,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.cisCustomPageClientSessionOnce.Do(sess.configureCisCustomPageClientSession)
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -981,7 +1228,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.cisAccessRuleClientSessionOnce.Do(sess.configureCisAccessRuleClientSession)
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -989,7 +1237,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.cisUARuleClientSessionOnce.Do(sess.configureCisUARuleClientSession)
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -997,7 +1246,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.cisLockdownClientSessionOnce.Do(sess.configureCisLockdownClientSession)
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -1005,7 +1255,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.cisRangeAppClientSessionOnce.Do(sess.configureCisRangeAppClientSession)
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -1013,7 +1264,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.cisWAFRuleClientSessionOnce.Do(sess.configureCisWAFRuleClientSession)
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -1021,43 +1273,51 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.iamIdentityV1APIOnce.Do(sess.configureIAMIdentityV1API)
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.resourceManagerV2APIOnce.Do(sess.configureResourceManagerV2API)
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.enterpriseManagementV1Once.Do(session.configureEnterpriseManagementV1)
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.resourceControllerV2APIOnce.Do(sess.configureResourceControllerV2API)
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
 // SecretsManager Session
-func (session clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+func (session *clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+	session.secretsManagerV1Once.Do(session.configureSecretsManagerV1)
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.satellitLinkClientSessionOnce.Do(session.configureSatellitLinkClientSession)
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.satelliteClientSessionOnce.Do(sess.configureSatelliteClientSession)
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.cisLogpushJobsSessionOnce.Do(sess.configureCisLogpushJobsSession)
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1065,7 +1325,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS MTLS session
-func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+func (sess *clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+	sess.cisMtlsSessionOnce.Do(sess.configureCisMtlsSession)
 	if sess.cisMtlsErr != nil {
 		return sess.cisMtlsClient, sess.cisMtlsErr
 	}
@@ -1073,7 +1334,8 @@ func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.cisWebhookSessionOnce.Do(sess.configureCisWebhookSession)
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1081,7 +1343,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.cisFiltersSessionOnce.Do(sess.configureCisFiltersSession)
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1089,7 +1352,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.cisFirewallRulesSessionOnce.Do(sess.configureCisFirewallRulesSession)
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1097,20 +1361,24 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
+func (session *clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
+	session.atrackerV1Once.Do(session.configureAtrackerV1)
 	return session.atrackerClient, session.atrackerClientErr
 }
 
-func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+func (session *clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+	session.atrackerV2Once.Do(session.configureAtrackerV2)
 	return session.atrackerClientV2, session.atrackerClientV2Err
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.esSchemaRegistrySessionOnce.Do(session.configureESschemaRegistrySession)
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
 // Security and Compliance center Findings API
-func (session clientSession) FindingsV1() (*findingsv1.FindingsV1, error) {
+func (session *clientSession) FindingsV1() (*findingsv1.FindingsV1, error) {
+	session.findingsV1Once.Do(session.configureFindingsV1)
 	if session.findingsClientErr != nil {
 		return session.findingsClient, session.findingsClientErr
 	}
@@ -1118,16 +1386,19 @@ func (session clientSession) FindingsV1() (*findingsv1.FindingsV1, error) {
 }
 
 //Security and Compliance center Admin API
-func (session clientSession) AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error) {
+func (session *clientSession) AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error) {
+	session.adminServiceApiV1Once.Do(session.configureAdminServiceApiV1)
 	return session.adminServiceApiClient, session.adminServiceApiClientErr
 }
 
-func (session clientSession) ConfigurationGovernanceV1() (*configurationgovernancev1.ConfigurationGovernanceV1, error) {
+func (session *clientSession) ConfigurationGovernanceV1() (*configurationgovernancev1.ConfigurationGovernanceV1, error) {
+	session.configurationGovernanceV1Once.Do(session.configureConfigurationGovernanceV1)
 	return session.configServiceApiClient, session.configServiceApiClientErr
 }
 
 // Security and Compliance center Posture Management
-func (session clientSession) PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error) {
+func (session *clientSession) PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error) {
+	session.postureManagementV1Once.Do(session.configurePostureManagementV1)
 	if session.postureManagementClientErr != nil {
 		return session.postureManagementClient, session.postureManagementClientErr
 	}
@@ -1135,7 +1406,8 @@ func (session clientSession) PostureManagementV1() (*posturemanagementv1.Posture
 }
 
 //Security and Compliance center Posture Management v2
-func (session clientSession) PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error) {
+func (session *clientSession) PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error) {
+	session.postureManagementV2Once.Do(session.configurePostureManagementV2)
 	if session.postureManagementClientErrv2 != nil {
 		return session.postureManagementClientv2, session.postureManagementClientErrv2
 	}
@@ -1143,243 +1415,281 @@ func (session clientSession) PostureManagementV2() (*posturemanagementv2.Posture
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.contextBasedRestrictionsV1Once.Do(session.configureContextBasedRestrictionsV1)
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
 // CD Toolchain
-func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+func (session *clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+	session.cdToolchainV2Once.Do(session.configureCdToolchainV2)
 	return session.cdToolchainClient, session.cdToolchainClientErr
 }
 
 // CD Tekton Pipeline
-func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+func (session *clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+	session.cdTektonPipelineV2Once.Do(session.configureCdTektonPipelineV2)
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
-// ClientSession configures and returns a fully initialized ClientSession
+// ClientSession configures and returns a ClientSession. Service clients are
+// not built here: each one is configured, and cached, the first time its
+// accessor is called, so a configuration only pays for the services it uses.
 func (c *Config) ClientSession() (interface{}, error) {
+	c.tokens = &tokenManager{}
+	c.failedRequests = &failedRequestLog{}
+	if len(c.RateLimits) > 0 {
+		c.rateLimiter = newRateLimiter(c.RateLimits)
+	}
+	if c.AuditLogDir != "" {
+		auditLog, err := openAuditLog(c.AuditLogDir)
+		if err != nil {
+			return nil, err
+		}
+		c.auditLog = auditLog
+	}
+	if err := c.loadEndpointsFile(); err != nil {
+		return nil, err
+	}
+	network, err := c.Network.load()
+	if err != nil {
+		return nil, err
+	}
+	c.network = network
+	if c.ResponseCacheTTL > 0 {
+		c.cache = newResponseCache(c.ResponseCacheTTL)
+	}
 	sess, err := newSession(c)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
+	session := &clientSession{
 		session: sess,
+		config:  c,
+		mutexKV: IbmMutexKV.WithLocker(c.Locker, c.LockTimeout),
 	}
 
 	if sess.BluemixSession == nil {
 		//Can be nil only  if bluemix_api_key is not provided
 		log.Println("Skipping Bluemix Clients configuration")
-		session.bluemixSessionErr = errEmptyBluemixCredentials
-		session.accountConfigErr = errEmptyBluemixCredentials
-		session.accountV1ConfigErr = errEmptyBluemixCredentials
-		session.csConfigErr = errEmptyBluemixCredentials
-		session.csv2ConfigErr = errEmptyBluemixCredentials
-		session.containerRegistryClientErr = errEmptyBluemixCredentials
-		session.kpErr = errEmptyBluemixCredentials
-		session.pushServiceClientErr = errEmptyBluemixCredentials
-		session.appConfigurationClientErr = errEmptyBluemixCredentials
-		session.kmsErr = errEmptyBluemixCredentials
-		session.cfConfigErr = errEmptyBluemixCredentials
-		session.cisConfigErr = errEmptyBluemixCredentials
-		session.functionConfigErr = errEmptyBluemixCredentials
-		session.globalSearchConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErrV1 = errEmptyBluemixCredentials
-		session.hpcsEndpointErr = errEmptyBluemixCredentials
-		session.iamAccessGroupsErr = errEmptyBluemixCredentials
-		session.icdConfigErr = errEmptyBluemixCredentials
-		session.resourceCatalogConfigErr = errEmptyBluemixCredentials
-		session.resourceManagerErr = errEmptyBluemixCredentials
-		session.resourceManagementConfigErrv2 = errEmptyBluemixCredentials
-		session.resourceControllerConfigErr = errEmptyBluemixCredentials
-		session.resourceControllerConfigErrv2 = errEmptyBluemixCredentials
-		session.enterpriseManagementClientErr = errEmptyBluemixCredentials
-		session.resourceControllerErr = errEmptyBluemixCredentials
-		session.catalogManagementClientErr = errEmptyBluemixCredentials
-		session.ibmpiConfigErr = errEmptyBluemixCredentials
-		session.userManagementErr = errEmptyBluemixCredentials
-		session.certManagementErr = errEmptyBluemixCredentials
-		session.vpcErr = errEmptyBluemixCredentials
-		session.apigatewayErr = errEmptyBluemixCredentials
-		session.pDNSErr = errEmptyBluemixCredentials
-		session.bmxUserFetchErr = errEmptyBluemixCredentials
-		session.directlinkErr = errEmptyBluemixCredentials
-		session.dlProviderErr = errEmptyBluemixCredentials
-		session.cosConfigErr = errEmptyBluemixCredentials
-		session.transitgatewayErr = errEmptyBluemixCredentials
-		session.functionIAMNamespaceErr = errEmptyBluemixCredentials
-		session.cisDNSErr = errEmptyBluemixCredentials
-		session.cisAlertsErr = errEmptyBluemixCredentials
-		session.cisDNSBulkErr = errEmptyBluemixCredentials
-		session.cisGLBPoolErr = errEmptyBluemixCredentials
-		session.cisGLBErr = errEmptyBluemixCredentials
-		session.cisGLBHealthCheckErr = errEmptyBluemixCredentials
-		session.cisIPErr = errEmptyBluemixCredentials
-		session.cisZonesErr = errEmptyBluemixCredentials
-		session.cisRLErr = errEmptyBluemixCredentials
-		session.cisPageRuleErr = errEmptyBluemixCredentials
-		session.cisEdgeFunctionErr = errEmptyBluemixCredentials
-		session.cisSSLErr = errEmptyBluemixCredentials
-		session.cisWAFPackageErr = errEmptyBluemixCredentials
-		session.cisDomainSettingsErr = errEmptyBluemixCredentials
-		session.cisRoutingErr = errEmptyBluemixCredentials
-		session.cisWAFGroupErr = errEmptyBluemixCredentials
-		session.cisCacheErr = errEmptyBluemixCredentials
-		session.cisCustomPageErr = errEmptyBluemixCredentials
-		session.cisMtlsErr = errEmptyBluemixCredentials
-		session.cisAccessRuleErr = errEmptyBluemixCredentials
-		session.cisUARuleErr = errEmptyBluemixCredentials
-		session.cisLockdownErr = errEmptyBluemixCredentials
-		session.cisRangeAppErr = errEmptyBluemixCredentials
-		session.cisWAFRuleErr = errEmptyBluemixCredentials
-		session.iamIdentityErr = errEmptyBluemixCredentials
-		session.secretsManagerClientErr = errEmptyBluemixCredentials
-		session.cisFiltersErr = errEmptyBluemixCredentials
-		session.cisWebhooksErr = errEmptyBluemixCredentials
-		session.cisLogpushJobsErr = errEmptyBluemixCredentials
-		session.schematicsClientErr = errEmptyBluemixCredentials
-		session.satelliteClientErr = errEmptyBluemixCredentials
-		session.iamPolicyManagementErr = errEmptyBluemixCredentials
-		session.satelliteLinkClientErr = errEmptyBluemixCredentials
-		session.esSchemaRegistryErr = errEmptyBluemixCredentials
-		session.contextBasedRestrictionsClientErr = errEmptyBluemixCredentials
-		session.postureManagementClientErr = errEmptyBluemixCredentials
-		session.postureManagementClientErrv2 = errEmptyBluemixCredentials
-		session.configServiceApiClientErr = errEmptyBluemixCredentials
-		session.cdTektonPipelineClientErr = errEmptyBluemixCredentials
-		session.cdToolchainClientErr = errEmptyBluemixCredentials
-
 		return session, nil
 	}
 
-	if sess.BluemixSession.Config.BluemixAPIKey != "" {
-		err = authenticateAPIKey(sess.BluemixSession)
-		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying IAM Authentication %d", count)
-				err = authenticateAPIKey(sess.BluemixSession)
-			}
-			if err != nil {
+	BluemixRegion = sess.BluemixSession.Config.Region
+
+	if os.Getenv("TF_LOG") != "" {
+		logDestination := log.Writer()
+		goLogger := log.New(logDestination, "", log.LstdFlags)
+		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
+	}
+	return session, nil
+}
+
+// iamLogin authenticates the Bluemix session against IAM, fetches the account
+// user details and builds the authenticator shared by the IBM Cloud SDK clients.
+// It runs once, when the first client that needs it is configured; the returned
+// error is reported by every client configured afterwards.
+func (session *clientSession) iamLogin() error {
+	session.iamOnce.Do(func() {
+		c, sess := session.config, session.session
+		if sess.BluemixSession == nil {
+			session.iamErr = errEmptyBluemixCredentials
+			session.bmxUserFetchErr = errEmptyBluemixCredentials
+			return
+		}
+
+		if sess.BluemixSession.Config.BluemixAPIKey != "" {
+			// The token requests are retried by the HTTP client of the session.
+			if err := authenticateAPIKey(sess.BluemixSession); err != nil {
 				session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", err)
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
 			}
 		}
-		err = authenticateCF(sess.BluemixSession)
-		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying CF Authentication %d", count)
-				err = authenticateCF(sess.BluemixSession)
-			}
-			if err != nil {
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
+
+		if c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
+			if err := RefreshToken(sess.BluemixSession); err != nil {
+				session.iamErr = fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
+				session.bmxUserFetchErr = session.iamErr
+				return
 			}
 		}
-	}
 
-	if c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
-		err := RefreshToken(sess.BluemixSession)
+		userConfig, err := fetchUserDetails(sess.BluemixSession)
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
+			session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching account user details: %q", err)
+		}
+		session.bmxUserDetails = userConfig
+
+		if sess.SoftLayerSession != nil && sess.SoftLayerSession.IAMToken != "" {
+			sess.SoftLayerSession.IAMToken = sess.BluemixSession.Config.IAMAccessToken
+			sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
+		}
+
+		iamURL := session.iamEndpoint()
+		if c.trustedProfile != nil {
+			session.authenticator = c.trustedProfile
+		} else if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
+			if c.BluemixAPIKey != "" {
+				session.authenticator = &core.IamAuthenticator{
+					ApiKey: c.BluemixAPIKey,
+					URL:    iamURL,
+					Client: c.httpClient(),
+				}
+			} else {
+				// Construct the IamAuthenticator with the IAM refresh token.
+				session.authenticator = &core.IamAuthenticator{
+					RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
+					ClientId:     "bx",
+					ClientSecret: "bx",
+					URL:          iamURL,
+					Client:       c.httpClient(),
 				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying refresh token %d", count)
-				err = RefreshToken(sess.BluemixSession)
 			}
-			if err != nil {
-				return nil, fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
+		} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
+			session.authenticator = &core.BearerTokenAuthenticator{
+				BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
+			}
+		} else {
+			session.authenticator = &core.BearerTokenAuthenticator{
+				BearerToken: sess.BluemixSession.Config.IAMAccessToken,
 			}
 		}
 
-	}
-	userConfig, err := fetchUserDetails(sess.BluemixSession, c.RetryCount, c.RetryDelay)
-	if err != nil {
-		session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching account user details: %q", err)
-	}
-	session.bmxUserDetails = userConfig
-
-	if sess.SoftLayerSession != nil && sess.SoftLayerSession.IAMToken != "" {
-		sess.SoftLayerSession.IAMToken = sess.BluemixSession.Config.IAMAccessToken
-		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
-	}
-
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
-
-	BluemixRegion = sess.BluemixSession.Config.Region
-	var fileMap map[string]interface{}
-	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
-		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
+		// The bluemix-go and SoftLayer clients switch to the token of the
+		// authenticator, which is refreshed before it expires.
+		if authenticator, ok := session.authenticator.(tokenAuthenticator); ok && c.tokens != nil {
+			c.tokens.setAuthenticator(authenticator, c.IAMToken, sess.BluemixSession.Config.IAMAccessToken)
 		}
-		defer jsonFile.Close()
-		bytes, err := ioutil.ReadAll(jsonFile)
-		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
+	})
+	return session.iamErr
+}
+
+// cfLogin authenticates the Bluemix session against UAA. Only Cloud Functions
+// needs the UAA tokens, for Cloud Foundry based namespaces.
+func (session *clientSession) cfLogin() {
+	session.cfOnce.Do(func() {
+		sess := session.session
+		if sess.BluemixSession == nil || sess.BluemixSession.Config.BluemixAPIKey == "" {
+			return
 		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
-		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
+		if err := authenticateCF(sess.BluemixSession); err != nil {
+			log.Printf("[WARN] Error occured while fetching auth key for function: %q", err)
 		}
+	})
+}
+
+// iamEndpoint returns the IAM endpoint for the configured visibility and region.
+func (session *clientSession) iamEndpoint() string {
+	c := session.config
+	iamURL := c.DefaultEndpoint("IBMCLOUD_IAM_API_ENDPOINT")
+	return c.endpoint("IBMCLOUD_IAM_API_ENDPOINT", iamURL)
+}
+
+// cisEndpoint returns the endpoint shared by all the CIS service clients.
+func (session *clientSession) cisEndpoint() string {
+	c := session.config
+	cisURL := c.DefaultEndpoint("IBMCLOUD_CIS_API_ENDPOINT")
+	return c.endpoint("IBMCLOUD_CIS_API_ENDPOINT", cisURL)
+}
+
+func (session *clientSession) configureFunctionClient() {
+	if session.functionConfigErr = session.iamLogin(); session.functionConfigErr != nil {
+		return
+	}
+	session.cfLogin()
+	session.functionClient, session.functionConfigErr = FunctionClient(session.session.BluemixSession.Config)
+}
+
+func (session *clientSession) configureBluemixAcccountv1API() {
+	if session.accountV1ConfigErr = session.iamLogin(); session.accountV1ConfigErr != nil {
+		return
 	}
+	sess := session.session
+
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
 	}
 	session.bmxAccountv1ServiceAPI = accv1API
+}
+
+func (session *clientSession) configureBluemixAcccountAPI() {
+	if session.accountConfigErr = session.iamLogin(); session.accountConfigErr != nil {
+		return
+	}
+	sess := session.session
 
 	accAPI, err := accountv2.New(sess.BluemixSession)
 	if err != nil {
 		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
 	}
 	session.bmxAccountServiceAPI = accAPI
+}
+
+func (session *clientSession) configureMccpAPI() {
+	if session.cfConfigErr = session.iamLogin(); session.cfConfigErr != nil {
+		return
+	}
+	sess := session.session
 
 	cfAPI, err := mccpv2.New(sess.BluemixSession)
 	if err != nil {
 		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
 	}
 	session.cfServiceAPI = cfAPI
+}
+
+func (session *clientSession) configureContainerAPI() {
+	if session.csConfigErr = session.iamLogin(); session.csConfigErr != nil {
+		return
+	}
+	sess := session.session
 
 	clusterAPI, err := containerv1.New(sess.BluemixSession)
 	if err != nil {
 		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
 	}
 	session.csServiceAPI = clusterAPI
+}
+
+func (session *clientSession) configureVpcContainerAPI() {
+	if session.csv2ConfigErr = session.iamLogin(); session.csv2ConfigErr != nil {
+		return
+	}
+	sess := session.session
 
 	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
 	}
 	session.csv2ServiceAPI = v2clusterAPI
+}
+
+func (session *clientSession) configureHpcsEndpointAPI() {
+	if session.hpcsEndpointErr = session.iamLogin(); session.hpcsEndpointErr != nil {
+		return
+	}
+	sess := session.session
 
 	hpcsAPI, err := hpcs.New(sess.BluemixSession)
 	if err != nil {
 		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
 	}
 	session.hpcsEndpointAPI = hpcsAPI
+}
 
-	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
+func (session *clientSession) configureKeyProtectAPI() {
+	if session.kpErr = session.iamLogin(); session.kpErr != nil {
+		return
 	}
+	c := session.config
+	sess := session.session
+
+	kpurl := c.DefaultEndpoint("IBMCLOUD_KP_API_ENDPOINT")
 	var options kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		options = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+			BaseURL: c.endpoint("IBMCLOUD_KP_API_ENDPOINT", kpurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
@@ -1387,100 +1697,71 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	} else {
 		options = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+			BaseURL:       c.endpoint("IBMCLOUD_KP_API_ENDPOINT", kpurl),
 			Authorization: sess.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
+	kpAPIclient, err := kp.New(options, c.transport())
 	if err != nil {
 		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 	}
 	session.kpAPI = kpAPIclient
+}
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+func (session *clientSession) configureKeyManagementAPI() {
+	if session.kmsErr = session.iamLogin(); session.kmsErr != nil {
+		return
 	}
+	c := session.config
+	sess := session.session
+	iamURL := session.iamEndpoint()
 
 	// KEY MANAGEMENT Service
-	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
-	}
+	kmsurl := c.DefaultEndpoint("IBMCLOUD_KP_API_ENDPOINT")
 	var kmsOptions kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		kmsOptions = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+			BaseURL: c.endpoint("IBMCLOUD_KP_API_ENDPOINT", kmsurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			TokenURL: iamURL + "/identity/token",
 		}
 
 	} else {
 		kmsOptions = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+			BaseURL:       c.endpoint("IBMCLOUD_KP_API_ENDPOINT", kmsurl),
 			Authorization: sess.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			TokenURL: iamURL + "/identity/token",
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	kmsAPIclient, err := kp.New(kmsOptions, c.transport())
 	if err != nil {
 		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 	}
 	session.kmsAPI = kmsAPIclient
+}
 
-	var authenticator core.Authenticator
-
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
-		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		}
-	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
+func (session *clientSession) configureUkoV4() {
+	if session.ukoClientErr = session.iamLogin(); session.ukoClientErr != nil {
+		return
 	}
+	var err error
 
 	// Construct an "options" struct for creating the service client.
 	ukoClientOptions := &ukov4.UkoV4Options{
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 
 	// Construct the service client.
 	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.ukoClient.Service)
 		// Add custom header for analytics
 		session.ukoClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1488,49 +1769,58 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
 	}
+}
+
+func (session *clientSession) configureAppIDAPI() {
+	if session.appidErr = session.iamLogin(); session.appidErr != nil {
+		return
+	}
+	c := session.config
 
 	// APPID Service
 	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
 	if c.Visibility == "private" {
 		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
-	}
 	appIDClientOptions := &appid.AppIDManagementV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", appIDEndpoint),
 	}
 	appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
 	if err != nil {
 		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(appIDClient.Service)
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.appidAPI = appIDClient
+}
+
+func (session *clientSession) configureContextBasedRestrictionsV1() {
+	if session.contextBasedRestrictionsClientErr = session.iamLogin(); session.contextBasedRestrictionsClientErr != nil {
+		return
+	}
+	c := session.config
+	var err error
 
 	// Construct an "options" struct for creating Context Based Restrictions service client.
 	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("Context Based Restrictions Service API does not support private endpoints") //return this error if private endpoints are not supported
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
-	}
 	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", cbrURL),
 	}
 
 	// Construct the service client.
 	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
 	if err == nil && session.contextBasedRestrictionsClient != nil {
 		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.contextBasedRestrictionsClient.Service)
 		// Add custom header for analytics
 		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1538,18 +1828,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
 	}
+}
+
+func (session *clientSession) configureCatalogManagementV1() {
+	if session.catalogManagementClientErr = session.iamLogin(); session.catalogManagementClientErr != nil {
+		return
+	}
+	c := session.config
+	var err error
 
 	// CATALOG MANAGEMENT Service
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
-	}
 	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
-		Authenticator: authenticator,
+		URL:           c.endpoint("IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", catalogManagementURL),
+		Authenticator: session.authenticator,
 	}
 	// Construct the service client.
 	session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
@@ -1558,12 +1853,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.catalogManagementClient.Service)
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureAtrackerV1() {
+	if session.atrackerClientErr = session.iamLogin(); session.atrackerClientErr != nil {
+		return
+	}
+	c := session.config
+	var err error
 
 	// ATRACKER Service
 	var atrackerClientURL string
@@ -1580,12 +1883,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 			}
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientURL)
-	}
 	atrackerClientOptions := &atrackerv1.AtrackerV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_ATRACKER_API_ENDPOINT", atrackerClientURL),
 	}
 	// Construct the service client.
 	session.atrackerClient, err = atrackerv1.NewAtrackerV1(atrackerClientOptions)
@@ -1594,36 +1894,31 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.atrackerClient != nil && session.atrackerClient.Service != nil {
 		// Enable retries for API calls
-		session.atrackerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.atrackerClient.Service)
 		// Add custom header for analytics
 		session.atrackerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// Version 2 Atracker
-	var atrackerClientV2URL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		atrackerClientV2URL, err = atrackerv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			atrackerClientV2URL, err = atrackerv2.GetServiceURLForRegion(c.Region)
-		}
-	} else {
-		atrackerClientV2URL, err = atrackerv2.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		atrackerClientV2URL = atrackerv2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
+}
+
+func (session *clientSession) configureAtrackerV2() {
+	if session.atrackerClientV2Err = session.iamLogin(); session.atrackerClientV2Err != nil {
+		return
 	}
+	c := session.config
+	var err error
+
+	// Version 2 Atracker
+	atrackerClientV2URL := c.DefaultEndpoint("IBMCLOUD_ATRACKER_API_ENDPOINT")
 	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_ATRACKER_API_ENDPOINT", atrackerClientV2URL),
 	}
 	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
 	if err == nil {
 		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.atrackerClientV2.Service)
 		// Add custom header for analytics
 		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1631,6 +1926,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
 	}
+}
+
+func (session *clientSession) configureFindingsV1() {
+	if session.findingsClientErr = session.iamLogin(); session.findingsClientErr != nil {
+		return
+	}
+	c := session.config
+	var err error
 
 	// SCC FINDINGS Service
 	var findingsClientURL string
@@ -1642,13 +1945,10 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.findingsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Security Insights Findings API service: `%v` visibility not supported", c.Visibility)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		findingsClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCC_FINDINGS_API_ENDPOINT", c.Region, findingsClientURL)
-	}
 	findingsClientOptions := &findingsv1.FindingsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_FINDINGS_API_ENDPOINT"}, findingsClientURL),
-		AccountID:     core.StringPtr(userConfig.UserAccount),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_SCC_FINDINGS_API_ENDPOINT", findingsClientURL),
+		AccountID:     core.StringPtr(session.bmxUserDetails.UserAccount),
 	}
 	// Construct the service client.
 	session.findingsClient, err = findingsv1.NewFindingsV1(findingsClientOptions)
@@ -1657,36 +1957,33 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.findingsClient != nil && session.findingsClient.Service != nil {
 		// Enable retries for API calls
-		session.findingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.findingsClient.Service)
 		// Add custom header for analytics
 		session.findingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// SCC ADMIN Service
-	var adminServiceApiClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion(c.Region)
-		}
-	} else {
-		adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		adminServiceApiClientURL = adminserviceapiv1.DefaultServiceURL
+func (session *clientSession) configureAdminServiceApiV1() {
+	if session.adminServiceApiClientErr = session.iamLogin(); session.adminServiceApiClientErr != nil {
+		return
 	}
+	c := session.config
+	var err error
+
+	// SCC ADMIN Service
+	adminServiceApiClientURL := c.DefaultEndpoint("IBMCLOUD_SCC_ADMIN_API_ENDPOINT")
 	adminServiceApiClientOptions := &adminserviceapiv1.AdminServiceApiV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_ADMIN_API_ENDPOINT"}, adminServiceApiClientURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_SCC_ADMIN_API_ENDPOINT", adminServiceApiClientURL),
 	}
 
 	// Construct the service client.
 	session.adminServiceApiClient, err = adminserviceapiv1.NewAdminServiceApiV1(adminServiceApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.adminServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.adminServiceApiClient.Service)
 		// Add custom header for analytics
 		session.adminServiceApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1694,24 +1991,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.adminServiceApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Admin Service API service: %q", err)
 	}
+}
 
-	// SCHEMATICS Service
-	schematicsEndpoint := "https://schematics.cloud.ibm.com"
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			schematicsEndpoint = ContructEndpoint("private-us.schematics", cloudEndpoint)
-		} else if c.Region == "eu-gb" || c.Region == "eu-de" {
-			schematicsEndpoint = ContructEndpoint("private-eu.schematics", cloudEndpoint)
-		} else {
-			schematicsEndpoint = "https://schematics.cloud.ibm.com"
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
+func (session *clientSession) configureSchematicsV1() {
+	if session.schematicsClientErr = session.iamLogin(); session.schematicsClientErr != nil {
+		return
 	}
+	c := session.config
+
+	// SCHEMATICS Service
+	schematicsEndpoint := c.DefaultEndpoint("IBMCLOUD_SCHEMATICS_API_ENDPOINT")
 	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_SCHEMATICS_API_ENDPOINT", schematicsEndpoint),
 	}
 	// Construct the service client.
 	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
@@ -1720,48 +2012,53 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(schematicsClient.Service)
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.schematicsClient = schematicsClient
+}
 
-	// VPC Service
-	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
+func (session *clientSession) configureVpcV1API() {
+	if session.vpcErr = session.iamLogin(); session.vpcErr != nil {
+		return
 	}
+	c := session.config
+
+	// VPC Service
+	vpcurl := c.DefaultEndpoint("IBMCLOUD_IS_NG_API_ENDPOINT")
 	vpcoptions := &vpc.VpcV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
+		URL:           c.endpoint("IBMCLOUD_IS_NG_API_ENDPOINT", vpcurl),
+		Authenticator: session.authenticator,
 	}
 	vpcclient, err := vpc.NewVpcV1(vpcoptions)
 	if err != nil {
 		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(vpcclient.Service)
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcAPI = vpcclient
+}
+
+func (session *clientSession) configurePushServiceV1() {
+	if session.pushServiceClientErr = session.iamLogin(); session.pushServiceClientErr != nil {
+		return
+	}
+	c := session.config
 
 	// PUSH NOTIFICATIONS Service
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
-	}
 	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
-		Authenticator: authenticator,
+		URL:           c.endpoint("IBMCLOUD_PUSH_API_ENDPOINT", pnurl),
+		Authenticator: session.authenticator,
 	}
 	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
 	if err != nil {
@@ -1769,23 +2066,29 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if pnclient != nil && pnclient.Service != nil {
 		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(pnclient.Service)
 		pnclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.pushServiceClient = pnclient
+}
+
+func (session *clientSession) configureEventNotificationsApiV1() {
+	if session.eventNotificationsApiClientErr = session.iamLogin(); session.eventNotificationsApiClientErr != nil {
+		return
+	}
+	c := session.config
+	var err error
+
 	// event notifications
 	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 	if c.Visibility == "private" {
 		session.eventNotificationsApiClientErr = fmt.Errorf("Event Notifications Service does not support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
-	}
 	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", enurl),
 	}
 	// Construct the service client.
 	session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
@@ -1795,47 +2098,50 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
 		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.eventNotificationsApiClient.Service)
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureAppConfigurationV1() {
+	if session.appConfigurationClientErr = session.iamLogin(); session.appConfigurationClientErr != nil {
+		return
+	}
+	c := session.config
 
 	// APP CONFIGURATION Service
 	if c.Visibility == "private" {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] App Configuration Service API doesnot support private endpoints")
 	}
 	appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
 		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(appConfigClient.Service)
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
 	}
+}
+
+func (session *clientSession) configureContainerRegistryV1() {
+	if session.containerRegistryClientErr = session.iamLogin(); session.containerRegistryClientErr != nil {
+		return
+	}
+	c := session.config
+	var err error
 
 	// CONTAINER REGISTRY Service
 	// Construct an "options" struct for creating the service client.
-	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
-	if err != nil {
-		containerRegistryClientURL = containerregistryv1.DefaultServiceURL
-	}
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		containerRegistryClientURL, err = GetPrivateServiceURLForRegion(c.Region)
-		if err != nil {
-			containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
-	}
+	containerRegistryClientURL := c.DefaultEndpoint("IBMCLOUD_CR_API_ENDPOINT")
 	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
-		Account:       core.StringPtr(userConfig.UserAccount),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_CR_API_ENDPOINT", containerRegistryClientURL),
+		Account:       core.StringPtr(session.bmxUserDetails.UserAccount),
 	}
 	// Construct the service client.
 	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
@@ -1844,57 +2150,74 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
 		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.containerRegistryClient.Service)
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// OBJECT STORAGE Service
-	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
+func (session *clientSession) configureCosConfigV1API() {
+	if session.cosConfigErr = session.iamLogin(); session.cosConfigErr != nil {
+		return
 	}
+	c := session.config
+
+	// OBJECT STORAGE Service
+	cosconfigurl := c.DefaultEndpoint("IBMCLOUD_COS_CONFIG_ENDPOINT")
 	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_COS_CONFIG_ENDPOINT", cosconfigurl),
 	}
 	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
 	if err != nil {
 		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
 	}
+	if cosconfigclient != nil {
+		cosconfigclient.Service.Client.Transport = c.wrapTransport(cosconfigclient.Service.Client.Transport, c.retryPolicy(), 0)
+	}
 	session.cosConfigAPI = cosconfigclient
+}
+
+func (session *clientSession) configureGlobalSearchAPI() {
+	if session.globalSearchConfigErr = session.iamLogin(); session.globalSearchConfigErr != nil {
+		return
+	}
+	sess := session.session
 
 	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
 	if err != nil {
 		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	session.globalSearchServiceAPI = globalSearchAPI
+}
+
+func (session *clientSession) configureGlobalTaggingAPI() {
+	if session.globalTaggingConfigErr = session.iamLogin(); session.globalTaggingConfigErr != nil {
+		return
+	}
+	sess := session.session
+
 	// Global Tagging Bluemix-go
 	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
 	if err != nil {
 		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
 	}
 	session.globalTaggingServiceAPI = globalTaggingAPI
+}
 
-	// GLOBAL TAGGING Service
-	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		var globalTaggingRegion string
-		if c.Region != "us-south" && c.Region != "us-east" {
-			globalTaggingRegion = "us-south"
-		} else {
-			globalTaggingRegion = c.Region
-		}
-		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
+func (session *clientSession) configureGlobalTaggingAPIv1() {
+	if session.globalTaggingConfigErrV1 = session.iamLogin(); session.globalTaggingConfigErrV1 != nil {
+		return
 	}
+	c := session.config
+
+	// GLOBAL TAGGING Service
+	globalTaggingEndpoint := c.DefaultEndpoint("IBMCLOUD_GT_API_ENDPOINT")
 	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
-		Authenticator: authenticator,
+		URL:           c.endpoint("IBMCLOUD_GT_API_ENDPOINT", globalTaggingEndpoint),
+		Authenticator: session.authenticator,
 	}
 	globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
 	if err != nil {
@@ -1902,37 +2225,46 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.globalTaggingServiceAPIV1.Service)
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureICDAPI() {
+	if session.icdConfigErr = session.iamLogin(); session.icdConfigErr != nil {
+		return
+	}
+	sess := session.session
 
 	icdAPI, err := icdv4.New(sess.BluemixSession)
 	if err != nil {
 		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
 	}
 	session.icdServiceAPI = icdAPI
+}
 
-	var cloudDatabasesEndpoint string
-
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.private.databases.cloud.ibm.com/v5/ibm", c.Region)
-	} else {
-		cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.databases.cloud.ibm.com/v5/ibm", c.Region)
+func (session *clientSession) configureCloudDatabasesV5() {
+	if session.cloudDatabasesClientErr = session.iamLogin(); session.cloudDatabasesClientErr != nil {
+		return
 	}
+	c := session.config
+	var err error
+
+	cloudDatabasesEndpoint := c.DefaultEndpoint("IBMCLOUD_DATABASES_API_ENDPOINT")
 
 	// Construct an "options" struct for creating the service client.
 	cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
-		Authenticator: authenticator,
+		URL:           c.endpoint("IBMCLOUD_DATABASES_API_ENDPOINT", cloudDatabasesEndpoint),
+		Authenticator: session.authenticator,
 	}
 
 	// Construct the service client.
 	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cloudDatabasesClient.Service)
 		// Add custom header for analytics
 		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1940,118 +2272,187 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
 	}
+}
+
+func (session *clientSession) configureResourceCatalogAPI() {
+	if session.resourceCatalogConfigErr = session.iamLogin(); session.resourceCatalogConfigErr != nil {
+		return
+	}
+	sess := session.session
 
 	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
 	}
 	session.resourceCatalogServiceAPI = resourceCatalogAPI
+}
+
+func (session *clientSession) configureResourceManagementAPIv2() {
+	if session.resourceManagementConfigErrv2 = session.iamLogin(); session.resourceManagementConfigErrv2 != nil {
+		return
+	}
+	sess := session.session
 
 	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
 	}
 	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+}
+
+func (session *clientSession) configureResourceControllerAPI() {
+	if session.resourceControllerConfigErr = session.iamLogin(); session.resourceControllerConfigErr != nil {
+		return
+	}
+	sess := session.session
 
 	resourceControllerAPI, err := controller.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	session.resourceControllerServiceAPI = resourceControllerAPI
+}
+
+func (session *clientSession) configureResourceControllerAPIV2() {
+	if session.resourceControllerConfigErrv2 = session.iamLogin(); session.resourceControllerConfigErrv2 != nil {
+		return
+	}
+	sess := session.session
 
 	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
 	}
 	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+}
+
+func (session *clientSession) configureUserManagementAPI() {
+	if session.userManagementErr = session.iamLogin(); session.userManagementErr != nil {
+		return
+	}
+	sess := session.session
 
 	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
 	}
 	session.userManagementAPI = userManagementAPI
+}
+
+func (session *clientSession) configureCertificateManagerAPI() {
+	if session.certManagementErr = session.iamLogin(); session.certManagementErr != nil {
+		return
+	}
+	sess := session.session
 
 	certManagementAPI, err := certificatemanager.New(sess.BluemixSession)
 	if err != nil {
 		session.certManagementErr = fmt.Errorf("[ERROR] Error occured while configuring Certificate manager service: %q", err)
 	}
 	session.certManagementAPI = certManagementAPI
+}
 
-	namespaceFunction, err := functions.New(sess.BluemixSession)
+func (session *clientSession) configureFunctionIAMNamespaceAPI() {
+	if session.functionIAMNamespaceErr = session.iamLogin(); session.functionIAMNamespaceErr != nil {
+		return
+	}
+	// CF based namespaces authenticate with the UAA tokens
+	session.cfLogin()
+	sess := session.session
+
+	namespaceFunction, err := functions.New(sess.BluemixSession)
 	if err != nil {
 		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
 	}
 	session.functionIAMNamespaceAPI = namespaceFunction
+}
 
-	//  API GATEWAY service
-	apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
+func (session *clientSession) configureAPIGateway() {
+	if session.apigatewayErr = session.iamLogin(); session.apigatewayErr != nil {
+		return
 	}
+	c := session.config
+
+	//  API GATEWAY service
+	apicurl := c.DefaultEndpoint("IBMCLOUD_API_GATEWAY_ENDPOINT")
 	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
+		URL:           c.endpoint("IBMCLOUD_API_GATEWAY_ENDPOINT", apicurl),
 		Authenticator: &core.NoAuthAuthenticator{},
 	}
 	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
 	if err != nil {
 		session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
 	}
+	if apigatewayAPI != nil {
+		apigatewayAPI.Service.Client.Transport = c.wrapTransport(apigatewayAPI.Service.Client.Transport, c.retryPolicy(), 0)
+	}
 	session.apigatewayAPI = apigatewayAPI
+}
+
+func (session *clientSession) configureIBMPISession() {
+	if session.ibmpiConfigErr = session.iamLogin(); session.ibmpiConfigErr != nil {
+		return
+	}
+	c := session.config
 
 	// POWER SYSTEMS Service
-	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
+	piURL := c.DefaultEndpoint("IBMCLOUD_PI_API_ENDPOINT")
 	ibmPIOptions := &ibmpisession.IBMPIOptions{
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 		Debug:         os.Getenv("TF_LOG") != "",
 		Region:        c.Region,
-		URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
-		UserAccount:   userConfig.UserAccount,
+		URL:           c.endpoint("IBMCLOUD_PI_API_ENDPOINT", piURL),
+		UserAccount:   session.bmxUserDetails.UserAccount,
 		Zone:          c.Zone,
 	}
 	ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
 	if err != nil {
 		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
 	}
+	if ibmpisession != nil {
+		if runtime, ok := ibmpisession.Power.Transport.(*httptransport.Runtime); ok {
+			runtime.Transport = c.wrapTransport(runtime.Transport, c.retryPolicy(), 0)
+		}
+	}
 	session.ibmpiSession = ibmpisession
+}
 
-	// PRIVATE DNS Service
-	pdnsURL := dns.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
+func (session *clientSession) configurePrivateDNSClientSession() {
+	if session.pDNSErr = session.iamLogin(); session.pDNSErr != nil {
+		return
 	}
+	c := session.config
+
+	// PRIVATE DNS Service
+	pdnsURL := c.DefaultEndpoint("IBMCLOUD_PRIVATE_DNS_API_ENDPOINT")
 	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
-		Authenticator: authenticator,
+		URL:           c.endpoint("IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", pdnsURL),
+		Authenticator: session.authenticator,
 	}
 	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
 	if session.pDNSErr != nil {
 		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.pDNSClient.Service)
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureDirectlinkV1API() {
+	if session.directlinkErr = session.iamLogin(); session.directlinkErr != nil {
+		return
+	}
+	c := session.config
 
 	// DIRECT LINK Service
 	ver := time.Now().Format("2006-01-02")
-	dlURL := dl.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
-	}
+	dlURL := c.DefaultEndpoint("IBMCLOUD_DL_API_ENDPOINT")
 	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
-		Authenticator: authenticator,
+		URL:           c.endpoint("IBMCLOUD_DL_API_ENDPOINT", dlURL),
+		Authenticator: session.authenticator,
 		Version:       &ver,
 	}
 	session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
@@ -2059,23 +2460,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.directlinkAPI.Service)
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// DIRECT LINK PROVIDER Service
-	dlproviderURL := dlProviderV2.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
+func (session *clientSession) configureDirectlinkProviderV2API() {
+	if session.dlProviderErr = session.iamLogin(); session.dlProviderErr != nil {
+		return
 	}
+	c := session.config
+	ver := time.Now().Format("2006-01-02")
+
+	// DIRECT LINK PROVIDER Service
+	dlproviderURL := c.DefaultEndpoint("IBMCLOUD_DL_PROVIDER_API_ENDPOINT")
 	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
-		Authenticator: authenticator,
+		URL:           c.endpoint("IBMCLOUD_DL_PROVIDER_API_ENDPOINT", dlproviderURL),
+		Authenticator: session.authenticator,
 		Version:       &ver,
 	}
 	session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
@@ -2083,23 +2486,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.dlProviderAPI.Service)
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// TRANSIT GATEWAY Service
-	tgURL := tg.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
+func (session *clientSession) configureTransitGatewayV1API() {
+	if session.transitgatewayErr = session.iamLogin(); session.transitgatewayErr != nil {
+		return
 	}
+	c := session.config
+
+	// TRANSIT GATEWAY Service
+	tgURL := c.DefaultEndpoint("IBMCLOUD_TG_API_ENDPOINT")
 	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
-		Authenticator: authenticator,
+		URL:           c.endpoint("IBMCLOUD_TG_API_ENDPOINT", tgURL),
+		Authenticator: session.authenticator,
 		Version:       CreateVersionDate(),
 	}
 	session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
@@ -2107,52 +2511,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.transitgatewayAPI.Service)
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		// })
 	}
+}
 
-	// CIS Service instances starts here.
-	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+func (session *clientSession) configureCisZonesV1ClientSession() {
+	if session.cisZonesErr = session.iamLogin(); session.cisZonesErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Zones service
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
 	if session.cisZonesErr != nil {
@@ -2161,36 +2537,50 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisZonesV1Client.Service)
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisDNSRecordClientSession() {
+	if session.cisDNSErr = session.iamLogin(); session.cisDNSErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS DNS Record service
 	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
 	if session.cisDNSErr != nil {
 		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisDNSRecordsClient.Service)
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisDNSRecordBulkClientSession() {
+	if session.cisDNSBulkErr = session.iamLogin(); session.cisDNSBulkErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS DNS Record bulk service
 	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
 	if session.cisDNSBulkErr != nil {
@@ -2199,17 +2589,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisDNSRecordBulkClient.Service)
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisGLBPoolClientSession() {
+	if session.cisGLBPoolErr = session.iamLogin(); session.cisGLBPoolErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Global load balancer pool
 	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisGLBPoolClient, session.cisGLBPoolErr =
 		cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
@@ -2219,16 +2616,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisGLBPoolClient.Service)
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisGLBClientSession() {
+	if session.cisGLBErr = session.iamLogin(); session.cisGLBErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Global load balancer
 	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
 		URL:            cisEndPoint,
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
 	}
@@ -2239,17 +2643,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisGLBClient.Service)
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisGLBHealthCheckClientSession() {
+	if session.cisGLBHealthCheckErr = session.iamLogin(); session.cisGLBHealthCheckErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Global load balancer health check/monitor
 	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr =
 		cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
@@ -2259,16 +2670,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisGLBHealthCheckClient.Service)
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisIPClientSession() {
+	if session.cisIPErr = session.iamLogin(); session.cisIPErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS IP
 	cisIPOpt := &cisipv1.CisIpApiV1Options{
 		URL:           cisEndPoint,
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
 	if session.cisIPErr != nil {
@@ -2276,18 +2694,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisIPClient.Service)
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisRLClientSession() {
+	if session.cisRLErr = session.iamLogin(); session.cisRLErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Zone Rate Limit
 	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
 	if session.cisRLErr != nil {
@@ -2296,16 +2721,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisRLClient.Service)
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisAlertsSession() {
+	if session.cisAlertsErr = session.iamLogin(); session.cisAlertsErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
+
 	// IBM Network CIS Alerts
 	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisAlertsClient, session.cisAlertsErr = cisalertsv1.NewAlertsV1(cisAlertsOpt)
 	if session.cisAlertsErr != nil {
@@ -2314,18 +2747,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAlertsErr)
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisAlertsClient.Service)
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisPageRuleClientSession() {
+	if session.cisPageRuleErr = session.iamLogin(); session.cisPageRuleErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Page Rules
 	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
 	if session.cisPageRuleErr != nil {
@@ -2334,18 +2774,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisPageRuleClient.Service)
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisEdgeFunctionClientSession() {
+	if session.cisEdgeFunctionErr = session.iamLogin(); session.cisEdgeFunctionErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Edge Function
 	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisEdgeFunctionClient, session.cisEdgeFunctionErr =
 		cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
@@ -2355,18 +2802,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisEdgeFunctionClient.Service)
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisSSLClientSession() {
+	if session.cisSSLErr = session.iamLogin(); session.cisSSLErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS SSL certificate
 	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 
 	session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
@@ -2376,18 +2830,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisSSLClient.Service)
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisWAFPackageClientSession() {
+	if session.cisWAFPackageErr = session.iamLogin(); session.cisWAFPackageErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS WAF Package
 	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisWAFPackageClient, session.cisWAFPackageErr =
 		ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
@@ -2397,18 +2858,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisWAFPackageClient.Service)
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisDomainSettingsClientSession() {
+	if session.cisDomainSettingsErr = session.iamLogin(); session.cisDomainSettingsErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Domain settings
 	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisDomainSettingsClient, session.cisDomainSettingsErr =
 		cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
@@ -2418,18 +2886,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisDomainSettingsClient.Service)
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisRoutingClientSession() {
+	if session.cisRoutingErr = session.iamLogin(); session.cisRoutingErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Routing
 	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisRoutingClient, session.cisRoutingErr =
 		cisroutingv1.NewRoutingV1(cisRoutingOpt)
@@ -2439,18 +2914,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisRoutingClient.Service)
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisWAFGroupClientSession() {
+	if session.cisWAFGroupErr = session.iamLogin(); session.cisWAFGroupErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS WAF Group
 	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisWAFGroupClient, session.cisWAFGroupErr =
 		ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
@@ -2460,18 +2942,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisWAFGroupClient.Service)
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisCacheClientSession() {
+	if session.cisCacheErr = session.iamLogin(); session.cisCacheErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Cache service
 	cisCacheOpt := &ciscachev1.CachingApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisCacheClient, session.cisCacheErr =
 		ciscachev1.NewCachingApiV1(cisCacheOpt)
@@ -2481,18 +2970,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisCacheClient.Service)
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisCustomPageClientSession() {
+	if session.cisCustomPageErr = session.iamLogin(); session.cisCustomPageErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Custom pages service
 	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 
 	session.cisCustomPageClient, session.cisCustomPageErr =
@@ -2503,18 +2999,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisCustomPageClient.Service)
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisAccessRuleClientSession() {
+	if session.cisAccessRuleErr = session.iamLogin(); session.cisAccessRuleErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Firewall Access rule
 	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisAccessRuleClient, session.cisAccessRuleErr =
 		cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
@@ -2524,18 +3027,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisAccessRuleClient.Service)
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisUARuleClientSession() {
+	if session.cisUARuleErr = session.iamLogin(); session.cisUARuleErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Firewall User Agent Blocking rule
 	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisUARuleClient, session.cisUARuleErr =
 		cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
@@ -2545,18 +3055,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisUARuleClient.Service)
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisLockdownClientSession() {
+	if session.cisLockdownErr = session.iamLogin(); session.cisLockdownErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Firewall Lockdown rule
 	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisLockdownClient, session.cisLockdownErr =
 		cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
@@ -2566,18 +3083,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisLockdownClient.Service)
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisRangeAppClientSession() {
+	if session.cisRangeAppErr = session.iamLogin(); session.cisRangeAppErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Range Application rule
 	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisRangeAppClient, session.cisRangeAppErr =
 		cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
@@ -2587,18 +3111,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisRangeAppClient.Service)
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisWAFRuleClientSession() {
+	if session.cisWAFRuleErr = session.iamLogin(); session.cisWAFRuleErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS WAF Rule Service
 	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisWAFRuleClient, session.cisWAFRuleErr =
 		ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
@@ -2608,11 +3139,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisWAFRuleClient.Service)
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisLogpushJobsSession() {
+	if session.cisLogpushJobsErr = session.iamLogin(); session.cisLogpushJobsErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS LogpushJobs
 	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
@@ -2620,7 +3158,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
 		Dataset:       core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisLogpushJobsClient, session.cisLogpushJobsErr = cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
 	if session.cisLogpushJobsErr != nil {
@@ -2629,17 +3167,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLogpushJobsErr)
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisLogpushJobsClient.Service)
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisMtlsSession() {
+	if session.cisMtlsErr = session.iamLogin(); session.cisMtlsErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM MTLS Session
 	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisMtlsClient, session.cisMtlsErr = cismtlsv1.NewMtlsV1(cisMtlsOpt)
 	if session.cisMtlsErr != nil {
@@ -2648,17 +3193,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisMtlsErr)
 	}
 	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisMtlsClient.Service)
 		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisWebhookSession() {
+	if session.cisWebhooksErr = session.iamLogin(); session.cisWebhooksErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Webhooks
 	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisWebhooksClient, session.cisWebhooksErr = ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
 	if session.cisWebhooksErr != nil {
@@ -2667,15 +3219,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWebhooksErr)
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisWebhooksClient.Service)
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisFiltersSession() {
+	if session.cisFiltersErr = session.iamLogin(); session.cisFiltersErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
+
 	// IBM Network CIS Filters
 	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
 		URL:           cisEndPoint,
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
 	if session.cisFiltersErr != nil {
@@ -2684,16 +3244,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisFiltersClient.Service)
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureCisFirewallRulesSession() {
+	if session.cisFirewallRulesErr = session.iamLogin(); session.cisFirewallRulesErr != nil {
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 
 	// IBM Network CIS Firewall rules
 	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
 		URL:           cisEndPoint,
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
 	if session.cisFirewallRulesErr != nil {
@@ -2702,229 +3269,198 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFirewallRulesErr)
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cisFirewallRulesClient.Service)
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureIAMIdentityV1API() {
+	if session.iamIdentityErr = session.iamLogin(); session.iamIdentityErr != nil {
+		return
+	}
+	c := session.config
 
 	// IAM IDENTITY Service
 	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
-	iamIdenityURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamIdenityURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
-	}
+	iamIdenityURL := c.DefaultEndpoint("IBMCLOUD_IAM_API_ENDPOINT")
 	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_IAM_API_ENDPOINT", iamIdenityURL),
 	}
 	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
 	if err != nil {
 		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(iamIdentityClient.Service)
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamIdentityAPI = iamIdentityClient
+}
 
-	// IAM POLICY MANAGEMENT Service
-	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamPolicyManagementURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
+func (session *clientSession) configureIAMPolicyManagementV1API() {
+	if session.iamPolicyManagementErr = session.iamLogin(); session.iamPolicyManagementErr != nil {
+		return
 	}
+	c := session.config
+
+	// IAM POLICY MANAGEMENT Service
+	iamPolicyManagementURL := c.DefaultEndpoint("IBMCLOUD_IAM_API_ENDPOINT")
 	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_IAM_API_ENDPOINT", iamPolicyManagementURL),
 	}
 	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
 	if err != nil {
 		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(iamPolicyManagementClient.Service)
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
+}
 
-	// IAM ACCESS GROUP
-	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamAccessGroupsURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
+func (session *clientSession) configureIAMAccessGroupsV2() {
+	if session.iamAccessGroupsErr = session.iamLogin(); session.iamAccessGroupsErr != nil {
+		return
 	}
+	c := session.config
+
+	// IAM ACCESS GROUP
+	iamAccessGroupsURL := c.DefaultEndpoint("IBMCLOUD_IAM_API_ENDPOINT")
 	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_IAM_API_ENDPOINT", iamAccessGroupsURL),
 	}
 	iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
 	if err != nil {
 		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(iamAccessGroupsClient.Service)
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamAccessGroupsAPI = iamAccessGroupsClient
+}
 
-	// RESOURCE MANAGEMENT Service
-	rmURL := resourcemanager.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			rmURL = ContructEndpoint("private.us-south.resource-controller", fmt.Sprintf("%s", cloudEndpoint))
-		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
-		} else {
-			rmURL = resourcemanager.DefaultServiceURL
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
+func (session *clientSession) configureResourceManagerV2API() {
+	if session.resourceManagerErr = session.iamLogin(); session.resourceManagerErr != nil {
+		return
 	}
+	c := session.config
+
+	// RESOURCE MANAGEMENT Service
+	rmURL := c.DefaultEndpoint("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT")
 	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", rmURL),
 	}
 	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
 	if err != nil {
 		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(resourceManagerClient.Service)
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceManagerAPI = resourceManagerClient
+}
 
-	//CLOUD SHELL Service
-	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
+func (session *clientSession) configureIBMCloudShellV1() {
+	if session.ibmCloudShellClientErr = session.iamLogin(); session.ibmCloudShellClientErr != nil {
+		return
 	}
+	c := session.config
+	var err error
+
+	//CLOUD SHELL Service
+	cloudShellUrl := c.DefaultEndpoint("IBMCLOUD_CLOUD_SHELL_API_ENDPOINT")
 	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", cloudShellUrl),
 	}
 	session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
 	if err != nil {
 		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.ibmCloudShellClient.Service)
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// ENTERPRISE Service
-	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
-			enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			enterpriseURL = ContructEndpoint("private.us-south.enterprise", fmt.Sprintf("%s/v1", cloudEndpoint))
-		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
-			enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region),
-				fmt.Sprintf("%s/v1", cloudEndpoint))
-		} else {
-			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
+func (session *clientSession) configureEnterpriseManagementV1() {
+	if session.enterpriseManagementClientErr = session.iamLogin(); session.enterpriseManagementClientErr != nil {
+		return
 	}
+	c := session.config
+
+	// ENTERPRISE Service
+	enterpriseURL := c.DefaultEndpoint("IBMCLOUD_ENTERPRISE_API_ENDPOINT")
 	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_ENTERPRISE_API_ENDPOINT", enterpriseURL),
 	}
 	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
 	if err != nil {
 		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(enterpriseManagementClient.Service)
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
+}
 
-	// RESOURCE CONTROLLER Service
-	rcURL := resourcecontroller.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			rcURL = ContructEndpoint("private.us-south.resource-controller", cloudEndpoint)
-		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
-		} else {
-			rcURL = resourcecontroller.DefaultServiceURL
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
+func (session *clientSession) configureResourceControllerV2API() {
+	if session.resourceControllerErr = session.iamLogin(); session.resourceControllerErr != nil {
+		return
 	}
+	c := session.config
+
+	// RESOURCE CONTROLLER Service
+	rcURL := c.DefaultEndpoint("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT")
 	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", rcURL),
 	}
 	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
 	if err != nil {
 		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(resourceControllerClient.Service)
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceControllerAPI = resourceControllerClient
+}
+
+func (session *clientSession) configureSecretsManagerV1() {
+	if session.secretsManagerClientErr = session.iamLogin(); session.secretsManagerClientErr != nil {
+		return
+	}
+	var err error
 
 	// SECRETS MANAGER Service
 	secretsManagerClientOptions := &secretsmanagerv1.SecretsManagerV1Options{
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	/// Construct the service client.
 	session.secretsManagerClient, err = secretsmanagerv1.NewSecretsManagerV1(secretsManagerClientOptions)
@@ -2933,24 +3469,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.secretsManagerClient.Service)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureSatelliteClientSession() {
+	if session.satelliteClientErr = session.iamLogin(); session.satelliteClientErr != nil {
+		return
+	}
+	c := session.config
+	var err error
 
 	// SATELLITE Service
-	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
-	}
+	containerEndpoint := c.DefaultEndpoint("IBMCLOUD_SATELLITE_API_ENDPOINT")
 	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
-		Authenticator: authenticator,
+		URL:           c.endpoint("IBMCLOUD_SATELLITE_API_ENDPOINT", containerEndpoint),
+		Authenticator: session.authenticator,
 	}
 	session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
 	if err != nil {
@@ -2959,24 +3497,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.satelliteClient.Service)
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureSatellitLinkClientSession() {
+	if session.satelliteLinkClientErr = session.iamLogin(); session.satelliteLinkClientErr != nil {
+		return
+	}
+	c := session.config
+	var err error
 
 	// SATELLITE LINK Service
 	// Construct an "options" struct for creating the service client.
-	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
-	}
+	satelliteLinkEndpoint := c.DefaultEndpoint("IBMCLOUD_SATELLITE_LINK_API_ENDPOINT")
 	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
-		Authenticator: authenticator,
+		URL:           c.endpoint("IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", satelliteLinkEndpoint),
+		Authenticator: session.authenticator,
 	}
 	session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
 	if err != nil {
@@ -2984,48 +3524,52 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
 		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.satelliteLinkClient.Service)
 		// Add custom header for analytics
 		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configureESschemaRegistrySession() {
+	if session.esSchemaRegistryErr = session.iamLogin(); session.esSchemaRegistryErr != nil {
+		return
+	}
+	var err error
 
 	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
 	if err != nil {
 		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.esSchemaRegistryClient.Service)
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// Governance Service
-	var configServiceApiClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion(c.Region)
-		}
-	} else {
-		configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		configServiceApiClientURL = configurationgovernancev1.DefaultServiceURL
+func (session *clientSession) configureConfigurationGovernanceV1() {
+	if session.configServiceApiClientErr = session.iamLogin(); session.configServiceApiClientErr != nil {
+		return
 	}
+	c := session.config
+	var err error
+
+	// Governance Service
+	configServiceApiClientURL := c.DefaultEndpoint("IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT")
 	configServiceApiClientOptions := &configurationgovernancev1.ConfigurationGovernanceV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT"}, configServiceApiClientURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT", configServiceApiClientURL),
 	}
 	session.configServiceApiClient, err = configurationgovernancev1.NewConfigurationGovernanceV1(configServiceApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.configServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.configServiceApiClient.Service)
 		// Add custom header for analytics
 		session.configServiceApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3033,6 +3577,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.configServiceApiClientErr = fmt.Errorf("Error occurred while configuring Config Service API service: %q", err)
 	}
+}
+
+func (session *clientSession) configurePostureManagementV1() {
+	if session.postureManagementClientErr = session.iamLogin(); session.postureManagementClientErr != nil {
+		return
+	}
+	c := session.config
+	var err error
 
 	//COMPLIANCE Service
 	// Construct an "options" struct for creating the service client.
@@ -3045,13 +3597,10 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		postureManagementClientURL = posturemanagementv1.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURL)
-	}
 	postureManagementClientOptions := &posturemanagementv1.PostureManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURL),
-		AccountID:     core.StringPtr(userConfig.UserAccount),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_COMPLIANCE_API_ENDPOINT", postureManagementClientURL),
+		AccountID:     core.StringPtr(session.bmxUserDetails.UserAccount),
 	}
 
 	// Construct the service client.
@@ -3061,12 +3610,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.postureManagementClient != nil && session.postureManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.postureManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.postureManagementClient.Service)
 		// Add custom header for analytics
 		session.postureManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) configurePostureManagementV2() {
+	if session.postureManagementClientErrv2 = session.iamLogin(); session.postureManagementClientErrv2 != nil {
+		return
+	}
+	c := session.config
+	var err error
 
 	//COMPLIANCE Service v2 version
 	// Construct an "options" struct for creating the service client.
@@ -3079,12 +3636,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Security Posture Management API service:  `%s` region not supported", c.Region)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURLv2 = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURLv2)
-	}
 	postureManagementClientOptionsv2 := &posturemanagementv2.PostureManagementV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURLv2),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_COMPLIANCE_API_ENDPOINT", postureManagementClientURLv2),
 	}
 
 	// Construct the service client.
@@ -3094,39 +3648,33 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.postureManagementClientv2 != nil && session.postureManagementClientv2.Service != nil {
 		// Enable retries for API calls
-		session.postureManagementClientv2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.postureManagementClientv2.Service)
 		// Add custom header for analytics
 		session.postureManagementClientv2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// Construct an "options" struct for creating the service client.
-	var cdToolchainClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
-		}
-	} else {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		cdToolchainClientURL = cdtoolchainv2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
+func (session *clientSession) configureCdToolchainV2() {
+	if session.cdToolchainClientErr = session.iamLogin(); session.cdToolchainClientErr != nil {
+		return
 	}
+	c := session.config
+	var err error
+
+	// Construct an "options" struct for creating the service client.
+	cdToolchainClientURL := c.DefaultEndpoint("IBMCLOUD_TOOLCHAIN_ENDPOINT")
 	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_TOOLCHAIN_ENDPOINT", cdToolchainClientURL),
 	}
 
 	// Construct the service client.
 	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cdToolchainClient.Service)
 		// Add custom header for analytics
 		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3134,32 +3682,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 	}
+}
 
-	// Construct an "options" struct for creating the tekton pipeline service client.
-	var cdTektonPipelineClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
-		}
-	} else {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
+func (session *clientSession) configureCdTektonPipelineV2() {
+	if session.cdTektonPipelineClientErr = session.iamLogin(); session.cdTektonPipelineClientErr != nil {
+		return
 	}
+	c := session.config
+	var err error
+
+	// Construct an "options" struct for creating the tekton pipeline service client.
+	cdTektonPipelineClientURL := c.DefaultEndpoint("IBMCLOUD_TEKTON_PIPELINE_ENDPOINT")
 	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
+		Authenticator: session.authenticator,
+		URL:           c.endpoint("IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", cdTektonPipelineClientURL),
 	}
 	// Construct the service client.
 	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureTransport(session.cdTektonPipelineClient.Service)
 		// Add custom header for analytics
 		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3167,13 +3709,6 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
 	}
-
-	if os.Getenv("TF_LOG") != "" {
-		logDestination := log.Writer()
-		goLogger := log.New(logDestination, "", log.LstdFlags)
-		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
-	}
-	return session, nil
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -3184,20 +3719,36 @@ func CreateVersionDate() *string {
 
 func newSession(c *Config) (*Session, error) {
 	ibmSession := &Session{}
+	// The requests of the bluemix-go sessions are retried by their HTTP client.
+	noRetries := 0
 
+	iamToken := c.IAMToken
+	if c.IAMTrustedProfileID != "" && iamToken == "" {
+		authenticator, err := c.trustedProfileAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+		if iamToken, err = trustedProfileToken(authenticator); err != nil {
+			return nil, err
+		}
+		c.trustedProfile = authenticator
+	}
+
+	// Like the bluemix-go sessions, the SoftLayer session leaves the retries
+	// to its HTTP client.
 	softlayerSession := &slsession.Session{
-		Endpoint:  c.SoftLayerEndpointURL,
-		Timeout:   c.SoftLayerTimeout,
-		UserName:  c.SoftLayerUserName,
-		APIKey:    c.SoftLayerAPIKey,
-		Debug:     os.Getenv("TF_LOG") != "",
-		Retries:   c.RetryCount,
-		RetryWait: c.RetryDelay,
+		Endpoint:   c.SoftLayerEndpointURL,
+		Timeout:    c.SoftLayerTimeout,
+		UserName:   c.SoftLayerUserName,
+		APIKey:     c.SoftLayerAPIKey,
+		Debug:      os.Getenv("TF_LOG") != "",
+		Retries:    0,
+		HTTPClient: c.softlayerHTTPClient(),
 	}
 
-	if c.IAMToken != "" {
+	if iamToken != "" {
 		log.Println("Configuring SoftLayer Session with token")
-		softlayerSession.IAMToken = c.IAMToken
+		softlayerSession.IAMToken = iamToken
 		softlayerSession.IAMRefreshToken = c.IAMRefreshToken
 	}
 	if c.SoftLayerAPIKey != "" && c.SoftLayerUserName != "" {
@@ -3211,26 +3762,24 @@ func newSession(c *Config) (*Session, error) {
 	if c.IAMTrustedProfileID == "" && (c.IAMToken != "" && c.IAMRefreshToken == "") || (c.IAMToken == "" && c.IAMRefreshToken != "") {
 		return nil, fmt.Errorf("iam_token and iam_refresh_token must be provided")
 	}
-	if c.IAMTrustedProfileID != "" && c.IAMToken == "" {
-		return nil, fmt.Errorf("iam_token and iam_profile_id must be provided")
-	}
-
-	if c.IAMToken != "" {
+	if iamToken != "" {
 		log.Println("Configuring IBM Cloud Session with token")
 		var sess *bxsession.Session
 		bmxConfig := &bluemix.Config{
-			IAMAccessToken:  c.IAMToken,
+			IAMAccessToken:  iamToken,
 			IAMRefreshToken: c.IAMRefreshToken,
 			//Comment out debug mode for v0.12
-			Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
-			EndpointsFile: c.EndpointsFile,
-			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			Debug:           os.Getenv("TF_LOG") != "",
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			RetryDelay:      &c.RetryDelay,
+			MaxRetries:      &noRetries,
+			Visibility:      c.Visibility,
+			EndpointsFile:   c.EndpointsFile,
+			EndpointLocator: c.endpointLocator(),
+			UserAgent:       fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			HTTPClient:      c.httpClient(),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -3245,15 +3794,17 @@ func newSession(c *Config) (*Session, error) {
 		bmxConfig := &bluemix.Config{
 			BluemixAPIKey: c.BluemixAPIKey,
 			//Comment out debug mode for v0.12
-			Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
-			EndpointsFile: c.EndpointsFile,
-			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			Debug:           os.Getenv("TF_LOG") != "",
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			RetryDelay:      &c.RetryDelay,
+			MaxRetries:      &noRetries,
+			Visibility:      c.Visibility,
+			EndpointsFile:   c.EndpointsFile,
+			EndpointLocator: c.endpointLocator(),
+			UserAgent:       fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			HTTPClient:      c.httpClient(),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -3272,6 +3823,7 @@ func authenticateAPIKey(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -3286,6 +3838,7 @@ func authenticateCF(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{http.UserAgent()},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -3293,7 +3846,9 @@ func authenticateCF(sess *bxsession.Session) error {
 	return tokenRefresher.AuthenticateAPIKey(config.BluemixAPIKey)
 }
 
-func fetchUserDetails(sess *bxsession.Session, retries int, retryDelay time.Duration) (*UserConfig, error) {
+// fetchUserDetails reads the account user details from the IAM access token
+// of the session.
+func fetchUserDetails(sess *bxsession.Session) (*UserConfig, error) {
 	config := sess.Config
 	user := UserConfig{}
 	var bluemixToken string
@@ -3309,14 +3864,6 @@ func fetchUserDetails(sess *bxsession.Session, retries int, retryDelay time.Dura
 	})
 	//TODO validate with key
 	if err != nil && !strings.Contains(err.Error(), "key is of invalid type") {
-		if retries > 0 {
-			if config.BluemixAPIKey != "" {
-				time.Sleep(retryDelay)
-				log.Printf("Retrying authentication for user details %d", retries)
-				_ = authenticateAPIKey(sess)
-				return fetchUserDetails(sess, retries-1, retryDelay)
-			}
-		}
 		return &user, err
 	}
 	claims := token.Claims.(jwt.MapClaims)
@@ -3344,6 +3891,7 @@ func RefreshToken(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -3360,15 +3908,78 @@ func EnvFallBack(envs []string, defaultValue string) string {
 	}
 	return defaultValue
 }
-func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defaultValue string) string {
-	if val, ok := fileMap[key]; ok {
-		if v, ok := val.(map[string]interface{})[visibility]; ok {
-			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
-				return r.(string)
-			}
-		}
+
+// retryPolicy returns the retry policy of the requests sent by the clients.
+func (c *Config) retryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: c.RetryCount,
+		MinDelay:   c.RetryMinDelay,
+		MaxDelay:   c.RetryMaxDelay,
 	}
-	return defaultValue
+}
+
+// wrapTransport returns the round tripper of a client whose requests are
+// otherwise sent with base: the configured HTTPTransport, if any, replaces
+// base, else the proxy and TLS settings are applied to it, the requests are
+// written to the audit log, rate limited, and retried according to the retry
+// policy. Each attempt waits for the rate limiter and is audited. The cached
+// responses, if enabled, are returned without sending the request.
+func (c *Config) wrapTransport(base gohttp.RoundTripper, policy RetryPolicy, timeout time.Duration) gohttp.RoundTripper {
+	if c.HTTPTransport != nil {
+		base = c.HTTPTransport
+	}
+	if base == nil {
+		base = gohttp.DefaultTransport
+	}
+	if c.HTTPTransport == nil {
+		base = c.network.transport(base)
+	}
+	if c.auditLog != nil {
+		base = &auditTransport{base: base, log: c.auditLog}
+	}
+	if c.rateLimiter != nil {
+		base = &rateLimitTransport{base: base, limiter: c.rateLimiter}
+	}
+	if c.tokens != nil {
+		base = &tokenTransport{base: base, manager: c.tokens}
+	}
+	transport := gohttp.RoundTripper(&failedRequestTransport{base: NewRetryTransport(base, policy, timeout), log: c.failedRequests})
+	if c.cache != nil {
+		transport = &cacheTransport{base: transport, cache: c.cache}
+	}
+	return transport
+}
+
+// httpClient returns the client the bluemix-go sessions and the IAM
+// authenticator send their requests with. The bluemix-go timeout bounds each
+// attempt, and its own retries are disabled in favour of the retry policy.
+func (c *Config) httpClient() *gohttp.Client {
+	base := http.NewHTTPClient(&bluemix.Config{}).Transport
+	if c.network != nil {
+		// The bluemix-go transport doesn't expose its http.Transport.
+		base = http.NewTraceLoggingTransport(c.network.transport(DefaultTransport()))
+	}
+	return &gohttp.Client{Transport: c.wrapTransport(base, c.retryPolicy(), c.BluemixTimeout)}
+}
+
+// softlayerHTTPClient returns the client of the SoftLayer session, which only
+// retries the throttled and gateway errors.
+func (c *Config) softlayerHTTPClient() *gohttp.Client {
+	policy := c.retryPolicy()
+	policy.Statuses = softlayerRetryStatuses
+	return &gohttp.Client{Transport: c.wrapTransport(DefaultTransport(), policy, 0)}
+}
+
+// transport returns the round tripper for the clients that take one directly.
+func (c *Config) transport() gohttp.RoundTripper {
+	return c.wrapTransport(DefaultTransport(), c.retryPolicy(), 0)
+}
+
+// configureTransport applies the retry policy, and the configured
+// HTTPTransport, to the requests of a platform service client.
+func (session *clientSession) configureTransport(service *core.BaseService) {
+	client := service.GetHTTPClient()
+	client.Transport = session.config.wrapTransport(client.Transport, session.config.retryPolicy(), 0)
 }
 
 // DefaultTransport ...
@@ -3384,29 +3995,6 @@ func DefaultTransport() gohttp.RoundTripper {
 	return transport
 }
 
-func isRetryable(err error) bool {
-	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
-		switch bmErr.StatusCode() {
-		case 408, 504, 599, 429, 500, 502, 520, 503:
-			return true
-		}
-	}
-
-	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(*net.OpError); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(net.UnknownNetworkError); ok && netErr.Timeout() {
-		return true
-	}
-
-	return false
-}
-
 func ContructEndpoint(subdomain, domain string) string {
 	endpoint := fmt.Sprintf("https://%s.%s", subdomain, domain)
 	return endpoint
@@ -27,7 +27,7 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 		return nil, err
 	}
 
-	functionsClient, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	functionsClient, err := whisk.NewClient(whiskHTTPClient(c), &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -35,6 +35,14 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 	return functionsClient, err
 }
 
+//whiskHTTPClient returns the HTTP client configured on the session, if any
+func whiskHTTPClient(c *bluemix.Config) *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
 //getBaseURL ..
 func getBaseURL(region string) string {
 	baseEndpoint := fmt.Sprintf(DefaultServiceURL)
@@ -54,7 +62,7 @@ func getBaseURL(region string) string {
  */
 func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, functionNamespace functions.FunctionServiceAPI) (*whisk.Client, error) {
 	u, _ := url.Parse(fmt.Sprintf("https://%s.functions.cloud.ibm.com/api", sess.Config.Region))
-	wskClient, _ := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	wskClient, _ := whisk.NewClient(whiskHTTPClient(sess.Config), &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -82,18 +90,8 @@ func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, funct
 			if n.IsIamEnabled() {
 				additionalHeaders := make(http.Header)
 
-				err := RefreshToken(sess)
-				if err != nil {
-					for count := sess.Config.MaxRetries; *count >= 0; *count-- {
-						if err == nil || !isRetryable(err) {
-							break
-						}
-						err = RefreshToken(sess)
-					}
-					if err != nil {
-						return nil, err
-					}
-
+				if err := RefreshToken(sess); err != nil {
+					return nil, err
 				}
 				additionalHeaders.Add("Authorization", sess.Config.IAMAccessToken)
 				additionalHeaders.Add("X-Namespace-Id", n.GetID())
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	jwt "github.com/golang-jwt/jwt"
+)
+
+// testIAMServer stands in for the IAM token endpoint and counts the logins.
+func testIAMServer(t *testing.T, logins *int32) *httptest.Server {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"id":      "IBMid-test",
+		"email":   "test@example.com",
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"account": map[string]interface{}{"bss": "test-account"},
+	}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/identity/token" {
+			http.NotFound(w, r)
+			return
+		}
+		atomic.AddInt32(logins, 1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  token,
+			"refresh_token": "refresh",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"expiration":    time.Now().Add(time.Hour).Unix(),
+		})
+	}))
+}
+
+func setTestEnv(t *testing.T, key, value string) {
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	for _, k := range []string{"IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY", "IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN"} {
+		setTestEnv(t, k, "")
+	}
+	config := &Config{Region: "us-south"}
+	sess, err := config.ClientSession()
+	if err != nil {
+		t.Fatalf("ClientSession failed: %s", err)
+	}
+	session := sess.(ClientSession)
+	if _, err := session.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected %q, got %v", errEmptyBluemixCredentials, err)
+	}
+	if _, err := session.BluemixSession(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected %q, got %v", errEmptyBluemixCredentials, err)
+	}
+	if session.SoftLayerSession() == nil {
+		t.Fatal("expected a SoftLayer session")
+	}
+}
+
+func TestClientSessionLazyIAMLogin(t *testing.T) {
+	var logins int32
+	server := testIAMServer(t, &logins)
+	defer server.Close()
+	setTestEnv(t, "IBMCLOUD_IAM_API_ENDPOINT", server.URL)
+
+	config := &Config{
+		BluemixAPIKey: "test-api-key",
+		Region:        "us-south",
+		RetryDelay:    time.Millisecond,
+	}
+	sess, err := config.ClientSession()
+	if err != nil {
+		t.Fatalf("ClientSession failed: %s", err)
+	}
+	if n := atomic.LoadInt32(&logins); n != 0 {
+		t.Fatalf("expected no IAM login while configuring the session, got %d", n)
+	}
+
+	session := sess.(ClientSession)
+	var wg sync.WaitGroup
+	clients := make(chan interface{}, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client, err := session.VpcV1API()
+			if err != nil {
+				t.Errorf("VpcV1API failed: %s", err)
+			}
+			clients <- client
+		}()
+	}
+	wg.Wait()
+	close(clients)
+
+	var first interface{}
+	for client := range clients {
+		if first == nil {
+			first = client
+		} else if client != first {
+			t.Fatal("expected every caller to get the cached VPC client")
+		}
+	}
+	if n := atomic.LoadInt32(&logins); n != 1 {
+		t.Fatalf("expected a single IAM login, got %d", n)
+	}
+
+	if _, err := session.CisZonesV1ClientSession(); err != nil {
+		t.Fatalf("CisZonesV1ClientSession failed: %s", err)
+	}
+	user, err := session.BluemixUserDetails()
+	if err != nil {
+		t.Fatalf("BluemixUserDetails failed: %s", err)
+	}
+	if user.UserAccount != "test-account" {
+		t.Fatalf("expected account test-account, got %q", user.UserAccount)
+	}
+	if n := atomic.LoadInt32(&logins); n != 1 {
+		t.Fatalf("expected the IAM login to be shared, got %d logins", n)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClientSessionHTTPTransport(t *testing.T) {
+	var logins int32
+	server := testIAMServer(t, &logins)
+	defer server.Close()
+	setTestEnv(t, "IBMCLOUD_IAM_API_ENDPOINT", "https://iam.test")
+
+	var mu sync.Mutex
+	var hosts []string
+	config := &Config{
+		BluemixAPIKey: "test-api-key",
+		Region:        "us-south",
+		RetryDelay:    time.Millisecond,
+		HTTPTransport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			hosts = append(hosts, req.URL.Host)
+			mu.Unlock()
+			if req.URL.Path == "/identity/token" {
+				req.URL.Scheme = "http"
+				req.URL.Host = server.Listener.Addr().String()
+				return http.DefaultTransport.RoundTrip(req)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"vpcs":[],"limit":50}`)),
+				Request:    req,
+			}, nil
+		}),
+	}
+	sess, err := config.ClientSession()
+	if err != nil {
+		t.Fatalf("ClientSession failed: %s", err)
+	}
+	vpcClient, err := sess.(ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatalf("VpcV1API failed: %s", err)
+	}
+	if _, _, err := vpcClient.ListVpcs(&vpcv1.ListVpcsOptions{}); err != nil {
+		t.Fatalf("ListVpcs failed: %s", err)
+	}
+
+	// The bluemix-go login and the IAM authenticator of the VPC client both
+	// fetch a token, then the VPC request is sent.
+	want := []string{"iam.test", "iam.test", "us-south.iaas.cloud.ibm.com"}
+	if strings.Join(hosts, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected requests to %v through the transport, got %v", want, hosts)
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+)
+
+// ServiceEndpoint is a service whose endpoint can be overridden. Name is the
+// argument of the endpoints block of the provider, and Key is both the
+// environment variable and the key of the endpoints file setting it.
+type ServiceEndpoint struct {
+	Name    string
+	Key     string
+	Service string
+}
+
+// ServiceEndpoints are the services whose endpoint can be overridden.
+var ServiceEndpoints = []ServiceEndpoint{
+	{Name: "account_management", Key: "IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT", Service: "Account Management"},
+	{Name: "api_gateway", Key: "IBMCLOUD_API_GATEWAY_ENDPOINT", Service: "API Gateway"},
+	{Name: "app_configuration", Key: "IBMCLOUD_APP_CONFIG_API_ENDPOINT", Service: "App Configuration"},
+	{Name: "appid", Key: "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", Service: "App ID"},
+	{Name: "atracker", Key: "IBMCLOUD_ATRACKER_API_ENDPOINT", Service: "Activity Tracker"},
+	{Name: "catalog_management", Key: "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", Service: "Catalog Management"},
+	{Name: "certificate_manager", Key: "IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT", Service: "Certificate Manager"},
+	{Name: "cis", Key: "IBMCLOUD_CIS_API_ENDPOINT", Service: "Internet Services"},
+	{Name: "cloud_shell", Key: "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", Service: "Cloud Shell"},
+	{Name: "cloudant", Key: "IBMCLOUD_CLOUDANT_ENDPOINT", Service: "Cloudant"},
+	{Name: "cloudant_database", Key: "IBMCLOUD_CLOUDANT_API_ENDPOINT", Service: "Cloudant database"},
+	{Name: "compliance", Key: "IBMCLOUD_COMPLIANCE_API_ENDPOINT", Service: "Compliance (Posture Management)"},
+	{Name: "configuration_governance", Key: "IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT", Service: "Configuration Governance"},
+	{Name: "container", Key: "IBMCLOUD_CS_API_ENDPOINT", Service: "Kubernetes Service"},
+	{Name: "container_registry", Key: "IBMCLOUD_CR_API_ENDPOINT", Service: "Container Registry"},
+	{Name: "context_based_restrictions", Key: "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", Service: "Context Based Restrictions"},
+	{Name: "cos", Key: "IBMCLOUD_COS_ENDPOINT", Service: "Cloud Object Storage S3 API"},
+	{Name: "cos_config", Key: "IBMCLOUD_COS_CONFIG_ENDPOINT", Service: "Cloud Object Storage Resource Configuration"},
+	{Name: "databases", Key: "IBMCLOUD_DATABASES_API_ENDPOINT", Service: "Cloud Databases"},
+	{Name: "directlink", Key: "IBMCLOUD_DL_API_ENDPOINT", Service: "Direct Link"},
+	{Name: "directlink_provider", Key: "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", Service: "Direct Link Provider"},
+	{Name: "enterprise", Key: "IBMCLOUD_ENTERPRISE_API_ENDPOINT", Service: "Enterprise Management"},
+	{Name: "event_notifications", Key: "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", Service: "Event Notifications"},
+	{Name: "functions", Key: "IBMCLOUD_FUNCTIONS_API_ENDPOINT", Service: "Cloud Functions"},
+	{Name: "global_search", Key: "IBMCLOUD_GS_API_ENDPOINT", Service: "Global Search"},
+	{Name: "global_tagging", Key: "IBMCLOUD_GT_API_ENDPOINT", Service: "Global Tagging"},
+	{Name: "hpcs", Key: "IBMCLOUD_HPCS_API_ENDPOINT", Service: "Hyper Protect Crypto Services"},
+	{Name: "hpcs_tke", Key: "IBMCLOUD_HPCS_TKE_ENDPOINT", Service: "Hyper Protect Crypto Services TKE"},
+	{Name: "iam", Key: "IBMCLOUD_IAM_API_ENDPOINT", Service: "Identity and Access Management"},
+	{Name: "iam_pap", Key: "IBMCLOUD_IAMPAP_API_ENDPOINT", Service: "IAM Policy Administration"},
+	{Name: "icd", Key: "IBMCLOUD_ICD_API_ENDPOINT", Service: "Cloud Databases (v4)"},
+	{Name: "kms", Key: "IBMCLOUD_KP_API_ENDPOINT", Service: "Key Management Services"},
+	{Name: "mccp", Key: "IBMCLOUD_MCCP_API_ENDPOINT", Service: "Cloud Foundry"},
+	{Name: "power", Key: "IBMCLOUD_PI_API_ENDPOINT", Service: "Power Systems Virtual Server"},
+	{Name: "private_dns", Key: "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", Service: "Private DNS"},
+	{Name: "push_notifications", Key: "IBMCLOUD_PUSH_API_ENDPOINT", Service: "Push Notifications"},
+	{Name: "resource_catalog", Key: "IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", Service: "Global Catalog"},
+	{Name: "resource_controller", Key: "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", Service: "Resource Controller"},
+	{Name: "resource_manager", Key: "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", Service: "Resource Manager"},
+	{Name: "satellite", Key: "IBMCLOUD_SATELLITE_API_ENDPOINT", Service: "Satellite"},
+	{Name: "satellite_link", Key: "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", Service: "Satellite Link"},
+	{Name: "scc_admin", Key: "IBMCLOUD_SCC_ADMIN_API_ENDPOINT", Service: "Security and Compliance Center Admin"},
+	{Name: "scc_findings", Key: "IBMCLOUD_SCC_FINDINGS_API_ENDPOINT", Service: "Security and Compliance Center Findings"},
+	{Name: "schematics", Key: "IBMCLOUD_SCHEMATICS_API_ENDPOINT", Service: "Schematics"},
+	{Name: "secrets_manager", Key: "IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT", Service: "Secrets Manager"},
+	{Name: "tekton_pipeline", Key: "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", Service: "Continuous Delivery Tekton Pipeline"},
+	{Name: "toolchain", Key: "IBMCLOUD_TOOLCHAIN_ENDPOINT", Service: "Continuous Delivery Toolchain"},
+	{Name: "transit_gateway", Key: "IBMCLOUD_TG_API_ENDPOINT", Service: "Transit Gateway"},
+	{Name: "uaa", Key: "IBMCLOUD_UAA_ENDPOINT", Service: "UAA"},
+	{Name: "user_management", Key: "IBMCLOUD_USER_MANAGEMENT_ENDPOINT", Service: "User Management"},
+	{Name: "vpc", Key: "IBMCLOUD_IS_NG_API_ENDPOINT", Service: "Virtual Private Cloud"},
+}
+
+// locatorEndpointKeys are the keys of the endpoints file only read by the
+// endpoint locator of bluemix-go, with no argument in the endpoints block.
+var locatorEndpointKeys = []string{"IBMCLOUD_CSE_ENDPOINT", "IBMCLOUD_SAT_API_ENDPOINT"}
+
+// Sources of the endpoints overriding the default endpoint of a service.
+const (
+	EndpointSourceProvider    = "provider"
+	EndpointSourceEnvironment = "environment"
+	EndpointSourceFile        = "file"
+)
+
+// endpointsFile maps the key of a service to its endpoints by visibility and
+// region.
+type endpointsFile map[string]map[string]map[string]string
+
+// EndpointOverride returns the endpoint overriding the default endpoint of the
+// service with the environment variable and endpoints file key, and its
+// source: the endpoints block of the provider, the environment variable, or
+// the endpoints file for the visibility and the region, in this order of
+// precedence. It returns empty strings if the default endpoint is used.
+func (c *Config) EndpointOverride(key string) (string, string) {
+	if url := c.Endpoints[key]; url != "" {
+		return url, EndpointSourceProvider
+	}
+	if url := EnvFallBack([]string{key}, ""); url != "" {
+		return url, EndpointSourceEnvironment
+	}
+	if c.Visibility != "public-and-private" {
+		if url := c.endpointsFile[key][c.Visibility][c.Region]; url != "" {
+			return url, EndpointSourceFile
+		}
+	}
+	return "", ""
+}
+
+// endpoint returns the endpoint of the service with the environment variable
+// and endpoints file key, or defaultURL if it isn't overridden.
+func (c *Config) endpoint(key, defaultURL string) string {
+	if url, _ := c.EndpointOverride(key); url != "" {
+		return url
+	}
+	return defaultURL
+}
+
+// loadEndpointsFile reads the endpoints file, if any, and fails if it isn't
+// valid.
+func (c *Config) loadEndpointsFile() error {
+	path := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile)
+	if path == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading the endpoints file: %s", err)
+	}
+	file, err := parseEndpointsFile(data)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error in the endpoints file %s: %s", path, err)
+	}
+	c.endpointsFile = file
+	return nil
+}
+
+// parseEndpointsFile parses an endpoints file: a JSON object mapping the Key
+// of ServiceEndpoints to objects mapping the visibility, public or private, to
+// objects mapping regions to endpoints.
+func parseEndpointsFile(data []byte) (endpointsFile, error) {
+	var file endpointsFile
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if err := decoder.Decode(&file); err != nil {
+		if typeErr, ok := err.(*json.UnmarshalTypeError); ok {
+			return nil, fmt.Errorf("expected {\"<key>\": {\"<public or private>\": {\"<region>\": \"<endpoint>\"}}}, got a JSON %s at offset %d", typeErr.Value, typeErr.Offset)
+		}
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(ServiceEndpoints)+len(locatorEndpointKeys))
+	for _, key := range endpointKeys() {
+		known[key] = true
+	}
+	var unknownKeys, problems []string
+	for key, visibilities := range file {
+		if !known[key] {
+			unknownKeys = append(unknownKeys, fmt.Sprintf("%q", key))
+			continue
+		}
+		for visibility := range visibilities {
+			if visibility != "public" && visibility != "private" {
+				problems = append(problems, fmt.Sprintf("%s: unknown visibility %q, expected public or private", key, visibility))
+			}
+		}
+	}
+	if len(unknownKeys) > 0 {
+		sort.Strings(unknownKeys)
+		problems = append(problems, fmt.Sprintf("unknown keys %s, the supported keys are %s", strings.Join(unknownKeys, ", "), strings.Join(endpointKeys(), ", ")))
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return file, nil
+}
+
+func endpointKeys() []string {
+	keys := make([]string, 0, len(ServiceEndpoints)+len(locatorEndpointKeys))
+	for _, s := range ServiceEndpoints {
+		keys = append(keys, s.Key)
+	}
+	keys = append(keys, locatorEndpointKeys...)
+	sort.Strings(keys)
+	return keys
+}
+
+// endpointLocator locates the endpoints of the clients of the Bluemix session,
+// overridden by the endpoints block of the provider. The environment variables
+// and the endpoints file are handled by the EndpointLocator it wraps.
+type endpointLocator struct {
+	endpoints.EndpointLocator
+	overrides map[string]string
+}
+
+func (c *Config) endpointLocator() endpoints.EndpointLocator {
+	return &endpointLocator{
+		EndpointLocator: endpoints.NewEndpointLocator(c.Region, c.Visibility, c.EndpointsFile),
+		overrides:       c.Endpoints,
+	}
+}
+
+func (l *endpointLocator) locate(key string, locate func() (string, error)) (string, error) {
+	if url := l.overrides[key]; url != "" {
+		return url, nil
+	}
+	return locate()
+}
+
+func (l *endpointLocator) AccountManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.AccountManagementEndpoint)
+}
+
+func (l *endpointLocator) CertificateManagerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT", l.EndpointLocator.CertificateManagerEndpoint)
+}
+
+func (l *endpointLocator) ContainerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CS_API_ENDPOINT", l.EndpointLocator.ContainerEndpoint)
+}
+
+func (l *endpointLocator) ContainerRegistryEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CR_API_ENDPOINT", l.EndpointLocator.ContainerRegistryEndpoint)
+}
+
+func (l *endpointLocator) CisEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CIS_API_ENDPOINT", l.EndpointLocator.CisEndpoint)
+}
+
+func (l *endpointLocator) GlobalSearchEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_GS_API_ENDPOINT", l.EndpointLocator.GlobalSearchEndpoint)
+}
+
+func (l *endpointLocator) GlobalTaggingEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_GT_API_ENDPOINT", l.EndpointLocator.GlobalTaggingEndpoint)
+}
+
+func (l *endpointLocator) IAMEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_IAM_API_ENDPOINT", l.EndpointLocator.IAMEndpoint)
+}
+
+func (l *endpointLocator) IAMPAPEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_IAMPAP_API_ENDPOINT", l.EndpointLocator.IAMPAPEndpoint)
+}
+
+func (l *endpointLocator) ICDEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_ICD_API_ENDPOINT", l.EndpointLocator.ICDEndpoint)
+}
+
+func (l *endpointLocator) MCCPAPIEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_MCCP_API_ENDPOINT", l.EndpointLocator.MCCPAPIEndpoint)
+}
+
+func (l *endpointLocator) ResourceManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.ResourceManagementEndpoint)
+}
+
+func (l *endpointLocator) ResourceControllerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", l.EndpointLocator.ResourceControllerEndpoint)
+}
+
+func (l *endpointLocator) ResourceCatalogEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", l.EndpointLocator.ResourceCatalogEndpoint)
+}
+
+func (l *endpointLocator) UAAEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_UAA_ENDPOINT", l.EndpointLocator.UAAEndpoint)
+}
+
+func (l *endpointLocator) SchematicsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_SCHEMATICS_API_ENDPOINT", l.EndpointLocator.SchematicsEndpoint)
+}
+
+func (l *endpointLocator) UserManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_USER_MANAGEMENT_ENDPOINT", l.EndpointLocator.UserManagementEndpoint)
+}
+
+func (l *endpointLocator) HpcsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_HPCS_API_ENDPOINT", l.EndpointLocator.HpcsEndpoint)
+}
+
+func (l *endpointLocator) FunctionsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_FUNCTIONS_API_ENDPOINT", l.EndpointLocator.FunctionsEndpoint)
+}
@@ -0,0 +1,307 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
+	dlProviderV2 "github.com/IBM/networking-go-sdk/directlinkproviderv2"
+	dl "github.com/IBM/networking-go-sdk/directlinkv1"
+	dns "github.com/IBM/networking-go-sdk/dnssvcsv1"
+	tg "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	iamidentity "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	ibmcloudshellv1 "github.com/IBM/platform-services-go-sdk/ibmcloudshellv1"
+	resourcecontroller "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	resourcemanager "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/IBM/scc-go-sdk/findingsv1"
+	"github.com/IBM/scc-go-sdk/v3/adminserviceapiv1"
+	"github.com/IBM/scc-go-sdk/v3/configurationgovernancev1"
+	"github.com/IBM/scc-go-sdk/v3/posturemanagementv2"
+)
+
+// defaultEndpoints build the default endpoint of the services by Key, for the
+// region and visibility of the configuration. The services whose endpoint
+// depends on their instance, such as Cloud Object Storage or Secrets Manager,
+// have none.
+var defaultEndpoints = map[string]func(c *Config) string{
+	"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT": locatedEndpoint(endpoints.EndpointLocator.AccountManagementEndpoint),
+	"IBMCLOUD_API_GATEWAY_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
+		}
+		return ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
+	},
+	"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT": publicEndpoint(func(c *Config) string {
+		return fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
+	}),
+	"IBMCLOUD_ATRACKER_API_ENDPOINT": regionalEndpoint(atrackerv2.GetServiceURLForRegion, atrackerv2.DefaultServiceURL),
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT": publicEndpoint(func(c *Config) string {
+		return "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
+	}),
+	"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT": locatedEndpoint(endpoints.EndpointLocator.CertificateManagerEndpoint),
+	"IBMCLOUD_CIS_API_ENDPOINT": func(c *Config) string {
+		return ContructEndpoint("api.cis", cloudEndpoint)
+	},
+	"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT": func(c *Config) string {
+		return ibmcloudshellv1.DefaultServiceURL
+	},
+	"IBMCLOUD_COMPLIANCE_API_ENDPOINT": func(c *Config) string {
+		if c.Visibility == "private" {
+			return ""
+		}
+		url, err := posturemanagementv2.GetServiceURLForRegion(c.Region)
+		if err != nil {
+			return ""
+		}
+		return url
+	},
+	"IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT": regionalEndpoint(configurationgovernancev1.GetServiceURLForRegion, configurationgovernancev1.DefaultServiceURL),
+	"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return ""
+		}
+		return contextbasedrestrictionsv1.DefaultServiceURL
+	},
+	"IBMCLOUD_COS_CONFIG_ENDPOINT": func(c *Config) string {
+		return "https://config.cloud-object-storage.cloud.ibm.com/v1"
+	},
+	"IBMCLOUD_CR_API_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			url, err := GetPrivateServiceURLForRegion(c.Region)
+			if err != nil {
+				url, _ = GetPrivateServiceURLForRegion("global")
+			}
+			return url
+		}
+		url, err := containerregistryv1.GetServiceURLForRegion(c.Region)
+		if err != nil {
+			return containerregistryv1.DefaultServiceURL
+		}
+		return url
+	},
+	"IBMCLOUD_CS_API_ENDPOINT": locatedEndpoint(endpoints.EndpointLocator.ContainerEndpoint),
+	"IBMCLOUD_DATABASES_API_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return fmt.Sprintf("https://api.%s.private.databases.cloud.ibm.com/v5/ibm", c.Region)
+		}
+		return fmt.Sprintf("https://api.%s.databases.cloud.ibm.com/v5/ibm", c.Region)
+	},
+	"IBMCLOUD_DL_API_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		return dl.DefaultServiceURL
+	},
+	"IBMCLOUD_DL_PROVIDER_API_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
+		}
+		return dlProviderV2.DefaultServiceURL
+	},
+	"IBMCLOUD_ENTERPRISE_API_ENDPOINT": func(c *Config) string {
+		supported := c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr"
+		switch {
+		case c.Visibility == "private" && !supported:
+			log.Printf("[WARN] Private Endpint supports only us-south and us-east region specific endpoint")
+			return ContructEndpoint("private.us-south.enterprise", fmt.Sprintf("%s/v1", cloudEndpoint))
+		case c.private() && supported:
+			return ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		return enterprisemanagementv1.DefaultServiceURL
+	},
+	"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT": publicEndpoint(func(c *Config) string {
+		return fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
+	}),
+	"IBMCLOUD_FUNCTIONS_API_ENDPOINT": locatedEndpoint(endpoints.EndpointLocator.FunctionsEndpoint),
+	"IBMCLOUD_GS_API_ENDPOINT":        locatedEndpoint(endpoints.EndpointLocator.GlobalSearchEndpoint),
+	"IBMCLOUD_GT_API_ENDPOINT": func(c *Config) string {
+		if !c.private() {
+			return "https://tags.global-search-tagging.cloud.ibm.com"
+		}
+		region := c.Region
+		if region != "us-south" && region != "us-east" {
+			region = "us-south"
+		}
+		return ContructEndpoint(fmt.Sprintf("tags.private.%s", region), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
+	},
+	"IBMCLOUD_HPCS_API_ENDPOINT": locatedEndpoint(endpoints.EndpointLocator.HpcsEndpoint),
+	"IBMCLOUD_HPCS_TKE_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return "private.cloud.ibm.com"
+		}
+		return "cloud.ibm.com"
+	},
+	"IBMCLOUD_IAM_API_ENDPOINT": func(c *Config) string {
+		if !c.private() {
+			return iamidentity.DefaultServiceURL
+		}
+		if c.Region == "us-south" || c.Region == "us-east" {
+			return ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		}
+		return ContructEndpoint("private.iam", cloudEndpoint)
+	},
+	"IBMCLOUD_IAMPAP_API_ENDPOINT": locatedEndpoint(endpoints.EndpointLocator.IAMPAPEndpoint),
+	"IBMCLOUD_ICD_API_ENDPOINT":    locatedEndpoint(endpoints.EndpointLocator.ICDEndpoint),
+	"IBMCLOUD_IS_NG_API_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		return ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+	},
+	"IBMCLOUD_KP_API_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
+		}
+		return ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
+	},
+	"IBMCLOUD_MCCP_API_ENDPOINT": locatedEndpoint(endpoints.EndpointLocator.MCCPAPIEndpoint),
+	"IBMCLOUD_PI_API_ENDPOINT": func(c *Config) string {
+		return ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
+	},
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		return dns.DefaultServiceURL
+	},
+	"IBMCLOUD_PUSH_API_ENDPOINT": publicEndpoint(func(c *Config) string {
+		return fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
+	}),
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT": locatedEndpoint(endpoints.EndpointLocator.ResourceCatalogEndpoint),
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT": func(c *Config) string {
+		return resourceControllerEndpoint(c, resourcecontroller.DefaultServiceURL)
+	},
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT": func(c *Config) string {
+		return resourceControllerEndpoint(c, resourcemanager.DefaultServiceURL)
+	},
+	"IBMCLOUD_SATELLITE_API_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
+		}
+		return kubernetesserviceapiv1.DefaultServiceURL
+	},
+	"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return ContructEndpoint("private.api.link.satellite", cloudEndpoint)
+		}
+		return satellitelinkv1.DefaultServiceURL
+	},
+	"IBMCLOUD_SCC_ADMIN_API_ENDPOINT": regionalEndpoint(adminserviceapiv1.GetServiceURLForRegion, adminserviceapiv1.DefaultServiceURL),
+	"IBMCLOUD_SCC_FINDINGS_API_ENDPOINT": func(c *Config) string {
+		if c.Visibility == "private" {
+			return ""
+		}
+		url, err := findingsv1.GetServiceURLForRegion(c.Region)
+		if err != nil {
+			return ""
+		}
+		return url
+	},
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				return ContructEndpoint("private-us.schematics", cloudEndpoint)
+			}
+			if c.Region == "eu-gb" || c.Region == "eu-de" {
+				return ContructEndpoint("private-eu.schematics", cloudEndpoint)
+			}
+		}
+		return "https://schematics.cloud.ibm.com"
+	},
+	"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT": regionalEndpoint(cdtektonpipelinev2.GetServiceURLForRegion, cdtektonpipelinev2.DefaultServiceURL),
+	"IBMCLOUD_TG_API_ENDPOINT": func(c *Config) string {
+		if c.private() {
+			return ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		return tg.DefaultServiceURL
+	},
+	"IBMCLOUD_TOOLCHAIN_ENDPOINT":       regionalEndpoint(cdtoolchainv2.GetServiceURLForRegion, cdtoolchainv2.DefaultServiceURL),
+	"IBMCLOUD_UAA_ENDPOINT":             locatedEndpoint(endpoints.EndpointLocator.UAAEndpoint),
+	"IBMCLOUD_USER_MANAGEMENT_ENDPOINT": locatedEndpoint(endpoints.EndpointLocator.UserManagementEndpoint),
+}
+
+// DefaultEndpoint returns the endpoint of the service with the environment
+// variable and endpoints file key when it isn't overridden, or "" if it
+// depends on the instance of the service or the service doesn't support the
+// region and visibility of c.
+func (c *Config) DefaultEndpoint(key string) string {
+	if defaultEndpoint, ok := defaultEndpoints[key]; ok {
+		return defaultEndpoint(c)
+	}
+	return ""
+}
+
+// private reports whether the services are reached on their private endpoints.
+func (c *Config) private() bool {
+	return c.Visibility == "private" || c.Visibility == "public-and-private"
+}
+
+// publicEndpoint returns the default endpoint of a service without private
+// endpoints.
+func publicEndpoint(endpoint func(c *Config) string) func(c *Config) string {
+	return func(c *Config) string {
+		if c.Visibility == "private" {
+			return ""
+		}
+		return endpoint(c)
+	}
+}
+
+// regionalEndpoint returns the default endpoint of a service with the
+// GetServiceURLForRegion function of its SDK, the private one of the region
+// for the private visibility, or defaultURL if the region isn't supported.
+func regionalEndpoint(urlForRegion func(string) (string, error), defaultURL string) func(c *Config) string {
+	return func(c *Config) string {
+		var url string
+		var err error
+		if c.private() {
+			url, err = urlForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				url, err = urlForRegion(c.Region)
+			}
+		} else {
+			url, err = urlForRegion(c.Region)
+		}
+		if err != nil {
+			return defaultURL
+		}
+		return url
+	}
+}
+
+// locatedEndpoint returns the default endpoint of a service of the Bluemix
+// session, located by bluemix-go.
+func locatedEndpoint(locate func(endpoints.EndpointLocator) (string, error)) func(c *Config) string {
+	return func(c *Config) string {
+		url, err := locate(endpoints.NewEndpointLocator(c.Region, c.Visibility, ""))
+		if err != nil {
+			return ""
+		}
+		return url
+	}
+}
+
+// resourceControllerEndpoint returns the endpoint of the resource controller
+// or manager, whose private endpoints are only in us-south and us-east.
+func resourceControllerEndpoint(c *Config, defaultURL string) string {
+	supported := c.Region == "us-south" || c.Region == "us-east"
+	switch {
+	case c.Visibility == "private" && !supported:
+		log.Printf("[WARN] Private Endpint supports only us-south and us-east region specific endpoint")
+		return ContructEndpoint("private.us-south.resource-controller", cloudEndpoint)
+	case c.private() && supported:
+		return ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
+	}
+	return defaultURL
+}
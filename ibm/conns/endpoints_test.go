@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseEndpointsFile(t *testing.T) {
+	file, err := parseEndpointsFile([]byte(`{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": "https://vpc.example.com/v1"}},
+		"IBMCLOUD_KP_API_ENDPOINT": {"private": {"us-south": "https://kms.example.com"}}
+	}`))
+	if err != nil {
+		t.Fatalf("parseEndpointsFile failed: %s", err)
+	}
+	if got := file["IBMCLOUD_IS_NG_API_ENDPOINT"]["public"]["us-south"]; got != "https://vpc.example.com/v1" {
+		t.Fatalf("expected the VPC endpoint, got %q", got)
+	}
+
+	// The keys only read by the endpoint locator of bluemix-go are accepted.
+	file, err = parseEndpointsFile([]byte(`{
+		"IBMCLOUD_CSE_ENDPOINT": {"private": {"us-south": "https://cse.example.com"}},
+		"IBMCLOUD_SAT_API_ENDPOINT": {"public": {"us-south": "https://sat.example.com"}}
+	}`))
+	if err != nil {
+		t.Fatalf("parseEndpointsFile failed with the keys of the endpoint locator: %s", err)
+	}
+	if got := file["IBMCLOUD_SAT_API_ENDPOINT"]["public"]["us-south"]; got != "https://sat.example.com" {
+		t.Fatalf("expected the satellite endpoint, got %q", got)
+	}
+
+	cases := map[string]string{
+		`{"IBMCLOUD_IS_NG_API_ENDPIONT": {"public": {"us-south": "https://vpc.example.com/v1"}}}`: `unknown keys "IBMCLOUD_IS_NG_API_ENDPIONT", the supported keys are `,
+		`{"IBMCLOUD_IS_NG_API_ENDPOINT": {"direct": {"us-south": "https://vpc.example.com/v1"}}}`: `IBMCLOUD_IS_NG_API_ENDPOINT: unknown visibility "direct"`,
+		`{"IBMCLOUD_IS_NG_API_ENDPOINT": "https://vpc.example.com/v1"}`:                           `got a JSON string`,
+		`{"IBMCLOUD_IS_NG_API_ENDPOINT": `:                                                        `unexpected EOF`,
+	}
+	for data, want := range cases {
+		if _, err := parseEndpointsFile([]byte(data)); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: expected an error containing %q, got %v", data, want, err)
+		}
+	}
+}
+
+func TestEndpointOverride(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := ioutil.WriteFile(path, []byte(`{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {"private": {"us-south": "https://file.vpc.example.com/v1"}},
+		"IBMCLOUD_TG_API_ENDPOINT": {"private": {"us-south": "https://file.tg.example.com/v1"}},
+		"IBMCLOUD_DL_API_ENDPOINT": {"private": {"us-south": "https://file.dl.example.com/v1"}}
+	}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	setTestEnv(t, "IBMCLOUD_ENDPOINTS_FILE_PATH", "")
+	setTestEnv(t, "IC_ENDPOINTS_FILE_PATH", "")
+	setTestEnv(t, "IBMCLOUD_IS_NG_API_ENDPOINT", "https://env.vpc.example.com/v1")
+	setTestEnv(t, "IBMCLOUD_TG_API_ENDPOINT", "https://env.tg.example.com/v1")
+	setTestEnv(t, "IBMCLOUD_DL_API_ENDPOINT", "")
+
+	config := &Config{
+		Region:        "us-south",
+		Visibility:    "private",
+		EndpointsFile: path,
+		Endpoints:     map[string]string{"IBMCLOUD_IS_NG_API_ENDPOINT": "https://block.vpc.example.com/v1"},
+	}
+	if err := config.loadEndpointsFile(); err != nil {
+		t.Fatalf("loadEndpointsFile failed: %s", err)
+	}
+	cases := []struct {
+		key, endpoint, source string
+	}{
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "https://block.vpc.example.com/v1", EndpointSourceProvider},
+		{"IBMCLOUD_TG_API_ENDPOINT", "https://env.tg.example.com/v1", EndpointSourceEnvironment},
+		{"IBMCLOUD_DL_API_ENDPOINT", "https://file.dl.example.com/v1", EndpointSourceFile},
+		{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", "", ""},
+	}
+	for _, c := range cases {
+		if endpoint, source := config.EndpointOverride(c.key); endpoint != c.endpoint || source != c.source {
+			t.Errorf("%s: expected %q from %q, got %q from %q", c.key, c.endpoint, c.source, endpoint, source)
+		}
+	}
+	if got := config.endpoint("IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", "https://api.dns-svcs.cloud.ibm.com/v1"); got != "https://api.dns-svcs.cloud.ibm.com/v1" {
+		t.Errorf("expected the default endpoint, got %q", got)
+	}
+
+	// The endpoints file isn't used with both visibilities.
+	config.Visibility = "public-and-private"
+	if endpoint, source := config.EndpointOverride("IBMCLOUD_DL_API_ENDPOINT"); endpoint != "" || source != "" {
+		t.Errorf("expected the default endpoint, got %q from %q", endpoint, source)
+	}
+
+	// The endpoints block overrides the endpoints of the Bluemix clients.
+	locator := config.endpointLocator()
+	if got, err := locator.IAMEndpoint(); err != nil || got == "" {
+		t.Errorf("expected the IAM endpoint, got %q, %v", got, err)
+	}
+	config.Endpoints["IBMCLOUD_IAM_API_ENDPOINT"] = "https://block.iam.example.com"
+	if got, _ := locator.IAMEndpoint(); got != "https://block.iam.example.com" {
+		t.Errorf("expected the IAM endpoint of the endpoints block, got %q", got)
+	}
+}
+
+func TestDefaultEndpoint(t *testing.T) {
+	cases := []struct {
+		region, visibility, key, endpoint string
+	}{
+		{"eu-de", "public", "IBMCLOUD_IS_NG_API_ENDPOINT", "https://eu-de.iaas.cloud.ibm.com/v1"},
+		{"eu-de", "private", "IBMCLOUD_IS_NG_API_ENDPOINT", "https://eu-de.private.iaas.cloud.ibm.com/v1"},
+		{"us-south", "public", "IBMCLOUD_IAM_API_ENDPOINT", "https://iam.cloud.ibm.com"},
+		{"eu-de", "private", "IBMCLOUD_IAM_API_ENDPOINT", "https://private.iam.cloud.ibm.com"},
+		{"us-south", "private", "IBMCLOUD_IAM_API_ENDPOINT", "https://private.us-south.iam.cloud.ibm.com"},
+		{"us-south", "public", "IBMCLOUD_COS_CONFIG_ENDPOINT", "https://config.cloud-object-storage.cloud.ibm.com/v1"},
+		{"us-south", "public", "IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT", ""},
+	}
+	for _, c := range cases {
+		config := &Config{Region: c.region, Visibility: c.visibility}
+		if got := config.DefaultEndpoint(c.key); got != c.endpoint {
+			t.Errorf("%s in %s (%s): expected %q, got %q", c.key, c.region, c.visibility, c.endpoint, got)
+		}
+	}
+}
+
+func TestLoadEndpointsFileInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := ioutil.WriteFile(path, []byte(`{"IBMCLOUD_VPC_API_ENDPOINT": {"public": {"us-south": "https://vpc.example.com/v1"}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	setTestEnv(t, "IBMCLOUD_ENDPOINTS_FILE_PATH", path)
+
+	_, err := (&Config{Region: "us-south"}).ClientSession()
+	if err == nil || !strings.Contains(err.Error(), `unknown keys "IBMCLOUD_VPC_API_ENDPOINT"`) {
+		t.Fatalf("expected the unknown key to fail the configuration, got %v", err)
+	}
+}
+
+func TestServiceEndpointKeys(t *testing.T) {
+	names := map[string]bool{}
+	keys := map[string]bool{}
+	for _, e := range ServiceEndpoints {
+		if names[e.Name] || keys[e.Key] {
+			t.Errorf("duplicate service endpoint %s (%s)", e.Name, e.Key)
+		}
+		names[e.Name] = true
+		keys[e.Key] = true
+	}
+}
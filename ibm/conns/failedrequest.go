@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	gohttp "net/http"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/google/uuid"
+)
+
+// failedRequestLogSize is the number of failed requests kept by the log of a
+// session.
+const failedRequestLogSize = 64
+
+// FailedRequest is a request that an API answered with an error status.
+type FailedRequest struct {
+	Service    string
+	Method     string
+	URL        string
+	StatusCode int
+	// RequestID is the ID the API returned for the request, or else the
+	// X-Request-Id it was sent with.
+	RequestID string
+	// Response is the response of the API, whose result is its JSON body,
+	// if any.
+	Response *core.DetailedResponse
+}
+
+type failedRequestKey struct{}
+
+type failedRequestRecorder struct {
+	mu     sync.Mutex
+	failed []*FailedRequest
+}
+
+func (r *failedRequestRecorder) record(failed *FailedRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failed = append(r.failed, failed)
+}
+
+func (r *failedRequestRecorder) list() []*FailedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*FailedRequest{}, r.failed...)
+}
+
+// failedRequestLog keeps the last failed requests of a session sent without
+// the context of an operation, like the requests of the bluemix-go and
+// SoftLayer clients.
+type failedRequestLog struct {
+	mu     sync.Mutex
+	seq    uint64
+	recent []*FailedRequest
+}
+
+func (l *failedRequestLog) record(failed *FailedRequest) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.seq++
+	l.recent = append(l.recent, failed)
+	if len(l.recent) > failedRequestLogSize {
+		l.recent = l.recent[len(l.recent)-failedRequestLogSize:]
+	}
+}
+
+// last returns the sequence number of the last failed request.
+func (l *failedRequestLog) last() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.seq
+}
+
+// since returns the failed requests recorded after the one of sequence
+// number seq, still kept.
+func (l *failedRequestLog) since(seq uint64) []*FailedRequest {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := l.seq - seq
+	if n > uint64(len(l.recent)) {
+		n = uint64(len(l.recent))
+	}
+	return append([]*FailedRequest{}, l.recent[uint64(len(l.recent))-n:]...)
+}
+
+// WithFailedRequests returns a context recording the requests sent with it
+// that fail, and a function returning them for the errors of an operation to
+// report the ID of their failed request, by order of failure.
+//
+// The clients which don't send their requests with a context, like the
+// bluemix-go and SoftLayer ones, can't be told apart from the ones of the
+// concurrent operations: the function also returns their requests of the
+// session meta that failed since the context was returned, listed first.
+func WithFailedRequests(ctx context.Context, meta interface{}) (context.Context, func() []*FailedRequest) {
+	recorder := &failedRequestRecorder{}
+	var log *failedRequestLog
+	var start uint64
+	if sess, ok := meta.(*clientSession); ok && sess.config.failedRequests != nil {
+		log = sess.config.failedRequests
+		start = log.last()
+	}
+	return context.WithValue(ctx, failedRequestKey{}, recorder), func() []*FailedRequest {
+		var failed []*FailedRequest
+		if log != nil {
+			failed = log.since(start)
+		}
+		return append(failed, recorder.list()...)
+	}
+}
+
+// failedRequestTransport sends every request with an X-Request-Id, and
+// records the requests sent with base that fail, once retried, in the
+// recorder of their context, or else in the log of the session.
+type failedRequestTransport struct {
+	base gohttp.RoundTripper
+	log  *failedRequestLog
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *failedRequestTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if req.Header.Get("X-Request-Id") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("X-Request-Id", uuid.New().String())
+	}
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode < gohttp.StatusBadRequest {
+		return resp, err
+	}
+	recorder, ok := req.Context().Value(failedRequestKey{}).(*failedRequestRecorder)
+	if !ok && t.log == nil {
+		return resp, nil
+	}
+	failed := &FailedRequest{
+		Service:    auditService(req.URL.Hostname()),
+		Method:     req.Method,
+		URL:        redactURL(req.URL),
+		StatusCode: resp.StatusCode,
+		RequestID:  requestID(req, resp),
+		Response:   detailedResponse(resp),
+	}
+	if ok {
+		recorder.record(failed)
+	} else {
+		t.log.record(failed)
+	}
+	return resp, nil
+}
+
+// detailedResponse returns the DetailedResponse of a failed request, whose
+// result is its JSON body, if any, which is left to be read by the client.
+func detailedResponse(resp *gohttp.Response) *core.DetailedResponse {
+	detailed := &core.DetailedResponse{
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header.Clone(),
+	}
+	if resp.Body == nil || resp.ContentLength > auditBodyLimit || !isAuditedContent(resp.Header.Get("Content-Type")) {
+		return detailed
+	}
+	content, _ := ioutil.ReadAll(io.LimitReader(resp.Body, auditBodyLimit+1))
+	resp.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(content), resp.Body), Closer: resp.Body}
+	var result interface{}
+	if len(content) <= auditBodyLimit && json.Unmarshal(content, &result) == nil {
+		detailed.Result = result
+	}
+	return detailed
+}
+
+// requestID returns the ID of a request, from the headers of its response or
+// the one it was sent with.
+func requestID(req *gohttp.Request, resp *gohttp.Response) string {
+	for _, h := range auditRequestIDHeaders {
+		if id := resp.Header.Get(h); id != "" {
+			return id
+		}
+	}
+	return req.Header.Get("X-Request-Id")
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// failingTransport answers the requests to the paths ending with /missing with
+// a 404 and an error body, and returns the ID of the requests in the
+// X-Request-Id of the response if echo is set.
+func failingTransport(echo bool, sent map[string]string) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		sent[req.URL.Path] = req.Header.Get("X-Request-Id")
+		status, body := http.StatusOK, `{}`
+		if strings.HasSuffix(req.URL.Path, "/missing") {
+			status, body = http.StatusNotFound, `{"errors": [{"code": "not_found", "message": "missing not found"}], "trace": "trace-1"}`
+		}
+		header := http.Header{"Content-Type": []string{"application/json"}}
+		if echo {
+			header.Set("X-Request-Id", "req-"+req.URL.Path[len("/v1/"):])
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     header,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	})
+}
+
+func TestFailedRequests(t *testing.T) {
+	sent := map[string]string{}
+	c := &Config{HTTPTransport: failingTransport(true, sent), failedRequests: &failedRequestLog{}}
+	sess := &clientSession{config: c}
+	client := &http.Client{Transport: c.transport()}
+	get := func(ctx context.Context, url string) string {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("GET %s failed: %s", url, err)
+		}
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	ctx, failed := WithFailedRequests(context.Background(), sess)
+	get(ctx, "https://us-south.iaas.cloud.ibm.com/v1/vpcs")
+	if f := failed(); len(f) != 0 {
+		t.Fatalf("expected no failed request, got %+v", f)
+	}
+	if body := get(ctx, "https://us-south.iaas.cloud.ibm.com/v1/missing?version=2022-06-14"); !strings.Contains(body, "missing not found") {
+		t.Fatalf("expected the client to read the error body, got %q", body)
+	}
+	get(ctx, "https://us-south.iaas.cloud.ibm.com/v1/vpcs")
+	f := failed()
+	if len(f) != 1 || f[0].Service != "iaas" || f[0].Method != http.MethodGet || f[0].StatusCode != http.StatusNotFound ||
+		f[0].RequestID != "req-missing" || f[0].URL != "https://us-south.iaas.cloud.ibm.com/v1/missing?version=2022-06-14" {
+		t.Fatalf("unexpected failed requests %+v", f)
+	}
+	if f[0].Response == nil || f[0].Response.StatusCode != http.StatusNotFound || f[0].Response.Result.(map[string]interface{})["trace"] != "trace-1" {
+		t.Fatalf("expected the response of the failed request, got %+v", f[0].Response)
+	}
+
+	// The requests sent without the context of an operation, like the ones
+	// of the bluemix-go and SoftLayer clients, are recorded by the session.
+	otherCtx, otherFailed := WithFailedRequests(context.Background(), sess)
+	get(context.Background(), "https://api.softlayer.com/rest/v3/missing")
+	get(otherCtx, "https://us-south.iaas.cloud.ibm.com/v1/vpcs")
+	if f := otherFailed(); len(f) != 1 || f[0].Service != "softlayer" {
+		t.Fatalf("expected the failed SoftLayer request, got %+v", f)
+	}
+	if f := failed(); len(f) != 2 || f[0].Service != "softlayer" || f[1].Service != "iaas" {
+		t.Fatalf("expected the failed SoftLayer request before the one of the operation, got %+v", f)
+	}
+	_, laterFailed := WithFailedRequests(context.Background(), sess)
+	if f := laterFailed(); len(f) != 0 {
+		t.Fatalf("expected no failed request, got %+v", f)
+	}
+}
+
+func TestFailedRequestID(t *testing.T) {
+	sent := map[string]string{}
+	c := &Config{HTTPTransport: failingTransport(false, sent), failedRequests: &failedRequestLog{}}
+	client := &http.Client{Transport: c.transport()}
+	ctx, failed := WithFailedRequests(context.Background(), &clientSession{config: c})
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://us-south.iaas.cloud.ibm.com/v1/missing", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if req.Header.Get("X-Request-Id") != "" {
+		t.Fatalf("expected the request of the client to be left unchanged")
+	}
+	f := failed()
+	if len(f) != 1 || f[0].RequestID == "" || f[0].RequestID != sent["/v1/missing"] {
+		t.Fatalf("expected the failed request with the ID %q it was sent with, got %+v", sent["/v1/missing"], f)
+	}
+
+	// The ID set by the client is kept.
+	req, _ = http.NewRequestWithContext(ctx, http.MethodGet, "https://us-south.iaas.cloud.ibm.com/v1/vpcs", nil)
+	req.Header.Set("X-Request-Id", "client-1")
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if sent["/v1/vpcs"] != "client-1" {
+		t.Fatalf("expected the request to be sent with the ID of the client, got %q", sent["/v1/vpcs"])
+	}
+}
+
+func TestFailedRequestLog(t *testing.T) {
+	l := &failedRequestLog{}
+	for i := 0; i < failedRequestLogSize+10; i++ {
+		l.record(&FailedRequest{StatusCode: i})
+	}
+	if f := l.since(l.last() - 2); len(f) != 2 || f[1].StatusCode != failedRequestLogSize+9 {
+		t.Fatalf("expected the last 2 failed requests, got %+v", f)
+	}
+	if f := l.since(0); len(f) != failedRequestLogSize || f[0].StatusCode != 10 {
+		t.Fatalf("expected the %d failed requests kept, got %d", failedRequestLogSize, len(f))
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+// FrameworkProvider is implemented by the provider of the resources and data
+// sources written with the Terraform plugin framework. It shares the
+// ClientSession configured by the SDKv2 provider, which handles the provider
+// block, so the resources get it from the provider they are created by:
+//
+//	func (t exampleResourceType) NewResource(ctx context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+//		return exampleResource{provider: p.(conns.FrameworkProvider)}, nil
+//	}
+//
+// and call ClientSession when they are applied, once the provider is
+// configured.
+type FrameworkProvider interface {
+	ClientSession() (ClientSession, error)
+}
@@ -0,0 +1,361 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+)
+
+// Locker holds the locks of IbmMutexKV across processes, so that the
+// Terraform runs of different workspaces changing the same resource, e.g. the
+// rules of a security group, are serialized too.
+type Locker interface {
+	// Lock acquires the lock of key, waiting for it until ctx is done.
+	Lock(ctx context.Context, key string) error
+	// Unlock releases the lock of key.
+	Unlock(key string) error
+}
+
+const (
+	// DefaultLockTimeout is how long a lock is waited for.
+	DefaultLockTimeout = 30 * time.Minute
+	// DefaultLockStaleAfter is how long a lock which is not refreshed by its
+	// owner is kept, before being considered abandoned by a crashed process.
+	DefaultLockStaleAfter = 5 * time.Minute
+
+	lockPollInterval = time.Second
+)
+
+// lockOwner identifies the locks of this process.
+var lockOwner = func() string {
+	host, _ := os.Hostname()
+	b := make([]byte, 4)
+	rand.Read(b)
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(b))
+}()
+
+// lockInfo is the content of a lock, telling who holds it.
+type lockInfo struct {
+	Owner   string    `json:"owner"`
+	Key     string    `json:"key"`
+	Expires time.Time `json:"expires,omitempty"`
+}
+
+// heartbeats refresh the locks held by a Locker until they are released.
+type heartbeats struct {
+	mu   sync.Mutex
+	stop map[string]chan struct{}
+}
+
+func (h *heartbeats) start(key string, interval time.Duration, refresh func() error) {
+	stop := make(chan struct{})
+	h.mu.Lock()
+	if h.stop == nil {
+		h.stop = map[string]chan struct{}{}
+	}
+	h.stop[key] = stop
+	h.mu.Unlock()
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := refresh(); err != nil {
+					log.Printf("[WARN] Error refreshing the lock of %q: %s", key, err)
+				}
+			}
+		}
+	}()
+}
+
+func (h *heartbeats) end(key string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if stop, ok := h.stop[key]; ok {
+		close(stop)
+		delete(h.stop, key)
+	}
+}
+
+// waitLock waits for the next attempt to acquire a lock.
+func waitLock(ctx context.Context, key string) error {
+	timer := time.NewTimer(lockPollInterval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("timeout while waiting for the lock of %q: %s", key, ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// FileLocker holds the locks as files of a directory, shared by the
+// processes of a host. The modification time of a lock file is refreshed
+// while the lock is held, a lock file older than StaleAfter is removed.
+type FileLocker struct {
+	Dir        string
+	StaleAfter time.Duration
+
+	heartbeats heartbeats
+}
+
+// NewFileLocker returns a FileLocker holding its locks in dir.
+func NewFileLocker(dir string, staleAfter time.Duration) (*FileLocker, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error creating the lock directory %s: %s", dir, err)
+	}
+	if staleAfter <= 0 {
+		staleAfter = DefaultLockStaleAfter
+	}
+	return &FileLocker{Dir: dir, StaleAfter: staleAfter}, nil
+}
+
+func (l *FileLocker) path(key string) string {
+	return filepath.Join(l.Dir, url.PathEscape(key)+".lock")
+}
+
+// Lock implements Locker.
+func (l *FileLocker) Lock(ctx context.Context, key string) error {
+	path := l.path(key)
+	content, _ := json.Marshal(lockInfo{Owner: lockOwner, Key: key})
+	for {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err == nil {
+			_, err = f.Write(content)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				os.Remove(path)
+				return err
+			}
+			l.heartbeats.start(key, l.StaleAfter/3, func() error {
+				now := time.Now()
+				return os.Chtimes(path, now, now)
+			})
+			return nil
+		}
+		if !os.IsExist(err) {
+			return err
+		}
+		l.removeStale(path)
+		if err := waitLock(ctx, key); err != nil {
+			return err
+		}
+	}
+}
+
+// removeStale removes the lock file at path if it was not refreshed within
+// StaleAfter. The file is renamed first, and put back if another process
+// acquired the lock in the meantime.
+func (l *FileLocker) removeStale(path string) {
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) < l.StaleAfter {
+		return
+	}
+	stale := fmt.Sprintf("%s.%s.stale", path, lockOwner)
+	if err := os.Rename(path, stale); err != nil {
+		return
+	}
+	if renamed, err := os.Stat(stale); err == nil && !os.SameFile(info, renamed) {
+		os.Rename(stale, path)
+		return
+	}
+	content, _ := ioutil.ReadFile(stale)
+	log.Printf("[WARN] Removing the stale lock %s, not refreshed since %s: %s", path, info.ModTime(), content)
+	os.Remove(stale)
+}
+
+// Unlock implements Locker.
+func (l *FileLocker) Unlock(key string) error {
+	l.heartbeats.end(key)
+	path := l.path(key)
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var info lockInfo
+	if json.Unmarshal(content, &info) != nil || info.Owner != lockOwner {
+		return fmt.Errorf("the lock %s is held by %s", path, content)
+	}
+	return os.Remove(path)
+}
+
+// COSLocker holds the locks as leases written to the objects of a COS
+// bucket. A lease is renewed while the lock is held, and expires StaleAfter
+// after its last renewal.
+//
+// COS has no conditional writes, a lease is acquired by writing it and
+// reading it back once the concurrent writes are settled: the last writer
+// gets the lease.
+type COSLocker struct {
+	Bucket     string
+	Prefix     string
+	StaleAfter time.Duration
+
+	client     func() (s3iface.S3API, error)
+	settle     time.Duration
+	heartbeats heartbeats
+}
+
+// NewCOSLocker returns a COSLocker holding its locks in bucket, with the
+// client returned by client, which is only called on the first lock.
+func NewCOSLocker(bucket, prefix string, staleAfter time.Duration, client func() (s3iface.S3API, error)) *COSLocker {
+	if staleAfter <= 0 {
+		staleAfter = DefaultLockStaleAfter
+	}
+	var once sync.Once
+	var s3Client s3iface.S3API
+	var err error
+	return &COSLocker{
+		Bucket:     bucket,
+		Prefix:     prefix,
+		StaleAfter: staleAfter,
+		client: func() (s3iface.S3API, error) {
+			once.Do(func() { s3Client, err = client() })
+			return s3Client, err
+		},
+		settle: 2 * time.Second,
+	}
+}
+
+// NewCOSLockerClient returns the S3 client of a COSLocker, authenticated
+// with the credentials of the Bluemix session.
+func NewCOSLockerClient(bxSession *bxsession.Session, endpoint, instanceCRN string) (s3iface.S3API, error) {
+	authEndpoint, err := bxSession.Config.EndpointLocator.IAMEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	if bxSession.Config.BluemixAPIKey == "" {
+		return nil, fmt.Errorf("[ERROR] The COS lock backend requires an IBM Cloud API key")
+	}
+	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
+	httpClient := bxSession.Config.HTTPClient
+	creds := ibmiam.NewStaticCredentials(aws.NewConfig().WithHTTPClient(httpClient), authEndpointPath, bxSession.Config.BluemixAPIKey, instanceCRN)
+	s3Conf := aws.NewConfig().WithHTTPClient(httpClient).WithEndpoint(endpoint).WithCredentials(creds).WithS3ForcePathStyle(true)
+	return s3.New(session.Must(session.NewSession()), s3Conf), nil
+}
+
+func (l *COSLocker) objectKey(key string) string {
+	return l.Prefix + url.PathEscape(key)
+}
+
+// get returns the lease of key, nil if there is none.
+func (l *COSLocker) get(ctx context.Context, client s3iface.S3API, key string) (*lockInfo, error) {
+	out, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(l.Bucket),
+		Key:    aws.String(l.objectKey(key)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer out.Body.Close()
+	var info lockInfo
+	if err := json.NewDecoder(out.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("invalid lease of %q: %s", key, err)
+	}
+	return &info, nil
+}
+
+func (l *COSLocker) put(ctx context.Context, client s3iface.S3API, key string) error {
+	content, _ := json.Marshal(lockInfo{Owner: lockOwner, Key: key, Expires: time.Now().Add(l.StaleAfter)})
+	_, err := client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(l.Bucket),
+		Key:         aws.String(l.objectKey(key)),
+		Body:        bytes.NewReader(content),
+		ContentType: aws.String("application/json"),
+	})
+	return err
+}
+
+// Lock implements Locker.
+func (l *COSLocker) Lock(ctx context.Context, key string) error {
+	client, err := l.client()
+	if err != nil {
+		return err
+	}
+	for {
+		info, err := l.get(ctx, client, key)
+		if err != nil {
+			return err
+		}
+		if info != nil && info.Owner != lockOwner && time.Now().After(info.Expires) {
+			log.Printf("[WARN] Taking over the lease of %q held by %s, expired at %s", key, info.Owner, info.Expires)
+			info = nil
+		}
+		if info == nil || info.Owner == lockOwner {
+			if err := l.put(ctx, client, key); err != nil {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(l.settle):
+			}
+			if info, err = l.get(ctx, client, key); err != nil {
+				return err
+			}
+			if info != nil && info.Owner == lockOwner {
+				l.heartbeats.start(key, l.StaleAfter/3, func() error {
+					return l.put(context.Background(), client, key)
+				})
+				return nil
+			}
+		}
+		if err := waitLock(ctx, key); err != nil {
+			return err
+		}
+	}
+}
+
+// Unlock implements Locker.
+func (l *COSLocker) Unlock(key string) error {
+	l.heartbeats.end(key)
+	client, err := l.client()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	info, err := l.get(ctx, client, key)
+	if err != nil {
+		return err
+	}
+	if info == nil || info.Owner != lockOwner {
+		owner := "nobody"
+		if info != nil {
+			owner = info.Owner
+		}
+		return fmt.Errorf("the lease of %q is held by %s", key, owner)
+	}
+	_, err = client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(l.Bucket),
+		Key:    aws.String(l.objectKey(key)),
+	})
+	return err
+}
@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+)
+
+func TestFileLocker(t *testing.T) {
+	dir := t.TempDir()
+	locker, err := NewFileLocker(dir, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := locker.Lock(context.Background(), "sg/r006-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Another process waits for the lock until its timeout.
+	other := &FileLocker{Dir: dir, StaleAfter: time.Minute}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := other.Lock(ctx, "sg/r006-1"); err == nil {
+		t.Fatal("expected a timeout while the lock is held")
+	}
+	if err := other.Lock(context.Background(), "sg/r006-2"); err != nil {
+		t.Fatalf("expected the lock of another key to be acquired: %s", err)
+	}
+
+	if err := locker.Unlock("sg/r006-1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Lock(context.Background(), "sg/r006-1"); err != nil {
+		t.Fatalf("expected the released lock to be acquired: %s", err)
+	}
+}
+
+func TestFileLockerStale(t *testing.T) {
+	locker, err := NewFileLocker(t.TempDir(), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := locker.path("sg/r006-1")
+	if err := ioutil.WriteFile(path, []byte(`{"owner":"crashed"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * time.Minute)
+	os.Chtimes(path, old, old)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := locker.Lock(ctx, "sg/r006-1"); err != nil {
+		t.Fatalf("expected the stale lock to be taken over: %s", err)
+	}
+	if err := locker.Unlock("sg/r006-1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// fakeS3 stores the objects of a bucket in memory.
+type fakeS3 struct {
+	s3iface.S3API
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) GetObjectWithContext(ctx aws.Context, in *s3.GetObjectInput, opts ...request.Option) (*s3.GetObjectOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	content, ok := f.objects[*in.Key]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchKey, "not found", nil)
+	}
+	return &s3.GetObjectOutput{Body: ioutil.NopCloser(bytes.NewReader(content))}, nil
+}
+
+func (f *fakeS3) PutObjectWithContext(ctx aws.Context, in *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+	content, _ := ioutil.ReadAll(in.Body)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.objects[*in.Key] = content
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeS3) DeleteObjectWithContext(ctx aws.Context, in *s3.DeleteObjectInput, opts ...request.Option) (*s3.DeleteObjectOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.objects, *in.Key)
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func TestCOSLocker(t *testing.T) {
+	bucket := &fakeS3{objects: map[string][]byte{
+		"locks/expired": []byte(`{"owner":"crashed","expires":"2022-01-01T00:00:00Z"}`),
+		"locks/held":    []byte(`{"owner":"other","expires":"2100-01-01T00:00:00Z"}`),
+	}}
+	locker := NewCOSLocker("bucket", "locks/", time.Minute, func() (s3iface.S3API, error) {
+		return bucket, nil
+	})
+	locker.settle = 0
+
+	if err := locker.Lock(context.Background(), "expired"); err != nil {
+		t.Fatalf("expected the expired lease to be taken over: %s", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := locker.Lock(ctx, "held"); err == nil {
+		t.Fatal("expected a timeout while the lease is held")
+	}
+	if err := locker.Unlock("expired"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := bucket.objects["locks/expired"]; ok {
+		t.Fatal("expected the lease to be deleted")
+	}
+}
+
+func TestMutexKVLocker(t *testing.T) {
+	locker, err := NewFileLocker(t.TempDir(), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := &FileLocker{Dir: locker.Dir, StaleAfter: time.Minute}
+	if err := other.Lock(context.Background(), "lb"); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewMutexKV().WithLocker(locker, 100*time.Millisecond)
+	if err := m.Lock(context.Background(), "lb"); err == nil {
+		t.Fatal("expected a timeout while another process holds the lock")
+	}
+	// The in-process mutex is released on error.
+	other.Unlock("lb")
+	if err := m.Lock(context.Background(), "lb"); err != nil {
+		t.Fatal(err)
+	}
+	m.Unlock("lb")
+}
+
+func TestMutexKVWithLocker(t *testing.T) {
+	m := NewMutexKV()
+	if err := m.Lock(context.Background(), "sg"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Another provider configuration shares the locks of the process, without
+	// changing the locker of m.
+	locker, err := NewFileLocker(t.TempDir(), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := m.WithLocker(locker, 100*time.Millisecond)
+	if m.locker != nil {
+		t.Fatal("expected the locker of m to be unset")
+	}
+	if err := other.Lock(context.Background(), "sg"); err == nil {
+		t.Fatal("expected a timeout while the lock is held in process")
+	}
+	m.Unlock("sg")
+	if err := other.Lock(context.Background(), "sg"); err != nil {
+		t.Fatal(err)
+	}
+	other.Unlock("sg")
+}
+
+func TestMutexKVLockCancel(t *testing.T) {
+	m := NewMutexKV()
+	if err := m.Lock(context.Background(), "sg"); err != nil {
+		t.Fatal(err)
+	}
+	defer m.Unlock("sg")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- m.Lock(ctx, "sg")
+	}()
+	cancel()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("expected the wait to be cancelled")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the wait to end when the context is cancelled")
+	}
+}
@@ -4,67 +4,100 @@
 package conns
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"sync"
+	"time"
 )
 
 // MutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
-// keys they must serialize on.
-//
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
+// keys they must serialize on, across processes with a Locker.
 //
 // The initial use case is to let aws_security_group_rule resources serialize
 // their access to individual security groups based on SG ID.
 
-// This is a global MutexKV for use within this plugin.
+// This is a global MutexKV for use within this plugin. It holds the locks in
+// process only, the ClientSession of a provider configuration with a lock
+// block returns a MutexKV sharing them which holds them across processes too.
 var IbmMutexKV = NewMutexKV()
 
 type MutexKV struct {
+	// keys are the in-process locks, shared by the MutexKVs returned by
+	// WithLocker.
+	keys *keyMutexes
+
+	// locker, when set, holds the locks across processes as well.
+	locker  Locker
+	timeout time.Duration
+}
+
+type keyMutexes struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]chan struct{}
+}
+
+// WithLocker returns a MutexKV sharing the in-process locks of m, which also
+// holds them across processes with locker, if set, and waits at most timeout
+// for each of them, if set.
+func (m *MutexKV) WithLocker(locker Locker, timeout time.Duration) *MutexKV {
+	return &MutexKV{keys: m.keys, locker: locker, timeout: timeout}
 }
 
 // Lock the mutex for the given key. Caller is responsible for calling Unlock
-// for the same key
-//
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
-func (m *MutexKV) Lock(key string) {
+// for the same key, unless an error is returned: the lock could not be
+// acquired before ctx is done or the timeout of m.
+func (m *MutexKV) Lock(ctx context.Context, key string) error {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
+	if m.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.timeout)
+		defer cancel()
+	}
+	mutex := m.get(key)
+	select {
+	case mutex <- struct{}{}:
+	case <-ctx.Done():
+		return fmt.Errorf("[ERROR] Error locking %q: %s", key, ctx.Err())
+	}
+	if m.locker != nil {
+		if err := m.locker.Lock(ctx, key); err != nil {
+			<-mutex
+			return fmt.Errorf("[ERROR] Error locking %q: %s", key, err)
+		}
+	}
 	log.Printf("[DEBUG] Locked %q", key)
+	return nil
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
-//
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
 func (m *MutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+	if m.locker != nil {
+		if err := m.locker.Unlock(key); err != nil {
+			log.Printf("[WARN] Error unlocking %q: %s", key, err)
+		}
+	}
+	<-m.get(key)
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.Mutex {
-	m.lock.Lock()
-	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+func (m *MutexKV) get(key string) chan struct{} {
+	m.keys.lock.Lock()
+	defer m.keys.lock.Unlock()
+	mutex, ok := m.keys.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		mutex = make(chan struct{}, 1)
+		m.keys.store[key] = mutex
 	}
 	return mutex
 }
 
 // NewMutexKV Returns a properly initalized MutexKV
-//
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
 func NewMutexKV() *MutexKV {
 	return &MutexKV{
-		store: make(map[string]*sync.Mutex),
+		keys: &keyMutexes{store: make(map[string]chan struct{})},
 	}
 }
@@ -3,6 +3,7 @@
 package conns
 
 import (
+	"context"
 	"testing"
 	"time"
 )
@@ -10,12 +11,12 @@ import (
 func TestMutexKVLock(t *testing.T) {
 	mkv := NewMutexKV()
 
-	mkv.Lock("foo")
+	mkv.Lock(context.Background(), "foo")
 
 	doneCh := make(chan struct{})
 
 	go func() {
-		mkv.Lock("foo")
+		mkv.Lock(context.Background(), "foo")
 		close(doneCh)
 	}()
 
@@ -30,13 +31,13 @@ func TestMutexKVLock(t *testing.T) {
 func TestMutexKVUnlock(t *testing.T) {
 	mkv := NewMutexKV()
 
-	mkv.Lock("foo")
+	mkv.Lock(context.Background(), "foo")
 	mkv.Unlock("foo")
 
 	doneCh := make(chan struct{})
 
 	go func() {
-		mkv.Lock("foo")
+		mkv.Lock(context.Background(), "foo")
 		close(doneCh)
 	}()
 
@@ -51,12 +52,12 @@ func TestMutexKVUnlock(t *testing.T) {
 func TestMutexKVDifferentKeys(t *testing.T) {
 	mkv := NewMutexKV()
 
-	mkv.Lock("foo")
+	mkv.Lock(context.Background(), "foo")
 
 	doneCh := make(chan struct{})
 
 	go func() {
-		mkv.Lock("bar")
+		mkv.Lock(context.Background(), "bar")
 		close(doneCh)
 	}()
 
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// NetworkConfig holds the proxy and TLS settings applied to the requests of
+// every client.
+type NetworkConfig struct {
+	// ProxyURL is the proxy of the requests, instead of the proxy of the
+	// HTTP_PROXY and HTTPS_PROXY environment variables. The hosts of the
+	// NO_PROXY environment variable are still reached directly.
+	ProxyURL string
+	// CABundleFile is a PEM file of the certificate authorities trusted in
+	// addition to the ones of the system, e.g. the one of a TLS intercepting
+	// proxy.
+	CABundleFile string
+	// ClientCertFile and ClientKeyFile are the PEM files of the certificate
+	// and key the clients authenticate with when the server asks for one.
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+// network is the result of loading a NetworkConfig.
+type network struct {
+	proxy     func(*gohttp.Request) (*url.URL, error)
+	tlsConfig func(*tls.Config) *tls.Config
+}
+
+// load checks the settings and reads the files they refer to. It returns nil
+// if the default settings are used.
+func (n NetworkConfig) load() (*network, error) {
+	if n == (NetworkConfig{}) {
+		return nil, nil
+	}
+	loaded := &network{}
+
+	if n.ProxyURL != "" {
+		u, err := url.Parse(n.ProxyURL)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("[ERROR] Error parsing the proxy URL %q, expected a URL such as http://proxy.example.com:3128", n.ProxyURL)
+		}
+		proxyFunc := (&httpproxy.Config{
+			HTTPProxy:  n.ProxyURL,
+			HTTPSProxy: n.ProxyURL,
+			NoProxy:    EnvFallBack([]string{"NO_PROXY", "no_proxy"}, ""),
+		}).ProxyFunc()
+		loaded.proxy = func(req *gohttp.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+	}
+
+	var rootCAs *x509.CertPool
+	if n.CABundleFile != "" {
+		pem, err := ioutil.ReadFile(n.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error reading the CA bundle: %s", err)
+		}
+		rootCAs, err = x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("[ERROR] Error reading the CA bundle %s: no PEM certificate found", n.CABundleFile)
+		}
+	}
+
+	var certificates []tls.Certificate
+	if n.ClientCertFile != "" || n.ClientKeyFile != "" {
+		if n.ClientCertFile == "" || n.ClientKeyFile == "" {
+			return nil, fmt.Errorf("[ERROR] The client certificate and the client key must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(n.ClientCertFile, n.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error loading the client certificate: %s", err)
+		}
+		certificates = []tls.Certificate{cert}
+	}
+
+	if rootCAs != nil || certificates != nil {
+		loaded.tlsConfig = func(base *tls.Config) *tls.Config {
+			config := &tls.Config{}
+			if base != nil {
+				config = base.Clone()
+			}
+			if rootCAs != nil {
+				config.RootCAs = rootCAs
+			}
+			if certificates != nil {
+				config.Certificates = certificates
+			}
+			return config
+		}
+	}
+	return loaded, nil
+}
+
+// transport returns a copy of base with the proxy and TLS settings applied.
+// Round trippers other than an http.Transport are returned unchanged.
+func (n *network) transport(base gohttp.RoundTripper) gohttp.RoundTripper {
+	t, ok := base.(*gohttp.Transport)
+	if n == nil || !ok {
+		return base
+	}
+	t = t.Clone()
+	if n.proxy != nil {
+		t.Proxy = n.proxy
+	}
+	if n.tlsConfig != nil {
+		t.TLSClientConfig = n.tlsConfig(t.TLSClientConfig)
+	}
+	return t
+}
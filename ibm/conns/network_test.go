@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeClientCert writes a self-signed client certificate and its key to dir.
+func writeClientCert(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestNetworkTLS(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	if err := ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := writeClientCert(t, dir)
+
+	// The certificate of the server isn't trusted without the CA bundle.
+	if _, err := (&http.Client{Transport: (&Config{}).transport()}).Get(server.URL); err == nil {
+		t.Fatalf("expected the certificate of the server not to be trusted")
+	}
+
+	c := &Config{Network: NetworkConfig{CABundleFile: caFile, ClientCertFile: certFile, ClientKeyFile: keyFile}}
+	network, err := c.Network.load()
+	if err != nil {
+		t.Fatalf("load failed: %s", err)
+	}
+	c.network = network
+	for name, client := range map[string]*http.Client{
+		"transport":  {Transport: c.transport()},
+		"httpClient": c.httpClient(),
+		"softlayer":  c.softlayerHTTPClient(),
+	} {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("%s: request failed: %s", name, err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != "terraform" {
+			t.Errorf("%s: expected the client certificate to be sent, got %d %q", name, resp.StatusCode, body)
+		}
+	}
+}
+
+func TestNetworkProxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+	}))
+	defer proxy.Close()
+	setTestEnv(t, "NO_PROXY", "direct.example.com")
+
+	c := &Config{Network: NetworkConfig{ProxyURL: proxy.URL}}
+	network, err := c.Network.load()
+	if err != nil {
+		t.Fatalf("load failed: %s", err)
+	}
+	c.network = network
+	client := &http.Client{Transport: c.transport()}
+	resp, err := client.Get("http://api.example.com/v1/resources")
+	if err != nil {
+		t.Fatalf("request failed: %s", err)
+	}
+	resp.Body.Close()
+	if len(proxied) != 1 || proxied[0] != "http://api.example.com/v1/resources" {
+		t.Fatalf("expected the request to be sent to the proxy, got %v", proxied)
+	}
+
+	proxyURL, err := network.proxy(httptestRequest(t, "https://direct.example.com/"))
+	if err != nil || proxyURL != nil {
+		t.Fatalf("expected the NO_PROXY hosts to be reached directly, got %v, %v", proxyURL, err)
+	}
+}
+
+func httptestRequest(t *testing.T, url string) *http.Request {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func TestNetworkConfigInvalid(t *testing.T) {
+	dir := t.TempDir()
+	certFile, _ := writeClientCert(t, dir)
+	notPEM := filepath.Join(dir, "ca.txt")
+	if err := ioutil.WriteFile(notPEM, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]NetworkConfig{
+		"Error parsing the proxy URL":          {ProxyURL: "proxy.example.com:3128"},
+		"Error reading the CA bundle":          {CABundleFile: filepath.Join(dir, "missing.pem")},
+		"no PEM certificate found":             {CABundleFile: notPEM},
+		"must be set together":                 {ClientCertFile: certFile},
+		"Error loading the client certificate": {ClientCertFile: certFile, ClientKeyFile: certFile},
+	}
+	for want, n := range cases {
+		if _, err := n.load(); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected an error containing %q, got %v", want, err)
+		}
+	}
+	if network, err := (NetworkConfig{}).load(); network != nil || err != nil {
+		t.Errorf("expected the default settings, got %v, %v", network, err)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfile is the profile used when none is configured.
+const DefaultProfile = "default"
+
+// Profile holds the credentials and settings of the provider read from a
+// shared config file. They only apply to the arguments which are neither set
+// in the provider block nor in the environment.
+type Profile struct {
+	IBMCloudAPIKey      string `json:"ibmcloud_api_key"`
+	IAMToken            string `json:"iam_token"`
+	IAMRefreshToken     string `json:"iam_refresh_token"`
+	IAMProfileID        string `json:"iam_profile_id"`
+	Region              string `json:"region"`
+	Zone                string `json:"zone"`
+	ResourceGroup       string `json:"resource_group"`
+	IAASClassicUsername string `json:"iaas_classic_username"`
+	IAASClassicAPIKey   string `json:"iaas_classic_api_key"`
+}
+
+// credentialsFile is the provider credentials file, holding named profiles.
+//
+//	{
+//	  "profiles": {
+//	    "default": {"ibmcloud_api_key": "...", "region": "us-south"},
+//	    "staging": {"ibmcloud_api_key": "...", "region": "eu-de"}
+//	  }
+//	}
+type credentialsFile struct {
+	Profiles map[string]Profile `json:"profiles"`
+}
+
+// cliConfig is the part of the IBM Cloud CLI config file, usually
+// ~/.bluemix/config.json, holding the session of the logged in user.
+type cliConfig struct {
+	IAMToken        string `json:"IAMToken"`
+	IAMRefreshToken string `json:"IAMRefreshToken"`
+	Region          string `json:"Region"`
+	ResourceGroup   struct {
+		GUID string `json:"GUID"`
+	} `json:"ResourceGroup"`
+}
+
+// DefaultSharedConfigFile returns the path of the provider credentials file,
+// ~/.ibmcloud/credentials.json.
+func DefaultSharedConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".ibmcloud", "credentials.json")
+}
+
+// DefaultCLIConfigFile returns the path of the IBM Cloud CLI config file,
+// under IBMCLOUD_HOME if it is set.
+func DefaultCLIConfigFile() string {
+	home := os.Getenv("IBMCLOUD_HOME")
+	if home == "" {
+		var err error
+		if home, err = os.UserHomeDir(); err != nil {
+			return ""
+		}
+	}
+	return filepath.Join(home, ".bluemix", "config.json")
+}
+
+// LoadProfile reads a profile from a shared config file, either a provider
+// credentials file or an IBM Cloud CLI config file, which only holds the
+// default profile. When file is empty, the provider credentials file is read
+// if it exists, otherwise the CLI config file. Without a file nor a profile
+// name, nothing is read and a nil profile is returned.
+func LoadProfile(file, name string) (*Profile, error) {
+	if file == "" && name == "" {
+		return nil, nil
+	}
+	if file == "" {
+		file = DefaultSharedConfigFile()
+		if _, err := os.Stat(file); os.IsNotExist(err) {
+			file = DefaultCLIConfigFile()
+		}
+	}
+	content, err := ioutil.ReadFile(expandHome(file))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading the shared config file %s: %s", file, err)
+	}
+	return parseProfile(file, content, name)
+}
+
+func parseProfile(file string, content []byte, name string) (*Profile, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(content, &fields); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing the shared config file %s: %s", file, err)
+	}
+	if _, ok := fields["profiles"]; !ok {
+		if name != "" && name != DefaultProfile {
+			return nil, fmt.Errorf("[ERROR] The IBM Cloud CLI config file %s only has the %q profile, not %q", file, DefaultProfile, name)
+		}
+		var config cliConfig
+		if err := json.Unmarshal(content, &config); err != nil {
+			return nil, fmt.Errorf("[ERROR] Error parsing the IBM Cloud CLI config file %s: %s", file, err)
+		}
+		return &Profile{
+			IAMToken:        config.IAMToken,
+			IAMRefreshToken: config.IAMRefreshToken,
+			Region:          config.Region,
+			ResourceGroup:   config.ResourceGroup.GUID,
+		}, nil
+	}
+
+	var credentials credentialsFile
+	if err := json.Unmarshal(content, &credentials); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing the shared config file %s: %s", file, err)
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+	if profile, ok := credentials.Profiles[name]; ok {
+		return &profile, nil
+	}
+	return nil, fmt.Errorf("[ERROR] Profile %q not found in the shared config file %s", name, file)
+}
+
+func expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, strings.TrimPrefix(path, "~"))
+		}
+	}
+	return path
+}
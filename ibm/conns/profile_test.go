@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadProfile(t *testing.T) {
+	dir := t.TempDir()
+	credentials := filepath.Join(dir, "credentials.json")
+	ioutil.WriteFile(credentials, []byte(`{
+		"profiles": {
+			"default": {"ibmcloud_api_key": "default-key", "region": "us-south"},
+			"staging": {"ibmcloud_api_key": "staging-key", "region": "eu-de", "resource_group": "rg-1"}
+		}
+	}`), 0600)
+	cli := filepath.Join(dir, "config.json")
+	ioutil.WriteFile(cli, []byte(`{
+		"IAMToken": "Bearer cli-token",
+		"IAMRefreshToken": "cli-refresh-token",
+		"Region": "jp-tok",
+		"ResourceGroup": {"GUID": "rg-2", "Name": "default"}
+	}`), 0600)
+
+	if p, err := LoadProfile("", ""); p != nil || err != nil {
+		t.Fatalf("expected nothing to be read without a file nor a profile, got %+v, %v", p, err)
+	}
+
+	p, err := LoadProfile(credentials, "staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.IBMCloudAPIKey != "staging-key" || p.Region != "eu-de" || p.ResourceGroup != "rg-1" {
+		t.Fatalf("unexpected profile %+v", p)
+	}
+	if p, err = LoadProfile(credentials, ""); err != nil || p.IBMCloudAPIKey != "default-key" {
+		t.Fatalf("expected the default profile, got %+v, %v", p, err)
+	}
+	if _, err = LoadProfile(credentials, "prod"); err == nil {
+		t.Fatal("expected an error for an unknown profile")
+	}
+
+	p, err = LoadProfile(cli, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.IAMToken != "Bearer cli-token" || p.IAMRefreshToken != "cli-refresh-token" || p.Region != "jp-tok" || p.ResourceGroup != "rg-2" {
+		t.Fatalf("unexpected CLI profile %+v", p)
+	}
+	if _, err = LoadProfile(cli, "staging"); err == nil {
+		t.Fatal("expected an error for a named profile of the CLI config")
+	}
+
+	// Without a file, the provider credentials file is read, otherwise the
+	// CLI config file.
+	home := t.TempDir()
+	setTestEnv(t, "HOME", home)
+	setTestEnv(t, "IBMCLOUD_HOME", "")
+	if _, err = LoadProfile("", "default"); err == nil {
+		t.Fatal("expected an error without any shared config file")
+	}
+	os.MkdirAll(filepath.Join(home, ".bluemix"), 0700)
+	os.Rename(cli, filepath.Join(home, ".bluemix", "config.json"))
+	if p, err = LoadProfile("", "default"); err != nil || p.Region != "jp-tok" {
+		t.Fatalf("expected the CLI profile, got %+v, %v", p, err)
+	}
+	os.MkdirAll(filepath.Join(home, ".ibmcloud"), 0700)
+	os.Rename(credentials, filepath.Join(home, ".ibmcloud", "credentials.json"))
+	if p, err = LoadProfile("", "staging"); err != nil || p.Region != "eu-de" {
+		t.Fatalf("expected the staging profile, got %+v, %v", p, err)
+	}
+}
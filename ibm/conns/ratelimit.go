@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"log"
+	"math"
+	gohttp "net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit limits the requests sent to the API hosts matching Host, a host
+// name or a domain, e.g. iaas.cloud.ibm.com for the VPC API of every region.
+// Each matching host gets its own token bucket, filled with RequestsPerSecond
+// tokens per second up to Burst, and at most MaxInFlight requests in flight.
+// A zero RequestsPerSecond or MaxInFlight leaves it unlimited.
+type RateLimit struct {
+	Host              string
+	RequestsPerSecond float64
+	Burst             int
+	MaxInFlight       int
+}
+
+// matches returns the length of the host, or domain, of the limit which
+// matches host, or -1.
+func (l RateLimit) matches(host string) int {
+	limitHost := strings.ToLower(strings.TrimPrefix(l.Host, "."))
+	host = strings.ToLower(host)
+	if host == limitHost || strings.HasSuffix(host, "."+limitHost) {
+		return len(limitHost)
+	}
+	return -1
+}
+
+// rateLimiter holds the limiters of the hosts the clients of a session send
+// requests to, shared by all of them.
+type rateLimiter struct {
+	limits []RateLimit
+
+	mu    sync.Mutex
+	hosts map[string]*hostLimiter
+}
+
+func newRateLimiter(limits []RateLimit) *rateLimiter {
+	return &rateLimiter{
+		limits: limits,
+		hosts:  map[string]*hostLimiter{},
+	}
+}
+
+// forHost returns the limiter of a host, configured by the most specific
+// limit matching it, or nil when no limit applies.
+func (l *rateLimiter) forHost(host string) *hostLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if h, ok := l.hosts[host]; ok {
+		return h
+	}
+	var limit *RateLimit
+	best := -1
+	for i := range l.limits {
+		if n := l.limits[i].matches(host); n > best {
+			limit, best = &l.limits[i], n
+		}
+	}
+	var h *hostLimiter
+	if limit != nil {
+		h = newHostLimiter(host, *limit)
+	}
+	l.hosts[host] = h
+	return h
+}
+
+// hostLimiter is the token bucket and the in-flight cap of a host.
+type hostLimiter struct {
+	host     string
+	rate     float64
+	burst    float64
+	inFlight chan struct{}
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newHostLimiter(host string, limit RateLimit) *hostLimiter {
+	h := &hostLimiter{
+		host:  host,
+		rate:  limit.RequestsPerSecond,
+		burst: float64(limit.Burst),
+		last:  time.Now(),
+	}
+	if h.burst < 1 {
+		h.burst = math.Max(1, math.Ceil(h.rate))
+	}
+	h.tokens = h.burst
+	if limit.MaxInFlight > 0 {
+		h.inFlight = make(chan struct{}, limit.MaxInFlight)
+	}
+	return h
+}
+
+// acquire waits for a request slot and a token, and returns the function
+// releasing the slot once the request is done.
+func (h *hostLimiter) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+	if h.inFlight != nil {
+		select {
+		case h.inFlight <- struct{}{}:
+		default:
+			log.Printf("[DEBUG] Waiting for one of the %d requests in flight to %s", cap(h.inFlight), h.host)
+			select {
+			case h.inFlight <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		var once sync.Once
+		release = func() {
+			once.Do(func() { <-h.inFlight })
+		}
+	}
+	if err := h.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// wait takes a token from the bucket, waiting for it to be refilled if it
+// is empty.
+func (h *hostLimiter) wait(ctx context.Context) error {
+	if h.rate <= 0 {
+		return nil
+	}
+	h.mu.Lock()
+	now := time.Now()
+	h.tokens = math.Min(h.burst, h.tokens+now.Sub(h.last).Seconds()*h.rate)
+	h.last = now
+	h.tokens--
+	var delay time.Duration
+	if h.tokens < 0 {
+		delay = time.Duration(-h.tokens / h.rate * float64(time.Second))
+	}
+	h.mu.Unlock()
+	if delay == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Rate limiting the requests to %s, waiting %s", h.host, delay)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Give the token back, the request is not sent.
+		h.mu.Lock()
+		h.tokens++
+		h.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+type rateLimitTransport struct {
+	base    gohttp.RoundTripper
+	limiter *rateLimiter
+}
+
+// RoundTrip implements http.RoundTripper. A request counts as in flight until
+// its response body is closed.
+func (t *rateLimitTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	h := t.limiter.forHost(req.URL.Hostname())
+	if h == nil {
+		return t.base.RoundTrip(req)
+	}
+	release, err := h.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &releaseBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseBody releases the slot of a request once its body is read or closed.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == io.EOF {
+		b.release()
+	}
+	return n, err
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
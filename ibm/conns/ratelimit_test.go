@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterHosts(t *testing.T) {
+	limiter := newRateLimiter([]RateLimit{
+		{Host: "cloud.ibm.com", RequestsPerSecond: 100},
+		{Host: "iaas.cloud.ibm.com", RequestsPerSecond: 10},
+	})
+	cases := map[string]float64{
+		"us-south.iaas.cloud.ibm.com": 10,
+		"iaas.cloud.ibm.com":          10,
+		"api.cis.cloud.ibm.com":       100,
+	}
+	for host, rate := range cases {
+		h := limiter.forHost(host)
+		if h == nil || h.rate != rate {
+			t.Fatalf("%s: expected a rate of %v, got %+v", host, rate, h)
+		}
+	}
+	if limiter.forHost("us-south.iaas.cloud.ibm.com") == limiter.forHost("eu-de.iaas.cloud.ibm.com") {
+		t.Fatal("expected each host to be limited separately")
+	}
+	if h := limiter.forHost("api.softlayer.com"); h != nil {
+		t.Fatalf("expected no limit, got %+v", h)
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	limiter := newRateLimiter([]RateLimit{{Host: "127.0.0.1", RequestsPerSecond: 50, Burst: 2, MaxInFlight: 2}})
+	client := &http.Client{Transport: &rateLimitTransport{base: http.DefaultTransport, limiter: limiter}}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+	// The burst covers 2 requests, the 4 others wait for a token every 20ms.
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Fatalf("expected the requests to be rate limited, took %s", elapsed)
+	}
+}
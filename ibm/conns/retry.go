@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	gohttp "net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultRetryMinDelay is the delay before the first retry of a request.
+	DefaultRetryMinDelay = 1 * time.Second
+	// DefaultRetryMaxDelay is the longest delay between two retries.
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// defaultRetryStatuses are the status codes of the transient errors retried
+// for the idempotent requests.
+var defaultRetryStatuses = []int{408, 429, 500, 502, 503, 504, 520, 599}
+
+// softlayerRetryStatuses are the ones retried for the SoftLayer API, which
+// reports most of its errors, transient or not, as 500.
+var softlayerRetryStatuses = []int{429, 502, 503, 504}
+
+// RetryPolicy is the retry policy of the requests sent by every client of the
+// provider. The delay between two attempts grows exponentially from MinDelay
+// up to MaxDelay, with jitter, unless the response tells how long to wait
+// with a Retry-After header, which is capped at MaxDelay.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a failed request is retried.
+	MaxRetries int
+	MinDelay   time.Duration
+	MaxDelay   time.Duration
+	// Statuses are the status codes retried, defaultRetryStatuses if empty.
+	Statuses []int
+}
+
+// delays returns the minimum and maximum delays of the policy, with their
+// defaults applied.
+func (p RetryPolicy) delays() (minDelay, maxDelay time.Duration) {
+	minDelay, maxDelay = p.MinDelay, p.MaxDelay
+	if minDelay <= 0 {
+		minDelay = DefaultRetryMinDelay
+	}
+	if maxDelay < minDelay {
+		maxDelay = DefaultRetryMaxDelay
+		if maxDelay < minDelay {
+			maxDelay = minDelay
+		}
+	}
+	return minDelay, maxDelay
+}
+
+// Backoff returns the delay before the given retry, starting from 0.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	minDelay, maxDelay := p.delays()
+	delay := maxDelay
+	if retry < 32 {
+		if d := minDelay << uint(retry); d > 0 && d < maxDelay {
+			delay = d
+		}
+	}
+	// Equal jitter keeps at least half of the delay so that the retries of
+	// concurrent requests spread out without hammering the API.
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// RetryableStatus tells whether a response with the given status code is
+// retried by the policy.
+func (p RetryPolicy) RetryableStatus(code int) bool {
+	statuses := p.Statuses
+	if len(statuses) == 0 {
+		statuses = defaultRetryStatuses
+	}
+	for _, s := range statuses {
+		if s == code {
+			return true
+		}
+	}
+	return false
+}
+
+// retryAfter returns the delay asked by the Retry-After header of a response,
+// given either in seconds or as an HTTP date.
+func retryAfter(resp *gohttp.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := gohttp.ParseTime(v); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// NewRetryTransport returns a round tripper sending the requests with base
+// and retrying them according to policy. A non zero timeout bounds each
+// attempt rather than the whole request.
+func NewRetryTransport(base gohttp.RoundTripper, policy RetryPolicy, timeout time.Duration) gohttp.RoundTripper {
+	if base == nil {
+		base = gohttp.DefaultTransport
+	}
+	return &retryTransport{base: base, policy: policy, timeout: timeout}
+}
+
+type retryTransport struct {
+	base    gohttp.RoundTripper
+	policy  RetryPolicy
+	timeout time.Duration
+}
+
+// RoundTrip implements http.RoundTripper. The requests which may have been
+// processed are only retried when they are idempotent, a POST or PATCH is
+// retried on 429 and 503 and when the connection could not be established.
+// The requests whose body cannot be read again are sent once.
+func (t *retryTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	replayable := req.Body == nil || req.Body == gohttp.NoBody || req.GetBody != nil
+	idempotent := isIdempotent(req)
+	for retry := 0; ; retry++ {
+		r := req
+		if retry > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+		resp, err := t.send(r)
+
+		if !replayable || retry >= t.policy.MaxRetries || req.Context().Err() != nil {
+			return resp, err
+		}
+		var delay time.Duration
+		switch {
+		case err != nil:
+			if !retryableError(err, idempotent) {
+				return resp, err
+			}
+			delay = t.policy.Backoff(retry)
+			log.Printf("[DEBUG] Retrying %s %s in %s after error: %s", req.Method, req.URL.Redacted(), delay, err)
+		case t.policy.RetryableStatus(resp.StatusCode) && (idempotent || resp.StatusCode == 429 || resp.StatusCode == 503):
+			delay = t.policy.Backoff(retry)
+			// The delay asked by the response is capped so that a
+			// request doesn't hang for hours.
+			if d, ok := retryAfter(resp); ok {
+				if _, maxDelay := t.policy.delays(); d > maxDelay {
+					d = maxDelay
+				}
+				delay = d
+			}
+			log.Printf("[DEBUG] Retrying %s %s in %s after status %d", req.Method, req.URL.Redacted(), delay, resp.StatusCode)
+			// Drain the body so that the connection can be reused.
+			io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+		default:
+			return resp, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// send sends one attempt of a request, within the timeout of the transport.
+func (t *retryTransport) send(req *gohttp.Request) (*gohttp.Response, error) {
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelBody releases the timeout of an attempt once its body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func isIdempotent(req *gohttp.Request) bool {
+	switch req.Method {
+	case gohttp.MethodGet, gohttp.MethodHead, gohttp.MethodOptions, gohttp.MethodPut, gohttp.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryableError tells whether a request failing with err is retried. TLS
+// and certificate errors are not transient, and a request which failed once
+// connected is only retried when it is idempotent.
+func retryableError(err error, idempotent bool) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostname x509.HostnameError
+	var invalid x509.CertificateInvalidError
+	if errors.As(err, &unknownAuthority) || errors.As(err, &hostname) || errors.As(err, &invalid) {
+		return false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return idempotent
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MinDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for retry, want := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		want *= time.Millisecond
+		for i := 0; i < 20; i++ {
+			if got := policy.Backoff(retry); got < want/2 || got > want {
+				t.Fatalf("retry %d: expected a delay between %s and %s, got %s", retry, want/2, want, got)
+			}
+		}
+	}
+	if got := policy.Backoff(100); got > time.Second {
+		t.Fatalf("expected the delay to be capped, got %s", got)
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		n := atomic.AddInt32(&calls, 1)
+		switch r.URL.Path {
+		case "/throttled":
+			if n == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Write(body)
+		case "/unavailable":
+			w.WriteHeader(http.StatusBadGateway)
+		case "/invalid":
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{MaxRetries: 2, MinDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	client := &http.Client{Transport: NewRetryTransport(nil, policy, time.Second)}
+
+	cases := []struct {
+		method, path string
+		status       int
+		calls        int32
+	}{
+		// The body of a throttled request is sent again.
+		{http.MethodPost, "/throttled", http.StatusOK, 2},
+		{http.MethodGet, "/unavailable", http.StatusBadGateway, 3},
+		// A POST may have been processed by the server.
+		{http.MethodPost, "/unavailable", http.StatusBadGateway, 1},
+		{http.MethodGet, "/invalid", http.StatusBadRequest, 1},
+	}
+	for _, c := range cases {
+		atomic.StoreInt32(&calls, 0)
+		req, _ := http.NewRequest(c.method, server.URL+c.path, strings.NewReader("payload"))
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %s", c.method, c.path, err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != c.status || calls != c.calls {
+			t.Fatalf("%s %s: expected status %d after %d calls, got %d after %d calls", c.method, c.path, c.status, c.calls, resp.StatusCode, calls)
+		}
+		if c.status == http.StatusOK && string(body) != "payload" {
+			t.Fatalf("%s %s: expected the body to be sent again, got %q", c.method, c.path, body)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "7")
+	if d, ok := retryAfter(resp); !ok || d != 7*time.Second {
+		t.Fatalf("expected 7s, got %s", d)
+	}
+	resp.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	if d, ok := retryAfter(resp); !ok || d <= 50*time.Second || d > time.Minute {
+		t.Fatalf("expected about a minute, got %s", d)
+	}
+	resp.Header.Set("Retry-After", "soon")
+	if _, ok := retryAfter(resp); ok {
+		t.Fatal("expected an invalid Retry-After to be ignored")
+	}
+}
+
+func TestRetryTransportCapsRetryAfter(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{MaxRetries: 1, MinDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+	client := &http.Client{Transport: NewRetryTransport(nil, policy, time.Second)}
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls != 2 {
+		t.Fatalf("expected status 200 after 2 calls, got %d after %d calls", resp.StatusCode, calls)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the Retry-After delay to be capped at MaxDelay, waited %s", elapsed)
+	}
+}
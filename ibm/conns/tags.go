@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"strings"
+)
+
+// TagConfig holds the tags the provider attaches to every taggable resource,
+// and the tags it leaves alone, for example the tags attached by other tools.
+type TagConfig struct {
+	// DefaultTags are the user tags attached to every resource.
+	DefaultTags []string
+
+	// DefaultAccessTags are the access management tags attached to every
+	// resource supporting them.
+	DefaultAccessTags []string
+
+	// IgnoreTags are the tags which are neither read, attached nor detached.
+	// An entry ending with * matches the tags starting with the rest of it.
+	IgnoreTags []string
+}
+
+// Ignored reports whether tag is one of IgnoreTags. Tags are compared
+// without case, as the tagging service does.
+func (t TagConfig) Ignored(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for _, ignored := range t.IgnoreTags {
+		ignored = strings.ToLower(strings.TrimSpace(ignored))
+		if prefix := strings.TrimSuffix(ignored, "*"); prefix != ignored {
+			if strings.HasPrefix(tag, prefix) {
+				return true
+			}
+		} else if tag == ignored {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns tags without the ignored ones.
+func (t TagConfig) Filter(tags []string) []string {
+	if len(t.IgnoreTags) == 0 {
+		return tags
+	}
+	filtered := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if !t.Ignored(tag) {
+			filtered = append(filtered, tag)
+		}
+	}
+	return filtered
+}
+
+// MergeTags returns the tags of a resource with defaults, without
+// duplicates. The tags set on the resource come first.
+func MergeTags(tags, defaults []string) []string {
+	merged := make([]string, 0, len(tags)+len(defaults))
+	seen := map[string]bool{}
+	for _, tag := range append(append([]string{}, tags...), defaults...) {
+		key := strings.ToLower(strings.TrimSpace(tag))
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		merged = append(merged, tag)
+	}
+	return merged
+}
+
+// SameTags reports whether a and b hold the same tags, compared without case.
+func SameTags(a, b []string) bool {
+	merged := MergeTags(a, b)
+	return len(merged) == len(MergeTags(a, nil)) && len(merged) == len(MergeTags(b, nil))
+}
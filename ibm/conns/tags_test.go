@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTagConfigFilter(t *testing.T) {
+	tags := TagConfig{IgnoreTags: []string{"Compliance:Scanned", "cost-center:*"}}
+	cases := map[string]bool{
+		"compliance:scanned":   true,
+		" COMPLIANCE:SCANNED ": true,
+		"compliance:pending":   false,
+		"cost-center:1234":     true,
+		"Cost-Center:":         true,
+		"cost-centre:1234":     false,
+		"env:prod":             false,
+	}
+	for tag, want := range cases {
+		if got := tags.Ignored(tag); got != want {
+			t.Errorf("%q: expected ignored to be %t", tag, want)
+		}
+	}
+
+	got := tags.Filter([]string{"env:prod", "cost-center:1234", "compliance:scanned", "team:platform"})
+	if want := []string{"env:prod", "team:platform"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if got := (TagConfig{}).Filter([]string{"env:prod"}); !reflect.DeepEqual(got, []string{"env:prod"}) {
+		t.Fatalf("expected the tags to be left unchanged without ignored tags, got %v", got)
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	got := MergeTags([]string{"Env:Prod", "app:web"}, []string{"env:prod", "team:platform", "app:web"})
+	if want := []string{"Env:Prod", "app:web", "team:platform"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if got := MergeTags(nil, nil); len(got) != 0 {
+		t.Fatalf("expected no tags, got %v", got)
+	}
+
+	if !SameTags([]string{"env:prod", "team:platform"}, []string{"Team:Platform", "env:prod"}) {
+		t.Error("expected the tags to be the same")
+	}
+	if SameTags([]string{"env:prod"}, []string{"env:prod", "team:platform"}) {
+		t.Error("expected the tags to differ")
+	}
+}
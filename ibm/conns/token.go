@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"log"
+	gohttp "net/http"
+	"strings"
+	"sync"
+
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+// tokenManager shares the IAM token of a session between the IBM Cloud
+// session, the SoftLayer session and the IBM Cloud SDK clients, so that a run
+// outliving the token does not fail once it expires. The authenticator of the
+// SDK clients requests a new token before the current one expires, and the
+// requests sent with an older token are updated with the current one.
+//
+// The authenticator is only set once the session has logged in, and not at
+// all when the token cannot be refreshed, for example a bare iam_token.
+type tokenManager struct {
+	mu            sync.RWMutex
+	authenticator tokenAuthenticator
+	// issued are the Authorization header values of the tokens of the
+	// session, the current one included.
+	issued map[string]bool
+}
+
+// setAuthenticator makes the token manager refresh the tokens of the session
+// with authenticator. tokens are the tokens the session logged in with.
+func (m *tokenManager) setAuthenticator(authenticator tokenAuthenticator, tokens ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.authenticator = authenticator
+	if m.issued == nil {
+		m.issued = map[string]bool{}
+	}
+	for _, token := range tokens {
+		if token != "" {
+			m.issued[bearer(token)] = true
+		}
+	}
+}
+
+// Token returns the current IAM token as an Authorization header value, or
+// an empty string when the token is not refreshed. The authenticator
+// serializes the refreshes, so concurrent callers share the new token.
+func (m *tokenManager) Token() (string, error) {
+	m.mu.RLock()
+	authenticator := m.authenticator
+	m.mu.RUnlock()
+	if authenticator == nil {
+		return "", nil
+	}
+	token, err := authenticator.GetToken()
+	if err != nil {
+		return "", err
+	}
+	token = bearer(token)
+	m.mu.Lock()
+	m.issued[token] = true
+	m.mu.Unlock()
+	return token, nil
+}
+
+// isIssued reports whether authorization carries a token of the session.
+func (m *tokenManager) isIssued(authorization string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.issued[bearer(authorization)]
+}
+
+// session returns sess with the current IAM token. It leaves sess unchanged,
+// as it is shared by the resources applied concurrently.
+func (m *tokenManager) session(sess *bxsession.Session) *bxsession.Session {
+	if m == nil || sess == nil {
+		return sess
+	}
+	token, err := m.Token()
+	if err != nil {
+		log.Printf("[WARN] Error refreshing the IAM token: %s", err)
+		return sess
+	}
+	if token == "" || token == sess.Config.IAMAccessToken {
+		return sess
+	}
+	config := sess.Config.Copy()
+	config.IAMAccessToken = token
+	return &bxsession.Session{Config: config}
+}
+
+func bearer(token string) string {
+	if strings.HasPrefix(token, "Bearer ") || strings.HasPrefix(token, "bearer ") {
+		return "Bearer " + token[7:]
+	}
+	return "Bearer " + token
+}
+
+// tokenTransport replaces the outdated IAM tokens of the session in the
+// Authorization header of the requests by the current one. The bluemix-go and
+// the SoftLayer clients set the header from the token they were created with.
+type tokenTransport struct {
+	base    gohttp.RoundTripper
+	manager *tokenManager
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *tokenTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	authorization := req.Header.Get("Authorization")
+	if authorization == "" || !t.manager.isIssued(authorization) {
+		return t.base.RoundTrip(req)
+	}
+	token, err := t.manager.Token()
+	if err != nil {
+		log.Printf("[WARN] Error refreshing the IAM token: %s", err)
+		return t.base.RoundTrip(req)
+	}
+	if token != "" && token != authorization {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", token)
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// iamService stands in for the IAM token endpoint. The tokens it issues are
+// numbered, and expire after expiresIn.
+type iamService struct {
+	mu        sync.Mutex
+	tokens    int
+	expiresIn time.Duration
+}
+
+func (s *iamService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r.ParseForm()
+	if r.Form.Get("grant_type") != "refresh_token" || r.Form.Get("refresh_token") == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	// Slow down the requests, so that concurrent refreshes would overlap.
+	time.Sleep(10 * time.Millisecond)
+	s.tokens++
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token":  fmt.Sprintf("iam-token-%d", s.tokens),
+		"refresh_token": fmt.Sprintf("refresh-token-%d", s.tokens),
+		"token_type":    "Bearer",
+		"expires_in":    int64(s.expiresIn.Seconds()),
+		"expiration":    time.Now().Add(s.expiresIn).Unix(),
+	})
+}
+
+func (s *iamService) issued() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens
+}
+
+func TestTokenManager(t *testing.T) {
+	iam := &iamService{expiresIn: time.Hour}
+	server := httptest.NewServer(iam)
+	defer server.Close()
+
+	m := &tokenManager{}
+	if token, err := m.Token(); token != "" || err != nil {
+		t.Fatalf("expected no token before the session logs in, got %q, %v", token, err)
+	}
+	m.setAuthenticator(&core.IamAuthenticator{
+		RefreshToken: "refresh-token-0",
+		ClientId:     "bx",
+		ClientSecret: "bx",
+		URL:          server.URL,
+	}, "iam-token-0")
+
+	// Concurrent callers share a single refresh.
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if token, err := m.Token(); err != nil || token != "Bearer iam-token-1" {
+				t.Errorf("expected the first token, got %q, %v", token, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := iam.issued(); n != 1 {
+		t.Fatalf("expected a single token request, got %d", n)
+	}
+	for _, token := range []string{"iam-token-0", "Bearer iam-token-0", "Bearer iam-token-1"} {
+		if !m.isIssued(token) {
+			t.Errorf("expected %q to be a token of the session", token)
+		}
+	}
+	if m.isIssued("Bearer other-token") {
+		t.Error("expected a foreign token not to be a token of the session")
+	}
+
+	sess := &bxsession.Session{Config: &bluemix.Config{IAMAccessToken: "Bearer iam-token-0", Region: "us-south"}}
+	current := m.session(sess)
+	if current.Config.IAMAccessToken != "Bearer iam-token-1" || current.Config.Region != "us-south" {
+		t.Fatalf("expected the session with the current token, got %+v", current.Config)
+	}
+	if sess.Config.IAMAccessToken != "Bearer iam-token-0" {
+		t.Fatal("expected the shared session to be left unchanged")
+	}
+}
+
+func TestTokenTransport(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer server.Close()
+
+	iam := &iamService{expiresIn: time.Hour}
+	iamServer := httptest.NewServer(iam)
+	defer iamServer.Close()
+
+	m := &tokenManager{}
+	m.setAuthenticator(&core.IamAuthenticator{
+		RefreshToken: "refresh-token-0",
+		ClientId:     "bx",
+		ClientSecret: "bx",
+		URL:          iamServer.URL,
+	}, "Bearer iam-token-0")
+	client := &http.Client{Transport: &tokenTransport{base: http.DefaultTransport, manager: m}}
+
+	cases := map[string]string{
+		"Bearer iam-token-0": "Bearer iam-token-1",
+		"iam-token-0":        "Bearer iam-token-1",
+		"Bearer other-token": "Bearer other-token",
+		"Basic Yng6Yng=":     "Basic Yng6Yng=",
+		"bearer uaa-token-0": "bearer uaa-token-0",
+	}
+	for sent, want := range cases {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		req.Header.Set("Authorization", sent)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if authorization != want {
+			t.Errorf("%s: expected %q, got %q", sent, want, authorization)
+		}
+		if req.Header.Get("Authorization") != sent {
+			t.Errorf("%s: expected the request to be left unchanged", sent)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultCRTokenFiles are the compute resource token files projected in the
+// pods of the IBM Cloud Kubernetes Service and Red Hat OpenShift clusters, by
+// order of preference.
+var DefaultCRTokenFiles = []string{
+	"/var/run/secrets/tokens/vault-token",
+	"/var/run/secrets/tokens/sa-token",
+}
+
+// trustedProfileAuthenticator returns the authenticator which logs in with the
+// trusted profile IAMTrustedProfileID from the compute resource the provider
+// runs on. It exchanges the compute resource token of CRTokenFile, or of one
+// of DefaultCRTokenFiles, for an IAM token, or, when there is none, the
+// instance identity token of the VPC instance metadata service. The IAM token
+// is requested again by the authenticator before it expires.
+func (c *Config) trustedProfileAuthenticator() (tokenAuthenticator, error) {
+	crTokenFile := c.CRTokenFile
+	if crTokenFile == "" {
+		for _, f := range DefaultCRTokenFiles {
+			if _, err := os.Stat(f); err == nil {
+				crTokenFile = f
+				break
+			}
+		}
+	}
+	if crTokenFile != "" {
+		log.Printf("[INFO] Logging in with the trusted profile %s and the compute resource token %s", c.IAMTrustedProfileID, crTokenFile)
+		iamURL := (&clientSession{config: c}).iamEndpoint()
+		authenticator, err := core.NewContainerAuthenticatorBuilder().
+			SetCRTokenFilename(crTokenFile).
+			SetIAMProfileID(c.IAMTrustedProfileID).
+			SetURL(iamURL).
+			SetClient(c.httpClient()).
+			Build()
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error configuring the trusted profile login: %s", err)
+		}
+		return authenticator, nil
+	}
+
+	log.Printf("[INFO] Logging in with the trusted profile %s and the VPC instance metadata service", c.IAMTrustedProfileID)
+	builder := core.NewVpcInstanceAuthenticatorBuilder().
+		SetURL(c.MetadataServiceEndpoint).
+		SetClient(c.httpClient())
+	if strings.HasPrefix(c.IAMTrustedProfileID, "crn:") {
+		builder.SetIAMProfileCRN(c.IAMTrustedProfileID)
+	} else {
+		builder.SetIAMProfileID(c.IAMTrustedProfileID)
+	}
+	authenticator, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error configuring the trusted profile login: %s", err)
+	}
+	return authenticator, nil
+}
+
+// tokenAuthenticator is an authenticator which hands out the IAM token it
+// authenticates the requests with.
+type tokenAuthenticator interface {
+	core.Authenticator
+	GetToken() (string, error)
+}
+
+// trustedProfileToken returns the current IAM token of a trusted profile
+// authenticator, as an Authorization header value.
+func trustedProfileToken(authenticator tokenAuthenticator) (string, error) {
+	token, err := authenticator.GetToken()
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error getting an IAM token for the trusted profile: %s", err)
+	}
+	return "Bearer " + token, nil
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// metadataService stands in for the VPC instance metadata service. The IAM
+// tokens it issues are numbered, and expire after expiresIn.
+type metadataService struct {
+	mu        sync.Mutex
+	tokens    int
+	expiresIn time.Duration
+	profile   string
+}
+
+func (m *metadataService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	switch {
+	case r.Method == http.MethodPut && r.URL.Path == "/instance_identity/v1/token":
+		if r.Header.Get("Metadata-Flavor") != "ibm" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writeToken(w, "instance-identity-token", 5*time.Minute)
+	case r.Method == http.MethodPost && r.URL.Path == "/instance_identity/v1/iam_token":
+		var body struct {
+			TrustedProfile struct {
+				ID string `json:"id"`
+			} `json:"trusted_profile"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if r.Header.Get("Authorization") != "Bearer instance-identity-token" || body.TrustedProfile.ID != m.profile {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		m.tokens++
+		writeToken(w, fmt.Sprintf("iam-token-%d", m.tokens), m.expiresIn)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writeToken(w http.ResponseWriter, token string, expiresIn time.Duration) {
+	now := time.Now().UTC()
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": token,
+		"created_at":   now.Format(time.RFC3339),
+		"expires_at":   now.Add(expiresIn).Format(time.RFC3339),
+		"expires_in":   int64(expiresIn.Seconds()),
+	})
+}
+
+func TestTrustedProfileMetadataService(t *testing.T) {
+	metadata := &metadataService{profile: "Profile-1", expiresIn: time.Hour}
+	server := httptest.NewServer(metadata)
+	defer server.Close()
+
+	c := &Config{IAMTrustedProfileID: "Profile-1", MetadataServiceEndpoint: server.URL}
+	sess, err := newSession(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token := sess.BluemixSession.Config.IAMAccessToken; token != "Bearer iam-token-1" {
+		t.Fatalf("expected the IBM Cloud session to use the trusted profile token, got %q", token)
+	}
+	if token := sess.SoftLayerSession.IAMToken; token != "Bearer iam-token-1" {
+		t.Fatalf("expected the SoftLayer session to use the trusted profile token, got %q", token)
+	}
+	if c.trustedProfile == nil {
+		t.Fatal("expected the trusted profile authenticator to be kept for the SDK clients")
+	}
+
+	// The token is requested again once it expires.
+	metadata.mu.Lock()
+	metadata.expiresIn = 0
+	metadata.mu.Unlock()
+	c.trustedProfile, _ = c.trustedProfileAuthenticator()
+	if token, err := trustedProfileToken(c.trustedProfile); err != nil || token != "Bearer iam-token-2" {
+		t.Fatalf("expected a new token, got %q, %v", token, err)
+	}
+	if token, err := trustedProfileToken(c.trustedProfile); err != nil || token != "Bearer iam-token-3" {
+		t.Fatalf("expected the expired token to be refreshed, got %q, %v", token, err)
+	}
+
+	c = &Config{IAMTrustedProfileID: "Profile-2", MetadataServiceEndpoint: server.URL}
+	if _, err := newSession(c); err == nil {
+		t.Fatal("expected an error for a trusted profile the instance is not linked to")
+	}
+}
+
+func TestTrustedProfileCRToken(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/identity/token" || r.Form.Get("grant_type") != "urn:ibm:params:oauth:grant-type:cr-token" ||
+			r.Form.Get("cr_token") != "cr-token" || r.Form.Get("profile_id") != "Profile-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": fmt.Sprintf("iam-token-%d", requests),
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"expiration":   time.Now().Add(time.Hour).Unix(),
+		})
+	}))
+	defer server.Close()
+	setTestEnv(t, "IBMCLOUD_IAM_API_ENDPOINT", server.URL)
+
+	crTokenFile := filepath.Join(t.TempDir(), "sa-token")
+	ioutil.WriteFile(crTokenFile, []byte("cr-token"), 0600)
+
+	c := &Config{IAMTrustedProfileID: "Profile-1", CRTokenFile: crTokenFile}
+	sess, err := newSession(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token := sess.BluemixSession.Config.IAMAccessToken; token != "Bearer iam-token-1" {
+		t.Fatalf("expected the IBM Cloud session to use the trusted profile token, got %q", token)
+	}
+	// The token is cached until it needs to be refreshed.
+	if token, err := trustedProfileToken(c.trustedProfile); err != nil || token != "Bearer iam-token-1" {
+		t.Fatalf("expected the cached token, got %q, %v", token, err)
+	}
+}
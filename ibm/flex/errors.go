@@ -0,0 +1,303 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// requestIDHeaders are the headers carrying the ID of a request, by order of
+// preference.
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "X-Global-Transaction-Id", "X-Transaction-Id", "Transaction-Id", "Softlayer-Request-Id"}
+
+// bluemixRequestFailure matches the errors of the bluemix-go clients.
+var bluemixRequestFailure = regexp.MustCompile(`Request failed with status code: (\d+), ([^:\s]+):`)
+
+// softlayerRequestFailure matches the errors of the SoftLayer client.
+var softlayerRequestFailure = regexp.MustCompile(`(SoftLayer_Exception\w*): [^\n]*\(HTTP (\d+)\)`)
+
+// ServiceError is an error returned by the API of a service, with what the
+// support of the service needs to find the failed request.
+type ServiceError struct {
+	// Resource is the address of the resource whose operation failed.
+	Resource string
+	// Service and Operation are the service and the API operation, e.g.
+	// "vpc" and "CreateVPC", or the resource operation, e.g. "create",
+	// "read", "update", "delete", "import" or "plan".
+	Service   string
+	Operation string
+	// Request is the method and URL of the failed request.
+	Request    string
+	StatusCode int
+	// Code is the error code of the API, e.g. "vpc_not_found".
+	Code      string
+	Message   string
+	RequestID string
+	Trace     string
+	Err       error
+}
+
+// NewServiceError returns the ServiceError of an API operation returning err
+// and response.
+func NewServiceError(err error, response *core.DetailedResponse, service, operation string) *ServiceError {
+	e := &ServiceError{
+		Service:   service,
+		Operation: operation,
+		Message:   err.Error(),
+		Err:       err,
+	}
+	if response != nil {
+		e.StatusCode = response.StatusCode
+		e.RequestID = headerRequestID(response.Headers)
+		e.setResult(response.Result)
+	}
+	if apiErr, ok := err.(bmxerror.RequestFailure); ok {
+		e.StatusCode = apiErr.StatusCode()
+		e.Code = apiErr.Code()
+		e.Message = apiErr.Description()
+	}
+	return e
+}
+
+// Error returns the message of the error. The error of a resource operation is
+// followed by its detail, as it is returned to Terraform as is.
+func (e *ServiceError) Error() string {
+	if e.Resource != "" {
+		return e.Message + "\n\n" + e.detail()
+	}
+	message := fmt.Sprintf("[ERROR] Error calling %s %s: %s", e.Service, e.Operation, e.Message)
+	if e.RequestID != "" {
+		message += fmt.Sprintf(" (request ID %s)", e.RequestID)
+	}
+	return message
+}
+
+func (e *ServiceError) Unwrap() error {
+	return e.Err
+}
+
+// Diagnostics returns the diagnostic of the error, whose detail lists the
+// fields of the error.
+func (e *ServiceError) Diagnostics() diag.Diagnostics {
+	summary := e.Message
+	if e.Service != "" || e.Operation != "" {
+		summary = fmt.Sprintf("[ERROR] Error calling %s %s: %s", e.Service, e.Operation, e.Message)
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   e.detail(),
+	}}
+}
+
+func (e *ServiceError) detail() string {
+	var lines []string
+	for _, field := range []struct{ name, value string }{
+		{"Resource", e.Resource},
+		{"Service", e.Service},
+		{"Operation", e.Operation},
+		{"Request", e.Request},
+		{"Status code", statusCode(e.StatusCode)},
+		{"Error code", e.Code},
+		{"Request ID", e.RequestID},
+		{"Trace", e.Trace},
+	} {
+		if field.value != "" {
+			lines = append(lines, fmt.Sprintf("%s: %s", field.name, field.value))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// setResult sets the error code and trace of the error from the result of
+// the API response.
+func (e *ServiceError) setResult(result interface{}) {
+	body, ok := result.(map[string]interface{})
+	if !ok {
+		return
+	}
+	if trace, ok := body["trace"].(string); ok {
+		e.Trace = trace
+	}
+	if errs, ok := body["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			if code, ok := first["code"].(string); ok {
+				e.Code = code
+			}
+			if message, ok := first["message"].(string); ok && e.Message == "" {
+				e.Message = message
+			}
+		}
+	}
+	for _, key := range []string{"code", "errorCode", "error_code"} {
+		if code, ok := body[key].(string); ok && e.Code == "" {
+			e.Code = code
+		}
+	}
+	if message, ok := body["message"].(string); ok && e.Message == "" {
+		e.Message = message
+	}
+}
+
+// ErrorDiagnostics returns the diagnostics of err, whose detail lists the
+// fields of the ServiceError it wraps, if any.
+func ErrorDiagnostics(err error) diag.Diagnostics {
+	var serviceErr *ServiceError
+	if errors.As(err, &serviceErr) {
+		diags := serviceErr.Diagnostics()
+		if serviceErr != err {
+			diags[0].Summary = err.Error()
+		}
+		return diags
+	}
+	return diag.FromErr(err)
+}
+
+// ServiceErrorDiagnostics structures the error diagnostics returned by the
+// operation of a resource, e.g. create, read or import: the API responses that
+// the errors embed, as printed by the SDK clients or BeautifyError, are
+// replaced by a detail listing the status code, error code, request ID and
+// trace of the request. The error is built from the response of its failed
+// request, the last of the failed requests of the operation whose status code
+// is the one of the error, if any.
+func ServiceErrorDiagnostics(diags diag.Diagnostics, resource, operation string, failed []*conns.FailedRequest) diag.Diagnostics {
+	for i, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		e, ok := serviceError(d.Summary, resource, operation, failed)
+		if !ok {
+			continue
+		}
+		diags[i].Summary = e.Message
+		if d.Detail != "" {
+			diags[i].Detail = d.Detail + "\n\n" + e.detail()
+		} else {
+			diags[i].Detail = e.detail()
+		}
+	}
+	return diags
+}
+
+// ServiceErrorOf returns the ServiceError of the error returned by the
+// operation of a resource, like ServiceErrorDiagnostics, for the operations
+// returning an error, e.g. the plan of a resource. It returns err if err
+// doesn't embed an API response.
+func ServiceErrorOf(err error, resource, operation string, failed []*conns.FailedRequest) error {
+	if err == nil {
+		return nil
+	}
+	e, ok := serviceError(err.Error(), resource, operation, failed)
+	if !ok {
+		return err
+	}
+	e.Err = err
+	return e
+}
+
+// serviceError returns the ServiceError of the message of an error returned by
+// the operation of a resource, built from the response of its failed request.
+func serviceError(message, resource, operation string, failed []*conns.FailedRequest) (*ServiceError, bool) {
+	parsed, ok := parseServiceError(message)
+	if !ok {
+		return nil, false
+	}
+	e := parsed
+	for i := len(failed) - 1; i >= 0; i-- {
+		request := failed[i]
+		if request.StatusCode != parsed.StatusCode {
+			continue
+		}
+		e = NewServiceError(errors.New(parsed.Message), request.Response, request.Service, operation)
+		e.StatusCode = request.StatusCode
+		e.Request = request.Method + " " + request.URL
+		if e.Code == "" {
+			e.Code = parsed.Code
+		}
+		if e.Trace == "" {
+			e.Trace = parsed.Trace
+		}
+		if e.RequestID == "" {
+			e.RequestID = request.RequestID
+		}
+		break
+	}
+	e.Resource, e.Operation = resource, operation
+	return e, true
+}
+
+// apiResponse holds the fields of a core.DetailedResponse, or of a
+// ServiceErrorResponse, printed as JSON.
+type apiResponse struct {
+	StatusCode *int
+	Headers    http.Header
+	Result     interface{}
+	Message    string
+}
+
+// parseServiceError returns the ServiceError of the message of an error
+// embedding an API response, or of a bluemix-go or SoftLayer error. The
+// message of the ServiceError is the message of the error without the
+// response.
+func parseServiceError(message string) (*ServiceError, bool) {
+	e := &ServiceError{Message: message}
+	for i := strings.Index(message, "{"); i >= 0; {
+		var response apiResponse
+		dec := json.NewDecoder(strings.NewReader(message[i:]))
+		if err := dec.Decode(&response); err == nil && response.StatusCode != nil {
+			end := i + int(dec.InputOffset())
+			e.Message = strings.TrimSpace(strings.TrimSpace(message[:i]) + " " + strings.TrimSpace(message[end:]))
+			e.StatusCode = *response.StatusCode
+			e.RequestID = headerRequestID(response.Headers)
+			if response.Message != "" {
+				e.Message = strings.TrimSuffix(e.Message, ":") + ": " + response.Message
+			}
+			e.setResult(response.Result)
+			return e, true
+		}
+		next := strings.Index(message[i+1:], "{")
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	if m := bluemixRequestFailure.FindStringSubmatch(message); m != nil {
+		e.StatusCode, _ = strconv.Atoi(m[1])
+		e.Code = m[2]
+		return e, true
+	}
+	if m := softlayerRequestFailure.FindStringSubmatch(message); m != nil {
+		e.StatusCode, _ = strconv.Atoi(m[2])
+		e.Code = m[1]
+		return e, true
+	}
+	return e, false
+}
+
+func headerRequestID(headers http.Header) string {
+	for _, h := range requestIDHeaders {
+		if id := headers.Get(h); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func statusCode(code int) string {
+	if code == 0 {
+		return ""
+	}
+	return strconv.Itoa(code)
+}
@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func vpcNotFoundResponse() *core.DetailedResponse {
+	return &core.DetailedResponse{
+		StatusCode: 404,
+		Headers:    http.Header{"X-Request-Id": {"req-1"}},
+		Result: map[string]interface{}{
+			"errors": []interface{}{map[string]interface{}{"code": "vpc_not_found", "message": "VPC not found"}},
+			"trace":  "trace-1",
+		},
+	}
+}
+
+func TestParseServiceError(t *testing.T) {
+	cases := []struct {
+		name    string
+		message string
+		ok      bool
+		want    ServiceError
+	}{
+		{
+			name:    "detailed response",
+			message: fmt.Sprintf("[ERROR] Error getting VPC (r006-1): VPC not found\n%s", vpcNotFoundResponse()),
+			ok:      true,
+			want:    ServiceError{Message: "[ERROR] Error getting VPC (r006-1): VPC not found", StatusCode: 404, Code: "vpc_not_found", RequestID: "req-1", Trace: "trace-1"},
+		},
+		{
+			name:    "beautified error",
+			message: fmt.Sprintf("[ERROR] Error getting VPC (r006-1): %s", BeautifyError(errors.New("VPC not found"), vpcNotFoundResponse())),
+			ok:      true,
+			want:    ServiceError{Message: "[ERROR] Error getting VPC (r006-1): VPC not found", StatusCode: 404, Code: "vpc_not_found", Trace: "trace-1"},
+		},
+		{
+			name:    "bluemix-go error",
+			message: fmt.Sprintf("[ERROR] Error retrieving cluster: %s", bmxerror.NewRequestFailure("E0013", "The specified cluster could not be found.", 404)),
+			ok:      true,
+			want:    ServiceError{Message: "[ERROR] Error retrieving cluster: Request failed with status code: 404, E0013: The specified cluster could not be found.", StatusCode: 404, Code: "E0013"},
+		},
+		{
+			name:    "SoftLayer error",
+			message: fmt.Sprintf("[ERROR] Error retrieving virtual guest: %s", sl.Error{StatusCode: 404, Exception: "SoftLayer_Exception_ObjectNotFound", Message: "Unable to find object with id of '1'."}),
+			ok:      true,
+			want:    ServiceError{Message: "[ERROR] Error retrieving virtual guest: SoftLayer_Exception_ObjectNotFound: Unable to find object with id of '1'. (HTTP 404)", StatusCode: 404, Code: "SoftLayer_Exception_ObjectNotFound"},
+		},
+		{
+			name:    "plain error",
+			message: "[ERROR] name must be set {when the type is SSL}",
+			ok:      false,
+			want:    ServiceError{Message: "[ERROR] name must be set {when the type is SSL}"},
+		},
+	}
+	for _, c := range cases {
+		e, ok := parseServiceError(c.message)
+		if ok != c.ok {
+			t.Errorf("%s: expected %t, got %t", c.name, c.ok, ok)
+			continue
+		}
+		if *e != c.want {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.want, *e)
+		}
+	}
+}
+
+func TestServiceErrorDiagnostics(t *testing.T) {
+	detailed := fmt.Sprintf("[ERROR] Error getting VPC (r006-1): VPC not found\n%s", vpcNotFoundResponse())
+	bluemix := fmt.Sprintf("[ERROR] Error retrieving cluster: %s", bmxerror.NewRequestFailure("E0013", "The specified cluster could not be found.", 404))
+	failed := &conns.FailedRequest{Service: "iaas", Method: "GET", URL: "https://us-south.iaas.cloud.ibm.com/v1/vpcs/r006-1", StatusCode: 404, RequestID: "req-2", Response: vpcNotFoundResponse()}
+	conflict := &conns.FailedRequest{Service: "iaas", Method: "PATCH", URL: "https://us-south.iaas.cloud.ibm.com/v1/vpcs/r006-1", StatusCode: 409, RequestID: "req-3"}
+	cluster := &conns.FailedRequest{
+		Service:    "containers",
+		Method:     "GET",
+		URL:        "https://containers.cloud.ibm.com/global/v1/clusters/c-1",
+		StatusCode: 404,
+		RequestID:  "sent-4",
+		Response:   &core.DetailedResponse{StatusCode: 404, Result: map[string]interface{}{"code": "E0013", "incidentID": "i-1"}},
+	}
+
+	cases := []struct {
+		name    string
+		diag    diag.Diagnostic
+		failed  []*conns.FailedRequest
+		summary string
+		detail  []string
+		absent  []string
+	}{
+		{
+			name:    "detailed response",
+			diag:    diag.Diagnostic{Severity: diag.Error, Summary: detailed},
+			summary: "[ERROR] Error getting VPC (r006-1): VPC not found",
+			detail:  []string{"Resource: ibm_is_vpc.vpc", "Operation: read", "Status code: 404", "Error code: vpc_not_found", "Request ID: req-1", "Trace: trace-1"},
+			absent:  []string{"Request: "},
+		},
+		{
+			name:    "failed request of the error",
+			diag:    diag.Diagnostic{Severity: diag.Error, Summary: detailed},
+			failed:  []*conns.FailedRequest{failed, conflict},
+			summary: "[ERROR] Error getting VPC (r006-1): VPC not found",
+			detail:  []string{"Service: iaas", "Request: GET https://us-south.iaas.cloud.ibm.com/v1/vpcs/r006-1", "Request ID: req-1", "Error code: vpc_not_found", "Trace: trace-1"},
+		},
+		{
+			name:    "bluemix-go error with the failed request of the session",
+			diag:    diag.Diagnostic{Severity: diag.Error, Summary: bluemix},
+			failed:  []*conns.FailedRequest{cluster, conflict},
+			summary: bluemix,
+			detail:  []string{"Service: containers", "Request: GET https://containers.cloud.ibm.com/global/v1/clusters/c-1", "Status code: 404", "Error code: E0013", "Request ID: sent-4"},
+		},
+		{
+			name:    "bluemix-go error with the failed request of another status",
+			diag:    diag.Diagnostic{Severity: diag.Error, Summary: bluemix},
+			failed:  []*conns.FailedRequest{conflict},
+			summary: bluemix,
+			detail:  []string{"Status code: 404", "Error code: E0013"},
+			absent:  []string{"Request", "req-3"},
+		},
+		{
+			name:    "plain error",
+			diag:    diag.Diagnostic{Severity: diag.Error, Summary: "[ERROR] Error waiting for the VPC"},
+			failed:  []*conns.FailedRequest{failed},
+			summary: "[ERROR] Error waiting for the VPC",
+			absent:  []string{"Request", "req-2"},
+		},
+		{
+			name:    "warning",
+			diag:    diag.Diagnostic{Severity: diag.Warning, Summary: detailed},
+			failed:  []*conns.FailedRequest{failed},
+			summary: detailed,
+		},
+	}
+	for _, c := range cases {
+		diags := ServiceErrorDiagnostics(diag.Diagnostics{c.diag}, "ibm_is_vpc.vpc", "read", c.failed)
+		if len(diags) != 1 {
+			t.Fatalf("%s: expected one diagnostic, got %d", c.name, len(diags))
+		}
+		if diags[0].Summary != c.summary {
+			t.Errorf("%s: expected the summary %q, got %q", c.name, c.summary, diags[0].Summary)
+		}
+		for _, s := range c.detail {
+			if !strings.Contains(diags[0].Detail, s) {
+				t.Errorf("%s: expected the detail to contain %q, got %q", c.name, s, diags[0].Detail)
+			}
+		}
+		for _, s := range c.absent {
+			if strings.Contains(diags[0].Detail, s) {
+				t.Errorf("%s: expected the detail not to contain %q, got %q", c.name, s, diags[0].Detail)
+			}
+		}
+	}
+}
+
+func TestServiceErrorOf(t *testing.T) {
+	if err := ServiceErrorOf(nil, "ibm_is_vpc.vpc", "import", nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	plain := errors.New("[ERROR] Incorrect ID r006-1: ID should be a combination of vpc/route")
+	if err := ServiceErrorOf(plain, "ibm_is_vpc_route", "import", nil); err != plain {
+		t.Fatalf("expected the plain error, got %v", err)
+	}
+
+	cause := fmt.Errorf("[ERROR] Error getting VPC (r006-1): VPC not found\n%s", vpcNotFoundResponse())
+	err := ServiceErrorOf(cause, "ibm_is_vpc.r006-1", "import", nil)
+	var serviceErr *ServiceError
+	if !errors.As(err, &serviceErr) || !errors.Is(err, cause) {
+		t.Fatalf("expected a ServiceError wrapping the error, got %#v", err)
+	}
+	for _, s := range []string{"[ERROR] Error getting VPC (r006-1): VPC not found\n\n", "Operation: import", "Request ID: req-1"} {
+		if !strings.Contains(err.Error(), s) {
+			t.Errorf("expected the error to contain %q, got %q", s, err.Error())
+		}
+	}
+}
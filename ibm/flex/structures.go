@@ -1973,6 +1973,7 @@ func GetGlobalTagsUsingCRN(meta interface{}, resourceID, resourceType, tagType s
 	for _, item := range taggingResult.Items {
 		taglist = append(taglist, *item.Name)
 	}
+	taglist = meta.(conns.ClientSession).TagConfig().Filter(taglist)
 	log.Println("tagList: ", taglist)
 	return NewStringSet(ResourceIBMVPCHash, taglist), nil
 }
@@ -2011,6 +2012,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	for i, v := range removeInt {
 		remove[i] = fmt.Sprint(v)
 	}
+	remove = meta.(conns.ClientSession).TagConfig().Filter(remove)
 
 	if strings.TrimSpace(tagType) == "" || tagType == "user" {
 		schematicTags := os.Getenv("IC_ENV_TAGS")
@@ -2095,6 +2097,7 @@ func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error)
 	for _, item := range taggingResult.Items {
 		taglist = append(taglist, item.Name)
 	}
+	taglist = meta.(conns.ClientSession).TagConfig().Filter(taglist)
 	log.Println("tagList: ", taglist)
 	return NewStringSet(ResourceIBMVPCHash, taglist), nil
 }
@@ -2122,6 +2125,7 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 	for i, v := range removeInt {
 		remove[i] = fmt.Sprint(v)
 	}
+	remove = meta.(conns.ClientSession).TagConfig().Filter(remove)
 
 	schematicTags := os.Getenv("IC_ENV_TAGS")
 	var envTags []string
@@ -2172,7 +2176,20 @@ func FlattenSSLCiphers(ciphers []datatypes.Network_LBaaS_SSLCipher) *schema.Set
 	return NewStringSet(schema.HashString, c)
 }
 
-func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
+// ResourceTagsCustomizeDiff plans the tags of a taggable resource. The
+// default tags of the provider are merged into its tags and access_tags, so
+// that the plan shows the tags which are attached. meta is nil for the
+// resources the default tags do not apply to.
+func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if meta != nil {
+		tagConfig := meta.(conns.ClientSession).TagConfig()
+		if err := mergeDefaultTags(diff, "tags", tagConfig.DefaultTags); err != nil {
+			return err
+		}
+		if err := mergeDefaultTags(diff, "access_tags", tagConfig.DefaultAccessTags); err != nil {
+			return err
+		}
+	}
 
 	if diff.Id() != "" && diff.HasChange("tags") {
 		o, n := diff.GetChange("tags")
@@ -2191,6 +2208,39 @@ func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 	return nil
 }
 
+// mergeDefaultTags sets the planned value of the tags attribute key to its
+// configured tags and defaults.
+func mergeDefaultTags(diff *schema.ResourceDiff, key string, defaults []string) error {
+	if len(defaults) == 0 {
+		return nil
+	}
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute(key) {
+		return nil
+	}
+	configured := config.GetAttr(key)
+	if !configured.IsKnown() {
+		return nil
+	}
+	var tags []string
+	if !configured.IsNull() {
+		for it := configured.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			if !v.IsKnown() {
+				return nil
+			}
+			if !v.IsNull() {
+				tags = append(tags, v.AsString())
+			}
+		}
+	}
+	merged := conns.MergeTags(tags, defaults)
+	if planned, ok := diff.Get(key).(*schema.Set); ok && conns.SameTags(ExpandStringList(planned.List()), merged) {
+		return nil
+	}
+	return diff.SetNew(key, merged)
+}
+
 func ResourceLBListenerPolicyCustomizeDiff(diff *schema.ResourceDiff) error {
 	policyActionIntf, _ := diff.GetOk(isLBListenerPolicyAction)
 	policyAction := policyActionIntf.(string)
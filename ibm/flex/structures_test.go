@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// tagSession is a ClientSession with the tag configuration of a provider.
+type tagSession struct {
+	conns.ClientSession
+	tags conns.TagConfig
+}
+
+func (s tagSession) TagConfig() conns.TagConfig {
+	return s.tags
+}
+
+func taggedResource() *schema.Resource {
+	tags := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      schema.HashString,
+		}
+	}
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":        {Type: schema.TypeString, Optional: true},
+			"tags":        tags(),
+			"access_tags": tags(),
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return ResourceTagsCustomizeDiff(diff, meta)
+		},
+	}
+}
+
+// tagsDiff plans a resource with the tags and access_tags of its state and
+// configuration, and returns the planned ones.
+func tagsDiff(t *testing.T, tagConfig conns.TagConfig, state, config map[string][]string) map[string][]string {
+	s := &terraform.InstanceState{ID: "r006-1", Attributes: map[string]string{"id": "r006-1"}}
+	raw := map[string]interface{}{}
+	rawConfig := map[string]cty.Value{"name": cty.NullVal(cty.String)}
+	for _, key := range []string{"tags", "access_tags"} {
+		s.Attributes[key+".#"] = strconv.Itoa(len(state[key]))
+		for _, tag := range state[key] {
+			s.Attributes[key+"."+strconv.Itoa(schema.HashString(tag))] = tag
+		}
+		tags, ok := config[key]
+		if !ok {
+			rawConfig[key] = cty.NullVal(cty.Set(cty.String))
+			continue
+		}
+		values := make([]cty.Value, len(tags))
+		list := make([]interface{}, len(tags))
+		for i, tag := range tags {
+			values[i], list[i] = cty.StringVal(tag), tag
+		}
+		if len(values) == 0 {
+			rawConfig[key] = cty.SetValEmpty(cty.String)
+		} else {
+			rawConfig[key] = cty.SetVal(values)
+		}
+		raw[key] = list
+	}
+	s.RawConfig = cty.ObjectVal(rawConfig)
+
+	diff, err := taggedResource().Diff(context.Background(), s, terraform.NewResourceConfigRaw(raw), tagSession{tags: tagConfig})
+	if err != nil {
+		t.Fatal(err)
+	}
+	planned := map[string][]string{}
+	for _, key := range []string{"tags", "access_tags"} {
+		changed, tags := false, []string{}
+		if diff != nil {
+			for k, attr := range diff.Attributes {
+				if !strings.HasPrefix(k, key+".") {
+					continue
+				}
+				changed = true
+				if k != key+".#" && !attr.NewRemoved {
+					tags = append(tags, attr.New)
+				}
+			}
+		}
+		if !changed {
+			tags = append(tags, state[key]...)
+		}
+		planned[key] = tags
+	}
+	for _, tags := range planned {
+		sort.Strings(tags)
+	}
+	return planned
+}
+
+func TestResourceTagsCustomizeDiff(t *testing.T) {
+	defaults := conns.TagConfig{DefaultTags: []string{"env:dev", "team:network"}, DefaultAccessTags: []string{"project:vpc"}}
+	cases := []struct {
+		name       string
+		tagConfig  conns.TagConfig
+		state      map[string][]string
+		config     map[string][]string
+		tags       []string
+		accessTags []string
+	}{
+		{
+			name:       "default tags only",
+			tagConfig:  defaults,
+			state:      map[string][]string{},
+			config:     map[string][]string{},
+			tags:       []string{"env:dev", "team:network"},
+			accessTags: []string{"project:vpc"},
+		},
+		{
+			name:       "explicit tags",
+			tagConfig:  defaults,
+			state:      map[string][]string{},
+			config:     map[string][]string{"tags": {"ENV:dev", "owner:me"}, "access_tags": {"project:dns"}},
+			tags:       []string{"ENV:dev", "owner:me", "team:network"},
+			accessTags: []string{"project:dns", "project:vpc"},
+		},
+		{
+			name:       "applied default tags",
+			tagConfig:  defaults,
+			state:      map[string][]string{"tags": {"env:dev", "owner:me", "team:network"}, "access_tags": {"project:vpc"}},
+			config:     map[string][]string{"tags": {"owner:me"}},
+			tags:       []string{"env:dev", "owner:me", "team:network"},
+			accessTags: []string{"project:vpc"},
+		},
+		{
+			name:       "no default tags",
+			state:      map[string][]string{"tags": {"owner:me"}},
+			config:     map[string][]string{"tags": {"owner:you"}},
+			tags:       []string{"owner:you"},
+			accessTags: []string{},
+		},
+	}
+	for _, c := range cases {
+		planned := tagsDiff(t, c.tagConfig, c.state, c.config)
+		if strings.Join(planned["tags"], ",") != strings.Join(c.tags, ",") {
+			t.Errorf("%s: expected the tags %v, got %v", c.name, c.tags, planned["tags"])
+		}
+		if strings.Join(planned["access_tags"], ",") != strings.Join(c.accessTags, ",") {
+			t.Errorf("%s: expected the access tags %v, got %v", c.name, c.accessTags, planned["access_tags"])
+		}
+	}
+}
+
+func TestResourceTagsCustomizeDiffIgnoredTags(t *testing.T) {
+	// The environment tags attached outside of Terraform are not detached.
+	os.Setenv("IC_ENV_TAGS", "schematics:1")
+	defer os.Unsetenv("IC_ENV_TAGS")
+	planned := tagsDiff(t, conns.TagConfig{}, map[string][]string{"tags": {"owner:me", "schematics:1"}}, map[string][]string{"tags": {"owner:me"}})
+	if strings.Join(planned["tags"], ",") != "owner:me,schematics:1" {
+		t.Errorf("expected the diff of the environment tags to be suppressed, got %v", planned["tags"])
+	}
+
+	// The ignored tags are never read into the state, nor merged with the
+	// default tags.
+	tagConfig := conns.TagConfig{DefaultTags: []string{"env:dev"}, IgnoreTags: []string{"cost-center:*"}}
+	state := map[string][]string{"tags": tagConfig.Filter([]string{"env:dev", "cost-center:42", "owner:me"})}
+	planned = tagsDiff(t, tagConfig, state, map[string][]string{"tags": {"owner:me"}})
+	if strings.Join(planned["tags"], ",") != "env:dev,owner:me" {
+		t.Errorf("expected no diff for the ignored tags, got %v", planned["tags"])
+	}
+}
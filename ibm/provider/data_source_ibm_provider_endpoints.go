@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMProviderEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMProviderEndpointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"endpoints": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The endpoints of the services whose endpoint can be overridden.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The argument of the endpoints block of the provider setting the endpoint.",
+						},
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The environment variable and the key of the endpoints file setting the endpoint.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The service.",
+						},
+						"endpoint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The endpoint of the service, empty if the default endpoint depends on the instance of the service or the region and visibility are not supported.",
+						},
+						"source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The source of the endpoint: provider, environment, file or default.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMProviderEndpointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess := meta.(conns.ClientSession)
+	endpoints := make([]map[string]interface{}, 0, len(conns.ServiceEndpoints))
+	for _, e := range conns.ServiceEndpoints {
+		endpoint, source := sess.EndpointOverride(e.Key)
+		if source == "" {
+			endpoint, source = sess.DefaultEndpoint(e.Key), "default"
+		}
+		endpoints = append(endpoints, map[string]interface{}{
+			"name":     e.Name,
+			"key":      e.Key,
+			"service":  e.Service,
+			"endpoint": endpoint,
+			"source":   source,
+		})
+	}
+
+	d.SetId("endpoints")
+	if err := d.Set("endpoints", endpoints); err != nil {
+		return diag.Errorf("[ERROR] Error setting endpoints: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// frameworkResources are the resources written with the Terraform plugin
+// framework, by resource type name. Their names must not be used by the
+// resources of the SDKv2 provider.
+var frameworkResources = map[string]tfsdk.ResourceType{}
+
+// frameworkDataSources are the data sources written with the Terraform plugin
+// framework, by data source type name.
+var frameworkDataSources = map[string]tfsdk.DataSourceType{}
+
+// ProviderServer returns the protocol version 5 server of the provider. It
+// muxes the SDKv2 provider with the plugin framework provider, which shares
+// the ClientSession of the SDKv2 provider.
+func ProviderServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := Provider()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		sdkProvider.GRPCProvider,
+		FrameworkProviderServer(sdkProvider),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error muxing the provider servers: %s", err)
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// FrameworkProviderServer returns the protocol version 5 server of the plugin
+// framework provider sharing the configuration of sdkProvider.
+func FrameworkProviderServer(sdkProvider *schema.Provider) func() tfprotov5.ProviderServer {
+	server := providerserver.NewProtocol5(&frameworkProvider{sdkProvider: sdkProvider})
+	return func() tfprotov5.ProviderServer {
+		return sharedConfigServer{server()}
+	}
+}
+
+// frameworkProvider is the provider of the resources and data sources written
+// with the plugin framework. It has no configuration of its own.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var _ conns.FrameworkProvider = &frameworkProvider{}
+
+// GetSchema implements tfsdk.Provider. The provider block is defined by the
+// SDKv2 provider.
+func (p *frameworkProvider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{}, nil
+}
+
+// Configure implements tfsdk.Provider. The ClientSession is configured by the
+// SDKv2 provider.
+func (p *frameworkProvider) Configure(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+}
+
+// GetResources implements tfsdk.Provider.
+func (p *frameworkProvider) GetResources(ctx context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
+	return frameworkResources, nil
+}
+
+// GetDataSources implements tfsdk.Provider.
+func (p *frameworkProvider) GetDataSources(ctx context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
+	return frameworkDataSources, nil
+}
+
+// ClientSession implements conns.FrameworkProvider.
+func (p *frameworkProvider) ClientSession() (conns.ClientSession, error) {
+	session, ok := p.sdkProvider.Meta().(conns.ClientSession)
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] The provider is not configured")
+	}
+	return session, nil
+}
+
+// sharedConfigServer serves the plugin framework provider without a provider
+// block. The mux requires the servers to have the same provider schema when
+// they have one, so only the SDKv2 provider validates and configures the
+// provider block.
+type sharedConfigServer struct {
+	tfprotov5.ProviderServer
+}
+
+// GetProviderSchema implements tfprotov5.ProviderServer.
+func (s sharedConfigServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if resp != nil {
+		resp.Provider = nil
+	}
+	return resp, err
+}
+
+// PrepareProviderConfig implements tfprotov5.ProviderServer.
+func (s sharedConfigServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	return &tfprotov5.PrepareProviderConfigResponse{}, nil
+}
+
+// ConfigureProvider implements tfprotov5.ProviderServer.
+func (s sharedConfigServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	return &tfprotov5.ConfigureProviderResponse{}, nil
+}
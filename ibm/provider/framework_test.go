@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestProviderServer(t *testing.T) {
+	ctx := context.Background()
+	server, err := ProviderServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("unexpected error: %s: %s", d.Summary, d.Detail)
+		}
+	}
+	if resp.Provider == nil || resp.Provider.Block == nil {
+		t.Fatal("expected the provider block of the SDKv2 provider")
+	}
+	if _, ok := resp.ResourceSchemas["ibm_is_vpc"]; !ok {
+		t.Fatal("expected the resources of the SDKv2 provider")
+	}
+	if _, ok := resp.DataSourceSchemas["ibm_is_vpc"]; !ok {
+		t.Fatal("expected the data sources of the SDKv2 provider")
+	}
+}
+
+// failingTransport fails every request, so that configuring the provider
+// doesn't reach the network.
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, errors.New("no network in unit tests")
+}
+
+func TestFrameworkProviderClientSession(t *testing.T) {
+	sdkProvider := Provider()
+	p := &frameworkProvider{sdkProvider: sdkProvider}
+	if _, err := p.ClientSession(); err == nil {
+		t.Fatal("expected an error before the provider is configured")
+	}
+
+	sdkProvider.ConfigureFunc = ConfigureWithTransport(failingTransport{})
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"ibmcloud_api_key": "unit-test",
+		"region":           "us-south",
+	})
+	if diags := sdkProvider.Configure(context.Background(), config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	session, err := p.ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session != sdkProvider.Meta() {
+		t.Fatal("expected the ClientSession of the SDKv2 provider")
+	}
+}
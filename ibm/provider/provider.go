@@ -4,13 +4,22 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
 	"sync"
 	"time"
 
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3iface"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/apigateway"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appid"
@@ -56,7 +65,7 @@ import (
 
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -84,11 +93,23 @@ func Provider() *schema.Provider {
 				Description: "The timeout (in seconds) to set for any IBM Cloud API calls made.",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_TIMEOUT", "IBMCLOUD_TIMEOUT"}, 60),
 			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The profile of the shared config file to read the credentials and settings from.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_PROFILE", "IBMCLOUD_PROFILE"}, ""),
+			},
+			"shared_config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The shared config file, a provider credentials file or the IBM Cloud CLI config file.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_SHARED_CONFIG_FILE", "IBMCLOUD_SHARED_CONFIG_FILE"}, ""),
+			},
 			"region": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The IBM cloud Region (for example 'us-south').",
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, "us-south"),
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, ""),
 			},
 			"zone": {
 				Type:        schema.TypeString,
@@ -160,6 +181,170 @@ func Provider() *schema.Provider {
 				Description: "The retry count to set for API calls.",
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRIES", 10),
 			},
+			"min_retry_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The delay in seconds before the first retry of an API call, doubled on each retry.",
+				DefaultFunc:  schema.EnvDefaultFunc("MIN_RETRY_DELAY", 1),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_retry_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum delay in seconds between two retries of an API call.",
+				DefaultFunc:  schema.EnvDefaultFunc("MAX_RETRY_DELAY", 30),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"lock": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Shares the locks serializing the changes to a resource with the other Terraform runs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backend": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "memory",
+							Description:  "The lock backend: memory, file or cos.",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"memory", "file", "cos"}),
+						},
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      int(conns.DefaultLockTimeout / time.Second),
+							Description:  "How long in seconds a lock is waited for.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"stale_after": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      int(conns.DefaultLockStaleAfter / time.Second),
+							Description:  "How long in seconds a lock which is not refreshed by its holder is kept.",
+							ValidateFunc: validation.IntAtLeast(30),
+						},
+						"directory": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The directory of the file locks.",
+						},
+						"cos_bucket": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The bucket of the COS locks.",
+						},
+						"cos_endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The endpoint of the bucket of the COS locks.",
+						},
+						"cos_instance_crn": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the COS instance of the bucket.",
+						},
+						"cos_prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "terraform-provider-ibm/locks/",
+							Description: "The prefix of the objects of the COS locks.",
+						},
+					},
+				},
+			},
+			"response_cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "How long in seconds the responses of the read API calls are cached and shared by the resources and data sources. 0 disables the cache.",
+				DefaultFunc:  schema.EnvDefaultFunc("IBMCLOUD_RESPONSE_CACHE_TTL", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The proxy of the API calls, instead of the proxy of the HTTP_PROXY and HTTPS_PROXY environment variables.",
+				DefaultFunc:  schema.EnvDefaultFunc("IBMCLOUD_PROXY_URL", nil),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM file of the certificate authorities trusted in addition to the ones of the system.",
+				DefaultFunc: schema.EnvDefaultFunc("IBMCLOUD_CA_BUNDLE_FILE", nil),
+			},
+			"client_certificate_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The PEM file of the client certificate of the API calls.",
+				DefaultFunc:  schema.EnvDefaultFunc("IBMCLOUD_CLIENT_CERTIFICATE_FILE", nil),
+				RequiredWith: []string{"client_key_file"},
+			},
+			"client_key_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The PEM file of the key of the client certificate.",
+				DefaultFunc:  schema.EnvDefaultFunc("IBMCLOUD_CLIENT_KEY_FILE", nil),
+				RequiredWith: []string{"client_certificate_file"},
+			},
+			"audit_log_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The directory of the audit log listing the API calls of each run.",
+				DefaultFunc: schema.EnvDefaultFunc("IBMCLOUD_AUDIT_LOG_DIR", nil),
+			},
+			"default_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The user tags attached to every resource supporting tags, in addition to their own tags.",
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateTag},
+				Set:         schema.HashString,
+			},
+			"default_access_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The access management tags attached to every resource supporting access tags, in addition to their own access tags.",
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateTag},
+				Set:         schema.HashString,
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The tags which are neither read, attached nor detached, such as the tags attached by other tools. An entry ending with * matches the tags starting with the rest of it.",
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotWhiteSpace},
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Limits the rate and the concurrency of the API calls to the hosts of a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The API host, or a domain to limit each of its hosts, e.g. iaas.cloud.ibm.com.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Description:  "The number of API calls per second to each host, unlimited if 0.",
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The number of API calls which can be sent at once before being limited to requests_per_second.",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"max_in_flight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The maximum number of concurrent API calls to each host, unlimited if 0.",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -187,6 +372,18 @@ func Provider() *schema.Provider {
 				Description: "IAM Trusted Profile Authentication token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
 			},
+			"cr_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The compute resource token file exchanged for the IAM token of the trusted profile iam_profile_id",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_CR_TOKEN_FILE", "IBMCLOUD_CR_TOKEN_FILE"}, nil),
+			},
+			"metadata_service_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The VPC instance metadata service endpoint, used to log in with the trusted profile iam_profile_id",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_METADATA_SERVICE_ENDPOINT", "IBMCLOUD_METADATA_SERVICE_ENDPOINT"}, nil),
+			},
 			"iam_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -212,6 +409,13 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Endpoints of the services, overriding the endpoints of the environment variables and of the endpoints file",
+				Elem:        &schema.Resource{Schema: endpointsSchema()},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -259,6 +463,7 @@ func Provider() *schema.Provider {
 			"ibm_certificate_manager_certificate":   certificatemanager.DataIBMCertificateManagerCertificate(),
 			"ibm_cis":                               cis.DataSourceIBMCISInstance(),
 			"ibm_cis_dns_records":                   cis.DataSourceIBMCISDNSRecords(),
+			"ibm_cis_dns_records_export":            cis.DataSourceIBMCISDNSRecordsExport(),
 			"ibm_cis_certificates":                  cis.DataSourceIBMCISCertificates(),
 			"ibm_cis_global_load_balancers":         cis.DataSourceIBMCISGlbs(),
 			"ibm_cis_origin_pools":                  cis.DataSourceIBMCISOriginPools(),
@@ -485,6 +690,7 @@ func Provider() *schema.Provider {
 			"ibm_kms_keys":                       kms.DataSourceIBMKMSkeys(),
 			"ibm_kms_key":                        kms.DataSourceIBMKMSkey(),
 			"ibm_pn_application_chrome":          pushnotification.DataSourceIBMPNApplicationChrome(),
+			"ibm_provider_endpoints":             DataSourceIBMProviderEndpoints(),
 			"ibm_app_config_environment":         appconfiguration.DataSourceIBMAppConfigEnvironment(),
 			"ibm_app_config_environments":        appconfiguration.DataSourceIBMAppConfigEnvironments(),
 			"ibm_app_config_feature":             appconfiguration.DataSourceIBMAppConfigFeature(),
@@ -801,6 +1007,10 @@ func Provider() *schema.Provider {
 			"ibm_ob_monitoring":                         kubernetes.ResourceIBMObMonitoring(),
 			"ibm_cos_bucket":                            cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_object":                     cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_objects_sync":               cos.ResourceIBMCOSBucketObjectsSync(),
+			"ibm_cos_bucket_replication_rule":           cos.ResourceIBMCOSBucketReplicationRule(),
+			"ibm_cos_bucket_object_lock_configuration":  cos.ResourceIBMCOSBucketObjectLockConfiguration(),
+			"ibm_cos_bucket_website_configuration":      cos.ResourceIBMCOSBucketWebsiteConfiguration(),
 			"ibm_dns_domain":                            classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":   classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                         classicinfrastructure.ResourceIBMDNSSecondary(),
@@ -1001,6 +1211,14 @@ func Provider() *schema.Provider {
 			"ibm_schematics_inventory":      schematics.ResourceIBMSchematicsInventory(),
 			"ibm_schematics_resource_query": schematics.ResourceIBMSchematicsResourceQuery(),
 
+			// //Added for Secrets Manager
+			"ibm_secrets_manager_secret_group":             secretsmanager.ResourceIBMSecretsManagerSecretGroup(),
+			"ibm_secrets_manager_arbitrary_secret":         secretsmanager.ResourceIBMSecretsManagerArbitrarySecret(),
+			"ibm_secrets_manager_kv_secret":                secretsmanager.ResourceIBMSecretsManagerKvSecret(),
+			"ibm_secrets_manager_username_password_secret": secretsmanager.ResourceIBMSecretsManagerUsernamePasswordSecret(),
+			"ibm_secrets_manager_iam_credentials_secret":   secretsmanager.ResourceIBMSecretsManagerIAMCredentialsSecret(),
+			"ibm_secrets_manager_imported_certificate":     secretsmanager.ResourceIBMSecretsManagerImportedCertificate(),
+
 			// //satellite  resources
 			"ibm_satellite_location":                            satellite.ResourceIBMSatelliteLocation(),
 			"ibm_satellite_host":                                satellite.ResourceIBMSatelliteHost(),
@@ -1093,6 +1311,105 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+	for name, r := range provider.ResourcesMap {
+		withResourceAddress(name, r)
+	}
+	for name, r := range provider.DataSourcesMap {
+		withResourceAddress("data."+name, r)
+	}
+	return provider
+}
+
+// endpointsSchema returns the arguments of the endpoints block, one for each
+// service whose endpoint can be overridden.
+func endpointsSchema() map[string]*schema.Schema {
+	s := make(map[string]*schema.Schema, len(conns.ServiceEndpoints))
+	for _, e := range conns.ServiceEndpoints {
+		s[e.Name] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  fmt.Sprintf("Endpoint of the %s service, overriding %s", e.Service, e.Key),
+		}
+	}
+	return s
+}
+
+// withResourceAddress makes the context of the operations of a resource carry
+// its type and ID, written to the audit log with the API requests sent with it,
+// and structures the errors of the operations with their failed request. The
+// functions returning an error are turned into the ones returning diagnostics.
+func withResourceAddress(name string, r *schema.Resource) {
+	address := func(d interface{ Id() string }) string {
+		if d.Id() == "" {
+			return name
+		}
+		return name + "." + d.Id()
+	}
+	wrap := func(operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			resource := address(d)
+			ctx, failed := conns.WithFailedRequests(conns.WithResource(ctx, name, d.Id()), meta)
+			diags := f(ctx, d, meta)
+			if !diags.HasError() {
+				return diags
+			}
+			return flex.ServiceErrorDiagnostics(diags, resource, operation, failed())
+		}
+	}
+	legacy := func(f func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := f(d, meta); err != nil {
+				return flex.ErrorDiagnostics(err)
+			}
+			return nil
+		}
+	}
+	if r.Create != nil {
+		r.CreateContext, r.Create = legacy(r.Create), nil
+	}
+	if r.Read != nil {
+		r.ReadContext, r.Read = legacy(r.Read), nil
+	}
+	if r.Update != nil {
+		r.UpdateContext, r.Update = legacy(r.Update), nil
+	}
+	if r.Delete != nil {
+		r.DeleteContext, r.Delete = legacy(r.Delete), nil
+	}
+	r.CreateContext = wrap("create", r.CreateContext)
+	r.ReadContext = wrap("read", r.ReadContext)
+	r.UpdateContext = wrap("update", r.UpdateContext)
+	r.DeleteContext = wrap("delete", r.DeleteContext)
+
+	if importer := r.Importer; importer != nil && (importer.StateContext != nil || importer.State != nil) {
+		state := importer.StateContext
+		if state == nil {
+			legacyState := importer.State
+			state = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return legacyState(d, meta)
+			}
+		}
+		r.Importer = &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				ctx, failed := conns.WithFailedRequests(conns.WithResource(ctx, name, d.Id()), meta)
+				imported, err := state(ctx, d, meta)
+				return imported, flex.ServiceErrorOf(err, address(d), "import", failed())
+			},
+		}
+	}
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			ctx, failed := conns.WithFailedRequests(conns.WithResource(ctx, name, diff.Id()), meta)
+			return flex.ServiceErrorOf(customizeDiff(ctx, diff, meta), address(diff), "plan", failed())
+		}
+	}
 }
 
 var globalValidatorDict validate.ValidatorDict
@@ -1140,6 +1457,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_dl_gateway":                  directlink.ResourceIBMDLGatewayValidator(),
 				"ibm_dl_provider_gateway":         directlink.ResourceIBMDLProviderGatewayValidator(),
 				"ibm_database":                    database.ResourceIBMICDValidator(),
+				"ibm_cos_bucket":                  cos.ResourceIBMCOSBucketValidator(),
+				"ibm_lb_vpx_vip":                  classicinfrastructure.ResourceIBMLbVpxVipValidator(),
 				"ibm_function_package":            functions.ResourceIBMFuncPackageValidator(),
 				"ibm_function_action":             functions.ResourceIBMFuncActionValidator(),
 				"ibm_function_rule":               functions.ResourceIBMFuncRuleValidator(),
@@ -1264,6 +1583,16 @@ func Validator() validate.ValidatorDict {
 				"ibm_cd_tekton_pipeline_trigger_property": cdtektonpipeline.ResourceIBMTektonPipelineTriggerPropertyValidator(),
 				"ibm_cd_tekton_pipeline_property":         cdtektonpipeline.ResourceIBMTektonPipelinePropertyValidator(),
 				"ibm_cd_tekton_pipeline_trigger":          cdtektonpipeline.ResourceIBMTektonPipelineTriggerValidator(),
+
+				// // Added for Secrets Manager
+				"ibm_secrets_manager_secret_group":             secretsmanager.ResourceIBMSecretsManagerSecretGroupValidator(),
+				"ibm_secrets_manager_arbitrary_secret":         secretsmanager.ResourceIBMSecretsManagerArbitrarySecretValidator(),
+				"ibm_secrets_manager_kv_secret":                secretsmanager.ResourceIBMSecretsManagerKvSecretValidator(),
+				"ibm_secrets_manager_username_password_secret": secretsmanager.ResourceIBMSecretsManagerUsernamePasswordSecretValidator(),
+				"ibm_secrets_manager_iam_credentials_secret":   secretsmanager.ResourceIBMSecretsManagerIAMCredentialsSecretValidator(),
+				"ibm_secrets_manager_imported_certificate":     secretsmanager.ResourceIBMSecretsManagerImportedCertificateValidator(),
+
+				"ibm_cos_bucket_object_lock_configuration": cos.ResourceIBMCOSBucketObjectLockConfigurationValidator(),
 			},
 			DataSourceValidatorDictionary: map[string]*validate.ResourceValidator{
 				"ibm_is_subnet":          vpc.DataSourceIBMISSubnetValidator(),
@@ -1288,6 +1617,19 @@ func Validator() validate.ValidatorDict {
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	return configureProvider(d, nil)
+}
+
+// ConfigureWithTransport returns a ConfigureFunc which sends every API request
+// of the provider through transport. The acceptance tests use it to record and
+// replay the API traffic of a test case.
+func ConfigureWithTransport(transport http.RoundTripper) schema.ConfigureFunc {
+	return func(d *schema.ResourceData) (interface{}, error) {
+		return configureProvider(d, transport)
+	}
+}
+
+func configureProvider(d *schema.ResourceData, transport http.RoundTripper) (interface{}, error) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
 	var iamToken, iamRefreshToken, iamTrustedProfileId string
@@ -1351,7 +1693,76 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
+
+	// The shared config file only provides the settings which are set neither
+	// in the provider block nor in the environment, the credentials of a
+	// profile are only used together.
+	profile, err := conns.LoadProfile(d.Get("shared_config_file").(string), d.Get("profile").(string))
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		if bluemixAPIKey == "" && iamToken == "" && iamRefreshToken == "" {
+			bluemixAPIKey = profile.IBMCloudAPIKey
+			iamToken = profile.IAMToken
+			iamRefreshToken = profile.IAMRefreshToken
+			if iamTrustedProfileId == "" {
+				iamTrustedProfileId = profile.IAMProfileID
+			}
+		}
+		if softlayerUsername == "" && softlayerAPIKey == "" {
+			softlayerUsername = profile.IAASClassicUsername
+			softlayerAPIKey = profile.IAASClassicAPIKey
+		}
+		if region == "" {
+			region = profile.Region
+		}
+		if zone == "" {
+			zone = profile.Zone
+		}
+		if resourceGrp == "" {
+			resourceGrp = profile.ResourceGroup
+		}
+	}
+	if region == "" {
+		region = "us-south"
+	}
 	retryCount := d.Get("max_retries").(int)
+	minRetryDelay := d.Get("min_retry_delay").(int)
+	maxRetryDelay := d.Get("max_retry_delay").(int)
+	if minRetryDelay > maxRetryDelay {
+		return nil, fmt.Errorf("[ERROR] min_retry_delay (%d) must not be greater than max_retry_delay (%d)", minRetryDelay, maxRetryDelay)
+	}
+	var rateLimits []conns.RateLimit
+	for _, l := range d.Get("rate_limit").([]interface{}) {
+		limit := l.(map[string]interface{})
+		rateLimits = append(rateLimits, conns.RateLimit{
+			Host:              limit["host"].(string),
+			RequestsPerSecond: limit["requests_per_second"].(float64),
+			Burst:             limit["burst"].(int),
+			MaxInFlight:       limit["max_in_flight"].(int),
+		})
+	}
+	endpoints := map[string]string{}
+	if l := d.Get("endpoints").([]interface{}); len(l) > 0 && l[0] != nil {
+		block := l[0].(map[string]interface{})
+		for _, e := range conns.ServiceEndpoints {
+			if url := block[e.Name].(string); url != "" {
+				endpoints[e.Key] = url
+			}
+		}
+	}
+	network := conns.NetworkConfig{
+		ProxyURL:       d.Get("proxy_url").(string),
+		CABundleFile:   d.Get("ca_bundle_file").(string),
+		ClientCertFile: d.Get("client_certificate_file").(string),
+		ClientKeyFile:  d.Get("client_key_file").(string),
+	}
+	tags := conns.TagConfig{
+		DefaultTags:       flex.ExpandStringList(d.Get("default_tags").(*schema.Set).List()),
+		DefaultAccessTags: flex.ExpandStringList(d.Get("default_access_tags").(*schema.Set).List()),
+		IgnoreTags:        flex.ExpandStringList(d.Get("ignore_tags").([]interface{})),
+	}
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
@@ -1365,25 +1776,93 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	config := conns.Config{
-		BluemixAPIKey:        bluemixAPIKey,
-		Region:               region,
-		ResourceGroup:        resourceGrp,
-		BluemixTimeout:       time.Duration(bluemixTimeout) * time.Second,
-		SoftLayerTimeout:     time.Duration(softlayerTimeout) * time.Second,
-		SoftLayerUserName:    softlayerUsername,
-		SoftLayerAPIKey:      softlayerAPIKey,
-		RetryCount:           retryCount,
-		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           conns.RetryAPIDelay,
-		FunctionNameSpace:    wskNameSpace,
-		RiaasEndPoint:        riaasEndPoint,
-		IAMToken:             iamToken,
-		IAMRefreshToken:      iamRefreshToken,
-		Zone:                 zone,
-		Visibility:           visibility,
-		EndpointsFile:        file,
-		IAMTrustedProfileID:  iamTrustedProfileId,
+		BluemixAPIKey:           bluemixAPIKey,
+		Region:                  region,
+		ResourceGroup:           resourceGrp,
+		BluemixTimeout:          time.Duration(bluemixTimeout) * time.Second,
+		SoftLayerTimeout:        time.Duration(softlayerTimeout) * time.Second,
+		SoftLayerUserName:       softlayerUsername,
+		SoftLayerAPIKey:         softlayerAPIKey,
+		RetryCount:              retryCount,
+		SoftLayerEndpointURL:    softlayerEndpointUrl,
+		RetryDelay:              conns.RetryAPIDelay,
+		RetryMinDelay:           time.Duration(minRetryDelay) * time.Second,
+		RetryMaxDelay:           time.Duration(maxRetryDelay) * time.Second,
+		RateLimits:              rateLimits,
+		AuditLogDir:             d.Get("audit_log_dir").(string),
+		ResponseCacheTTL:        time.Duration(d.Get("response_cache_ttl").(int)) * time.Second,
+		Tags:                    tags,
+		FunctionNameSpace:       wskNameSpace,
+		RiaasEndPoint:           riaasEndPoint,
+		IAMToken:                iamToken,
+		IAMRefreshToken:         iamRefreshToken,
+		Zone:                    zone,
+		Visibility:              visibility,
+		EndpointsFile:           file,
+		Network:                 network,
+		Endpoints:               endpoints,
+		IAMTrustedProfileID:     iamTrustedProfileId,
+		CRTokenFile:             d.Get("cr_token_file").(string),
+		MetadataServiceEndpoint: d.Get("metadata_service_endpoint").(string),
+		HTTPTransport:           transport,
 	}
 
-	return config.ClientSession()
+	// The locker of the cos backend reads its bucket with the session, once
+	// configured.
+	var session interface{}
+	if l, ok := d.GetOk("lock"); ok {
+		locker, timeout, err := newLocker(l.([]interface{})[0].(map[string]interface{}), func() conns.ClientSession {
+			return session.(conns.ClientSession)
+		})
+		if err != nil {
+			return nil, err
+		}
+		config.Locker, config.LockTimeout = locker, timeout
+	}
+
+	session, err = config.ClientSession()
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// validateTag validates a tag of default_tags and default_access_tags, with
+// the rules of the tagging service.
+var validateTag = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9:_ .-]+$`), "must only contain letters, numbers, spaces and the characters _ . - :"),
+)
+
+// newLocker returns the backend holding the locks of the ClientSession across
+// processes, none for the memory backend, and how long a lock is waited for.
+func newLocker(lock map[string]interface{}, session func() conns.ClientSession) (conns.Locker, time.Duration, error) {
+	timeout := time.Duration(lock["timeout"].(int)) * time.Second
+	staleAfter := time.Duration(lock["stale_after"].(int)) * time.Second
+	switch lock["backend"].(string) {
+	case "file":
+		dir := lock["directory"].(string)
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "terraform-provider-ibm-locks")
+		}
+		locker, err := conns.NewFileLocker(dir, staleAfter)
+		if err != nil {
+			return nil, 0, err
+		}
+		return locker, timeout, nil
+	case "cos":
+		bucket, endpoint, instanceCRN := lock["cos_bucket"].(string), lock["cos_endpoint"].(string), lock["cos_instance_crn"].(string)
+		if bucket == "" || endpoint == "" || instanceCRN == "" {
+			return nil, 0, fmt.Errorf("[ERROR] cos_bucket, cos_endpoint and cos_instance_crn must be set for the cos lock backend")
+		}
+		locker := conns.NewCOSLocker(bucket, lock["cos_prefix"].(string), staleAfter, func() (s3iface.S3API, error) {
+			bxSession, err := session().BluemixSession()
+			if err != nil {
+				return nil, err
+			}
+			return conns.NewCOSLockerClient(bxSession, endpoint, instanceCRN)
+		})
+		return locker, timeout, nil
+	}
+	return nil, timeout, nil
 }
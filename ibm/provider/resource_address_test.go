@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestWithResourceAddressImportAndPlan(t *testing.T) {
+	notFound := fmt.Errorf("[ERROR] Error getting VPC (r006-1): VPC not found\n%s", &core.DetailedResponse{StatusCode: 404, Result: map[string]interface{}{"trace": "trace-1"}})
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return nil, notFound
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return notFound
+		},
+	}
+	withResourceAddress("ibm_is_vpc", r)
+
+	d := r.Data(nil)
+	d.SetId("r006-1")
+	_, err := r.Importer.StateContext(context.Background(), d, nil)
+	var serviceErr *flex.ServiceError
+	if !errors.As(err, &serviceErr) || serviceErr.Resource != "ibm_is_vpc.r006-1" || serviceErr.Operation != "import" || serviceErr.Trace != "trace-1" {
+		t.Fatalf("expected the ServiceError of the import, got %#v", err)
+	}
+	if !strings.Contains(err.Error(), "Operation: import") {
+		t.Errorf("expected the error to list its operation, got %q", err.Error())
+	}
+
+	state := &terraform.InstanceState{
+		ID:         "r006-1",
+		Attributes: map[string]string{"id": "r006-1", "name": "vpc-1"},
+		RawConfig:  cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("vpc-2")}),
+	}
+	_, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "vpc-2"}), nil)
+	if !errors.As(err, &serviceErr) || serviceErr.Resource != "ibm_is_vpc.r006-1" || serviceErr.Operation != "plan" || !errors.Is(err, notFound) {
+		t.Fatalf("expected the ServiceError of the plan, got %#v", err)
+	}
+}
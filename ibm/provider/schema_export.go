@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+)
+
+// SchemaExport is the machine-readable description of the provider written by
+// ExportSchema. ProviderSchema has the format of the provider schemas of
+// `terraform providers schema -json`, and Validators are the constraints the
+// provider enforces on the arguments of the resources and data sources.
+type SchemaExport struct {
+	FormatVersion   string                 `json:"format_version"`
+	ProviderVersion string                 `json:"provider_version"`
+	ProviderSchema  *ProviderSchemaExport  `json:"provider_schema"`
+	Validators      validate.ValidatorDict `json:"validators"`
+}
+
+// ProviderSchemaExport is the schema of the provider block, the resources and
+// the data sources.
+type ProviderSchemaExport struct {
+	Provider          *SchemaJSON            `json:"provider"`
+	ResourceSchemas   map[string]*SchemaJSON `json:"resource_schemas"`
+	DataSourceSchemas map[string]*SchemaJSON `json:"data_source_schemas"`
+}
+
+// SchemaJSON is the schema of the provider block, a resource or a data
+// source.
+type SchemaJSON struct {
+	Version int64      `json:"version"`
+	Block   *BlockJSON `json:"block"`
+}
+
+// BlockJSON is a block of a schema.
+type BlockJSON struct {
+	Attributes      map[string]*AttributeJSON `json:"attributes,omitempty"`
+	BlockTypes      map[string]*BlockTypeJSON `json:"block_types,omitempty"`
+	Description     string                    `json:"description,omitempty"`
+	DescriptionKind string                    `json:"description_kind,omitempty"`
+	Deprecated      bool                      `json:"deprecated,omitempty"`
+}
+
+// AttributeJSON is an attribute of a block. Type is the JSON encoding of the
+// attribute type, e.g. "string" or ["list","string"].
+type AttributeJSON struct {
+	Type            json.RawMessage `json:"type"`
+	Description     string          `json:"description,omitempty"`
+	DescriptionKind string          `json:"description_kind,omitempty"`
+	Required        bool            `json:"required,omitempty"`
+	Optional        bool            `json:"optional,omitempty"`
+	Computed        bool            `json:"computed,omitempty"`
+	Sensitive       bool            `json:"sensitive,omitempty"`
+	Deprecated      bool            `json:"deprecated,omitempty"`
+}
+
+// BlockTypeJSON is a block nested in a block.
+type BlockTypeJSON struct {
+	NestingMode string     `json:"nesting_mode"`
+	Block       *BlockJSON `json:"block"`
+	MinItems    int64      `json:"min_items,omitempty"`
+	MaxItems    int64      `json:"max_items,omitempty"`
+}
+
+// ExportSchema writes the schema of the provider, and the validators of its
+// resources and data sources, as JSON to w. The schema is read from the
+// provider server, so it includes the resources of the plugin framework.
+func ExportSchema(ctx context.Context, w io.Writer) error {
+	providerServer, err := ProviderServer(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := providerServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting the provider schema: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return fmt.Errorf("[ERROR] Error getting the provider schema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	export := SchemaExport{
+		FormatVersion:   "1.0",
+		ProviderVersion: version.Version,
+		ProviderSchema: &ProviderSchemaExport{
+			ResourceSchemas:   map[string]*SchemaJSON{},
+			DataSourceSchemas: map[string]*SchemaJSON{},
+		},
+		Validators: Validator(),
+	}
+	if export.ProviderSchema.Provider, err = schemaJSON(resp.Provider); err != nil {
+		return err
+	}
+	for name, s := range resp.ResourceSchemas {
+		if export.ProviderSchema.ResourceSchemas[name], err = schemaJSON(s); err != nil {
+			return fmt.Errorf("[ERROR] Error exporting the schema of %s: %s", name, err)
+		}
+	}
+	for name, s := range resp.DataSourceSchemas {
+		if export.ProviderSchema.DataSourceSchemas[name], err = schemaJSON(s); err != nil {
+			return fmt.Errorf("[ERROR] Error exporting the schema of %s: %s", name, err)
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(export)
+}
+
+func schemaJSON(s *tfprotov5.Schema) (*SchemaJSON, error) {
+	if s == nil {
+		return nil, nil
+	}
+	block, err := blockJSON(s.Block)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaJSON{Version: s.Version, Block: block}, nil
+}
+
+func blockJSON(b *tfprotov5.SchemaBlock) (*BlockJSON, error) {
+	if b == nil {
+		return &BlockJSON{}, nil
+	}
+	block := &BlockJSON{
+		Description:     b.Description,
+		DescriptionKind: descriptionKind(b.Description, b.DescriptionKind),
+		Deprecated:      b.Deprecated,
+	}
+	for _, a := range b.Attributes {
+		t, err := json.Marshal(a.Type)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %s", a.Name, err)
+		}
+		if block.Attributes == nil {
+			block.Attributes = map[string]*AttributeJSON{}
+		}
+		block.Attributes[a.Name] = &AttributeJSON{
+			Type:            t,
+			Description:     a.Description,
+			DescriptionKind: descriptionKind(a.Description, a.DescriptionKind),
+			Required:        a.Required,
+			Optional:        a.Optional,
+			Computed:        a.Computed,
+			Sensitive:       a.Sensitive,
+			Deprecated:      a.Deprecated,
+		}
+	}
+	for _, bt := range b.BlockTypes {
+		nested, err := blockJSON(bt.Block)
+		if err != nil {
+			return nil, fmt.Errorf("block %s: %s", bt.TypeName, err)
+		}
+		if block.BlockTypes == nil {
+			block.BlockTypes = map[string]*BlockTypeJSON{}
+		}
+		block.BlockTypes[bt.TypeName] = &BlockTypeJSON{
+			NestingMode: strings.ToLower(bt.Nesting.String()),
+			Block:       nested,
+			MinItems:    bt.MinItems,
+			MaxItems:    bt.MaxItems,
+		}
+	}
+	return block, nil
+}
+
+func descriptionKind(description string, kind tfprotov5.StringKind) string {
+	if description == "" {
+		return ""
+	}
+	if kind == tfprotov5.StringKindMarkdown {
+		return "markdown"
+	}
+	return "plain"
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestExportSchema(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportSchema(context.Background(), &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var export struct {
+		FormatVersion  string `json:"format_version"`
+		ProviderSchema struct {
+			Provider        *SchemaJSON            `json:"provider"`
+			ResourceSchemas map[string]*SchemaJSON `json:"resource_schemas"`
+		} `json:"provider_schema"`
+		Validators struct {
+			ResourceValidatorDictionary   map[string]json.RawMessage
+			DataSourceValidatorDictionary map[string]json.RawMessage
+		} `json:"validators"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &export); err != nil {
+		t.Fatalf("expected JSON, got %s: %s", err, buf.Bytes())
+	}
+	if export.FormatVersion != "1.0" {
+		t.Fatalf("unexpected format version %q", export.FormatVersion)
+	}
+
+	provider := export.ProviderSchema.Provider
+	if provider == nil || provider.Block == nil || provider.Block.Attributes["region"] == nil {
+		t.Fatal("expected the provider block with its region attribute")
+	}
+	vpc := export.ProviderSchema.ResourceSchemas["ibm_is_vpc"]
+	if vpc == nil || vpc.Block == nil {
+		t.Fatal("expected the schema of ibm_is_vpc")
+	}
+	if name := vpc.Block.Attributes["name"]; name == nil || string(name.Type) != `"string"` || !name.Required {
+		t.Fatalf("unexpected name attribute of ibm_is_vpc: %+v", name)
+	}
+
+	if _, ok := export.Validators.ResourceValidatorDictionary["ibm_cis_healthcheck"]; !ok {
+		t.Fatal("expected the resource validator of ibm_cis_healthcheck")
+	}
+	if _, ok := export.Validators.DataSourceValidatorDictionary["ibm_is_subnet"]; !ok {
+		t.Fatal("expected the data source validator of ibm_is_subnet")
+	}
+}
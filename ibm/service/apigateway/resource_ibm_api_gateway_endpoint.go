@@ -4,6 +4,7 @@
 package apigateway
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"path"
@@ -12,18 +13,19 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	apigatewaysdk "github.com/IBM/apigateway-go-sdk/apigatewaycontrollerapiv1"
 	"github.com/ghodss/yaml"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMApiGatewayEndPoint() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMApiGatewayEndPointCreate,
-		Read:     resourceIBMApiGatewayEndPointGet,
-		Update:   resourceIBMApiGatewayEndPointUpdate,
-		Delete:   resourceIBMApiGatewayEndPointDelete,
-		Importer: &schema.ResourceImporter{},
-		Exists:   resourceIBMApiGatewayEndPointExists,
+		CreateContext: resourceIBMApiGatewayEndPointCreate,
+		ReadContext:   resourceIBMApiGatewayEndPointGet,
+		UpdateContext: resourceIBMApiGatewayEndPointUpdate,
+		DeleteContext: resourceIBMApiGatewayEndPointDelete,
+		Importer:      &schema.ResourceImporter{},
+		Exists:        resourceIBMApiGatewayEndPointExists,
 		Schema: map[string]*schema.Schema{
 			"service_instance_crn": {
 				Type:        schema.TypeString,
@@ -84,14 +86,14 @@ func ResourceIBMApiGatewayEndPoint() *schema.Resource {
 	}
 }
 
-func resourceIBMApiGatewayEndPointCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndPointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(conns.ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	payload := &apigatewaysdk.CreateEndpointOptions{}
 
@@ -118,23 +120,23 @@ func resourceIBMApiGatewayEndPointCreate(d *schema.ResourceData, meta interface{
 			data, err := ioutil.ReadFile(openAPIDocName)
 			if err != nil {
 				fmt.Println("Error uploading file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			document = data
 		} else if strings.ToLower(ext) == ".yaml" || strings.ToLower(ext) == ".yml" {
 			data, err := ioutil.ReadFile(openAPIDocName)
 			if err != nil {
 				fmt.Println("Error uploading file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			y2j, yErr := yaml.YAMLToJSON(data)
 			if yErr != nil {
 				fmt.Println("Error parsing yaml file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			document = y2j
 		} else {
-			return fmt.Errorf("[ERROR] File extension type must be json or yaml")
+			return diag.FromErr(fmt.Errorf("[ERROR] File extension type must be json or yaml"))
 
 		}
 	}
@@ -153,28 +155,28 @@ func resourceIBMApiGatewayEndPointCreate(d *schema.ResourceData, meta interface{
 
 	result, response, err := endpointservice.CreateEndpoint(payload)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Endpoint: %s,%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Endpoint: %s,%s", err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s//%s", *result.ServiceInstanceCrn, *result.ArtifactID))
 
-	return resourceIBMApiGatewayEndPointGet(d, meta)
+	return resourceIBMApiGatewayEndPointGet(ctx, d, meta)
 }
 
-func resourceIBMApiGatewayEndPointGet(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndPointGet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(conns.ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts := d.Id()
 	partslist := strings.Split(parts, "//")
 	if len(partslist) < 2 {
-		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of instanceCRN//artifactID", d.Id())
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of instanceCRN//artifactID", d.Id()))
 	}
 
 	serviceInstanceCrn := partslist[0]
@@ -194,7 +196,7 @@ func resourceIBMApiGatewayEndPointGet(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Endpoint: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Endpoint: %s\n%s", err, response))
 	}
 	d.Set("service_instance_crn", serviceInstanceCrn)
 	d.Set("endpoint_id", apiID)
@@ -213,14 +215,14 @@ func resourceIBMApiGatewayEndPointGet(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndPointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(conns.ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	//payload for updating endpoint
 	payload := &apigatewaysdk.UpdateEndpointOptions{}
@@ -255,23 +257,23 @@ func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{
 			data, err := ioutil.ReadFile(openAPIDocName)
 			if err != nil {
 				fmt.Println("Error uploading file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			document = data
 		} else if strings.ToLower(ext) == ".yaml" || strings.ToLower(ext) == ".yml" {
 			data, err := ioutil.ReadFile(openAPIDocName)
 			if err != nil {
 				fmt.Println("Error uploading file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			y2j, yErr := yaml.YAMLToJSON(data)
 			if yErr != nil {
 				fmt.Println("Error parsing yaml file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			document = y2j
 		} else {
-			return fmt.Errorf("[ERROR] File extension type must be json or yaml")
+			return diag.FromErr(fmt.Errorf("[ERROR] File extension type must be json or yaml"))
 
 		}
 	}
@@ -305,13 +307,13 @@ func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{
 		actionType := d.Get("type").(string)
 
 		if !managed && actionType == "share" {
-			return fmt.Errorf("[ERROR] Endpoint %s not managed", apiID)
+			return diag.FromErr(fmt.Errorf("[ERROR] Endpoint %s not managed", apiID))
 		}
 		actionPayload.Type = &actionType
 
 		_, response, err := endpointservice.EndpointActions(actionPayload)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Endpoint Action: %s,%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Endpoint Action: %s,%s", err, response))
 		}
 	}
 
@@ -325,23 +327,23 @@ func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{
 				data, err := ioutil.ReadFile(openAPIDocName)
 				if err != nil {
 					fmt.Println("Error uploading file", err)
-					return err
+					return diag.FromErr(err)
 				}
 				document = data
 			} else if strings.ToLower(ext) == ".yaml" || strings.ToLower(ext) == ".yml" {
 				data, err := ioutil.ReadFile(openAPIDocName)
 				if err != nil {
 					fmt.Println("Error uploading file", err)
-					return err
+					return diag.FromErr(err)
 				}
 				y2j, yErr := yaml.YAMLToJSON(data)
 				if yErr != nil {
 					fmt.Println("Error parsing yaml file", err)
-					return err
+					return diag.FromErr(err)
 				}
 				document = y2j
 			} else {
-				return fmt.Errorf("[ERROR] File extension type must be json or yaml")
+				return diag.FromErr(fmt.Errorf("[ERROR] File extension type must be json or yaml"))
 
 			}
 		}
@@ -356,19 +358,19 @@ func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{
 	if update {
 		_, response, err := endpointservice.UpdateEndpoint(payload)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Endpoint: %s,%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Endpoint: %s,%s", err, response))
 		}
 	}
-	return resourceIBMApiGatewayEndPointGet(d, meta)
+	return resourceIBMApiGatewayEndPointGet(ctx, d, meta)
 }
-func resourceIBMApiGatewayEndPointDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndPointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(conns.ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts := d.Id()
@@ -391,7 +393,7 @@ func resourceIBMApiGatewayEndPointDelete(d *schema.ResourceData, meta interface{
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Endpoint: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Endpoint: %s\n%s", err, response))
 	}
 	d.SetId("")
 
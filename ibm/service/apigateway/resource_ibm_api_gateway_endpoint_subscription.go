@@ -4,24 +4,26 @@
 package apigateway
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	apigatewaysdk "github.com/IBM/apigateway-go-sdk/apigatewaycontrollerapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMApiGatewayEndpointSubscription() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMApiGatewayEndpointSubscriptionCreate,
-		Read:     resourceIBMApiGatewayEndpointSubscriptionGet,
-		Update:   resourceIBMApiGatewayEndpointSubscriptionUpdate,
-		Delete:   resourceIBMApiGatewayEndpointSubscriptionDelete,
-		Importer: &schema.ResourceImporter{},
-		Exists:   resourceIBMApiGatewayEndpointSubscriptionExists,
+		CreateContext: resourceIBMApiGatewayEndpointSubscriptionCreate,
+		ReadContext:   resourceIBMApiGatewayEndpointSubscriptionGet,
+		UpdateContext: resourceIBMApiGatewayEndpointSubscriptionUpdate,
+		DeleteContext: resourceIBMApiGatewayEndpointSubscriptionDelete,
+		Importer:      &schema.ResourceImporter{},
+		Exists:        resourceIBMApiGatewayEndpointSubscriptionExists,
 		Schema: map[string]*schema.Schema{
 			"artifact_id": {
 				Type:        schema.TypeString,
@@ -67,14 +69,14 @@ func ResourceIBMApiGatewayEndpointSubscription() *schema.Resource {
 		},
 	}
 }
-func resourceIBMApiGatewayEndpointSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(conns.ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	payload := &apigatewaysdk.CreateSubscriptionOptions{}
 
@@ -116,27 +118,27 @@ func resourceIBMApiGatewayEndpointSubscriptionCreate(d *schema.ResourceData, met
 
 	result, response, err := endpointservice.CreateSubscription(payload)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Subscription: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Subscription: %s %s", err, response))
 	}
 	d.SetId(fmt.Sprintf("%s//%s", *result.ArtifactID, *result.ClientID))
 
-	return resourceIBMApiGatewayEndpointSubscriptionGet(d, meta)
+	return resourceIBMApiGatewayEndpointSubscriptionGet(ctx, d, meta)
 }
 
-func resourceIBMApiGatewayEndpointSubscriptionGet(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionGet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(conns.ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts := d.Id()
 	partslist := strings.Split(parts, "//")
 	if len(partslist) < 2 {
-		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of artifactID//clientID", d.Id())
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of artifactID//clientID", d.Id()))
 	}
 	artifactID := partslist[0]
 	clientID := partslist[1]
@@ -155,7 +157,7 @@ func resourceIBMApiGatewayEndpointSubscriptionGet(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subscription: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Subscription: %s\n%s", err, response))
 	}
 	d.Set("artifact_id", result.ArtifactID)
 	d.Set("client_id", result.ClientID)
@@ -170,14 +172,14 @@ func resourceIBMApiGatewayEndpointSubscriptionGet(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceIBMApiGatewayEndpointSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(conns.ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	payload := &apigatewaysdk.UpdateSubscriptionOptions{}
 
@@ -216,26 +218,26 @@ func resourceIBMApiGatewayEndpointSubscriptionUpdate(d *schema.ResourceData, met
 		}
 		_, SecretResponse, err := endpointservice.AddSubscriptionSecret(secretpayload)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Adding Secret to Subscription: %s,%s", err, SecretResponse)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Adding Secret to Subscription: %s,%s", err, SecretResponse))
 		}
 	}
 	if update {
 		_, response, err := endpointservice.UpdateSubscription(payload)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Subscription: %s,%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Subscription: %s,%s", err, response))
 		}
 	}
-	return resourceIBMApiGatewayEndpointSubscriptionGet(d, meta)
+	return resourceIBMApiGatewayEndpointSubscriptionGet(ctx, d, meta)
 }
 
-func resourceIBMApiGatewayEndpointSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(conns.ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts := d.Id()
 	partslist := strings.Split(parts, "//")
@@ -255,7 +257,7 @@ func resourceIBMApiGatewayEndpointSubscriptionDelete(d *schema.ResourceData, met
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Subscription: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Subscription: %s\n%s", err, response))
 	}
 	d.SetId("")
 
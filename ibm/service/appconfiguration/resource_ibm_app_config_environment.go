@@ -4,21 +4,23 @@
 package appconfiguration
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMAppConfigEnvironment() *schema.Resource {
 	return &schema.Resource{
-		Read:     resourceEnvironmentRead,
-		Create:   resourceEnvironmentCreate,
-		Update:   resourceEnvironmentUpdate,
-		Delete:   resourceEnvironmentDelete,
-		Importer: &schema.ResourceImporter{},
+		ReadContext:   resourceEnvironmentRead,
+		CreateContext: resourceEnvironmentCreate,
+		UpdateContext: resourceEnvironmentUpdate,
+		DeleteContext: resourceEnvironmentDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -81,16 +83,16 @@ func getAppConfigClient(meta interface{}, guid string) (*appconfigurationv1.AppC
 		return nil, err
 	}
 	appConfigURL := fmt.Sprintf("https://%s.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/%s", bluemixSession.Config.Region, guid)
-	url := conns.EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_API_ENDPOINT"}, appConfigURL)
+	url := meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_APP_CONFIG_API_ENDPOINT", appConfigURL)
 	appconfigClient.Service.Options.URL = url
 	return appconfigClient, nil
 }
 
-func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	guid := d.Get("guid").(string)
 	appconfigClient, err := getAppConfigClient(meta, guid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &appconfigurationv1.CreateEnvironmentOptions{}
 
@@ -105,17 +107,17 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 	if _, ok := d.GetOk("color_code"); ok {
 		options.SetColorCode(d.Get("color_code").(string))
 	}
-	_, response, err := appconfigClient.CreateEnvironment(options)
+	_, response, err := appconfigClient.CreateEnvironmentWithContext(ctx, options)
 
 	if err != nil {
-		return fmt.Errorf("[DEBUG] CreateEnvironment failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] CreateEnvironment failed %s\n%s", err, response))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", guid, *options.EnvironmentID))
 
-	return resourceEnvironmentRead(d, meta)
+	return resourceEnvironmentRead(ctx, d, meta)
 }
 
-func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if ok := d.HasChanges("name", "tags", "color_code", "description"); ok {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
@@ -123,7 +125,7 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		appconfigClient, err := getAppConfigClient(meta, parts[0])
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		options := &appconfigurationv1.UpdateEnvironmentOptions{}
@@ -140,23 +142,23 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 			options.SetColorCode(d.Get("color_code").(string))
 		}
 
-		_, response, err := appconfigClient.UpdateEnvironment(options)
+		_, response, err := appconfigClient.UpdateEnvironmentWithContext(ctx, options)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] UpdateEnvironment failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[DEBUG] UpdateEnvironment failed %s\n%s", err, response))
 		}
-		return resourceEnvironmentRead(d, meta)
+		return resourceEnvironmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
 		return nil
 	}
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.GetEnvironmentOptions{}
@@ -164,56 +166,56 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 	options.SetExpand(true)
 	options.SetEnvironmentID(parts[1])
 
-	result, response, err := appconfigClient.GetEnvironment(options)
+	result, response, err := appconfigClient.GetEnvironmentWithContext(ctx, options)
 
 	if err != nil {
-		return fmt.Errorf("[DEBUG] GetEnvironment failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] GetEnvironment failed %s\n%s", err, response))
 	}
 	d.Set("guid", parts[0])
 	if result.Name != nil {
 		if err = d.Set("name", result.Name); err != nil {
-			return fmt.Errorf("[ERROR] Error setting name: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
 		}
 	}
 	if result.EnvironmentID != nil {
 		if err = d.Set("environment_id", result.EnvironmentID); err != nil {
-			return fmt.Errorf("[ERROR] Error setting environment_id: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting environment_id: %s", err))
 		}
 	}
 	if result.Description != nil {
 		if err = d.Set("description", result.Description); err != nil {
-			return fmt.Errorf("[ERROR] Error setting description: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
 		}
 	}
 	if result.Tags != nil {
 		if err = d.Set("tags", result.Tags); err != nil {
-			return fmt.Errorf("[ERROR] Error setting tags: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting tags: %s", err))
 		}
 	}
 	if result.ColorCode != nil {
 		if err = d.Set("color_code", result.ColorCode); err != nil {
-			return fmt.Errorf("[ERROR] Error setting color_code: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting color_code: %s", err))
 		}
 	}
 	if result.CreatedTime != nil {
 		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
-			return fmt.Errorf("[ERROR] Error setting created_time: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_time: %s", err))
 		}
 	}
 	if result.UpdatedTime != nil {
 		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
-			return fmt.Errorf("[ERROR] Error setting updated_time: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_time: %s", err))
 		}
 	}
 	if result.Href != nil {
 		if err = d.Set("href", result.Href); err != nil {
-			return fmt.Errorf("[ERROR] Error setting href: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
 		}
 	}
 	return nil
 }
 
-func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
 		return nil
@@ -221,20 +223,20 @@ func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.DeleteEnvironmentOptions{}
 	options.SetEnvironmentID(parts[1])
 
-	response, err := appconfigClient.DeleteEnvironment(options)
+	response, err := appconfigClient.DeleteEnvironmentWithContext(ctx, options)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] DeleteEnvironment failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] DeleteEnvironment failed %s\n%s", err, response))
 	}
 	d.SetId("")
 	return nil
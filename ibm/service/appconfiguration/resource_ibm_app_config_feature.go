@@ -4,12 +4,14 @@
 package appconfiguration
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
@@ -18,11 +20,11 @@ import (
 
 func ResourceIBMIbmAppConfigFeature() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIbmIbmAppConfigFeatureCreate,
-		Read:     resourceIbmIbmAppConfigFeatureRead,
-		Update:   resourceIbmIbmAppConfigFeatureUpdate,
-		Delete:   resourceIbmIbmAppConfigFeatureDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIbmIbmAppConfigFeatureCreate,
+		ReadContext:   resourceIbmIbmAppConfigFeatureRead,
+		UpdateContext: resourceIbmIbmAppConfigFeatureUpdate,
+		DeleteContext: resourceIbmIbmAppConfigFeatureDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -158,11 +160,11 @@ func ResourceIBMIbmAppConfigFeature() *schema.Resource {
 	}
 }
 
-func resourceIbmIbmAppConfigFeatureCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmIbmAppConfigFeatureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	guid := d.Get("guid").(string)
 	appconfigClient, err := getAppConfigClient(meta, guid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &appconfigurationv1.CreateFeatureOptions{}
 	options.SetType(d.Get("type").(string))
@@ -187,7 +189,7 @@ func resourceIbmIbmAppConfigFeatureCreate(d *schema.ResourceData, meta interface
 			value := e.(map[string]interface{})
 			segmentRulesItem, err := resourceIbmAppConfigFeatureMapToSegmentRule(d, value)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			segmentRules = append(segmentRules, segmentRulesItem)
 		}
@@ -203,24 +205,24 @@ func resourceIbmIbmAppConfigFeatureCreate(d *schema.ResourceData, meta interface
 		options.SetCollections(collections)
 	}
 
-	feature, response, err := appconfigClient.CreateFeature(options)
+	feature, response, err := appconfigClient.CreateFeatureWithContext(ctx, options)
 
 	if err != nil {
 		log.Printf("CreateFeature failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", guid, *options.EnvironmentID, *feature.FeatureID))
-	return resourceIbmIbmAppConfigFeatureRead(d, meta)
+	return resourceIbmIbmAppConfigFeatureRead(ctx, d, meta)
 }
 
-func resourceIbmIbmAppConfigFeatureUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmIbmAppConfigFeatureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
 		return nil
 	}
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.UpdateFeatureOptions{}
@@ -247,7 +249,7 @@ func resourceIbmIbmAppConfigFeatureUpdate(d *schema.ResourceData, meta interface
 				value := e.(map[string]interface{})
 				segmentRulesItem, err := resourceIbmAppConfigFeatureMapToSegmentRule(d, value)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				segmentRules = append(segmentRules, segmentRulesItem)
 			}
@@ -263,66 +265,66 @@ func resourceIbmIbmAppConfigFeatureUpdate(d *schema.ResourceData, meta interface
 			options.SetCollections(collections)
 		}
 
-		_, response, err := appconfigClient.UpdateFeature(options)
+		_, response, err := appconfigClient.UpdateFeatureWithContext(ctx, options)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateFeature %s\n%s", err, response)
-			return err
+			return diag.FromErr(err)
 		}
-		return resourceIbmIbmAppConfigFeatureRead(d, meta)
+		return resourceIbmIbmAppConfigFeatureRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceIbmIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmIbmAppConfigFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
 		return nil
 	}
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.GetFeatureOptions{}
 	options.SetEnvironmentID(parts[1])
 	options.SetFeatureID(parts[2])
 
-	result, response, err := appconfigClient.GetFeature(options)
+	result, response, err := appconfigClient.GetFeatureWithContext(ctx, options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] GetFeature failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] GetFeature failed %s\n%s", err, response))
 	}
 
 	d.Set("guid", parts[0])
 	d.Set("environment_id", parts[1])
 	if result.Name != nil {
 		if err = d.Set("name", result.Name); err != nil {
-			return fmt.Errorf("[ERROR] Error setting name: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
 		}
 	}
 	if result.FeatureID != nil {
 		if err = d.Set("feature_id", result.FeatureID); err != nil {
-			return fmt.Errorf("[ERROR] Error setting feature_id: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting feature_id: %s", err))
 		}
 	}
 	if result.Type != nil {
 		if err = d.Set("type", result.Type); err != nil {
-			return fmt.Errorf("[ERROR] Error setting type: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
 		}
 	}
 	if result.Description != nil {
 		if err = d.Set("description", result.Description); err != nil {
-			return fmt.Errorf("[ERROR] Error setting description: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
 		}
 	}
 
 	if result.RolloutPercentage != nil {
 		if err = d.Set("rollout_percentage", result.RolloutPercentage); err != nil {
-			return fmt.Errorf("[ERROR] Error setting rollout_percentage: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting rollout_percentage: %s", err))
 		}
 	}
 	if result.Tags != nil {
 		if err = d.Set("tags", result.Tags); err != nil {
-			return fmt.Errorf("[ERROR] Error setting tags: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting tags: %s", err))
 		}
 	}
 
@@ -333,7 +335,7 @@ func resourceIbmIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{}
 			segmentRules = append(segmentRules, segmentRulesItemMap)
 		}
 		if err = d.Set("segment_rules", segmentRules); err != nil {
-			return fmt.Errorf("[ERROR] Error setting segment_rules: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting segment_rules: %s", err))
 		}
 	}
 	if result.Collections != nil {
@@ -343,32 +345,32 @@ func resourceIbmIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{}
 			collections = append(collections, collectionsItemMap)
 		}
 		if err = d.Set("collections", collections); err != nil {
-			return fmt.Errorf("[ERROR] Error setting collections: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting collections: %s", err))
 		}
 	}
 	if result.SegmentExists != nil {
 		if err = d.Set("segment_exists", result.SegmentExists); err != nil {
-			return fmt.Errorf("[ERROR] Error setting segment_exists: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting segment_exists: %s", err))
 		}
 	}
 	if result.CreatedTime != nil {
 		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
-			return fmt.Errorf("[ERROR] Error setting created_time: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_time: %s", err))
 		}
 	}
 	if result.UpdatedTime != nil {
 		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
-			return fmt.Errorf("[ERROR] Error setting updated_time: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_time: %s", err))
 		}
 	}
 	if result.Href != nil {
 		if err = d.Set("href", result.Href); err != nil {
-			return fmt.Errorf("[ERROR] Error setting href: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
 		}
 	}
 	if result.Enabled != nil {
 		if err = d.Set("enabled", result.Enabled); err != nil {
-			return fmt.Errorf("[ERROR] Error setting enabled: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting enabled: %s", err))
 		}
 	}
 
@@ -400,27 +402,27 @@ func resourceIbmIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIbmIbmAppConfigFeatureDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmIbmAppConfigFeatureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
 		return nil
 	}
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.DeleteFeatureOptions{}
 	options.SetEnvironmentID(parts[1])
 	options.SetFeatureID(parts[2])
 
-	response, err := appconfigClient.DeleteFeature(options)
+	response, err := appconfigClient.DeleteFeatureWithContext(ctx, options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] DeleteFeature failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] DeleteFeature failed %s\n%s", err, response))
 	}
 
 	d.SetId("")
@@ -4,10 +4,12 @@
 package catalogmanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
@@ -15,10 +17,10 @@ import (
 
 func ResourceIBMCmCatalog() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCmCatalogCreate,
-		Read:     resourceIBMCmCatalogRead,
-		Delete:   resourceIBMCmCatalogDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCmCatalogCreate,
+		ReadContext:   resourceIBMCmCatalogRead,
+		DeleteContext: resourceIBMCmCatalogDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"kind": {
@@ -79,10 +81,10 @@ func ResourceIBMCmCatalog() *schema.Resource {
 	}
 }
 
-func resourceIBMCmCatalogCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmCatalogCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createCatalogOptions := &catalogmanagementv1.CreateCatalogOptions{}
@@ -106,21 +108,21 @@ func resourceIBMCmCatalogCreate(d *schema.ResourceData, meta interface{}) error
 		createCatalogOptions.SetResourceGroupID(d.Get("resource_group_id").(string))
 	}
 
-	catalog, response, err := catalogManagementClient.CreateCatalog(createCatalogOptions)
+	catalog, response, err := catalogManagementClient.CreateCatalogWithContext(ctx, createCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateCatalog failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*catalog.ID)
 
-	return resourceIBMCmCatalogRead(d, meta)
+	return resourceIBMCmCatalogRead(ctx, d, meta)
 }
 
-func resourceIBMCmCatalogRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmCatalogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] client is a nil pointer: %v\n", catalogManagementClient == nil)
 
@@ -128,62 +130,62 @@ func resourceIBMCmCatalogRead(d *schema.ResourceData, meta interface{}) error {
 
 	getCatalogOptions.SetCatalogIdentifier(d.Id())
 
-	catalog, response, err := catalogManagementClient.GetCatalog(getCatalogOptions)
+	catalog, response, err := catalogManagementClient.GetCatalogWithContext(ctx, getCatalogOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
 		log.Printf("[DEBUG] GetCatalog failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("label", catalog.Label); err != nil {
-		return fmt.Errorf("[ERROR] Error setting label: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting label: %s", err))
 	}
 	if err = d.Set("short_description", catalog.ShortDescription); err != nil {
-		return fmt.Errorf("[ERROR] Error setting short_description: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting short_description: %s", err))
 	}
 	if err = d.Set("catalog_icon_url", catalog.CatalogIconURL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting catalog_icon_url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting catalog_icon_url: %s", err))
 	}
 	if catalog.Tags != nil {
 		if err = d.Set("tags", catalog.Tags); err != nil {
-			return fmt.Errorf("[ERROR] Error setting tags: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting tags: %s", err))
 		}
 	}
 	if err = d.Set("url", catalog.URL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting url: %s", err))
 	}
 	if err = d.Set("crn", catalog.CRN); err != nil {
-		return fmt.Errorf("[ERROR] Error setting crn: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
 	}
 	if err = d.Set("offerings_url", catalog.OfferingsURL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting offerings_url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting offerings_url: %s", err))
 	}
 	if err = d.Set("kind", catalog.Kind); err != nil {
-		return fmt.Errorf("[ERROR] Error setting kind: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting kind: %s", err))
 	}
 	if err = d.Set("resource_group_id", catalog.ResourceGroupID); err != nil {
-		return fmt.Errorf("[ERROR] Error setting resource_group_id: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group_id: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMCmCatalogDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmCatalogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	deleteCatalogOptions := &catalogmanagementv1.DeleteCatalogOptions{}
 
 	deleteCatalogOptions.SetCatalogIdentifier(d.Id())
 
-	response, err := catalogManagementClient.DeleteCatalog(deleteCatalogOptions)
+	response, err := catalogManagementClient.DeleteCatalogWithContext(ctx, deleteCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteCatalog failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -4,11 +4,13 @@
 package catalogmanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
@@ -16,10 +18,10 @@ import (
 
 func ResourceIBMCmOffering() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCmOfferingCreate,
-		Read:     resourceIBMCmOfferingRead,
-		Delete:   resourceIBMCmOfferingDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCmOfferingCreate,
+		ReadContext:   resourceIBMCmOfferingRead,
+		DeleteContext: resourceIBMCmOfferingDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"offering_id": {
@@ -159,10 +161,10 @@ func ResourceIBMCmOffering() *schema.Resource {
 	}
 }
 
-func resourceIBMCmOfferingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createOfferingOptions := catalogManagementClient.NewCreateOfferingOptions(d.Get("catalog_id").(string))
@@ -185,21 +187,21 @@ func resourceIBMCmOfferingCreate(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	offering, response, err := catalogManagementClient.CreateOffering(createOfferingOptions)
+	offering, response, err := catalogManagementClient.CreateOfferingWithContext(ctx, createOfferingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateOffering failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*offering.ID)
 
-	return resourceIBMCmOfferingRead(d, meta)
+	return resourceIBMCmOfferingRead(ctx, d, meta)
 }
 
-func resourceIBMCmOfferingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getOfferingOptions := &catalogmanagementv1.GetOfferingOptions{}
@@ -207,79 +209,79 @@ func resourceIBMCmOfferingRead(d *schema.ResourceData, meta interface{}) error {
 	getOfferingOptions.SetCatalogIdentifier(d.Get("catalog_id").(string))
 	getOfferingOptions.SetOfferingID(d.Id())
 
-	offering, response, err := catalogManagementClient.GetOffering(getOfferingOptions)
+	offering, response, err := catalogManagementClient.GetOfferingWithContext(ctx, getOfferingOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
 		log.Printf("[DEBUG] GetOffering failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("url", offering.URL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting url: %s", err))
 	}
 	if err = d.Set("crn", offering.CRN); err != nil {
-		return fmt.Errorf("[ERROR] Error setting crn: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
 	}
 	if err = d.Set("label", offering.Label); err != nil {
-		return fmt.Errorf("[ERROR] Error setting label: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting label: %s", err))
 	}
 	if err = d.Set("name", offering.Name); err != nil {
-		return fmt.Errorf("[ERROR] Error setting name: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
 	}
 	if err = d.Set("offering_icon_url", offering.OfferingIconURL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting offering_icon_url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting offering_icon_url: %s", err))
 	}
 	if err = d.Set("offering_docs_url", offering.OfferingDocsURL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting offering_docs_url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting offering_docs_url: %s", err))
 	}
 	if err = d.Set("offering_support_url", offering.OfferingSupportURL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting offering_support_url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting offering_support_url: %s", err))
 	}
 	if err = d.Set("short_description", offering.ShortDescription); err != nil {
-		return fmt.Errorf("[ERROR] Error setting short_description: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting short_description: %s", err))
 	}
 	if err = d.Set("long_description", offering.LongDescription); err != nil {
-		return fmt.Errorf("[ERROR] Error setting long_description: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting long_description: %s", err))
 	}
 	if err = d.Set("permit_request_ibm_public_publish", offering.PermitRequestIBMPublicPublish); err != nil {
-		return fmt.Errorf("[ERROR] Error setting permit_request_ibm_public_publish: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting permit_request_ibm_public_publish: %s", err))
 	}
 	if err = d.Set("ibm_publish_approved", offering.IBMPublishApproved); err != nil {
-		return fmt.Errorf("[ERROR] Error setting ibm_publish_approved: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ibm_publish_approved: %s", err))
 	}
 	if err = d.Set("public_publish_approved", offering.PublicPublishApproved); err != nil {
-		return fmt.Errorf("[ERROR] Error setting public_publish_approved: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting public_publish_approved: %s", err))
 	}
 	if err = d.Set("public_original_crn", offering.PublicOriginalCRN); err != nil {
-		return fmt.Errorf("[ERROR] Error setting public_original_crn: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting public_original_crn: %s", err))
 	}
 	if err = d.Set("publish_public_crn", offering.PublishPublicCRN); err != nil {
-		return fmt.Errorf("[ERROR] Error setting publish_public_crn: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting publish_public_crn: %s", err))
 	}
 	if err = d.Set("portal_approval_record", offering.PortalApprovalRecord); err != nil {
-		return fmt.Errorf("[ERROR] Error setting portal_approval_record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting portal_approval_record: %s", err))
 	}
 	if err = d.Set("portal_ui_url", offering.PortalUIURL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting portal_ui_url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting portal_ui_url: %s", err))
 	}
 	if err = d.Set("catalog_id", offering.CatalogID); err != nil {
-		return fmt.Errorf("[ERROR] Error setting catalog_id: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting catalog_id: %s", err))
 	}
 	if err = d.Set("catalog_name", offering.CatalogName); err != nil {
-		return fmt.Errorf("[ERROR] Error setting catalog_name: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting catalog_name: %s", err))
 	}
 	if err = d.Set("disclaimer", offering.Disclaimer); err != nil {
-		return fmt.Errorf("[ERROR] Error setting disclaimer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting disclaimer: %s", err))
 	}
 	if err = d.Set("hidden", offering.Hidden); err != nil {
-		return fmt.Errorf("[ERROR] Error setting hidden: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting hidden: %s", err))
 	}
 	if offering.RepoInfo != nil {
 		repoInfoMap := resourceIBMCmOfferingRepoInfoToMap(*offering.RepoInfo)
 		if err = d.Set("repo_info", []map[string]interface{}{repoInfoMap}); err != nil {
-			return fmt.Errorf("[ERROR] Error setting repo_info: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting repo_info: %s", err))
 		}
 	}
 
@@ -295,10 +297,10 @@ func resourceIBMCmOfferingRepoInfoToMap(repoInfo catalogmanagementv1.RepoInfo) m
 	return repoInfoMap
 }
 
-func resourceIBMCmOfferingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	deleteOfferingOptions := &catalogmanagementv1.DeleteOfferingOptions{}
@@ -306,10 +308,10 @@ func resourceIBMCmOfferingDelete(d *schema.ResourceData, meta interface{}) error
 	deleteOfferingOptions.SetCatalogIdentifier(d.Get("catalog_id").(string))
 	deleteOfferingOptions.SetOfferingID(d.Id())
 
-	response, err := catalogManagementClient.DeleteOffering(deleteOfferingOptions)
+	response, err := catalogManagementClient.DeleteOfferingWithContext(ctx, deleteOfferingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteOfferingWithContext failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package catalogmanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -26,12 +28,12 @@ const (
 
 func ResourceIBMCmOfferingInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCmOfferingInstanceCreate,
-		Read:     resourceIBMCmOfferingInstanceRead,
-		Update:   resourceIBMCmOfferingInstanceUpdate,
-		Delete:   resourceIBMCmOfferingInstanceDelete,
-		Exists:   resourceIBMCmOfferingInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCmOfferingInstanceCreate,
+		ReadContext:   resourceIBMCmOfferingInstanceRead,
+		UpdateContext: resourceIBMCmOfferingInstanceUpdate,
+		DeleteContext: resourceIBMCmOfferingInstanceDelete,
+		Exists:        resourceIBMCmOfferingInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(4 * time.Minute),
@@ -127,15 +129,15 @@ func ResourceIBMCmOfferingInstance() *schema.Resource {
 	}
 }
 
-func resourceIBMCmOfferingInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createOfferingInstanceOptions := &catalogmanagementv1.CreateOfferingInstanceOptions{}
@@ -183,27 +185,27 @@ func resourceIBMCmOfferingInstanceCreate(d *schema.ResourceData, meta interface{
 		createOfferingInstanceOptions.SetChannel(d.Get("channel").(string))
 	}
 
-	offeringInstance, response, err := catalogManagementClient.CreateOfferingInstance(createOfferingInstanceOptions)
+	offeringInstance, response, err := catalogManagementClient.CreateOfferingInstanceWithContext(ctx, createOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*offeringInstance.ID)
 
 	if d.Get("wait_until_successful").(bool) {
-		if _, err = waitUntilSuccess(d, meta); err != nil {
+		if _, err = waitUntilSuccess(ctx, d, meta); err != nil {
 			log.Print(err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	log.Printf("LOG2 Service version instance of type %q was created on cluster %q", *createOfferingInstanceOptions.KindFormat, *createOfferingInstanceOptions.ClusterID)
 
-	return resourceIBMCmOfferingInstanceRead(d, meta)
+	return resourceIBMCmOfferingInstanceRead(ctx, d, meta)
 }
 
-func waitUntilSuccess(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitUntilSuccess(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
 		return nil, err
@@ -216,7 +218,7 @@ func waitUntilSuccess(d *schema.ResourceData, meta interface{}) (interface{}, er
 		Pending: []string{inProgress},
 		Target:  []string{success},
 		Refresh: func() (interface{}, string, error) {
-			offeringInstance, _, err := catalogManagementClient.GetOfferingInstance(getOfferingInstanceOptions)
+			offeringInstance, _, err := catalogManagementClient.GetOfferingInstanceWithContext(ctx, getOfferingInstanceOptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error retrieving offering instance: %s", err)
 			}
@@ -228,96 +230,96 @@ func waitUntilSuccess(d *schema.ResourceData, meta interface{}) (interface{}, er
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func resourceIBMCmOfferingInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getOfferingInstanceOptions := &catalogmanagementv1.GetOfferingInstanceOptions{}
 
 	getOfferingInstanceOptions.SetInstanceIdentifier(d.Id())
 
-	offeringInstance, response, err := catalogManagementClient.GetOfferingInstance(getOfferingInstanceOptions)
+	offeringInstance, response, err := catalogManagementClient.GetOfferingInstanceWithContext(ctx, getOfferingInstanceOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
 		log.Printf("[DEBUG] GetOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("url", offeringInstance.URL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting url: %s", err))
 	}
 	if err = d.Set("crn", offeringInstance.CRN); err != nil {
-		return fmt.Errorf("[ERROR] Error setting crn: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
 	}
 	if err = d.Set("label", offeringInstance.Label); err != nil {
-		return fmt.Errorf("[ERROR] Error setting label: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting label: %s", err))
 	}
 	if err = d.Set("catalog_id", offeringInstance.CatalogID); err != nil {
-		return fmt.Errorf("[ERROR] Error setting catalog_id: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting catalog_id: %s", err))
 	}
 	if err = d.Set("offering_id", offeringInstance.OfferingID); err != nil {
-		return fmt.Errorf("[ERROR] Error setting offering_id: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting offering_id: %s", err))
 	}
 	if err = d.Set("kind_format", offeringInstance.KindFormat); err != nil {
-		return fmt.Errorf("[ERROR] Error setting kind_format: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting kind_format: %s", err))
 	}
 	if err = d.Set("version", offeringInstance.Version); err != nil {
-		return fmt.Errorf("[ERROR] Error setting version: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting version: %s", err))
 	}
 	if err = d.Set("cluster_id", offeringInstance.ClusterID); err != nil {
-		return fmt.Errorf("[ERROR] Error setting cluster_id: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster_id: %s", err))
 	}
 	if err = d.Set("cluster_region", offeringInstance.ClusterRegion); err != nil {
-		return fmt.Errorf("[ERROR] Error setting cluster_region: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster_region: %s", err))
 	}
 	if offeringInstance.ClusterNamespaces != nil {
 		if err = d.Set("cluster_namespaces", offeringInstance.ClusterNamespaces); err != nil {
-			return fmt.Errorf("[ERROR] Error setting cluster_namespaces: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster_namespaces: %s", err))
 		}
 	}
 	if err = d.Set("cluster_all_namespaces", offeringInstance.ClusterAllNamespaces); err != nil {
-		return fmt.Errorf("[ERROR] Error setting cluster_all_namespaces: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster_all_namespaces: %s", err))
 	}
 	if err = d.Set("schematics_workspace_id", offeringInstance.SchematicsWorkspaceID); err != nil {
-		return fmt.Errorf("[ERROR] Error setting schematics_workspace_id: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting schematics_workspace_id: %s", err))
 	}
 	if err = d.Set("install_plan", offeringInstance.InstallPlan); err != nil {
-		return fmt.Errorf("[ERROR] Error setting install_plan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting install_plan: %s", err))
 	}
 	if err = d.Set("channel", offeringInstance.Channel); err != nil {
-		return fmt.Errorf("[ERROR] Error setting channel: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting channel: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMCmOfferingInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getOfferingInstanceOptions := &catalogmanagementv1.GetOfferingInstanceOptions{}
 
 	getOfferingInstanceOptions.SetInstanceIdentifier(d.Id())
 
-	offeringInstance, response, err := catalogManagementClient.GetOfferingInstance(getOfferingInstanceOptions)
+	offeringInstance, response, err := catalogManagementClient.GetOfferingInstanceWithContext(ctx, getOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Failed to retrieve rev %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	putOfferingInstanceOptions := &catalogmanagementv1.PutOfferingInstanceOptions{}
@@ -369,23 +371,23 @@ func resourceIBMCmOfferingInstanceUpdate(d *schema.ResourceData, meta interface{
 		putOfferingInstanceOptions.SetChannel(d.Get("channel").(string))
 	}
 
-	_, response, err = catalogManagementClient.PutOfferingInstance(putOfferingInstanceOptions)
+	_, response, err = catalogManagementClient.PutOfferingInstanceWithContext(ctx, putOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] PutOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMCmOfferingInstanceRead(d, meta)
+	return resourceIBMCmOfferingInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMCmOfferingInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	deleteOfferingInstanceOptions := &catalogmanagementv1.DeleteOfferingInstanceOptions{}
@@ -393,10 +395,10 @@ func resourceIBMCmOfferingInstanceDelete(d *schema.ResourceData, meta interface{
 	deleteOfferingInstanceOptions.SetInstanceIdentifier(d.Id())
 	deleteOfferingInstanceOptions.SetXAuthRefreshToken(rsConClient.Config.IAMRefreshToken)
 
-	response, err := catalogManagementClient.DeleteOfferingInstance(deleteOfferingInstanceOptions)
+	response, err := catalogManagementClient.DeleteOfferingInstanceWithContext(ctx, deleteOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
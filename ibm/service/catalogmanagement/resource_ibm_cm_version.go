@@ -4,11 +4,13 @@
 package catalogmanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
@@ -16,10 +18,10 @@ import (
 
 func ResourceIBMCmVersion() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCmVersionCreate,
-		Read:     resourceIBMCmVersionRead,
-		Delete:   resourceIBMCmVersionDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCmVersionCreate,
+		ReadContext:   resourceIBMCmVersionRead,
+		DeleteContext: resourceIBMCmVersionDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"catalog_identifier": {
@@ -120,10 +122,10 @@ func ResourceIBMCmVersion() *schema.Resource {
 	}
 }
 
-func resourceIBMCmVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	importOfferingVersionOptions := catalogManagementClient.NewImportOfferingVersionOptions(d.Get("catalog_identifier").(string), d.Get("offering_id").(string))
@@ -146,31 +148,31 @@ func resourceIBMCmVersionCreate(d *schema.ResourceData, meta interface{}) error
 		importOfferingVersionOptions.SetTargetVersion(d.Get("target_version").(string))
 	}
 
-	offering, response, err := catalogManagementClient.ImportOfferingVersion(importOfferingVersionOptions)
+	offering, response, err := catalogManagementClient.ImportOfferingVersionWithContext(ctx, importOfferingVersionOptions)
 
 	if err != nil {
 		log.Printf("[DEBUG] ImportOfferingVersion failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	versionLocator := *offering.Kinds[0].Versions[0].VersionLocator
 
 	d.SetId(versionLocator)
 
-	return resourceIBMCmVersionRead(d, meta)
+	return resourceIBMCmVersionRead(ctx, d, meta)
 }
 
-func resourceIBMCmVersionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getVersionOptions := &catalogmanagementv1.GetVersionOptions{}
 
 	getVersionOptions.SetVersionLocID(d.Id())
 
-	offering, response, err := catalogManagementClient.GetVersion(getVersionOptions)
+	offering, response, err := catalogManagementClient.GetVersionWithContext(ctx, getVersionOptions)
 	version := offering.Kinds[0].Versions[0]
 
 	if err != nil {
@@ -179,56 +181,56 @@ func resourceIBMCmVersionRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 		log.Printf("[DEBUG] GetVersion failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("crn", version.CRN); err != nil {
-		return fmt.Errorf("[ERROR] Error setting crn: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
 	}
 	if err = d.Set("version", version.Version); err != nil {
-		return fmt.Errorf("[ERROR] Error setting version: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting version: %s", err))
 	}
 	if err = d.Set("sha", version.Sha); err != nil {
-		return fmt.Errorf("[ERROR] Error setting sha: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting sha: %s", err))
 	}
 	if err = d.Set("created", version.Created.String()); err != nil {
-		return fmt.Errorf("[ERROR] Error setting created: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created: %s", err))
 	}
 	if err = d.Set("updated", version.Updated.String()); err != nil {
-		return fmt.Errorf("[ERROR] Error setting updated: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated: %s", err))
 	}
 	if err = d.Set("catalog_id", version.CatalogID); err != nil {
-		return fmt.Errorf("[ERROR] Error setting catalog_id: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting catalog_id: %s", err))
 	}
 	if err = d.Set("kind_id", version.KindID); err != nil {
-		return fmt.Errorf("[ERROR] Error setting kind_id: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting kind_id: %s", err))
 	}
 	if err = d.Set("repo_url", version.RepoURL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting repo_url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting repo_url: %s", err))
 	}
 	if err = d.Set("source_url", version.SourceURL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting source_url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting source_url: %s", err))
 	}
 	if err = d.Set("tgz_url", version.TgzURL); err != nil {
-		return fmt.Errorf("[ERROR] Error setting tgz_url: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting tgz_url: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMCmVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	deleteVersionOptions := &catalogmanagementv1.DeleteVersionOptions{}
 	deleteVersionOptions.SetVersionLocID(d.Id())
 
-	response, err := catalogManagementClient.DeleteVersion(deleteVersionOptions)
+	response, err := catalogManagementClient.DeleteVersionWithContext(ctx, deleteVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVersion failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -4,9 +4,11 @@
 package certificatemanager
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
@@ -16,12 +18,12 @@ import (
 
 func ResourceIBMCertificateManagerImport() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCertificateManagerImportCertificate,
-		Read:     resourceIBMCertificateManagerGet,
-		Update:   resourceIBMCertificateManagerUpdate,
-		Importer: &schema.ResourceImporter{},
-		Delete:   resourceIBMCertificateManagerDelete,
-		Exists:   resourceIBMCertificateManagerExists,
+		CreateContext: resourceIBMCertificateManagerImportCertificate,
+		ReadContext:   resourceIBMCertificateManagerGet,
+		UpdateContext: resourceIBMCertificateManagerUpdate,
+		Importer:      &schema.ResourceImporter{},
+		DeleteContext: resourceIBMCertificateManagerDelete,
+		Exists:        resourceIBMCertificateManagerExists,
 		Schema: map[string]*schema.Schema{
 			"certificate_manager_instance_id": {
 				Type:        schema.TypeString,
@@ -82,11 +84,11 @@ func ResourceIBMCertificateManagerImport() *schema.Resource {
 	}
 }
 
-func resourceIBMCertificateManagerImportCertificate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerImportCertificate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	cmService, err := meta.(conns.ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get("certificate_manager_instance_id").(string)
@@ -112,20 +114,20 @@ func resourceIBMCertificateManagerImportCertificate(d *schema.ResourceData, meta
 
 	result, importCertError := client.ImportCertificate(instanceID, payload)
 	if importCertError != nil {
-		return importCertError
+		return diag.FromErr(importCertError)
 	}
 	d.SetId(result.ID)
-	return resourceIBMCertificateManagerUpdate(d, meta)
+	return resourceIBMCertificateManagerUpdate(ctx, d, meta)
 }
-func resourceIBMCertificateManagerGet(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerGet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(conns.ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certID := d.Id()
 	certificatedata, err := cmService.Certificate().GetCertData(certID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting certificate during import: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting certificate during import: %s", err))
 	}
 	cminstanceid := strings.Split(certID, ":certificate:")
 	d.Set("certificate_manager_instance_id", cminstanceid[0]+"::")
@@ -155,10 +157,10 @@ func resourceIBMCertificateManagerGet(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMCertificateManagerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(conns.ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certID := d.Id()
 	client := cmService.Certificate()
@@ -169,7 +171,7 @@ func resourceIBMCertificateManagerUpdate(d *schema.ResourceData, meta interface{
 
 		importCertError := client.UpdateCertificateMetaData(certID, payload)
 		if importCertError != nil {
-			return importCertError
+			return diag.FromErr(importCertError)
 		}
 	}
 	if d.HasChange("data") {
@@ -189,20 +191,20 @@ func resourceIBMCertificateManagerUpdate(d *schema.ResourceData, meta interface{
 		payload := models.CertificateReimportData{Content: importData.Content, Privatekey: importData.Privatekey, IntermediateCertificate: importData.IntermediateCertificate}
 		_, reImportCertError := client.ReimportCertificate(certID, payload)
 		if reImportCertError != nil {
-			return reImportCertError
+			return diag.FromErr(reImportCertError)
 		}
 	}
-	return resourceIBMCertificateManagerGet(d, meta)
+	return resourceIBMCertificateManagerGet(ctx, d, meta)
 }
-func resourceIBMCertificateManagerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(conns.ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certID := d.Id()
 	err = cmService.Certificate().DeleteCertificate(certID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Certificate: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Certificate: %s", err))
 	}
 	d.SetId("")
 
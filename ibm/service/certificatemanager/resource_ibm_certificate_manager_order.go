@@ -4,11 +4,13 @@
 package certificatemanager
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -20,12 +22,12 @@ import (
 
 func ResourceIBMCertificateManagerOrder() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCertificateManagerOrderCertificate,
-		Read:     resourceIBMCertificateManagerRead,
-		Update:   resourceIBMCertificateManagerRenew,
-		Importer: &schema.ResourceImporter{},
-		Delete:   resourceIBMCertificateManagerDelete,
-		Exists:   resourceIBMCertificateManagerExists,
+		CreateContext: resourceIBMCertificateManagerOrderCertificate,
+		ReadContext:   resourceIBMCertificateManagerRead,
+		UpdateContext: resourceIBMCertificateManagerRenew,
+		Importer:      &schema.ResourceImporter{},
+		DeleteContext: resourceIBMCertificateManagerDelete,
+		Exists:        resourceIBMCertificateManagerExists,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -132,11 +134,11 @@ func ResourceIBMCertificateManagerOrder() *schema.Resource {
 	}
 }
 
-func resourceIBMCertificateManagerOrderCertificate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerOrderCertificate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	cmService, err := meta.(conns.ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get("certificate_manager_instance_id").(string)
@@ -165,26 +167,26 @@ func resourceIBMCertificateManagerOrderCertificate(d *schema.ResourceData, meta
 	payload := models.CertificateOrderData{Name: name, Description: description, Domains: domainList, DomainValidationMethod: domainValidationMethod, DNSProviderInstanceCrn: dnsProviderInstanceCrn, KeyAlgorithm: keyAlgorithm, AutoRenewEnabled: autoRenew}
 	result, err := client.OrderCertificate(instanceID, payload)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(result.ID)
 
-	_, err = waitForCertificateOrder(d, meta)
+	_, err = waitForCertificateOrder(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for Ordering Certificate (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for Ordering Certificate (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMCertificateManagerRead(d, meta)
+	return resourceIBMCertificateManagerRead(ctx, d, meta)
 }
-func resourceIBMCertificateManagerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(conns.ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certID := d.Id()
 	certificatedata, err := cmService.Certificate().GetMetaData(certID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cminstanceid := strings.Split(certID, ":certificate:")
 	d.Set("certificate_manager_instance_id", cminstanceid[0]+"::")
@@ -224,10 +226,10 @@ func resourceIBMCertificateManagerRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMCertificateManagerRenew(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerRenew(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(conns.ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certID := d.Id()
 	client := cmService.Certificate()
@@ -238,7 +240,7 @@ func resourceIBMCertificateManagerRenew(d *schema.ResourceData, meta interface{}
 
 		_, err := client.RenewCertificate(certID, payload)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("name") || d.HasChange("description") {
@@ -248,7 +250,7 @@ func resourceIBMCertificateManagerRenew(d *schema.ResourceData, meta interface{}
 
 		err := client.UpdateCertificateMetaData(certID, payload)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("auto_renew_enabled") {
@@ -257,16 +259,16 @@ func resourceIBMCertificateManagerRenew(d *schema.ResourceData, meta interface{}
 
 		_, err := client.UpdateOrderPolicy(certID, payload)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	_, err = waitForCertificateRenew(d, meta)
+	_, err = waitForCertificateRenew(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for Renew Certificate (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for Renew Certificate (%s) to be succeeded: %s", d.Id(), err))
 	}
-	return resourceIBMCertificateManagerRead(d, meta)
+	return resourceIBMCertificateManagerRead(ctx, d, meta)
 }
-func waitForCertificateOrder(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCertificateOrder(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cmService, err := meta.(conns.ClientSession).CertificateManagerAPI()
 	if err != nil {
 		return false, err
@@ -294,9 +296,9 @@ func waitForCertificateOrder(d *schema.ResourceData, meta interface{}) (interfac
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
-func waitForCertificateRenew(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCertificateRenew(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cmService, err := meta.(conns.ClientSession).CertificateManagerAPI()
 	if err != nil {
 		return false, err
@@ -324,5 +326,5 @@ func waitForCertificateRenew(d *schema.ResourceData, meta interface{}) (interfac
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisDNSRecordsExportZoneFile = "zone_file"
+)
+
+// cisZoneFileTypes is the order of the record types in an exported zone file.
+var cisZoneFileTypes = []string{
+	cisDNSRecordTypeA,
+	cisDNSRecordTypeAAAA,
+	cisDNSRecordTypeCNAME,
+	cisDNSRecordTypeMX,
+	cisDNSRecordTypeNS,
+	cisDNSRecordTypePTR,
+	cisDNSRecordTypeSRV,
+	cisDNSRecordTypeTXT,
+	cisDNSRecordTypeSPF,
+	cisDNSRecordTypeCAA,
+	cisDNSRecordTypeLOC,
+}
+
+func DataSourceIBMCISDNSRecordsExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCISDNSRecordsExportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "DNS Zone CRN",
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Zone Id",
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisZoneName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNS zone name",
+			},
+			cisDNSRecordsExportZoneFile: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNS records of the zone as a BIND zone file",
+			},
+		},
+	}
+}
+
+func dataSourceIBMCISDNSRecordsExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+
+	zonesClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zonesClient.Crn = core.StringPtr(crn)
+	zone, response, err := zonesClient.GetZoneWithContext(ctx, zonesClient.NewGetZoneOptions(zoneID))
+	if err != nil {
+		log.Printf("Error reading zone: %s", response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading zone %s: %s", zoneID, err))
+	}
+	zoneName := strings.ToLower(*zone.Result.Name)
+
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+	_, records, err := cisZoneRecords(ctx, sess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisZoneName, zoneName)
+	d.Set(cisDNSRecordsExportZoneFile, renderCISZoneFile(zoneName, records))
+	return nil
+}
+
+// renderCISZoneFile renders the records of the zone as a BIND zone file of
+// fully qualified names, grouped by record type.
+func renderCISZoneFile(zoneName string, records []cisZoneRecord) string {
+	byType := make(map[string][]cisZoneRecord)
+	types := append([]string{}, cisZoneFileTypes...)
+	for _, r := range records {
+		if _, ok := byType[r.Type]; !ok && !flex.StringContains(cisZoneFileTypes, r.Type) {
+			types = append(types, r.Type)
+		}
+		byType[r.Type] = append(byType[r.Type], r)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", zoneName)
+	for _, t := range types {
+		if len(byType[t]) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n;; %s Records\n", t)
+		for _, r := range byType[t] {
+			fmt.Fprintf(&b, "%s.\t%d\tIN\t%s\t%s\n", r.Name, r.TTL, r.Type, renderCISZoneFileData(r))
+		}
+	}
+	return b.String()
+}
+
+// renderCISZoneFileData renders the record data of the record as written in a
+// zone file.
+func renderCISZoneFileData(r cisZoneRecord) string {
+	switch r.Type {
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+		return r.Content + "."
+	case cisDNSRecordTypeMX:
+		return fmt.Sprintf("%d %s.", r.Priority, r.Content)
+	case cisDNSRecordTypeSRV:
+		fields := strings.Fields(r.Content)
+		if len(fields) == 4 {
+			return fmt.Sprintf("%s %s %s %s.", fields[0], fields[1], fields[2], fields[3])
+		}
+	case cisDNSRecordTypeTXT, cisDNSRecordTypeSPF:
+		// A character string of a zone file holds up to 255 bytes.
+		var quoted []string
+		for s := r.Content; ; s = s[255:] {
+			if len(s) <= 255 {
+				quoted = append(quoted, quoteCISZoneFileString(s))
+				break
+			}
+			quoted = append(quoted, quoteCISZoneFileString(s[:255]))
+		}
+		return strings.Join(quoted, " ")
+	}
+	return r.Content
+}
+
+func quoteCISZoneFileString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < ' ' || c > '~':
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
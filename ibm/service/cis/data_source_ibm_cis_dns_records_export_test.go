@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisDNSRecordsExportDataSource_basic(t *testing.T) {
+	node := "data.ibm_cis_dns_records_export.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDNSRecordsExportDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "zone_name", acc.CisDomainStatic),
+					resource.TestMatchResourceAttr(node, "zone_file",
+						regexp.MustCompile(fmt.Sprintf(`(?m)^test-dns-export\.%s\.\t1\tIN\tA\t192\.168\.0\.10$`, regexp.QuoteMeta(acc.CisDomainStatic)))),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisDNSRecordsExportDataSourceConfig() string {
+	return testAccCheckIBMCisDNSRecordConfigCisDSBasic("test-dns-export", acc.CisDomainStatic) +
+		`
+	data "ibm_cis_dns_records_export" "test" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = ibm_cis_dns_record.test-dns-export.domain_id
+	}`
+}
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -12,6 +13,8 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -34,12 +37,12 @@ const (
 
 func ResourceIBMCISInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISInstanceCreate,
-		Read:     ResourceIBMCISInstanceRead,
-		Update:   ResourceIBMCISInstanceUpdate,
-		Delete:   ResourceIBMCISInstanceDelete,
-		Exists:   ResourceIBMCISInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISInstanceCreate,
+		ReadContext:   ResourceIBMCISInstanceRead,
+		UpdateContext: ResourceIBMCISInstanceUpdate,
+		DeleteContext: ResourceIBMCISInstanceDelete,
+		Exists:        ResourceIBMCISInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -47,6 +50,12 @@ func ResourceIBMCISInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return flex.ResourceTagsCustomizeDiff(diff, v)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -97,6 +106,7 @@ func ResourceIBMCISInstance() *schema.Resource {
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_cis", "tags")},
 				Set:      schema.HashString,
 			},
@@ -157,11 +167,11 @@ func ResourceIBMCISValidator() *validate.ResourceValidator {
 }
 
 // Replace with func wrapper for resourceIBMResourceInstanceCreate specifying serviceName := "internet-svcs"
-func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serviceName := "internet-svcs"
 	plan := d.Get("plan").(string)
@@ -174,27 +184,27 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 	}
 	rsInst.ResourcePlanID = &servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err))
 	}
 	if len(deployments) == 0 {
-		return fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan)
+		return diag.FromErr(fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan))
 	}
 	deployments, supportedLocations := filterCISDeployments(deployments, location)
 
@@ -203,7 +213,7 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q", plan, location, locationList)
+		return diag.FromErr(fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q", plan, location, locationList))
 	}
 
 	rsInst.Target = &deployments[0].CatalogCRN
@@ -214,7 +224,7 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		defaultRg, err := flex.DefaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsInst.ResourceGroup = &defaultRg
 	}
@@ -223,9 +233,9 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		rsInst.Parameters = parameters.(map[string]interface{})
 	}
 
-	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
+	instance, response, err := rsConClient.CreateResourceInstanceWithContext(ctx, &rsInst)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource instance: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource instance: %s %s", err, response))
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
@@ -240,28 +250,28 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	// Moved d.SetId(instance.ID) to after waiting for resource to finish creation. Otherwise Terraform initates depedent tasks too early.
 	// Original flow had SetId here as its required as input to waitForCISInstanceCreate
 
-	_, err = waitForCISInstanceCreate(d, meta, *instance.ID)
+	_, err = waitForCISInstanceCreate(ctx, d, meta, *instance.ID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
 	d.SetId(*instance.ID)
 
-	return ResourceIBMCISInstanceRead(d, meta)
+	return ResourceIBMCISInstanceRead(ctx, d, meta)
 }
 
-func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
 	rsInst := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
-	instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(ctx, &rsInst)
 	if err != nil {
 		if strings.Contains(err.Error(), "Object not found") ||
 			strings.Contains(err.Error(), "status code: 404") {
@@ -269,7 +279,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s %s", err, response))
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -296,7 +306,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
@@ -304,7 +314,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(*instance.ResourcePlanID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 
@@ -315,18 +325,18 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	rcontroller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, rcontroller+"/internet-svcs/"+url.QueryEscape(*instance.CRN))
 
 	return nil
 }
 
-func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -344,18 +354,18 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		service := d.Get("service").(string)
 		rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsCatRepo := rsCatClient.ResourceCatalog()
 
 		serviceOff, err := rsCatRepo.FindByName(service, true)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
 		}
 
 		servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 		}
 
 		updateReq.ResourcePlanID = &servicePlan
@@ -371,24 +381,24 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
+	_, response, err := rsConClient.UpdateResourceInstanceWithContext(ctx, &updateReq)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response))
 	}
 
-	_, err = waitForCISInstanceUpdate(d, meta)
+	_, err = waitForCISInstanceUpdate(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return ResourceIBMCISInstanceRead(d, meta)
+	return ResourceIBMCISInstanceRead(ctx, d, meta)
 }
 
-func ResourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	recursive := true
@@ -396,7 +406,7 @@ func ResourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 		ID:        &id,
 		Recursive: &recursive,
 	}
-	response, err := rsConClient.DeleteResourceInstance(&deleteReq)
+	response, err := rsConClient.DeleteResourceInstanceWithContext(ctx, &deleteReq)
 	if err != nil {
 		// If prior delete occurs, instance is not immediately deleted, but remains in "removed" state"
 		// RC 410 with "Gone" returned as error
@@ -405,13 +415,13 @@ func ResourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[WARN] Resource instance already deleted %s\n %s", err, response)
 			err = nil
 		} else {
-			return fmt.Errorf("[ERROR] Error deleting resource instance: %s %s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting resource instance: %s %s", err, response))
 		}
 	}
 
-	_, err = waitForCISInstanceDelete(d, meta)
+	_, err = waitForCISInstanceDelete(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
@@ -446,7 +456,7 @@ func ResourceIBMCISInstanceExists(d *schema.ResourceData, meta interface{}) (boo
 	return *instance.ID == instanceID, nil
 }
 
-func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+func waitForCISInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -461,7 +471,7 @@ func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instance
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(ctx, &rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
@@ -478,10 +488,10 @@ func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instance
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -496,7 +506,7 @@ func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interfa
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(ctx, &rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
@@ -513,10 +523,10 @@ func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interfa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -530,7 +540,7 @@ func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interfa
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(ctx, &rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return instance, CisInstanceSuccessStatus, nil
@@ -547,7 +557,7 @@ func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interfa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func filterCISDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/networking-go-sdk/alertsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -38,11 +40,11 @@ const (
 
 func ResourceIBMCISAlert() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISAlertPolicyCreate,
-		Read:     ResourceIBMCISAlertPolicyRead,
-		Update:   ResourceIBMCISAlertPolicyUpdate,
-		Delete:   ResourceIBMCISAlertPolicyDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISAlertPolicyCreate,
+		ReadContext:   ResourceIBMCISAlertPolicyRead,
+		UpdateContext: ResourceIBMCISAlertPolicyUpdate,
+		DeleteContext: ResourceIBMCISAlertPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -127,10 +129,10 @@ func ResourceIBMCISAlert() *schema.Resource {
 	}
 }
 
-func ResourceIBMCISAlertPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISAlertPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisAlertsSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the CisAlertsSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisAlertsSession %s", err))
 	}
 	crn := d.Get(cisID).(string)
 	sess.Crn = core.StringPtr(crn)
@@ -187,35 +189,35 @@ func ResourceIBMCISAlertPolicyCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 	opt.Mechanisms = mechanismsOpt
-	result, resp, err := sess.CreateAlertPolicy(opt)
+	result, resp, err := sess.CreateAlertPolicyWithContext(ctx, opt)
 	if err != nil || result == nil {
-		return fmt.Errorf("[ERROR] Error creating Alert Policy %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Alert Policy %s %s", err, resp))
 	}
 	d.SetId(flex.ConvertCisToTfTwoVar(*result.Result.ID, crn))
 	d.Set(cisAlertID, *result.Result.ID)
 
-	return ResourceIBMCISAlertPolicyRead(d, meta)
+	return ResourceIBMCISAlertPolicyRead(ctx, d, meta)
 }
 
-func ResourceIBMCISAlertPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISAlertPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisAlertsSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the CisAlertsSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisAlertsSession %s", err))
 	}
 
 	alertID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while ConvertTftoCisTwoVar %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while ConvertTftoCisTwoVar %s", err))
 	}
 	sess.Crn = core.StringPtr(crn)
 	opt := sess.NewGetAlertPolicyOptions(alertID)
-	result, resp, err := sess.GetAlertPolicy(opt)
+	result, resp, err := sess.GetAlertPolicyWithContext(ctx, opt)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting alert policy detail %s, %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting alert policy detail %s, %s", err, resp))
 	}
 
 	d.Set(cisID, crn)
@@ -231,31 +233,31 @@ func ResourceIBMCISAlertPolicyRead(d *schema.ResourceData, meta interface{}) err
 
 	filterOpt, err := json.Marshal(result.Result.Filters)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error marshalling the created filters: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error marshalling the created filters: %s", err))
 	}
 	if err = d.Set(cisAlertFilters, string(filterOpt)); err != nil {
-		return fmt.Errorf("[ERROR] Error setting the filters: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting the filters: %s", err))
 	}
 	conditionsOpt, err := json.Marshal(result.Result.Conditions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error marshalling the created Conditions: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error marshalling the created Conditions: %s", err))
 	}
 	if err = d.Set(cisAlertConditions, string(conditionsOpt)); err != nil {
-		return fmt.Errorf("[ERROR] Error setting the Conditions: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting the Conditions: %s", err))
 	}
 	return nil
 }
 
-func ResourceIBMCISAlertPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISAlertPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisAlertsSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the CisAlertsSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisAlertsSession %s", err))
 	}
 
 	alertID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while ConvertTftoCisTwoVar %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while ConvertTftoCisTwoVar %s", err))
 	}
 	sess.Crn = core.StringPtr(crn)
 
@@ -327,32 +329,32 @@ func ResourceIBMCISAlertPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 		opt.Mechanisms = mechanismsOpt
 
-		result, resp, err := sess.UpdateAlertPolicy(opt)
+		result, resp, err := sess.UpdateAlertPolicyWithContext(ctx, opt)
 		if err != nil || result == nil {
-			return fmt.Errorf("[ERROR] Error while Update Alert Policy %s %s", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while Update Alert Policy %s %s", err, resp))
 		}
 	}
 
 	return nil
 }
-func ResourceIBMCISAlertPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISAlertPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(conns.ClientSession).CisAlertsSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the CisAlertsSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisAlertsSession %s", err))
 	}
 	alertID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	opt := sess.NewDeleteAlertPolicyOptions(alertID)
-	_, response, err := sess.DeleteAlertPolicy(opt)
+	_, response, err := sess.DeleteAlertPolicyWithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting the alert %s:%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the alert %s:%s", err, response))
 	}
 	return nil
 }
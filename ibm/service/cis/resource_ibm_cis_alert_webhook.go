@@ -4,11 +4,13 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,11 +24,11 @@ const (
 
 func ResourceIBMCISWebhooks() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISWebhookCreate,
-		Read:     ResourceIBMCISWebhookRead,
-		Update:   ResourceIBMCISWebhookUpdate,
-		Delete:   ResourceIBMCISWebhookDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISWebhookCreate,
+		ReadContext:   ResourceIBMCISWebhookRead,
+		UpdateContext: ResourceIBMCISWebhookUpdate,
+		DeleteContext: ResourceIBMCISWebhookDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -62,10 +64,10 @@ func ResourceIBMCISWebhooks() *schema.Resource {
 		},
 	}
 }
-func ResourceIBMCISWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisWebhookSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the cisWebhookSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the cisWebhookSession %s", err))
 	}
 
 	crn := d.Get(cisID).(string)
@@ -82,33 +84,33 @@ func ResourceIBMCISWebhookCreate(d *schema.ResourceData, meta interface{}) error
 	if secret, ok := d.GetOk(cisWebhookSecret); ok {
 		opt.SetSecret((secret.(string)))
 	}
-	result, resp, err := sess.CreateAlertWebhook(opt)
+	result, resp, err := sess.CreateAlertWebhookWithContext(ctx, opt)
 	if err != nil || result == nil {
-		return fmt.Errorf("[ERROR] Error creating Webhooks  %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Webhooks  %s %s", err, resp))
 	}
 	d.SetId(flex.ConvertCisToTfTwoVar(*result.Result.ID, crn))
-	return ResourceIBMCISWebhookRead(d, meta)
+	return ResourceIBMCISWebhookRead(ctx, d, meta)
 
 }
-func ResourceIBMCISWebhookRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisWebhookSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the cisWebhookSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the cisWebhookSession %s", err))
 	}
 	webhooksID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	opt := sess.NewGetWebhookOptions(webhooksID)
 
-	result, response, err := sess.GetWebhook(opt)
+	result, response, err := sess.GetWebhookWithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting webhook detail %s, %s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting webhook detail %s, %s", err, response))
 	}
 	d.Set(cisID, crn)
 	d.Set(cisWebhookID, result.Result.ID)
@@ -117,14 +119,14 @@ func ResourceIBMCISWebhookRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(cisWebhookType, result.Result.Type)
 	return nil
 }
-func ResourceIBMCISWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisWebhookSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while updating the webhook %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while updating the webhook %s", err))
 	}
 	webhooksID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	opt := sess.NewUpdateAlertWebhookOptions(webhooksID)
@@ -142,32 +144,32 @@ func ResourceIBMCISWebhookUpdate(d *schema.ResourceData, meta interface{}) error
 			opt.SetSecret((secret.(string)))
 		}
 
-		result, _, err := sess.UpdateAlertWebhook(opt)
+		result, _, err := sess.UpdateAlertWebhookWithContext(ctx, opt)
 		if err != nil || result == nil {
-			return fmt.Errorf("[ERROR] Error updating the Webhook %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the Webhook %s", err))
 		}
 	}
-	return ResourceIBMCISWebhookRead(d, meta)
+	return ResourceIBMCISWebhookRead(ctx, d, meta)
 }
-func ResourceIBMCISWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisWebhookSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while Deleting the webhook %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while Deleting the webhook %s", err))
 	}
 	webhooksID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the webhook ID %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the webhook ID %s", err))
 	}
 	sess.Crn = core.StringPtr(crn)
 
 	opt := sess.NewDeleteWebhookOptions(webhooksID)
 
-	_, response, err := sess.DeleteWebhook(opt)
+	_, response, err := sess.DeleteWebhookWithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting the Webhook %s:%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the Webhook %s:%s", err, response))
 	}
 	return nil
 
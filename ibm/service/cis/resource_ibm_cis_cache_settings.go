@@ -4,12 +4,14 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -128,11 +130,11 @@ func ResourceIBMCISCacheSettings() *schema.Resource {
 				},
 			},
 		},
-		Create:   resourceCISCacheSettingsUpdate,
-		Read:     resourceCISCacheSettingsRead,
-		Update:   resourceCISCacheSettingsUpdate,
-		Delete:   resourceCISCacheSettingsDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISCacheSettingsUpdate,
+		ReadContext:   resourceCISCacheSettingsRead,
+		UpdateContext: resourceCISCacheSettingsUpdate,
+		DeleteContext: resourceCISCacheSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
@@ -218,10 +220,10 @@ func resourceCISCacheSettingsUpdateGetValueCheck(d *schema.ResourceData) bool {
 	return true
 }
 
-func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCacheSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisCacheClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -242,20 +244,20 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 		if value, ok := d.GetOk(cisCacheSettingsCachingLevel); ok {
 			opt := cisClient.NewUpdateCacheLevelOptions()
 			opt.SetValue(value.(string))
-			_, resp, err := cisClient.UpdateCacheLevel(opt)
+			_, resp, err := cisClient.UpdateCacheLevelWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Update caching level failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		// Serve Stale Content Setting
 		if value, ok := d.GetOk(cisCacheServeStaleContent); ok {
 			opt := cisClient.NewUpdateServeStaleContentOptions()
 			opt.SetValue(value.(string))
-			_, resp, err := cisClient.UpdateServeStaleContent(opt)
+			_, resp, err := cisClient.UpdateServeStaleContentWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Update Serve Stale Content Setting failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -264,10 +266,10 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			value, _ := d.GetOk(cisCacheSettingsBrowserExpiration)
 			opt := cisClient.NewUpdateBrowserCacheTtlOptions()
 			opt.SetValue(int64(value.(int)))
-			_, resp, err := cisClient.UpdateBrowserCacheTTL(opt)
+			_, resp, err := cisClient.UpdateBrowserCacheTTLWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Update browser expiration setting failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -275,30 +277,30 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 		if value, ok := d.GetOk(cisCacheSettingsDevelopmentMode); ok {
 			opt := cisClient.NewUpdateDevelopmentModeOptions()
 			opt.SetValue(value.(string))
-			_, resp, err := cisClient.UpdateDevelopmentMode(opt)
+			_, resp, err := cisClient.UpdateDevelopmentModeWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Update development mode setting failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		// Query string sort setting
 		if value, ok := d.GetOk(cisCacheSettingsQueryStringSort); ok {
 			opt := cisClient.NewUpdateQueryStringSortOptions()
 			opt.SetValue(value.(string))
-			_, resp, err := cisClient.UpdateQueryStringSort(opt)
+			_, resp, err := cisClient.UpdateQueryStringSortWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Update query string sort setting failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		if value, ok := d.GetOkExists(cisCachePurgeAll); ok {
 			if value.(bool) == true {
 				opt := cisClient.NewPurgeAllOptions()
-				result, response, err := cisClient.PurgeAll(opt)
+				result, response, err := cisClient.PurgeAllWithContext(ctx, opt)
 				if err != nil {
 					log.Printf("Purge all failed : %v", response)
-					return err
+					return diag.FromErr(err)
 				}
 				log.Printf("Purge all successful : %s", *result.Result.ID)
 			}
@@ -307,20 +309,20 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			urls := flex.ExpandStringList(value.([]interface{}))
 			opt := cisClient.NewPurgeByUrlsOptions()
 			opt.SetFiles(urls)
-			_, response, err := cisClient.PurgeByUrls(opt)
+			_, response, err := cisClient.PurgeByUrlsWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Purge by urls failed : %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		if value, ok := d.GetOk(cisCachePurgeByCacheTags); ok {
 			cacheTags := flex.ExpandStringList(value.([]interface{}))
 			opt := cisClient.NewPurgeByCacheTagsOptions()
 			opt.SetTags(cacheTags)
-			result, response, err := cisClient.PurgeByCacheTags(opt)
+			result, response, err := cisClient.PurgeByCacheTagsWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Purge by cache tags failed : %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 			log.Printf("Purge by tags successful : %s", *result.Result.ID)
 
@@ -329,63 +331,63 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			hosts := flex.ExpandStringList(value.([]interface{}))
 			opt := cisClient.NewPurgeByHostsOptions()
 			opt.SetHosts(hosts)
-			result, response, err := cisClient.PurgeByHosts(opt)
+			result, response, err := cisClient.PurgeByHostsWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Purge by hosts failed : %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 			log.Printf("Purge by hosts successful : %s", *result.Result.ID)
 		}
 	}
 	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
-	return resourceCISCacheSettingsRead(d, meta)
+	return resourceCISCacheSettingsRead(ctx, d, meta)
 }
 
-func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCacheSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisCacheClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	zoneID, crn, _ := flex.ConvertTftoCisTwoVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
 
 	// Caching Level Setting
-	cacheLevel, resp, err := cisClient.GetCacheLevel(cisClient.NewGetCacheLevelOptions())
+	cacheLevel, resp, err := cisClient.GetCacheLevelWithContext(ctx, cisClient.NewGetCacheLevelOptions())
 	if err != nil {
 		log.Printf("Get caching leve setting failed : %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Serve Stale Content setting
-	servestaleContent, resp, err := cisClient.GetServeStaleContent(cisClient.NewGetServeStaleContentOptions())
+	servestaleContent, resp, err := cisClient.GetServeStaleContentWithContext(ctx, cisClient.NewGetServeStaleContentOptions())
 	if err != nil {
 		log.Printf("Get Serve Stale Content setting failed : %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Browser Expiration setting
-	browserCacheTTL, resp, err := cisClient.GetBrowserCacheTTL(
+	browserCacheTTL, resp, err := cisClient.GetBrowserCacheTTLWithContext(ctx,
 		cisClient.NewGetBrowserCacheTtlOptions())
 	if err != nil {
 		log.Printf("Get browser expiration setting failed : %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// development mode setting
-	devMode, resp, err := cisClient.GetDevelopmentMode(
+	devMode, resp, err := cisClient.GetDevelopmentModeWithContext(ctx,
 		cisClient.NewGetDevelopmentModeOptions())
 	if err != nil {
 		log.Printf("Get development mode setting failed : %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Query string sort setting
-	queryStringSort, resp, err := cisClient.GetQueryStringSort(
+	queryStringSort, resp, err := cisClient.GetQueryStringSortWithContext(ctx,
 		cisClient.NewGetQueryStringSortOptions())
 	if err != nil {
 		log.Printf("Get query string sort setting failed : %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -397,7 +399,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceCISCacheSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCacheSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
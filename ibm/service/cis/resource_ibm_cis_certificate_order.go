@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"log"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,12 +30,12 @@ const (
 
 func ResourceIBMCISCertificateOrder() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISCertificateOrderCreate,
-		Update:   ResourceIBMCISCertificateOrderRead,
-		Read:     ResourceIBMCISCertificateOrderRead,
-		Delete:   ResourceIBMCISCertificateOrderDelete,
-		Exists:   ResourceIBMCISCertificateOrderExist,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISCertificateOrderCreate,
+		UpdateContext: ResourceIBMCISCertificateOrderRead,
+		ReadContext:   ResourceIBMCISCertificateOrderRead,
+		DeleteContext: ResourceIBMCISCertificateOrderDelete,
+		Exists:        ResourceIBMCISCertificateOrderExist,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -88,10 +90,10 @@ func ResourceIBMCISCertificateOrderValidator() *validate.ResourceValidator {
 	return &cisCertificateOrderValidator
 }
 
-func ResourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISCertificateOrderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -105,33 +107,33 @@ func ResourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface
 	opt.SetType(certType)
 	opt.SetHosts(hostsList)
 
-	result, resp, err := cisClient.OrderCertificate(opt)
+	result, resp, err := cisClient.OrderCertificateWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Certificate order failed: %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return ResourceIBMCISCertificateOrderRead(d, meta)
+	return ResourceIBMCISCertificateOrderRead(ctx, d, meta)
 }
 
-func ResourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISCertificateOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certificateID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading certificate id")
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetCustomCertificateOptions(certificateID)
-	result, resp, err := cisClient.GetCustomCertificate(opt)
+	result, resp, err := cisClient.GetCustomCertificateWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Certificate read failed: %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -142,28 +144,28 @@ func ResourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func ResourceIBMCISCertificateOrderDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISCertificateOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certificateID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading certificate id")
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewDeleteCertificateOptions(certificateID)
-	resp, err := cisClient.DeleteCertificate(opt)
+	resp, err := cisClient.DeleteCertificateWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Certificate delete failed: %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
-	_, err = waitForCISCertificateOrderDelete(d, meta)
+	_, err = waitForCISCertificateOrderDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -194,7 +196,7 @@ func ResourceIBMCISCertificateOrderExist(d *schema.ResourceData, meta interface{
 	return true, nil
 }
 
-func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISCertificateOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
 		return nil, err
@@ -211,7 +213,7 @@ func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{})
 		Pending: []string{cisCertificateOrderDeletePending},
 		Target:  []string{cisCertificateOrderDeleted},
 		Refresh: func() (interface{}, string, error) {
-			_, detail, err := cisClient.GetCustomCertificate(opt)
+			_, detail, err := cisClient.GetCustomCertificateWithContext(ctx, opt)
 			if err != nil {
 				if detail != nil && detail.StatusCode == 400 {
 					return detail, cisCertificateOrderDeleted, nil
@@ -226,5 +228,5 @@ func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{})
 		PollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
@@ -4,12 +4,14 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -88,11 +90,11 @@ func ResourceIBMCISCustomPage() *schema.Resource {
 				Computed:    true,
 			},
 		},
-		Create:   resourceCISCustomPageUpdate,
-		Read:     resourceCISCustomPageRead,
-		Update:   resourceCISCustomPageUpdate,
-		Delete:   resourceCISCustomPageDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISCustomPageUpdate,
+		ReadContext:   resourceCISCustomPageRead,
+		UpdateContext: resourceCISCustomPageUpdate,
+		DeleteContext: resourceCISCustomPageDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
@@ -114,10 +116,10 @@ func ResourceIBMCISCustomPageValidator() *validate.ResourceValidator {
 	return &ibmCISCustomPageResourceValidator
 }
 
-func resourceCISCustomPageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCustomPageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisCustomPageClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -136,20 +138,20 @@ func resourceCISCustomPageUpdate(d *schema.ResourceData, meta interface{}) error
 		opt.SetURL(url)
 		opt.SetState(state)
 
-		result, response, err := cisClient.UpdateZoneCustomPage(opt)
+		result, response, err := cisClient.UpdateZoneCustomPageWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Update custom page failed : %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	}
-	return resourceCISCustomPageRead(d, meta)
+	return resourceCISCustomPageRead(ctx, d, meta)
 }
 
-func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCustomPageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisCustomPageClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	pageID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -157,7 +159,7 @@ func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
 
 	opt := cisClient.NewGetZoneCustomPageOptions(pageID)
 
-	result, response, err := cisClient.GetZoneCustomPage(opt)
+	result, response, err := cisClient.GetZoneCustomPageWithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("Custom Page has some error: %v", response)
@@ -165,7 +167,7 @@ func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 		log.Printf("Get custom page failed : %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(cisID, crn)
@@ -181,7 +183,7 @@ func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISCustomPageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCustomPageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
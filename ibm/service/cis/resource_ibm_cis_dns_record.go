@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,12 +52,12 @@ const (
 
 func ResourceIBMCISDnsRecord() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISDnsRecordCreate,
-		Read:     ResourceIBMCISDnsRecordRead,
-		Update:   ResourceIBMCISDnsRecordUpdate,
-		Delete:   ResourceIBMCISDnsRecordDelete,
-		Exists:   ResourceIBMCISDnsRecordExist,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISDnsRecordCreate,
+		ReadContext:   ResourceIBMCISDnsRecordRead,
+		UpdateContext: ResourceIBMCISDnsRecordUpdate,
+		DeleteContext: ResourceIBMCISDnsRecordDelete,
+		Exists:        ResourceIBMCISDnsRecordExist,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -139,12 +141,12 @@ func ResourceIBMCISDnsRecord() *schema.Resource {
 	}
 }
 
-func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISDnsRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
 	if err != nil {
 		log.Printf("Error: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	var (
 		crn            string
@@ -210,7 +212,7 @@ func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		data, ok = d.GetOk(cisDNSRecordData)
 		if ok == false {
 			log.Printf("Error in getting data")
-			return err
+			return diag.FromErr(err)
 		}
 		recordData = make(map[string]interface{})
 		var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -218,14 +220,14 @@ func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// altitude
 		v, ok = strconv.ParseFloat(dataMap["altitude"].(string), 64)
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diag.FromErr(fmt.Errorf("data input error"))
 		}
 		recordData["altitude"] = v
 
 		// lat_degrees
 		v, ok = strconv.Atoi(dataMap["lat_degrees"].(string))
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diag.FromErr(fmt.Errorf("data input error"))
 		}
 		recordData["lat_degrees"] = v
 
@@ -238,14 +240,14 @@ func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// lat_minutes
 		v, ok = strconv.Atoi(dataMap["lat_minutes"].(string))
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diag.FromErr(fmt.Errorf("data input error"))
 		}
 		recordData["lat_minutes"] = v
 
 		// lat_seconds
 		v, ok = strconv.ParseFloat(dataMap["lat_seconds"].(string), 64)
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diag.FromErr(fmt.Errorf("data input error"))
 
 		}
 		recordData["lat_seconds"] = v
@@ -253,42 +255,42 @@ func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// long_degrees
 		v, ok := strconv.Atoi(dataMap["long_degrees"].(string))
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["long_degrees"] = v
 
 		// long_minutes
 		v, ok = strconv.Atoi(dataMap["long_minutes"].(string))
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["long_minutes"] = v
 
 		// long_seconds
 		i, ok := strconv.ParseFloat(dataMap["long_seconds"].(string), 64)
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["long_seconds"] = i
 
 		// percision_horz
 		i, ok = strconv.ParseFloat(dataMap["precision_horz"].(string), 64)
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["precision_horz"] = i
 
 		// precision_vert
 		i, ok = strconv.ParseFloat(dataMap["precision_vert"].(string), 64)
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["precision_vert"] = i
 
 		// size
 		i, ok = strconv.ParseFloat(dataMap["size"].(string), 64)
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["size"] = i
 
@@ -303,7 +305,7 @@ func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		data, ok = d.GetOk(cisDNSRecordData)
 		if ok == false {
 			log.Printf("Error in getting data")
-			return err
+			return diag.FromErr(err)
 		}
 		recordData = make(map[string]interface{})
 		var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -323,7 +325,7 @@ func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		data, ok = d.GetOk(cisDNSRecordData)
 		if ok == false {
 			log.Printf("Error in getting data")
-			return err
+			return diag.FromErr(err)
 		}
 		recordData = make(map[string]interface{})
 		var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -348,21 +350,21 @@ func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// port
 		s, ok := strconv.Atoi(dataMap["port"].(string))
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["port"] = s
 
 		// priority
 		s, ok = strconv.Atoi(dataMap["priority"].(string))
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["priority"] = s
 
 		// weight
 		s, ok = strconv.Atoi(dataMap["weight"].(string))
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["weight"] = s
 		opt.SetData(recordData)
@@ -385,7 +387,7 @@ func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 			for id, content := range data.(map[string]interface{}) {
 				newData, err := flex.TransformToIBMCISDnsData(recordType, id, content)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				} else if newData == nil {
 					continue
 				}
@@ -396,9 +398,9 @@ func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		if contentOk == dataOk {
-			return fmt.Errorf(
+			return diag.FromErr(fmt.Errorf(
 				"either 'content' (present: %t) or 'data' (present: %t) must be provided",
-				contentOk, dataOk)
+				contentOk, dataOk))
 		}
 
 		if priority, ok := d.GetOk("priority"); ok {
@@ -410,18 +412,18 @@ func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 
 	}
 
-	result, response, err := sess.CreateDnsRecord(opt)
+	result, response, err := sess.CreateDnsRecordWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Error creating dns record: %s, error %s", response, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return ResourceIBMCISDnsRecordUpdate(d, meta)
+	return ResourceIBMCISDnsRecordUpdate(ctx, d, meta)
 
 }
 
-func ResourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISDnsRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		crn      string
 		zoneID   string
@@ -429,25 +431,25 @@ func ResourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 	)
 	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	recordID, zoneID, crn, _ = flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := sess.NewGetDnsRecordOptions(recordID)
-	result, response, err := sess.GetDnsRecord(opt)
+	result, response, err := sess.GetDnsRecordWithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
 		log.Printf("Error reading dns record: %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(cisID, crn)
@@ -473,11 +475,11 @@ func ResourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISDnsRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
 	if err != nil {
 		log.Printf("Error: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	var (
 		recordID       string
@@ -497,7 +499,7 @@ func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 	recordID, zoneID, crn, err = flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading record id")
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
@@ -565,7 +567,7 @@ func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			data, ok = d.GetOk(cisDNSRecordData)
 			if !ok {
 				log.Printf("Error in getting data")
-				return err
+				return diag.FromErr(err)
 			}
 			recordData = make(map[string]interface{})
 			var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -573,14 +575,14 @@ func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			// altitude
 			v, ok := strconv.ParseFloat(dataMap["altitude"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["altitude"] = v
 
 			// lat_degrees
 			i, ok := strconv.Atoi(dataMap["lat_degrees"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["lat_degrees"] = i
 
@@ -593,56 +595,56 @@ func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			// lat_minutes
 			i, ok = strconv.Atoi(dataMap["lat_minutes"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["lat_minutes"] = i
 
 			// lat_seconds
 			v, ok = strconv.ParseFloat(dataMap["lat_seconds"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["lat_seconds"] = v
 
 			// long_degrees
 			i, ok = strconv.Atoi(dataMap["long_degrees"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["long_degrees"] = i
 
 			// long_minutes
 			i, ok = strconv.Atoi(dataMap["long_minutes"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["long_minutes"] = i
 
 			// long_seconds
 			v, ok = strconv.ParseFloat(dataMap["long_seconds"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["long_seconds"] = v
 
 			// percision_horz
 			v, ok = strconv.ParseFloat(dataMap["precision_horz"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["precision_horz"] = v
 
 			// precision_vert
 			v, ok = strconv.ParseFloat(dataMap["precision_vert"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["precision_vert"] = v
 
 			// size
 			v, ok = strconv.ParseFloat(dataMap["size"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["size"] = v
 
@@ -657,7 +659,7 @@ func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			data, ok = d.GetOk(cisDNSRecordData)
 			if !ok {
 				log.Printf("Error in getting data")
-				return err
+				return diag.FromErr(err)
 			}
 			recordData = make(map[string]interface{})
 			var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -677,7 +679,7 @@ func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			data, ok = d.GetOk(cisDNSRecordData)
 			if !ok {
 				log.Printf("Error in getting data")
-				return err
+				return diag.FromErr(err)
 			}
 			recordData = make(map[string]interface{})
 			var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -702,21 +704,21 @@ func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			// port
 			s, ok := strconv.Atoi(dataMap["port"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["port"] = s
 
 			// priority
 			s, ok = strconv.Atoi(dataMap["priority"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["priority"] = s
 
 			// weight
 			s, ok = strconv.Atoi(dataMap["weight"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["weight"] = s
 			opt.SetData(recordData)
@@ -744,8 +746,8 @@ func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 					opt.SetTTL(int64(ttl.(int)))
 				}
 				if ttl != 1 && proxied == true {
-					return fmt.Errorf("[ERROR] To enable proxy TTL should be Automatic %s",
-						"i.e it should be set to 1. For the the values other than Automatic, proxy should be disabled")
+					return diag.FromErr(fmt.Errorf("[ERROR] To enable proxy TTL should be Automatic %s",
+						"i.e it should be set to 1. For the the values other than Automatic, proxy should be disabled"))
 				}
 				priority, priorityOk := d.GetOk(cisDNSRecordPriority)
 				if priorityOk {
@@ -758,7 +760,7 @@ func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 					for id, content := range data.(map[string]interface{}) {
 						newData, err := flex.TransformToIBMCISDnsData(recordType, id, content)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						} else if newData == nil {
 							continue
 						}
@@ -768,24 +770,24 @@ func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 					opt.SetData(newDataMap)
 				}
 				if contentOk == dataOk {
-					return fmt.Errorf(
+					return diag.FromErr(fmt.Errorf(
 						"either 'content' (present: %t) or 'data' (present: %t) must be provided",
-						contentOk, dataOk)
+						contentOk, dataOk))
 				}
 			}
 		}
 
-		result, response, err := sess.UpdateDnsRecord(opt)
+		result, response, err := sess.UpdateDnsRecordWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Error updating dns record: %s, error %s", response, err)
-			return err
+			return diag.FromErr(err)
 		}
 		log.Printf("record id: %s", *result.Result.ID)
 	}
-	return ResourceIBMCISDnsRecordRead(d, meta)
+	return ResourceIBMCISDnsRecordRead(ctx, d, meta)
 }
 
-func ResourceIBMCISDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISDnsRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		crn      string
 		zoneID   string
@@ -794,23 +796,23 @@ func ResourceIBMCISDnsRecordDelete(d *schema.ResourceData, meta interface{}) err
 	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
 	if err != nil {
 		log.Printf("Error: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	// session options
 	recordID, zoneID, crn, _ = flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading input")
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
 
 	delOpt := sess.NewDeleteDnsRecordOptions(recordID)
-	result, response, err := sess.DeleteDnsRecord(delOpt)
+	result, response, err := sess.DeleteDnsRecordWithContext(ctx, delOpt)
 
 	if err != nil && !strings.Contains(err.Error(), "Request failed with status code: 404") {
 		log.Printf("Error deleting dns record %s: %s", *result.Result.ID, response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
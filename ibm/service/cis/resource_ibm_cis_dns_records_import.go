@@ -4,15 +4,21 @@
 package cis
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
+	cisdnsrecordsv1 "github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,6 +26,8 @@ const (
 	cisDNSRecordsImportFile               = "file"
 	cisDNSRecordsImportTotalRecordsParsed = "total_records_parsed"
 	cisDNSRecordsImportRecordsAdded       = "records_added"
+	cisDNSRecordsImportAuthoritative      = "authoritative"
+	cisDNSRecordsImportRecords            = "records"
 )
 
 func ResourceIBMCISDNSRecordsImport() *schema.Resource {
@@ -40,7 +48,12 @@ func ResourceIBMCISDNSRecordsImport() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "File to import",
 				Required:    true,
-				ForceNew:    true,
+			},
+			cisDNSRecordsImportAuthoritative: {
+				Type:        schema.TypeBool,
+				Description: "Whether the records of the domain are kept in sync with the file, the records not in the file being deleted",
+				Optional:    true,
+				Default:     false,
 			},
 			cisDNSRecordsImportTotalRecordsParsed: {
 				Type:        schema.TypeInt,
@@ -52,19 +65,58 @@ func ResourceIBMCISDNSRecordsImport() *schema.Resource {
 				Description: "added records count",
 				Computed:    true,
 			},
+			cisDNSRecordsImportRecords: {
+				Type:        schema.TypeList,
+				Description: "Records of the domain kept in sync with the file, in authoritative mode",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisDNSRecordName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record name",
+						},
+						cisDNSRecordType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record type",
+						},
+						cisDNSRecordContent: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record content, the record data for SRV and CAA records",
+						},
+						cisDNSRecordTTL: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "DNS record TTL",
+						},
+						cisDNSRecordPriority: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "MX record priority",
+						},
+					},
+				},
+			},
 		},
 
-		Create:   resourceCISDNSRecordsImportUpdate,
-		Read:     resourceCISDNSRecordsImportRead,
-		Update:   resourceCISDNSRecordsImportRead,
-		Delete:   resourceCISDNSRecordsImportDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISDNSRecordsImportCreate,
+		ReadContext:   resourceCISDNSRecordsImportRead,
+		UpdateContext: resourceCISDNSRecordsImportUpdate,
+		DeleteContext: resourceCISDNSRecordsImportDelete,
+		CustomizeDiff: resourceCISDNSRecordsImportCustomizeDiff,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
-func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISDNSRecordsImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get(cisDNSRecordsImportAuthoritative).(bool) {
+		return resourceCISDNSRecordsImportSync(ctx, d, meta)
+	}
+
 	cisClient, err := meta.(conns.ClientSession).CisDNSRecordBulkClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -75,14 +127,14 @@ func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{})
 
 	f, err := os.Open(file)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	opt := cisClient.NewPostDnsRecordsBulkOptions()
 	opt.SetFile(f)
-	result, response, err := cisClient.PostDnsRecordsBulk(opt)
+	result, response, err := cisClient.PostDnsRecordsBulkWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Error importing dns records: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	id := fmt.Sprintf("%v:%v:%s:%s:%s", *result.Result.TotalRecordsParsed,
 		*result.Result.RecsAdded, file, zoneID, crn)
@@ -92,7 +144,7 @@ func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{})
 
 }
 
-func resourceCISDNSRecordsImportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISDNSRecordsImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSplitStr := strings.SplitN(d.Id(), ":", 5)
 	parsed, _ := strconv.Atoi(idSplitStr[0])
 	added, _ := strconv.Atoi(idSplitStr[1])
@@ -101,14 +153,777 @@ func resourceCISDNSRecordsImportRead(d *schema.ResourceData, meta interface{}) e
 	crn := idSplitStr[4]
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
-	d.Set(cisDNSRecordsImportFile, file)
-	d.Set(cisDNSRecordsImportTotalRecordsParsed, parsed)
-	d.Set(cisDNSRecordsImportRecordsAdded, added)
+	if !d.Get(cisDNSRecordsImportAuthoritative).(bool) {
+		d.Set(cisDNSRecordsImportFile, file)
+		d.Set(cisDNSRecordsImportTotalRecordsParsed, parsed)
+		d.Set(cisDNSRecordsImportRecordsAdded, added)
+		return nil
+	}
+
+	// In authoritative mode the file may change in place, the ID keeping the
+	// file and the counts of the first sync.
+	if _, ok := d.GetOk(cisDNSRecordsImportFile); !ok {
+		d.Set(cisDNSRecordsImportFile, file)
+		d.Set(cisDNSRecordsImportTotalRecordsParsed, parsed)
+		d.Set(cisDNSRecordsImportRecordsAdded, added)
+	}
+	sess, err := cisDNSRecordsImportClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneName, records, err := cisZoneRecords(ctx, sess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(cisDNSRecordsImportRecords, flattenCISZoneRecords(cisSyncedZoneRecords(zoneName, records)))
 	return nil
 }
 
-func resourceCISDNSRecordsImportDelete(d *schema.ResourceData, meta interface{}) error {
-	// Nothing to delete on CIS DNS Record import resource
+func resourceCISDNSRecordsImportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get(cisDNSRecordsImportAuthoritative).(bool) {
+		return resourceCISDNSRecordsImportSync(ctx, d, meta)
+	}
+	return resourceCISDNSRecordsImportRead(ctx, d, meta)
+}
+
+func resourceCISDNSRecordsImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Nothing to delete on CIS DNS Record import resource, but the records
+	// kept in sync with the file in authoritative mode
+	if d.Get(cisDNSRecordsImportAuthoritative).(bool) {
+		sess, err := cisDNSRecordsImportClient(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		zoneName, records, err := cisZoneRecords(ctx, sess)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		synced := make(map[string]bool)
+		for _, r := range expandCISZoneRecords(d.Get(cisDNSRecordsImportRecords).([]interface{})) {
+			synced[r.key()] = true
+		}
+		deletes := make([]cisZoneRecord, 0)
+		for _, r := range cisSyncedZoneRecords(zoneName, records) {
+			if synced[r.key()] {
+				deletes = append(deletes, r)
+			}
+		}
+		if err := applyCISZoneRecords(ctx, sess, nil, nil, deletes); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	d.SetId("")
 	return nil
 }
+
+// resourceCISDNSRecordsImportCustomizeDiff plans the records of the file in
+// authoritative mode, showing the records of the domain drifting from the file.
+// Otherwise, the file is imported once and a new file forces a new resource.
+func resourceCISDNSRecordsImportCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get(cisDNSRecordsImportAuthoritative).(bool) {
+		if d.Id() != "" && d.HasChange(cisDNSRecordsImportFile) {
+			return d.ForceNew(cisDNSRecordsImportFile)
+		}
+		return nil
+	}
+	if !d.NewValueKnown(cisDNSRecordsImportFile) {
+		return d.SetNewComputed(cisDNSRecordsImportRecords)
+	}
+	records, err := parseCISZoneFile(d.Get(cisDNSRecordsImportFile).(string))
+	if err != nil {
+		return err
+	}
+	if !equalCISZoneRecords(records, expandCISZoneRecords(d.Get(cisDNSRecordsImportRecords).([]interface{}))) {
+		return d.SetNew(cisDNSRecordsImportRecords, flattenCISZoneRecords(records))
+	}
+	return nil
+}
+
+// resourceCISDNSRecordsImportSync creates, updates and deletes the records of
+// the domain for them to match the records of the file.
+func resourceCISDNSRecordsImportSync(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := cisDNSRecordsImportClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	file := d.Get(cisDNSRecordsImportFile).(string)
+	records, err := parseCISZoneFile(file)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneName, current, err := cisZoneRecords(ctx, sess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	creates, updates, deletes := planCISZoneRecords(records, cisSyncedZoneRecords(zoneName, current))
+	if d.Id() == "" {
+		d.SetId(fmt.Sprintf("%d:%d:%s:%s:%s", len(records), len(creates), file,
+			*sess.ZoneIdentifier, *sess.Crn))
+	}
+	if err := applyCISZoneRecords(ctx, sess, creates, updates, deletes); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(cisDNSRecordsImportTotalRecordsParsed, len(records))
+	d.Set(cisDNSRecordsImportRecordsAdded, len(creates))
+
+	return resourceCISDNSRecordsImportRead(ctx, d, meta)
+}
+
+func cisDNSRecordsImportClient(d *schema.ResourceData, meta interface{}) (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	sess.Crn = core.StringPtr(d.Get(cisID).(string))
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+	return sess, nil
+}
+
+// cisZoneRecord is a DNS record of a zone file or of a domain. The name is
+// fully qualified, without the trailing dot, and the content of SRV and CAA
+// records is their record data as written in a zone file.
+type cisZoneRecord struct {
+	ID       string
+	Name     string
+	Type     string
+	Content  string
+	TTL      int
+	Priority int
+	Proxied  bool
+}
+
+// key identifies the record among the records of the domain, the domain
+// holding a single record of a name, type and content.
+func (r cisZoneRecord) key() string {
+	return r.Name + " " + r.Type + " " + r.Content
+}
+
+// options returns the name, content, priority and data of the record to create
+// or update it.
+func (r cisZoneRecord) options() (name *string, content *string, priority *int64, data interface{}) {
+	fields := strings.Fields(r.Content)
+	switch r.Type {
+	case cisDNSRecordTypeSRV:
+		labels := strings.SplitN(r.Name, ".", 3)
+		target := "."
+		if len(fields) > 3 {
+			target = fields[3]
+		}
+		data = map[string]interface{}{
+			"service":  labels[0],
+			"proto":    labels[1],
+			"name":     labels[2],
+			"priority": cisZoneFileInt(fields[0]),
+			"weight":   cisZoneFileInt(fields[1]),
+			"port":     cisZoneFileInt(fields[2]),
+			"target":   target,
+		}
+		return nil, nil, nil, data
+	case cisDNSRecordTypeCAA:
+		value := strings.SplitN(r.Content, " ", 3)[2]
+		value, _ = strconv.Unquote(value)
+		data = map[string]interface{}{
+			"flags": cisZoneFileInt(fields[0]),
+			"tag":   fields[1],
+			"value": value,
+		}
+		return core.StringPtr(r.Name), nil, nil, data
+	case cisDNSRecordTypeMX:
+		priority = core.Int64Ptr(int64(r.Priority))
+	}
+	return core.StringPtr(r.Name), core.StringPtr(r.Content), priority, nil
+}
+
+// cisZoneFileRecordTypes are the record types of the zone files kept in sync
+// with the domain, the records of other types being left as they are.
+var cisZoneFileRecordTypes = map[string]bool{
+	cisDNSRecordTypeA:     true,
+	cisDNSRecordTypeAAAA:  true,
+	cisDNSRecordTypeCAA:   true,
+	cisDNSRecordTypeCNAME: true,
+	cisDNSRecordTypeMX:    true,
+	cisDNSRecordTypeNS:    true,
+	cisDNSRecordTypePTR:   true,
+	cisDNSRecordTypeSPF:   true,
+	cisDNSRecordTypeSRV:   true,
+	cisDNSRecordTypeTXT:   true,
+}
+
+// cisZoneRecords returns the name of the zone and its records, sorted by name,
+// type and content.
+func cisZoneRecords(ctx context.Context, sess *cisdnsrecordsv1.DnsRecordsV1) (string, []cisZoneRecord, error) {
+	var (
+		zoneName string
+		records  []cisZoneRecord
+	)
+	for page := int64(1); ; page++ {
+		opt := sess.NewListAllDnsRecordsOptions()
+		opt.SetPage(page)
+		opt.SetPerPage(1000)
+		result, response, err := sess.ListAllDnsRecordsWithContext(ctx, opt)
+		if err != nil {
+			log.Printf("Error reading dns records: %s", response)
+			return "", nil, err
+		}
+		for _, instance := range result.Result {
+			zoneName = strings.ToLower(*instance.ZoneName)
+			records = append(records, cisZoneRecordFromDetails(instance))
+		}
+		if len(result.Result) == 0 || result.ResultInfo == nil || result.ResultInfo.TotalCount == nil ||
+			page*1000 >= *result.ResultInfo.TotalCount {
+			break
+		}
+	}
+	sortCISZoneRecords(records)
+	return zoneName, records, nil
+}
+
+func cisZoneRecordFromDetails(instance cisdnsrecordsv1.DnsrecordDetails) cisZoneRecord {
+	record := cisZoneRecord{
+		ID:   *instance.ID,
+		Name: strings.ToLower(*instance.Name),
+		Type: *instance.Type,
+	}
+	if instance.Content != nil {
+		record.Content = *instance.Content
+	}
+	if instance.TTL != nil {
+		record.TTL = int(*instance.TTL)
+	}
+	if instance.Proxied != nil {
+		record.Proxied = *instance.Proxied
+	}
+	data, _ := instance.Data.(map[string]interface{})
+	switch record.Type {
+	case cisDNSRecordTypeAAAA:
+		if ip := net.ParseIP(record.Content); ip != nil {
+			record.Content = ip.String()
+		}
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+		record.Content = cisZoneFileTarget(record.Content)
+	case cisDNSRecordTypeMX:
+		record.Content = cisZoneFileTarget(record.Content)
+		if instance.Priority != nil {
+			record.Priority = int(*instance.Priority)
+		}
+	case cisDNSRecordTypeSRV:
+		if data != nil {
+			record.Content = fmt.Sprintf("%d %d %d %s", cisZoneFileInt(data["priority"]),
+				cisZoneFileInt(data["weight"]), cisZoneFileInt(data["port"]),
+				cisZoneFileTarget(fmt.Sprint(data["target"])))
+		}
+	case cisDNSRecordTypeCAA:
+		if data != nil {
+			record.Content = fmt.Sprintf("%d %s %s", cisZoneFileInt(data["flags"]),
+				strings.ToLower(fmt.Sprint(data["tag"])), strconv.Quote(fmt.Sprint(data["value"])))
+		}
+	}
+	return record
+}
+
+// cisSyncedZoneRecords returns the records of the domain kept in sync with a
+// zone file, skipping the NS records of the zone apex managed by CIS.
+func cisSyncedZoneRecords(zoneName string, records []cisZoneRecord) []cisZoneRecord {
+	synced := make([]cisZoneRecord, 0, len(records))
+	for _, r := range records {
+		if !cisZoneFileRecordTypes[r.Type] || (r.Type == cisDNSRecordTypeNS && r.Name == zoneName) {
+			continue
+		}
+		synced = append(synced, r)
+	}
+	return synced
+}
+
+// planCISZoneRecords returns the records to create, update and delete for the
+// current records of the domain to match the records of the zone file. A
+// record matching by name, type and content is updated when its TTL or
+// priority changed, the other records of a name and type are updated in pairs
+// before being created or deleted, changing a CNAME record in place.
+func planCISZoneRecords(records []cisZoneRecord, current []cisZoneRecord) (creates []cisZoneRecord, updates []cisZoneRecord, deletes []cisZoneRecord) {
+	currentByKey := make(map[string]cisZoneRecord, len(current))
+	for _, r := range current {
+		currentByKey[r.key()] = r
+	}
+	kept := make(map[string]bool, len(current))
+	unmatched := make([]cisZoneRecord, 0)
+	for _, r := range records {
+		c, ok := currentByKey[r.key()]
+		if !ok {
+			unmatched = append(unmatched, r)
+			continue
+		}
+		kept[c.ID] = true
+		if c.TTL != r.TTL || c.Priority != r.Priority {
+			r.ID, r.Proxied = c.ID, c.Proxied
+			updates = append(updates, r)
+		}
+	}
+
+	remaining := make(map[string][]cisZoneRecord)
+	for _, c := range current {
+		if !kept[c.ID] {
+			remaining[c.Name+" "+c.Type] = append(remaining[c.Name+" "+c.Type], c)
+		}
+	}
+	for _, r := range unmatched {
+		if c := remaining[r.Name+" "+r.Type]; len(c) > 0 {
+			remaining[r.Name+" "+r.Type] = c[1:]
+			kept[c[0].ID] = true
+			r.ID, r.Proxied = c[0].ID, c[0].Proxied
+			updates = append(updates, r)
+			continue
+		}
+		creates = append(creates, r)
+	}
+	for _, c := range current {
+		if !kept[c.ID] {
+			deletes = append(deletes, c)
+		}
+	}
+	return creates, updates, deletes
+}
+
+// applyCISZoneRecords deletes, updates and creates the records of the domain,
+// in that order for a record deleted not to conflict with the one replacing it.
+func applyCISZoneRecords(ctx context.Context, sess *cisdnsrecordsv1.DnsRecordsV1, creates []cisZoneRecord, updates []cisZoneRecord, deletes []cisZoneRecord) error {
+	for _, r := range deletes {
+		opt := sess.NewDeleteDnsRecordOptions(r.ID)
+		_, response, err := sess.DeleteDnsRecordWithContext(ctx, opt)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("Error deleting dns record: %s", response)
+			return fmt.Errorf("[ERROR] Error deleting %s record %s (%s): %s", r.Type, r.Name, r.Content, err)
+		}
+		log.Printf("[INFO] Deleted %s record %s (%s)", r.Type, r.Name, r.Content)
+	}
+	for _, r := range updates {
+		opt := sess.NewUpdateDnsRecordOptions(r.ID)
+		opt.SetType(r.Type)
+		opt.SetTTL(int64(r.TTL))
+		opt.SetProxied(r.Proxied)
+		opt.Name, opt.Content, opt.Priority, opt.Data = r.options()
+		_, response, err := sess.UpdateDnsRecordWithContext(ctx, opt)
+		if err != nil {
+			log.Printf("Error updating dns record: %s", response)
+			return fmt.Errorf("[ERROR] Error updating %s record %s (%s): %s", r.Type, r.Name, r.Content, err)
+		}
+		log.Printf("[INFO] Updated %s record %s (%s)", r.Type, r.Name, r.Content)
+	}
+	for _, r := range creates {
+		opt := sess.NewCreateDnsRecordOptions()
+		opt.SetType(r.Type)
+		opt.SetTTL(int64(r.TTL))
+		opt.Name, opt.Content, opt.Priority, opt.Data = r.options()
+		_, response, err := sess.CreateDnsRecordWithContext(ctx, opt)
+		if err != nil {
+			log.Printf("Error creating dns record: %s", response)
+			return fmt.Errorf("[ERROR] Error creating %s record %s (%s): %s", r.Type, r.Name, r.Content, err)
+		}
+		log.Printf("[INFO] Created %s record %s (%s)", r.Type, r.Name, r.Content)
+	}
+	return nil
+}
+
+func sortCISZoneRecords(records []cisZoneRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Name != records[j].Name {
+			return records[i].Name < records[j].Name
+		}
+		if records[i].Type != records[j].Type {
+			return records[i].Type < records[j].Type
+		}
+		return records[i].Content < records[j].Content
+	})
+}
+
+// equalCISZoneRecords reports whether the sorted records have the same names,
+// types, contents, TTLs and priorities.
+func equalCISZoneRecords(a []cisZoneRecord, b []cisZoneRecord) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].key() != b[i].key() || a[i].TTL != b[i].TTL || a[i].Priority != b[i].Priority {
+			return false
+		}
+	}
+	return true
+}
+
+func flattenCISZoneRecords(records []cisZoneRecord) []interface{} {
+	flattened := make([]interface{}, 0, len(records))
+	for _, r := range records {
+		flattened = append(flattened, map[string]interface{}{
+			cisDNSRecordName:     r.Name,
+			cisDNSRecordType:     r.Type,
+			cisDNSRecordContent:  r.Content,
+			cisDNSRecordTTL:      r.TTL,
+			cisDNSRecordPriority: r.Priority,
+		})
+	}
+	return flattened
+}
+
+func expandCISZoneRecords(records []interface{}) []cisZoneRecord {
+	expanded := make([]cisZoneRecord, 0, len(records))
+	for _, v := range records {
+		r, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		expanded = append(expanded, cisZoneRecord{
+			Name:     r[cisDNSRecordName].(string),
+			Type:     r[cisDNSRecordType].(string),
+			Content:  r[cisDNSRecordContent].(string),
+			TTL:      r[cisDNSRecordTTL].(int),
+			Priority: r[cisDNSRecordPriority].(int),
+		})
+	}
+	return expanded
+}
+
+// cisZoneFileToken is a word or a quoted string of a zone file entry.
+type cisZoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+// cisZoneFileEntry is a directive or a record of a zone file, spanning several
+// lines within parentheses. The first token of an entry beginning with a blank
+// is empty, the entry having the owner of the previous one.
+type cisZoneFileEntry struct {
+	line   int
+	tokens []cisZoneFileToken
+}
+
+// parseCISZoneFile returns the records of a BIND zone file, sorted by name,
+// type and content. The SOA record and the NS records of the zone apex, named
+// after the SOA record or the first $ORIGIN, are skipped as managed by CIS.
+func parseCISZoneFile(file string) ([]cisZoneRecord, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading zone file %s: %s", file, err)
+	}
+	entries, err := splitCISZoneFile(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing zone file %s: %s", file, err)
+	}
+
+	var (
+		origin, owner, apex string
+		ttl                 = 1
+		ttlDirective        bool
+		records             []cisZoneRecord
+	)
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		tokens := entry.tokens
+		errorf := func(format string, a ...interface{}) error {
+			return fmt.Errorf("[ERROR] Error parsing zone file %s at line %d: %s", file, entry.line, fmt.Sprintf(format, a...))
+		}
+
+		if directive := tokens[0].text; !tokens[0].quoted && strings.HasPrefix(directive, "$") {
+			if len(tokens) < 2 {
+				return nil, errorf("%s needs a value", directive)
+			}
+			switch strings.ToUpper(directive) {
+			case "$ORIGIN":
+				if origin, err = cisZoneFileName(tokens[1].text, origin); err != nil {
+					return nil, errorf("%s", err)
+				}
+				if apex == "" {
+					apex = origin
+				}
+			case "$TTL":
+				if ttl, err = cisZoneFileTTL(tokens[1].text); err != nil {
+					return nil, errorf("%s", err)
+				}
+				ttlDirective = true
+			default:
+				return nil, errorf("unsupported directive %s", directive)
+			}
+			continue
+		}
+
+		if tokens[0].text != "" || tokens[0].quoted {
+			if owner, err = cisZoneFileName(tokens[0].text, origin); err != nil {
+				return nil, errorf("%s", err)
+			}
+		} else if owner == "" {
+			return nil, errorf("record without owner name")
+		}
+		tokens = tokens[1:]
+
+		recordTTL := ttl
+		for len(tokens) > 0 {
+			if strings.EqualFold(tokens[0].text, "IN") {
+				tokens = tokens[1:]
+				continue
+			}
+			v, err := cisZoneFileTTL(tokens[0].text)
+			if err != nil {
+				break
+			}
+			recordTTL = v
+			if !ttlDirective {
+				ttl = v
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 {
+			return nil, errorf("record without type")
+		}
+
+		recordType := strings.ToUpper(tokens[0].text)
+		if recordType == "SOA" {
+			apex = owner
+			continue
+		}
+		record, err := parseCISZoneFileRecord(owner, recordType, tokens[1:], origin)
+		if err != nil {
+			return nil, errorf("%s", err)
+		}
+		record.TTL = recordTTL
+		if seen[record.key()] {
+			return nil, errorf("duplicate %s record %s (%s)", record.Type, record.Name, record.Content)
+		}
+		seen[record.key()] = true
+		records = append(records, record)
+	}
+
+	parsed := make([]cisZoneRecord, 0, len(records))
+	for _, r := range records {
+		if r.Type == cisDNSRecordTypeNS && r.Name == apex {
+			continue
+		}
+		parsed = append(parsed, r)
+	}
+	sortCISZoneRecords(parsed)
+	return parsed, nil
+}
+
+// parseCISZoneFileRecord returns the record of the owner name, type and record
+// data of a zone file entry.
+func parseCISZoneFileRecord(owner string, recordType string, rdata []cisZoneFileToken, origin string) (cisZoneRecord, error) {
+	record := cisZoneRecord{Name: owner, Type: recordType}
+	count := map[string]int{
+		cisDNSRecordTypeA:     1,
+		cisDNSRecordTypeAAAA:  1,
+		cisDNSRecordTypeCNAME: 1,
+		cisDNSRecordTypeNS:    1,
+		cisDNSRecordTypePTR:   1,
+		cisDNSRecordTypeMX:    2,
+		cisDNSRecordTypeCAA:   3,
+		cisDNSRecordTypeSRV:   4,
+	}
+	if !cisZoneFileRecordTypes[recordType] {
+		return record, fmt.Errorf("unsupported record type %s", recordType)
+	}
+	if n, ok := count[recordType]; (ok && len(rdata) != n) || len(rdata) == 0 {
+		return record, fmt.Errorf("%s record %s has %d values", recordType, owner, len(rdata))
+	}
+
+	var err error
+	switch recordType {
+	case cisDNSRecordTypeA, cisDNSRecordTypeAAAA:
+		ip := net.ParseIP(rdata[0].text)
+		if ip == nil || (ip.To4() != nil) != (recordType == cisDNSRecordTypeA) {
+			return record, fmt.Errorf("invalid %s record address %s", recordType, rdata[0].text)
+		}
+		record.Content = ip.String()
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+		record.Content, err = cisZoneFileName(rdata[0].text, origin)
+	case cisDNSRecordTypeMX:
+		if record.Priority, err = cisZoneFileUint(rdata[0].text, 65535); err != nil {
+			return record, err
+		}
+		record.Content, err = cisZoneFileName(rdata[1].text, origin)
+	case cisDNSRecordTypeTXT, cisDNSRecordTypeSPF:
+		texts := make([]string, 0, len(rdata))
+		for _, t := range rdata {
+			texts = append(texts, t.text)
+		}
+		record.Content = strings.Join(texts, "")
+	case cisDNSRecordTypeSRV:
+		labels := strings.SplitN(owner, ".", 3)
+		if len(labels) != 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return record, fmt.Errorf("SRV record %s isn't named _service._proto.name", owner)
+		}
+		values := make([]int, 3)
+		for i := range values {
+			if values[i], err = cisZoneFileUint(rdata[i].text, 65535); err != nil {
+				return record, err
+			}
+		}
+		target, err := cisZoneFileName(rdata[3].text, origin)
+		if err != nil {
+			return record, err
+		}
+		record.Content = fmt.Sprintf("%d %d %d %s", values[0], values[1], values[2], target)
+	case cisDNSRecordTypeCAA:
+		flags, err := cisZoneFileUint(rdata[0].text, 255)
+		if err != nil {
+			return record, err
+		}
+		record.Content = fmt.Sprintf("%d %s %s", flags, strings.ToLower(rdata[1].text), strconv.Quote(rdata[2].text))
+	}
+	return record, err
+}
+
+// splitCISZoneFile splits the content of a zone file into its entries, without
+// the comments and the parentheses.
+func splitCISZoneFile(content string) ([]cisZoneFileEntry, error) {
+	var (
+		entries []cisZoneFileEntry
+		entry   cisZoneFileEntry
+		parens  int
+		line    = 1
+	)
+	lineStart := true
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		if entry.line == 0 && c != '\n' {
+			entry.line = line
+		}
+		switch {
+		case c == '\n':
+			if parens == 0 {
+				if len(entry.tokens) > 1 || (len(entry.tokens) == 1 && (entry.tokens[0].text != "" || entry.tokens[0].quoted)) {
+					entries = append(entries, entry)
+				}
+				entry = cisZoneFileEntry{}
+				lineStart = true
+			}
+			line++
+			continue
+		case c == ';':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			if lineStart && parens == 0 {
+				entry.tokens = append(entry.tokens, cisZoneFileToken{})
+			}
+		case c == '(':
+			parens++
+		case c == ')':
+			if parens--; parens < 0 {
+				return nil, fmt.Errorf("unbalanced parenthesis at line %d", line)
+			}
+		case c == '"':
+			var text strings.Builder
+			for i++; i < len(content) && content[i] != '"'; i++ {
+				if content[i] == '\n' {
+					line++
+				}
+				if content[i] == '\\' && i+1 < len(content) {
+					i++
+					if i+2 < len(content) && isCISZoneFileDigits(content[i:i+3]) {
+						b, _ := strconv.Atoi(content[i : i+3])
+						text.WriteByte(byte(b))
+						i += 2
+						continue
+					}
+				}
+				text.WriteByte(content[i])
+			}
+			if i == len(content) {
+				return nil, fmt.Errorf("unterminated string at line %d", line)
+			}
+			entry.tokens = append(entry.tokens, cisZoneFileToken{text: text.String(), quoted: true})
+		default:
+			start := i
+			for i+1 < len(content) && !strings.ContainsRune(" \t\r\n;()\"", rune(content[i+1])) {
+				i++
+			}
+			entry.tokens = append(entry.tokens, cisZoneFileToken{text: content[start : i+1]})
+		}
+		lineStart = false
+	}
+	if parens != 0 {
+		return nil, fmt.Errorf("unbalanced parenthesis at line %d", line)
+	}
+	if len(entry.tokens) > 1 || (len(entry.tokens) == 1 && (entry.tokens[0].text != "" || entry.tokens[0].quoted)) {
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func isCISZoneFileDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// cisZoneFileName returns the fully qualified name of a zone file name, without
+// the trailing dot, the names not ending with a dot being relative to origin.
+func cisZoneFileName(name string, origin string) (string, error) {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@" && origin == "":
+		return "", fmt.Errorf("@ used without $ORIGIN")
+	case name == "@":
+		return origin, nil
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, "."), nil
+	case origin == "":
+		return "", fmt.Errorf("relative name %s used without $ORIGIN", name)
+	}
+	return name + "." + origin, nil
+}
+
+// cisZoneFileTTL returns the seconds of a TTL in seconds or in units of weeks,
+// days, hours, minutes and seconds, such as 1h30m.
+func cisZoneFileTTL(s string) (int, error) {
+	units := map[byte]int{'w': 604800, 'd': 86400, 'h': 3600, 'm': 60, 's': 1}
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0, fmt.Errorf("invalid TTL %s", s)
+	}
+	ttl, n := 0, 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			n = n*10 + int(c-'0')
+		case units[c|0x20] != 0 && i > 0 && s[i-1] >= '0' && s[i-1] <= '9':
+			ttl += n * units[c|0x20]
+			n = 0
+		default:
+			return 0, fmt.Errorf("invalid TTL %s", s)
+		}
+	}
+	return ttl + n, nil
+}
+
+func cisZoneFileUint(s string, max int) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < 0 || v > max {
+		return 0, fmt.Errorf("invalid value %s, expected an integer from 0 to %d", s, max)
+	}
+	return v, nil
+}
+
+// cisZoneFileInt returns the integer of a record data value, decoded from JSON
+// as a number or held as a string.
+func cisZoneFileInt(v interface{}) int {
+	switch v := v.(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case string:
+		i, _ := strconv.Atoi(v)
+		return i
+	}
+	return 0
+}
+
+// cisZoneFileTarget returns a target name of the API without the trailing dot.
+func cisZoneFileTarget(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
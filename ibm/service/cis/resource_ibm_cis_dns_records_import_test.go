@@ -5,6 +5,8 @@ package cis_test
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -35,6 +37,64 @@ func TestAccIBMCisDNSRecordsImport_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCisDNSRecordsImport_Authoritative(t *testing.T) {
+	name := "ibm_cis_dns_records_import." + "test"
+	file := filepath.Join(t.TempDir(), "dns_records.txt")
+	writeZoneFile := func(records string) {
+		zone := fmt.Sprintf("$ORIGIN %s.\n$TTL 900\n%s", acc.CisDomainTest, records)
+		if err := ioutil.WriteFile(file, []byte(zone), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeZoneFile(`
+test-import IN A 192.168.178.5
+test-import IN TXT "acceptance testing"
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisDNSRecordsImportConfigAuthoritative(file),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "authoritative", "true"),
+					resource.TestCheckResourceAttr(name, "total_records_parsed", "2"),
+					resource.TestCheckResourceAttr(name, "records.#", "2"),
+					resource.TestCheckResourceAttr(name, "records.0.type", "A"),
+					resource.TestCheckResourceAttr(name, "records.0.ttl", "900"),
+				),
+			},
+			{
+				// The record changed is updated and the record removed is
+				// deleted.
+				PreConfig: func() {
+					writeZoneFile(`
+test-import IN A 192.168.178.6
+`)
+				},
+				Config: testAccCheckCisDNSRecordsImportConfigAuthoritative(file),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "total_records_parsed", "1"),
+					resource.TestCheckResourceAttr(name, "records.#", "1"),
+					resource.TestCheckResourceAttr(name, "records.0.content", "192.168.178.6"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCisDNSRecordsImportConfigAuthoritative(file string) string {
+	return testAccCheckCisDomainConfigCisRIbasic("test", acc.CisDomainTest) +
+		fmt.Sprintf(`
+		resource "ibm_cis_dns_records_import" "test" {
+			cis_id        = data.ibm_cis.cis.id
+			domain_id     = ibm_cis_domain.cis_domain.id
+			file          = "%[1]s"
+			authoritative = true
+		}`, file)
+}
+
 func testAccCheckCisDNSRecordsImportConfigBasic1(file string) string {
 	return testAccCheckIBMCisDNSRecordConfigCisDSBasic(
 		"test-dns-record", acc.CisDomainStatic) +
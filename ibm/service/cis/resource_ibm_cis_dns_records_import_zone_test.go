@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitCISZoneFile(t *testing.T) {
+	word := func(text string) cisZoneFileToken { return cisZoneFileToken{text: text} }
+	quoted := func(text string) cisZoneFileToken { return cisZoneFileToken{text: text, quoted: true} }
+	blank := cisZoneFileToken{}
+
+	cases := []struct {
+		name    string
+		content string
+		entries []cisZoneFileEntry
+		err     string
+	}{
+		{
+			name:    "records and comments",
+			content: "; zone of example.com\n\nwww IN A 192.0.2.1 ; web server\n\tIN AAAA 2001:db8::1\n",
+			entries: []cisZoneFileEntry{
+				{line: 3, tokens: []cisZoneFileToken{word("www"), word("IN"), word("A"), word("192.0.2.1")}},
+				{line: 4, tokens: []cisZoneFileToken{blank, word("IN"), word("AAAA"), word("2001:db8::1")}},
+			},
+		},
+		{
+			name:    "parentheses",
+			content: "@ IN SOA ns1 admin (\n  2022060101 ; serial\n  7200 3600 )\nwww IN A 192.0.2.1",
+			entries: []cisZoneFileEntry{
+				{line: 1, tokens: []cisZoneFileToken{word("@"), word("IN"), word("SOA"), word("ns1"), word("admin"), word("2022060101"), word("7200"), word("3600")}},
+				{line: 4, tokens: []cisZoneFileToken{word("www"), word("IN"), word("A"), word("192.0.2.1")}},
+			},
+		},
+		{
+			name:    "quoted strings",
+			content: "txt IN TXT \"v=spf1 ; -all\" \"say \\\"hi\\\" \\065\"(\"split\n string\")\r\n",
+			entries: []cisZoneFileEntry{
+				{line: 1, tokens: []cisZoneFileToken{word("txt"), word("IN"), word("TXT"), quoted("v=spf1 ; -all"), quoted(`say "hi" A`), quoted("split\n string")}},
+			},
+		},
+		{
+			name:    "unbalanced parenthesis",
+			content: "www IN A 192.0.2.1\n)\n",
+			err:     "unbalanced parenthesis at line 2",
+		},
+		{
+			name:    "unclosed parenthesis",
+			content: "www IN TXT ( \"a\"\n",
+			err:     "unbalanced parenthesis at line 2",
+		},
+		{
+			name:    "unterminated string",
+			content: "www IN TXT \"a\nb",
+			err:     "unterminated string at line 2",
+		},
+	}
+	for _, c := range cases {
+		entries, err := splitCISZoneFile(c.content)
+		if c.err != "" {
+			if err == nil || err.Error() != c.err {
+				t.Errorf("%s: expected the error %q, got %v", c.name, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(entries, c.entries) {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.entries, entries)
+		}
+	}
+}
+
+func TestParseCISZoneFileRecord(t *testing.T) {
+	words := func(texts ...string) []cisZoneFileToken {
+		tokens := make([]cisZoneFileToken, 0, len(texts))
+		for _, text := range texts {
+			tokens = append(tokens, cisZoneFileToken{text: text})
+		}
+		return tokens
+	}
+
+	cases := []struct {
+		name       string
+		owner      string
+		recordType string
+		rdata      []cisZoneFileToken
+		record     cisZoneRecord
+		err        string
+	}{
+		{"A", "www.example.com", "A", words("192.0.2.1"), cisZoneRecord{Content: "192.0.2.1"}, ""},
+		{"AAAA", "www.example.com", "AAAA", words("2001:DB8:0::1"), cisZoneRecord{Content: "2001:db8::1"}, ""},
+		{"relative CNAME", "ftp.example.com", "CNAME", words("WWW"), cisZoneRecord{Content: "www.example.com"}, ""},
+		{"absolute CNAME", "ftp.example.com", "CNAME", words("cdn.example.org."), cisZoneRecord{Content: "cdn.example.org"}, ""},
+		{"MX", "example.com", "MX", words("10", "mail"), cisZoneRecord{Content: "mail.example.com", Priority: 10}, ""},
+		{"TXT", "example.com", "TXT", []cisZoneFileToken{{text: "v=spf1 ", quoted: true}, {text: "-all", quoted: true}}, cisZoneRecord{Content: "v=spf1 -all"}, ""},
+		{"SRV", "_sip._tcp.example.com", "SRV", words("10", "20", "5060", "sip.example.org."), cisZoneRecord{Content: "10 20 5060 sip.example.org"}, ""},
+		{"CAA", "example.com", "CAA", []cisZoneFileToken{{text: "0"}, {text: "ISSUE"}, {text: "letsencrypt.org", quoted: true}}, cisZoneRecord{Content: `0 issue "letsencrypt.org"`}, ""},
+		{"A of an IPv6 address", "www.example.com", "A", words("2001:db8::1"), cisZoneRecord{}, "invalid A record address 2001:db8::1"},
+		{"A of two addresses", "www.example.com", "A", words("192.0.2.1", "192.0.2.2"), cisZoneRecord{}, "A record www.example.com has 2 values"},
+		{"MX priority", "example.com", "MX", words("70000", "mail"), cisZoneRecord{}, "invalid value 70000, expected an integer from 0 to 65535"},
+		{"SRV name", "sip.example.com", "SRV", words("10", "20", "5060", "sip"), cisZoneRecord{}, "SRV record sip.example.com isn't named _service._proto.name"},
+		{"CAA flags", "example.com", "CAA", words("256", "issue", "ca"), cisZoneRecord{}, "invalid value 256, expected an integer from 0 to 255"},
+		{"unsupported type", "example.com", "LOC", words("52", "22", "23.000", "N"), cisZoneRecord{}, "unsupported record type LOC"},
+	}
+	for _, c := range cases {
+		record, err := parseCISZoneFileRecord(c.owner, c.recordType, c.rdata, "example.com")
+		if c.err != "" {
+			if err == nil || err.Error() != c.err {
+				t.Errorf("%s: expected the error %q, got %v", c.name, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+		c.record.Name, c.record.Type = c.owner, c.recordType
+		if record != c.record {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.record, record)
+		}
+	}
+}
+
+func TestParseCISZoneFile(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		records []cisZoneRecord
+		err     string
+	}{
+		{
+			name: "origin and TTL directives",
+			content: `$ORIGIN example.com.
+$TTL 1h
+@ IN SOA ns1.example.com. admin.example.com. (
+        2022060101 ; serial
+        7200 3600 1209600 3600 )
+@       IN NS ns1.example.com.
+        IN MX 10 mail
+        IN TXT "v=spf1 include:_spf.example.com ~all"
+        IN CAA 0 issue "letsencrypt.org"
+www 300 IN A 192.0.2.1
+        IN AAAA 2001:db8::1
+mail    IN A 192.0.2.2
+ftp     IN CNAME www
+_sip._tcp IN SRV 10 20 5060 sip.example.org.
+long    IN TXT ( "part one "
+                 "part two" ) ; comment
+sub     IN NS ns.sub
+$ORIGIN sub.example.com.
+host    IN A 192.0.2.3
+`,
+			records: []cisZoneRecord{
+				{Name: "_sip._tcp.example.com", Type: "SRV", Content: "10 20 5060 sip.example.org", TTL: 3600},
+				{Name: "example.com", Type: "CAA", Content: `0 issue "letsencrypt.org"`, TTL: 3600},
+				{Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 3600, Priority: 10},
+				{Name: "example.com", Type: "TXT", Content: "v=spf1 include:_spf.example.com ~all", TTL: 3600},
+				{Name: "ftp.example.com", Type: "CNAME", Content: "www.example.com", TTL: 3600},
+				{Name: "host.sub.example.com", Type: "A", Content: "192.0.2.3", TTL: 3600},
+				{Name: "long.example.com", Type: "TXT", Content: "part one part two", TTL: 3600},
+				{Name: "mail.example.com", Type: "A", Content: "192.0.2.2", TTL: 3600},
+				{Name: "sub.example.com", Type: "NS", Content: "ns.sub.example.com", TTL: 3600},
+				{Name: "www.example.com", Type: "A", Content: "192.0.2.1", TTL: 300},
+				{Name: "www.example.com", Type: "AAAA", Content: "2001:db8::1", TTL: 3600},
+			},
+		},
+		{
+			name: "absolute names and TTL of the previous record",
+			content: `example.com. IN NS ns1.example.com.
+www.example.com. 600 IN A 192.0.2.1
+api.example.com. IN A 192.0.2.4
+`,
+			records: []cisZoneRecord{
+				{Name: "api.example.com", Type: "A", Content: "192.0.2.4", TTL: 600},
+				{Name: "example.com", Type: "NS", Content: "ns1.example.com", TTL: 1},
+				{Name: "www.example.com", Type: "A", Content: "192.0.2.1", TTL: 600},
+			},
+		},
+		{
+			name:    "relative name without origin",
+			content: "www IN A 192.0.2.1\n",
+			err:     "at line 1: relative name www used without $ORIGIN",
+		},
+		{
+			name:    "unsupported record type",
+			content: "$ORIGIN example.com.\nloc IN LOC 52 22 23.000 N 4 53 32.000 E -2.00m\n",
+			err:     "at line 2: unsupported record type LOC",
+		},
+		{
+			name:    "unsupported directive",
+			content: "$INCLUDE other.zone\n",
+			err:     "at line 1: unsupported directive $INCLUDE",
+		},
+		{
+			name:    "duplicate record",
+			content: "$ORIGIN example.com.\nwww IN A 192.0.2.1\nWWW 300 IN A 192.0.2.1\n",
+			err:     "at line 3: duplicate A record www.example.com (192.0.2.1)",
+		},
+		{
+			name:    "record without owner",
+			content: "  IN A 192.0.2.1\n",
+			err:     "at line 1: record without owner name",
+		},
+		{
+			name:    "unbalanced parenthesis",
+			content: "$ORIGIN example.com.\nwww IN TXT ( \"a\"\n",
+			err:     "unbalanced parenthesis at line 3",
+		},
+	}
+	for _, c := range cases {
+		file := filepath.Join(t.TempDir(), "example.com.zone")
+		if err := ioutil.WriteFile(file, []byte(c.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		records, err := parseCISZoneFile(file)
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%s: expected an error containing %q, got %v", c.name, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(records, c.records) {
+			t.Errorf("%s: expected\n%+v\ngot\n%+v", c.name, c.records, records)
+		}
+	}
+}
+
+func TestPlanCISZoneRecords(t *testing.T) {
+	current := []cisZoneRecord{
+		{ID: "1", Name: "www.example.com", Type: "A", Content: "192.0.2.1", TTL: 300, Proxied: true},
+		{ID: "2", Name: "www.example.com", Type: "A", Content: "192.0.2.2", TTL: 300},
+		{ID: "3", Name: "ftp.example.com", Type: "CNAME", Content: "old.example.com", TTL: 300},
+		{ID: "4", Name: "mail.example.com", Type: "A", Content: "192.0.2.9", TTL: 300},
+		{ID: "5", Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 300, Priority: 10},
+		{ID: "6", Name: "api.example.com", Type: "A", Content: "192.0.2.5", TTL: 300},
+	}
+	records := []cisZoneRecord{
+		{Name: "www.example.com", Type: "A", Content: "192.0.2.1", TTL: 600},
+		{Name: "www.example.com", Type: "A", Content: "192.0.2.3", TTL: 300},
+		{Name: "www.example.com", Type: "A", Content: "192.0.2.4", TTL: 300},
+		{Name: "ftp.example.com", Type: "CNAME", Content: "new.example.com", TTL: 300},
+		{Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 300, Priority: 20},
+		{Name: "api.example.com", Type: "A", Content: "192.0.2.5", TTL: 300},
+	}
+
+	creates, updates, deletes := planCISZoneRecords(records, current)
+	expectedCreates := []cisZoneRecord{
+		{Name: "www.example.com", Type: "A", Content: "192.0.2.4", TTL: 300},
+	}
+	expectedUpdates := []cisZoneRecord{
+		// Matched by name, type and content, with another TTL or priority.
+		{ID: "1", Name: "www.example.com", Type: "A", Content: "192.0.2.1", TTL: 600, Proxied: true},
+		{ID: "5", Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 300, Priority: 20},
+		// Paired with the unmatched records of their name and type.
+		{ID: "2", Name: "www.example.com", Type: "A", Content: "192.0.2.3", TTL: 300},
+		{ID: "3", Name: "ftp.example.com", Type: "CNAME", Content: "new.example.com", TTL: 300},
+	}
+	expectedDeletes := []cisZoneRecord{current[3]}
+	if !reflect.DeepEqual(creates, expectedCreates) {
+		t.Errorf("expected the creates %+v, got %+v", expectedCreates, creates)
+	}
+	if !reflect.DeepEqual(updates, expectedUpdates) {
+		t.Errorf("expected the updates %+v, got %+v", expectedUpdates, updates)
+	}
+	if !reflect.DeepEqual(deletes, expectedDeletes) {
+		t.Errorf("expected the deletes %+v, got %+v", expectedDeletes, deletes)
+	}
+
+	creates, updates, deletes = planCISZoneRecords(current, current)
+	if len(creates) != 0 || len(updates) != 0 || len(deletes) != 0 {
+		t.Errorf("expected no change for the same records, got %+v %+v %+v", creates, updates, deletes)
+	}
+	creates, updates, deletes = planCISZoneRecords(nil, current)
+	if len(creates) != 0 || len(updates) != 0 || !reflect.DeepEqual(deletes, current) {
+		t.Errorf("expected the deletes of all the records, got %+v %+v %+v", creates, updates, deletes)
+	}
+}
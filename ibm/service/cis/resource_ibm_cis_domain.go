@@ -4,11 +4,13 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -56,19 +58,19 @@ func ResourceIBMCISDomain() *schema.Resource {
 				Computed: true,
 			},
 		},
-		Create:   resourceCISdomainCreate,
-		Read:     resourceCISdomainRead,
-		Exists:   resourceCISdomainExists,
-		Update:   resourceCISdomainUpdate,
-		Delete:   resourceCISdomainDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISdomainCreate,
+		ReadContext:   resourceCISdomainRead,
+		Exists:        resourceCISdomainExists,
+		UpdateContext: resourceCISdomainUpdate,
+		DeleteContext: resourceCISdomainDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
-func resourceCISdomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISdomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -77,31 +79,31 @@ func resourceCISdomainCreate(d *schema.ResourceData, meta interface{}) error {
 
 	opt := cisClient.NewCreateZoneOptions()
 	opt.SetName(zoneName)
-	result, resp, err := cisClient.CreateZone(opt)
+	result, resp, err := cisClient.CreateZoneWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("CreateZones Failed %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(flex.ConvertCisToTfTwoVar(*result.Result.ID, crn))
-	return resourceCISdomainRead(d, meta)
+	return resourceCISdomainRead(ctx, d, meta)
 }
 
-func resourceCISdomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISdomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	zoneID, crn, _ := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetZoneOptions(zoneID)
-	result, resp, err := cisClient.GetZone(opt)
+	result, resp, err := cisClient.GetZoneWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("[WARN] Error getting zone %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, result.Result.ID)
@@ -139,34 +141,34 @@ func resourceCISdomainExists(d *schema.ResourceData, meta interface{}) (bool, er
 	return true, nil
 }
 
-func resourceCISdomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceCISdomainRead(d, meta)
+func resourceCISdomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceCISdomainRead(ctx, d, meta)
 }
 
-func resourceCISdomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISdomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	zoneID, crn, _ := flex.ConvertTftoCisTwoVar(d.Id())
 	log.Println("resource delete :", d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetZoneOptions(zoneID)
-	_, resp, err := cisClient.GetZone(opt)
+	_, resp, err := cisClient.GetZoneWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("[WARN] Error getting zone %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	delOpt := cisClient.NewDeleteZoneOptions(zoneID)
-	_, resp, err = cisClient.DeleteZone(delOpt)
+	_, resp, err = cisClient.DeleteZoneWithContext(ctx, delOpt)
 	if err != nil {
 		log.Printf("[ERR] Error deleting zone %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
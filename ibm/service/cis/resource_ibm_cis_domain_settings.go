@@ -4,12 +4,14 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -446,11 +448,11 @@ func ResourceIBMCISSettings() *schema.Resource {
 			},
 		},
 
-		Create:   resourceCISSettingsUpdate,
-		Read:     resourceCISSettingsRead,
-		Update:   resourceCISSettingsUpdate,
-		Delete:   resourceCISSettingsDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISSettingsUpdate,
+		ReadContext:   resourceCISSettingsRead,
+		UpdateContext: resourceCISSettingsUpdate,
+		DeleteContext: resourceCISSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
@@ -750,10 +752,10 @@ var settingsList = []string{
 	cisDomainSettingsCipher,
 }
 
-func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cisID := d.Get(cisID).(string)
@@ -771,7 +773,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateZoneDnssecOptions()
 					opt.SetStatus(v.(string))
-					_, resp, err = cisClient.UpdateZoneDnssec(opt)
+					_, resp, err = cisClient.UpdateZoneDnssecWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsWAF:
@@ -779,7 +781,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateWebApplicationFirewallOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateWebApplicationFirewall(opt)
+					_, resp, err = cisClient.UpdateWebApplicationFirewallWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsSSL:
@@ -787,13 +789,13 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 					cisClient.Crn = core.StringPtr(cisID)
 					cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 					opt := cisClient.NewChangeSslSettingOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.ChangeSslSetting(opt)
+					_, resp, err = cisClient.ChangeSslSettingWithContext(ctx, opt)
 				}
 			}
 
@@ -802,7 +804,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateMinTlsVersionOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateMinTlsVersion(opt)
+					_, resp, err = cisClient.UpdateMinTlsVersionWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsBrotli:
@@ -810,7 +812,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateBrotliOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateBrotli(opt)
+					_, resp, err = cisClient.UpdateBrotliWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsCNAMEFlattening:
@@ -818,7 +820,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateZoneCnameFlatteningOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateZoneCnameFlattening(opt)
+					_, resp, err = cisClient.UpdateZoneCnameFlatteningWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsOpportunisticEncryption:
@@ -826,7 +828,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateOpportunisticEncryptionOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateOpportunisticEncryption(opt)
+					_, resp, err = cisClient.UpdateOpportunisticEncryptionWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsAutomaticHTPSRewrites:
@@ -834,7 +836,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateAutomaticHttpsRewritesOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateAutomaticHttpsRewrites(opt)
+					_, resp, err = cisClient.UpdateAutomaticHttpsRewritesWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsAlwaysUseHTTPS:
@@ -842,7 +844,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateAlwaysUseHttpsOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateAlwaysUseHttps(opt)
+					_, resp, err = cisClient.UpdateAlwaysUseHttpsWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsIPv6:
@@ -850,7 +852,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateIpv6Options()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateIpv6(opt)
+					_, resp, err = cisClient.UpdateIpv6WithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsBrowserCheck:
@@ -858,7 +860,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateBrowserCheckOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateBrowserCheck(opt)
+					_, resp, err = cisClient.UpdateBrowserCheckWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsHotlinkProtection:
@@ -866,7 +868,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateHotlinkProtectionOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateHotlinkProtection(opt)
+					_, resp, err = cisClient.UpdateHotlinkProtectionWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsHTTP2:
@@ -874,7 +876,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateHttp2Options()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateHttp2(opt)
+					_, resp, err = cisClient.UpdateHttp2WithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsImageLoadOptimization:
@@ -882,7 +884,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateImageLoadOptimizationOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateImageLoadOptimization(opt)
+					_, resp, err = cisClient.UpdateImageLoadOptimizationWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsImageSizeOptimization:
@@ -890,7 +892,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateImageSizeOptimizationOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateImageSizeOptimization(opt)
+					_, resp, err = cisClient.UpdateImageSizeOptimizationWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsIPGeoLocation:
@@ -898,7 +900,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateIpGeolocationOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateIpGeolocation(opt)
+					_, resp, err = cisClient.UpdateIpGeolocationWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsOriginErrorPagePassThru:
@@ -906,7 +908,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateEnableErrorPagesOnOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateEnableErrorPagesOn(opt)
+					_, resp, err = cisClient.UpdateEnableErrorPagesOnWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsPseudoIPv4:
@@ -914,7 +916,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdatePseudoIpv4Options()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdatePseudoIpv4(opt)
+					_, resp, err = cisClient.UpdatePseudoIpv4WithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsPrefetchPreload:
@@ -922,7 +924,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdatePrefetchPreloadOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdatePrefetchPreload(opt)
+					_, resp, err = cisClient.UpdatePrefetchPreloadWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsResponseBuffering:
@@ -930,7 +932,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateResponseBufferingOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateResponseBuffering(opt)
+					_, resp, err = cisClient.UpdateResponseBufferingWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsScriptLoadOptimisation:
@@ -938,7 +940,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateScriptLoadOptimizationOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateScriptLoadOptimization(opt)
+					_, resp, err = cisClient.UpdateScriptLoadOptimizationWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsServerSideExclude:
@@ -946,7 +948,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateServerSideExcludeOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateServerSideExclude(opt)
+					_, resp, err = cisClient.UpdateServerSideExcludeWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsTLSClientAuth:
@@ -954,7 +956,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateTlsClientAuthOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateTlsClientAuth(opt)
+					_, resp, err = cisClient.UpdateTlsClientAuthWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsTrueClientIPHeader:
@@ -962,7 +964,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateTrueClientIpOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateTrueClientIp(opt)
+					_, resp, err = cisClient.UpdateTrueClientIpWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsWebSockets:
@@ -970,7 +972,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateWebSocketsOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateWebSockets(opt)
+					_, resp, err = cisClient.UpdateWebSocketsWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsChallengeTTL:
@@ -978,7 +980,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateChallengeTtlOptions()
 					opt.SetValue(int64(v.(int)))
-					_, resp, err = cisClient.UpdateChallengeTTL(opt)
+					_, resp, err = cisClient.UpdateChallengeTTLWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsMaxUpload:
@@ -986,7 +988,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateMaxUploadOptions()
 					opt.SetValue(int64(v.(int)))
-					_, resp, err = cisClient.UpdateMaxUpload(opt)
+					_, resp, err = cisClient.UpdateMaxUploadWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsCipher:
@@ -995,7 +997,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					cipherValue := flex.ExpandStringList(v.(*schema.Set).List())
 					opt := cisClient.NewUpdateCiphersOptions()
 					opt.SetValue(cipherValue)
-					_, resp, err = cisClient.UpdateCiphers(opt)
+					_, resp, err = cisClient.UpdateCiphersWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsMinify:
@@ -1008,11 +1010,11 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					minifyVal, err := cisClient.NewMinifySettingValue(css, html, js)
 					if err != nil {
 						log.Println("Invalid minfiy setting values")
-						return err
+						return diag.FromErr(err)
 					}
 					opt := cisClient.NewUpdateMinifyOptions()
 					opt.SetValue(minifyVal)
-					_, resp, err = cisClient.UpdateMinify(opt)
+					_, resp, err = cisClient.UpdateMinifyWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsSecurityHeader:
@@ -1027,16 +1029,16 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 						enabled, maxAge, includeSubdomain, nosniff)
 					if err != nil {
 						log.Println("Invalid security header setting values")
-						return err
+						return diag.FromErr(err)
 					}
 					securityOpt, err := cisClient.NewSecurityHeaderSettingValue(securityVal)
 					if err != nil {
 						log.Println("Invalid security header setting options")
-						return err
+						return diag.FromErr(err)
 					}
 					opt := cisClient.NewUpdateSecurityHeaderOptions()
 					opt.SetValue(securityOpt)
-					_, resp, err = cisClient.UpdateSecurityHeader(opt)
+					_, resp, err = cisClient.UpdateSecurityHeaderWithContext(ctx, opt)
 				}
 			}
 		case cisDomainSettingsMobileRedirect:
@@ -1049,11 +1051,11 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					mobileOpt, err := cisClient.NewMobileRedirecSettingValue(status, mobileSubdomain, stripURI)
 					if err != nil {
 						log.Println("Invalid mobile redirect options")
-						return err
+						return diag.FromErr(err)
 					}
 					opt := cisClient.NewUpdateMobileRedirectOptions()
 					opt.SetValue(mobileOpt)
-					_, resp, err = cisClient.UpdateMobileRedirect(opt)
+					_, resp, err = cisClient.UpdateMobileRedirectWithContext(ctx, opt)
 				}
 			}
 		}
@@ -1063,17 +1065,17 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				continue
 			}
 			log.Printf("Update settings Failed on %s, %v\n", item, resp)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, cisID))
-	return resourceCISSettingsRead(d, meta)
+	return resourceCISSettingsRead(ctx, d, meta)
 }
 
-func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	zoneID, crn, _ := flex.ConvertTftoCisTwoVar(d.Id())
@@ -1086,7 +1088,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 		switch item {
 		case cisDomainSettingsDNSSEC:
 			opt := cisClient.NewGetZoneDnssecOptions()
-			result, resp, err := cisClient.GetZoneDnssec(opt)
+			result, resp, err := cisClient.GetZoneDnssecWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsDNSSEC, result.Result.Status)
 			}
@@ -1095,7 +1097,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsWAF:
 			opt := cisClient.NewGetWebApplicationFirewallOptions()
-			result, resp, err := cisClient.GetWebApplicationFirewall(opt)
+			result, resp, err := cisClient.GetWebApplicationFirewallWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsWAF, result.Result.Value)
 			}
@@ -1105,12 +1107,12 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 		case cisDomainSettingsSSL:
 			cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 			opt := cisClient.NewGetSslSettingOptions()
-			result, resp, err := cisClient.GetSslSetting(opt)
+			result, resp, err := cisClient.GetSslSettingWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsSSL, result.Result.Value)
 			}
@@ -1119,7 +1121,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsBrotli:
 			opt := cisClient.NewGetBrotliOptions()
-			result, resp, err := cisClient.GetBrotli(opt)
+			result, resp, err := cisClient.GetBrotliWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsBrotli, result.Result.Value)
 			}
@@ -1127,7 +1129,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsMinTLSVersion:
 			opt := cisClient.NewGetMinTlsVersionOptions()
-			result, resp, err := cisClient.GetMinTlsVersion(opt)
+			result, resp, err := cisClient.GetMinTlsVersionWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsMinTLSVersion, result.Result.Value)
 			}
@@ -1136,7 +1138,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsCNAMEFlattening:
 			opt := cisClient.NewGetZoneCnameFlatteningOptions()
-			result, resp, err := cisClient.GetZoneCnameFlattening(opt)
+			result, resp, err := cisClient.GetZoneCnameFlatteningWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsCNAMEFlattening, result.Result.Value)
 			}
@@ -1145,7 +1147,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsOpportunisticEncryption:
 			opt := cisClient.NewGetOpportunisticEncryptionOptions()
-			result, resp, err := cisClient.GetOpportunisticEncryption(opt)
+			result, resp, err := cisClient.GetOpportunisticEncryptionWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsOpportunisticEncryption, result.Result.Value)
 			}
@@ -1154,7 +1156,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsAutomaticHTPSRewrites:
 			opt := cisClient.NewGetAutomaticHttpsRewritesOptions()
-			result, resp, err := cisClient.GetAutomaticHttpsRewrites(opt)
+			result, resp, err := cisClient.GetAutomaticHttpsRewritesWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsAutomaticHTPSRewrites, result.Result.Value)
 			}
@@ -1163,7 +1165,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsAlwaysUseHTTPS:
 			opt := cisClient.NewGetAlwaysUseHttpsOptions()
-			result, resp, err := cisClient.GetAlwaysUseHttps(opt)
+			result, resp, err := cisClient.GetAlwaysUseHttpsWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsAlwaysUseHTTPS, result.Result.Value)
 			}
@@ -1172,7 +1174,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsIPv6:
 			opt := cisClient.NewGetIpv6Options()
-			result, resp, err := cisClient.GetIpv6(opt)
+			result, resp, err := cisClient.GetIpv6WithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsIPv6, result.Result.Value)
 			}
@@ -1181,7 +1183,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsBrowserCheck:
 			opt := cisClient.NewGetBrowserCheckOptions()
-			result, resp, err := cisClient.GetBrowserCheck(opt)
+			result, resp, err := cisClient.GetBrowserCheckWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsBrowserCheck, result.Result.Value)
 			}
@@ -1190,7 +1192,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsHotlinkProtection:
 			opt := cisClient.NewGetHotlinkProtectionOptions()
-			result, resp, err := cisClient.GetHotlinkProtection(opt)
+			result, resp, err := cisClient.GetHotlinkProtectionWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsHotlinkProtection, result.Result.Value)
 			}
@@ -1199,7 +1201,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsHTTP2:
 			opt := cisClient.NewGetHttp2Options()
-			result, resp, err := cisClient.GetHttp2(opt)
+			result, resp, err := cisClient.GetHttp2WithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsHTTP2, result.Result.Value)
 			}
@@ -1208,7 +1210,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsImageLoadOptimization:
 			opt := cisClient.NewGetImageLoadOptimizationOptions()
-			result, resp, err := cisClient.GetImageLoadOptimization(opt)
+			result, resp, err := cisClient.GetImageLoadOptimizationWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsImageLoadOptimization, result.Result.Value)
 			}
@@ -1217,7 +1219,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsImageSizeOptimization:
 			opt := cisClient.NewGetImageSizeOptimizationOptions()
-			result, resp, err := cisClient.GetImageSizeOptimization(opt)
+			result, resp, err := cisClient.GetImageSizeOptimizationWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsImageSizeOptimization, result.Result.Value)
 			}
@@ -1226,7 +1228,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsIPGeoLocation:
 			opt := cisClient.NewGetIpGeolocationOptions()
-			result, resp, err := cisClient.GetIpGeolocation(opt)
+			result, resp, err := cisClient.GetIpGeolocationWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsIPGeoLocation, result.Result.Value)
 			}
@@ -1235,7 +1237,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsOriginErrorPagePassThru:
 			opt := cisClient.NewGetEnableErrorPagesOnOptions()
-			result, resp, err := cisClient.GetEnableErrorPagesOn(opt)
+			result, resp, err := cisClient.GetEnableErrorPagesOnWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsOriginErrorPagePassThru, result.Result.Value)
 			}
@@ -1244,7 +1246,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsPseudoIPv4:
 			opt := cisClient.NewGetPseudoIpv4Options()
-			result, resp, err := cisClient.GetPseudoIpv4(opt)
+			result, resp, err := cisClient.GetPseudoIpv4WithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsPseudoIPv4, result.Result.Value)
 			}
@@ -1253,7 +1255,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsPrefetchPreload:
 			opt := cisClient.NewGetPrefetchPreloadOptions()
-			result, resp, err := cisClient.GetPrefetchPreload(opt)
+			result, resp, err := cisClient.GetPrefetchPreloadWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsPrefetchPreload, result.Result.Value)
 			}
@@ -1262,7 +1264,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsResponseBuffering:
 			opt := cisClient.NewGetResponseBufferingOptions()
-			result, resp, err := cisClient.GetResponseBuffering(opt)
+			result, resp, err := cisClient.GetResponseBufferingWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsResponseBuffering, result.Result.Value)
 			}
@@ -1271,7 +1273,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsScriptLoadOptimisation:
 			opt := cisClient.NewGetScriptLoadOptimizationOptions()
-			result, resp, err := cisClient.GetScriptLoadOptimization(opt)
+			result, resp, err := cisClient.GetScriptLoadOptimizationWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsScriptLoadOptimisation, result.Result.Value)
 			}
@@ -1280,7 +1282,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsServerSideExclude:
 			opt := cisClient.NewGetServerSideExcludeOptions()
-			result, resp, err := cisClient.GetServerSideExclude(opt)
+			result, resp, err := cisClient.GetServerSideExcludeWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsServerSideExclude, result.Result.Value)
 			}
@@ -1289,7 +1291,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsTLSClientAuth:
 			opt := cisClient.NewGetTlsClientAuthOptions()
-			result, resp, err := cisClient.GetTlsClientAuth(opt)
+			result, resp, err := cisClient.GetTlsClientAuthWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsTLSClientAuth, result.Result.Value)
 			}
@@ -1298,7 +1300,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsTrueClientIPHeader:
 			opt := cisClient.NewGetTrueClientIpOptions()
-			result, resp, err := cisClient.GetTrueClientIp(opt)
+			result, resp, err := cisClient.GetTrueClientIpWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsTrueClientIPHeader, result.Result.Value)
 			}
@@ -1307,7 +1309,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsWebSockets:
 			opt := cisClient.NewGetWebSocketsOptions()
-			result, resp, err := cisClient.GetWebSockets(opt)
+			result, resp, err := cisClient.GetWebSocketsWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsWebSockets, result.Result.Value)
 			}
@@ -1316,7 +1318,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsChallengeTTL:
 			opt := cisClient.NewGetChallengeTtlOptions()
-			result, resp, err := cisClient.GetChallengeTTL(opt)
+			result, resp, err := cisClient.GetChallengeTTLWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsChallengeTTL, result.Result.Value)
 			}
@@ -1325,7 +1327,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsMaxUpload:
 			opt := cisClient.NewGetMaxUploadOptions()
-			result, resp, err := cisClient.GetMaxUpload(opt)
+			result, resp, err := cisClient.GetMaxUploadWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsMaxUpload, result.Result.Value)
 			}
@@ -1334,7 +1336,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsCipher:
 			opt := cisClient.NewGetCiphersOptions()
-			result, resp, err := cisClient.GetCiphers(opt)
+			result, resp, err := cisClient.GetCiphersWithContext(ctx, opt)
 			if err == nil {
 				d.Set(cisDomainSettingsCipher, result.Result.Value)
 			}
@@ -1343,7 +1345,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsMinify:
 			opt := cisClient.NewGetMinifyOptions()
-			result, resp, err := cisClient.GetMinify(opt)
+			result, resp, err := cisClient.GetMinifyWithContext(ctx, opt)
 			if err == nil {
 				minify := result.Result.Value
 				value := map[string]string{
@@ -1358,7 +1360,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsSecurityHeader:
 			opt := cisClient.NewGetSecurityHeaderOptions()
-			result, resp, err := cisClient.GetSecurityHeader(opt)
+			result, resp, err := cisClient.GetSecurityHeaderWithContext(ctx, opt)
 			if err == nil {
 
 				if result.Result.Value != nil && result.Result.Value.StrictTransportSecurity != nil {
@@ -1385,7 +1387,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsMobileRedirect:
 			opt := cisClient.NewGetMobileRedirectOptions()
-			result, resp, err := cisClient.GetMobileRedirect(opt)
+			result, resp, err := cisClient.GetMobileRedirectWithContext(ctx, opt)
 			if err == nil {
 				if result.Result.Value != nil {
 
@@ -1414,7 +1416,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 				continue
 			}
 			log.Printf("Get settings failed on %s, %v\n", item, settingErr)
-			return settingErr
+			return diag.FromErr(settingErr)
 		}
 	}
 	d.Set(cisID, crn)
@@ -1422,7 +1424,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
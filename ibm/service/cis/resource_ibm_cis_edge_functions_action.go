@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,12 +25,12 @@ const (
 
 func ResourceIBMCISEdgeFunctionsAction() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISEdgeFunctionsActionCreate,
-		Read:     ResourceIBMCISEdgeFunctionsActionRead,
-		Update:   ResourceIBMCISEdgeFunctionsActionUpdate,
-		Delete:   ResourceIBMCISEdgeFunctionsActionDelete,
-		Exists:   ResourceIBMCISEdgeFunctionsActionExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISEdgeFunctionsActionCreate,
+		ReadContext:   ResourceIBMCISEdgeFunctionsActionRead,
+		UpdateContext: ResourceIBMCISEdgeFunctionsActionUpdate,
+		DeleteContext: ResourceIBMCISEdgeFunctionsActionDelete,
+		Exists:        ResourceIBMCISEdgeFunctionsActionExists,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -56,10 +58,10 @@ func ResourceIBMCISEdgeFunctionsAction() *schema.Resource {
 	}
 }
 
-func ResourceIBMCISEdgeFunctionsActionCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISEdgeFunctionsActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -73,26 +75,26 @@ func ResourceIBMCISEdgeFunctionsActionCreate(d *schema.ResourceData, meta interf
 	opt := cisClient.NewUpdateEdgeFunctionsActionOptions(scriptName)
 	opt.SetEdgeFunctionsAction(r)
 
-	_, _, err = cisClient.UpdateEdgeFunctionsAction(opt)
+	_, _, err = cisClient.UpdateEdgeFunctionsActionWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error: %v", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error: %v", err))
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(scriptName, zoneID, crn))
-	return ResourceIBMCISEdgeFunctionsActionRead(d, meta)
+	return ResourceIBMCISEdgeFunctionsActionRead(ctx, d, meta)
 }
 
-func ResourceIBMCISEdgeFunctionsActionUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISEdgeFunctionsActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange(cisEdgeFunctionsActionScript) {
-		return ResourceIBMCISEdgeFunctionsActionCreate(d, meta)
+		return ResourceIBMCISEdgeFunctionsActionCreate(ctx, d, meta)
 	}
 
-	return ResourceIBMCISEdgeFunctionsActionRead(d, meta)
+	return ResourceIBMCISEdgeFunctionsActionRead(ctx, d, meta)
 }
 
-func ResourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISEdgeFunctionsActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	scriptName, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
@@ -100,9 +102,9 @@ func ResourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interfac
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetEdgeFunctionsActionOptions(scriptName)
-	result, resp, err := cisClient.GetEdgeFunctionsAction(opt)
+	result, resp, err := cisClient.GetEdgeFunctionsActionWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error: %v", resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error: %v", resp))
 	}
 
 	// read script content
@@ -117,7 +119,7 @@ func ResourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interfac
 	}
 	err = result.Close()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in closing reader")
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in closing reader"))
 	}
 
 	d.Set(cisID, crn)
@@ -149,10 +151,10 @@ func ResourceIBMCISEdgeFunctionsActionExists(d *schema.ResourceData, meta interf
 	return true, nil
 }
 
-func ResourceIBMCISEdgeFunctionsActionDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISEdgeFunctionsActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in creating CIS object")
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in creating CIS object"))
 	}
 
 	scriptName, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
@@ -160,9 +162,9 @@ func ResourceIBMCISEdgeFunctionsActionDelete(d *schema.ResourceData, meta interf
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewDeleteEdgeFunctionsActionOptions(scriptName)
-	_, response, err := cisClient.DeleteEdgeFunctionsAction(opt)
+	_, response, err := cisClient.DeleteEdgeFunctionsActionWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in edge function action script deletion: %v", response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in edge function action script deletion: %v", response))
 	}
 	return nil
 }
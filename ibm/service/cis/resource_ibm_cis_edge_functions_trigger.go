@@ -4,12 +4,14 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,12 +24,12 @@ const (
 
 func ResourceIBMCISEdgeFunctionsTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISEdgeFunctionsTriggerCreate,
-		Read:     ResourceIBMCISEdgeFunctionsTriggerRead,
-		Update:   ResourceIBMCISEdgeFunctionsTriggerUpdate,
-		Delete:   ResourceIBMCISEdgeFunctionsTriggerDelete,
-		Exists:   ResourceIBMCISEdgeFunctionsTriggerExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISEdgeFunctionsTriggerCreate,
+		ReadContext:   ResourceIBMCISEdgeFunctionsTriggerRead,
+		UpdateContext: ResourceIBMCISEdgeFunctionsTriggerUpdate,
+		DeleteContext: ResourceIBMCISEdgeFunctionsTriggerDelete,
+		Exists:        ResourceIBMCISEdgeFunctionsTriggerExists,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -64,10 +66,10 @@ func ResourceIBMCISEdgeFunctionsTrigger() *schema.Resource {
 	}
 }
 
-func ResourceIBMCISEdgeFunctionsTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISEdgeFunctionsTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -81,18 +83,18 @@ func ResourceIBMCISEdgeFunctionsTriggerCreate(d *schema.ResourceData, meta inter
 	pattern := d.Get(cisEdgeFunctionsTriggerPattern).(string)
 	opt.SetPattern(pattern)
 
-	result, _, err := cisClient.CreateEdgeFunctionsTrigger(opt)
+	result, _, err := cisClient.CreateEdgeFunctionsTriggerWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating edge function trigger route : %v", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating edge function trigger route : %v", err))
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return ResourceIBMCISEdgeFunctionsTriggerRead(d, meta)
+	return ResourceIBMCISEdgeFunctionsTriggerRead(ctx, d, meta)
 }
 
-func ResourceIBMCISEdgeFunctionsTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISEdgeFunctionsTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routeID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
@@ -109,18 +111,18 @@ func ResourceIBMCISEdgeFunctionsTriggerUpdate(d *schema.ResourceData, meta inter
 		pattern := d.Get(cisEdgeFunctionsTriggerPattern).(string)
 		opt.SetPattern(pattern)
 
-		_, _, err := cisClient.UpdateEdgeFunctionsTrigger(opt)
+		_, _, err := cisClient.UpdateEdgeFunctionsTriggerWithContext(ctx, opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating edge function trigger route : %v", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating edge function trigger route : %v", err))
 		}
 	}
-	return ResourceIBMCISEdgeFunctionsTriggerRead(d, meta)
+	return ResourceIBMCISEdgeFunctionsTriggerRead(ctx, d, meta)
 }
 
-func ResourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISEdgeFunctionsTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routeID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
@@ -128,9 +130,9 @@ func ResourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta interfa
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetEdgeFunctionsTriggerOptions(routeID)
-	result, resp, err := cisClient.GetEdgeFunctionsTrigger(opt)
+	result, resp, err := cisClient.GetEdgeFunctionsTriggerWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error: %v", resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error: %v", resp))
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -163,10 +165,10 @@ func ResourceIBMCISEdgeFunctionsTriggerExists(d *schema.ResourceData, meta inter
 	return true, nil
 }
 
-func ResourceIBMCISEdgeFunctionsTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISEdgeFunctionsTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in creating CIS object")
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in creating CIS object"))
 	}
 
 	routeID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
@@ -174,9 +176,9 @@ func ResourceIBMCISEdgeFunctionsTriggerDelete(d *schema.ResourceData, meta inter
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewDeleteEdgeFunctionsTriggerOptions(routeID)
-	_, response, err := cisClient.DeleteEdgeFunctionsTrigger(opt)
+	_, response, err := cisClient.DeleteEdgeFunctionsTriggerWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in edge function trigger route deletion: %v", response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in edge function trigger route deletion: %v", response))
 	}
 	return nil
 }
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/filtersv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,11 +26,11 @@ const (
 
 func ResourceIBMCISFilter() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISFilterCreate,
-		Read:     ResourceIBMCISFilterRead,
-		Update:   ResourceIBMCISFilterUpdate,
-		Delete:   ResourceIBMCISFilterDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISFilterCreate,
+		ReadContext:   ResourceIBMCISFilterRead,
+		UpdateContext: ResourceIBMCISFilterUpdate,
+		DeleteContext: ResourceIBMCISFilterDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -65,16 +67,16 @@ func ResourceIBMCISFilter() *schema.Resource {
 		},
 	}
 }
-func ResourceIBMCISFilterCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while Getting IAM Access Token using BluemixSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while Getting IAM Access Token using BluemixSession %s", err))
 	}
 	xAuthtoken := sess.Config.IAMAccessToken
 
 	cisClient, err := meta.(conns.ClientSession).CisFiltersSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the CisFiltersSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisFiltersSession %s", err))
 	}
 
 	crn := d.Get(cisID).(string)
@@ -99,39 +101,39 @@ func ResourceIBMCISFilterCreate(d *schema.ResourceData, meta interface{}) error
 
 	opt.SetFilterInput([]filtersv1.FilterInput{newfilter})
 
-	result, resp, err := cisClient.CreateFilter(opt)
+	result, resp, err := cisClient.CreateFilterWithContext(ctx, opt)
 	if err != nil || result == nil {
-		return fmt.Errorf("[ERROR] Error creating Filter for zone %q: %s %s", zoneID, err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Filter for zone %q: %s %s", zoneID, err, resp))
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result[0].ID, zoneID, crn))
-	return ResourceIBMCISFilterRead(d, meta)
+	return ResourceIBMCISFilterRead(ctx, d, meta)
 
 }
-func ResourceIBMCISFilterRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while Getting IAM Access Token using BluemixSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while Getting IAM Access Token using BluemixSession %s", err))
 	}
 	xAuthtoken := sess.Config.IAMAccessToken
 
 	cisClient, err := meta.(conns.ClientSession).CisFiltersSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the CisFiltersSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisFiltersSession %s", err))
 	}
 	filterid, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	opt := cisClient.NewGetFilterOptions(xAuthtoken, crn, zoneID, filterid)
 
-	result, response, err := cisClient.GetFilter(opt)
+	result, response, err := cisClient.GetFilterWithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("Error GetFilter not found ")
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error finding GetFilter %q: %s %s", d.Id(), err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error finding GetFilter %q: %s %s", d.Id(), err, response))
 	}
 	if result.Result != nil {
 		d.Set(cisID, crn)
@@ -143,21 +145,21 @@ func ResourceIBMCISFilterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	return nil
 }
-func ResourceIBMCISFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while Getting IAM Access Token using BluemixSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while Getting IAM Access Token using BluemixSession %s", err))
 	}
 	xAuthtoken := sess.Config.IAMAccessToken
 
 	cisClient, err := meta.(conns.ClientSession).CisFiltersSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the CisFiltersSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisFiltersSession %s", err))
 	}
 
 	filterid, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange(cisFilterExpression) ||
@@ -184,35 +186,35 @@ func ResourceIBMCISFilterUpdate(d *schema.ResourceData, meta interface{}) error
 
 		opt.SetFilterUpdateInput([]filtersv1.FilterUpdateInput{updatefilter})
 
-		result, resp, err := cisClient.UpdateFilters(opt)
+		result, resp, err := cisClient.UpdateFiltersWithContext(ctx, opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Filter for zone %q: %s %s", zoneID, err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Filter for zone %q: %s %s", zoneID, err, resp))
 		}
 
 		if *result.Result[0].ID == "" {
-			return fmt.Errorf("[ERROR] Error failed to find id in Update response; resource was empty")
+			return diag.FromErr(fmt.Errorf("[ERROR] Error failed to find id in Update response; resource was empty"))
 		}
 	}
-	return ResourceIBMCISFilterRead(d, meta)
+	return ResourceIBMCISFilterRead(ctx, d, meta)
 }
-func ResourceIBMCISFilterDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	xAuthtoken := sess.Config.IAMAccessToken
 	cisClient, err := meta.(conns.ClientSession).CisFiltersSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	filterid, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	opt := cisClient.NewDeleteFiltersOptions(xAuthtoken, crn, zoneID, filterid)
-	_, _, err = cisClient.DeleteFilters(opt)
+	_, _, err = cisClient.DeleteFiltersWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Filter: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Filter: %s", err))
 	}
 
 	return nil
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	cislockdownv1 "github.com/IBM/networking-go-sdk/zonelockdownv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -60,12 +62,12 @@ const (
 
 func ResourceIBMCISFirewallRecord() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISFirewallRecordCreate,
-		Read:     ResourceIBMCISFirewallRecordRead,
-		Update:   ResourceIBMCISFirewallRecordUpdate,
-		Delete:   ResourceIBMCISFirewallRecordDelete,
-		Exists:   ResourceIBMCISFirewallRecordExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISFirewallRecordCreate,
+		ReadContext:   ResourceIBMCISFirewallRecordRead,
+		UpdateContext: ResourceIBMCISFirewallRecordUpdate,
+		DeleteContext: ResourceIBMCISFirewallRecordDelete,
+		Exists:        ResourceIBMCISFirewallRecordExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -315,7 +317,7 @@ func ResourceIBMCISFirewallValidator() *validate.ResourceValidator {
 	return &cisFirewallValidator
 }
 
-func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISFirewallRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
 	firewallType := d.Get(cisFirewallType).(string)
@@ -325,7 +327,7 @@ func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 
 		cisClient, err := meta.(conns.ClientSession).CisLockdownClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		lockdown := d.Get(cisFirewallLockdown).([]interface{})[0].(map[string]interface{})
 
@@ -343,17 +345,17 @@ func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		configurations, err := expandLockdownsTypeConfiguration(
 			lockdown[cisFirewallLockdownConfigurations].([]interface{}))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		opt.SetUrls(urls)
 		opt.SetConfigurations(configurations)
 
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-		result, response, err := cisClient.CreateZoneLockdownRule(opt)
+		result, response, err := cisClient.CreateZoneLockdownRuleWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Create zone firewall lockdown failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(flex.ConvertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 
@@ -361,7 +363,7 @@ func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 
 		cisClient, err := meta.(conns.ClientSession).CisAccessRuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -378,7 +380,7 @@ func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		configOpt, err := cisClient.NewZoneAccessRuleInputConfiguration(target, value)
 		if err != nil {
 			log.Printf("Error in firewall type %s input: %s", firewallType, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		opt := cisClient.NewCreateZoneAccessRuleOptions()
@@ -388,17 +390,17 @@ func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 			opt.SetNotes(v.(string))
 		}
 
-		result, response, err := cisClient.CreateZoneAccessRule(opt)
+		result, response, err := cisClient.CreateZoneAccessRuleWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Create zone firewall access rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(flex.ConvertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 
 	} else if firewallType == cisFirewallTypeUARules {
 		cisClient, err := meta.(conns.ClientSession).CisUARuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -407,7 +409,7 @@ func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		mode := uaRule[cisFirewallUARuleMode].(string)
 		configList := uaRule[cisFirewallUARuleConfiguration].([]interface{})
 		if len(configList) > 1 {
-			return fmt.Errorf("[ERROR] Only one configuration is allowed for %s type", firewallType)
+			return diag.FromErr(fmt.Errorf("[ERROR] Only one configuration is allowed for %s type", firewallType))
 		}
 		config := configList[0].(map[string]interface{})
 		target := config[cisFirewallLockdownConfigurationsTarget].(string)
@@ -416,7 +418,7 @@ func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		configOpt, err := cisClient.NewUseragentRuleInputConfiguration(target, value)
 		if err != nil {
 			log.Printf("Error in firewall type %s input: %s", firewallType, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		opt := cisClient.NewCreateZoneUserAgentRuleOptions()
@@ -430,25 +432,25 @@ func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		v := uaRule[cisFirewallUARulePaused]
 		opt.SetPaused(v.(bool))
 
-		result, response, err := cisClient.CreateZoneUserAgentRule(opt)
+		result, response, err := cisClient.CreateZoneUserAgentRuleWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Create zone user agent rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(flex.ConvertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 	}
 
-	return ResourceIBMCISFirewallRecordRead(d, meta)
+	return ResourceIBMCISFirewallRecordRead(ctx, d, meta)
 }
 
-func ResourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISFirewallRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	firewallType, lockdownID, zoneID, crn, _ := flex.ConvertTfToCisFourVar(d.Id())
 
 	if firewallType == cisFirewallTypeLockdowns {
 		// Firewall Type : Lockdowns
 		cisClient, err := meta.(conns.ClientSession).CisLockdownClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		cisClient.Crn = core.StringPtr(crn)
@@ -456,10 +458,10 @@ func ResourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 
 		opt := cisClient.NewGetLockdownOptions(lockdownID)
 
-		result, response, err := cisClient.GetLockdown(opt)
+		result, response, err := cisClient.GetLockdownWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		lockdownList := []interface{}{}
 		lockdown := map[string]interface{}{}
@@ -482,17 +484,17 @@ func ResourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		// Firewall Type : Zone Access firewall rules
 		cisClient, err := meta.(conns.ClientSession).CisAccessRuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 		opt := cisClient.NewGetZoneAccessRuleOptions(lockdownID)
 
-		result, response, err := cisClient.GetZoneAccessRule(opt)
+		result, response, err := cisClient.GetZoneAccessRuleWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 
 		config := map[string]interface{}{}
@@ -514,16 +516,16 @@ func ResourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		// Firewall Type: User Agent access rules
 		cisClient, err := meta.(conns.ClientSession).CisUARuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 		opt := cisClient.NewGetUserAgentRuleOptions(lockdownID)
-		result, response, err := cisClient.GetUserAgentRule(opt)
+		result, response, err := cisClient.GetUserAgentRuleWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Get zone user agent rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 
 		config := map[string]interface{}{}
@@ -550,7 +552,7 @@ func ResourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func ResourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISFirewallRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	firewallType, lockdownID, zoneID, crn, _ := flex.ConvertTfToCisFourVar(d.Id())
 
@@ -564,7 +566,7 @@ func ResourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 
 			cisClient, err := meta.(conns.ClientSession).CisLockdownClientSession()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			opt := cisClient.NewUpdateLockdownRuleOptions(lockdownID)
@@ -580,17 +582,17 @@ func ResourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			urls := flex.ExpandStringList(lockdown[cisFirewallLockdownURLs].([]interface{}))
 			configurations, err := expandLockdownsTypeConfiguration(lockdown[cisFirewallLockdownConfigurations].([]interface{}))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			opt.SetUrls(urls)
 			opt.SetConfigurations(configurations)
 
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-			_, response, err := cisClient.UpdateLockdownRule(opt)
+			_, response, err := cisClient.UpdateLockdownRuleWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Update zone firewall lockdown failed: %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 
 		} else if firewallType == cisFirewallTypeAccessRules {
@@ -600,7 +602,7 @@ func ResourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			// Firewall Type : Zone Access firewall rules
 			cisClient, err := meta.(conns.ClientSession).CisAccessRuleClientSession()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -612,10 +614,10 @@ func ResourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			}
 			opt.SetMode(mode)
 
-			_, response, err := cisClient.UpdateZoneAccessRule(opt)
+			_, response, err := cisClient.UpdateZoneAccessRuleWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Update zone firewall access rule failed: %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 
 		} else if firewallType == cisFirewallTypeUARules {
@@ -623,7 +625,7 @@ func ResourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			uaRule := d.Get(cisFirewallUARule).([]interface{})[0].(map[string]interface{})
 			cisClient, err := meta.(conns.ClientSession).CisUARuleClientSession()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -636,7 +638,7 @@ func ResourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			configOpt, err := cisClient.NewUseragentRuleInputConfiguration(target, value)
 			if err != nil {
 				log.Printf("Error in firewall type %s input: %s", firewallType, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 			opt := cisClient.NewUpdateUserAgentRuleOptions(lockdownID)
@@ -650,25 +652,25 @@ func ResourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			v := uaRule[cisFirewallUARulePaused]
 			opt.SetPaused(v.(bool))
 
-			_, response, err := cisClient.UpdateUserAgentRule(opt)
+			_, response, err := cisClient.UpdateUserAgentRuleWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Update zone user agent rule failed: %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 	}
-	return ResourceIBMCISFirewallRecordRead(d, meta)
+	return ResourceIBMCISFirewallRecordRead(ctx, d, meta)
 }
 
-func ResourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISFirewallRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	firewallType, lockdownID, zoneID, crn, _ := flex.ConvertTfToCisFourVar(d.Id())
 
 	if firewallType == cisFirewallTypeLockdowns {
 		// Firewall Type : Lockdowns
 		cisClient, err := meta.(conns.ClientSession).CisLockdownClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		cisClient.Crn = core.StringPtr(crn)
@@ -676,10 +678,10 @@ func ResourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 
 		opt := cisClient.NewDeleteZoneLockdownRuleOptions(lockdownID)
 
-		_, response, err := cisClient.DeleteZoneLockdownRule(opt)
+		_, response, err := cisClient.DeleteZoneLockdownRuleWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Delete zone firewall lockdown failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else if firewallType == cisFirewallTypeAccessRules {
@@ -687,33 +689,33 @@ func ResourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		// Firewall Type : Zone Access firewall rules
 		cisClient, err := meta.(conns.ClientSession).CisAccessRuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 		opt := cisClient.NewDeleteZoneAccessRuleOptions(lockdownID)
 
-		_, response, err := cisClient.DeleteZoneAccessRule(opt)
+		_, response, err := cisClient.DeleteZoneAccessRuleWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Delete zone firewall access rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else if firewallType == cisFirewallTypeUARules {
 		// Firewall Type: User Agent access rules
 		cisClient, err := meta.(conns.ClientSession).CisUARuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 		opt := cisClient.NewDeleteZoneUserAgentRuleOptions(lockdownID)
-		_, response, err := cisClient.DeleteZoneUserAgentRule(opt)
+		_, response, err := cisClient.DeleteZoneUserAgentRuleWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Delete zone user agent rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -166,19 +168,19 @@ func ResourceIBMCISGlb() *schema.Resource {
 			},
 		},
 
-		Create:   resourceCISGlbCreate,
-		Read:     resourceCISGlbRead,
-		Update:   resourceCISGlbUpdate,
-		Exists:   resourceCISGlbExists,
-		Delete:   resourceCISGlbDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISGlbCreate,
+		ReadContext:   resourceCISGlbRead,
+		UpdateContext: resourceCISGlbUpdate,
+		Exists:        resourceCISGlbExists,
+		DeleteContext: resourceCISGlbDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
-func resourceCISGlbCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -208,37 +210,37 @@ func resourceCISGlbCreate(d *schema.ResourceData, meta interface{}) error {
 	if regionPools, ok := d.GetOk(cisGLBRegionPools); ok {
 		expandedRegionPools, err := expandGeoPools(regionPools, cisGLBRegionPoolsRegion)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		opt.SetRegionPools(expandedRegionPools)
 	}
 	if popPools, ok := d.GetOk(cisGLBPopPools); ok {
 		expandedPopPools, err := expandGeoPools(popPools, cisGLBPopPoolsPop)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		opt.SetPopPools(expandedPopPools)
 	}
 
-	result, resp, err := cisClient.CreateLoadBalancer(opt)
+	result, resp, err := cisClient.CreateLoadBalancerWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Create GLB failed %s\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceCISGlbUpdate(d, meta)
+	return resourceCISGlbUpdate(ctx, d, meta)
 }
 
-func resourceCISGlbRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Extract CIS Ids from TF Id
 	glbID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cisClient.Crn = core.StringPtr(crn)
@@ -246,10 +248,10 @@ func resourceCISGlbRead(d *schema.ResourceData, meta interface{}) error {
 
 	opt := cisClient.NewGetLoadBalancerSettingsOptions(glbID)
 
-	result, resp, err := cisClient.GetLoadBalancerSettings(opt)
+	result, resp, err := cisClient.GetLoadBalancerSettingsWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("[WARN] GLB Read failed: %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	glbObj := result.Result
 	d.Set(cisID, crn)
@@ -274,15 +276,15 @@ func resourceCISGlbRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISGlbUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// Extract CIS Ids from TF Id
 	glbID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -320,46 +322,46 @@ func resourceCISGlbUpdate(d *schema.ResourceData, meta interface{}) error {
 		if regionPools, ok := d.GetOk(cisGLBRegionPools); ok {
 			expandedRegionPools, err := expandGeoPools(regionPools, cisGLBRegionPoolsRegion)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			opt.SetRegionPools(expandedRegionPools)
 		}
 		if popPools, ok := d.GetOk(cisGLBPopPools); ok {
 			expandedPopPools, err := expandGeoPools(popPools, cisGLBPopPoolsPop)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			opt.SetPopPools(expandedPopPools)
 		}
 
-		_, resp, err := cisClient.EditLoadBalancer(opt)
+		_, resp, err := cisClient.EditLoadBalancerWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("[WARN] Error updating GLB %v\n", resp)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceCISGlbRead(d, meta)
+	return resourceCISGlbRead(ctx, d, meta)
 }
 
-func resourceCISGlbDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// Extract CIS Ids from TF Id
 	glbID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewDeleteLoadBalancerOptions(glbID)
 
-	result, resp, err := cisClient.DeleteLoadBalancer(opt)
+	result, resp, err := cisClient.DeleteLoadBalancerWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("[WARN] Error deleting GLB %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("Deletion successful : %s", *result.Result.ID)
 	return nil
@@ -4,12 +4,14 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -38,12 +40,12 @@ const (
 func ResourceIBMCISHealthCheck() *schema.Resource {
 	return &schema.Resource{
 
-		Create:   resourceCISHealthCheckCreate,
-		Read:     resourceCISHealthCheckRead,
-		Update:   resourceCISHealthCheckUpdate,
-		Delete:   resourceCISHealthCheckDelete,
-		Exists:   resourceCISHealthCheckExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISHealthCheckCreate,
+		ReadContext:   resourceCISHealthCheckRead,
+		UpdateContext: resourceCISHealthCheckUpdate,
+		DeleteContext: resourceCISHealthCheckDelete,
+		Exists:        resourceCISHealthCheckExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -221,10 +223,10 @@ func ResourceIBMCISHealthCheckValidator() *validate.ResourceValidator {
 	return &cisHealthCheckValidator
 }
 
-func resourceCISHealthCheckCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -272,34 +274,34 @@ func resourceCISHealthCheckCreate(d *schema.ResourceData, meta interface{}) erro
 		opt.SetHeader(expandLoadBalancerMonitorHeader(header))
 	}
 
-	result, resp, err := sess.CreateLoadBalancerMonitor(opt)
+	result, resp, err := sess.CreateLoadBalancerMonitorWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("create global load balancer health check failed %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("global load balancer created successfully : %s", *result.Result.ID)
 	d.SetId(flex.ConvertCisToTfTwoVar(*result.Result.ID, crn))
-	return resourceCISHealthCheckRead(d, meta)
+	return resourceCISHealthCheckRead(ctx, d, meta)
 }
 
-func resourceCISHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	monitorID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 
 	opt := sess.NewGetLoadBalancerMonitorOptions(monitorID)
 
-	result, resp, err := sess.GetLoadBalancerMonitor(opt)
+	result, resp, err := sess.GetLoadBalancerMonitorWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Error reading global load balancer health check detail: %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisGLBHealthCheckID, result.Result.ID)
 	d.Set(cisID, crn)
@@ -324,15 +326,15 @@ func resourceCISHealthCheckRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceCISHealthCheckUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	monitorID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 
@@ -389,35 +391,35 @@ func resourceCISHealthCheckUpdate(d *schema.ResourceData, meta interface{}) erro
 		if header, ok := d.GetOk(cisGLBHealthCheckHeaders); ok {
 			opt.SetHeader(expandLoadBalancerMonitorHeader(header))
 		}
-		result, resp, err := sess.EditLoadBalancerMonitor(opt)
+		result, resp, err := sess.EditLoadBalancerMonitorWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Error updating global load balancer health check detail: %s", resp)
-			return err
+			return diag.FromErr(err)
 		}
 		log.Printf("Monitor update succesful : %s", *result.Result.ID)
 	}
 
-	return resourceCISHealthCheckRead(d, meta)
+	return resourceCISHealthCheckRead(ctx, d, meta)
 }
 
-func resourceCISHealthCheckDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	monitorID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 
 	opt := sess.NewDeleteLoadBalancerMonitorOptions(monitorID)
 
-	result, resp, err := sess.DeleteLoadBalancerMonitor(opt)
+	result, resp, err := sess.DeleteLoadBalancerMonitorWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Error deleting global load balancer health check detail: %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("Monitor ID: %s", *result.Result.ID)
 	return nil
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/networking-go-sdk/logpushjobsapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,11 +31,11 @@ const (
 
 func ResourceIBMCISLogPushJob() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISLogpushJobCreate,
-		Read:     ResourceIBMCISLogpushJobRead,
-		Update:   ResourceIBMCISLogpushJobUpdate,
-		Delete:   ResourceIBMCISLogpushJobDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISLogpushJobCreate,
+		ReadContext:   ResourceIBMCISLogpushJobRead,
+		UpdateContext: ResourceIBMCISLogpushJobUpdate,
+		DeleteContext: ResourceIBMCISLogpushJobDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -99,10 +101,10 @@ func ResourceIBMCISLogPushJob() *schema.Resource {
 	}
 }
 
-func ResourceIBMCISLogpushJobCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISLogpushJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisLogpushJobsSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -139,38 +141,38 @@ func ResourceIBMCISLogpushJobCreate(d *schema.ResourceData, meta interface{}) er
 	options := &logpushjobsapiv1.CreateLogpushJobV2Options{
 		CreateLogpushJobV2Request: logpushJob,
 	}
-	result, response, err := sess.CreateLogpushJobV2(options)
+	result, response, err := sess.CreateLogpushJobV2WithContext(ctx, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	JobID := strconv.Itoa(int(*result.Result.ID))
 
 	d.SetId(flex.ConvertCisToTfThreeVar(JobID, zoneID, crn))
-	return ResourceIBMCISLogpushJobRead(d, meta)
+	return ResourceIBMCISLogpushJobRead(ctx, d, meta)
 }
 
-func ResourceIBMCISLogpushJobRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISLogpushJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisLogpushJobsSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	logpushID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Converting ConvertTfToCisThreeVar in Read")
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Converting ConvertTfToCisThreeVar in Read"))
 	}
 	JobID, _ := strconv.Atoi(logpushID)
 	sess.Crn = core.StringPtr(crn)
 	sess.ZoneID = core.StringPtr(zoneID)
 
 	opt := sess.NewGetLogpushJobV2Options(int64(JobID))
-	result, response, err := sess.GetLogpushJobV2(opt)
+	result, response, err := sess.GetLogpushJobV2WithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error While Reading the Logpushjobs for LogDNA %s:%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error While Reading the Logpushjobs for LogDNA %s:%s", err, response))
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -183,10 +185,10 @@ func ResourceIBMCISLogpushJobRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set(cisLogpushDestConf, *result.Result.DestinationConf)
 	return nil
 }
-func ResourceIBMCISLogpushJobUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISLogpushJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisLogpushJobsSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -198,7 +200,7 @@ func ResourceIBMCISLogpushJobUpdate(d *schema.ResourceData, meta interface{}) er
 	JobId, _ := strconv.Atoi(logpushID)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Converting ConvertTfToCisThreeVar in Update")
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Converting ConvertTfToCisThreeVar in Update"))
 	}
 	if d.HasChange(cisLogpushEnabled) ||
 		d.HasChange(cisLogpullOpt) ||
@@ -228,17 +230,17 @@ func ResourceIBMCISLogpushJobUpdate(d *schema.ResourceData, meta interface{}) er
 			JobID:                     core.Int64Ptr(int64(JobId)),
 			UpdateLogpushJobV2Request: updateLogpushJob,
 		}
-		result, resp, err := sess.UpdateLogpushJobV2(options)
+		result, resp, err := sess.UpdateLogpushJobV2WithContext(ctx, options)
 		if err != nil || result == nil {
-			return fmt.Errorf("[ERROR] Error While Updating the Logpushjobs for LogDNA  %v, %v", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error While Updating the Logpushjobs for LogDNA  %v, %v", err, resp))
 		}
 	}
-	return ResourceIBMCISLogpushJobRead(d, meta)
+	return ResourceIBMCISLogpushJobRead(ctx, d, meta)
 }
-func ResourceIBMCISLogpushJobDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISLogpushJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisLogpushJobsSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -248,15 +250,15 @@ func ResourceIBMCISLogpushJobDelete(d *schema.ResourceData, meta interface{}) er
 	logpushID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	JobID, _ := strconv.Atoi(logpushID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Converting ConvertTfToCisThreeVar in Delete")
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Converting ConvertTfToCisThreeVar in Delete"))
 	}
 	opt := sess.NewDeleteLogpushJobV2Options(int64(JobID))
-	_, response, err := sess.DeleteLogpushJobV2(opt)
+	_, response, err := sess.DeleteLogpushJobV2WithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error While Deleting the Logpushjob for LogDNA %s:%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error While Deleting the Logpushjob for LogDNA %s:%s", err, response))
 	}
 	d.SetId("")
 	return nil
@@ -4,12 +4,14 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/networking-go-sdk/globalloadbalancerpoolsv0"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -148,20 +150,20 @@ func ResourceIBMCISPool() *schema.Resource {
 			},
 		},
 
-		Create:   resourceCISPoolCreate,
-		Read:     resourceCISPoolRead,
-		Update:   resourceCISPoolUpdate,
-		Delete:   resourceCISPoolDelete,
-		Exists:   resourceCISPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISPoolCreate,
+		ReadContext:   resourceCISPoolRead,
+		UpdateContext: resourceCISPoolUpdate,
+		DeleteContext: resourceCISPoolDelete,
+		Exists:        resourceCISPoolExists,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
-func resourceCISPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var regions []string
 	cisClient, err := meta.(conns.ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -207,31 +209,31 @@ func resourceCISPoolCreate(d *schema.ResourceData, meta interface{}) error {
 		opt.SetDescription(description.(string))
 	}
 
-	result, resp, err := cisClient.CreateLoadBalancerPool(opt)
+	result, resp, err := cisClient.CreateLoadBalancerPoolWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("[WARN] Create GLB Pools failed %s\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	//Set unique TF Id from concatenated CIS Ids
 	d.SetId(flex.ConvertCisToTfTwoVar(*result.Result.ID, crn))
-	return resourceCISPoolRead(d, meta)
+	return resourceCISPoolRead(ctx, d, meta)
 }
 
-func resourceCISPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	poolID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetLoadBalancerPoolOptions(poolID)
-	result, resp, err := cisClient.GetLoadBalancerPool(opt)
+	result, resp, err := cisClient.GetLoadBalancerPoolWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("[WARN] Create GLB Pools failed %s\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	poolObj := *result.Result
@@ -253,14 +255,14 @@ func resourceCISPoolRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	poolID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	if d.HasChange(cisGLBPoolName) ||
@@ -317,30 +319,30 @@ func resourceCISPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 		if description, ok := d.GetOk(cisGLBPoolDesc); ok {
 			opt.SetDescription(description.(string))
 		}
-		_, resp, err := cisClient.EditLoadBalancerPool(opt)
+		_, resp, err := cisClient.EditLoadBalancerPoolWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("[WARN] Error getting zone during PoolUpdate %v\n", resp)
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceCISPoolRead(d, meta)
+	return resourceCISPoolRead(ctx, d, meta)
 }
 
-func resourceCISPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	poolID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewDeleteLoadBalancerPoolOptions(poolID)
-	result, resp, err := cisClient.DeleteLoadBalancerPool(opt)
+	result, resp, err := cisClient.DeleteLoadBalancerPoolWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("[WARN] Delete GLB Pools failed %s\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("Pool %s deleted successfully.", *result.Result.ID)
 	return nil
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	cispagerulev1 "github.com/IBM/networking-go-sdk/pageruleapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -44,12 +46,12 @@ const (
 
 func ResourceIBMCISPageRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceCISPageRuleCreate,
-		Read:     resourceCISPageRuleRead,
-		Update:   resourceCISPageRuleUpdate,
-		Delete:   resourceCISPageRuleDelete,
-		Exists:   resourceCISPageRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISPageRuleCreate,
+		ReadContext:   resourceCISPageRuleRead,
+		UpdateContext: resourceCISPageRuleUpdate,
+		DeleteContext: resourceCISPageRuleDelete,
+		Exists:        resourceCISPageRuleExists,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -195,10 +197,10 @@ func ResourceIBMCISPageRuleValidator() *validate.ResourceValidator {
 	return &cisPageRuleValidator
 }
 
-func resourceCISPageRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -219,32 +221,32 @@ func resourceCISPageRuleCreate(d *schema.ResourceData, meta interface{}) error {
 		opt.SetStatus(value.(string))
 	}
 
-	result, response, err := cisClient.CreatePageRule(opt)
+	result, response, err := cisClient.CreatePageRuleWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Create page rule failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceCISPageRuleRead(d, meta)
+	return resourceCISPageRuleRead(ctx, d, meta)
 }
-func resourceCISPageRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetPageRuleOptions(ruleID)
-	result, response, err := cisClient.GetPageRule(opt)
+	result, response, err := cisClient.GetPageRuleWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Get page rule failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -255,10 +257,10 @@ func resourceCISPageRuleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(cisPageRuleActions, flattenCISPageRuleActions(result.Result.Actions))
 	return nil
 }
-func resourceCISPageRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
@@ -283,29 +285,29 @@ func resourceCISPageRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 			opt.SetStatus(value.(string))
 		}
 
-		_, response, err := cisClient.UpdatePageRule(opt)
+		_, response, err := cisClient.UpdatePageRuleWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Update page rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceCISPageRuleRead(d, meta)
+	return resourceCISPageRuleRead(ctx, d, meta)
 }
 
-func resourceCISPageRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
 	opt := cisClient.NewDeletePageRuleOptions(ruleID)
-	_, response, err := cisClient.DeletePageRule(opt)
+	_, response, err := cisClient.DeletePageRuleWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Delete page rule failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
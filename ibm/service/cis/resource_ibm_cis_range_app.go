@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	cisrangeappv1 "github.com/IBM/networking-go-sdk/rangeapplicationsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -51,12 +53,12 @@ const (
 
 func ResourceIBMCISRangeApp() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISRangeAppCreate,
-		Read:     ResourceIBMCISRangeAppRead,
-		Update:   ResourceIBMCISRangeAppUpdate,
-		Delete:   ResourceIBMCISRangeAppDelete,
-		Exists:   ResourceIBMCISRangeAppExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISRangeAppCreate,
+		ReadContext:   ResourceIBMCISRangeAppRead,
+		UpdateContext: ResourceIBMCISRangeAppUpdate,
+		DeleteContext: ResourceIBMCISRangeAppDelete,
+		Exists:        ResourceIBMCISRangeAppExists,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -208,10 +210,10 @@ func ResourceIBMCISRangeAppValidator() *validate.ResourceValidator {
 	ibmCISRangeAppResourceValidator := validate.ResourceValidator{ResourceName: ibmCISRangeApp, Schema: validateSchema}
 	return &ibmCISRangeAppResourceValidator
 }
-func ResourceIBMCISRangeAppCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRangeAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -265,18 +267,18 @@ func ResourceIBMCISRangeAppCreate(d *schema.ResourceData, meta interface{}) erro
 		opt.SetTls(v.(string))
 	}
 
-	result, resp, err := cisClient.CreateRangeApp(opt)
+	result, resp, err := cisClient.CreateRangeAppWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to create range application: %v", resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Failed to create range application: %v", resp))
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return ResourceIBMCISRangeAppRead(d, meta)
+	return ResourceIBMCISRangeAppRead(ctx, d, meta)
 }
 
-func ResourceIBMCISRangeAppRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRangeAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rangeAppID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
@@ -284,9 +286,9 @@ func ResourceIBMCISRangeAppRead(d *schema.ResourceData, meta interface{}) error
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetRangeAppOptions(rangeAppID)
-	result, resp, err := cisClient.GetRangeApp(opt)
+	result, resp, err := cisClient.GetRangeAppWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to read range application: %v", resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Failed to read range application: %v", resp))
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -306,10 +308,10 @@ func ResourceIBMCISRangeAppRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func ResourceIBMCISRangeAppUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRangeAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange(cisRangeAppOriginDirect) ||
@@ -370,27 +372,27 @@ func ResourceIBMCISRangeAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		if v, ok := d.GetOk(cisRangeAppTLS); ok {
 			opt.SetTls(v.(string))
 		}
-		_, resp, err := cisClient.UpdateRangeApp(opt)
+		_, resp, err := cisClient.UpdateRangeAppWithContext(ctx, opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Failed to update range application: %v", resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Failed to update range application: %v", resp))
 		}
 	}
-	return ResourceIBMCISRangeAppRead(d, meta)
+	return ResourceIBMCISRangeAppRead(ctx, d, meta)
 }
 
-func ResourceIBMCISRangeAppDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRangeAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rangeAppID, zoneID, cisID, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewDeleteRangeAppOptions(rangeAppID)
-	_, resp, err := cisClient.DeleteRangeApp(opt)
+	_, resp, err := cisClient.DeleteRangeAppWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to delete range application: %v", resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Failed to delete range application: %v", resp))
 	}
 	return nil
 }
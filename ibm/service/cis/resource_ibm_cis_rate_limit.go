@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/networking-go-sdk/zoneratelimitsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,12 +28,12 @@ const (
 
 func ResourceIBMCISRateLimit() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISRateLimitCreate,
-		Read:     ResourceIBMCISRateLimitRead,
-		Update:   ResourceIBMCISRateLimitUpdate,
-		Delete:   ResourceIBMCISRateLimitDelete,
-		Exists:   ResourceIBMCISRateLimitExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISRateLimitCreate,
+		ReadContext:   ResourceIBMCISRateLimitRead,
+		UpdateContext: ResourceIBMCISRateLimitUpdate,
+		DeleteContext: ResourceIBMCISRateLimitDelete,
+		Exists:        ResourceIBMCISRateLimitExists,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"cis_id": {
 				Type:        schema.TypeString,
@@ -349,10 +351,10 @@ func ResourceIBMCISRateLimitValidator() *validate.ResourceValidator {
 	ibmCISRateLimitResourceValidator := validate.ResourceValidator{ResourceName: "ibm_cis_rate_limit", Schema: validateSchema}
 	return &ibmCISRateLimitResourceValidator
 }
-func ResourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRateLimitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cisID := d.Get("cis_id").(string)
@@ -375,13 +377,13 @@ func ResourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) err
 
 	action, err := expandRateLimitAction(d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting action from expandRateLimitAction %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in getting action from expandRateLimitAction %s", err))
 	}
 	opt.SetAction(action)
 
 	match, err := expandRateLimitMatch(d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting match from expandRateLimitMatch %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in getting match from expandRateLimitMatch %s", err))
 	}
 	opt.SetMatch(match)
 
@@ -392,35 +394,35 @@ func ResourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) err
 
 	byPass, err := expandRateLimitBypass(d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting bypass from expandRateLimitBypass %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in getting bypass from expandRateLimitBypass %s", err))
 	}
 	opt.SetBypass(byPass)
 
 	//creating rate limit rule
-	result, resp, err := cisClient.CreateZoneRateLimits(opt)
+	result, resp, err := cisClient.CreateZoneRateLimitsWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to create RateLimit: %v", resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Failed to create RateLimit: %v", resp))
 	}
 	record := result.Result
 	d.SetId(flex.ConvertCisToTfThreeVar(*record.ID, zoneID, cisID))
-	return ResourceIBMCISRateLimitRead(d, meta)
+	return ResourceIBMCISRateLimitRead(ctx, d, meta)
 }
 
-func ResourceIBMCISRateLimitRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRateLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	recordID, zoneID, cisID, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetRateLimitOptions(recordID)
-	result, resp, err := cisClient.GetRateLimit(opt)
+	result, resp, err := cisClient.GetRateLimitWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to read RateLimit: %v", resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Failed to read RateLimit: %v", resp))
 	}
 
 	rule := result.Result
@@ -439,10 +441,10 @@ func ResourceIBMCISRateLimitRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func ResourceIBMCISRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRateLimitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	recordID, zoneID, cisID, _ := flex.ConvertTfToCisThreeVar(d.Id())
@@ -472,13 +474,13 @@ func ResourceIBMCISRateLimitUpdate(d *schema.ResourceData, meta interface{}) err
 
 		action, err := expandRateLimitAction(d)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error in getting action from expandRateLimitAction %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error in getting action from expandRateLimitAction %s", err))
 		}
 		opt.SetAction(action)
 
 		match, err := expandRateLimitMatch(d)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error in getting match from expandRateLimitMatch %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error in getting match from expandRateLimitMatch %s", err))
 		}
 		opt.SetMatch(match)
 
@@ -489,31 +491,31 @@ func ResourceIBMCISRateLimitUpdate(d *schema.ResourceData, meta interface{}) err
 
 		byPass, err := expandRateLimitBypass(d)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error in getting bypass from expandRateLimitBypass %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error in getting bypass from expandRateLimitBypass %s", err))
 		}
 		opt.SetBypass(byPass)
-		_, resp, err := cisClient.UpdateRateLimit(opt)
+		_, resp, err := cisClient.UpdateRateLimitWithContext(ctx, opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Failed to update RateLimit: %v", resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Failed to update RateLimit: %v", resp))
 		}
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(recordID, zoneID, cisID))
-	return ResourceIBMCISRateLimitRead(d, meta)
+	return ResourceIBMCISRateLimitRead(ctx, d, meta)
 }
 
-func ResourceIBMCISRateLimitDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRateLimitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	recordID, zoneID, cisID, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewDeleteZoneRateLimitOptions(recordID)
-	_, resp, err := cisClient.DeleteZoneRateLimit(opt)
+	_, resp, err := cisClient.DeleteZoneRateLimitWithContext(ctx, opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to delete RateLimit: %v", resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Failed to delete RateLimit: %v", resp))
 	}
 	return nil
 }
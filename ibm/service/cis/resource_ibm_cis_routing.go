@@ -4,12 +4,14 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,11 +22,11 @@ const (
 
 func ResourceIBMCISRouting() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISRoutingUpdate,
-		Read:     ResourceIBMCISRoutingRead,
-		Update:   ResourceIBMCISRoutingUpdate,
-		Delete:   ResourceIBMCISRoutingDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISRoutingUpdate,
+		ReadContext:   ResourceIBMCISRoutingRead,
+		UpdateContext: ResourceIBMCISRoutingUpdate,
+		DeleteContext: ResourceIBMCISRoutingDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -64,10 +66,10 @@ func ResourceIBMCISRoutingValidator() *validate.ResourceValidator {
 	return &ibmCISRoutingValidator
 }
 
-func ResourceIBMCISRoutingUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRoutingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisRoutingClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -79,30 +81,30 @@ func ResourceIBMCISRoutingUpdate(d *schema.ResourceData, meta interface{}) error
 		smartRoutingValue := d.Get(cisRoutingSmartRouting).(string)
 		opt := cisClient.NewUpdateSmartRoutingOptions()
 		opt.SetValue(smartRoutingValue)
-		_, response, err := cisClient.UpdateSmartRouting(opt)
+		_, response, err := cisClient.UpdateSmartRoutingWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Update smart route setting failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
-	return ResourceIBMCISRoutingRead(d, meta)
+	return ResourceIBMCISRoutingRead(ctx, d, meta)
 }
 
-func ResourceIBMCISRoutingRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRoutingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisRoutingClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	zoneID, crn, _ := flex.ConvertTftoCisTwoVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetSmartRoutingOptions()
-	result, response, err := cisClient.GetSmartRouting(opt)
+	result, response, err := cisClient.GetSmartRoutingWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Get smart route setting failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -110,7 +112,7 @@ func ResourceIBMCISRoutingRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func ResourceIBMCISRoutingDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRoutingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
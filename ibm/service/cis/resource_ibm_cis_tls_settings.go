@@ -4,12 +4,14 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -57,11 +59,11 @@ func ResourceIBMCISTLSSettings() *schema.Resource {
 				Default:      "1.1",
 			},
 		},
-		Create:   resourceCISTLSSettingsUpdate,
-		Read:     resourceCISTLSSettingsRead,
-		Update:   resourceCISTLSSettingsUpdate,
-		Delete:   resourceCISTLSSettingsDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISTLSSettingsUpdate,
+		ReadContext:   resourceCISTLSSettingsRead,
+		UpdateContext: resourceCISTLSSettingsUpdate,
+		DeleteContext: resourceCISTLSSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
@@ -88,10 +90,10 @@ func ResourceIBMCISTLSSettingsValidator() *validate.ResourceValidator {
 	return &ibmCISTLSSettingsResourceValidator
 }
 
-func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISTLSSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -107,10 +109,10 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 		if tls13, ok := d.GetOk(cisTLSSettingsTLS13); ok {
 			opt := cisClient.NewChangeTls13SettingOptions()
 			opt.SetValue(tls13.(string))
-			_, resp, err := cisClient.ChangeTls13Setting(opt)
+			_, resp, err := cisClient.ChangeTls13SettingWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Update TLS 1.3 setting Failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -118,10 +120,10 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 		if universalSSL, ok := d.GetOkExists(cisTLSSettingsUniversalSSL); ok {
 			opt := cisClient.NewChangeUniversalCertificateSettingOptions()
 			opt.SetEnabled(universalSSL.(bool))
-			resp, err := cisClient.ChangeUniversalCertificateSetting(opt)
+			resp, err := cisClient.ChangeUniversalCertificateSettingWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Update universal ssl setting Failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -129,59 +131,59 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 		if minTLSVer, ok := d.GetOk(cisTLSSettingsMinTLSVersion); ok {
 			cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 			opt := cisClient.NewUpdateMinTlsVersionOptions()
 			opt.SetValue(minTLSVer.(string))
-			_, resp, err := cisClient.UpdateMinTlsVersion(opt)
+			_, resp, err := cisClient.UpdateMinTlsVersionWithContext(ctx, opt)
 			if err != nil {
 				log.Printf("Update minimum TLS version setting Failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
-	return resourceCISTLSSettingsRead(d, meta)
+	return resourceCISTLSSettingsRead(ctx, d, meta)
 }
 
-func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISTLSSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	zoneID, crn, _ := flex.ConvertTftoCisTwoVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	// TLS 1.3 setting
-	tls13Result, resp, err := cisClient.GetTls13Setting(cisClient.NewGetTls13SettingOptions())
+	tls13Result, resp, err := cisClient.GetTls13SettingWithContext(ctx, cisClient.NewGetTls13SettingOptions())
 	if err != nil {
 		log.Printf("Get TLS 1.3 setting failed : %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Universal SSL setting
-	universalSSLResult, resp, err := cisClient.GetUniversalCertificateSetting(
+	universalSSLResult, resp, err := cisClient.GetUniversalCertificateSettingWithContext(ctx,
 		cisClient.NewGetUniversalCertificateSettingOptions())
 	if err != nil {
 		log.Printf("Update TLS 1.3 setting failed : %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Minumum TLS version setting
 	minTLSClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	minTLSClient.Crn = core.StringPtr(crn)
 	minTLSClient.ZoneIdentifier = core.StringPtr(zoneID)
-	minTLSVerResult, resp, err := minTLSClient.GetMinTlsVersion(
+	minTLSVerResult, resp, err := minTLSClient.GetMinTlsVersionWithContext(ctx,
 		minTLSClient.NewGetMinTlsVersionOptions())
 	if err != nil {
 		log.Printf("Min TLS Version setting get request failed : %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -191,7 +193,7 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceCISTLSSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISTLSSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
@@ -4,12 +4,14 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,11 +29,11 @@ const (
 
 func ResourceIBMCISWAFGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISWAFGroupUpdate,
-		Read:     ResourceIBMCISWAFGroupRead,
-		Update:   ResourceIBMCISWAFGroupUpdate,
-		Delete:   ResourceIBMCISWAFGroupDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISWAFGroupUpdate,
+		ReadContext:   ResourceIBMCISWAFGroupRead,
+		UpdateContext: ResourceIBMCISWAFGroupUpdate,
+		DeleteContext: ResourceIBMCISWAFGroupDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -109,10 +111,10 @@ func ResourceIBMCISWAFGroupValidator() *validate.ResourceValidator {
 	return &ibmCISWAFGroupValidator
 }
 
-func ResourceIBMCISWAFGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWAFGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisWAFGroupClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -127,42 +129,42 @@ func ResourceIBMCISWAFGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	if checkMode == true {
 		opt := cisClient.NewGetWafRuleGroupOptions(packageID, groupID)
-		result, _, error := cisClient.GetWafRuleGroup(opt)
+		result, _, error := cisClient.GetWafRuleGroupWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Get waf rule group setting failed: %v", error)
-			return err
+			return diag.FromErr(err)
 		}
 
 		actualMode := *result.Result.Mode
 		if actualMode == mode {
 			d.SetId(flex.ConvertCisToTfFourVar(groupID, packageID, zoneID, crn))
-			return ResourceIBMCISWAFGroupRead(d, meta)
+			return ResourceIBMCISWAFGroupRead(ctx, d, meta)
 		}
 	}
 
 	if d.HasChange(cisWAFGroupMode) {
 		opt := cisClient.NewUpdateWafRuleGroupOptions(packageID, groupID)
 		opt.SetMode(mode)
-		_, response, err := cisClient.UpdateWafRuleGroup(opt)
+		_, response, err := cisClient.UpdateWafRuleGroupWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Update waf rule group mode failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId(flex.ConvertCisToTfFourVar(groupID, packageID, zoneID, crn))
-	return ResourceIBMCISWAFGroupRead(d, meta)
+	return ResourceIBMCISWAFGroupRead(ctx, d, meta)
 }
 
-func ResourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWAFGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisWAFGroupClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	groupID, packageID, zoneID, crn, _ := flex.ConvertTfToCisFourVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
 	opt := cisClient.NewGetWafRuleGroupOptions(packageID, groupID)
-	result, response, err := cisClient.GetWafRuleGroup(opt)
+	result, response, err := cisClient.GetWafRuleGroupWithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("WAF group is not found!")
@@ -170,7 +172,7 @@ func ResourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 		log.Printf("Get waf rule group setting failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -184,7 +186,7 @@ func ResourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func ResourceIBMCISWAFGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWAFGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS WAF Group resource
 	d.SetId("")
 	return nil
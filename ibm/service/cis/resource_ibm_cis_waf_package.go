@@ -4,12 +4,14 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,11 +27,11 @@ const (
 
 func ResourceIBMCISWAFPackage() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISWAFPackageUpdate,
-		Read:     ResourceIBMCISWAFPackageRead,
-		Update:   ResourceIBMCISWAFPackageUpdate,
-		Delete:   ResourceIBMCISWAFPackageDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISWAFPackageUpdate,
+		ReadContext:   ResourceIBMCISWAFPackageRead,
+		UpdateContext: ResourceIBMCISWAFPackageUpdate,
+		DeleteContext: ResourceIBMCISWAFPackageDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -106,10 +108,10 @@ func ResourceIBMCISWAFPackageValidator() *validate.ResourceValidator {
 	return &ibmCISWAFPackageValidator
 }
 
-func ResourceIBMCISWAFPackageUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWAFPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisWAFPackageClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -127,27 +129,27 @@ func ResourceIBMCISWAFPackageUpdate(d *schema.ResourceData, meta interface{}) er
 		if v, ok := d.GetOk(cisWAFPackageActionMode); ok {
 			opt.SetActionMode(v.(string))
 		}
-		result, response, err := cisClient.UpdateWafPackage(opt)
+		result, response, err := cisClient.UpdateWafPackageWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Update waf package setting failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	}
 
-	return ResourceIBMCISWAFPackageRead(d, meta)
+	return ResourceIBMCISWAFPackageRead(ctx, d, meta)
 }
 
-func ResourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWAFPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisWAFPackageClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	packageID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
 	opt := cisClient.NewGetWafPackageOptions(packageID)
-	result, response, err := cisClient.GetWafPackage(opt)
+	result, response, err := cisClient.GetWafPackageWithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("WAF package is not found!")
@@ -155,7 +157,7 @@ func ResourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) erro
 			return nil
 		}
 		log.Printf("Get waf package setting failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -168,7 +170,7 @@ func ResourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func ResourceIBMCISWAFPackageDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWAFPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS WAF Package resource
 	d.SetId("")
 	return nil
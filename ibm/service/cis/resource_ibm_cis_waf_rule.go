@@ -4,12 +4,14 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -30,11 +32,11 @@ const (
 
 func ResourceIBMCISWAFRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISWAFRuleUpdate,
-		Read:     ResourceIBMCISWAFRuleRead,
-		Update:   ResourceIBMCISWAFRuleUpdate,
-		Delete:   ResourceIBMCISWAFRuleDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISWAFRuleUpdate,
+		ReadContext:   ResourceIBMCISWAFRuleRead,
+		UpdateContext: ResourceIBMCISWAFRuleUpdate,
+		DeleteContext: ResourceIBMCISWAFRuleDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -122,10 +124,10 @@ func ResourceIBMCISWAFRuleValidator() *validate.ResourceValidator {
 	return &ibmCISWAFRuleValidator
 }
 
-func ResourceIBMCISWAFRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWAFRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisWAFRuleClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -139,10 +141,10 @@ func ResourceIBMCISWAFRuleUpdate(d *schema.ResourceData, meta interface{}) error
 		mode := d.Get(cisWAFRuleMode).(string)
 
 		getOpt := cisClient.NewGetWafRuleOptions(packageID, ruleID)
-		getResult, getResponse, err := cisClient.GetWafRule(getOpt)
+		getResult, getResponse, err := cisClient.GetWafRuleWithContext(ctx, getOpt)
 		if err != nil {
 			log.Printf("Get WAF rule setting failed: %v", getResponse)
-			return err
+			return diag.FromErr(err)
 		}
 		getMode := *getResult.Result.Mode
 		updateOpt := cisClient.NewUpdateWafRuleOptions(packageID, ruleID)
@@ -159,27 +161,27 @@ func ResourceIBMCISWAFRuleUpdate(d *schema.ResourceData, meta interface{}) error
 			updateOpt.SetCis(cisOpt)
 
 		}
-		_, response, err := cisClient.UpdateWafRule(updateOpt)
+		_, response, err := cisClient.UpdateWafRuleWithContext(ctx, updateOpt)
 		if err != nil {
 			log.Printf("Update WAF rule setting failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(flex.ConvertCisToTfFourVar(ruleID, packageID, zoneID, crn))
-	return ResourceIBMCISWAFRuleRead(d, meta)
+	return ResourceIBMCISWAFRuleRead(ctx, d, meta)
 }
 
-func ResourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWAFRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisWAFRuleClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ruleID, packageID, zoneID, crn, _ := flex.ConvertTfToCisFourVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
 	opt := cisClient.NewGetWafRuleOptions(packageID, ruleID)
-	result, response, err := cisClient.GetWafRule(opt)
+	result, response, err := cisClient.GetWafRuleWithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("WAF Rule is not found!")
@@ -187,7 +189,7 @@ func ResourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 		log.Printf("Get waf rule setting failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	groups := []interface{}{}
 	group := map[string]interface{}{}
@@ -207,7 +209,7 @@ func ResourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func ResourceIBMCISWAFRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISWAFRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS WAF rule resource
 	d.SetId("")
 	return nil
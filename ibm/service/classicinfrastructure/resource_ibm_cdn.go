@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -21,11 +23,11 @@ const str string = ".cdn.appdomain.cloud"
 
 func ResourceIBMCDN() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMCDNCreate,
-		Read:   resourceIBMCDNRead,
-		Update: resourceIBMCDNUpdate,
-		Delete: resourceIBMCDNDelete,
-		Exists: resourceIBMCDNExists,
+		CreateContext: resourceIBMCDNCreate,
+		ReadContext:   resourceIBMCDNRead,
+		UpdateContext: resourceIBMCDNUpdate,
+		DeleteContext: resourceIBMCDNDelete,
+		Exists:        resourceIBMCDNExists,
 
 		Schema: map[string]*schema.Schema{
 			"host_name": {
@@ -151,7 +153,7 @@ func ResourceIBMCDN() *schema.Resource {
 	}
 }
 
-func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	///create  session
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	///get the value of all the parameters
@@ -198,14 +200,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt1[0].UniqueId)
 		id, _ := strconv.Atoi((d.Id()))
 		result1, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result1)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 
 	}
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTPS" {
@@ -226,14 +228,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt2[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result2, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result2)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTP_AND_HTTPS" {
 		receipt3, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -254,14 +256,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt3[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result3, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result3)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 	if origintype == "HOST_SERVER" && protocol == "HTTP" {
 		receipt4, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -279,14 +281,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt4[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result4, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result4)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 	if origintype == "HOST_SERVER" && protocol == "HTTPS" {
 		receipt5, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -305,14 +307,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt5[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result5, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result5)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 	if origintype == "HOST_SERVER" && protocol == "HTTP_AND_HTTPS" {
 		receipt6, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -332,20 +334,20 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt6[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result6, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result6)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 
 	return nil
 }
 
-func resourceIBMCDNRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkCdnMarketplaceConfigurationMappingService(sess)
 	cdnId := sl.String(d.Id())
@@ -380,7 +382,7 @@ func resourceIBMCDNRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	/// Nothing to update for now. Not supported.
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	domain := d.Get("host_name").(string)
@@ -436,7 +438,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 
 	if origintype == "HOST_SERVER" && protocol == "HTTPS" {
@@ -461,7 +463,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 
 	}
 
@@ -486,7 +488,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 
 	}
 
@@ -515,7 +517,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTPS" {
@@ -542,7 +544,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTP" {
@@ -568,13 +570,13 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 
 	return nil
 }
 
-func resourceIBMCDNDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkCdnMarketplaceConfigurationMappingService(sess)
 
@@ -583,7 +585,7 @@ func resourceIBMCDNDelete(d *schema.ResourceData, meta interface{}) error {
 	delete, err := service.DeleteDomainMapping(cdnId)
 	if err != nil {
 		log.Println(err)
-		return err
+		return diag.FromErr(err)
 	}
 	///print the delete response
 	log.Print("Delete response is : ", delete)
@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -39,12 +41,12 @@ var IBMComputeAutoScaleGroupObjectMask = []string{
 
 func ResourceIBMComputeAutoScaleGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeAutoScaleGroupCreate,
-		Read:     resourceIBMComputeAutoScaleGroupRead,
-		Update:   resourceIBMComputeAutoScaleGroupUpdate,
-		Delete:   resourceIBMComputeAutoScaleGroupDelete,
-		Exists:   resourceIBMComputeAutoScaleGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeAutoScaleGroupCreate,
+		ReadContext:   resourceIBMComputeAutoScaleGroupRead,
+		UpdateContext: resourceIBMComputeAutoScaleGroupUpdate,
+		DeleteContext: resourceIBMComputeAutoScaleGroupDelete,
+		Exists:        resourceIBMComputeAutoScaleGroupExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -249,23 +251,23 @@ func getVirtualGuestTemplate(vGuestTemplateList []interface{}, meta interface{})
 	return vgs[0], err
 }
 
-func resourceIBMComputeAutoScaleGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	accountServiceNoRetry := services.GetScaleGroupService(sess.SetRetries(0))
 
 	virtualGuestTemplateOpts, err := getVirtualGuestTemplate(d.Get("virtual_guest_member_template").([]interface{}), meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while parsing virtual_guest_member_template values: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while parsing virtual_guest_member_template values: %s", err))
 	}
 
 	scaleNetworkVlans, err := buildScaleVlansFromResourceData(d.Get("network_vlan_ids").(*schema.Set).List(), meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while parsing network vlan values: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while parsing network vlan values: %s", err))
 	}
 
 	locationGroupRegionalId, err := getLocationGroupRegionalId(sess, d.Get("regional_group").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Build up our creation options
@@ -286,12 +288,12 @@ func resourceIBMComputeAutoScaleGroupCreate(d *schema.ResourceData, meta interfa
 
 	opts.LoadBalancers, err = buildLoadBalancers(d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Scale Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Scale Group: %s", err))
 	}
 
 	res, err := accountServiceNoRetry.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Scale Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Scale Group: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
@@ -300,13 +302,13 @@ func resourceIBMComputeAutoScaleGroupCreate(d *schema.ResourceData, meta interfa
 	time.Sleep(60)
 
 	// wait for scale group to become active
-	_, err = waitForActiveStatus(d, meta)
+	_, err = waitForActiveStatus(ctx, d, meta)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for scale group (%s) to become active: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for scale group (%s) to become active: %s", d.Id(), err))
 	}
 
-	return resourceIBMComputeAutoScaleGroupRead(d, meta)
+	return resourceIBMComputeAutoScaleGroupRead(ctx, d, meta)
 }
 
 func buildLoadBalancers(d *schema.ResourceData, ids ...int) ([]datatypes.Scale_LoadBalancer, error) {
@@ -342,7 +344,7 @@ func buildLoadBalancers(d *schema.ResourceData, ids ...int) ([]datatypes.Scale_L
 	}
 }
 
-func resourceIBMComputeAutoScaleGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetScaleGroupService(sess)
 
@@ -356,7 +358,7 @@ func resourceIBMComputeAutoScaleGroupRead(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("[ERROR] Error retrieving autoscale Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving autoscale Group: %s", err))
 	}
 
 	d.Set("name", slGroupObj.Name)
@@ -456,7 +458,7 @@ func populateMemberTemplateResourceData(template datatypes.Virtual_Guest) []map[
 	return []map[string]interface{}{d}
 }
 
-func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	scaleGroupService := services.GetScaleGroupService(sess)
@@ -466,14 +468,14 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 
 	groupId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID. Must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID. Must be an integer: %s", err))
 	}
 
 	// Fetch the complete object from SoftLayer, update with current values from the configuration, and send the
 	// whole thing back to SoftLayer (effectively, a PUT)
 	groupObj, err := scaleGroupService.Id(groupId).Mask(strings.Join(IBMComputeAutoScaleGroupObjectMask, ",")).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving autoscale_group resource: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving autoscale_group resource: %s", err))
 	}
 
 	groupObj.Name = sl.String(d.Get("name").(string))
@@ -489,7 +491,7 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 		groupObj.LoadBalancers, err = buildLoadBalancers(d)
 	}
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Scale Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Scale Group: %s", err))
 	}
 
 	if d.HasChange("network_vlan_ids") {
@@ -507,13 +509,13 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 			Id(groupId).
 			GetNetworkVlans()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Could  not retrieve current vlans for scale group (%d): %s", groupId, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Could  not retrieve current vlans for scale group (%d): %s", groupId, err))
 		}
 
 		for _, oldScaleVlan := range oldScaleVlans {
 			_, err := scaleNetworkVlanService.Id(*oldScaleVlan.Id).DeleteObject()
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error deleting scale network vlan %d: %s", *oldScaleVlan.Id, err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error deleting scale network vlan %d: %s", *oldScaleVlan.Id, err))
 			}
 		}
 
@@ -521,7 +523,7 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 		scaleVlans, err := buildScaleVlansFromResourceData(newIds, meta)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Unable  to parse network vlan options: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Unable  to parse network vlan options: %s", err))
 		}
 
 		groupObj.NetworkVlans = scaleVlans
@@ -530,7 +532,7 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 	if d.HasChange("virtual_guest_member_template") {
 		virtualGuestTemplateOpts, err := getVirtualGuestTemplate(d.Get("virtual_guest_member_template").([]interface{}), meta)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Unable  to parse virtual guest member template options: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Unable  to parse virtual guest member template options: %s", err))
 		}
 
 		groupObj.VirtualGuestMemberTemplate = &virtualGuestTemplateOpts
@@ -538,14 +540,14 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 	}
 	_, err = scaleGroupServiceNoRetry.Id(groupId).EditObject(&groupObj)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error received while editing autoscale_group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error received while editing autoscale_group: %s", err))
 	}
 
 	// wait for scale group to become active
-	_, err = waitForActiveStatus(d, meta)
+	_, err = waitForActiveStatus(ctx, d, meta)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for scale group (%s) to become active: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for scale group (%s) to become active: %s", d.Id(), err))
 	}
 
 	// Delete a load balancer if there is the load balancer in a scale group
@@ -553,26 +555,26 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 	if len(currentLoadBalancers) > 0 && len(groupObj.LoadBalancers) <= 0 {
 		_, err = scaleLoadBalancerService.Id(*currentLoadBalancers[0].Id).DeleteObject()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error received while deleting loadbalancers: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error received while deleting loadbalancers: %s", err))
 		}
 	}
 
 	return nil
 }
 
-func resourceIBMComputeAutoScaleGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	scaleGroupService := services.GetScaleGroupService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting scale group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting scale group: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting scale group: %d", id)
 	_, err = scaleGroupService.Id(id).ForceDeleteObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting scale group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting scale group: %s", err))
 	}
 
 	d.SetId("")
@@ -580,7 +582,7 @@ func resourceIBMComputeAutoScaleGroupDelete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func waitForActiveStatus(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForActiveStatus(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	scaleGroupService := services.GetScaleGroupService(sess)
 
@@ -639,7 +641,7 @@ func waitForActiveStatus(d *schema.ResourceData, meta interface{}) (interface{},
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func resourceIBMComputeAutoScaleGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
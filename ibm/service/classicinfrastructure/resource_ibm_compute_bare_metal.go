@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -26,12 +28,12 @@ import (
 
 func ResourceIBMComputeBareMetal() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeBareMetalCreate,
-		Read:     resourceIBMComputeBareMetalRead,
-		Update:   resourceIBMComputeBareMetalUpdate,
-		Delete:   resourceIBMComputeBareMetalDelete,
-		Exists:   resourceIBMComputeBareMetalExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeBareMetalCreate,
+		ReadContext:   resourceIBMComputeBareMetalRead,
+		UpdateContext: resourceIBMComputeBareMetalUpdate,
+		DeleteContext: resourceIBMComputeBareMetalDelete,
+		Exists:        resourceIBMComputeBareMetalExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -528,7 +530,7 @@ func getBareMetalOrderFromResourceData(d *schema.ResourceData, meta interface{})
 	return hardware, nil
 }
 
-func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeBareMetalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	hwService := services.GetHardwareService(sess)
 	var order datatypes.Container_Product_Order
@@ -544,8 +546,8 @@ func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{})
 		order, err = services.GetBillingOrderQuoteService(sess).
 			Id(quote_id).GetRecalculatedOrderContainer(nil, sl.Bool(false))
 		if err != nil {
-			return fmt.Errorf(
-				"Encountered problem trying to get the bare metal order template from quote: %s", err)
+			return diag.FromErr(fmt.Errorf(
+				"Encountered problem trying to get the bare metal order template from quote: %s", err))
 		}
 		order.Quantity = sl.Int(1)
 		order.Hardware = make([]datatypes.Hardware, 0, 1)
@@ -557,16 +559,16 @@ func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{})
 		// Build an hourly bare metal server template using fixed_config_preset.
 		hardware, err = getBareMetalOrderFromResourceData(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		order, err = services.GetHardwareService(sess).GenerateOrderTemplate(&hardware)
 		if err != nil {
-			return fmt.Errorf(
-				"Encountered problem trying to get the bare metal order template: %s", err)
+			return diag.FromErr(fmt.Errorf(
+				"Encountered problem trying to get the bare metal order template: %s", err))
 		}
 		items, err := product.GetPackageProducts(sess, *order.PackageId, productItemMaskWithPriceLocationGroupID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		redundantNetwork := d.Get("redundant_network").(bool)
 		unbondedNetwork := d.Get("unbonded_network").(bool)
@@ -583,34 +585,34 @@ func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{})
 			}
 			portSpeed, err := findNetworkItemPriceId(items, d)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			prices[i] = portSpeed
 			order.Prices = prices
 		}
 		err = setMonthlyHourlyCommonOrder(d, items, &order)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else {
 		// Build a monthly bare metal server template
 		order, err = getMonthlyBareMetalOrder(d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Encountered problem trying to get the custom bare metal order template: %s", err)
+			return diag.FromErr(fmt.Errorf(
+				"Encountered problem trying to get the custom bare metal order template: %s", err))
 		}
 	}
 
 	order, err = setCommonBareMetalOrderOptions(d, meta, order)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Encountered problem trying to configure bare metal server options: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Encountered problem trying to configure bare metal server options: %s", err))
 	}
 
 	log.Println("[INFO] Ordering bare metal server")
 	orderReceipt, err := services.GetProductOrderService(sess.SetRetries(0)).PlaceOrder(&order, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error ordering bare metal server: %s\n%+v\n", err, order)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error ordering bare metal server: %s\n%+v\n", err, order))
 	}
 
 	gID := *orderReceipt.OrderDetails.Hardware[0].GlobalIdentifier
@@ -619,9 +621,9 @@ func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{})
 	log.Printf("[INFO] Bare Metal Server global ID: %s", gID)
 
 	// wait for machine availability
-	bm, err := waitForBareMetalProvision(&hardware, d, meta, gID)
+	bm, err := waitForBareMetalProvision(ctx, &hardware, d, meta, gID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for bare metal server (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for bare metal server (%s) to become ready: %s", d.Id(), err))
 	}
 
 	id := *bm.(datatypes.Hardware).Id
@@ -631,7 +633,7 @@ func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{})
 	if _, ok := d.GetOk("tags"); ok {
 		err = setHardwareTags(id, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -646,7 +648,7 @@ func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{})
 	if len(storageIds) > 0 {
 		err := addAccessToStorageList(hwService.Id(id), id, storageIds, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -654,19 +656,19 @@ func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{})
 	if d.Get("notes").(string) != "" {
 		err = setHardwareNotes(id, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMComputeBareMetalRead(d, meta)
+	return resourceIBMComputeBareMetalRead(ctx, d, meta)
 }
 
-func resourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeBareMetalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service := services.GetHardwareService(meta.(conns.ClientSession).SoftLayerSession())
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).Mask(
@@ -687,7 +689,7 @@ func resourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{}) e
 	).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving bare metal server: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving bare metal server: %s", err))
 	}
 
 	d.Set("hostname", *result.Hostname)
@@ -746,7 +748,7 @@ func resourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{}) e
 	).Id(id).GetBackendNetworkComponents()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving bare metal server network: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving bare metal server network: %s", err))
 	}
 
 	if len(backendNetworkComponent) > 2 && result.PrimaryBackendNetworkComponent != nil {
@@ -795,40 +797,40 @@ func resourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("ipv6_address_id", *result.PrimaryNetworkComponent.PrimaryVersion6IpAddressRecord.Id)
 	}
 	err = readSecondaryIPAddresses(d, meta, result.PrimaryIpAddress)
-	return err
+	return diag.FromErr(err)
 
 }
 
-func resourceIBMComputeBareMetalUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeBareMetalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id, _ := strconv.Atoi(d.Id())
 	service := services.GetHardwareService(meta.(conns.ClientSession).SoftLayerSession())
 
 	if d.HasChange("tags") {
 		err := setHardwareTags(id, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("notes") {
 		err := setHardwareNotes(id, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	err := modifyStorageAccess(service.Id(id), id, meta, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceIBMComputeBareMetalDelete(d *schema.ResourceData, meta interface{}) error {
-	return deleteHardware(d, meta)
+func resourceIBMComputeBareMetalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.FromErr(deleteHardware(ctx, d, meta))
 }
 
-func deleteHardware(d dataRetriever, meta interface{}) error {
+func deleteHardware(ctx context.Context, d dataRetriever, meta interface{}) error {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetHardwareService(sess)
 	id, err := strconv.Atoi(d.Id())
@@ -836,7 +838,7 @@ func deleteHardware(d dataRetriever, meta interface{}) error {
 		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
 	}
 
-	_, err = waitForNoBareMetalActiveTransactions(id, meta)
+	_, err = waitForNoBareMetalActiveTransactions(ctx, id, meta)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting bare metal server while waiting for zero active transactions: %s", err)
 	}
@@ -880,7 +882,7 @@ func resourceIBMComputeBareMetalExists(d *schema.ResourceData, meta interface{})
 // Have to wait on provision date to become available on server that matches
 // hostname and domain.
 // http://sldn.softlayer.com/blog/bpotter/ordering-bare-metal-servers-using-softlayer-api
-func waitForBareMetalProvision(hw *datatypes.Hardware, d *schema.ResourceData, meta interface{}, globalIdentifier string) (interface{}, error) {
+func waitForBareMetalProvision(ctx context.Context, hw *datatypes.Hardware, d *schema.ResourceData, meta interface{}, globalIdentifier string) (interface{}, error) {
 	hostname := *hw.Hostname
 	domain := *hw.Domain
 	log.Printf("Waiting for server (%s.%s) to have to be provisioned", hostname, domain)
@@ -925,10 +927,10 @@ func waitForBareMetalProvision(hw *datatypes.Hardware, d *schema.ResourceData, m
 		NotFoundChecks: 24 * 60,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForNoBareMetalActiveTransactions(id int, meta interface{}) (interface{}, error) {
+func waitForNoBareMetalActiveTransactions(ctx context.Context, id int, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%d) to have zero active transactions", id)
 	service := services.GetHardwareServerService(meta.(conns.ClientSession).SoftLayerSession())
 
@@ -953,7 +955,7 @@ func waitForNoBareMetalActiveTransactions(id int, meta interface{}) (interface{}
 		NotFoundChecks: 24 * 60,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func setHardwareTags(id int, d dataRetriever, meta interface{}) error {
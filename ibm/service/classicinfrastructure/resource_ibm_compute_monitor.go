@@ -4,12 +4,14 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -18,12 +20,12 @@ import (
 
 func ResourceIBMComputeMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeMonitorCreate,
-		Read:     resourceIBMComputeMonitorRead,
-		Update:   resourceIBMComputeMonitorUpdate,
-		Delete:   resourceIBMComputeMonitorDelete,
-		Exists:   resourceIBMComputeMonitorExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeMonitorCreate,
+		ReadContext:   resourceIBMComputeMonitorRead,
+		UpdateContext: resourceIBMComputeMonitorUpdate,
+		DeleteContext: resourceIBMComputeMonitorDelete,
+		Exists:        resourceIBMComputeMonitorExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -77,7 +79,7 @@ func ResourceIBMComputeMonitor() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	virtualGuestService := services.GetVirtualGuestService(sess)
 	monitorService := services.GetNetworkMonitorVersion1QueryHostService(sess.SetRetries(0))
@@ -87,12 +89,12 @@ func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) e
 	if ipAddress == "" {
 		virtualGuest, err := virtualGuestService.Id(guestId).GetObject()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error looking up virtual guest %d: %s", guestId, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error looking up virtual guest %d: %s", guestId, err))
 		}
 
 		if virtualGuest.PrimaryIpAddress == nil {
-			return fmt.Errorf(
-				"No primary ip address found for virtual guest %d. Please specify it.", guestId)
+			return diag.FromErr(fmt.Errorf(
+				"No primary ip address found for virtual guest %d. Please specify it.", guestId))
 		}
 
 		ipAddress = *virtualGuest.PrimaryIpAddress
@@ -112,7 +114,7 @@ func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) e
 	// Create a monitor
 	res, err := monitorService.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Basic Monitor : %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Basic Monitor : %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
@@ -120,10 +122,10 @@ func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) e
 
 	err = createNotifications(d, meta, guestId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMComputeMonitorRead(d, meta)
+	return resourceIBMComputeMonitorRead(ctx, d, meta)
 }
 
 func createNotifications(d *schema.ResourceData, meta interface{}, guestId int) error {
@@ -166,7 +168,7 @@ func notificationExists(notificationLinks []datatypes.User_Customer_Notification
 	return false
 }
 
-func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkMonitorVersion1QueryHostService(sess)
@@ -183,7 +185,7 @@ func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) err
 			return nil
 		}
 
-		return fmt.Errorf("[ERROR] Error retrieving Basic Monitor : %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Basic Monitor : %s", err))
 	}
 
 	guestId := *basicMonitor.GuestId
@@ -196,7 +198,7 @@ func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) err
 
 	notificationLinks, err := virtualGuestService.Id(guestId).GetMonitoringUserNotification()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error looking up user notifications for virtual guest %d", guestId)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error looking up user notifications for virtual guest %d", guestId))
 	}
 
 	notificationUserIds := schema.NewSet(func(v interface{}) int { return v.(int) }, make([]interface{}, 0, len(notificationLinks)))
@@ -227,7 +229,7 @@ func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMComputeMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	serviceNoRetry := services.GetNetworkMonitorVersion1QueryHostService(sess.SetRetries(0))
@@ -238,7 +240,7 @@ func resourceIBMComputeMonitorUpdate(d *schema.ResourceData, meta interface{}) e
 
 	basicMonitor, err := service.Id(basicMonitorId).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving Basic Monitor : %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Basic Monitor : %s", err))
 	}
 	if d.HasChange("query_type_id") {
 		basicMonitor.QueryTypeId = sl.Int(d.Get("query_type_id").(int))
@@ -252,20 +254,20 @@ func resourceIBMComputeMonitorUpdate(d *schema.ResourceData, meta interface{}) e
 
 	_, err = serviceNoRetry.Id(basicMonitorId).EditObject(&basicMonitor)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error editing Basic Monitor : %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error editing Basic Monitor : %s", err))
 	}
 
 	// Will only create notification objects for user/vm relationships that
 	// don't exist yet.
 	err = createNotifications(d, meta, guestId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMComputeMonitorRead(d, meta)
+	return resourceIBMComputeMonitorRead(ctx, d, meta)
 }
 
-func resourceIBMComputeMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkMonitorVersion1QueryHostService(sess)
 
@@ -275,7 +277,7 @@ func resourceIBMComputeMonitorDelete(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] Deleting Basic Monitor : %d", id)
 	_, err = service.Id(id).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Basic Monitor : %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Basic Monitor : %s", err))
 	}
 
 	d.SetId("")
@@ -4,12 +4,14 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -18,12 +20,12 @@ import (
 
 func ResourceIBMComputeProvisioningHook() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeProvisioningHookCreate,
-		Read:     resourceIBMComputeProvisioningHookRead,
-		Update:   resourceIBMComputeProvisioningHookUpdate,
-		Delete:   resourceIBMComputeProvisioningHookDelete,
-		Exists:   resourceIBMComputeProvisioningHookExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeProvisioningHookCreate,
+		ReadContext:   resourceIBMComputeProvisioningHookRead,
+		UpdateContext: resourceIBMComputeProvisioningHookUpdate,
+		DeleteContext: resourceIBMComputeProvisioningHookDelete,
+		Exists:        resourceIBMComputeProvisioningHookExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -49,7 +51,7 @@ func ResourceIBMComputeProvisioningHook() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeProvisioningHookCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess.SetRetries(0))
 
@@ -60,16 +62,16 @@ func resourceIBMComputeProvisioningHookCreate(d *schema.ResourceData, meta inter
 
 	hook, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Provisioning Hook: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Provisioning Hook: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*hook.Id))
 	log.Printf("[INFO] Provisioning Hook ID: %d", *hook.Id)
 
-	return resourceIBMComputeProvisioningHookRead(d, meta)
+	return resourceIBMComputeProvisioningHookRead(ctx, d, meta)
 }
 
-func resourceIBMComputeProvisioningHookRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess)
 
@@ -83,7 +85,7 @@ func resourceIBMComputeProvisioningHookRead(d *schema.ResourceData, meta interfa
 				return nil
 			}
 		}
-		return fmt.Errorf("[ERROR] Error retrieving Provisioning Hook: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Provisioning Hook: %s", err))
 	}
 
 	d.Set("name", hook.Name)
@@ -92,7 +94,7 @@ func resourceIBMComputeProvisioningHookRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceIBMComputeProvisioningHookUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess.SetRetries(0))
 
@@ -112,12 +114,12 @@ func resourceIBMComputeProvisioningHookUpdate(d *schema.ResourceData, meta inter
 	_, err := service.Id(hookId).EditObject(&opts)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error editing Provisioning Hook: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error editing Provisioning Hook: %s", err))
 	}
 	return nil
 }
 
-func resourceIBMComputeProvisioningHookDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess)
 
@@ -125,7 +127,7 @@ func resourceIBMComputeProvisioningHookDelete(d *schema.ResourceData, meta inter
 	log.Printf("[INFO] Deleting Provisioning Hook: %d", hookId)
 	_, err = service.Id(hookId).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Provisioning Hook: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Provisioning Hook: %s", err))
 	}
 
 	return nil
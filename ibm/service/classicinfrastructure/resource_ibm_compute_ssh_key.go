@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -21,12 +23,12 @@ import (
 
 func ResourceIBMComputeSSHKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeSSHKeyCreate,
-		Read:     resourceIBMComputeSSHKeyRead,
-		Update:   resourceIBMComputeSSHKeyUpdate,
-		Delete:   resourceIBMComputeSSHKeyDelete,
-		Exists:   resourceIBMComputeSSHKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeSSHKeyCreate,
+		ReadContext:   resourceIBMComputeSSHKeyRead,
+		UpdateContext: resourceIBMComputeSSHKeyUpdate,
+		DeleteContext: resourceIBMComputeSSHKeyDelete,
+		Exists:        resourceIBMComputeSSHKeyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"label": {
@@ -69,7 +71,7 @@ func ResourceIBMComputeSSHKey() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
@@ -80,7 +82,7 @@ func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 	fingerprint, err := computeSSHKeyFingerprint(key)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	keys, err := services.GetAccountService(sess).
@@ -109,7 +111,7 @@ func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 		if editKey {
 			_, err = service.Id(id).EditObject(&slKey)
-			return err
+			return diag.FromErr(err)
 		}
 
 		return nil
@@ -127,16 +129,16 @@ func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 	res, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating SSH Key: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating SSH Key: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
 	log.Printf("[INFO] SSH Key: %d", *res.Id)
 
-	return resourceIBMComputeSSHKeyRead(d, meta)
+	return resourceIBMComputeSSHKeyRead(ctx, d, meta)
 }
 
-func resourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
@@ -149,7 +151,7 @@ func resourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error retrieving SSH key: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving SSH key: %s", err))
 	}
 
 	d.Set("label", key.Label)
@@ -159,7 +161,7 @@ func resourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMComputeSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
@@ -167,7 +169,7 @@ func resourceIBMComputeSSHKeyUpdate(d *schema.ResourceData, meta interface{}) er
 
 	key, err := service.Id(keyID).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving SSH key: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving SSH key: %s", err))
 	}
 
 	if d.HasChange("label") {
@@ -180,24 +182,24 @@ func resourceIBMComputeSSHKeyUpdate(d *schema.ResourceData, meta interface{}) er
 
 	_, err = service.Id(keyID).EditObject(&key)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error editing SSH key: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error editing SSH key: %s", err))
 	}
-	return resourceIBMComputeSSHKeyRead(d, meta)
+	return resourceIBMComputeSSHKeyRead(ctx, d, meta)
 }
 
-func resourceIBMComputeSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting SSH Key: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting SSH Key: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting SSH key: %d", id)
 	_, err = service.Id(id).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting SSH key: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting SSH key: %s", err))
 	}
 
 	d.SetId("")
@@ -4,12 +4,14 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -18,12 +20,12 @@ import (
 
 func ResourceIBMComputeSSLCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeSSLCertificateCreate,
-		Read:     resourceIBMComputeSSLCertificateRead,
-		Update:   resourceIBMComputeSSLCertificateUpdate,
-		Delete:   resourceIBMComputeSSLCertificateDelete,
-		Exists:   resourceIBMComputeSSLCertificateExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeSSLCertificateCreate,
+		ReadContext:   resourceIBMComputeSSLCertificateRead,
+		UpdateContext: resourceIBMComputeSSLCertificateUpdate,
+		DeleteContext: resourceIBMComputeSSLCertificateDelete,
+		Exists:        resourceIBMComputeSSLCertificateExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -111,7 +113,7 @@ func ResourceIBMComputeSSLCertificate() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeSSLCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSLCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateService(sess.SetRetries(0))
 
@@ -126,27 +128,27 @@ func resourceIBMComputeSSLCertificateCreate(d *schema.ResourceData, meta interfa
 	cert, err := service.CreateObject(&template)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Security Certificate: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Security Certificate: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *cert.Id))
 
-	return resourceIBMComputeSSLCertificateRead(d, meta)
+	return resourceIBMComputeSSLCertificateRead(ctx, d, meta)
 }
 
-func resourceIBMComputeSSLCertificateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSLCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	cert, err := service.Id(id).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Unable  to get Security Certificate: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Unable  to get Security Certificate: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *cert.Id))
@@ -173,18 +175,18 @@ func resourceIBMComputeSSLCertificateRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceIBMComputeSSLCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSLCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Only tags are updated and that too locally hence nothing to validate and update in terms of real API at this point
 	return nil
 }
 
-func resourceIBMComputeSSLCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSLCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateService(sess)
 	id, err := strconv.Atoi(d.Id())
 	_, err = service.Id(id).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Security Certificate %d: %s", id, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Security Certificate %d: %s", id, err))
 	}
 
 	return nil
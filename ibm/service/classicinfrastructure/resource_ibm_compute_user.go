@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -23,12 +25,12 @@ const userCustomerCancelStatus = 1021
 
 func ResourceIBMComputeUser() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeUserCreate,
-		Read:     resourceIBMComputeUserRead,
-		Update:   resourceIBMComputeUserUpdate,
-		Delete:   resourceIBMComputeUserDelete,
-		Exists:   resourceIBMComputeUserExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeUserCreate,
+		ReadContext:   resourceIBMComputeUserRead,
+		UpdateContext: resourceIBMComputeUserUpdate,
+		DeleteContext: resourceIBMComputeUserDelete,
+		Exists:        resourceIBMComputeUserExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"username": {
@@ -163,19 +165,19 @@ func getPermissions(d *schema.ResourceData) []datatypes.User_Customer_CustomerPe
 	return permissions
 }
 
-func resourceIBMComputeUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetUserCustomerService(sess)
 	serviceNoRetry := services.GetUserCustomerService(sess.SetRetries(0))
 
 	timezoneID, err := getTimezoneIDByName(sess, d.Get("timezone").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userStatusID, err := getUserStatusIDByName(sess, d.Get("user_status").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Build up our creation options
@@ -208,7 +210,7 @@ func resourceIBMComputeUserCreate(d *schema.ResourceData, meta interface{}) erro
 	res, err := serviceNoRetry.CreateObject(&opts, pass, nil)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating IBM Cloud User: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating IBM Cloud User: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
@@ -228,12 +230,12 @@ func resourceIBMComputeUserCreate(d *schema.ResourceData, meta interface{}) erro
 
 	_, err = service.RemoveBulkPortalPermission(defaultPortalPermissions, sl.Bool(true))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error removing default portal permissions for IBM Cloud User: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error removing default portal permissions for IBM Cloud User: %s", err))
 	}
 
 	_, err = service.AddBulkPortalPermission(permissions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error setting portal permissions for IBM Cloud User: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting portal permissions for IBM Cloud User: %s", err))
 	}
 
 	create_api_key_flag := d.Get("has_api_key").(bool)
@@ -243,14 +245,14 @@ func resourceIBMComputeUserCreate(d *schema.ResourceData, meta interface{}) erro
 		// and not the edit method.
 		_, err = service.AddApiAuthenticationKey()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating API key: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating API key: %s", err))
 		}
 	}
 
-	return resourceIBMComputeUserRead(d, meta)
+	return resourceIBMComputeUserRead(ctx, d, meta)
 }
 
-func resourceIBMComputeUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service := services.GetUserCustomerService(meta.(conns.ClientSession).SoftLayerSession())
 	userID, _ := strconv.Atoi(d.Id())
 
@@ -282,7 +284,7 @@ func resourceIBMComputeUserRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 
-		return fmt.Errorf("[ERROR] Error retrieving IBM Cloud User: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving IBM Cloud User: %s", err))
 	}
 
 	d.Set("username", sluserObj.Username)
@@ -320,7 +322,7 @@ func resourceIBMComputeUserRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMComputeUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetUserCustomerService(sess)
@@ -383,21 +385,21 @@ func resourceIBMComputeUserUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("timezone") {
 		tzID, err := getTimezoneIDByName(sess, d.Get("timezone").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		userObj.TimezoneId = &tzID
 	}
 	if d.HasChange("user_status") {
 		userStatusID, err := getUserStatusIDByName(sess, d.Get("user_status").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		userObj.UserStatusId = &userStatusID
 	}
 
 	_, err = serviceNoRetry.Id(sluid).EditObject(&userObj)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error received while editing ibm_compute_user: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error received while editing ibm_compute_user: %s", err))
 	}
 
 	if d.HasChange("permissions") {
@@ -423,13 +425,13 @@ func resourceIBMComputeUserUpdate(d *schema.ResourceData, meta interface{}) erro
 		// 'remove' all old permissions
 		_, err = service.RemoveBulkPortalPermission(oldPermissions, sl.Bool(true))
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error received while removing old permissions from ibm_compute_user: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error received while removing old permissions from ibm_compute_user: %s", err))
 		}
 
 		// 'add' new permission set
 		_, err = service.AddBulkPortalPermission(newPermissions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error received while assigning new permissions to ibm_compute_user: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error received while assigning new permissions to ibm_compute_user: %s", err))
 		}
 	}
 
@@ -446,7 +448,7 @@ func resourceIBMComputeUserUpdate(d *schema.ResourceData, meta interface{}) erro
 			if len(keys) == 0 { // means key does not exist, so create one.
 				key, err := service.AddApiAuthenticationKey()
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error creating API key while editing ibm_compute_user resource: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error creating API key while editing ibm_compute_user resource: %s", err))
 				}
 
 				d.Set("api_key", key)
@@ -458,13 +460,13 @@ func resourceIBMComputeUserUpdate(d *schema.ResourceData, meta interface{}) erro
 			if len(keys) > 0 {
 				success, err := service.RemoveApiAuthenticationKey(keys[0].Id)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting API key while editing ibm_compute_user resource: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting API key while editing ibm_compute_user resource: %s", err))
 				}
 
 				if !success {
-					return fmt.Errorf("[ERROR] The API reported removal of the api key was not successful for %s",
+					return diag.FromErr(fmt.Errorf("[ERROR] The API reported removal of the api key was not successful for %s",
 						d.Get("email").(string),
-					)
+					))
 				}
 			}
 			d.Set("api_key", nil)
@@ -473,7 +475,7 @@ func resourceIBMComputeUserUpdate(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMComputeUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetUserCustomerService(sess)
 
@@ -486,7 +488,7 @@ func resourceIBMComputeUserDelete(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[INFO] Deleting IBM Cloud user: %d", id)
 	_, err := service.Id(id).EditObject(&user)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting IBM Cloud user: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting IBM Cloud user: %s", err))
 	}
 
 	d.SetId("")
@@ -5,6 +5,7 @@ package classicinfrastructure
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
@@ -19,6 +20,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -65,12 +67,12 @@ const (
 
 func ResourceIBMComputeVmInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeVmInstanceCreate,
-		Read:     resourceIBMComputeVmInstanceRead,
-		Update:   resourceIBMComputeVmInstanceUpdate,
-		Delete:   resourceIBMComputeVmInstanceDelete,
-		Exists:   resourceIBMComputeVmInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeVmInstanceCreate,
+		ReadContext:   resourceIBMComputeVmInstanceRead,
+		UpdateContext: resourceIBMComputeVmInstanceUpdate,
+		DeleteContext: resourceIBMComputeVmInstanceDelete,
+		Exists:        resourceIBMComputeVmInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
@@ -1033,7 +1035,7 @@ func getVirtualGuestTemplateFromResourceData(d *schema.ResourceData, meta interf
 	return vms, nil
 }
 
-func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetVirtualGuestService(sess)
@@ -1057,11 +1059,11 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if dcName == "" && len(retryOptions) == 0 {
-		return fmt.Errorf("[ERROR] Provide  either `datacenter` or `datacenter_choice`")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide  either `datacenter` or `datacenter_choice`"))
 	}
 
 	if (d.Get("hostname").(string) == "" || d.Get("domain").(string) == "") && len(d.Get("bulk_vms").(*schema.Set).List()) == 0 {
-		return fmt.Errorf("[ERROR] Provide  either `hostname` and `domain` or `bulk_vms`")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide  either `hostname` and `domain` or `bulk_vms`"))
 	}
 
 	if dcName != "" {
@@ -1081,11 +1083,11 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 
 		err := validate.ValidateDatacenterOption(retryOptions, []string{"datacenter", "public_vlan_id", "private_vlan_id"})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, option := range retryOptions {
 			if option == nil {
-				return fmt.Errorf("[ERROR] Provide  a valid `datacenter_choice`")
+				return diag.FromErr(fmt.Errorf("[ERROR] Provide  a valid `datacenter_choice`"))
 			}
 			center := option.(map[string]interface{})
 			var publicVlan, privateVlan int
@@ -1094,7 +1096,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 			if v, ok := center["datacenter"]; ok {
 				name = v.(string)
 			} else {
-				return fmt.Errorf("Missing datacenter in `datacenter_choice`")
+				return diag.FromErr(fmt.Errorf("Missing datacenter in `datacenter_choice`"))
 			}
 
 			if v, ok := center["public_vlan_id"]; ok {
@@ -1113,7 +1115,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if err1 != nil {
-		return fmt.Errorf("[ERROR] Error ordering virtual guest: %s", err1)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error ordering virtual guest: %s", err1))
 	}
 
 	var idStrings []string
@@ -1136,7 +1138,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	for _, str := range idStrings {
 		id, err = strconv.Atoi(str)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		// Set tags
 		tags := getTags(d)
@@ -1144,7 +1146,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 			//Try setting only when it is non empty as we are creating virtual guest
 			err = setGuestTags(id, tags, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -1159,38 +1161,38 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 		if len(storageIds) > 0 {
 			err := addAccessToStorageList(service.Id(id), id, storageIds, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		// Set notes
 		err = setNotes(id, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// wait for machine availability
 
-		_, err = WaitForVirtualGuestAvailable(id, d, meta)
+		_, err = WaitForVirtualGuestAvailable(ctx, id, d, meta)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for virtual machine (%s) to become ready: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for virtual machine (%s) to become ready: %s", d.Id(), err))
 		}
 	}
 
-	return resourceIBMComputeVmInstanceRead(d, meta)
+	return resourceIBMComputeVmInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service := services.GetVirtualGuestService(meta.(conns.ClientSession).SoftLayerSession())
 	parts, err := flex.VmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).Mask(
@@ -1215,7 +1217,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 	).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving virtual guest: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving virtual guest: %s", err))
 	}
 
 	if len(parts) == 1 {
@@ -1226,7 +1228,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 		for _, part := range parts {
 			vmId, err := strconv.Atoi(part)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 			}
 			vmResult, err := service.Id(vmId).Mask(
 				"hostname,domain",
@@ -1422,7 +1424,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set(flex.ResourceName, *result.Hostname)
 	d.Set(flex.ResourceStatus, *result.Status.Name)
 	err = readSecondaryIPAddresses(d, meta, result.PrimaryIpAddress)
-	return err
+	return diag.FromErr(err)
 }
 
 func readSecondaryIPAddresses(d *schema.ResourceData, meta interface{}, primaryIPAddress *string) error {
@@ -1452,23 +1454,23 @@ func readSecondaryIPAddresses(d *schema.ResourceData, meta interface{}, primaryI
 	}
 	return nil
 }
-func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetVirtualGuestService(sess)
 
 	parts, err := flex.VmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving virtual guest: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving virtual guest: %s", err))
 	}
 
 	isChanged := false
@@ -1489,7 +1491,7 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 	if isChanged {
 		_, err = service.Id(id).EditObject(&result)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Could n't update virtual guest: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Could n't update virtual guest: %s", err))
 		}
 	}
 
@@ -1498,13 +1500,13 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 		tags := getTags(d)
 		err := setGuestTags(id, tags, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	err = modifyStorageAccess(service.Id(id), id, meta, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Upgrade "cores", "memory" and "network_speed" if provided and changed
@@ -1532,7 +1534,7 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 
 		//Remove is not supported for now.
 		if len(oldDisk) > len(newDisk) {
-			return fmt.Errorf("Removing drives is not supported.")
+			return diag.FromErr(fmt.Errorf("Removing drives is not supported."))
 		}
 
 		var diskName string
@@ -1568,30 +1570,30 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 			presetKeyName := d.Get("flavor_key_name").(string)
 			_, err = virtual.UpgradeVirtualGuestWithPreset(sess.SetRetries(0), &result, presetKeyName, upgradeOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Could n't upgrade virtual guest: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Could n't upgrade virtual guest: %s", err))
 			}
 
 		} else {
 			_, err = virtual.UpgradeVirtualGuest(sess.SetRetries(0), &result, upgradeOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Could n't upgrade virtual guest: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Could n't upgrade virtual guest: %s", err))
 			}
 		}
 
 		// Wait for softlayer to start upgrading...
-		_, err = WaitForUpgradeTransactionsToAppear(d, meta)
+		_, err = WaitForUpgradeTransactionsToAppear(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		// Wait for upgrade transactions to finish
-		_, err = WaitForNoActiveTransactions(id, d, d.Timeout(schema.TimeoutUpdate), meta)
+		_, err = WaitForNoActiveTransactions(ctx, id, d, d.Timeout(schema.TimeoutUpdate), meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceIBMComputeVmInstanceRead(d, meta)
+	return resourceIBMComputeVmInstanceRead(ctx, d, meta)
 }
 
 func modifyStorageAccess(sam storageAccessModifier, deviceID int, meta interface{}, d *schema.ResourceData) error {
@@ -1628,36 +1630,36 @@ func modifyStorageAccess(sam storageAccessModifier, deviceID int, meta interface
 	return nil
 }
 
-func resourceIBMComputeVmInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetVirtualGuestService(sess)
 	parts, err := flex.VmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, part := range parts {
 		id, err := strconv.Atoi(part)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 		}
 
-		_, err = WaitForNoActiveTransactions(id, d, d.Timeout(schema.TimeoutDelete), meta)
+		_, err = WaitForNoActiveTransactions(ctx, id, d, d.Timeout(schema.TimeoutDelete), meta)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error deleting virtual guest, couldn't wait for zero active transactions: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting virtual guest, couldn't wait for zero active transactions: %s", err))
 		}
 		err = detachSecurityGroupNetworkComponentBindings(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ok, err := service.Id(id).DeleteObject()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error deleting virtual guest: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting virtual guest: %s", err))
 		}
 
 		if !ok {
-			return fmt.Errorf(
-				"API reported it was unsuccessful in removing the virtual guest '%d'", id)
+			return diag.FromErr(fmt.Errorf(
+				"API reported it was unsuccessful in removing the virtual guest '%d'", id))
 		}
 	}
 
@@ -1730,7 +1732,7 @@ func genID() (interface{}, error) {
 }
 
 // WaitForUpgradeTransactionsToAppear Wait for upgrade transactions
-func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForUpgradeTransactionsToAppear(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to have upgrade transactions", d.Id())
 
 	parts, err := flex.VmIdParts(d.Id())
@@ -1766,11 +1768,11 @@ func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // WaitForNoActiveTransactions Wait for no active transactions
-func WaitForNoActiveTransactions(id int, d *schema.ResourceData, timeout time.Duration, meta interface{}) (interface{}, error) {
+func WaitForNoActiveTransactions(ctx context.Context, id int, d *schema.ResourceData, timeout time.Duration, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to have zero active transactions", d.Id())
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"retry", activeTransaction},
@@ -1794,11 +1796,11 @@ func WaitForNoActiveTransactions(id int, d *schema.ResourceData, timeout time.Du
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // WaitForVirtualGuestAvailable Waits for virtual guest creation
-func WaitForVirtualGuestAvailable(id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForVirtualGuestAvailable(ctx context.Context, id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to be available.", d.Id())
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	stateConf := &resource.StateChangeConf{
@@ -1810,7 +1812,7 @@ func WaitForVirtualGuestAvailable(id int, d *schema.ResourceData, meta interface
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func virtualGuestStateRefreshFunc(sess *session.Session, instanceID int, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -4,12 +4,14 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -18,12 +20,12 @@ import (
 
 func ResourceIBMDNSDomain() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSDomainExists,
-		Create:   resourceIBMDNSDomainCreate,
-		Read:     resourceIBMDNSDomainRead,
-		Update:   resourceIBMDNSDomainUpdate,
-		Delete:   resourceIBMDNSDomainDelete,
-		Importer: &schema.ResourceImporter{},
+		Exists:        resourceIBMDNSDomainExists,
+		CreateContext: resourceIBMDNSDomainCreate,
+		ReadContext:   resourceIBMDNSDomainRead,
+		UpdateContext: resourceIBMDNSDomainUpdate,
+		DeleteContext: resourceIBMDNSDomainDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -61,7 +63,7 @@ func ResourceIBMDNSDomain() *schema.Resource {
 	}
 }
 
-func resourceIBMDNSDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess.SetRetries(0))
 
@@ -86,7 +88,7 @@ func resourceIBMDNSDomainCreate(d *schema.ResourceData, meta interface{}) error
 	// create Dns_Domain object
 	response, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Dns Domain: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Dns Domain: %s", err))
 	}
 
 	// populate id
@@ -95,10 +97,10 @@ func resourceIBMDNSDomainCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Created Dns Domain: %d", id)
 
 	// read remote state
-	return resourceIBMDNSDomainRead(d, meta)
+	return resourceIBMDNSDomainRead(ctx, d, meta)
 }
 
-func resourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess)
 
@@ -109,7 +111,7 @@ func resourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
 		"id,name,updateDate,resourceRecords",
 	).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving Dns Domain %d: %s", dnsId, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Dns Domain %d: %s", dnsId, err))
 	}
 
 	// populate fields
@@ -128,7 +130,7 @@ func resourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// If the target has been updated, find the corresponding dns record and update its data
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
@@ -148,7 +150,7 @@ func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error
 		"id,name,updateDate,resourceRecords",
 	).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving DNS resource %d: %s", domainId, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving DNS resource %d: %s", domainId, err))
 	}
 
 	// find a record with host @; that will have the current target.
@@ -160,8 +162,8 @@ func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if record.Id == nil {
-		return fmt.Errorf("[ERROR] Could  not find DNS target record for domain %s (%d)",
-			sl.Get(domain.Name), sl.Get(domain.Id))
+		return diag.FromErr(fmt.Errorf("[ERROR] Could  not find DNS target record for domain %s (%d)",
+			sl.Get(domain.Name), sl.Get(domain.Id)))
 	}
 
 	record.Data = sl.String(newTarget)
@@ -169,30 +171,30 @@ func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error
 	_, err = service.Id(*record.Id).EditObject(&record)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error editing DNS target record for domain %s (%d): %s",
-			sl.Get(domain.Name), sl.Get(domain.Id), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error editing DNS target record for domain %s (%d): %s",
+			sl.Get(domain.Name), sl.Get(domain.Id), err))
 	}
 
 	return nil
 }
 
-func resourceIBMDNSDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess)
 
 	dnsId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Dns Domain: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Dns Domain: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting Dns Domain: %d", dnsId)
 	result, err := service.Id(dnsId).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Dns Domain: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Dns Domain: %s", err))
 	}
 
 	if !result {
-		return errors.New("[ERROR] Error deleting Dns Domain")
+		return diag.FromErr(errors.New("[ERROR] Error deleting Dns Domain"))
 	}
 
 	d.SetId("")
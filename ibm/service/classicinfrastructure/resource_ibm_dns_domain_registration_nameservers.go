@@ -4,22 +4,24 @@
 package classicinfrastructure
 
 import (
+	"context"
 	//"errors"
 	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
 )
 
 func ResourceIBMDNSDomainRegistrationNameservers() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMDNSDomainRegistrationNSCreate,
-		Read:   resourceIBMDNSDomainRegistrationNSRead,
-		Update: resourceIBMDNSDomainRegistrationNSUpdate,
-		Delete: resourceIBMDNSDomainRegistrationNSDelete,
+		CreateContext: resourceIBMDNSDomainRegistrationNSCreate,
+		ReadContext:   resourceIBMDNSDomainRegistrationNSRead,
+		UpdateContext: resourceIBMDNSDomainRegistrationNSUpdate,
+		DeleteContext: resourceIBMDNSDomainRegistrationNSDelete,
 		Schema: map[string]*schema.Schema{
 			"dns_registration_id": {
 				Type:        schema.TypeString,
@@ -46,7 +48,7 @@ func ResourceIBMDNSDomainRegistrationNameservers() *schema.Resource {
 	}
 }
 
-func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainRegistrationNSCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	nService := services.GetDnsDomainRegistrationService(sess)
 	dnsId, _ := strconv.Atoi(d.Get("dns_registration_id").(string))
@@ -58,11 +60,11 @@ func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta inter
 		GetDomainNameservers()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving domain Registration NSCreate: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving domain Registration NSCreate: %s", err))
 	}
 
 	if len(dns_domain_nameservers) == 0 {
-		return fmt.Errorf("[ERROR] No domain found with id NSCreate [%d]", dnsId)
+		return diag.FromErr(fmt.Errorf("[ERROR] No domain found with id NSCreate [%d]", dnsId))
 	}
 	oldNameServers := make([]string, len(dns_domain_nameservers[0].Nameservers))
 	for i, elem := range dns_domain_nameservers[0].Nameservers {
@@ -94,13 +96,13 @@ func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta inter
 	// So return at this point.
 	if len(addNs) == 0 {
 		d.SetId(fmt.Sprintf("%d", dnsId))
-		return resourceIBMDNSDomainRegistrationNSRead(d, meta)
+		return resourceIBMDNSDomainRegistrationNSRead(ctx, d, meta)
 	}
 
 	nsUnlock_res, err := nService.Id(dnsId).
 		UnlockDomain()
 	if err != nil || nsUnlock_res != true {
-		return fmt.Errorf("[ERROR] Error unlocking domain registration record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error unlocking domain registration record: %s", err))
 	}
 
 	nsAdd_res := false
@@ -108,7 +110,7 @@ func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta inter
 		AddNameserversToDomain(addNs)
 
 	if err != nil || nsAdd_res != true {
-		return fmt.Errorf("[ERROR] Error Adding name servers to record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Adding name servers to record: %s", err))
 	}
 
 	// old NS to delete, if not found in new list
@@ -134,7 +136,7 @@ func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta inter
 		RemoveNameserversFromDomain(delNs)
 
 	if err != nil || nsDel_res != true {
-		return fmt.Errorf("[ERROR] Error Deleting name servers from record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting name servers from record: %s", err))
 	}
 
 	_, _ = nService.Id(dnsId).LockDomain()
@@ -143,10 +145,10 @@ func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta inter
 	// save the original name servers now as not available on read
 	d.SetId(fmt.Sprintf("%d", dnsId))
 	d.Set("original_name_servers", oldNameServers)
-	return resourceIBMDNSDomainRegistrationNSRead(d, meta)
+	return resourceIBMDNSDomainRegistrationNSRead(ctx, d, meta)
 }
 
-func resourceIBMDNSDomainRegistrationNSRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainRegistrationNSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	dnsId, _ := strconv.Atoi(d.Id())
 	//service := services.GetDnsDomainService(sess)
@@ -157,11 +159,11 @@ func resourceIBMDNSDomainRegistrationNSRead(d *schema.ResourceData, meta interfa
 		GetDomainNameservers()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving domain registration NSReaD: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving domain registration NSReaD: %s", err))
 	}
 
 	if len(dns_domain_nameservers) == 0 {
-		return fmt.Errorf("[ERROR] No domain found with id [%d]", dnsId)
+		return diag.FromErr(fmt.Errorf("[ERROR] No domain found with id [%d]", dnsId))
 	}
 
 	log.Printf("list %v\n", dns_domain_nameservers)
@@ -173,7 +175,7 @@ func resourceIBMDNSDomainRegistrationNSRead(d *schema.ResourceData, meta interfa
 	log.Printf("names %v\n", ns)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving domain registration nameservers: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving domain registration nameservers: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", dnsId))
@@ -182,12 +184,12 @@ func resourceIBMDNSDomainRegistrationNSRead(d *schema.ResourceData, meta interfa
 }
 
 // No delete on IBM Cloud
-func resourceIBMDNSDomainRegistrationNSUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainRegistrationNSUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
 // No delete on IBM Cloud
-func resourceIBMDNSDomainRegistrationNSDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainRegistrationNSDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Exact reverse of create to restore name servers back to original values
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	nService := services.GetDnsDomainRegistrationService(sess)
@@ -218,13 +220,13 @@ func resourceIBMDNSDomainRegistrationNSDelete(d *schema.ResourceData, meta inter
 	// So return at this point.
 	if len(addNs) == 0 {
 		d.SetId(fmt.Sprintf("%d", dnsId))
-		return resourceIBMDNSDomainRegistrationNSRead(d, meta)
+		return resourceIBMDNSDomainRegistrationNSRead(ctx, d, meta)
 	}
 
 	nsUnlock_res, err := nService.Id(dnsId).
 		UnlockDomain()
 	if err != nil || nsUnlock_res != true {
-		return fmt.Errorf("[ERROR] Error unlocking domain registration record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error unlocking domain registration record: %s", err))
 	}
 
 	nsAdd_res := false
@@ -232,7 +234,7 @@ func resourceIBMDNSDomainRegistrationNSDelete(d *schema.ResourceData, meta inter
 		AddNameserversToDomain(addNs)
 
 	if err != nil || nsAdd_res != true {
-		return fmt.Errorf("[ERROR] Error Adding name servers to record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Adding name servers to record: %s", err))
 	}
 
 	// current NS to delete, if not found in original list
@@ -258,7 +260,7 @@ func resourceIBMDNSDomainRegistrationNSDelete(d *schema.ResourceData, meta inter
 		RemoveNameserversFromDomain(delNs)
 
 	if err != nil || nsDel_res != true {
-		return fmt.Errorf("[ERROR] Error Deleting name servers from record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting name servers from record: %s", err))
 	}
 
 	_, _ = nService.Id(dnsId).LockDomain()
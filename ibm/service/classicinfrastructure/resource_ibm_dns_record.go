@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -33,12 +35,12 @@ func init() {
 
 func ResourceIBMDNSRecord() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSRecordExists,
-		Create:   resourceIBMDNSRecordCreate,
-		Read:     resourceIBMDNSRecordRead,
-		Update:   resourceIBMDNSRecordUpdate,
-		Delete:   resourceIBMDNSRecordDelete,
-		Importer: &schema.ResourceImporter{},
+		Exists:        resourceIBMDNSRecordExists,
+		CreateContext: resourceIBMDNSRecordCreate,
+		ReadContext:   resourceIBMDNSRecordRead,
+		UpdateContext: resourceIBMDNSRecordUpdate,
+		DeleteContext: resourceIBMDNSRecordDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"data": {
 				Type:     schema.TypeString,
@@ -191,7 +193,7 @@ func ResourceIBMDNSRecord() *schema.Resource {
 
 //  Creates DNS Domain Resource Record
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/createObject
-func resourceIBMDNSRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess.SetRetries(0))
 
@@ -267,29 +269,29 @@ func resourceIBMDNSRecordCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating DNS Resource %s Record: %s", *opts.Type, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating DNS Resource %s Record: %s", *opts.Type, err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", id))
 
 	log.Printf("[INFO] Dns Resource %s Record ID: %s", *opts.Type, d.Id())
 
-	return resourceIBMDNSRecordRead(d, meta)
+	return resourceIBMDNSRecordRead(ctx, d, meta)
 }
 
 //  Reads DNS Domain Resource Record from SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/getObject
-func resourceIBMDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving DNS Resource Record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving DNS Resource Record: %s", err))
 	}
 
 	// Required fields
@@ -320,7 +322,7 @@ func resourceIBMDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
 
 //  Updates DNS Domain Resource Record in SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/editObject
-func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 	serviceNoRetry := services.GetDnsDomainResourceRecordService(sess.SetRetries(0))
@@ -328,7 +330,7 @@ func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error
 	recordId, _ := strconv.Atoi(d.Id())
 	record, err := service.Id(recordId).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving DNS Resource Record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving DNS Resource Record: %s", err))
 	}
 
 	recordType := d.Get("type").(string)
@@ -406,7 +408,7 @@ func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error editing DNS Resource %s Record %d: %s", recordType, recordId, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error editing DNS Resource %s Record %d: %s", recordType, recordId, err))
 	}
 
 	return nil
@@ -414,19 +416,19 @@ func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error
 
 //  Deletes DNS Domain Resource Record in SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/deleteObject
-func resourceIBMDNSRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	_, err = service.Id(id).DeleteObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting DNS Resource Record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting DNS Resource Record: %s", err))
 	}
 
 	return nil
@@ -4,11 +4,13 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
 	"github.com/softlayer/softlayer-go/sl"
@@ -16,12 +18,12 @@ import (
 
 func ResourceIBMDNSReverseRecord() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSREVERSERecordExists,
-		Create:   resourceIBMDNSREVERSERecordCreate,
-		Read:     resourceIBMDNSREVERSERecordRead,
-		Update:   resourceIBMDNSREVERSERecordUpdate,
-		Delete:   resourceIBMDNSREVERSERecordDelete,
-		Importer: &schema.ResourceImporter{},
+		Exists:        resourceIBMDNSREVERSERecordExists,
+		CreateContext: resourceIBMDNSREVERSERecordCreate,
+		ReadContext:   resourceIBMDNSREVERSERecordRead,
+		UpdateContext: resourceIBMDNSREVERSERecordUpdate,
+		DeleteContext: resourceIBMDNSREVERSERecordDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"ipaddress": {
 				Type:        schema.TypeString,
@@ -48,7 +50,7 @@ func ResourceIBMDNSReverseRecord() *schema.Resource {
 
 //  Creates DNS Domain Reverse Record
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain/CreatePtrRecord
-func resourceIBMDNSREVERSERecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSREVERSERecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess.SetRetries(0))
 	Data := sl.String(d.Get("hostname").(string))
@@ -61,40 +63,40 @@ func resourceIBMDNSREVERSERecordCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating DNS Reverse %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating DNS Reverse %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", id))
 	log.Printf("[INFO] Dns Reverse %s ", d.Id())
-	return resourceIBMDNSREVERSERecordRead(d, meta)
+	return resourceIBMDNSREVERSERecordRead(ctx, d, meta)
 }
 
 //  Reads DNS Domain Reverse Record from SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/getObject
-func resourceIBMDNSREVERSERecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSREVERSERecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	_, nexterr := service.Id(id).GetObject()
 	if nexterr != nil {
-		return fmt.Errorf("[ERROR] Error retrieving DNS Reverse Record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving DNS Reverse Record: %s", err))
 	}
 	return nil
 }
 
 //  Updates DNS Domain Reverse Record in SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/editObject
-func resourceIBMDNSREVERSERecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSREVERSERecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 	serviceNoRetry := services.GetDnsDomainResourceRecordService(sess.SetRetries(0))
 	recordId, _ := strconv.Atoi(d.Id())
 	record, err := service.Id(recordId).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving DNS Reverse Record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving DNS Reverse Record: %s", err))
 	}
 	if data, ok := d.GetOk("hostname"); ok && d.HasChange("hostname") {
 		record.Data = sl.String(data.(string))
@@ -105,24 +107,24 @@ func resourceIBMDNSREVERSERecordUpdate(d *schema.ResourceData, meta interface{})
 	record.IsGatewayAddress = nil
 	_, err = serviceNoRetry.Id(recordId).EditObject(&record)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error editing DNS Reverse  Record %d: %s", recordId, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error editing DNS Reverse  Record %d: %s", recordId, err))
 	}
 	return nil
 }
 
 //  Deletes DNS Domain Reverse Record in SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/deleteObject
-func resourceIBMDNSREVERSERecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSREVERSERecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 	_, err = service.Id(id).DeleteObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting DNS Reverse Record: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting DNS Reverse Record: %s", err))
 	}
 	return nil
 }
@@ -4,12 +4,14 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -18,12 +20,12 @@ import (
 
 func ResourceIBMDNSSecondary() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSSecondaryExists,
-		Create:   resourceIBMDNSSecondaryCreate,
-		Read:     resourceIBMDNSSecondaryRead,
-		Update:   resourceIBMDNSSecondaryUpdate,
-		Delete:   resourceIBMDNSSecondaryDelete,
-		Importer: &schema.ResourceImporter{},
+		Exists:        resourceIBMDNSSecondaryExists,
+		CreateContext: resourceIBMDNSSecondaryCreate,
+		ReadContext:   resourceIBMDNSSecondaryRead,
+		UpdateContext: resourceIBMDNSSecondaryUpdate,
+		DeleteContext: resourceIBMDNSSecondaryDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"master_ip_address": {
 				Type:        schema.TypeString,
@@ -67,7 +69,7 @@ func ResourceIBMDNSSecondary() *schema.Resource {
 	}
 }
 
-func resourceIBMDNSSecondaryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsSecondaryService(sess)
 
@@ -81,7 +83,7 @@ func resourceIBMDNSSecondaryCreate(d *schema.ResourceData, meta interface{}) err
 	// create Dns_Secondary object
 	response, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Dns Secondary Zone: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Dns Secondary Zone: %s", err))
 	}
 
 	// populate id
@@ -90,10 +92,10 @@ func resourceIBMDNSSecondaryCreate(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[INFO] Created Dns Secondary Zone: %d", id)
 
 	// read remote state
-	return resourceIBMDNSSecondaryRead(d, meta)
+	return resourceIBMDNSSecondaryRead(ctx, d, meta)
 }
 
-func resourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsSecondaryService(sess)
 
@@ -102,7 +104,7 @@ func resourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) error
 	// retrieve remote object state
 	dns_domain_secondary, err := service.Id(dnsId).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving Dns Secondary Zone %d: %s", dnsId, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Dns Secondary Zone %d: %s", dnsId, err))
 	}
 
 	// populate fields
@@ -115,7 +117,7 @@ func resourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMDNSSecondaryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	domainId, _ := strconv.Atoi(d.Id())
 	hasChange := false
@@ -136,30 +138,30 @@ func resourceIBMDNSSecondaryUpdate(d *schema.ResourceData, meta interface{}) err
 		_, err := service.Id(domainId).EditObject(&opts)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error editing DNS secondary zone (%d): %s", domainId, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error editing DNS secondary zone (%d): %s", domainId, err))
 		}
 	}
 
 	return nil
 }
 
-func resourceIBMDNSSecondaryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetDnsSecondaryService(sess)
 
 	dnsId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Dns Secondary Zone: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Dns Secondary Zone: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting Dns Secondary Zone: %d", dnsId)
 	result, err := service.Id(dnsId).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Dns Secondary Zone: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Dns Secondary Zone: %s", err))
 	}
 
 	if !result {
-		return errors.New("[ERROR] Error deleting Dns Secondary Zone")
+		return diag.FromErr(errors.New("[ERROR] Error deleting Dns Secondary Zone"))
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -33,12 +35,12 @@ const (
 
 func ResourceIBMFirewall() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFirewallCreate,
-		Read:     resourceIBMFirewallRead,
-		Update:   resourceIBMFirewallUpdate,
-		Delete:   resourceIBMFirewallDelete,
-		Exists:   resourceIBMFirewallExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFirewallCreate,
+		ReadContext:   resourceIBMFirewallRead,
+		UpdateContext: resourceIBMFirewallUpdate,
+		DeleteContext: resourceIBMFirewallDelete,
+		Exists:        resourceIBMFirewallExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"firewall_type": {
@@ -97,7 +99,7 @@ func ResourceIBMFirewall() *schema.Resource {
 	}
 }
 
-func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	keyName := "HARDWARE_FIREWALL_DEDICATED"
@@ -117,13 +119,13 @@ func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
 
 	pkg, err := product.GetPackageByType(sess, FwHardwareDedicatedPackageType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Get all prices for ADDITIONAL_SERVICES_FIREWALL with the given capacity
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Select only those product items with a matching keyname
@@ -135,7 +137,7 @@ func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if len(targetItems) == 0 {
-		return fmt.Errorf("[ERROR] No product items matching %s could be found", keyName)
+		return diag.FromErr(fmt.Errorf("[ERROR] No product items matching %s could be found", keyName))
 	}
 
 	productOrderContainer := datatypes.Container_Product_Order_Network_Protection_Firewall_Dedicated{
@@ -156,11 +158,11 @@ func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(&productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall: %s", err))
 	}
-	vlan, _, _, err := findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d)
+	vlan, _, _, err := findDedicatedFirewallByOrderId(ctx, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall: %s", err))
 	}
 
 	id := *vlan.NetworkVlanFirewall.Id
@@ -176,14 +178,14 @@ func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
 		//Try setting only when it is non empty as we are creating Firewall
 		err = setFirewallTags(id, tags, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMFirewallRead(d, meta)
+	return resourceIBMFirewallRead(ctx, d, meta)
 }
 
-func resourceIBMFirewallRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	fwID, _ := strconv.Atoi(d.Id())
@@ -194,7 +196,7 @@ func resourceIBMFirewallRead(d *schema.ResourceData, meta interface{}) error {
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving firewall information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving firewall information: %s", err))
 	}
 
 	d.Set("public_vlan_id", *fw.NetworkVlan.Id)
@@ -219,11 +221,11 @@ func resourceIBMFirewallRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	fwID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid firewall ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid firewall ID, must be an integer: %s", err))
 	}
 
 	// Update tags
@@ -231,13 +233,13 @@ func resourceIBMFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
 		tags := getTags(d)
 		err := setFirewallTags(fwID, tags, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMFirewallRead(d, meta)
+	return resourceIBMFirewallRead(ctx, d, meta)
 }
 
-func resourceIBMFirewallDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	fwService := services.GetNetworkVlanFirewallService(sess)
 
@@ -247,20 +249,20 @@ func resourceIBMFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 	billingItem, err := fwService.Id(fwID).GetBillingItem()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while looking up billing item associated with the firewall: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while looking up billing item associated with the firewall: %s", err))
 	}
 
 	if billingItem.Id == nil {
-		return fmt.Errorf("[ERROR] Error while looking up billing item associated with the firewall: No billing item for ID:%d", fwID)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while looking up billing item associated with the firewall: No billing item for ID:%d", fwID))
 	}
 
 	success, err := services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diag.FromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 
 	return nil
@@ -288,7 +290,7 @@ func resourceIBMFirewallExists(d *schema.ResourceData, meta interface{}) (bool,
 	return true, nil
 }
 
-func findDedicatedFirewallByOrderId(sess *session.Session, orderId int, d *schema.ResourceData) (datatypes.Network_Vlan, datatypes.Network_Gateway, datatypes.Product_Upgrade_Request, error) {
+func findDedicatedFirewallByOrderId(ctx context.Context, sess *session.Session, orderId int, d *schema.ResourceData) (datatypes.Network_Vlan, datatypes.Network_Gateway, datatypes.Product_Upgrade_Request, error) {
 	filterPath := "networkVlans.networkVlanFirewall.billingItem.orderItem.order.id"
 	multivlanfilterpath := "networkGateways.networkFirewall.billingItem.orderItem.order.id"
 	var vlans []datatypes.Network_Vlan
@@ -348,7 +350,7 @@ func findDedicatedFirewallByOrderId(sess *session.Session, orderId int, d *schem
 		NotFoundChecks: 24 * 60,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Vlan{}, datatypes.Network_Gateway{}, datatypes.Product_Upgrade_Request{}, err
@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"strconv"
@@ -13,6 +14,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -26,12 +28,12 @@ const (
 
 func ResourceIBMFirewallPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFirewallPolicyCreate,
-		Read:     resourceIBMFirewallPolicyRead,
-		Update:   resourceIBMFirewallPolicyUpdate,
-		Delete:   resourceIBMFirewallPolicyDelete,
-		Exists:   resourceIBMFirewallPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFirewallPolicyCreate,
+		ReadContext:   resourceIBMFirewallPolicyRead,
+		UpdateContext: resourceIBMFirewallPolicyUpdate,
+		DeleteContext: resourceIBMFirewallPolicyDelete,
+		Exists:        resourceIBMFirewallPolicyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"firewall_id": {
@@ -159,7 +161,7 @@ func getFirewallContextAccessControlListId(fwId int, sess *session.Session) (int
 	return 0, fmt.Errorf("[ERROR] No firewallContextAccessControlListId")
 }
 
-func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	fwId := d.Get("firewall_id").(int)
@@ -167,7 +169,7 @@ func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) e
 
 	fwContextACLId, err := getFirewallContextAccessControlListId(fwId, sess)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall rules: %s", err))
 	}
 
 	ruleTemplate := datatypes.Network_Firewall_Update_Request{
@@ -179,7 +181,7 @@ func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) e
 
 	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall rules: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(fwId))
@@ -188,10 +190,10 @@ func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] Wait one minute for applying the rules.")
 	time.Sleep(time.Minute)
 
-	return resourceIBMFirewallPolicyRead(d, meta)
+	return resourceIBMFirewallPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	fwRulesID, _ := strconv.Atoi(d.Id())
@@ -202,7 +204,7 @@ func resourceIBMFirewallPolicyRead(d *schema.ResourceData, meta interface{}) err
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving firewall rules: %s", err))
 	}
 
 	rules := make([]map[string]interface{}, 0, len(fw.Rules))
@@ -259,18 +261,18 @@ func appendAnyOpenRule(rules []datatypes.Network_Firewall_Update_Request_Rule, p
 	return append(rules, ruleAnyOpen, ruleAnyOpenIpv6)
 }
 
-func resourceIBMFirewallPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	fwId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid firewall ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid firewall ID, must be an integer: %s", err))
 	}
 	rules := prepareRules(d)
 
 	fwContextACLId, err := getFirewallContextAccessControlListId(fwId, sess)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during updating of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during updating of dedicated hardware firewall rules: %s", err))
 	}
 
 	ruleTemplate := datatypes.Network_Firewall_Update_Request{
@@ -282,23 +284,23 @@ func resourceIBMFirewallPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 
 	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during updating of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during updating of dedicated hardware firewall rules: %s", err))
 	}
 	time.Sleep(time.Minute)
 
-	return resourceIBMFirewallPolicyRead(d, meta)
+	return resourceIBMFirewallPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	fwId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid firewall ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid firewall ID, must be an integer: %s", err))
 	}
 
 	fwContextACLId, err := getFirewallContextAccessControlListId(fwId, sess)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during deleting of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during deleting of dedicated hardware firewall rules: %s", err))
 	}
 
 	ruleTemplate := datatypes.Network_Firewall_Update_Request{
@@ -317,7 +319,7 @@ func resourceIBMFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) e
 
 	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during deleting of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during deleting of dedicated hardware firewall rules: %s", err))
 	}
 	time.Sleep(time.Minute)
 
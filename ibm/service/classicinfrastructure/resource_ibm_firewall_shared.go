@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -25,11 +27,11 @@ const (
 
 func ResourceIBMFirewallShared() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFirewallSharedCreate,
-		Read:     resourceIBMFirewallSharedRead,
-		Delete:   resourceIBMFirewallSharedDelete,
-		Exists:   resourceIBMFirewallSharedExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFirewallSharedCreate,
+		ReadContext:   resourceIBMFirewallSharedRead,
+		DeleteContext: resourceIBMFirewallSharedDelete,
+		Exists:        resourceIBMFirewallSharedExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -69,7 +71,7 @@ func ResourceIBMFirewallShared() *schema.Resource {
 
 // keyName is in between:[10MBPS_HARDWARE_FIREWALL, 20MBPS_HARDWARE_FIREWALL,
 //                         100MBPS_HARDWARE_FIREWALL, 1000MBPS_HARDWARE_FIREWALL]
-func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallSharedCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	keyName := d.Get("firewall_type").(string)
@@ -84,19 +86,19 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if virtualId == 0 && hardwareId == 0 {
-		return fmt.Errorf("[ERROR] Provide  either `virtual_instance_id` or `hardware_instance_id`")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide  either `virtual_instance_id` or `hardware_instance_id`"))
 	}
 
 	//var productOrderContainer *string
 	pkg, err := product.GetPackageByType(sess, FwHardwarePackageType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Get all prices for ADDITIONAL_SERVICES_FIREWALL with the given capacity
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Select only those product items with a matching keyname
@@ -108,7 +110,7 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if len(targetItems) == 0 {
-		return fmt.Errorf("[ERROR] No product items matching %s could be found", keyName)
+		return diag.FromErr(fmt.Errorf("[ERROR] No product items matching %s could be found", keyName))
 	}
 
 	masked := "id,firewallServiceComponent[id,status]"
@@ -156,9 +158,9 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 			NotFoundChecks: 24 * 60,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		result, err := service.Id(virtualId).Mask(masked).GetObject()
@@ -167,7 +169,7 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 		d.SetId(fmt.Sprintf("%d", idd))
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error during creation of hardware firewall: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of hardware firewall: %s", err))
 		}
 
 	}
@@ -216,9 +218,9 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 			NotFoundChecks: 24 * 60,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resultNew, err := service.Id(hardwareId).Mask(masked).GetObject()
@@ -227,16 +229,16 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 		d.SetId(fmt.Sprintf("%d", idd2))
 		log.Print(idd2)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error during creation of hardware firewall: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of hardware firewall: %s", err))
 		}
 
 	}
 	log.Println("[INFO] Creating hardware firewall shared")
 
-	return resourceIBMFirewallSharedRead(d, meta)
+	return resourceIBMFirewallSharedRead(ctx, d, meta)
 }
 
-func resourceIBMFirewallSharedRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallSharedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	firewall_type := (d.Get("firewall_type").(string))
@@ -249,25 +251,25 @@ func resourceIBMFirewallSharedRead(d *schema.ResourceData, meta interface{}) err
 	data, err := fservice.Id(fwID).Mask("billingItem.id").GetObject()
 	d.Set("billing_item_id", *data.BillingItem.Id)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of hardware firewall: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of hardware firewall: %s", err))
 	}
 
 	return nil
 }
 
 //detach hardware firewall from particular machine
-func resourceIBMFirewallSharedDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallSharedDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	idd2 := (d.Get("billing_item_id")).(int)
 
 	success, err := services.GetBillingItemService(sess).Id(idd2).CancelService()
 	log.Print(success)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diag.FromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 	return nil
 }
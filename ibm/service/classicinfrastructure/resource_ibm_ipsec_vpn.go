@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -26,12 +28,12 @@ import (
 
 func ResourceIBMIPSecVPN() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIPSecVpnCreate,
-		Read:     resourceIBMIPSecVPNRead,
-		Delete:   resourceIBMIPSecVPNDelete,
-		Update:   resourceIBMIPSecVPNUpdate,
-		Exists:   resourceIBMIPSecVPNExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIPSecVpnCreate,
+		ReadContext:   resourceIBMIPSecVPNRead,
+		DeleteContext: resourceIBMIPSecVPNDelete,
+		UpdateContext: resourceIBMIPSecVPNUpdate,
+		Exists:        resourceIBMIPSecVPNExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"datacenter": {
@@ -196,14 +198,14 @@ const (
 	ipsecMask = "billingItem.orderItem.order.id,serviceSubnets,staticRouteSubnets"
 )
 
-func resourceIBMIPSecVpnCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIPSecVpnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	datacenter := d.Get("datacenter").(string)
 	dc, err := location.GetDatacenterByName(sess, datacenter, "id")
 	locationid := strconv.Itoa(*dc.Id)
 	packageid := 0
 	if err != nil {
-		return fmt.Errorf("[ERROR] Something not found")
+		return diag.FromErr(fmt.Errorf("[ERROR] Something not found"))
 	}
 	locationservice := services.GetLocationService(sess)
 	priceidds, _ := locationservice.Id(*dc.Id).GetPriceGroups()
@@ -230,26 +232,26 @@ func resourceIBMIPSecVpnCreate(d *schema.ResourceData, meta interface{}) error {
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		VerifyOrder(&IPSecOrder)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during Verify order for Creating: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during Verify order for Creating: %s", err))
 	}
 
 	//Calling place order
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(&IPSecOrder, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during Place order for Creating: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during Place order for Creating: %s", err))
 	}
-	vpn, _ := findIPSecVpnByOrderID(sess, *receipt.OrderId, d)
+	vpn, _ := findIPSecVpnByOrderID(ctx, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of IPSec VPN: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of IPSec VPN: %s", err))
 	}
 	id := *vpn.Id
 	d.SetId(fmt.Sprintf("%d", id))
 	log.Printf("[INFO] IPSec VPN ID: %s", d.Id())
-	return resourceIBMIPSecVPNUpdate(d, meta)
+	return resourceIBMIPSecVPNUpdate(ctx, d, meta)
 }
 
-func findIPSecVpnByOrderID(sess *session.Session, orderID int, d *schema.ResourceData) (datatypes.Network_Tunnel_Module_Context, error) {
+func findIPSecVpnByOrderID(ctx context.Context, sess *session.Session, orderID int, d *schema.ResourceData) (datatypes.Network_Tunnel_Module_Context, error) {
 	filterPath := "networkTunnelContexts.billingItem.orderItem.order.id"
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
@@ -278,7 +280,7 @@ func findIPSecVpnByOrderID(sess *session.Session, orderID int, d *schema.Resourc
 		NotFoundChecks: 24 * 60,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Tunnel_Module_Context{}, err
@@ -292,7 +294,7 @@ func findIPSecVpnByOrderID(sess *session.Session, orderID int, d *schema.Resourc
 		fmt.Errorf("[ERROR] Cannot find IPSec Vpn with order id '%d'", orderID)
 }
 
-func resourceIBMIPSecVPNRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIPSecVPNRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	vpnID, _ := strconv.Atoi(d.Id())
 
@@ -300,7 +302,7 @@ func resourceIBMIPSecVPNRead(d *schema.ResourceData, meta interface{}) error {
 		Id(vpnID).Mask(ipsecMask).
 		GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving firewall information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving firewall information: %s", err))
 	}
 	d.Set("name", *vpn.Name)
 	d.Set("internal_peer_ip_address", *vpn.InternalPeerIpAddress)
@@ -356,7 +358,7 @@ func resourceIBMIPSecVPNExists(d *schema.ResourceData, meta interface{}) (bool,
 	return true, nil
 }
 
-func resourceIBMIPSecVPNDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIPSecVPNDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	vpnService := services.GetNetworkTunnelModuleContextService(sess)
 
@@ -366,31 +368,31 @@ func resourceIBMIPSecVPNDelete(d *schema.ResourceData, meta interface{}) error {
 	billingItem, err := vpnService.Id(vpnID).GetBillingItem()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while looking up billing item associated with the ipsecvpn: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while looking up billing item associated with the ipsecvpn: %s", err))
 	}
 
 	if billingItem.Id == nil {
-		return fmt.Errorf("[ERROR] Error while looking up billing item associated with the ipsecvpn: No billing item for ID:%d", vpnID)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while looking up billing item associated with the ipsecvpn: No billing item for ID:%d", vpnID))
 	}
 
 	success, err := services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diag.FromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 
 	return nil
 }
 
-func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIPSecVPNUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	vpnID, err := strconv.Atoi(d.Id())
 	var addresstranslation datatypes.Network_Tunnel_Module_Context_Address_Translation
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	vpn, err := services.GetNetworkTunnelModuleContextService(sess).
@@ -398,7 +400,7 @@ func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 	}
 	if d.HasChange("phase_one") {
 		for _, e := range d.Get("phase_one").([]interface{}) {
@@ -437,28 +439,28 @@ func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		_, err = services.GetNetworkTunnelModuleContextService(sess).Id(vpnID).EditObject(&vpn)
 		if err != nil {
-			return fmt.Errorf("SoftLayer reported an unsuccessful edit")
+			return diag.FromErr(fmt.Errorf("SoftLayer reported an unsuccessful edit"))
 		}
 	}
 	if d.HasChange("internal_subnet_id") {
 		subnetid := d.Get("internal_subnet_id").(int)
 		_, err = services.GetNetworkTunnelModuleContextService(sess).AddPrivateSubnetToNetworkTunnel(&subnetid)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Unable  to find object with id of: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Unable  to find object with id of: %s", err))
 		}
 	}
 	if d.HasChange("remote_subnet_id") {
 		subnetid := d.Get("remote_subnet_id").(int)
 		_, err = services.GetNetworkTunnelModuleContextService(sess).AddCustomerSubnetToNetworkTunnel(&subnetid)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Unable  to find object with id of: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Unable  to find object with id of: %s", err))
 		}
 	}
 	if d.HasChange("service_subnet_id") {
 		subnetid := d.Get("service_subnet_id").(int)
 		_, err = services.GetNetworkTunnelModuleContextService(sess).AddServiceSubnetToNetworkTunnel(&subnetid)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Unable  to find object with id of: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Unable  to find object with id of: %s", err))
 		}
 	}
 	if d.HasChange("address_translation") {
@@ -473,7 +475,7 @@ func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		_, err = services.GetNetworkTunnelModuleContextService(sess).Id(vpnID).CreateAddressTranslation(&addresstranslation)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Unable  to create the address translation: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Unable  to create the address translation: %s", err))
 		}
 	}
 	if d.HasChange("remote_subnet") {
@@ -490,11 +492,11 @@ func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
 			remoteSubnet.AccountId = &accountID
 			subnet, err := services.GetNetworkCustomerSubnetService(sess).Id(vpnID).CreateObject(&remoteSubnet)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Expected error occured creating the customer subnet resource %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Expected error occured creating the customer subnet resource %s", err))
 			}
 			_, err = services.GetNetworkTunnelModuleContextService(sess).Id(vpnID).AddCustomerSubnetToNetworkTunnel(subnet.Id)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Expected error occured adding the customer subnet to the network tunnel module %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Expected error occured adding the customer subnet to the network tunnel module %s", err))
 			}
 
 		}
@@ -503,14 +505,14 @@ func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, err = services.GetNetworkTunnelModuleContextService(sess).Id(vpnID).ApplyConfigurationsToDevice()
 		if err != nil {
-			return fmt.Errorf("[ERROR] There is some erorr applying the configuration %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] There is some erorr applying the configuration %s", err))
 		}
 	} else if _, ok := d.GetOk("remote_subnet"); ok {
 		_, err = services.GetNetworkTunnelModuleContextService(sess).Id(vpnID).ApplyConfigurationsToDevice()
 		if err != nil {
-			return fmt.Errorf("[ERROR] There is some erorr applying the configuration %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] There is some erorr applying the configuration %s", err))
 		}
 	}
 
-	return resourceIBMIPSecVPNRead(d, meta)
+	return resourceIBMIPSecVPNRead(ctx, d, meta)
 }
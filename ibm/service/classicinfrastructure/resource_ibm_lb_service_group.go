@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,6 +15,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -25,12 +27,12 @@ import (
 
 func ResourceIBMLbServiceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbServiceGroupCreate,
-		Read:     resourceIBMLbServiceGroupRead,
-		Update:   resourceIBMLbServiceGroupUpdate,
-		Delete:   resourceIBMLbServiceGroupDelete,
-		Exists:   resourceIBMLbServiceGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbServiceGroupCreate,
+		ReadContext:   resourceIBMLbServiceGroupRead,
+		UpdateContext: resourceIBMLbServiceGroupUpdate,
+		DeleteContext: resourceIBMLbServiceGroupDelete,
+		Exists:        resourceIBMLbServiceGroupExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"virtual_server_id": {
@@ -86,19 +88,19 @@ func ResourceIBMLbServiceGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	vipID := d.Get("load_balancer_id").(int)
 
 	routingMethodID, err := getRoutingMethodId(sess, d.Get("routing_method").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routingTypeID, err := getRoutingTypeId(sess, d.Get("routing_type").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	timeout := d.Get("timeout").(int)
@@ -121,10 +123,10 @@ func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Creating load balancer service group")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(ctx, sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating load balancer service group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating load balancer service group: %s", err))
 	}
 
 	// Retrieve the newly created object, to obtain its ID
@@ -135,7 +137,7 @@ func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) e
 		GetVirtualServers()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving load balancer: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*vs[0].Id))
@@ -143,9 +145,9 @@ func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[INFO] Load Balancer Service Group ID: %s", d.Id())
 
-	return resourceIBMLbServiceGroupRead(d, meta)
+	return resourceIBMLbServiceGroupRead(ctx, d, meta)
 }
-func resourceIBMLbServiceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	vipID := d.Get("load_balancer_id").(int)
@@ -154,12 +156,12 @@ func resourceIBMLbServiceGroupUpdate(d *schema.ResourceData, meta interface{}) e
 
 	routingMethodId, err := getRoutingMethodId(sess, d.Get("routing_method").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routingTypeId, err := getRoutingTypeId(sess, d.Get("routing_type").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vip := datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{
@@ -187,16 +189,16 @@ func resourceIBMLbServiceGroupUpdate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Updating load balancer service group")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(ctx, sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating load balancer service group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating load balancer service group: %s", err))
 	}
 
-	return resourceIBMLbServiceGroupRead(d, meta)
+	return resourceIBMLbServiceGroupRead(ctx, d, meta)
 }
 
-func resourceIBMLbServiceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	vsID, _ := strconv.Atoi(d.Id())
@@ -207,7 +209,7 @@ func resourceIBMLbServiceGroupRead(d *schema.ResourceData, meta interface{}) err
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving load balancer: %s", err))
 	}
 
 	d.Set("allocation", vs.Allocation)
@@ -221,7 +223,7 @@ func resourceIBMLbServiceGroupRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMLbServiceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	vsID, _ := strconv.Atoi(d.Id())
@@ -259,10 +261,10 @@ func resourceIBMLbServiceGroupDelete(d *schema.ResourceData, meta interface{}) e
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting service: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting service: %s", err))
 	}
 
 	return nil
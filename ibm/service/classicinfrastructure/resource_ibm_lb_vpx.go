@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -13,6 +14,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -31,12 +33,12 @@ const (
 
 func ResourceIBMLbVpx() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbVpxCreate,
-		Read:     resourceIBMLbVpxRead,
-		Update:   resourceIBMLbVpxUpdate,
-		Delete:   resourceIBMLbVpxDelete,
-		Exists:   resourceIBMLbVpxExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbVpxCreate,
+		ReadContext:   resourceIBMLbVpxRead,
+		UpdateContext: resourceIBMLbVpxUpdate,
+		DeleteContext: resourceIBMLbVpxDelete,
+		Exists:        resourceIBMLbVpxExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -274,7 +276,7 @@ func findVPXPriceItems(version string, speed int, plan string, ipCount int, meta
 	}, nil
 }
 
-func findVPXByOrderId(orderId int, meta interface{}) (datatypes.Network_Application_Delivery_Controller, error) {
+func findVPXByOrderId(ctx context.Context, orderId int, meta interface{}) (datatypes.Network_Application_Delivery_Controller, error) {
 	service := services.GetAccountService(meta.(conns.ClientSession).SoftLayerSession())
 
 	stateConf := &resource.StateChangeConf{
@@ -304,7 +306,7 @@ func findVPXByOrderId(orderId int, meta interface{}) (datatypes.Network_Applicat
 		MinTimeout: 10 * time.Second,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Application_Delivery_Controller{}, err
@@ -365,7 +367,7 @@ func prepareHardwareOptions(d *schema.ResourceData, meta interface{}) ([]datatyp
 	return hardwareOpts, nil
 }
 
-func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	NADCService := services.GetNetworkApplicationDeliveryControllerService(sess)
 	productOrderService := services.GetProductOrderService(sess.SetRetries(0))
@@ -384,7 +386,7 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 		meta)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Cannot find Application Delivery Controller prices '%s'", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Cannot find Application Delivery Controller prices '%s'", err))
 	}
 
 	datacenter := d.Get("datacenter").(string)
@@ -392,14 +394,14 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	if len(datacenter) > 0 {
 		datacenter, err := location.GetDatacenterByName(sess, datacenter, "id")
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating network application delivery controller: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating network application delivery controller: %s", err))
 		}
 		opts.Location = sl.String(strconv.Itoa(*datacenter.Id))
 	}
 
 	opts.Hardware, err = prepareHardwareOptions(d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Cannot get hardware options '%s'", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Cannot get hardware options '%s'", err))
 	}
 
 	log.Println("[INFO] Creating network application delivery controller")
@@ -407,14 +409,14 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	receipt, err := productOrderService.PlaceOrder(&opts, sl.Bool(false))
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating network application delivery controller: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating network application delivery controller: %s", err))
 	}
 
 	// Wait VPX provisioning
-	VPX, err := findVPXByOrderId(*receipt.OrderId, meta)
+	VPX, err := findVPXByOrderId(ctx, *receipt.OrderId, meta)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating network application delivery controller: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating network application delivery controller: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *VPX.Id))
@@ -423,7 +425,7 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	// Wait Virtual IP provisioning
@@ -432,7 +434,7 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	for vipWaitCount := 0; vipWaitCount < 270; vipWaitCount++ {
 		getObjectResult, err := NADCService.Id(id).Mask("subnets[ipAddresses],password[password]").GetObject()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving network application delivery controller: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving network application delivery controller: %s", err))
 		}
 
 		ipCount := 0
@@ -449,7 +451,7 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if !IsVipReady {
-		return fmt.Errorf("[ERROR] Failed to create VIPs for Netscaler VPX ID: %d", id)
+		return diag.FromErr(fmt.Errorf("[ERROR] Failed to create VIPs for Netscaler VPX ID: %d", id))
 	}
 
 	// Wait while VPX service is initializing. GetLoadBalancers() internally calls REST API of VPX and returns
@@ -469,22 +471,22 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if !IsRESTReady {
-		return fmt.Errorf("[ERROR] Failed to intialize VPX REST Service for Netscaler VPX ID: %d", id)
+		return diag.FromErr(fmt.Errorf("[ERROR] Failed to intialize VPX REST Service for Netscaler VPX ID: %d", id))
 	}
 
 	// Wait additional buffer time for VPX service.
 	time.Sleep(time.Minute)
 
-	return resourceIBMLbVpxRead(d, meta)
+	return resourceIBMLbVpxRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	service := services.GetNetworkApplicationDeliveryControllerService(sess)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	getObjectResult, err := service.
@@ -493,7 +495,7 @@ func resourceIBMLbVpxRead(d *schema.ResourceData, meta interface{}) error {
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving network application delivery controller: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving network application delivery controller: %s", err))
 	}
 
 	d.Set("name", *getObjectResult.Name)
@@ -573,30 +575,30 @@ func resourceIBMLbVpxRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMLbVpxUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Only tags are updated and that too locally hence nothing to validate and update in terms of real API at this point
 	return nil
 }
 
-func resourceIBMLbVpxDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkApplicationDeliveryControllerService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	billingItem, err := service.Id(id).GetBillingItem()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting network application delivery controller: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting network application delivery controller: %s", err))
 	}
 
 	if *billingItem.Id > 0 {
 		billingItemService := services.GetBillingItemService(sess)
 		deleted, err := billingItemService.Id(*billingItem.Id).CancelService()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error deleting network application delivery controller: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting network application delivery controller: %s", err))
 		}
 
 		if deleted {
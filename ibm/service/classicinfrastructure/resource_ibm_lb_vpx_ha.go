@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/minsikl/netscaler-nitro-go/client"
 	dt "github.com/minsikl/netscaler-nitro-go/datatypes"
@@ -19,12 +21,12 @@ import (
 
 func ResourceIBMLbVpxHa() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbVpxHaCreate,
-		Read:     resourceIBMLbVpxHaRead,
-		Update:   resourceIBMLbVpxHaUpdate,
-		Delete:   resourceIBMLbVpxHaDelete,
-		Exists:   resourceIBMLbVpxHaExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbVpxHaCreate,
+		ReadContext:   resourceIBMLbVpxHaRead,
+		UpdateContext: resourceIBMLbVpxHaUpdate,
+		DeleteContext: resourceIBMLbVpxHaDelete,
+		Exists:        resourceIBMLbVpxHaExists,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 
 			"primary_id": {
@@ -192,7 +194,7 @@ func parseHAId(id string) (int, int, error) {
 	return primaryId, secondaryId, nil
 }
 
-func resourceIBMLbVpxHaCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	primaryId := d.Get("primary_id").(int)
 	secondaryId := d.Get("secondary_id").(int)
 	staySecondary := false
@@ -202,40 +204,40 @@ func resourceIBMLbVpxHaCreate(d *schema.ResourceData, meta interface{}) error {
 
 	nClientPrimary, err := getNitroClient(meta.(conns.ClientSession).SoftLayerSession(), primaryId)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting primary netscaler information ID: %d", primaryId)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting primary netscaler information ID: %d", primaryId))
 	}
 
 	nClientSecondary, err := getNitroClient(meta.(conns.ClientSession).SoftLayerSession(), secondaryId)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting secondary netscaler information ID: %d", secondaryId)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting secondary netscaler information ID: %d", secondaryId))
 	}
 
 	err = configureHA(nClientPrimary, nClientSecondary, staySecondary)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error configuration HA %s", err.Error())
+		return diag.FromErr(fmt.Errorf("[ERROR] Error configuration HA %s", err.Error()))
 	}
 
 	d.SetId(fmt.Sprintf("%d:%d", primaryId, secondaryId))
 
 	log.Printf("[INFO] Netscaler HA ID: %s", d.Id())
 
-	return resourceIBMLbVpxHaRead(d, meta)
+	return resourceIBMLbVpxHaRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxHaRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	primaryId, secondaryId, err := parseHAId(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error reading HA %s", err.Error())
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading HA %s", err.Error()))
 	}
 
 	nClientPrimary, err := getNitroClient(meta.(conns.ClientSession).SoftLayerSession(), primaryId)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting primary netscaler information ID: %d", primaryId)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting primary netscaler information ID: %d", primaryId))
 	}
 
 	nClientSecondary, err := getNitroClient(meta.(conns.ClientSession).SoftLayerSession(), secondaryId)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting primary netscaler information ID: %d", primaryId)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting primary netscaler information ID: %d", primaryId))
 	}
 
 	nClientSecondary.Password = nClientPrimary.Password
@@ -257,20 +259,20 @@ func resourceIBMLbVpxHaRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMLbVpxHaUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	primaryId, secondaryId, err := parseHAId(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting HA %s", err.Error())
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting HA %s", err.Error()))
 	}
 
 	nClientPrimary, err := getNitroClient(meta.(conns.ClientSession).SoftLayerSession(), primaryId)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting primary netscaler information ID: %d", primaryId)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting primary netscaler information ID: %d", primaryId))
 	}
 
 	nClientSecondary, err := getNitroClient(meta.(conns.ClientSession).SoftLayerSession(), secondaryId)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting secondary netscaler information ID: %d", secondaryId)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting secondary netscaler information ID: %d", secondaryId))
 	}
 
 	nClientSecondary.Password = nClientPrimary.Password
@@ -289,31 +291,31 @@ func resourceIBMLbVpxHaUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	err = nClientSecondary.Update(&stay)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceIBMLbVpxHaDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	primaryId, secondaryId, err := parseHAId(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting HA %s", err.Error())
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting HA %s", err.Error()))
 	}
 	nClientPrimary, err := getNitroClient(meta.(conns.ClientSession).SoftLayerSession(), primaryId)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting primary netscaler information ID: %d", primaryId)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting primary netscaler information ID: %d", primaryId))
 	}
 	nClientSecondary, err := getNitroClient(meta.(conns.ClientSession).SoftLayerSession(), secondaryId)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting secondary netscaler information ID: %d", secondaryId)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting secondary netscaler information ID: %d", secondaryId))
 	}
 
 	secondaryPassword := nClientSecondary.Password
 	nClientSecondary.Password = nClientPrimary.Password
 	err = deleteHA(nClientPrimary, nClientSecondary)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting HA %s", err.Error())
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting HA %s", err.Error()))
 	}
 
 	// Restore password of the secondary VPX
@@ -325,7 +327,7 @@ func resourceIBMLbVpxHaDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = nClientSecondary.Update(&systemuserReq)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
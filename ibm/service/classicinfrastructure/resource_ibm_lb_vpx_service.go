@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dt "github.com/minsikl/netscaler-nitro-go/datatypes"
 	"github.com/minsikl/netscaler-nitro-go/op"
@@ -42,12 +44,12 @@ var (
 
 func ResourceIBMLbVpxService() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbVpxServiceCreate,
-		Read:     resourceIBMLbVpxServiceRead,
-		Update:   resourceIBMLbVpxServiceUpdate,
-		Delete:   resourceIBMLbVpxServiceDelete,
-		Exists:   resourceIBMLbVpxServiceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbVpxServiceCreate,
+		ReadContext:   resourceIBMLbVpxServiceRead,
+		UpdateContext: resourceIBMLbVpxServiceUpdate,
+		DeleteContext: resourceIBMLbVpxServiceDelete,
+		Exists:        resourceIBMLbVpxServiceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -157,78 +159,83 @@ func updateVpxService(sess *session.Session, nadcId int, lbVip *datatypes.Networ
 	return successFlag, err
 }
 
-func resourceIBMLbVpxServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vipId := d.Get("vip_id").(string)
 	_, nadcId, _, err := parseServiceId(vipId)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error parsing vip id: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error parsing vip id: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(conns.ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceCreate101(d, meta)
+		err = resourceIBMLbVpxServiceCreate101(ctx, d, meta)
+	} else {
+		err = resourceIBMLbVpxServiceCreate105(ctx, d, meta)
+	}
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMLbVpxServiceCreate105(d, meta)
+	return resourceIBMLbVpxServiceRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, nadcId, _, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error parsing vip id: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error parsing vip id: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(conns.ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Reading Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Reading Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceRead101(d, meta)
+		return diag.FromErr(resourceIBMLbVpxServiceRead101(d, meta))
 	}
 
-	return resourceIBMLbVpxServiceRead105(d, meta)
+	return diag.FromErr(resourceIBMLbVpxServiceRead105(d, meta))
 }
 
-func resourceIBMLbVpxServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, nadcId, _, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating Virtual IP Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating Virtual IP Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(conns.ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceUpdate101(d, meta)
+		return diag.FromErr(resourceIBMLbVpxServiceUpdate101(d, meta))
 	}
 
-	return resourceIBMLbVpxServiceUpdate105(d, meta)
+	return diag.FromErr(resourceIBMLbVpxServiceUpdate105(d, meta))
 }
 
-func resourceIBMLbVpxServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, nadcId, _, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Virtual Ip Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(conns.ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceDelete101(d, meta)
+		return diag.FromErr(resourceIBMLbVpxServiceDelete101(d, meta))
 	}
 
-	return resourceIBMLbVpxServiceDelete105(d, meta)
+	return diag.FromErr(resourceIBMLbVpxServiceDelete105(d, meta))
 }
 
 func resourceIBMLbVpxServiceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -249,7 +256,7 @@ func resourceIBMLbVpxServiceExists(d *schema.ResourceData, meta interface{}) (bo
 	return resourceIBMLbVpxServiceExists105(d, meta)
 }
 
-func resourceIBMLbVpxServiceCreate101(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceCreate101(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
@@ -300,10 +307,10 @@ func resourceIBMLbVpxServiceCreate101(d *schema.ResourceData, meta interface{})
 
 	d.SetId(fmt.Sprintf("%s:%s", vipId, serviceName))
 
-	return resourceIBMLbVpxServiceRead(d, meta)
+	return nil
 }
 
-func resourceIBMLbVpxServiceCreate105(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceCreate105(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
@@ -390,7 +397,7 @@ func resourceIBMLbVpxServiceCreate105(d *schema.ResourceData, meta interface{})
 
 	d.SetId(fmt.Sprintf("%s:%s", vipId, serviceName))
 
-	return resourceIBMLbVpxServiceRead(d, meta)
+	return nil
 }
 
 func resourceIBMLbVpxServiceRead101(d *schema.ResourceData, meta interface{}) error {
@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -13,6 +14,8 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/helpers/network"
@@ -59,12 +62,13 @@ var (
 
 func ResourceIBMLbVpxVip() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbVpxVipCreate,
-		Read:     resourceIBMLbVpxVipRead,
-		Update:   resourceIBMLbVpxVipUpdate,
-		Delete:   resourceIBMLbVpxVipDelete,
-		Exists:   resourceIBMLbVpxVipExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbVpxVipCreate,
+		ReadContext:   resourceIBMLbVpxVipRead,
+		UpdateContext: resourceIBMLbVpxVipUpdate,
+		DeleteContext: resourceIBMLbVpxVipDelete,
+		Exists:        resourceIBMLbVpxVipExists,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: validate.InvokeCustomizeDiff("ibm_lb_vpx_vip"),
 
 		Schema: map[string]*schema.Schema{
 			"nad_controller_id": {
@@ -135,71 +139,100 @@ func ResourceIBMLbVpxVip() *schema.Resource {
 	}
 }
 
-func resourceIBMLbVpxVipCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMLbVpxVipValidator() *validate.ResourceValidator {
+	validateRules := make([]validate.ValidateRule, 0)
+	validateRules = append(validateRules,
+		validate.ValidateRule{
+			Type:          validate.AllowedValuesWhen,
+			Identifiers:   []string{"type"},
+			When:          "security_certificate_id",
+			AllowedValues: "SSL"})
+	validateRules = append(validateRules,
+		validate.ValidateRule{
+			Type:        validate.RequiredWith,
+			Identifiers: []string{"security_certificate_id"},
+			When:        "type",
+			WhenValues:  "SSL"})
+
+	ibmLbVpxVipResourceValidator := validate.ResourceValidator{ResourceName: "ibm_lb_vpx_vip", Rules: validateRules}
+	return &ibmLbVpxVipResourceValidator
+}
+
+func resourceIBMLbVpxVipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	version, err := getVPXVersion(d.Get("nad_controller_id").(int), meta.(conns.ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipCreate101(d, meta)
+		err = resourceIBMLbVpxVipCreate101(ctx, d, meta)
+	} else {
+		err = resourceIBMLbVpxVipCreate105(ctx, d, meta)
+	}
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMLbVpxVipCreate105(d, meta)
+	return resourceIBMLbVpxVipRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxVipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId, _, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Reading Virtual IP Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Reading Virtual IP Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(conns.ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Reading Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Reading Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipRead101(d, meta)
+		return diag.FromErr(resourceIBMLbVpxVipRead101(d, meta))
 	}
 
-	return resourceIBMLbVpxVipRead105(d, meta)
+	return diag.FromErr(resourceIBMLbVpxVipRead105(d, meta))
 }
 
-func resourceIBMLbVpxVipUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId, _, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating Virtual IP Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating Virtual IP Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(conns.ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipUpdate101(d, meta)
+		err = resourceIBMLbVpxVipUpdate101(ctx, d, meta)
+	} else {
+		err = resourceIBMLbVpxVipUpdate105(ctx, d, meta)
+	}
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMLbVpxVipUpdate105(d, meta)
+	return resourceIBMLbVpxVipRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxVipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId, _, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Virtual Ip Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(conns.ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipDelete101(d, meta)
+		return diag.FromErr(resourceIBMLbVpxVipDelete101(d, meta))
 	}
 
-	return resourceIBMLbVpxVipDelete105(d, meta)
+	return diag.FromErr(resourceIBMLbVpxVipDelete105(d, meta))
 }
 
 func resourceIBMLbVpxVipExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -239,7 +272,7 @@ func parseId(id string) (int, string, error) {
 	return nadcId, vipName, nil
 }
 
-func resourceIBMLbVpxVipCreate101(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipCreate101(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	if _, ok := d.GetOk("security_certificate_id"); ok {
 		return fmt.Errorf("[ERROR] Error creating Virtual Ip Address: security_certificate_id is not supported with VPX 10.1.")
 	}
@@ -295,10 +328,10 @@ func resourceIBMLbVpxVipCreate101(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[INFO] Netscaler VPX VIP ID: %s", d.Id())
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return nil
 }
 
-func resourceIBMLbVpxVipCreate105(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipCreate105(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	nadcId := d.Get("nad_controller_id").(int)
 	nClient, err := getNitroClient(meta.(conns.ClientSession).SoftLayerSession(), nadcId)
 	if err != nil {
@@ -333,14 +366,6 @@ func resourceIBMLbVpxVipCreate105(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[INFO] Creating Virtual Ip Address %s", *lbvserverReq.Lbvserver.Ipv46)
 
-	// security_certificated_id is only available when type is 'SSL'
-	if securityCertificateId > 0 && vipType != "SSL" {
-		return fmt.Errorf("[ERROR] Error creating VIP : security_certificated_id is only available when type is 'SSL'")
-	} else if securityCertificateId == 0 && vipType == "SSL" {
-		return fmt.Errorf("[ERROR] Error creating VIP : 'SSL' type requires security_certificated_id.")
-
-	}
-
 	// Create a virtual server
 	err = nClient.Add(&lbvserverReq)
 	if err != nil {
@@ -365,7 +390,7 @@ func resourceIBMLbVpxVipCreate105(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[INFO] Netscaler VPX VIP ID: %s", d.Id())
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return nil
 }
 
 func resourceIBMLbVpxVipRead101(d *schema.ResourceData, meta interface{}) error {
@@ -470,7 +495,7 @@ func resourceIBMLbVpxVipRead105(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMLbVpxVipUpdate101(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipUpdate101(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkApplicationDeliveryControllerService(sess.SetRetries(0))
 
@@ -507,10 +532,10 @@ func resourceIBMLbVpxVipUpdate101(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("[ERROR] Error updating Virtual Ip Address: %s", err)
 	}
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return nil
 }
 
-func resourceIBMLbVpxVipUpdate105(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipUpdate105(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	nadcId := d.Get("nad_controller_id").(int)
 	nClient, err := getNitroClient(meta.(conns.ClientSession).SoftLayerSession(), nadcId)
 	if err != nil {
@@ -548,7 +573,7 @@ func resourceIBMLbVpxVipUpdate105(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("[ERROR] Error updating Virtual Ip Address: " + err.Error())
 	}
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return nil
 }
 
 func resourceIBMLbVpxVipDelete101(d *schema.ResourceData, meta interface{}) error {
@@ -4,11 +4,13 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -17,11 +19,11 @@ import (
 
 func ResourceIBMLbaasHealthMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbaasHealthMonitorCreate,
-		Read:     resourceIBMLbaasHealthMonitorRead,
-		Delete:   resourceIBMLbaasHealthMonitorDelete,
-		Update:   resourceIBMLbaasHealthMonitorUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbaasHealthMonitorCreate,
+		ReadContext:   resourceIBMLbaasHealthMonitorRead,
+		DeleteContext: resourceIBMLbaasHealthMonitorDelete,
+		UpdateContext: resourceIBMLbaasHealthMonitorUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -81,7 +83,7 @@ func ResourceIBMLbaasHealthMonitor() *schema.Resource {
 	}
 }
 
-func resourceIBMLbaasHealthMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasHealthMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	healthMonitorService := services.GetNetworkLBaaSHealthMonitorService(sess.SetRetries(0))
 
@@ -99,36 +101,36 @@ func resourceIBMLbaasHealthMonitorCreate(d *schema.ResourceData, meta interface{
 
 	healthMonitors = append(healthMonitors, healthMonitor)
 
-	_, err := waitForLbaasLBActive(d, meta)
+	_, err := waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 	}
 
 	_, err = healthMonitorService.UpdateLoadBalancerHealthMonitors(sl.String(lbaasID), healthMonitors)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error adding health monitors: %#v", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error adding health monitors: %#v", err))
 	}
-	_, err = waitForLbaasLBActive(d, meta)
+	_, err = waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbaasID, d.Get("monitor_id").(string)))
-	return resourceIBMLbaasHealthMonitorRead(d, meta)
+	return resourceIBMLbaasHealthMonitorRead(ctx, d, meta)
 }
 
-func resourceIBMLbaasHealthMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasHealthMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	lbaasID := parts[0]
 	monitorID := parts[1]
 
 	result, err := service.Mask("listeners.defaultPool.healthMonitor").GetLoadBalancer(sl.String(lbaasID))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving load balancer: %s", err))
 	}
 	for _, i := range result.Listeners {
 		if monitorID == *i.DefaultPool.HealthMonitor.Uuid {
@@ -148,12 +150,12 @@ func resourceIBMLbaasHealthMonitorRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMLbaasHealthMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasHealthMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	healthMonitorService := services.GetNetworkLBaaSHealthMonitorService(sess.SetRetries(0))
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	lbaasID := parts[0]
 	monitorID := parts[1]
@@ -172,26 +174,26 @@ func resourceIBMLbaasHealthMonitorUpdate(d *schema.ResourceData, meta interface{
 
 		healthMonitors = append(healthMonitors, healthMonitor)
 
-		_, err = waitForLbaasLBActive(d, meta)
+		_, err = waitForLbaasLBActive(ctx, d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 		}
 
 		_, err := healthMonitorService.UpdateLoadBalancerHealthMonitors(sl.String(lbaasID), healthMonitors)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error adding health monitors: %#v", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error adding health monitors: %#v", err))
 		}
-		_, err = waitForLbaasLBActive(d, meta)
+		_, err = waitForLbaasLBActive(ctx, d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 		}
 	}
-	return resourceIBMLbaasHealthMonitorRead(d, meta)
+	return resourceIBMLbaasHealthMonitorRead(ctx, d, meta)
 }
 
-func resourceIBMLbaasHealthMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasHealthMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	fmt.Println("Health monitor is destroyed only when the corresponding protocol is removed")
 	d.SetId("")
 	return nil
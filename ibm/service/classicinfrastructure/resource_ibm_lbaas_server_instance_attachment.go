@@ -4,12 +4,14 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -19,12 +21,12 @@ import (
 
 func ResourceIBMLbaasServerInstanceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbaasServerInstanceAttachmentCreate,
-		Read:     resourceIBMLbaasServerInstanceAttachmentRead,
-		Delete:   resourceIBMLbaasServerInstanceAttachmentDelete,
-		Exists:   resourceIBMLbaasServerInstanceAttachmentExists,
-		Update:   resourceIBMLbaasServerInstanceAttachmentUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbaasServerInstanceAttachmentCreate,
+		ReadContext:   resourceIBMLbaasServerInstanceAttachmentRead,
+		DeleteContext: resourceIBMLbaasServerInstanceAttachmentDelete,
+		Exists:        resourceIBMLbaasServerInstanceAttachmentExists,
+		UpdateContext: resourceIBMLbaasServerInstanceAttachmentUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"private_ip_address": {
@@ -56,7 +58,7 @@ func ResourceIBMLbaasServerInstanceAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMLbaasServerInstanceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasServerInstanceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 	memberService := services.GetNetworkLBaaSMemberService(sess)
@@ -68,17 +70,17 @@ func resourceIBMLbaasServerInstanceAttachmentCreate(d *schema.ResourceData, meta
 	p.Weight = sl.Int(weight)
 	members := make([]datatypes.Network_LBaaS_LoadBalancerServerInstanceInfo, 0, 1)
 	members = append(members, *p)
-	_, err := waitForLbaasLBActive(d, meta)
+	_, err := waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", d.Id(), err))
 	}
 	_, err = memberService.AddLoadBalancerMembers(sl.String(lbaasId), members)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error adding server instances: %#v", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error adding server instances: %#v", err))
 	}
-	_, err = waitForLbaasLBActive(d, meta)
+	_, err = waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 	}
 	result, err := service.Mask("members").GetLoadBalancer(sl.String(lbaasId))
 	lbaasMembers := result.Members
@@ -89,17 +91,17 @@ func resourceIBMLbaasServerInstanceAttachmentCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	return resourceIBMLbaasServerInstanceAttachmentRead(d, meta)
+	return resourceIBMLbaasServerInstanceAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMLbaasServerInstanceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasServerInstanceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	memberService := services.GetNetworkLBaaSMemberService(sess)
 	id := d.Id()
 	memId, _ := strconv.Atoi(d.Id())
 	member, err := memberService.Id(memId).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving load balancer member(%s) : %s", id, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving load balancer member(%s) : %s", id, err))
 	}
 	d.Set("private_ip_address", member.Address)
 	d.Set("weight", member.Weight)
@@ -108,7 +110,7 @@ func resourceIBMLbaasServerInstanceAttachmentRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceIBMLbaasServerInstanceAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasServerInstanceAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	memberService := services.GetNetworkLBaaSMemberService(sess)
 	if d.HasChange("weight") {
@@ -123,24 +125,24 @@ func resourceIBMLbaasServerInstanceAttachmentUpdate(d *schema.ResourceData, meta
 		updateParam.Address = sl.String(privateIpAddress)
 		members := make([]datatypes.Network_LBaaS_Member, 0, 1)
 		members = append(members, *updateParam)
-		_, err := waitForLbaasLBActive(d, meta)
+		_, err := waitForLbaasLBActive(ctx, d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error checking for load balancer (%s) is active: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf(
+				"Error checking for load balancer (%s) is active: %s", d.Id(), err))
 		}
 		_, err = memberService.UpdateLoadBalancerMembers(sl.String(lbaasId), members)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating loadbalnacer: %#v", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating loadbalnacer: %#v", err))
 		}
-		_, err = waitForLbaasLBActive(d, meta)
+		_, err = waitForLbaasLBActive(ctx, d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for load balancer (%s) to become ready: %s", lbaasId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for load balancer (%s) to become ready: %s", lbaasId, err))
 		}
 
 	}
 
-	return resourceIBMLbaasServerInstanceAttachmentRead(d, meta)
+	return resourceIBMLbaasServerInstanceAttachmentRead(ctx, d, meta)
 }
 
 func resourceIBMLbaasServerInstanceAttachmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -157,28 +159,28 @@ func resourceIBMLbaasServerInstanceAttachmentExists(d *schema.ResourceData, meta
 	return result.Id != nil && *result.Id == memId, nil
 }
 
-func resourceIBMLbaasServerInstanceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasServerInstanceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	memberService := services.GetNetworkLBaaSMemberService(sess)
 	lbaasId := d.Get("lbaas_id").(string)
 	removeList := make([]string, 0, 1)
 	removeList = append(removeList, d.Get("uuid").(string))
-	_, err := waitForLbaasLBActive(d, meta)
+	_, err := waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", d.Id(), err))
 	}
 	_, err = memberService.DeleteLoadBalancerMembers(sl.String(lbaasId), removeList)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error removing server instances: %#v", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error removing server instances: %#v", err))
 	}
-	_, err = waitForLbaasLBActive(d, meta)
+	_, err = waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 	}
 	return nil
 }
 
-func waitForLbaasLBActive(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLbaasLBActive(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 	lbaasId := d.Get("lbaas_id").(string)
@@ -206,5 +208,5 @@ func waitForLbaasLBActive(d *schema.ResourceData, meta interface{}) (interface{}
 		NotFoundChecks: 40,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
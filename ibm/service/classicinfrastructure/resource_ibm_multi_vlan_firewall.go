@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -23,12 +25,12 @@ import (
 
 func ResourceIBMMultiVlanFirewall() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkMultiVlanCreate,
-		Read:     resourceIBMMultiVlanFirewallRead,
-		Delete:   resourceIBMFirewallDelete,
-		Update:   resourceIBMMultiVlanFirewallUpdate,
-		Exists:   resourceIBMMultiVLanFirewallExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkMultiVlanCreate,
+		ReadContext:   resourceIBMMultiVlanFirewallRead,
+		DeleteContext: resourceIBMFirewallDelete,
+		UpdateContext: resourceIBMMultiVlanFirewallUpdate,
+		Exists:        resourceIBMMultiVLanFirewallExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"datacenter": {
@@ -124,7 +126,7 @@ const (
 	multiVlansMask            = "id,customerManagedFlag,datacenter.name,bandwidthAllocation"
 )
 
-func resourceIBMNetworkMultiVlanCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkMultiVlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	name := d.Get("name").(string)
 	FirewallType := d.Get("firewall_type").(string)
@@ -137,7 +139,7 @@ func resourceIBMNetworkMultiVlanCreate(d *schema.ResourceData, meta interface{})
 	// 1.Getting the router ID
 	routerids, err := PodService.Filter(filter.Path("datacenterName").Eq(datacenter).Build()).Mask(podMask).GetAllObjects()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Encountered  problem trying to get the router ID: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Encountered  problem trying to get the router ID: %s", err))
 	}
 	var routerid int
 	for _, iterate := range routerids {
@@ -149,7 +151,7 @@ func resourceIBMNetworkMultiVlanCreate(d *schema.ResourceData, meta interface{})
 	//2.Get the datacenter id
 	dc, err := location.GetDatacenterByName(sess, datacenter, "id")
 	if err != nil {
-		return fmt.Errorf("[ERROR] Encountered  problem trying to get the Datacenter ID: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Encountered  problem trying to get the Datacenter ID: %s", err))
 	}
 	locationservice := services.GetLocationService(sess)
 
@@ -183,7 +185,7 @@ func resourceIBMNetworkMultiVlanCreate(d *schema.ResourceData, meta interface{})
 	for _, addon := range actualaddons {
 		actualpriceid, err := product.GetPriceIDByPackageIdandLocationGroups(sess, listofpriceids, 863, addon)
 		if err != nil || actualpriceid == 0 {
-			return fmt.Errorf("[ERROR] Encountered  problem trying to get priceIds of items which have to be ordered: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Encountered  problem trying to get priceIds of items which have to be ordered: %s", err))
 		}
 		priceItem := datatypes.Product_Item_Price{
 			Id: &actualpriceid,
@@ -215,25 +217,25 @@ func resourceIBMNetworkMultiVlanCreate(d *schema.ResourceData, meta interface{})
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		VerifyOrder(&productOrderContainer)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during Verify order for Creating: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during Verify order for Creating: %s", err))
 	}
 	//9.Calling place order
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(&productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during Place order for Creating: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during Place order for Creating: %s", err))
 	}
-	_, vlan, _, err := findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d)
+	_, vlan, _, err := findDedicatedFirewallByOrderId(ctx, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall: %s", err))
 	}
 	id := *vlan.NetworkFirewall.Id
 	d.SetId(fmt.Sprintf("%d", id))
 	log.Printf("[INFO] Firewall ID: %s", d.Id())
-	return resourceIBMMultiVlanFirewallRead(d, meta)
+	return resourceIBMMultiVlanFirewallRead(ctx, d, meta)
 }
 
-func resourceIBMMultiVlanFirewallRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMultiVlanFirewallRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	fwID, _ := strconv.Atoi(d.Id())
@@ -245,7 +247,7 @@ func resourceIBMMultiVlanFirewallRead(d *schema.ResourceData, meta interface{})
 		Mask(multiVlanMask).
 		GetNetworkGateways()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving firewall information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving firewall information: %s", err))
 	}
 	d.Set("datacenter", *firewalls[0].NetworkFirewall.Datacenter.Name)
 	if *firewalls[0].NetworkFirewall.CustomerManagedFlag && *firewalls[0].MemberCount == 1 {
@@ -276,7 +278,7 @@ func resourceIBMMultiVlanFirewallRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMultiVlanFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("addon_configuration") {
 		sess := meta.(conns.ClientSession).SoftLayerSession()
 		fwID, _ := strconv.Atoi(d.Id())
@@ -302,7 +304,7 @@ func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}
 				Mask(multiVlanMask).
 				GetNetworkGateways()
 			if err != nil {
-				return fmt.Errorf("[ERROR] Expected error occured while fetching the information of the Multi-Vlan Firewall")
+				return diag.FromErr(fmt.Errorf("[ERROR] Expected error occured while fetching the information of the Multi-Vlan Firewall"))
 			}
 			for _, i := range remove {
 				for _, j := range firewalls[0].NetworkFirewall.BillingItem.ActiveChildren {
@@ -313,7 +315,7 @@ func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}
 						customerNote := "No longer needed"
 						billingitemservice, err := services.GetBillingItemService(sess).Id(*j.Id).CancelItem(&cancelimmediately, &cancelAssociatedBillingItems, &reason, &customerNote)
 						if err != nil || !billingitemservice {
-							return fmt.Errorf("[ERROR] Error while cancelling the addon")
+							return diag.FromErr(fmt.Errorf("[ERROR] Error while cancelling the addon"))
 						}
 					}
 				}
@@ -322,12 +324,12 @@ func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}
 		if len(add) > 0 {
 			datacentername, ok := d.GetOk("datacenter")
 			if !ok {
-				return fmt.Errorf("[ERROR] The attribute datacenter is not defined")
+				return diag.FromErr(fmt.Errorf("[ERROR] The attribute datacenter is not defined"))
 			}
 			//2.Get the datacenter id
 			dc, err := location.GetDatacenterByName(sess, datacentername.(string), "id")
 			if err != nil {
-				return fmt.Errorf("[ERROR] Something not found")
+				return diag.FromErr(fmt.Errorf("[ERROR] Something not found"))
 			}
 			locationservice := services.GetLocationService(sess)
 			//3. get the pricegroups that the datacenter belongs to
@@ -341,7 +343,7 @@ func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}
 			for _, addon := range add {
 				actualpriceid, err := product.GetPriceIDByPackageIdandLocationGroups(sess, listofpriceids, 863, addon)
 				if err != nil || actualpriceid == 0 {
-					return fmt.Errorf("[ERROR] The addon or the firewall is not available for the datacenter you have selected. Please enter a different datacenter")
+					return diag.FromErr(fmt.Errorf("[ERROR] The addon or the firewall is not available for the datacenter you have selected. Please enter a different datacenter"))
 				}
 				priceItem := datatypes.Product_Item_Price{
 					Id: &actualpriceid,
@@ -374,22 +376,22 @@ func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}
 			_, err = services.GetProductOrderService(sess.SetRetries(0)).
 				VerifyOrder(&upgradeproductOrderContainer)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error during Verify order for Updating: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error during Verify order for Updating: %s", err))
 			}
 
 			//9.Calling place order
 			receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 				PlaceOrder(&upgradeproductOrderContainer, sl.Bool(false))
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error during Place order for Updating: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error during Place order for Updating: %s", err))
 			}
-			_, _, _, err = findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d)
+			_, _, _, err = findDedicatedFirewallByOrderId(ctx, sess, *receipt.OrderId, d)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of dedicated hardware firewall: %s", err))
 			}
 		}
 	}
-	return resourceIBMMultiVlanFirewallRead(d, meta)
+	return resourceIBMMultiVlanFirewallRead(ctx, d, meta)
 }
 
 func resourceIBMMultiVLanFirewallExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -5,6 +5,7 @@ package classicinfrastructure
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"math/rand"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -31,12 +33,12 @@ const GATEWAY_APPLIANCE_CLUSTER = "NETWORK_GATEWAY_APPLIANCE_CLUSTER"
 
 func ResourceIBMNetworkGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkGatewayCreate,
-		Read:     resourceIBMNetworkGatewayRead,
-		Update:   resourceIBMNetworkGatewayUpdate,
-		Delete:   resourceIBMNetworkGatewayDelete,
-		Exists:   resourceIBMNetworkGatewayExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkGatewayCreate,
+		ReadContext:   resourceIBMNetworkGatewayRead,
+		UpdateContext: resourceIBMNetworkGatewayUpdate,
+		DeleteContext: resourceIBMNetworkGatewayDelete,
+		Exists:        resourceIBMNetworkGatewayExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -364,7 +366,7 @@ func ResourceIBMNetworkGateway() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	members := []gatewayMember{}
 	for _, v := range d.Get("members").(*schema.Set).List() {
@@ -374,19 +376,19 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 
 	if len(members) == 2 {
 		if !areVlanCompatible(members) {
-			return fmt.Errorf("[ERROR] Members should have exactly same public and private vlan configuration," +
-				"please check public_vlan_id and private_vlan_id property on individual members")
+			return diag.FromErr(fmt.Errorf("[ERROR] Members should have exactly same public and private vlan configuration," +
+				"please check public_vlan_id and private_vlan_id property on individual members"))
 		}
 	}
 
 	//Build order for one member
 	order, err := getMonthlyGatewayOrder(members[0], meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Encountered problem trying to get the Gateway order template: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Encountered problem trying to get the Gateway order template: %s", err))
 	}
 	err = setHardwareOptions(members[0], &order.Hardware[0])
 	if err != nil {
-		return fmt.Errorf("[ERROR] Encountered problem trying to configure Gateway options: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Encountered problem trying to configure Gateway options: %s", err))
 	}
 
 	// two members can be ordered together if they have same hardware configuration
@@ -421,7 +423,7 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 		})
 		err = setHardwareOptions(members[1], &order.Hardware[1])
 		if err != nil {
-			return fmt.Errorf("[ERROR] Encountered problem trying to configure Gateway options: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Encountered problem trying to configure Gateway options: %s", err))
 		}
 
 	}
@@ -444,23 +446,23 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	pkg, err := getPackageByModelGateway(sess, GATEWAY_APPLIANCE_CLUSTER, false)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if pkg.Id == nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// 2. Get all prices for the package
 	items, err := product.GetPackageProducts(sess, *pkg.Id, productItemMaskWithPriceLocationGroupID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// 3. Build price items
 	gwCluster, err := getItemPriceId(items, "gateway_resource_group", "GATEWAY_APPLIANCE_CLUSTER")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterIdentifier := randomString(8)
@@ -498,17 +500,17 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 
 	_, err = services.GetProductOrderService(sess).VerifyOrder(&productOrder)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Encountered problem trying to verify the order: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Encountered problem trying to verify the order: %s", err))
 	}
 	orderReceipt, err := services.GetProductOrderService(sess.SetRetries(0)).PlaceOrder(&productOrder, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Encountered problem trying to place the order: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Encountered problem trying to place the order: %s", err))
 	}
 
 	gID := *orderReceipt.OrderDetails.OrderContainers[0].Hardware[0].GlobalIdentifier
-	bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[0], meta, gID)
+	bm, err := waitForNetworkGatewayMemberProvision(ctx, &order.Hardware[0], meta, gID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for Gateway (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for Gateway (%s) to become ready: %s", d.Id(), err))
 	}
 
 	id := *bm.(datatypes.Hardware).NetworkGatewayMember.NetworkGatewayId
@@ -521,38 +523,38 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 
 	err = setTagsAndNotes(members[0], meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if sameOrder {
 		// If we ordered HA and then wait for other member
 		gID1 := *orderReceipt.OrderDetails.OrderContainers[0].Hardware[1].GlobalIdentifier
-		bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[1], meta, gID1)
+		bm, err := waitForNetworkGatewayMemberProvision(ctx, &order.Hardware[1], meta, gID1)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for Gateway (%s) to become ready: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for Gateway (%s) to become ready: %s", d.Id(), err))
 		}
 		member2Id := *bm.(datatypes.Hardware).Id
 		log.Printf("[INFO] Member 2 ID: %d", member2Id)
 		members[1]["member_id"] = member2Id
 		err = setTagsAndNotes(members[1], meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if len(members) == 2 {
 		//Add the new gateway which has different configuration than the first
-		err := addGatewayMember(id, members[1], meta)
+		err := addGatewayMember(ctx, id, members[1], meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	name := d.Get("name").(string)
 	err = updateGatewayName(id, name, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMNetworkGatewayRead(d, meta)
+	return resourceIBMNetworkGatewayRead(ctx, d, meta)
 }
 
 func randomString(length int) string {
@@ -577,11 +579,11 @@ func resourceIBMMemberHostHash(v interface{}) int {
 	return conns.String(buf.String())
 }
 
-func resourceIBMNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service := services.GetNetworkGatewayService(meta.(conns.ClientSession).SoftLayerSession())
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 	result, err := service.Id(id).Mask(
 		"insideVlans,members,status,privateIpAddress[ipAddress],publicIpAddress[ipAddress]," +
@@ -592,7 +594,7 @@ func resourceIBMNetworkGatewayRead(d *schema.ResourceData, meta interface{}) err
 			"powerSupplyCount,primaryNetworkComponent[networkVlan],memoryCapacity,networkVlans[id,vlanNumber]]]",
 	).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving Network Gateway: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Network Gateway: %s", err))
 	}
 	d.Set("name", result.Name)
 	if result.PrivateIpAddress != nil {
@@ -634,7 +636,7 @@ func updateGatewayName(id int, name string, meta interface{}) error {
 	return err
 }
 
-func addGatewayMember(gwID int, member gatewayMember, meta interface{}) error {
+func addGatewayMember(ctx context.Context, gwID int, member gatewayMember, meta interface{}) error {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	order, err := getMonthlyGatewayOrder(member, meta)
 	if err != nil {
@@ -676,7 +678,7 @@ func addGatewayMember(gwID int, member gatewayMember, meta interface{}) error {
 
 	gID := *orderReceipt.OrderDetails.Hardware[0].GlobalIdentifier
 
-	bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[0], meta, gID)
+	bm, err := waitForNetworkGatewayMemberProvision(ctx, &order.Hardware[0], meta, gID)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for Gateway (%d) to become ready: %s", gwID, err)
 	}
@@ -687,23 +689,23 @@ func addGatewayMember(gwID int, member gatewayMember, meta interface{}) error {
 	return err
 }
 
-func resourceIBMNetworkGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id, _ := strconv.Atoi(d.Id())
 	if d.HasChange("name") {
 		gwName := d.Get("name").(string)
 		err := updateGatewayName(id, gwName, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMNetworkGatewayRead(d, meta)
+	return resourceIBMNetworkGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 	service := services.GetNetworkGatewayService(sess)
 	gw, err := service.Id(id).Mask("members[hardwareId]").GetObject()
@@ -711,9 +713,9 @@ func resourceIBMNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) e
 		m := gatewayMember{
 			"member_id": *v.HardwareId,
 		}
-		err := deleteHardware(m, meta)
+		err := deleteHardware(ctx, m, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	//If both the hardwares have been deleted then gateway will go away as well
@@ -1001,7 +1003,7 @@ func setHardwareOptions(m gatewayMember, hardware *datatypes.Hardware) error {
 // Have to wait on provision date to become available on server that matches
 // hostname and domain.
 // http://sldn.softlayer.com/blog/bpotter/ordering-bare-metal-servers-using-softlayer-api
-func waitForNetworkGatewayMemberProvision(d *datatypes.Hardware, meta interface{}, globalIdentifier string) (interface{}, error) {
+func waitForNetworkGatewayMemberProvision(ctx context.Context, d *datatypes.Hardware, meta interface{}, globalIdentifier string) (interface{}, error) {
 	hostname := *d.Hostname
 	domain := *d.Domain
 	log.Printf("Waiting for Gateway (%s.%s) to be provisioned", hostname, domain)
@@ -1031,7 +1033,7 @@ func waitForNetworkGatewayMemberProvision(d *datatypes.Hardware, meta interface{
 		NotFoundChecks: 24 * 60,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func setTagsAndNotes(m gatewayMember, meta interface{}) error {
@@ -4,12 +4,14 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -19,12 +21,12 @@ import (
 
 func ResourceIBMNetworkGatewayVlanAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkGatewayVlanAttachmentCreate,
-		Read:     resourceIBMNetworkGatewayVlanAttachmentRead,
-		Update:   resourceIBMNetworkGatewayVlanAttachmentUpdate,
-		Delete:   resourceIBMNetworkGatewayVlanAttachmentDelete,
-		Exists:   resourceIBMNetworkGatewayVlanAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkGatewayVlanAttachmentCreate,
+		ReadContext:   resourceIBMNetworkGatewayVlanAttachmentRead,
+		UpdateContext: resourceIBMNetworkGatewayVlanAttachmentUpdate,
+		DeleteContext: resourceIBMNetworkGatewayVlanAttachmentDelete,
+		Exists:        resourceIBMNetworkGatewayVlanAttachmentExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
@@ -49,7 +51,7 @@ func ResourceIBMNetworkGatewayVlanAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkGatewayVlanAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayVlanAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	gatewayID := d.Get("gateway_id").(int)
 	networkVlanID := d.Get("network_vlan_id").(int)
 	bypass := d.Get("bypass").(bool)
@@ -68,22 +70,22 @@ func resourceIBMNetworkGatewayVlanAttachmentCreate(d *schema.ResourceData, meta
 					if !bypass {
 						err = vlanService.Id(*i.Id).Unbypass()
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					} else {
 						err = vlanService.Id(*i.Id).Bypass()
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
-					_, err = waitForNetworkGatewayActiveState(*i.NetworkGatewayId, meta)
+					_, err = waitForNetworkGatewayActiveState(ctx, *i.NetworkGatewayId, meta)
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 				}
 				vlan, err := vlanService.Id(*i.Id).GetObject()
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error trying to retrieve Network Gateway Vlan: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error trying to retrieve Network Gateway Vlan: %s", err))
 				}
 				d.SetId(fmt.Sprintf("%d", *vlan.Id))
 				d.Set("bypass", vlan.BypassFlag)
@@ -102,26 +104,26 @@ func resourceIBMNetworkGatewayVlanAttachmentCreate(d *schema.ResourceData, meta
 
 	resp, err := resourceIBMNetworkGatewayVlanAssociate(d, meta, vlan)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%d", *resp.Id))
-	_, err = waitForNetworkGatewayActiveState(gatewayID, meta)
+	_, err = waitForNetworkGatewayActiveState(ctx, gatewayID, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMNetworkGatewayVlanAttachmentRead(d, meta)
+	return resourceIBMNetworkGatewayVlanAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMNetworkGatewayVlanAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayVlanAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	vlan, err := services.GetNetworkGatewayVlanService(sess).Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error trying to retrieve Network Gateway Vlan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error trying to retrieve Network Gateway Vlan: %s", err))
 	}
 	d.Set("gateway_id", vlan.NetworkGatewayId)
 	d.Set("network_vlan_id", vlan.NetworkVlanId)
@@ -129,12 +131,12 @@ func resourceIBMNetworkGatewayVlanAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceIBMNetworkGatewayVlanAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayVlanAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkGatewayVlanService(sess)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 	if d.HasChange("bypass") {
 		bypass := d.Get("bypass").(bool)
@@ -142,22 +144,22 @@ func resourceIBMNetworkGatewayVlanAttachmentUpdate(d *schema.ResourceData, meta
 		if !bypass {
 			err = service.Id(id).Unbypass()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			err = service.Id(id).Bypass()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		vlan, err := service.Id(id).GetObject()
-		_, err = waitForNetworkGatewayActiveState(*vlan.NetworkGatewayId, meta)
+		_, err = waitForNetworkGatewayActiveState(ctx, *vlan.NetworkGatewayId, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMNetworkGatewayVlanAttachmentRead(d, meta)
+	return resourceIBMNetworkGatewayVlanAttachmentRead(ctx, d, meta)
 }
 
 func resourceIBMNetworkGatewayVlanAttachmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -175,22 +177,22 @@ func resourceIBMNetworkGatewayVlanAttachmentExists(d *schema.ResourceData, meta
 	return result.Id != nil && *result.Id == id, nil
 }
 
-func resourceIBMNetworkGatewayVlanAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayVlanAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 	vlan, err := services.GetNetworkGatewayVlanService(meta.(conns.ClientSession).SoftLayerSession()).Id(id).GetObject()
 
 	err = resourceIBMNetworkGatewayVlanDissociate(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	_, err = waitForNetworkGatewayActiveState(*vlan.NetworkGatewayId, meta)
+	_, err = waitForNetworkGatewayActiveState(ctx, *vlan.NetworkGatewayId, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -220,7 +222,7 @@ func resourceIBMNetworkGatewayVlanDissociate(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func waitForNetworkGatewayActiveState(id int, meta interface{}) (interface{}, error) {
+func waitForNetworkGatewayActiveState(ctx context.Context, id int, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for Gateway (%d) to be active", id)
 	service := services.GetNetworkGatewayService(meta.(conns.ClientSession).SoftLayerSession())
 
@@ -247,5 +249,5 @@ func waitForNetworkGatewayActiveState(id int, meta interface{}) (interface{}, er
 		NotFoundChecks: 24 * 60,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
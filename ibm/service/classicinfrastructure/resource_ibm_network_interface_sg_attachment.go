@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
@@ -20,10 +22,10 @@ import (
 
 func ResourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMNetworkInterfaceSGAttachmentCreate,
-		Read:   resourceIBMNetworkInterfaceSGAttachmentRead,
-		Delete: resourceIBMNetworkInterfaceSGAttachmentDelete,
-		Exists: resourceIBMNetworkInterfaceSGAttachmentExists,
+		CreateContext: resourceIBMNetworkInterfaceSGAttachmentCreate,
+		ReadContext:   resourceIBMNetworkInterfaceSGAttachmentRead,
+		DeleteContext: resourceIBMNetworkInterfaceSGAttachmentDelete,
+		Exists:        resourceIBMNetworkInterfaceSGAttachmentExists,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
 		},
@@ -51,10 +53,12 @@ func ResourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkInterfaceSGAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(mk)
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
@@ -62,14 +66,14 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 
 	sgID := d.Get("security_group_id").(int)
 	interfaceID := d.Get("network_interface_id").(int)
-	_, err := WaitForVSAvailable(d, meta, d.Timeout(schema.TimeoutCreate))
+	_, err := WaitForVSAvailable(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, err = service.Id(sgID).AttachNetworkComponents([]int{interfaceID})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%d_%d", sgID, interfaceID))
 
@@ -78,22 +82,22 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 		//Check if a soft reboot is required and perform it
 		ready, err := ncs.Id(interfaceID).SecurityGroupsReady()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if !ready {
 			log.Println("Soft reboot the VSI whose network component is", interfaceID)
 		}
 		guest, err := ncs.Id(interfaceID).GetGuest()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Could n't retrieve the virtual guest on interface %d", interfaceID)
+			return diag.FromErr(fmt.Errorf("[ERROR] Could n't retrieve the virtual guest on interface %d", interfaceID))
 		}
 		guestService := services.GetVirtualGuestService(sess)
 		ok, err := guestService.Id(*guest.Id).RebootSoft()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if !ok {
-			return fmt.Errorf("[ERROR] Could n't reboot the VSI %d", *guest.Id)
+			return diag.FromErr(fmt.Errorf("[ERROR] Could n't reboot the VSI %d", *guest.Id))
 		}
 		//Wait for security group to be ready again after reboot
 		stateConf := &resource.StateChangeConf{
@@ -102,47 +106,49 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 			Timeout: d.Timeout(schema.TimeoutCreate),
 			Refresh: securityGroupReadyRefreshStateFunc(sess, interfaceID),
 		}
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMNetworkInterfaceSGAttachmentRead(d, meta)
+	return resourceIBMNetworkInterfaceSGAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkInterfaceSGAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	bindings, err := service.Id(sgID).GetNetworkComponentBindings()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, b := range bindings {
 		if *b.NetworkComponentId == interfaceID {
 			return nil
 		}
 	}
-	return fmt.Errorf("[ERROR] No association found between security group %d and network interface %d", sgID, interfaceID)
+	return diag.FromErr(fmt.Errorf("[ERROR] No association found between security group %d and network interface %d", sgID, interfaceID))
 }
 
-func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkInterfaceSGAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(mk)
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, err = service.Id(sgID).DetachNetworkComponents([]int{interfaceID})
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error detaching network components from Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error detaching network components from Security Group: %s", err))
 	}
 	d.SetId("")
 	return nil
@@ -204,7 +210,7 @@ func securityGroupReadyRefreshStateFunc(sess *slsession.Session, ifcID int) reso
 }
 
 // WaitForVirtualGuestAvailable Waits for virtual guest creation
-func WaitForVSAvailable(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+func WaitForVSAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	interfaceID := d.Get("network_interface_id").(int)
 	log.Printf("Waiting for server (%d) to be available.", interfaceID)
 	sess := meta.(conns.ClientSession).SoftLayerSession()
@@ -217,7 +223,7 @@ func WaitForVSAvailable(d *schema.ResourceData, meta interface{}, timeout time.D
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func vsReadyRefreshStateFunc(sess *slsession.Session, ifcID int) resource.StateRefreshFunc {
@@ -4,23 +4,25 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strconv"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
 )
 
 func ResourceIBMNetworkVlanSpan() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkVlanSpanCreate,
-		Read:     resourceIBMNetworkVlanSpanRead,
-		Update:   resourceIBMNetworkVlanSpanUpdate,
-		Delete:   resourceIBMNetworkVlanSpanDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkVlanSpanCreate,
+		ReadContext:   resourceIBMNetworkVlanSpanRead,
+		UpdateContext: resourceIBMNetworkVlanSpanUpdate,
+		DeleteContext: resourceIBMNetworkVlanSpanDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"vlan_spanning": {
@@ -33,14 +35,14 @@ func ResourceIBMNetworkVlanSpan() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkVlanSpanRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanSpanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
 	vlanSpan, err := service.GetNetworkVlanSpan()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving vlan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving vlan: %s", err))
 	}
 
 	if *vlanSpan.EnabledFlag == true {
@@ -52,7 +54,7 @@ func resourceIBMNetworkVlanSpanRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMNetworkVlanSpanCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanSpanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
@@ -68,14 +70,14 @@ func resourceIBMNetworkVlanSpanCreate(d *schema.ResourceData, meta interface{})
 
 	_, err := service.SetVlanSpan(&enabled)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error settinging VLAN Spanning %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error settinging VLAN Spanning %s", err))
 	}
 
 	d.SetId(strconv.Itoa(rnd))
-	return resourceIBMNetworkVlanSpanRead(d, meta)
+	return resourceIBMNetworkVlanSpanRead(ctx, d, meta)
 }
 
-func resourceIBMNetworkVlanSpanUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanSpanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 	vlanSpanning := d.Get("vlan_spanning").(string)
@@ -89,13 +91,13 @@ func resourceIBMNetworkVlanSpanUpdate(d *schema.ResourceData, meta interface{})
 
 	_, err := service.SetVlanSpan(&enabled)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error settinging VLAN Spanning %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error settinging VLAN Spanning %s", err))
 	}
 
-	return resourceIBMNetworkVlanSpanRead(d, meta)
+	return resourceIBMNetworkVlanSpanRead(ctx, d, meta)
 }
 
-func resourceIBMNetworkVlanSpanDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanSpanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Leave VLAN Spanning setting in current state
 	d.SetId("")
 
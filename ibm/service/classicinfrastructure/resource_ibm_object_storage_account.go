@@ -4,6 +4,8 @@
 package classicinfrastructure
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -22,12 +25,12 @@ import (
 
 func ResourceIBMObjectStorageAccount() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMObjectStorageAccountCreate,
-		Read:     resourceIBMObjectStorageAccountRead,
-		Update:   resourceIBMObjectStorageAccountUpdate,
-		Delete:   resourceIBMObjectStorageAccountDelete,
-		Exists:   resourceIBMObjectStorageAccountExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMObjectStorageAccountCreate,
+		ReadContext:   resourceIBMObjectStorageAccountRead,
+		UpdateContext: resourceIBMObjectStorageAccountUpdate,
+		DeleteContext: resourceIBMObjectStorageAccountDelete,
+		Exists:        resourceIBMObjectStorageAccountExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -48,14 +51,14 @@ func ResourceIBMObjectStorageAccount() *schema.Resource {
 	}
 }
 
-func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMObjectStorageAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	accountService := services.GetAccountService(sess)
 
 	// Check if an object storage account exists
 	objectStorageAccounts, err := accountService.GetHubNetworkStorage()
 	if err != nil {
-		return fmt.Errorf("resource_ibm_object_storage_account: Error on create: %s", err)
+		return diag.FromErr(fmt.Errorf("resource_ibm_object_storage_account: Error on create: %s", err))
 	}
 
 	if len(objectStorageAccounts) == 0 {
@@ -70,15 +73,15 @@ func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interfac
 			},
 		}, sl.Bool(false))
 		if err != nil {
-			return fmt.Errorf(
-				"resource_ibm_object_storage_account: Error ordering account: %s", err)
+			return diag.FromErr(fmt.Errorf(
+				"resource_ibm_object_storage_account: Error ordering account: %s", err))
 		}
 
 		// Wait for the object storage account order to complete.
-		billingOrderItem, err := WaitForOrderCompletion(&receipt, meta)
+		billingOrderItem, err := WaitForOrderCompletion(ctx, &receipt, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for object storage account order (%d) to complete: %s", receipt.OrderId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for object storage account order (%d) to complete: %s", receipt.OrderId, err))
 		}
 
 		// Get accountName using filter on hub network storage
@@ -86,11 +89,11 @@ func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interfac
 			filter.Path("billingItem.id").Eq(billingOrderItem.BillingItem.Id).Build(),
 		).GetNetworkStorage()
 		if err != nil {
-			return fmt.Errorf("resource_ibm_object_storage_account: Error on retrieving new: %s", err)
+			return diag.FromErr(fmt.Errorf("resource_ibm_object_storage_account: Error on retrieving new: %s", err))
 		}
 
 		if len(objectStorageAccounts) == 0 {
-			return fmt.Errorf("resource_ibm_object_storage_account: Failed to create object storage account.")
+			return diag.FromErr(fmt.Errorf("resource_ibm_object_storage_account: Failed to create object storage account."))
 		}
 	}
 
@@ -101,7 +104,7 @@ func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func WaitForOrderCompletion(
+func WaitForOrderCompletion(ctx context.Context,
 	receipt *datatypes.Container_Product_Order_Receipt, meta interface{}) (datatypes.Billing_Order_Item, error) {
 
 	log.Printf("Waiting for billing order %d to have zero active transactions", receipt.OrderId)
@@ -130,11 +133,11 @@ func WaitForOrderCompletion(
 		MinTimeout: 10 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	return *billingOrderItem, err
 }
 
-func resourceIBMObjectStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMObjectStorageAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	accountService := services.GetAccountService(sess)
 	accountName := d.Id()
@@ -145,7 +148,7 @@ func resourceIBMObjectStorageAccountRead(d *schema.ResourceData, meta interface{
 		filter.Path("username").Eq(accountName).Build(),
 	).GetHubNetworkStorage()
 	if err != nil {
-		return fmt.Errorf("resource_ibm_object_storage_account: Error on Read: %s", err)
+		return diag.FromErr(fmt.Errorf("resource_ibm_object_storage_account: Error on Read: %s", err))
 	}
 
 	for _, objectStorageAccount := range objectStorageAccounts {
@@ -154,26 +157,26 @@ func resourceIBMObjectStorageAccountRead(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	return fmt.Errorf("resource_ibm_object_storage_account: Could not find account %s", accountName)
+	return diag.FromErr(fmt.Errorf("resource_ibm_object_storage_account: Could not find account %s", accountName))
 }
 
-func resourceIBMObjectStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMObjectStorageAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to update for now. Not supported.
 	return nil
 }
 
-func resourceIBMObjectStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMObjectStorageAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Delete is not supported for now.
 	return nil
 }
 
 func resourceIBMObjectStorageAccountExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	err := resourceIBMObjectStorageAccountRead(d, meta)
-	if err != nil {
-		if strings.Contains(err.Error(), "Could not find account") {
+	diags := resourceIBMObjectStorageAccountRead(context.Background(), d, meta)
+	if diags.HasError() {
+		if strings.Contains(diags[0].Summary, "Could not find account") {
 			return false, nil
 		}
-		return false, err
+		return false, errors.New(diags[0].Summary)
 	}
 	return true, nil
 }
@@ -4,11 +4,13 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -18,12 +20,12 @@ import (
 
 func ResourceIBMSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSecurityGroupCreate,
-		Read:     resourceIBMSecurityGroupRead,
-		Update:   resourceIBMSecurityGroupUpdate,
-		Delete:   resourceIBMSecurityGroupDelete,
-		Exists:   resourceIBMSecurityGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSecurityGroupCreate,
+		ReadContext:   resourceIBMSecurityGroupRead,
+		UpdateContext: resourceIBMSecurityGroupUpdate,
+		DeleteContext: resourceIBMSecurityGroupDelete,
+		Exists:        resourceIBMSecurityGroupExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -41,7 +43,7 @@ func ResourceIBMSecurityGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess.SetRetries(0))
 
@@ -74,7 +76,7 @@ func resourceIBMSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 		if editSG {
 			_, err = service.EditObject(&group)
-			return err
+			return diag.FromErr(err)
 		}
 
 		return nil
@@ -85,16 +87,16 @@ func resourceIBMSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	res, err := service.CreateObject(sg)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Security Group: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
 	log.Printf("[INFO] Security Group: %d", *res.Id)
 
-	return resourceIBMSecurityGroupRead(d, meta)
+	return resourceIBMSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
@@ -107,7 +109,7 @@ func resourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error retrieving Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Security Group: %s", err))
 	}
 
 	d.Set("name", group.Name)
@@ -115,18 +117,18 @@ func resourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
 	groupID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	group, err := services.GetNetworkSecurityGroupService(sess).Id(groupID).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Security Group: %s", err))
 	}
 
 	if d.HasChange("description") {
@@ -138,23 +140,23 @@ func resourceIBMSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 	_, err = service.Id(groupID).EditObject(&group)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error editing Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error editing Security Group: %s", err))
 	}
-	return resourceIBMSecurityGroupRead(d, meta)
+	return resourceIBMSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceIBMSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
 	groupID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 	log.Printf("[INFO] Deleting Security Group: %d", groupID)
 	_, err = service.Id(groupID).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Security Group: %s", err))
 	}
 
 	d.SetId("")
@@ -4,12 +4,14 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -19,12 +21,12 @@ import (
 
 func ResourceIBMSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSecurityGroupRuleCreate,
-		Read:     resourceIBMSecurityGroupRuleRead,
-		Delete:   resourceIBMSecurityGroupRuleDelete,
-		Update:   resourceIBMSecurityGroupRuleUpdate,
-		Exists:   resourceIBMSecurityGroupRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSecurityGroupRuleCreate,
+		ReadContext:   resourceIBMSecurityGroupRuleRead,
+		DeleteContext: resourceIBMSecurityGroupRuleDelete,
+		UpdateContext: resourceIBMSecurityGroupRuleUpdate,
+		Exists:        resourceIBMSecurityGroupRuleExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"direction": {
@@ -119,7 +121,7 @@ func findMatchingRule(sgID int, rule *datatypes.Network_SecurityGroup_Rule,
 	return &rules[0], nil
 }
 
-func resourceIBMSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
@@ -164,7 +166,7 @@ func resourceIBMSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 
 	matchingrule, err := findMatchingRule(sgID, &sgrule, service)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if matchingrule != nil {
@@ -179,19 +181,19 @@ func resourceIBMSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 	log.Println("[INFO] creating security group rule")
 	_, err = service.Id(sgID).AddRules(opts)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Security Group Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Security Group Rule: %s", err))
 	}
 
 	matchingrule, err = findMatchingRule(sgID, &sgrule, service)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(strconv.Itoa(*matchingrule.Id))
 
-	return resourceIBMSecurityGroupRuleRead(d, meta)
+	return resourceIBMSecurityGroupRuleRead(ctx, d, meta)
 }
 
-func resourceIBMSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
@@ -205,7 +207,7 @@ func resourceIBMSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error retrieving Security Group Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Security Group Rule: %s", err))
 	}
 
 	if len(matchingrules) == 0 {
@@ -237,14 +239,14 @@ func resourceIBMSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	securityGroupID := d.Get("security_group_id").(int)
 	matchingrules, err := service.Filter(filter.Build(
 		filter.Path("rules.id").Eq(d.Id()))).Id(securityGroupID).GetRules()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving Security Group Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Security Group Rule: %s", err))
 	}
 	if d.HasChange("direction") {
 		matchingrules[0].Direction = sl.String(d.Get("direction").(string))
@@ -269,12 +271,12 @@ func resourceIBMSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}
 	}
 	_, err = service.Id(securityGroupID).EditRules([]datatypes.Network_SecurityGroup_Rule{matchingrules[0]})
 	if err != nil {
-		return fmt.Errorf("[ERROR] Could n't update Security Group Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Could n't update Security Group Rule: %s", err))
 	}
-	return resourceIBMSecurityGroupRuleRead(d, meta)
+	return resourceIBMSecurityGroupRuleRead(ctx, d, meta)
 }
 
-func resourceIBMSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID := d.Get("security_group_id").(int)
@@ -285,7 +287,7 @@ func resourceIBMSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Security Group Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Security Group Rule: %s", err))
 	}
 
 	d.SetId("")
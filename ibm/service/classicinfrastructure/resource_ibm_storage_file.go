@@ -5,6 +5,7 @@ package classicinfrastructure
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -15,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -69,12 +71,12 @@ var (
 
 func ResourceIBMStorageFile() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMStorageFileCreate,
-		Read:     resourceIBMStorageFileRead,
-		Update:   resourceIBMStorageFileUpdate,
-		Delete:   resourceIBMStorageFileDelete,
-		Exists:   resourceIBMStorageFileExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMStorageFileCreate,
+		ReadContext:   resourceIBMStorageFileRead,
+		UpdateContext: resourceIBMStorageFileUpdate,
+		DeleteContext: resourceIBMStorageFileDelete,
+		Exists:        resourceIBMStorageFileExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(45 * time.Minute),
@@ -258,7 +260,7 @@ func ResourceIBMStorageFile() *schema.Resource {
 	}
 }
 
-func resourceIBMStorageFileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	storageType := d.Get("type").(string)
@@ -275,7 +277,7 @@ func resourceIBMStorageFileCreate(d *schema.ResourceData, meta interface{}) erro
 
 	storageOrderContainer, err = buildStorageProductOrderContainer(sess, storageType, iops, capacity, snapshotCapacity, fileStorage, datacenter, hourlyBilling)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating storage:%s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while creating storage:%s", err))
 	}
 
 	log.Println("[INFO] Creating storage")
@@ -298,42 +300,42 @@ func resourceIBMStorageFileCreate(d *schema.ResourceData, meta interface{}) erro
 			}, sl.Bool(false))
 
 	default:
-		return fmt.Errorf("[ERROR] Error during creation of storage: Invalid storageType %s", storageType)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of storage: Invalid storageType %s", storageType))
 	}
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of storage: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of storage: %s", err))
 	}
 
 	// Find the storage device
-	fileStorage, err := findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	fileStorage, err := findStorageByOrderId(ctx, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of storage: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of storage: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", *fileStorage.Id))
 
 	// Wait for storage availability
-	_, err = WaitForStorageAvailable(d, meta)
+	_, err = WaitForStorageAvailable(ctx, d, meta)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for storage (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for storage (%s) to become ready: %s", d.Id(), err))
 	}
 
 	// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
-	fileStorage, err = findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	fileStorage, err = findStorageByOrderId(ctx, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of storage: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of storage: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", *fileStorage.Id))
 
 	log.Printf("[INFO] Storage ID: %s", d.Id())
 
-	return resourceIBMStorageFileUpdate(d, meta)
+	return resourceIBMStorageFileUpdate(ctx, d, meta)
 }
 
-func resourceIBMStorageFileRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	storageId, _ := strconv.Atoi(d.Id())
@@ -344,18 +346,18 @@ func resourceIBMStorageFileRead(d *schema.ResourceData, meta interface{}) error
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving storage information: %s", err))
 	}
 
 	storageType, err := getStorageTypeFromKeyName(*storage.StorageType.KeyName)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving storage information: %s", err))
 	}
 
 	// Calculate IOPS
 	iops, err := getIops(storage, storageType)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving storage information: %s", err))
 	}
 	d.Set("iops", iops)
 
@@ -412,7 +414,7 @@ func resourceIBMStorageFileRead(d *schema.ResourceData, meta interface{}) error
 
 	mountpoint, err := services.GetNetworkStorageService(sess).Id(storageId).GetFileNetworkMountAddress()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving storage information: %s", err))
 	}
 	d.Set("mountpoint", mountpoint)
 
@@ -456,11 +458,11 @@ func resourceIBMStorageFileRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageFileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	storage, err := services.GetNetworkStorageService(sess).
@@ -469,14 +471,14 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 	}
 
 	// Update allowed_ip_addresses
 	if d.HasChange("allowed_ip_addresses") {
 		err := updateAllowedIpAddresses(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 		}
 	}
 
@@ -484,7 +486,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("allowed_subnets") {
 		err := updateAllowedSubnets(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 		}
 	}
 
@@ -492,7 +494,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("allowed_virtual_guest_ids") {
 		err := updateAllowedVirtualGuestIds(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 		}
 	}
 
@@ -500,7 +502,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("allowed_hardware_ids") {
 		err := updateAllowedHardwareIds(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 		}
 	}
 
@@ -508,7 +510,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("notes") {
 		err := updateNotes(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 		}
 	}
 
@@ -516,7 +518,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("snapshot_schedule") {
 		err := enableStorageSnapshot(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating storage snapshot schedule: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating storage snapshot schedule: %s", err))
 		}
 	}
 
@@ -526,7 +528,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 
 		modifyOrder, err := prepareModifyOrder(sess, storage, iops, size)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage: %s", err))
 		}
 
 		_, err = services.GetProductOrderService(sess.SetRetries(0)).PlaceOrder(
@@ -537,18 +539,18 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 				},
 			}, sl.Bool(false))
 		// Wait for storage availability
-		_, err = WaitForStorageUpdate(d, meta)
+		_, err = WaitForStorageUpdate(ctx, d, meta)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for storage (%s) to update: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for storage (%s) to update: %s", d.Id(), err))
 		}
 	}
 
-	return resourceIBMStorageFileRead(d, meta)
+	return resourceIBMStorageFileRead(ctx, d, meta)
 }
 
-func resourceIBMStorageFileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	storageService := services.GetNetworkStorageService(sess)
 	storageID, _ := strconv.Atoi(d.Id())
@@ -557,20 +559,20 @@ func resourceIBMStorageFileDelete(d *schema.ResourceData, meta interface{}) erro
 	billingItem, err := storageService.Id(storageID).GetBillingItem()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while looking up billing item associated with the storage: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while looking up billing item associated with the storage: %s", err))
 	}
 
 	if billingItem.Id == nil {
-		return fmt.Errorf("[ERROR] Error while looking up billing item associated with the storage: No billing item for ID:%d", storageID)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while looking up billing item associated with the storage: No billing item for ID:%d", storageID))
 	}
 
 	success, err := services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diag.FromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 	return nil
 }
@@ -699,7 +701,7 @@ func buildStorageProductOrderContainer(
 	return productOrderContainer, nil
 }
 
-func findStorageByOrderId(sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Storage, error) {
+func findStorageByOrderId(ctx context.Context, sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Storage, error) {
 	filterPath := "networkStorage.billingItem.orderItem.order.id"
 
 	stateConf := &resource.StateChangeConf{
@@ -730,7 +732,7 @@ func findStorageByOrderId(sess *session.Session, orderId int, timeout time.Durat
 		NotFoundChecks: 300,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Storage{}, err
@@ -747,7 +749,7 @@ func findStorageByOrderId(sess *session.Session, orderId int, timeout time.Durat
 }
 
 // Waits for storage provisioning
-func WaitForStorageAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForStorageAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for storage (%s) to be available.", d.Id())
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -799,7 +801,7 @@ func WaitForStorageAvailable(d *schema.ResourceData, meta interface{}) (interfac
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func getIops(storage datatypes.Network_Storage, storageType string) (float64, error) {
@@ -1517,7 +1519,7 @@ func findEnduranceTierIopsPerGb(originalVolume datatypes.Network_Storage) (iopsP
 }
 
 // Waits for storage update
-func WaitForStorageUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForStorageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for storage (%s) to be updated.", d.Id())
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -1556,5 +1558,5 @@ func WaitForStorageUpdate(d *schema.ResourceData, meta interface{}) (interface{}
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -37,12 +39,12 @@ var (
 
 func ResourceIBMSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSubnetCreate,
-		Read:     resourceIBMSubnetRead,
-		Update:   resourceIBMSubnetUpdate,
-		Delete:   resourceIBMSubnetDelete,
-		Exists:   resourceIBMSubnetExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSubnetCreate,
+		ReadContext:   resourceIBMSubnetRead,
+		UpdateContext: resourceIBMSubnetUpdate,
+		DeleteContext: resourceIBMSubnetDelete,
+		Exists:        resourceIBMSubnetExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
@@ -139,13 +141,13 @@ func ResourceIBMSubnet() *schema.Resource {
 	}
 }
 
-func resourceIBMSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	// Find price items with AdditionalServicesSubnetAddresses
 	productOrderContainer, err := buildSubnetProductOrderContainer(d, sess)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating subnet: %s", err))
 	}
 
 	log.Println("[INFO] Creating subnet")
@@ -153,31 +155,31 @@ func resourceIBMSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of subnet: %s", err))
 	}
 
-	Subnet, err := findSubnetByOrderID(sess, *receipt.OrderId, d)
+	Subnet, err := findSubnetByOrderID(ctx, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of subnet: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *Subnet.Id))
 
-	return resourceIBMSubnetUpdate(d, meta)
+	return resourceIBMSubnetUpdate(ctx, d, meta)
 }
 
-func resourceIBMSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetService(sess)
 
 	subnetID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid subnet ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid subnet ID, must be an integer: %s", err))
 	}
 
 	subnet, err := service.Id(subnetID).Mask(SubnetMask).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving a subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving a subnet: %s", err))
 	}
 
 	if *subnet.AddressSpace == "PRIVATE" {
@@ -187,14 +189,14 @@ func resourceIBMSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if subnet.SubnetType == nil {
-		return fmt.Errorf("[ERROR] Invalid vlan type: the subnet type is null")
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid vlan type: the subnet type is null"))
 	}
 	if strings.Contains(*subnet.SubnetType, "STATIC") {
 		d.Set("type", "Static")
 	} else if strings.Contains(*subnet.SubnetType, "VLAN") {
 		d.Set("type", "Portable")
 	} else {
-		return fmt.Errorf("[ERROR] Invalid vlan type: %s", *subnet.SubnetType)
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid vlan type: %s", *subnet.SubnetType))
 	}
 	d.Set("ip_version", *subnet.Version)
 	d.Set("capacity", *subnet.TotalIpAddresses)
@@ -216,36 +218,36 @@ func resourceIBMSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetService(sess)
 
 	subnetID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid subnet ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid subnet ID, must be an integer: %s", err))
 	}
 
 	if d.HasChange("notes") {
 		_, err = service.Id(subnetID).EditNote(sl.String(d.Get("notes").(string)))
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating subnet: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating subnet: %s", err))
 		}
 	}
-	return resourceIBMSubnetRead(d, meta)
+	return resourceIBMSubnetRead(ctx, d, meta)
 }
 
-func resourceIBMSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetService(sess)
 
 	subnetID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid subnet ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid subnet ID, must be an integer: %s", err))
 	}
 
 	billingItem, err := service.Id(subnetID).GetBillingItem()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting subnet: %s", err))
 	}
 
 	if billingItem.Id == nil {
@@ -253,10 +255,10 @@ func resourceIBMSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 	_, err = services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting subnet: %s", err))
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
 func resourceIBMSubnetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -278,7 +280,7 @@ func resourceIBMSubnetExists(d *schema.ResourceData, meta interface{}) (bool, er
 	return result.Id != nil && *result.Id == subnetID, nil
 }
 
-func findSubnetByOrderID(sess *session.Session, orderID int, d *schema.ResourceData) (datatypes.Network_Subnet, error) {
+func findSubnetByOrderID(ctx context.Context, sess *session.Session, orderID int, d *schema.ResourceData) (datatypes.Network_Subnet, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -303,7 +305,7 @@ func findSubnetByOrderID(sess *session.Session, orderID int, d *schema.ResourceD
 		NotFoundChecks: 1440,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Subnet{}, err
@@ -4,6 +4,7 @@
 package cloudant
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -123,17 +124,17 @@ func dataSourceIBMCloudantRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	err = setCloudantActivityTrackerEvents(client, d)
+	err = setCloudantActivityTrackerEvents(context.Background(), client, d)
 	if err != nil {
 		return err
 	}
 
-	err = setCloudantInstanceCapacity(client, d)
+	err = setCloudantInstanceCapacity(context.Background(), client, d)
 	if err != nil {
 		return err
 	}
 
-	err = setCloudantInstanceCors(client, d)
+	err = setCloudantInstanceCors(context.Background(), client, d)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -112,12 +113,12 @@ func ResourceIBMCloudant() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create:   resourceIBMCloudantCreate,
-		Read:     resourceIBMCloudantRead,
-		Update:   resourceIBMCloudantUpdate,
-		Delete:   resourcecontroller.ResourceIBMResourceInstanceDelete,
-		Exists:   resourcecontroller.ResourceIBMResourceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCloudantCreate,
+		ReadContext:   resourceIBMCloudantRead,
+		UpdateContext: resourceIBMCloudantUpdate,
+		DeleteContext: resourcecontroller.ResourceIBMResourceInstanceDelete,
+		Exists:        resourcecontroller.ResourceIBMResourceInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -127,7 +128,7 @@ func ResourceIBMCloudant() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -135,17 +136,17 @@ func ResourceIBMCloudant() *schema.Resource {
 	}
 }
 
-func resourceIBMCloudantCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCloudantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.Set("service", "cloudantnosqldb")
 
 	err := validateCloudantInstanceCapacity(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = validateCloudantInstanceCors(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	params := make(map[string]interface{})
@@ -175,9 +176,8 @@ func resourceIBMCloudantCreate(d *schema.ResourceData, meta interface{}) error {
 		d.Set("parameters", params)
 	}
 
-	err = resourcecontroller.ResourceIBMResourceInstanceCreate(d, meta)
-	if err != nil {
-		return err
+	if diags := resourcecontroller.ResourceIBMResourceInstanceCreate(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 
 	// return original parameters on state
@@ -185,120 +185,118 @@ func resourceIBMCloudantCreate(d *schema.ResourceData, meta interface{}) error {
 
 	client, err := getCloudantClient(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// if matches an instance creation default skip request
 	if d.Get("include_data_events").(bool) {
-		err := updateCloudantActivityTrackerEvents(client, d)
+		err := updateCloudantActivityTrackerEvents(ctx, client, d)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating activity tracker events: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating activity tracker events: %s", err))
 		}
 	}
 
 	// if matches an instance creation default skip request
 	if d.Get("capacity").(int) > 1 {
-		err := updateCloudantInstanceCapacity(client, d)
+		err := updateCloudantInstanceCapacity(ctx, client, d)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving capacity throughput information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving capacity throughput information: %s", err))
 		}
 	}
 
-	err = updateCloudantInstanceCors(client, d)
+	err = updateCloudantInstanceCors(ctx, client, d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating CORS settings: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating CORS settings: %s", err))
 	}
 
-	return resourceIBMCloudantRead(d, meta)
+	return resourceIBMCloudantRead(ctx, d, meta)
 }
 
-func resourceIBMCloudantRead(d *schema.ResourceData, meta interface{}) error {
-	err := resourcecontroller.ResourceIBMResourceInstanceRead(d, meta)
-	if err != nil {
-		return err
+func resourceIBMCloudantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := resourcecontroller.ResourceIBMResourceInstanceRead(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 
-	err = setCloudantLegacyCredentials(d, meta)
+	err := setCloudantLegacyCredentials(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = setCloudantResourceControllerURL(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	client, err := getCloudantClient(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = setCloudantActivityTrackerEvents(client, d)
+	err = setCloudantActivityTrackerEvents(ctx, client, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = setCloudantInstanceCapacity(client, d)
+	err = setCloudantInstanceCapacity(ctx, client, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = setCloudantInstanceCors(client, d)
+	err = setCloudantInstanceCors(ctx, client, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceIBMCloudantUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCloudantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.Set("service", "cloudantnosqldb")
 
 	err := validateCloudantInstanceCapacity(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = validateCloudantInstanceCors(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resourcecontroller.ResourceIBMResourceInstanceUpdate(d, meta)
-	if err != nil {
-		return err
+	if diags := resourcecontroller.ResourceIBMResourceInstanceUpdate(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 
 	client, err := getCloudantClient(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("include_data_events") {
-		err := updateCloudantActivityTrackerEvents(client, d)
+		err := updateCloudantActivityTrackerEvents(ctx, client, d)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating activity tracker events: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating activity tracker events: %s", err))
 		}
 	}
 
 	if d.HasChange("capacity") {
-		err := updateCloudantInstanceCapacity(client, d)
+		err := updateCloudantInstanceCapacity(ctx, client, d)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving capacity throughput information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving capacity throughput information: %s", err))
 		}
 	}
 
 	if d.HasChange("enable_cors") {
-		err := updateCloudantInstanceCors(client, d)
+		err := updateCloudantInstanceCors(ctx, client, d)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating CORS settings: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating CORS settings: %s", err))
 		}
 	}
 
-	return resourceIBMCloudantRead(d, meta)
+	return resourceIBMCloudantRead(ctx, d, meta)
 }
 
-func setCloudantLegacyCredentials(d *schema.ResourceData, meta interface{}) error {
+func setCloudantLegacyCredentials(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return err
@@ -309,7 +307,7 @@ func setCloudantLegacyCredentials(d *schema.ResourceData, meta interface{}) erro
 		ID: &instanceID,
 	}
 
-	instance, response, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving resource instance: %s\n%s", err, response)
 		return err
@@ -373,7 +371,7 @@ func getCloudantClient(d *schema.ResourceData, meta interface{}) (*cloudantv1.Cl
 		}
 	}
 
-	endpoint = conns.EnvFallBack([]string{"IBMCLOUD_CLOUDANT_ENDPOINT"}, endpoint)
+	endpoint = meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_CLOUDANT_ENDPOINT", endpoint)
 	if endpoint == "" {
 		return nil, fmt.Errorf("[ERROR] Missing endpoints.public in extensions")
 	}
@@ -425,8 +423,8 @@ func GetCloudantClientForUrl(endpoint string, meta interface{}) (*cloudantv1.Clo
 	return client, nil
 }
 
-func setCloudantActivityTrackerEvents(client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
-	activityTrackerEvents, err := readCloudantActivityTrackerEvents(client)
+func setCloudantActivityTrackerEvents(ctx context.Context, client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
+	activityTrackerEvents, err := readCloudantActivityTrackerEvents(ctx, client)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving activity tracker events: %s", err)
 	}
@@ -442,17 +440,17 @@ func setCloudantActivityTrackerEvents(client *cloudantv1.CloudantV1, d *schema.R
 	return nil
 }
 
-func readCloudantActivityTrackerEvents(client *cloudantv1.CloudantV1) (*cloudantv1.ActivityTrackerEvents, error) {
+func readCloudantActivityTrackerEvents(ctx context.Context, client *cloudantv1.CloudantV1) (*cloudantv1.ActivityTrackerEvents, error) {
 	opts := client.NewGetActivityTrackerEventsOptions()
 
-	activityTrackerEvents, response, err := client.GetActivityTrackerEvents(opts)
+	activityTrackerEvents, response, err := client.GetActivityTrackerEventsWithContext(ctx, opts)
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving activity tracker events: %s\n%s", err, response)
 	}
 	return activityTrackerEvents, err
 }
 
-func updateCloudantActivityTrackerEvents(client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
+func updateCloudantActivityTrackerEvents(ctx context.Context, client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
 	auditEventTypes := []string{"management"}
 	if d.Get("include_data_events").(bool) {
 		auditEventTypes = append(auditEventTypes, "data")
@@ -460,7 +458,7 @@ func updateCloudantActivityTrackerEvents(client *cloudantv1.CloudantV1, d *schem
 
 	opts := client.NewPostActivityTrackerEventsOptions(auditEventTypes)
 
-	_, response, err := client.PostActivityTrackerEvents(opts)
+	_, response, err := client.PostActivityTrackerEventsWithContext(ctx, opts)
 	if err != nil {
 		log.Printf("[DEBUG] Error updating activity tracker events: %s\n%s", err, response)
 	}
@@ -476,8 +474,8 @@ func validateCloudantInstanceCapacity(d *schema.ResourceData) error {
 	return nil
 }
 
-func setCloudantInstanceCapacity(client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
-	capacityThroughputInformation, err := readCloudantInstanceCapacity(client)
+func setCloudantInstanceCapacity(ctx context.Context, client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
+	capacityThroughputInformation, err := readCloudantInstanceCapacity(ctx, client)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving capacity throughput information: %s", err)
 	}
@@ -501,36 +499,36 @@ func setCloudantInstanceCapacity(client *cloudantv1.CloudantV1, d *schema.Resour
 	return nil
 }
 
-func readCloudantInstanceCapacity(client *cloudantv1.CloudantV1) (*cloudantv1.CapacityThroughputInformation, error) {
+func readCloudantInstanceCapacity(ctx context.Context, client *cloudantv1.CloudantV1) (*cloudantv1.CapacityThroughputInformation, error) {
 	opts := client.NewGetCapacityThroughputInformationOptions()
 
-	capacityThroughputInformation, response, err := client.GetCapacityThroughputInformation(opts)
+	capacityThroughputInformation, response, err := client.GetCapacityThroughputInformationWithContext(ctx, opts)
 	if err != nil {
 		log.Printf("[DEBUG] Error getting capacity throughput information: %s\n%s", err, response)
 	}
 	return capacityThroughputInformation, nil
 }
 
-func updateCloudantInstanceCapacity(client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
+func updateCloudantInstanceCapacity(ctx context.Context, client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
 	blocks := int64(d.Get("capacity").(int))
 
 	putOpts := client.NewPutCapacityThroughputConfigurationOptions(blocks)
 
-	_, response, err := client.PutCapacityThroughputConfiguration(putOpts)
+	_, response, err := client.PutCapacityThroughputConfigurationWithContext(ctx, putOpts)
 	if err != nil {
 		log.Printf("[DEBUG] Error updating capacity throughput: %s\n%s", err, response)
 		return err
 	}
 
-	return isWaitForCapacityUpdated(client)
+	return isWaitForCapacityUpdated(ctx, client)
 }
 
-func isWaitForCapacityUpdated(client *cloudantv1.CloudantV1) error {
+func isWaitForCapacityUpdated(ctx context.Context, client *cloudantv1.CloudantV1) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"retry"},
 		Target:  []string{"done", "failed"},
 		Refresh: func() (interface{}, string, error) {
-			capacityThroughputInformation, err := readCloudantInstanceCapacity(client)
+			capacityThroughputInformation, err := readCloudantInstanceCapacity(ctx, client)
 			if err != nil {
 				return nil, "failed", err
 			}
@@ -550,7 +548,7 @@ func isWaitForCapacityUpdated(client *cloudantv1.CloudantV1) error {
 		MinTimeout: 2 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	return err
 }
 
@@ -568,8 +566,8 @@ func validateCloudantInstanceCors(d *schema.ResourceData) error {
 	return nil
 }
 
-func setCloudantInstanceCors(client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
-	corsInformation, err := readCloudantInstanceCors(client)
+func setCloudantInstanceCors(ctx context.Context, client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
+	corsInformation, err := readCloudantInstanceCors(ctx, client)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving CORS config: %s", err)
 	}
@@ -589,17 +587,17 @@ func setCloudantInstanceCors(client *cloudantv1.CloudantV1, d *schema.ResourceDa
 	return nil
 }
 
-func readCloudantInstanceCors(client *cloudantv1.CloudantV1) (*cloudantv1.CorsInformation, error) {
+func readCloudantInstanceCors(ctx context.Context, client *cloudantv1.CloudantV1) (*cloudantv1.CorsInformation, error) {
 	opts := client.NewGetCorsInformationOptions()
 
-	corsInformation, response, err := client.GetCorsInformation(opts)
+	corsInformation, response, err := client.GetCorsInformationWithContext(ctx, opts)
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving CORS config: %s\n%s", err, response)
 	}
 	return corsInformation, err
 }
 
-func updateCloudantInstanceCors(client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
+func updateCloudantInstanceCors(ctx context.Context, client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
 	enableCors := d.Get("enable_cors").(bool)
 	allowCredentials := true
 	origins := make([]string, 0)
@@ -614,7 +612,7 @@ func updateCloudantInstanceCors(client *cloudantv1.CloudantV1, d *schema.Resourc
 	opts.SetEnableCors(enableCors)
 	opts.SetAllowCredentials(allowCredentials)
 
-	_, response, err := client.PutCorsConfiguration(opts)
+	_, response, err := client.PutCorsConfigurationWithContext(ctx, opts)
 	if err != nil {
 		log.Printf("[DEBUG] Error updating CORS settings: %s\n%s", err, response)
 	}
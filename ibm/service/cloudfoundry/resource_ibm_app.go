@@ -4,6 +4,7 @@
 package cloudfoundry
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,18 +15,19 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
 
 func ResourceIBMApp() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMAppCreate,
-		Read:     resourceIBMAppRead,
-		Update:   resourceIBMAppUpdate,
-		Delete:   resourceIBMAppDelete,
-		Exists:   resourceIBMAppExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMAppCreate,
+		ReadContext:   resourceIBMAppRead,
+		UpdateContext: resourceIBMAppUpdate,
+		DeleteContext: resourceIBMAppDelete,
+		Exists:        resourceIBMAppExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -129,10 +131,10 @@ func ResourceIBMApp() *schema.Resource {
 	}
 }
 
-func resourceIBMAppCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	appAPI := cfClient.Apps()
 	name := d.Get("name").(string)
@@ -180,13 +182,13 @@ func resourceIBMAppCreate(d *schema.ResourceData, meta interface{}) error {
 
 	_, err = appAPI.FindByName(spaceGUID, name)
 	if err == nil {
-		return fmt.Errorf("%s already exists in the given space %s", name, spaceGUID)
+		return diag.FromErr(fmt.Errorf("%s already exists in the given space %s", name, spaceGUID))
 	}
 
 	log.Println("[INFO] Creating Cloud Foundary Application")
 	app, err := appAPI.Create(appCreatePayload)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating app: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating app: %s", err))
 	}
 
 	appGUID := app.Metadata.GUID
@@ -199,7 +201,7 @@ func resourceIBMAppCreate(d *schema.ResourceData, meta interface{}) error {
 		for _, routeID := range v.List() {
 			_, err := appAPI.BindRoute(appGUID, routeID.(string))
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error binding route %s to app: %s", routeID.(string), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error binding route %s to app: %s", routeID.(string), err))
 			}
 		}
 	}
@@ -212,40 +214,40 @@ func resourceIBMAppCreate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, err := sbAPI.Create(req)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error binding service instance %s to  app: %s", svcID.(string), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error binding service instance %s to  app: %s", svcID.(string), err))
 			}
 		}
 	}
 	log.Println("[INFO] Upload the app bits to the cloud foundary application")
 	applicationZip, err := processAppZipPath(d.Get("app_path").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, err = appAPI.Upload(appGUID, applicationZip)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error uploading app bits: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error uploading app bits: %s", err))
 	}
 
 	err = restartApp(appGUID, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Application: %s has started successfully", name)
-	return resourceIBMAppRead(d, meta)
+	return resourceIBMAppRead(ctx, d, meta)
 }
 
-func resourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	appAPI := cfClient.Apps()
 	appGUID := d.Id()
 
 	appData, err := appAPI.Get(appGUID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving app details %s : %s", appGUID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving app details %s : %s", appGUID, err))
 	}
 
 	d.SetId(appData.Metadata.GUID)
@@ -263,7 +265,7 @@ func resourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
 
 	route, err := appAPI.ListRoutes(appGUID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(route) > 0 {
 		d.Set("route_guid", flex.FlattenRoute(route))
@@ -271,7 +273,7 @@ func resourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
 
 	svcBindings, err := appAPI.ListServiceBindings(appGUID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(svcBindings) > 0 {
 		d.Set("service_instance_guid", flex.FlattenServiceBindings(svcBindings))
@@ -281,10 +283,10 @@ func resourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMAppUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	appAPI := cfClient.Apps()
 	appGUID := d.Id()
@@ -349,30 +351,30 @@ func resourceIBMAppUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	_, err = appAPI.Update(appGUID, appUpdatePayload)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating application: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating application: %s", err))
 	}
 	//TODO find the digest of the zip and avoid upload if it is same
 	if d.HasChange("app_path") || d.HasChange("app_version") {
 		appZipLoc, err := processAppZipPath(d.Get("app_path").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		log.Println("[DEBUG] Uploading application bits")
 		_, err = appAPI.Upload(appGUID, appZipLoc)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error uploading  app: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error uploading  app: %s", err))
 		}
 		restartRequired = true
 	}
 
 	err = updateRouteGUID(appGUID, appAPI, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	restage, err := updateServiceInstanceGUID(appGUID, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if restage {
 		restageRequired = true
@@ -390,36 +392,36 @@ func resourceIBMAppUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Println("[INFO] Restage since buildpack has changed")
 		err := restageApp(appGUID, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if restartRequired {
 		err := restartApp(appGUID, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		//In case only memory/disk etc are updated then cloud controller would destroy the current instances
 		//and spin new ones, so we are waiting till they come up again
 		state, err := appAPI.WaitForInstanceStatus(v2.AppRunningState, appGUID, waitTimeout)
 		if waitTimeout != 0 && (err != nil || state != v2.AppRunningState) {
-			return fmt.Errorf("[ERROR] All applications instances aren't %s, Current status is %s, %q", v2.AppRunningState, state, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] All applications instances aren't %s, Current status is %s, %q", v2.AppRunningState, state, err))
 		}
 	}
 
-	return resourceIBMAppRead(d, meta)
+	return resourceIBMAppRead(ctx, d, meta)
 }
 
-func resourceIBMAppDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	appAPI := cfClient.Apps()
 	id := d.Id()
 
 	err = appAPI.Delete(id, false, true)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting app: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting app: %s", err))
 	}
 
 	d.SetId("")
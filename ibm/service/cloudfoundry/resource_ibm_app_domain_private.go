@@ -4,6 +4,7 @@
 package cloudfoundry
 
 import (
+	"context"
 	"fmt"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
@@ -11,17 +12,18 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMAppDomainPrivate() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMAppDomainPrivateCreate,
-		Read:     resourceIBMAppDomainPrivateRead,
-		Update:   resourceIBMAppDomainPrivateUpdate,
-		Delete:   resourceIBMAppDomainPrivateDelete,
-		Exists:   resourceIBMAppDomainPrivateExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMAppDomainPrivateCreate,
+		ReadContext:   resourceIBMAppDomainPrivateRead,
+		UpdateContext: resourceIBMAppDomainPrivateUpdate,
+		DeleteContext: resourceIBMAppDomainPrivateDelete,
+		Exists:        resourceIBMAppDomainPrivateExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -48,10 +50,10 @@ func ResourceIBMAppDomainPrivate() *schema.Resource {
 	}
 }
 
-func resourceIBMAppDomainPrivateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainPrivateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get("name").(string)
 	orgGUID := d.Get("org_guid").(string)
@@ -63,29 +65,29 @@ func resourceIBMAppDomainPrivateCreate(d *schema.ResourceData, meta interface{})
 
 	prdomain, err := cfClient.PrivateDomains().Create(params)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating private domain: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating private domain: %s", err))
 	}
 
 	d.SetId(prdomain.Metadata.GUID)
 
-	return resourceIBMAppDomainPrivateRead(d, meta)
+	return resourceIBMAppDomainPrivateRead(ctx, d, meta)
 }
 
-func resourceIBMAppDomainPrivateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainPrivateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Only tags are updated and that too locally hence nothing to validate and update in terms of real API at this point
 	return nil
 }
 
-func resourceIBMAppDomainPrivateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainPrivateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	prdomainGUID := d.Id()
 
 	prdomain, err := cfClient.PrivateDomains().Get(prdomainGUID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving private domain: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving private domain: %s", err))
 	}
 	d.Set("name", prdomain.Entity.Name)
 	d.Set("org_guid", prdomain.Entity.OwningOrganizationGUID)
@@ -93,17 +95,17 @@ func resourceIBMAppDomainPrivateRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMAppDomainPrivateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainPrivateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	prdomainGUID := d.Id()
 
 	err = cfClient.PrivateDomains().Delete(prdomainGUID, false)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting private domain: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting private domain: %s", err))
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package cloudfoundry
 
 import (
+	"context"
 	"fmt"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
@@ -11,17 +12,18 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMAppDomainShared() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMAppDomainSharedCreate,
-		Read:     resourceIBMAppDomainSharedRead,
-		Update:   resourceIBMAppDomainSharedUpdate,
-		Delete:   resourceIBMAppDomainSharedDelete,
-		Exists:   resourceIBMAppDomainSharedExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMAppDomainSharedCreate,
+		ReadContext:   resourceIBMAppDomainSharedRead,
+		UpdateContext: resourceIBMAppDomainSharedUpdate,
+		DeleteContext: resourceIBMAppDomainSharedDelete,
+		Exists:        resourceIBMAppDomainSharedExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -49,10 +51,10 @@ func ResourceIBMAppDomainShared() *schema.Resource {
 	}
 }
 
-func resourceIBMAppDomainSharedCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainSharedCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get("name").(string)
 	routerGroupGUID := d.Get("router_group_guid").(string)
@@ -64,29 +66,29 @@ func resourceIBMAppDomainSharedCreate(d *schema.ResourceData, meta interface{})
 
 	shdomain, err := cfClient.SharedDomains().Create(params)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating shared domain: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating shared domain: %s", err))
 	}
 
 	d.SetId(shdomain.Metadata.GUID)
 
-	return resourceIBMAppDomainSharedRead(d, meta)
+	return resourceIBMAppDomainSharedRead(ctx, d, meta)
 }
 
-func resourceIBMAppDomainSharedUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainSharedUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Only tags are updated and that too locally hence nothing to validate and update in terms of real API at this point
 	return nil
 }
 
-func resourceIBMAppDomainSharedRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainSharedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	shdomainGUID := d.Id()
 
 	shdomain, err := cfClient.SharedDomains().Get(shdomainGUID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving shared domain: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving shared domain: %s", err))
 	}
 	d.Set("name", shdomain.Entity.Name)
 	d.Set("router_group_guid", shdomain.Entity.RouterGroupGUID)
@@ -94,17 +96,17 @@ func resourceIBMAppDomainSharedRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMAppDomainSharedDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainSharedDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	shdomainGUID := d.Id()
 
 	err = cfClient.SharedDomains().Delete(shdomainGUID, false)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting shared domain: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting shared domain: %s", err))
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package cloudfoundry
 
 import (
+	"context"
 	"fmt"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
@@ -12,17 +13,18 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMAppRoute() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMAppRouteCreate,
-		Read:     resourceIBMAppRouteRead,
-		Update:   resourceIBMAppRouteUpdate,
-		Delete:   resourceIBMAppRouteDelete,
-		Exists:   resourceIBMAppRouteExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMAppRouteCreate,
+		ReadContext:   resourceIBMAppRouteRead,
+		UpdateContext: resourceIBMAppRouteUpdate,
+		DeleteContext: resourceIBMAppRouteDelete,
+		Exists:        resourceIBMAppRouteExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"host": {
@@ -69,10 +71,10 @@ func ResourceIBMAppRoute() *schema.Resource {
 	}
 }
 
-func resourceIBMAppRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	spaceGUID := d.Get("space_guid").(string)
@@ -97,24 +99,24 @@ func resourceIBMAppRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	route, err := cfClient.Routes().Create(params)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating route: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating route: %s", err))
 	}
 
 	d.SetId(route.Metadata.GUID)
 
-	return resourceIBMAppRouteRead(d, meta)
+	return resourceIBMAppRouteRead(ctx, d, meta)
 }
 
-func resourceIBMAppRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	routeGUID := d.Id()
 
 	route, err := cfClient.Routes().Get(routeGUID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving route: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving route: %s", err))
 	}
 
 	d.Set("host", route.Entity.Host)
@@ -128,10 +130,10 @@ func resourceIBMAppRouteRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMAppRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routeGUID := d.Id()
@@ -151,21 +153,21 @@ func resourceIBMAppRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	_, err = cfClient.Routes().Update(routeGUID, params)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating route: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating route: %s", err))
 	}
-	return resourceIBMAppRouteRead(d, meta)
+	return resourceIBMAppRouteRead(ctx, d, meta)
 }
 
-func resourceIBMAppRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	routeGUID := d.Id()
 
 	err = cfClient.Routes().Delete(routeGUID, false)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting route: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting route: %s", err))
 	}
 
 	d.SetId("")
@@ -4,9 +4,11 @@
 package cloudfoundry
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
@@ -26,12 +28,12 @@ var (
 
 func ResourceIBMOrg() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMOrgCreate,
-		Read:     resourceIBMOrgRead,
-		Delete:   resourceIBMOrgDelete,
-		Update:   resourceIBMOrgUpdate,
-		Exists:   resourceIBMOrgExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMOrgCreate,
+		ReadContext:   resourceIBMOrgRead,
+		DeleteContext: resourceIBMOrgDelete,
+		UpdateContext: resourceIBMOrgUpdate,
+		Exists:        resourceIBMOrgExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -83,10 +85,10 @@ func ResourceIBMOrg() *schema.Resource {
 		},
 	}
 }
-func resourceIBMOrgCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	orgName := d.Get("name").(string)
@@ -98,47 +100,47 @@ func resourceIBMOrgCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	orgFields, err := orgAPI.Create(req)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating organisation: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating organisation: %s", err))
 	}
 	orgGUID := orgFields.Metadata.GUID
 	d.SetId(orgGUID)
 
-	return resourceIBMOrgUpdate(d, meta)
+	return resourceIBMOrgUpdate(ctx, d, meta)
 }
 
-func resourceIBMOrgRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	id := d.Id()
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	orgOwnerID := userDetails.UserEmail
 	orgFields, err := orgAPI.Get(id)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving organisation: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving organisation: %s", err))
 	}
 	d.Set("name", orgFields.Entity.Name)
 	billingManager, err := orgAPI.ListBillingManager(id)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving billing manager in the org: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving billing manager in the org: %s", err))
 	}
 	managers, err := orgAPI.ListManager(id)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving managers in the org: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving managers in the org: %s", err))
 	}
 	auditors, err := orgAPI.ListAuditors(id)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving auditors in space: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving auditors in space: %s", err))
 	}
 	users, err := orgAPI.ListUsers(id)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving users in space: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving users in space: %s", err))
 	}
 	if len(auditors) > 0 {
 		d.Set("auditors", flex.FlattenOrgRole(auditors, ""))
@@ -158,10 +160,10 @@ func resourceIBMOrgRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMOrgUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	id := d.Id()
@@ -172,38 +174,38 @@ func resourceIBMOrgUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	_, err = orgAPI.Update(id, req)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating organisation: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating organisation: %s", err))
 	}
 	err = updateOrgBillingManagers(orgAPI, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = updateOrgManagers(meta, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = updateOrgAuditors(orgAPI, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = updateOrgUsers(meta, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMOrgRead(d, meta)
+	return resourceIBMOrgRead(ctx, d, meta)
 }
 
-func resourceIBMOrgDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	id := d.Id()
 	err = orgAPI.Delete(id, false)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting organisation: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting organisation: %s", err))
 	}
 	d.SetId("")
 	return nil
@@ -4,23 +4,25 @@
 package cloudfoundry
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMServiceKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMServiceKeyCreate,
-		Read:     resourceIBMServiceKeyRead,
-		Update:   resourceIBMServiceKeyUpdate,
-		Delete:   resourceIBMServiceKeyDelete,
-		Exists:   resourceIBMServiceKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMServiceKeyCreate,
+		ReadContext:   resourceIBMServiceKeyRead,
+		UpdateContext: resourceIBMServiceKeyUpdate,
+		DeleteContext: resourceIBMServiceKeyDelete,
+		Exists:        resourceIBMServiceKeyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -58,10 +60,10 @@ func ResourceIBMServiceKey() *schema.Resource {
 	}
 }
 
-func resourceIBMServiceKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get("name").(string)
 	serviceInstanceGUID := d.Get("service_instance_guid").(string)
@@ -83,29 +85,29 @@ func resourceIBMServiceKeyCreate(d *schema.ResourceData, meta interface{}) error
 
 	serviceKey, err := cfClient.ServiceKeys().Create(serviceInstanceGUID, name, keyParams)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating service key: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating service key: %s", err))
 	}
 
 	d.SetId(serviceKey.Metadata.GUID)
 
-	return resourceIBMServiceKeyRead(d, meta)
+	return resourceIBMServiceKeyRead(ctx, d, meta)
 }
 
-func resourceIBMServiceKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Only tags are updated and that too locally hence nothing to validate and update in terms of real API at this point
 	return nil
 }
 
-func resourceIBMServiceKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serviceKeyGUID := d.Id()
 
 	serviceKey, err := cfClient.ServiceKeys().Get(serviceKeyGUID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving service key: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service key: %s", err))
 	}
 	d.Set("credentials", flex.Flatten(serviceKey.Entity.Credentials))
 	d.Set("service_instance_guid", serviceKey.Entity.ServiceInstanceGUID)
@@ -114,17 +116,17 @@ func resourceIBMServiceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMServiceKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceKeyGUID := d.Id()
 
 	err = cfClient.ServiceKeys().Delete(serviceKeyGUID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting service key: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting service key: %s", err))
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package cloudfoundry
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
@@ -11,17 +12,18 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMSpace() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSpaceCreate,
-		Read:     resourceIBMSpaceRead,
-		Update:   resourceIBMSpaceUpdate,
-		Delete:   resourceIBMSpaceDelete,
-		Exists:   resourceIBMSpaceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSpaceCreate,
+		ReadContext:   resourceIBMSpaceRead,
+		UpdateContext: resourceIBMSpaceUpdate,
+		DeleteContext: resourceIBMSpaceDelete,
+		Exists:        resourceIBMSpaceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -72,10 +74,10 @@ func ResourceIBMSpace() *schema.Resource {
 	}
 }
 
-func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	org := d.Get("org").(string)
 	name := d.Get("name").(string)
@@ -86,14 +88,14 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	orgFields, err := cfClient.Organizations().FindByName(org, conns.BluemixRegion)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving org: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving org: %s", err))
 	}
 	req.OrgGUID = orgFields.GUID
 
 	if spaceQuota, ok := d.GetOk("space_quota"); ok {
 		quota, err := cfClient.SpaceQuotas().FindByName(spaceQuota.(string), orgFields.GUID)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving space quota: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving space quota: %s", err))
 		}
 		req.SpaceQuotaGUID = quota.GUID
 	}
@@ -101,7 +103,7 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 	spaceAPI := cfClient.Spaces()
 	space, err := spaceAPI.Create(req)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating space: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating space: %s", err))
 	}
 
 	spaceGUID := space.Metadata.GUID
@@ -112,7 +114,7 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 		for _, d := range developers {
 			_, err := spaceAPI.AssociateDeveloper(spaceGUID, d)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error associating developer %s with space %s : %s", d, spaceGUID, err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error associating developer %s with space %s : %s", d, spaceGUID, err))
 			}
 		}
 	}
@@ -122,7 +124,7 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 		for _, d := range auditors {
 			_, err := spaceAPI.AssociateAuditor(spaceGUID, d)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error associating auditor %s with space %s : %s", d, spaceGUID, err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error associating auditor %s with space %s : %s", d, spaceGUID, err))
 			}
 		}
 
@@ -132,18 +134,18 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 		for _, d := range managers {
 			_, err := spaceAPI.AssociateManager(spaceGUID, d)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error associating manager %s with space %s : %s", d, spaceGUID, err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error associating manager %s with space %s : %s", d, spaceGUID, err))
 			}
 		}
 	}
 
-	return resourceIBMSpaceRead(d, meta)
+	return resourceIBMSpaceRead(ctx, d, meta)
 }
 
-func resourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	spaceGUID := d.Id()
 
@@ -151,22 +153,22 @@ func resourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
 	orgAPI := cfClient.Organizations()
 	spaceDetails, err := spaceAPI.Get(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving space: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving space: %s", err))
 	}
 
 	auditors, err := spaceAPI.ListAuditors(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving auditors in the space: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving auditors in the space: %s", err))
 	}
 
 	managers, err := spaceAPI.ListManagers(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving managers in the space: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving managers in the space: %s", err))
 	}
 
 	developers, err := spaceAPI.ListDevelopers(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving developers in space: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving developers in space: %s", err))
 	}
 
 	d.Set("auditors", flex.FlattenSpaceRoleUsers(auditors))
@@ -177,23 +179,23 @@ func resourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
 		sqAPI := cfClient.SpaceQuotas()
 		quota, err := sqAPI.Get(spaceDetails.Entity.SpaceQuotaGUID)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving quotas details for space: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving quotas details for space: %s", err))
 		}
 		d.Set("space_quota", quota.Entity.Name)
 	}
 	d.Set("name", spaceDetails.Entity.Name)
 	org, err := orgAPI.Get(spaceDetails.Entity.OrgGUID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving Organization details for space: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Organization details for space: %s", err))
 	}
 	d.Set("org", org.Entity.Name)
 	return nil
 }
 
-func resourceIBMSpaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
@@ -205,34 +207,34 @@ func resourceIBMSpaceUpdate(d *schema.ResourceData, meta interface{}) error {
 	api := cfClient.Spaces()
 	_, err = api.Update(id, req)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating space: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating space: %s", err))
 	}
 
 	err = updateAuditors(api, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = updateManagers(api, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = updateDevelopers(api, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMSpaceRead(d, meta)
+	return resourceIBMSpaceRead(ctx, d, meta)
 }
 
-func resourceIBMSpaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
 	err = cfClient.Spaces().Delete(id, false)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting space: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting space: %s", err))
 	}
 
 	d.SetId("")
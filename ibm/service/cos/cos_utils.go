@@ -0,0 +1,692 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/private/checksum"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol/restxml"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// cosMaxParts is the maximum number of parts of a multipart upload.
+	cosMaxParts = 10000
+	mebibyte    = 1024 * 1024
+)
+
+// cosObjectUpload is the upload of the content of an object, as a multipart
+// upload of parts of PartSize bytes if it is larger than a part.
+type cosObjectUpload struct {
+	Client      *s3.S3
+	Bucket      string
+	Key         string
+	Content     io.ReaderAt
+	Size        int64
+	ContentType string
+	PartSize    int64
+	Concurrency int
+	// KMSKeyCRN and SSECustomerKey are the root key and the customer-provided
+	// key encrypting the object, if any.
+	KMSKeyCRN      string
+	SSECustomerKey string
+}
+
+// newCOSObjectUpload returns the upload of the content of a
+// ibm_cos_bucket_object, and a function closing its content file, if any.
+func newCOSObjectUpload(d *schema.ResourceData, s3Client *s3.S3, bucketName string) (*cosObjectUpload, func(), error) {
+	u := &cosObjectUpload{
+		Client:      s3Client,
+		Bucket:      bucketName,
+		Key:         d.Get("key").(string),
+		PartSize:    int64(d.Get("part_size").(int)) * mebibyte,
+		Concurrency: d.Get("upload_concurrency").(int),
+		KMSKeyCRN:   d.Get("kms_key_crn").(string),
+	}
+	sseCustomerKey, err := cosSSECustomerKey(d)
+	if err != nil {
+		return nil, nil, err
+	}
+	u.SSECustomerKey = sseCustomerKey
+
+	closeContent := func() {}
+	if v, ok := d.GetOk("content"); ok {
+		content := []byte(v.(string))
+		u.Content, u.Size = bytes.NewReader(content), int64(len(content))
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		content, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Error decoding content_base64: %s", err)
+		}
+		u.Content, u.Size = bytes.NewReader(content), int64(len(content))
+	} else if v, ok := d.GetOk("content_file"); ok {
+		path := v.(string)
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+		}
+		info, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return nil, nil, fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+		}
+		u.Content, u.Size = file, info.Size()
+		closeContent = func() {
+			if err := file.Close(); err != nil {
+				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+			}
+		}
+	} else {
+		u.Content = bytes.NewReader(nil)
+	}
+	return u, closeContent, nil
+}
+
+// Upload uploads the content of the object, resuming the incomplete
+// multipart upload of the object, if any, whose parts matching the content
+// aren't uploaded again.
+//
+// The settings an incomplete upload was created with can't be read, so the
+// object of a resumed upload is checked once completed, and uploaded again
+// without resuming if its content type or encryption aren't the ones of u.
+// A resumed upload whose parts are rejected, as when it is encrypted with
+// another customer-provided key, is aborted and the object uploaded again.
+func (u *cosObjectUpload) Upload(ctx context.Context) error {
+	resumed, err := u.upload(ctx, true)
+	if errors.Is(err, errCOSResumedUploadRejected) {
+		log.Printf("[INFO] The parts of the resumed multipart upload of COS bucket (%s) object (%s) were rejected, uploading the object again", u.Bucket, u.Key)
+		_, err = u.upload(ctx, false)
+		return err
+	}
+	if err != nil || !resumed {
+		return err
+	}
+	matches, err := u.uploadedWithSettings(ctx)
+	if err != nil {
+		return err
+	}
+	if matches {
+		return nil
+	}
+	log.Printf("[INFO] The resumed multipart upload of COS bucket (%s) object (%s) was created with other settings, uploading the object again", u.Bucket, u.Key)
+	_, err = u.upload(ctx, false)
+	return err
+}
+
+// upload uploads the content of the object, resuming the last incomplete
+// multipart upload of the object if resume is set, and returns whether it did.
+func (u *cosObjectUpload) upload(ctx context.Context, resume bool) (bool, error) {
+	if u.Size <= u.PartSize {
+		putInput := &s3.PutObjectInput{
+			Bucket: aws.String(u.Bucket),
+			Key:    aws.String(u.Key),
+			Body:   io.NewSectionReader(u.Content, 0, u.Size),
+		}
+		if u.ContentType != "" {
+			putInput.ContentType = aws.String(u.ContentType)
+		}
+		if u.KMSKeyCRN != "" {
+			putInput.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+			putInput.SSEKMSKeyId = aws.String(u.KMSKeyCRN)
+		}
+		if u.SSECustomerKey != "" {
+			putInput.SSECustomerAlgorithm = aws.String(s3.ServerSideEncryptionAes256)
+			putInput.SSECustomerKey = aws.String(u.SSECustomerKey)
+		}
+		if _, err := u.Client.PutObjectWithContext(ctx, putInput); err != nil {
+			return false, fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", u.Key, u.Bucket, err)
+		}
+		return false, nil
+	}
+
+	parts := (u.Size + u.PartSize - 1) / u.PartSize
+	if parts > cosMaxParts {
+		return false, fmt.Errorf("[ERROR] Error uploading object (%s) in COS bucket (%s): its %d bytes need %d parts of %d MiB, more than the %d parts of a multipart upload, increase part_size", u.Key, u.Bucket, u.Size, parts, u.PartSize/mebibyte, cosMaxParts)
+	}
+
+	var uploadID string
+	var uploaded map[int64]*s3.Part
+	if resume {
+		var err error
+		if uploadID, uploaded, err = u.incompleteUpload(ctx); err != nil {
+			return false, err
+		}
+	}
+	resumed := uploadID != ""
+	if !resumed {
+		createInput := &s3.CreateMultipartUploadInput{
+			Bucket: aws.String(u.Bucket),
+			Key:    aws.String(u.Key),
+		}
+		if u.ContentType != "" {
+			createInput.ContentType = aws.String(u.ContentType)
+		}
+		if u.KMSKeyCRN != "" {
+			createInput.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+			createInput.SSEKMSKeyId = aws.String(u.KMSKeyCRN)
+		}
+		if u.SSECustomerKey != "" {
+			createInput.SSECustomerAlgorithm = aws.String(s3.ServerSideEncryptionAes256)
+			createInput.SSECustomerKey = aws.String(u.SSECustomerKey)
+		}
+		out, err := u.Client.CreateMultipartUploadWithContext(ctx, createInput)
+		if err != nil {
+			return false, fmt.Errorf("[ERROR] Error creating the multipart upload of object (%s) in COS bucket (%s): %s", u.Key, u.Bucket, err)
+		}
+		uploadID = aws.StringValue(out.UploadId)
+	} else {
+		log.Printf("[INFO] Resuming the multipart upload (%s) of COS bucket (%s) object (%s), %d parts uploaded", uploadID, u.Bucket, u.Key, len(uploaded))
+	}
+
+	completed := make([]*s3.CompletedPart, parts)
+	concurrency := u.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var uploadErr error
+	for i := int64(0); i < parts; i++ {
+		sem <- struct{}{}
+		mu.Lock()
+		failed := uploadErr != nil
+		mu.Unlock()
+		if failed {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func(number int64) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			part, err := u.uploadPart(ctx, uploadID, number, uploaded[number])
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if uploadErr == nil {
+					uploadErr = err
+				}
+				return
+			}
+			completed[number-1] = part
+		}(i + 1)
+	}
+	wg.Wait()
+	if uploadErr != nil && resumed && isCOSBadRequest(uploadErr) {
+		log.Printf("[WARN] The multipart upload (%s) of COS bucket (%s) object (%s) rejected its parts: %s", uploadID, u.Bucket, u.Key, uploadErr)
+		if err := abortCOSObjectUploads(ctx, u.Client, u.Bucket, u.Key, map[string]bool{u.Key: true}); err != nil {
+			return false, err
+		}
+		return false, errCOSResumedUploadRejected
+	}
+	if uploadErr != nil {
+		// The upload is kept for the next apply to resume it.
+		return false, fmt.Errorf("[ERROR] Error uploading object (%s) in COS bucket (%s), the multipart upload (%s) is resumed by the next apply: %s", u.Key, u.Bucket, uploadID, uploadErr)
+	}
+
+	completeInput := &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(u.Bucket),
+		Key:             aws.String(u.Key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	}
+	if _, err := u.Client.CompleteMultipartUploadWithContext(ctx, completeInput); err != nil {
+		return false, fmt.Errorf("[ERROR] Error completing the multipart upload (%s) of object (%s) in COS bucket (%s): %s", uploadID, u.Key, u.Bucket, err)
+	}
+	return resumed, nil
+}
+
+// errCOSResumedUploadRejected is returned when the parts of a resumed
+// multipart upload are rejected.
+var errCOSResumedUploadRejected = errors.New("the parts of the resumed multipart upload were rejected")
+
+// isCOSBadRequest returns whether err is, or wraps, an error of a request
+// rejected with status 400.
+func isCOSBadRequest(err error) bool {
+	var reqErr awserr.RequestFailure
+	return errors.As(err, &reqErr) && reqErr.StatusCode() == http.StatusBadRequest
+}
+
+// uploadedWithSettings returns whether the object has the content type and
+// the encryption of the upload. The object can't be read without the
+// customer-provided key it is encrypted with, if any, nor with another key.
+func (u *cosObjectUpload) uploadedWithSettings(ctx context.Context) (bool, error) {
+	headInput := &s3.HeadObjectInput{
+		Bucket: aws.String(u.Bucket),
+		Key:    aws.String(u.Key),
+	}
+	if u.SSECustomerKey != "" {
+		headInput.SSECustomerAlgorithm = aws.String(s3.ServerSideEncryptionAes256)
+		headInput.SSECustomerKey = aws.String(u.SSECustomerKey)
+	}
+	out, err := u.Client.HeadObjectWithContext(ctx, headInput)
+	if reqErr, ok := err.(awserr.RequestFailure); ok && (reqErr.StatusCode() == http.StatusBadRequest || reqErr.StatusCode() == http.StatusForbidden) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("[ERROR] Error reading COS bucket (%s) object (%s): %s", u.Bucket, u.Key, err)
+	}
+	switch {
+	case u.ContentType != "" && aws.StringValue(out.ContentType) != u.ContentType:
+		return false, nil
+	case u.KMSKeyCRN != "" && aws.StringValue(out.SSEKMSKeyId) != u.KMSKeyCRN:
+		return false, nil
+	case u.SSECustomerKey == "" && out.SSECustomerAlgorithm != nil:
+		return false, nil
+	}
+	return true, nil
+}
+
+// uploadPart uploads the part number of the content, unless the part
+// uploaded matches it.
+func (u *cosObjectUpload) uploadPart(ctx context.Context, uploadID string, number int64, uploaded *s3.Part) (*s3.CompletedPart, error) {
+	offset := (number - 1) * u.PartSize
+	size := u.PartSize
+	if offset+size > u.Size {
+		size = u.Size - offset
+	}
+	body := io.NewSectionReader(u.Content, offset, size)
+
+	if uploaded != nil && aws.Int64Value(uploaded.Size) == size {
+		digest, err := cosPartDigest(body)
+		if err != nil {
+			return nil, err
+		}
+		if strings.Trim(aws.StringValue(uploaded.ETag), `"`) == hex.EncodeToString(digest) {
+			return &s3.CompletedPart{ETag: uploaded.ETag, PartNumber: aws.Int64(number)}, nil
+		}
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	uploadInput := &s3.UploadPartInput{
+		Bucket:        aws.String(u.Bucket),
+		Key:           aws.String(u.Key),
+		UploadId:      aws.String(uploadID),
+		PartNumber:    aws.Int64(number),
+		Body:          body,
+		ContentLength: aws.Int64(size),
+	}
+	if u.SSECustomerKey != "" {
+		uploadInput.SSECustomerAlgorithm = aws.String(s3.ServerSideEncryptionAes256)
+		uploadInput.SSECustomerKey = aws.String(u.SSECustomerKey)
+	}
+	out, err := u.Client.UploadPartWithContext(ctx, uploadInput)
+	if err != nil {
+		return nil, fmt.Errorf("uploading part %d: %w", number, err)
+	}
+	log.Printf("[DEBUG] Uploaded part %d of the multipart upload (%s) of COS bucket (%s) object (%s)", number, uploadID, u.Bucket, u.Key)
+	return &s3.CompletedPart{ETag: out.ETag, PartNumber: aws.Int64(number)}, nil
+}
+
+// incompleteUpload returns the ID and the uploaded parts, by number, of the
+// last incomplete multipart upload of the object, if any.
+func (u *cosObjectUpload) incompleteUpload(ctx context.Context) (string, map[int64]*s3.Part, error) {
+	var last *s3.MultipartUpload
+	listInput := &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(u.Bucket),
+		Prefix: aws.String(u.Key),
+	}
+	err := u.Client.ListMultipartUploadsPagesWithContext(ctx, listInput, func(page *s3.ListMultipartUploadsOutput, lastPage bool) bool {
+		for _, upload := range page.Uploads {
+			if aws.StringValue(upload.Key) != u.Key {
+				continue
+			}
+			if last == nil || aws.TimeValue(upload.Initiated).After(aws.TimeValue(last.Initiated)) {
+				last = upload
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("[ERROR] Error listing the multipart uploads of COS bucket (%s) object (%s): %s", u.Bucket, u.Key, err)
+	}
+	if last == nil {
+		return "", nil, nil
+	}
+
+	uploadID := aws.StringValue(last.UploadId)
+	uploaded := map[int64]*s3.Part{}
+	partsInput := &s3.ListPartsInput{
+		Bucket:   aws.String(u.Bucket),
+		Key:      aws.String(u.Key),
+		UploadId: aws.String(uploadID),
+	}
+	err = u.Client.ListPartsPagesWithContext(ctx, partsInput, func(page *s3.ListPartsOutput, lastPage bool) bool {
+		for _, part := range page.Parts {
+			uploaded[aws.Int64Value(part.PartNumber)] = part
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("[ERROR] Error listing the parts of the multipart upload (%s) of COS bucket (%s) object (%s): %s", uploadID, u.Bucket, u.Key, err)
+	}
+	return uploadID, uploaded, nil
+}
+
+// abortCOSObjectUploads aborts the incomplete multipart uploads of the
+// objects of keys, whose keys start with prefix.
+func abortCOSObjectUploads(ctx context.Context, s3Client *s3.S3, bucketName, prefix string, keys map[string]bool) error {
+	var uploads []*s3.MultipartUpload
+	listInput := &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(prefix),
+	}
+	err := s3Client.ListMultipartUploadsPagesWithContext(ctx, listInput, func(page *s3.ListMultipartUploadsOutput, lastPage bool) bool {
+		for _, upload := range page.Uploads {
+			if keys[aws.StringValue(upload.Key)] {
+				uploads = append(uploads, upload)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the multipart uploads of COS bucket (%s) with prefix (%s): %s", bucketName, prefix, err)
+	}
+	for _, upload := range uploads {
+		log.Printf("[INFO] Aborting the multipart upload (%s) of COS bucket (%s) object (%s)", aws.StringValue(upload.UploadId), bucketName, aws.StringValue(upload.Key))
+		_, err := s3Client.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(bucketName),
+			Key:      upload.Key,
+			UploadId: upload.UploadId,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error aborting the multipart upload (%s) of COS bucket (%s) object (%s): %s", aws.StringValue(upload.UploadId), bucketName, aws.StringValue(upload.Key), err)
+		}
+	}
+	return nil
+}
+
+// cosObjectETag returns the ETag of an object of content once uploaded: the
+// MD5 digest of the content, or of the digests of its parts followed by their
+// number if it is larger than a part of partSize bytes.
+func cosObjectETag(content io.ReaderAt, size, partSize int64) (string, error) {
+	if size <= partSize {
+		digest, err := cosPartDigest(io.NewSectionReader(content, 0, size))
+		if err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(digest), nil
+	}
+	digests := md5.New()
+	parts := int64(0)
+	for offset := int64(0); offset < size; offset += partSize {
+		digest, err := cosPartDigest(io.NewSectionReader(content, offset, partSize))
+		if err != nil {
+			return "", err
+		}
+		digests.Write(digest)
+		parts++
+	}
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(digests.Sum(nil)), parts), nil
+}
+
+// cosFileETag returns the ETag of an object of the content of the file path
+// once uploaded in parts of partSize bytes.
+func cosFileETag(path string, partSize int64) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+	return cosObjectETag(file, info.Size(), partSize)
+}
+
+func cosPartDigest(part io.Reader) ([]byte, error) {
+	digest := md5.New()
+	if _, err := io.Copy(digest, part); err != nil {
+		return nil, fmt.Errorf("reading content: %s", err)
+	}
+	return digest.Sum(nil), nil
+}
+
+// cosSSECustomerKey returns the decoded customer-provided key of an object,
+// if any.
+func cosSSECustomerKey(d *schema.ResourceData) (string, error) {
+	v, ok := d.GetOk("sse_customer_key")
+	if !ok {
+		return "", nil
+	}
+	key, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error decoding sse_customer_key: %s", err)
+	}
+	return string(key), nil
+}
+
+// validateSSECustomerKey validates a base64-encoded 256-bit AES key.
+func validateSSECustomerKey(v interface{}, k string) (ws []string, errors []error) {
+	key, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil || len(key) != 32 {
+		errors = append(errors, fmt.Errorf("%q must be a base64-encoded 256-bit key", k))
+	}
+	return
+}
+
+// cosBucketS3Client returns the S3 client of the bucket of bucketCRN, and the
+// name of the bucket.
+func cosBucketS3Client(m interface{}, bucketCRN, bucketLocation, endpointType string) (*s3.S3, string, error) {
+	if !strings.Contains(bucketCRN, ":bucket:") {
+		return nil, "", fmt.Errorf("[ERROR] Error parsing COS bucket CRN (%s)", bucketCRN)
+	}
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, "", err
+	}
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return nil, "", err
+	}
+	return s3Client, bucketName, nil
+}
+
+// getCOSBucketConfigID returns the ID of a configuration of a bucket, e.g.
+// its replication rules.
+func getCOSBucketConfigID(bucketCRN, config, bucketLocation string) string {
+	return fmt.Sprintf("%s:%s:location:%s", bucketCRN, config, bucketLocation)
+}
+
+// parseCOSBucketConfigID returns the bucket CRN and the bucket location of the
+// ID of a configuration of a bucket.
+func parseCOSBucketConfigID(id, config string) (string, string, error) {
+	parts := strings.Split(id, fmt.Sprintf(":%s:location:", config))
+	if len(parts) != 2 || !strings.Contains(parts[0], ":bucket:") || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Error parsing ID (%s), expected <bucket_crn>:%s:location:<bucket_location>", id, config)
+	}
+	return parts[0], parts[1], nil
+}
+
+// isCOSErrorCode returns whether err is an error of one of codes.
+func isCOSErrorCode(err error, codes ...string) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		for _, code := range codes {
+			if aerr.Code() == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// cosRequest sends the request of an S3 operation that the COS SDK doesn't
+// implement, such as the replication and the object lock operations, with its
+// REST XML protocol. The output is discarded if nil.
+func cosRequest(ctx context.Context, s3Client *s3.S3, name, method, path string, input, output interface{}) error {
+	operation := &request.Operation{
+		Name:       name,
+		HTTPMethod: method,
+		HTTPPath:   path,
+	}
+	discard := output == nil
+	if discard {
+		output = &cosEmptyOutput{}
+	}
+	req := s3Client.NewRequest(operation, input, output)
+	if discard {
+		req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	}
+	if method == http.MethodPut {
+		req.Handlers.Build.PushBackNamed(request.NamedHandler{
+			Name: "contentMd5Handler",
+			Fn:   checksum.AddBodyContentMD5Handler,
+		})
+	}
+	req.SetContext(ctx)
+	return req.Send()
+}
+
+type cosEmptyOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+type cosBucketInput struct {
+	_ struct{} `type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+}
+
+type cosObjectInput struct {
+	_ struct{} `type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	Key    *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+}
+
+// cosBucketReplication is the input of PutBucketReplication and the output of
+// GetBucketReplication.
+type cosBucketReplication struct {
+	_ struct{} `type:"structure" payload:"ReplicationConfiguration"`
+
+	Bucket                   *string                      `location:"uri" locationName:"Bucket" type:"string"`
+	ReplicationConfiguration *cosReplicationConfiguration `locationName:"ReplicationConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type cosReplicationConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	Rules []*cosReplicationRule `locationName:"Rule" type:"list" flattened:"true"`
+}
+
+type cosReplicationRule struct {
+	_ struct{} `type:"structure"`
+
+	ID                      *string                         `type:"string"`
+	Priority                *int64                          `type:"integer"`
+	Filter                  *cosReplicationRuleFilter       `type:"structure"`
+	Status                  *string                         `type:"string"`
+	Destination             *cosReplicationDestination      `type:"structure"`
+	DeleteMarkerReplication *cosReplicationDeleteMarkerRule `type:"structure"`
+}
+
+type cosReplicationRuleFilter struct {
+	_ struct{} `type:"structure"`
+
+	Prefix *string `type:"string"`
+}
+
+type cosReplicationDestination struct {
+	_ struct{} `type:"structure"`
+
+	Bucket *string `type:"string"`
+}
+
+type cosReplicationDeleteMarkerRule struct {
+	_ struct{} `type:"structure"`
+
+	Status *string `type:"string"`
+}
+
+// cosBucketObjectLock is the input of PutObjectLockConfiguration and the
+// output of GetObjectLockConfiguration.
+type cosBucketObjectLock struct {
+	_ struct{} `type:"structure" payload:"ObjectLockConfiguration"`
+
+	Bucket                  *string                     `location:"uri" locationName:"Bucket" type:"string"`
+	ObjectLockConfiguration *cosObjectLockConfiguration `locationName:"ObjectLockConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type cosObjectLockConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	ObjectLockEnabled *string            `type:"string"`
+	Rule              *cosObjectLockRule `type:"structure"`
+}
+
+type cosObjectLockRule struct {
+	_ struct{} `type:"structure"`
+
+	DefaultRetention *cosObjectLockDefaultRetention `type:"structure"`
+}
+
+type cosObjectLockDefaultRetention struct {
+	_ struct{} `type:"structure"`
+
+	Mode  *string `type:"string"`
+	Days  *int64  `type:"integer"`
+	Years *int64  `type:"integer"`
+}
+
+// cosObjectLegalHold is the input of PutObjectLegalHold and the output of
+// GetObjectLegalHold.
+type cosObjectLegalHold struct {
+	_ struct{} `type:"structure" payload:"LegalHold"`
+
+	Bucket    *string             `location:"uri" locationName:"Bucket" type:"string"`
+	Key       *string             `location:"uri" locationName:"Key" type:"string"`
+	LegalHold *cosObjectLockLegal `locationName:"LegalHold" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type cosObjectLockLegal struct {
+	_ struct{} `type:"structure"`
+
+	Status *string `type:"string"`
+}
+
+// cosObjectRetention is the input of PutObjectRetention and the output of
+// GetObjectRetention.
+type cosObjectRetention struct {
+	_ struct{} `type:"structure" payload:"Retention"`
+
+	Bucket    *string                 `location:"uri" locationName:"Bucket" type:"string"`
+	Key       *string                 `location:"uri" locationName:"Key" type:"string"`
+	Retention *cosObjectLockRetention `locationName:"Retention" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type cosObjectLockRetention struct {
+	_ struct{} `type:"structure"`
+
+	Mode            *string    `type:"string"`
+	RetainUntilDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+}
@@ -0,0 +1,385 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func md5Hex(content string) string {
+	digest := md5.Sum([]byte(content))
+	return hex.EncodeToString(digest[:])
+}
+
+func TestCOSObjectETag(t *testing.T) {
+	digests := func(parts ...string) string {
+		var all []byte
+		for _, part := range parts {
+			digest := md5.Sum([]byte(part))
+			all = append(all, digest[:]...)
+		}
+		digest := md5.Sum(all)
+		return fmt.Sprintf("%s-%d", hex.EncodeToString(digest[:]), len(parts))
+	}
+	cases := []struct {
+		content  string
+		partSize int64
+		etag     string
+	}{
+		{"", 4, md5Hex("")},
+		{"abc", 4, md5Hex("abc")},
+		{"abcd", 4, md5Hex("abcd")},
+		{"abcdefgh", 4, digests("abcd", "efgh")},
+		{"abcdefghij", 4, digests("abcd", "efgh", "ij")},
+	}
+	for _, c := range cases {
+		etag, err := cosObjectETag(strings.NewReader(c.content), int64(len(c.content)), c.partSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if etag != c.etag {
+			t.Errorf("%q in parts of %d bytes: expected %s, got %s", c.content, c.partSize, c.etag, etag)
+		}
+	}
+}
+
+// fakeCOSUpload is a multipart upload of the fake COS API, with the settings
+// it was created with.
+type fakeCOSUpload struct {
+	id, key, contentType, kmsKeyCRN, sseKeyMD5 string
+	parts                                      map[int64]string
+}
+
+// fakeCOSObject is an object of the fake COS API.
+type fakeCOSObject struct {
+	content, contentType, kmsKeyCRN, sseKeyMD5 string
+}
+
+// fakeCOS implements the S3 operations of the multipart uploads for a single
+// bucket, and records the operations it is called with.
+type fakeCOS struct {
+	mu         sync.Mutex
+	uploads    map[string]*fakeCOSUpload
+	objects    map[string]*fakeCOSObject
+	operations []string
+	created    int
+}
+
+func newFakeCOS() *fakeCOS {
+	return &fakeCOS{uploads: map[string]*fakeCOSUpload{}, objects: map[string]*fakeCOSObject{}}
+}
+
+func (f *fakeCOS) record(operation string) {
+	f.operations = append(f.operations, operation)
+}
+
+// countOperations returns the number of calls of the operations with the
+// given prefix, e.g. "UploadPart".
+func (f *fakeCOS) countOperations(prefix string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, op := range f.operations {
+		if strings.HasPrefix(op, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+func (f *fakeCOS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	key := ""
+	if len(path) == 2 {
+		key = path[1]
+	}
+	query := r.URL.Query()
+	_, uploads := query["uploads"]
+	uploadID := query.Get("uploadId")
+	sseKeyMD5 := r.Header.Get("X-Amz-Server-Side-Encryption-Customer-Key-Md5")
+	body, _ := ioutil.ReadAll(r.Body)
+
+	switch {
+	case r.Method == http.MethodGet && key == "" && uploads:
+		f.record("ListMultipartUploads")
+		fmt.Fprint(w, "<ListMultipartUploadsResult><Bucket>bucket</Bucket><IsTruncated>false</IsTruncated>")
+		for _, u := range f.uploads {
+			fmt.Fprintf(w, "<Upload><Key>%s</Key><UploadId>%s</UploadId><Initiated>2022-06-01T10:00:00.000Z</Initiated></Upload>", u.key, u.id)
+		}
+		fmt.Fprint(w, "</ListMultipartUploadsResult>")
+	case r.Method == http.MethodGet && uploadID != "":
+		f.record("ListParts")
+		u := f.uploads[uploadID]
+		var numbers []int
+		for number := range u.parts {
+			numbers = append(numbers, int(number))
+		}
+		sort.Ints(numbers)
+		fmt.Fprint(w, "<ListPartsResult><IsTruncated>false</IsTruncated>")
+		for _, number := range numbers {
+			part := u.parts[int64(number)]
+			fmt.Fprintf(w, `<Part><PartNumber>%d</PartNumber><ETag>"%s"</ETag><Size>%d</Size></Part>`, number, md5Hex(part), len(part))
+		}
+		fmt.Fprint(w, "</ListPartsResult>")
+	case r.Method == http.MethodPost && uploads:
+		f.record("CreateMultipartUpload")
+		f.created++
+		u := &fakeCOSUpload{
+			id:          fmt.Sprintf("upload-%d", f.created),
+			key:         key,
+			contentType: r.Header.Get("Content-Type"),
+			kmsKeyCRN:   r.Header.Get("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"),
+			sseKeyMD5:   sseKeyMD5,
+			parts:       map[int64]string{},
+		}
+		f.uploads[u.id] = u
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><Bucket>bucket</Bucket><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>", key, u.id)
+	case r.Method == http.MethodPut && uploadID != "":
+		number, _ := strconv.ParseInt(query.Get("partNumber"), 10, 64)
+		f.record(fmt.Sprintf("UploadPart %d", number))
+		u := f.uploads[uploadID]
+		if u.sseKeyMD5 != sseKeyMD5 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, "<Error><Code>InvalidRequest</Code><Message>The provided encryption key doesn't match the upload</Message></Error>")
+			return
+		}
+		u.parts[number] = string(body)
+		w.Header().Set("ETag", `"`+md5Hex(string(body))+`"`)
+	case r.Method == http.MethodPost && uploadID != "":
+		f.record("CompleteMultipartUpload")
+		var complete struct {
+			Parts []struct {
+				PartNumber int64
+			} `xml:"Part"`
+		}
+		xml.Unmarshal(body, &complete)
+		u := f.uploads[uploadID]
+		var content strings.Builder
+		for _, part := range complete.Parts {
+			content.WriteString(u.parts[part.PartNumber])
+		}
+		f.objects[u.key] = &fakeCOSObject{content: content.String(), contentType: u.contentType, kmsKeyCRN: u.kmsKeyCRN, sseKeyMD5: u.sseKeyMD5}
+		delete(f.uploads, uploadID)
+		fmt.Fprintf(w, "<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>%s</Key></CompleteMultipartUploadResult>", u.key)
+	case r.Method == http.MethodDelete && uploadID != "":
+		f.record("AbortMultipartUpload")
+		delete(f.uploads, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodHead:
+		f.record("HeadObject")
+		o, ok := f.objects[key]
+		switch {
+		case !ok:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case o.sseKeyMD5 != sseKeyMD5:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", o.contentType)
+		if o.kmsKeyCRN != "" {
+			w.Header().Set("X-Amz-Server-Side-Encryption", "aws:kms")
+			w.Header().Set("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id", o.kmsKeyCRN)
+		}
+		if o.sseKeyMD5 != "" {
+			w.Header().Set("X-Amz-Server-Side-Encryption-Customer-Algorithm", "AES256")
+			w.Header().Set("X-Amz-Server-Side-Encryption-Customer-Key-Md5", o.sseKeyMD5)
+		}
+	case r.Method == http.MethodPut:
+		f.record("PutObject")
+		f.objects[key] = &fakeCOSObject{content: string(body), contentType: r.Header.Get("Content-Type"), sseKeyMD5: sseKeyMD5}
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// newFakeCOSUpload returns the upload of content to the object key of the
+// fake COS API, in parts of 4 bytes.
+func newFakeCOSUpload(t *testing.T, f *fakeCOS, key, content string) *cosObjectUpload {
+	server := httptest.NewTLSServer(f)
+	t.Cleanup(server.Close)
+	// The certificate of the server is the CA bundle, so that AWS_CA_BUNDLE
+	// doesn't replace it.
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			Endpoint:         aws.String(server.URL),
+			Region:           aws.String("us-south"),
+			S3ForcePathStyle: aws.Bool(true),
+			Credentials:      credentials.NewStaticCredentials("access-key", "secret-key", ""),
+			HTTPClient:       server.Client(),
+			MaxRetries:       aws.Int(0),
+		},
+		CustomCABundle: bytes.NewReader(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &cosObjectUpload{
+		Client:      s3.New(sess),
+		Bucket:      "bucket",
+		Key:         key,
+		Content:     strings.NewReader(content),
+		Size:        int64(len(content)),
+		PartSize:    4,
+		Concurrency: 1,
+	}
+}
+
+func TestCOSObjectUploadPart(t *testing.T) {
+	f := newFakeCOS()
+	f.uploads["upload-0"] = &fakeCOSUpload{id: "upload-0", key: "object", parts: map[int64]string{}}
+	u := newFakeCOSUpload(t, f, "object", "aaaabbbbcc")
+
+	cases := []struct {
+		name     string
+		number   int64
+		uploaded *s3.Part
+		sent     bool
+	}{
+		{"not uploaded", 1, nil, true},
+		{"uploaded", 2, &s3.Part{ETag: aws.String(`"` + md5Hex("bbbb") + `"`), Size: aws.Int64(4)}, false},
+		{"uploaded last part", 3, &s3.Part{ETag: aws.String(`"` + md5Hex("cc") + `"`), Size: aws.Int64(2)}, false},
+		{"other content", 2, &s3.Part{ETag: aws.String(`"` + md5Hex("xxxx") + `"`), Size: aws.Int64(4)}, true},
+		{"other size", 3, &s3.Part{ETag: aws.String(`"` + md5Hex("cc") + `"`), Size: aws.Int64(4)}, true},
+	}
+	for _, c := range cases {
+		before := f.countOperations("UploadPart")
+		part, err := u.uploadPart(context.Background(), "upload-0", c.number, c.uploaded)
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		if sent := f.countOperations("UploadPart") > before; sent != c.sent {
+			t.Errorf("%s: expected the part to be sent %t, got %t", c.name, c.sent, sent)
+		}
+		if aws.Int64Value(part.PartNumber) != c.number || aws.StringValue(part.ETag) == "" {
+			t.Errorf("%s: unexpected completed part %v", c.name, part)
+		}
+	}
+	if got := f.uploads["upload-0"].parts[2]; got != "bbbb" {
+		t.Errorf("expected the part to be uploaded again with its content, got %q", got)
+	}
+}
+
+func TestCOSObjectUploadResume(t *testing.T) {
+	const content = "aaaabbbbcc"
+	const sseKey = "0123456789abcdef0123456789abcdef"
+	otherKeyMD5 := "b3RoZXIta2V5LW1kNQ=="
+
+	cases := []struct {
+		name string
+		// upload is the incomplete upload of the object, with part 1 matching
+		// the content and part 2 not.
+		upload     fakeCOSUpload
+		setup      func(u *cosObjectUpload)
+		parts      int
+		created    int
+		aborted    int
+		objectType string
+	}{
+		{
+			name:    "same settings",
+			upload:  fakeCOSUpload{contentType: "text/plain"},
+			setup:   func(u *cosObjectUpload) { u.ContentType = "text/plain" },
+			parts:   2,
+			created: 0,
+		},
+		{
+			name:       "other content type",
+			upload:     fakeCOSUpload{contentType: "application/octet-stream"},
+			setup:      func(u *cosObjectUpload) { u.ContentType = "text/plain" },
+			parts:      2 + 3,
+			created:    1,
+			objectType: "text/plain",
+		},
+		{
+			name:    "other root key",
+			upload:  fakeCOSUpload{kmsKeyCRN: "crn:v1:bluemix:public:kms:us-south:a/1::key:old"},
+			setup:   func(u *cosObjectUpload) { u.KMSKeyCRN = "crn:v1:bluemix:public:kms:us-south:a/1::key:new" },
+			parts:   2 + 3,
+			created: 1,
+		},
+		{
+			name:    "other customer-provided key",
+			upload:  fakeCOSUpload{sseKeyMD5: otherKeyMD5},
+			setup:   func(u *cosObjectUpload) { u.SSECustomerKey = sseKey },
+			parts:   1 + 3,
+			created: 1,
+			aborted: 1,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			f := newFakeCOS()
+			incomplete := c.upload
+			incomplete.id, incomplete.key = "upload-0", "object"
+			incomplete.parts = map[int64]string{1: "aaaa", 2: "xxxx"}
+			f.uploads["upload-0"] = &incomplete
+			u := newFakeCOSUpload(t, f, "object", content)
+			c.setup(u)
+
+			if err := u.Upload(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if n := f.countOperations("UploadPart"); n != c.parts {
+				t.Errorf("expected %d parts to be sent, got %d: %v", c.parts, n, f.operations)
+			}
+			if f.countOperations("UploadPart 1") > 0 && c.created == 0 {
+				t.Errorf("expected the uploaded part 1 not to be sent again: %v", f.operations)
+			}
+			if n := f.countOperations("CreateMultipartUpload"); n != c.created {
+				t.Errorf("expected %d uploads to be created, got %d: %v", c.created, n, f.operations)
+			}
+			if n := f.countOperations("AbortMultipartUpload"); n != c.aborted {
+				t.Errorf("expected %d uploads to be aborted, got %d: %v", c.aborted, n, f.operations)
+			}
+			object := f.objects["object"]
+			if object == nil || object.content != content {
+				t.Fatalf("expected the object to have the content, got %+v", object)
+			}
+			if object.contentType != u.ContentType || object.kmsKeyCRN != u.KMSKeyCRN {
+				t.Errorf("expected the object to have the settings of the upload, got %+v", object)
+			}
+			if len(f.uploads) != 0 {
+				t.Errorf("expected no incomplete upload to be left, got %d", len(f.uploads))
+			}
+		})
+	}
+}
+
+func TestCOSObjectUploadSinglePart(t *testing.T) {
+	f := newFakeCOS()
+	u := newFakeCOSUpload(t, f, "small", "abc")
+	u.ContentType = "text/plain"
+	if err := u.Upload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if f.countOperations("PutObject") != 1 || f.countOperations("ListMultipartUploads") != 0 {
+		t.Fatalf("expected a single PutObject, got %v", f.operations)
+	}
+	if object := f.objects["small"]; object == nil || object.content != "abc" || object.contentType != "text/plain" {
+		t.Fatalf("unexpected object %+v", object)
+	}
+}
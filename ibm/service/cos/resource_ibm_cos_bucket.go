@@ -20,6 +20,8 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -61,13 +63,16 @@ func resourceinstanceidDiffSuppress(_, old, new string, _ *schema.ResourceData)
 }
 func ResourceIBMCOSBucket() *schema.Resource {
 	return &schema.Resource{
-		Read:          resourceIBMCOSBucketRead,
-		Create:        resourceIBMCOSBucketCreate,
-		Update:        resourceIBMCOSBucketUpdate,
-		Delete:        resourceIBMCOSBucketDelete,
+		ReadContext:   resourceIBMCOSBucketRead,
+		CreateContext: resourceIBMCOSBucketCreate,
+		UpdateContext: resourceIBMCOSBucketUpdate,
+		DeleteContext: resourceIBMCOSBucketDelete,
 		Exists:        resourceIBMCOSBucketExists,
 		Importer:      &schema.ResourceImporter{},
-		CustomizeDiff: resourceExpiryValidate,
+		CustomizeDiff: customdiff.All(
+			resourceExpiryValidate,
+			validate.InvokeCustomizeDiff("ibm_cos_bucket"),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -442,6 +447,17 @@ func ResourceIBMCOSBucket() *schema.Resource {
 	}
 }
 
+func ResourceIBMCOSBucketValidator() *validate.ResourceValidator {
+	validateRules := make([]validate.ValidateRule, 0)
+	validateRules = append(validateRules,
+		validate.ValidateRule{
+			Type:        validate.ExactlyOneOf,
+			Identifiers: []string{"cross_region_location", "region_location", "single_site_location", "satellite_location_id"}})
+
+	ibmCOSBucketResourceValidator := validate.ResourceValidator{ResourceName: "ibm_cos_bucket", Rules: validateRules}
+	return &ibmCOSBucketResourceValidator
+}
+
 func archiveRuleList(archiveList []interface{}) []*s3.LifecycleRule {
 	var archive_status, archiveStorageClass, rule_id string
 	var days int64
@@ -660,11 +676,11 @@ func expireRuleList(expireList []interface{}) []*s3.LifecycleRule {
 	return rules
 }
 
-func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCOSBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	bucketName := parseBucketId(d.Id(), "bucketName")
 	serviceID := parseBucketId(d.Id(), "serviceID")
@@ -695,12 +711,12 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient).WithEndpoint(meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_COS_ENDPOINT", apiEndpoint)).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" {
@@ -713,7 +729,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient).WithEndpoint(meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_COS_ENDPOINT", apiEndpoint)).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
@@ -747,7 +763,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 
 			_, err := s3Client.PutBucketLifecycleConfiguration(lInput)
 			if err != nil {
-				return fmt.Errorf("failed to update the lifecyle rule on COS bucket %s, %v", bucketName, err)
+				return diag.FromErr(fmt.Errorf("failed to update the lifecyle rule on COS bucket %s, %v", bucketName, err))
 			}
 
 		} else {
@@ -758,7 +774,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 			delarchive, _ := s3Client.DeleteBucketLifecycleRequest(DelInput)
 			err := delarchive.Send()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -770,7 +786,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		if retention, ok := d.GetOk("retention_rule"); ok {
 			retentionList := retention.([]interface{})
 			if len(retentionList) > 1 {
-				return fmt.Errorf("Can not more than 1 retention policy")
+				return diag.FromErr(fmt.Errorf("Can not more than 1 retention policy"))
 			}
 			for _, l := range retentionList {
 				retentionMap, _ := l.(map[string]interface{})
@@ -813,7 +829,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 			}
 			_, err := s3Client.PutBucketProtectionConfiguration(pInput)
 			if err != nil {
-				return fmt.Errorf("failed to update the retention rule on COS bucket %s, %v", bucketName, err)
+				return diag.FromErr(fmt.Errorf("failed to update the retention rule on COS bucket %s, %v", bucketName, err))
 			}
 		}
 	}
@@ -846,13 +862,13 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 
 		_, err := s3Client.PutBucketVersioning(input)
 		if err != nil {
-			return fmt.Errorf("failed to update the object versioning on COS bucket %s, %v", bucketName, err)
+			return diag.FromErr(fmt.Errorf("failed to update the object versioning on COS bucket %s, %v", bucketName, err))
 		}
 	}
 
 	sess, err := meta.(conns.ClientSession).CosConfigV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if endpointType == "private" {
 		sess.SetServiceURL("https://config.private.cloud-object-storage.cloud.ibm.com/v1")
@@ -948,18 +964,18 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	if hasChanged {
 		response, err := sess.UpdateBucketConfig(updateBucketConfigOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Update COS Bucket: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Update COS Bucket: %s\n%s", err, response))
 		}
 	}
 
-	return resourceIBMCOSBucketRead(d, meta)
+	return resourceIBMCOSBucketRead(ctx, d, meta)
 }
 
-func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCOSBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	bucketName := parseBucketId(d.Id(), "bucketName")
 	serviceID := parseBucketId(d.Id(), "serviceID")
@@ -989,18 +1005,18 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	}
 
-	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	apiEndpoint = meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_COS_ENDPOINT", apiEndpoint)
 
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 
 	if err != nil {
 
-		return err
+		return diag.FromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" && apiKey == "" {
@@ -1013,7 +1029,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
@@ -1024,14 +1040,14 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	err = s3Client.WaitUntilBucketExists(headInput)
 	if err != nil {
-		return fmt.Errorf("failed waiting for bucket %s to be created, %v",
-			bucketName, err)
+		return diag.FromErr(fmt.Errorf("failed waiting for bucket %s to be created, %v",
+			bucketName, err))
 	}
 
 	bucketOutput, err := s3Client.ListBucketsExtended(&s3.ListBucketsExtendedInput{})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if apiType != "sl" {
@@ -1076,7 +1092,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	sess, err := meta.(conns.ClientSession).CosConfigV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if endpointType == "private" {
 		sess.SetServiceURL("https://config.private.cloud-object-storage.cloud.ibm.com/v1")
@@ -1091,7 +1107,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	bucketPtr, response, err := sess.GetBucketConfig(getBucketConfigOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting bucket info rule: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in getting bucket info rule: %s\n%s", err, response))
 	}
 
 	if bucketPtr != nil {
@@ -1118,7 +1134,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	lifecycleptr, err := s3Client.GetBucketLifecycleConfiguration(gInput)
 
 	if (err != nil && !strings.Contains(err.Error(), "NoSuchLifecycleConfiguration: The lifecycle configuration does not exist")) && (err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
-		return err
+		return diag.FromErr(err)
 	}
 	if lifecycleptr != nil {
 		archiveRules := flex.ArchiveRuleGet(lifecycleptr.Rules)
@@ -1146,7 +1162,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	retentionptr, err := s3Client.GetBucketProtectionConfiguration(retentionInput)
 
 	if err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if retentionptr != nil {
@@ -1164,7 +1180,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	versionPtr, err := s3Client.GetBucketVersioning(versionInput)
 
 	if err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
-		return err
+		return diag.FromErr(err)
 	}
 	if versionPtr != nil {
 		versioningData := flex.FlattenCosObejctVersioning(versionPtr)
@@ -1178,11 +1194,11 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCOSBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	bucketName := d.Get("bucket_name").(string)
 	storageClass := d.Get("storage_class").(string)
@@ -1218,7 +1234,7 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if bLocation == "" {
-		return fmt.Errorf("Provide either `cross_region_location` or `region_location` or `single_site_location` or `satellite_location_id`")
+		return diag.FromErr(fmt.Errorf("Provide either `cross_region_location` or `region_location` or `single_site_location` or `satellite_location_id`"))
 	}
 	lConstraint := fmt.Sprintf("%s-%s", bLocation, storageClass)
 
@@ -1240,10 +1256,10 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	apiEndpoint = meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_COS_ENDPOINT", apiEndpoint)
 
 	if apiEndpoint == "" {
-		return fmt.Errorf("[ERROR] The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType)
+		return diag.FromErr(fmt.Errorf("[ERROR] The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType))
 	}
 
 	var create *s3.CreateBucketInput
@@ -1267,12 +1283,12 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" && apiKey == "" {
@@ -1285,7 +1301,7 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 
 	s3Sess := session.Must(session.NewSession())
@@ -1294,17 +1310,17 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 	_, err = s3Client.CreateBucket(create)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// Generating a fake id which contains every information about to get the bucket via s3 api
 	bucketID := fmt.Sprintf("%s:%s:%s:meta:%s:%s:%s", strings.Replace(serviceID, "::", "", -1), "bucket", bucketName, apiType, bLocation, endpointType)
 	d.SetId(bucketID)
 
-	return resourceIBMCOSBucketUpdate(d, meta)
+	return resourceIBMCOSBucketUpdate(ctx, d, meta)
 
 }
 
-func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCOSBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var s3Conf *aws.Config
 	rsConClient, _ := meta.(conns.ClientSession).BluemixSession()
 	bucketName := parseBucketId(d.Id(), "bucketName")
@@ -1349,20 +1365,20 @@ func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	apiEndpoint = meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_COS_ENDPOINT", apiEndpoint)
 
 	if apiEndpoint == "" {
-		return fmt.Errorf("[ERROR] The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType)
+		return diag.FromErr(fmt.Errorf("[ERROR] The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType))
 	}
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" && apiKey == "" {
@@ -1375,7 +1391,7 @@ func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 
 	s3Sess := session.Must(session.NewSession())
@@ -1401,16 +1417,16 @@ func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error
 				err = deleteAllCOSObjectVersions(s3Client, bucketName, "", false, false)
 
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error COS Bucket force_delete: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error COS Bucket force_delete: %s", err))
 				}
 
 				// this line recurses until all objects are deleted or an error is returned
-				return resourceIBMCOSBucketDelete(d, meta)
+				return resourceIBMCOSBucketDelete(ctx, d, meta)
 			}
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting COS Bucket (%s): %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting COS Bucket (%s): %s", d.Id(), err))
 	}
 
 	return nil
@@ -1458,7 +1474,7 @@ func resourceIBMCOSBucketExists(d *schema.ResourceData, meta interface{}) (bool,
 
 	}
 
-	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	apiEndpoint = meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_COS_ENDPOINT", apiEndpoint)
 
 	if apiEndpoint == "" {
 		return false, fmt.Errorf("[ERROR] The endpoint doesn't exists for given endpoint type %s", endpointType)
@@ -1471,7 +1487,7 @@ func resourceIBMCOSBucketExists(d *schema.ResourceData, meta interface{}) (bool,
 
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" && apiKey == "" {
@@ -1484,7 +1500,7 @@ func resourceIBMCOSBucketExists(d *schema.ResourceData, meta interface{}) (bool,
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig().WithHTTPClient(rsConClient.Config.HTTPClient), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 
 	s3Sess := session.Must(session.NewSession())
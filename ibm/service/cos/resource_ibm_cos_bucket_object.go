@@ -6,11 +6,10 @@ package cos
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"fmt"
-	"io"
 	"log"
-	"os"
+	"math"
+	"net/http"
 	"regexp"
 	"strings"
 	"time"
@@ -25,7 +24,9 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceIBMCOSBucketObject() *schema.Resource {
@@ -35,6 +36,9 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 		UpdateContext: resourceIBMCOSBucketObjectUpdate,
 		DeleteContext: resourceIBMCOSBucketObjectDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.Sequence(
+			resourceIBMCOSBucketObjectContentFileDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -126,6 +130,58 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Computed:    true,
 				Description: "Access the object using an SQL Query instance.The reference url is used to perform queries against objects storing structured data.",
 			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validate.ValidateAllowedRangeInt(5, 5120),
+				Description:  "The size in MiB of the parts of the multipart upload of the objects larger than it, from 5 to 5120 MiB.",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, 32),
+				Description:  "The number of parts of a multipart upload uploaded concurrently.",
+			},
+			"kms_key_crn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"sse_customer_key"},
+				Description:   "The CRN of the Key Protect or Hyper Protect Crypto Services root key encrypting the object.",
+			},
+			"sse_customer_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ForceNew:      true,
+				ConflictsWith: []string{"kms_key_crn"},
+				ValidateFunc:  validateSSECustomerKey,
+				Description:   "The base64-encoded 256-bit AES key encrypting the object, needed to read it.",
+			},
+			"object_lock_legal_hold_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{cosObjectLockLegalHoldOn, cosObjectLockLegalHoldOff}),
+				Description:  "The legal hold of the object, ON or OFF, in a bucket with object lock enabled.",
+			},
+			"object_lock_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"object_lock_retain_until_date"},
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{cosObjectLockModeCompliance}),
+				Description:  "The retention mode of the object, COMPLIANCE, in a bucket with object lock enabled.",
+			},
+			"object_lock_retain_until_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"object_lock_mode"},
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentCOSDateTime,
+				Description:      "The date the retention of the object expires, in the RFC 3339 format. The retention can only be extended.",
+			},
 		},
 	}
 }
@@ -143,7 +199,7 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -160,47 +216,23 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("[ERROR] Error COS bucket (%s) object (%s) already exists", bucketName, objectKey))
 	}
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-		}
-		body = bytes.NewReader(contentRaw)
-	} else if v, ok := d.GetOk("content_file"); ok {
-		path := v.(string)
-		file, err := os.Open(path)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-			}
-		}()
-	}
-
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
+	upload, closeContent, err := newCOSObjectUpload(d, s3Client, bucketName)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	defer closeContent()
 
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	if err := upload.Upload(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
 	objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
 	d.SetId(objectID)
 
+	if err := resourceIBMCOSBucketObjectPutLock(ctx, d, s3Client, bucketName, true); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
 }
 
@@ -221,7 +253,7 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -231,6 +263,14 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		Bucket: aws.String(bucketName),
 		Key:    aws.String(objectKey),
 	}
+	sseCustomerKey, err := cosSSECustomerKey(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if sseCustomerKey != "" {
+		headInput.SSECustomerAlgorithm = aws.String(s3.ServerSideEncryptionAes256)
+		headInput.SSECustomerKey = aws.String(sseCustomerKey)
+	}
 
 	out, err := s3Client.HeadObject(headInput)
 	if err != nil {
@@ -245,6 +285,9 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("content_length", out.ContentLength)
 	d.Set("content_type", out.ContentType)
 	d.Set("etag", strings.Trim(aws.StringValue(out.ETag), `"`))
+	if out.SSEKMSKeyId != nil {
+		d.Set("kms_key_crn", out.SSEKMSKeyId)
+	}
 	if out.LastModified != nil {
 		d.Set("last_modified", out.LastModified.Format(time.RFC1123))
 	} else {
@@ -256,6 +299,10 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 			Bucket: aws.String(bucketName),
 			Key:    aws.String(objectKey),
 		}
+		if sseCustomerKey != "" {
+			getInput.SSECustomerAlgorithm = aws.String(s3.ServerSideEncryptionAes256)
+			getInput.SSECustomerKey = aws.String(sseCustomerKey)
+		}
 		out, err := s3Client.GetObject(&getInput)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed getting COS object: %w", err))
@@ -279,6 +326,10 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[INFO] Ignoring body of COS bucket (%s) object (%s) with Content-Type %q", bucketName, objectKey, contentType)
 	}
 
+	if err := resourceIBMCOSBucketObjectReadLock(ctx, d, s3Client, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.Set("key", objectKey)
 	d.Set("version_id", out.VersionId)
 	d.Set("object_sql_url", "cos://"+bucketLocation+"/"+bucketName+"/"+objectKey)
@@ -286,7 +337,8 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if d.HasChanges("content", "content_base64", "content_file", "etag") {
+	uploaded := d.HasChanges("content", "content_base64", "content_file", "etag")
+	if uploaded || d.HasChanges("object_lock_legal_hold_status", "object_lock_mode", "object_lock_retain_until_date") {
 		bucketCRN := d.Get("bucket_crn").(string)
 		bucketName := strings.Split(bucketCRN, ":bucket:")[1]
 		instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
@@ -299,53 +351,32 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 			return diag.FromErr(err)
 		}
 
-		s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+		s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		var body io.ReadSeeker
-
-		if v, ok := d.GetOk("content"); ok {
-			content := v.(string)
-			body = bytes.NewReader([]byte(content))
-		} else if v, ok := d.GetOk("content_base64"); ok {
-			content := v.(string)
-			contentRaw, err := base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-			}
-			body = bytes.NewReader(contentRaw)
-		} else if v, ok := d.GetOk("content_file"); ok {
-			path := v.(string)
-			file, err := os.Open(path)
+		if uploaded {
+			upload, closeContent, err := newCOSObjectUpload(d, s3Client, bucketName)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
+				return diag.FromErr(err)
 			}
+			defer closeContent()
 
-			body = file
-			defer func() {
-				err := file.Close()
-				if err != nil {
-					log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-				}
-			}()
-		}
-
-		objectKey := d.Get("key").(string)
+			if err := upload.Upload(ctx); err != nil {
+				return diag.FromErr(err)
+			}
 
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
+			objectKey := d.Get("key").(string)
+			objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
+			d.SetId(objectID)
 		}
 
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		// The new version of the object uploaded gets the default retention of
+		// the bucket, its legal hold and retention are set again.
+		if err := resourceIBMCOSBucketObjectPutLock(ctx, d, s3Client, bucketName, uploaded); err != nil {
+			return diag.FromErr(err)
 		}
-
-		objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
-		d.SetId(objectID)
 	}
 
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
@@ -364,7 +395,7 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -379,9 +410,128 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	// The multipart uploads which failed to complete, kept for the next apply
+	// to resume them, are aborted with the object.
+	if err := abortCOSObjectUploads(ctx, s3Client, bucketName, objectKey, map[string]bool{objectKey: true}); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
+// resourceIBMCOSBucketObjectContentFileDiff plans the update of the object if
+// the ETag of the content of content_file, once uploaded, differs from the
+// ETag of the object, unless etag is set.
+func resourceIBMCOSBucketObjectContentFileDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	path, ok := d.GetOk("content_file")
+	if !ok || d.Id() == "" || d.HasChange("content_file") || !d.GetRawConfig().GetAttr("etag").IsNull() {
+		return nil
+	}
+	// The ETags of the objects encrypted with a customer-provided key aren't
+	// the MD5 digests of their content.
+	if _, ok := d.GetOk("sse_customer_key"); ok {
+		return nil
+	}
+	etag := d.Get("etag").(string)
+	// The parts of a multipart upload are of the part size it was uploaded
+	// with, a single part has the digest of the whole content.
+	partSize, _ := d.GetChange("part_size")
+	size := int64(partSize.(int)) * mebibyte
+	if !strings.Contains(etag, "-") {
+		size = math.MaxInt64
+	}
+	fileETag, err := cosFileETag(path.(string), size)
+	if err != nil {
+		log.Printf("[WARN] Failed computing the ETag of COS object file (%s): %s", path, err)
+		return nil
+	}
+	if fileETag != etag {
+		log.Printf("[INFO] The ETag of COS object file (%s) changed from %s to %s", path, etag, fileETag)
+		return d.SetNewComputed("etag")
+	}
+	return nil
+}
+
+// resourceIBMCOSBucketObjectPutLock sets the legal hold and the retention of
+// the object, if set and changed or if all is set.
+func resourceIBMCOSBucketObjectPutLock(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName string, all bool) error {
+	objectKey := d.Get("key").(string)
+	if status, ok := d.GetOk("object_lock_legal_hold_status"); ok && (all || d.HasChange("object_lock_legal_hold_status")) {
+		input := &cosObjectLegalHold{
+			Bucket:    aws.String(bucketName),
+			Key:       aws.String(objectKey),
+			LegalHold: &cosObjectLockLegal{Status: aws.String(status.(string))},
+		}
+		if err := cosRequest(ctx, s3Client, "PutObjectLegalHold", http.MethodPut, "/{Bucket}/{Key+}?legal-hold", input, nil); err != nil {
+			return fmt.Errorf("[ERROR] Error setting the legal hold of COS bucket (%s) object (%s): %s", bucketName, objectKey, err)
+		}
+	}
+	if mode, ok := d.GetOk("object_lock_mode"); ok && (all || d.HasChanges("object_lock_mode", "object_lock_retain_until_date")) {
+		retainUntilDate, err := time.Parse(time.RFC3339, d.Get("object_lock_retain_until_date").(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing object_lock_retain_until_date: %s", err)
+		}
+		input := &cosObjectRetention{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+			Retention: &cosObjectLockRetention{
+				Mode:            aws.String(mode.(string)),
+				RetainUntilDate: aws.Time(retainUntilDate),
+			},
+		}
+		if err := cosRequest(ctx, s3Client, "PutObjectRetention", http.MethodPut, "/{Bucket}/{Key+}?retention", input, nil); err != nil {
+			return fmt.Errorf("[ERROR] Error setting the retention of COS bucket (%s) object (%s): %s", bucketName, objectKey, err)
+		}
+	}
+	return nil
+}
+
+// resourceIBMCOSBucketObjectReadLock reads the legal hold and the retention of
+// the object, if set.
+func resourceIBMCOSBucketObjectReadLock(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string) error {
+	if _, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+		out := &cosObjectLegalHold{}
+		err := cosRequest(ctx, s3Client, "GetObjectLegalHold", http.MethodGet, "/{Bucket}/{Key+}?legal-hold", &cosObjectInput{Bucket: aws.String(bucketName), Key: aws.String(objectKey)}, out)
+		if err != nil && !isCOSErrorCode(err, cosErrCodeNoSuchObjectLockConfiguration, "NoSuchObjectLockConfiguration") {
+			return fmt.Errorf("[ERROR] Error getting the legal hold of COS bucket (%s) object (%s): %s", bucketName, objectKey, err)
+		}
+		if out.LegalHold != nil {
+			d.Set("object_lock_legal_hold_status", out.LegalHold.Status)
+		} else {
+			d.Set("object_lock_legal_hold_status", cosObjectLockLegalHoldOff)
+		}
+	}
+	if _, ok := d.GetOk("object_lock_mode"); ok {
+		out := &cosObjectRetention{}
+		err := cosRequest(ctx, s3Client, "GetObjectRetention", http.MethodGet, "/{Bucket}/{Key+}?retention", &cosObjectInput{Bucket: aws.String(bucketName), Key: aws.String(objectKey)}, out)
+		if err != nil && !isCOSErrorCode(err, cosErrCodeNoSuchObjectLockConfiguration, "NoSuchObjectLockConfiguration") {
+			return fmt.Errorf("[ERROR] Error getting the retention of COS bucket (%s) object (%s): %s", bucketName, objectKey, err)
+		}
+		if out.Retention != nil && out.Retention.RetainUntilDate != nil {
+			d.Set("object_lock_mode", out.Retention.Mode)
+			d.Set("object_lock_retain_until_date", out.Retention.RetainUntilDate.UTC().Format(time.RFC3339))
+		} else {
+			d.Set("object_lock_mode", nil)
+			d.Set("object_lock_retain_until_date", nil)
+		}
+	}
+	return nil
+}
+
+// suppressEquivalentCOSDateTime suppresses the diff of the RFC 3339 date
+// times of the same instant.
+func suppressEquivalentCOSDateTime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
 func getCosEndpoint(bucketLocation string, endpointType string) string {
 	if bucketLocation != "" {
 		switch endpointType {
@@ -398,11 +548,11 @@ func getCosEndpoint(bucketLocation string, endpointType string) string {
 	return ""
 }
 
-func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
+func getS3Client(m interface{}, bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
 	var s3Conf *aws.Config
 
 	apiEndpoint := getCosEndpoint(bucketLocation, endpointType)
-	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	apiEndpoint = m.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_COS_ENDPOINT", apiEndpoint)
 	if apiEndpoint == "" {
 		return nil, fmt.Errorf("the endpoint doesn't exists for given location %s and endpoint type %s", bucketLocation, endpointType)
 	}
@@ -414,7 +564,7 @@ func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointTy
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := bxSession.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, instanceCRN)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(bxSession.Config.HTTPClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig().WithHTTPClient(bxSession.Config.HTTPClient), authEndpointPath, apiKey, instanceCRN)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := bxSession.Config.IAMAccessToken
 	if iamAccessToken != "" {
@@ -427,7 +577,7 @@ func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointTy
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, instanceCRN)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithHTTPClient(bxSession.Config.HTTPClient).WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig().WithHTTPClient(bxSession.Config.HTTPClient), initFunc, authEndpointPath, instanceCRN)).WithS3ForcePathStyle(true)
 	}
 	s3Sess := session.Must(session.NewSession())
 	return s3.New(s3Sess, s3Conf), nil
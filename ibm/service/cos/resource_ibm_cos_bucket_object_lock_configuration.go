@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cosErrCodeNoSuchObjectLockConfiguration = "ObjectLockConfigurationNotFoundError"
+	cosObjectLockEnabled                    = "Enabled"
+	cosObjectLockModeCompliance             = "COMPLIANCE"
+	cosObjectLockLegalHoldOn                = "ON"
+	cosObjectLockLegalHoldOff               = "OFF"
+)
+
+func ResourceIBMCOSBucketObjectLockConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectLockConfigurationCreate,
+		ReadContext:   resourceIBMCOSBucketObjectLockConfigurationRead,
+		UpdateContext: resourceIBMCOSBucketObjectLockConfigurationUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectLockConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: validate.InvokeCustomizeDiff("ibm_cos_bucket_object_lock_configuration"),
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"object_lock_configuration": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The object lock configuration of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_lock_enabled": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{cosObjectLockEnabled}),
+							Description:  "Whether the object lock is enabled on the bucket, `Enabled`.",
+						},
+						"object_lock_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The rule locking the new objects of the bucket.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_retention": {
+										Type:        schema.TypeList,
+										Required:    true,
+										MaxItems:    1,
+										Description: "The retention of the new objects of the bucket.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"mode": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validate.ValidateAllowedStringValues([]string{cosObjectLockModeCompliance}),
+													Description:  "The retention mode, `COMPLIANCE`.",
+												},
+												"days": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validate.ValidateAllowedRangeInt(1, 36500),
+													Description:  "The retention period in days, exactly one of days and years must be set.",
+												},
+												"years": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validate.ValidateAllowedRangeInt(1, 100),
+													Description:  "The retention period in years, exactly one of days and years must be set.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMCOSBucketObjectLockConfigurationValidator() *validate.ResourceValidator {
+	validateRules := make([]validate.ValidateRule, 0)
+	validateRules = append(validateRules,
+		validate.ValidateRule{
+			Type:        validate.ExactlyOneOf,
+			Identifiers: []string{"object_lock_configuration.0.object_lock_rule.0.default_retention.0.days", "object_lock_configuration.0.object_lock_rule.0.default_retention.0.years"},
+			When:        "object_lock_configuration.0.object_lock_rule.0.default_retention"})
+
+	ibmCOSBucketObjectLockConfigurationResourceValidator := validate.ResourceValidator{ResourceName: "ibm_cos_bucket_object_lock_configuration", Rules: validateRules}
+	return &ibmCOSBucketObjectLockConfigurationResourceValidator
+}
+
+func resourceIBMCOSBucketObjectLockConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+
+	if err := resourceIBMCOSBucketObjectLockConfigurationPut(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(getCOSBucketConfigID(bucketCRN, "object-lock", bucketLocation))
+
+	return resourceIBMCOSBucketObjectLockConfigurationRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectLockConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN, bucketLocation, err := parseCOSBucketConfigID(d.Id(), "object-lock")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if _, ok := d.GetOk("endpoint_type"); !ok {
+		d.Set("endpoint_type", "public")
+	}
+
+	s3Client, bucketName, err := cosBucketS3Client(m, bucketCRN, bucketLocation, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out := &cosBucketObjectLock{}
+	err = cosRequest(ctx, s3Client, "GetObjectLockConfiguration", http.MethodGet, "/{Bucket}?object-lock", &cosBucketInput{Bucket: aws.String(bucketName)}, out)
+	if err != nil {
+		if isCOSErrorCode(err, s3.ErrCodeNoSuchBucket, cosErrCodeNoSuchObjectLockConfiguration) {
+			log.Printf("[WARN] The object lock configuration of COS bucket (%s) not found, removing it from state", bucketName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the object lock configuration of COS bucket (%s): %s", bucketName, err))
+	}
+
+	configuration := []map[string]interface{}{}
+	if lock := out.ObjectLockConfiguration; lock != nil {
+		rule := []map[string]interface{}{}
+		if lock.Rule != nil && lock.Rule.DefaultRetention != nil {
+			retention := lock.Rule.DefaultRetention
+			rule = append(rule, map[string]interface{}{
+				"default_retention": []map[string]interface{}{{
+					"mode":  aws.StringValue(retention.Mode),
+					"days":  int(aws.Int64Value(retention.Days)),
+					"years": int(aws.Int64Value(retention.Years)),
+				}},
+			})
+		}
+		configuration = append(configuration, map[string]interface{}{
+			"object_lock_enabled": aws.StringValue(lock.ObjectLockEnabled),
+			"object_lock_rule":    rule,
+		})
+	}
+	if err := d.Set("object_lock_configuration", configuration); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting object_lock_configuration: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketObjectLockConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("object_lock_configuration") {
+		if err := resourceIBMCOSBucketObjectLockConfigurationPut(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMCOSBucketObjectLockConfigurationRead(ctx, d, m)
+}
+
+// resourceIBMCOSBucketObjectLockConfigurationDelete removes the default
+// retention of the bucket, the object lock of a bucket can't be disabled once
+// enabled.
+func resourceIBMCOSBucketObjectLockConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := cosBucketS3Client(m, d.Get("bucket_crn").(string), d.Get("bucket_location").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	input := &cosBucketObjectLock{
+		Bucket: aws.String(bucketName),
+		ObjectLockConfiguration: &cosObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(cosObjectLockEnabled),
+		},
+	}
+	err = cosRequest(ctx, s3Client, "PutObjectLockConfiguration", http.MethodPut, "/{Bucket}?object-lock", input, nil)
+	if err != nil && !isCOSErrorCode(err, s3.ErrCodeNoSuchBucket) {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error removing the default retention of COS bucket (%s): %s", bucketName, err))
+	}
+	log.Printf("[INFO] The object lock of COS bucket (%s) stays enabled, only its default retention is removed", bucketName)
+	return nil
+}
+
+// resourceIBMCOSBucketObjectLockConfigurationPut sets the object lock
+// configuration of the bucket.
+func resourceIBMCOSBucketObjectLockConfigurationPut(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	s3Client, bucketName, err := cosBucketS3Client(m, d.Get("bucket_crn").(string), d.Get("bucket_location").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	configuration := &cosObjectLockConfiguration{
+		ObjectLockEnabled: aws.String(d.Get("object_lock_configuration.0.object_lock_enabled").(string)),
+	}
+	if v, ok := d.GetOk("object_lock_configuration.0.object_lock_rule.0.default_retention.0"); ok {
+		r := v.(map[string]interface{})
+		retention := &cosObjectLockDefaultRetention{
+			Mode: aws.String(r["mode"].(string)),
+		}
+		if days := r["days"].(int); days != 0 {
+			retention.Days = aws.Int64(int64(days))
+		} else {
+			retention.Years = aws.Int64(int64(r["years"].(int)))
+		}
+		configuration.Rule = &cosObjectLockRule{DefaultRetention: retention}
+	}
+
+	input := &cosBucketObjectLock{
+		Bucket:                  aws.String(bucketName),
+		ObjectLockConfiguration: configuration,
+	}
+	if err := cosRequest(ctx, s3Client, "PutObjectLockConfiguration", http.MethodPut, "/{Bucket}?object-lock", input, nil); err != nil {
+		return fmt.Errorf("[ERROR] Error setting the object lock configuration of COS bucket (%s): %s", bucketName, err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectLockConfiguration_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	retainUntilDate := time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectLockConfigurationConfig(name, instanceCRN, 1, retainUntilDate, "ON"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object_lock_configuration.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object_lock_configuration.testacc", "object_lock_configuration.0.object_lock_enabled", "Enabled"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object_lock_configuration.testacc", "object_lock_configuration.0.object_lock_rule.0.default_retention.0.mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object_lock_configuration.testacc", "object_lock_configuration.0.object_lock_rule.0.default_retention.0.days", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_retain_until_date", retainUntilDate),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_legal_hold_status", "ON"),
+				),
+			},
+			{
+				// The legal hold is released for the object to be deleted once
+				// its retention expires.
+				Config: testAccIBMCOSBucketObjectLockConfigurationConfig(name, instanceCRN, 2, retainUntilDate, "OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object_lock_configuration.testacc", "object_lock_configuration.0.object_lock_rule.0.default_retention.0.days", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_legal_hold_status", "OFF"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectLockConfigurationConfig(name string, instanceCRN string, days int, retainUntilDate string, legalHold string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+			object_versioning {
+				enable = true
+			}
+		}
+		resource "ibm_cos_bucket_object_lock_configuration" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			object_lock_configuration {
+				object_lock_enabled = "Enabled"
+				object_lock_rule {
+					default_retention {
+						mode = "COMPLIANCE"
+						days = %[3]d
+					}
+				}
+			}
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn                    = ibm_cos_bucket.testacc.crn
+			bucket_location               = ibm_cos_bucket.testacc.region_location
+			key                           = "%[1]s.txt"
+			content                       = "Acceptance Testing"
+			object_lock_mode              = "COMPLIANCE"
+			object_lock_retain_until_date = "%[4]s"
+			object_lock_legal_hold_status = "%[5]s"
+			depends_on                    = [ibm_cos_bucket_object_lock_configuration.testacc]
+		}`, name, instanceCRN, days, retainUntilDate, legalHold)
+}
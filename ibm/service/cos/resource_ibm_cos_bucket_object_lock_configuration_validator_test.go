@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+)
+
+func TestCOSBucketObjectLockConfigurationValidator(t *testing.T) {
+	ty := ResourceIBMCOSBucketObjectLockConfiguration().CoreConfigSchema().ImpliedType()
+	rules := ResourceIBMCOSBucketObjectLockConfigurationValidator().Rules
+	config := func(rule string) string {
+		return `{"bucket_crn": "crn:v1:bluemix:public:cloud-object-storage:global:a/1:1:bucket:locked", "bucket_location": "us-south",
+			"object_lock_configuration": [{"object_lock_enabled": "Enabled", "object_lock_rule": [` + rule + `]}]}`
+	}
+
+	cases := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{"days", config(`{"default_retention": [{"mode": "COMPLIANCE", "days": 6}]}`), ""},
+		{"years", config(`{"default_retention": [{"mode": "COMPLIANCE", "years": 1}]}`), ""},
+		{"no rule", config(``), ""},
+		{"neither", config(`{"default_retention": [{"mode": "COMPLIANCE"}]}`), "must be set"},
+		{"both", config(`{"default_retention": [{"mode": "COMPLIANCE", "days": 6, "years": 1}]}`), "can be set"},
+	}
+	for _, c := range cases {
+		v, err := ctyjson.Unmarshal([]byte(c.config), ty)
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		err = validate.ValidateRules(v, rules)
+		switch {
+		case c.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+		case c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)):
+			t.Errorf("%s: expected an error containing %q, got %v", c.name, c.err, err)
+		}
+	}
+}
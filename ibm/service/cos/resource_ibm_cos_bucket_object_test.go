@@ -4,15 +4,19 @@
 package cos_test
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccIBMCOSBucketObject_basic(t *testing.T) {
@@ -115,3 +119,66 @@ func testAccIBMCOSBucketObjectConfig_file(name string, instanceCRN string, objec
 			content_file	  = "%[3]s"
 		}`, name, instanceCRN, objectFile)
 }
+
+func TestAccIBMCOSBucketObject_multipart(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	objectFile := filepath.Join(t.TempDir(), "object.bin")
+	writeObjectFile := func(fill byte) {
+		// 12 MiB, three parts of 5 MiB.
+		if err := ioutil.WriteFile(objectFile, bytes.Repeat([]byte{fill}, 12*1024*1024), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeObjectFile('a')
+	var etag string
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", "12582912"),
+					resource.TestMatchResourceAttr("ibm_cos_bucket_object.testacc", "etag", regexp.MustCompile(`^[0-9a-f]{32}-3$`)),
+					func(s *terraform.State) error {
+						etag = s.RootModule().Resources["ibm_cos_bucket_object.testacc"].Primary.Attributes["etag"]
+						return nil
+					},
+				),
+			},
+			{
+				// The change of the content of the file is detected by its ETag.
+				PreConfig: func() { writeObjectFile('b') },
+				Config:    testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("ibm_cos_bucket_object.testacc", "etag", regexp.MustCompile(`^[0-9a-f]{32}-3$`)),
+					func(s *terraform.State) error {
+						if updated := s.RootModule().Resources["ibm_cos_bucket_object.testacc"].Primary.Attributes["etag"]; updated == etag {
+							return fmt.Errorf("expected the object to be uploaded again, its ETag is still %s", etag)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectConfig_multipart(name string, instanceCRN string, objectFile string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn         = ibm_cos_bucket.testacc.crn
+			bucket_location    = ibm_cos_bucket.testacc.region_location
+			key                = "%[1]s.bin"
+			content_file       = "%[3]s"
+			part_size          = 5
+			upload_concurrency = 2
+		}`, name, instanceCRN, objectFile)
+}
@@ -0,0 +1,450 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cosDeleteObjectsBatch is the maximum number of objects deleted by a request.
+const cosDeleteObjectsBatch = 1000
+
+func ResourceIBMCOSBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectsSyncCreate,
+		ReadContext:   resourceIBMCOSBucketObjectsSyncRead,
+		UpdateContext: resourceIBMCOSBucketObjectsSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectsSyncDelete,
+		CustomizeDiff: customdiff.Sequence(
+			resourceIBMCOSBucketObjectsSyncManifestDiff,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The local directory whose files are synchronized to the bucket.",
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "The prefix of the keys of the objects, prepended to the paths of the files relative to source_dir, e.g. `site/`.",
+			},
+			"delete_removed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the objects of the files removed from source_dir are deleted, they are left in the bucket otherwise.",
+			},
+			"content_types": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "The content types of the objects by file extension, e.g. `.wasm = \"application/wasm\"`, overriding the content types guessed from the extensions.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validate.ValidateAllowedRangeInt(5, 5120),
+				Description:  "The size in MiB of the parts of the multipart upload of the files larger than it, from 5 to 5120 MiB.",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, 32),
+				Description:  "The number of files uploaded concurrently, from 1 to 32.",
+			},
+			"manifest": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The ETags of the objects synchronized, by path of their file relative to source_dir.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceIBMCOSBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	d.SetId(fmt.Sprintf("%s:sync:%s:location:%s", bucketCRN, keyPrefix, bucketLocation))
+
+	if err := resourceIBMCOSBucketObjectsSyncApply(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := resourceIBMCOSBucketObjectsSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keyPrefix := d.Get("key_prefix").(string)
+
+	objects, err := listCOSObjectETags(ctx, s3Client, bucketName, keyPrefix)
+	if err != nil {
+		if isCOSErrorCode(err, s3.ErrCodeNoSuchBucket) {
+			log.Printf("[WARN] COS bucket (%s) not found, removing the synchronization of its objects from state", bucketName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the objects of COS bucket (%s) with prefix (%s): %s", bucketName, keyPrefix, err))
+	}
+
+	// The objects removed or modified out of Terraform are uploaded again by
+	// the next apply.
+	manifest := map[string]interface{}{}
+	for path := range d.Get("manifest").(map[string]interface{}) {
+		if etag, ok := objects[keyPrefix+path]; ok {
+			manifest[path] = etag
+		}
+	}
+	if err := d.Set("manifest", manifest); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting manifest: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := resourceIBMCOSBucketObjectsSyncApply(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := resourceIBMCOSBucketObjectsSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keyPrefix := d.Get("key_prefix").(string)
+
+	keys := map[string]bool{}
+	for path := range d.Get("manifest").(map[string]interface{}) {
+		keys[keyPrefix+path] = true
+	}
+	if err := deleteCOSObjects(ctx, s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := abortCOSObjectUploads(ctx, s3Client, bucketName, keyPrefix, keys); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceIBMCOSBucketObjectsSyncManifestDiff plans the upload of the files
+// of source_dir whose ETags, once uploaded, differ from the ETags of their
+// objects.
+func resourceIBMCOSBucketObjectsSyncManifestDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("part_size") {
+		return d.SetNewComputed("manifest")
+	}
+	sourceDir := d.Get("source_dir").(string)
+	manifest, err := cosSyncManifest(sourceDir, int64(d.Get("part_size").(int))*mebibyte)
+	if err != nil {
+		return err
+	}
+	newManifest := map[string]interface{}{}
+	for path, etag := range manifest {
+		newManifest[path] = etag
+	}
+	if reflect.DeepEqual(newManifest, d.Get("manifest").(map[string]interface{})) {
+		return nil
+	}
+	log.Printf("[INFO] The files of directory (%s) changed, planning their synchronization", sourceDir)
+	return d.SetNew("manifest", newManifest)
+}
+
+// resourceIBMCOSBucketObjectsSyncApply uploads the files of source_dir whose
+// objects are missing, differ or have another content type, deletes the
+// objects of the files removed if delete_removed is set, and sets the
+// manifest to the objects synchronized.
+func resourceIBMCOSBucketObjectsSyncApply(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	s3Client, bucketName, err := resourceIBMCOSBucketObjectsSyncClient(d, m)
+	if err != nil {
+		return err
+	}
+	sourceDir := d.Get("source_dir").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	partSize := int64(d.Get("part_size").(int)) * mebibyte
+
+	local, err := cosSyncManifest(sourceDir, partSize)
+	if err != nil {
+		return err
+	}
+	remote, err := listCOSObjectETags(ctx, s3Client, bucketName, keyPrefix)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the objects of COS bucket (%s) with prefix (%s): %s", bucketName, keyPrefix, err)
+	}
+
+	oldContentTypes, newContentTypes := d.GetChange("content_types")
+	oldManifest, _ := d.GetChange("manifest")
+	uploads, removed := cosSyncChanges(local, remote, keyPrefix, oldManifest.(map[string]interface{}), oldContentTypes.(map[string]interface{}), newContentTypes.(map[string]interface{}))
+
+	// The manifest is set to the objects uploaded even if others fail, for the
+	// next apply to upload only the others.
+	manifest := map[string]interface{}{}
+	for path := range local {
+		if etag, ok := remote[keyPrefix+path]; ok {
+			manifest[path] = etag
+		}
+	}
+	uploaded, uploadErr := resourceIBMCOSBucketObjectsSyncUpload(ctx, d, s3Client, bucketName, uploads)
+	for _, path := range uploaded {
+		manifest[path] = local[path]
+	}
+
+	if uploadErr == nil && d.Get("delete_removed").(bool) {
+		uploadErr = deleteCOSObjects(ctx, s3Client, bucketName, removed)
+	}
+
+	if err := d.Set("manifest", manifest); err != nil {
+		return fmt.Errorf("[ERROR] Error setting manifest: %s", err)
+	}
+	return uploadErr
+}
+
+// cosSyncChanges returns the paths of the files of the local manifest whose
+// objects in remote, by key, are missing, differ or have another content type,
+// and the keys of the objects of the files of oldManifest removed.
+func cosSyncChanges(local, remote map[string]string, keyPrefix string, oldManifest, oldContentTypes, newContentTypes map[string]interface{}) ([]string, map[string]bool) {
+	var uploads []string
+	for path, etag := range local {
+		if remote[keyPrefix+path] != etag || cosContentType(path, oldContentTypes) != cosContentType(path, newContentTypes) {
+			uploads = append(uploads, path)
+		}
+	}
+	sort.Strings(uploads)
+
+	removed := map[string]bool{}
+	for path := range oldManifest {
+		if _, ok := local[path]; !ok {
+			removed[keyPrefix+path] = true
+		}
+	}
+	return uploads, removed
+}
+
+// resourceIBMCOSBucketObjectsSyncUpload uploads the files of paths
+// concurrently, and returns the paths of the files uploaded.
+func resourceIBMCOSBucketObjectsSyncUpload(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName string, paths []string) ([]string, error) {
+	sourceDir := d.Get("source_dir").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	partSize := int64(d.Get("part_size").(int)) * mebibyte
+	contentTypes := d.Get("content_types").(map[string]interface{})
+
+	var uploaded []string
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var uploadErr error
+	sem := make(chan struct{}, d.Get("upload_concurrency").(int))
+	for _, path := range paths {
+		sem <- struct{}{}
+		mu.Lock()
+		failed := uploadErr != nil
+		mu.Unlock()
+		if failed {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func(path string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := uploadCOSSyncFile(ctx, &cosObjectUpload{
+				Client:      s3Client,
+				Bucket:      bucketName,
+				Key:         keyPrefix + path,
+				ContentType: cosContentType(path, contentTypes),
+				PartSize:    partSize,
+				Concurrency: 1,
+			}, filepath.Join(sourceDir, filepath.FromSlash(path)))
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if uploadErr == nil {
+					uploadErr = err
+				}
+				return
+			}
+			uploaded = append(uploaded, path)
+		}(path)
+	}
+	wg.Wait()
+	log.Printf("[INFO] Uploaded %d of %d files of directory (%s) to COS bucket (%s)", len(uploaded), len(paths), sourceDir, bucketName)
+	return uploaded, uploadErr
+}
+
+func resourceIBMCOSBucketObjectsSyncClient(d *schema.ResourceData, m interface{}) (*s3.S3, string, error) {
+	return cosBucketS3Client(m, d.Get("bucket_crn").(string), d.Get("bucket_location").(string), d.Get("endpoint_type").(string))
+}
+
+// uploadCOSSyncFile uploads the content of the file path.
+func uploadCOSSyncFile(ctx context.Context, u *cosObjectUpload, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+	}
+	u.Content, u.Size = file, info.Size()
+	return u.Upload(ctx)
+}
+
+// cosSyncManifest returns the ETags of the objects of the regular files of
+// sourceDir once uploaded in parts of partSize bytes, by slash-separated path
+// relative to sourceDir.
+func cosSyncManifest(sourceDir string, partSize int64) (map[string]string, error) {
+	info, err := os.Stat(sourceDir)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading directory (%s): %s", sourceDir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("[ERROR] Error reading directory (%s): not a directory", sourceDir)
+	}
+
+	manifest := map[string]string{}
+	err = filepath.Walk(sourceDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			if info, err = os.Stat(path); err != nil {
+				return err
+			}
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(sourceDir, path)
+		if err != nil {
+			return err
+		}
+		etag, err := cosFileETag(path, partSize)
+		if err != nil {
+			return err
+		}
+		manifest[filepath.ToSlash(rel)] = etag
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading the files of directory (%s): %s", sourceDir, err)
+	}
+	return manifest, nil
+}
+
+// cosContentType returns the content type of the object of the file path:
+// the content type of its extension in contentTypes, or the one guessed from
+// its extension.
+func cosContentType(path string, contentTypes map[string]interface{}) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	for extension, contentType := range contentTypes {
+		if "."+strings.TrimPrefix(strings.ToLower(extension), ".") == ext {
+			return contentType.(string)
+		}
+	}
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+// listCOSObjectETags returns the ETags of the objects of a bucket whose keys
+// start with prefix, by key.
+func listCOSObjectETags(ctx context.Context, s3Client *s3.S3, bucketName, prefix string) (map[string]string, error) {
+	etags := map[string]string{}
+	listInput := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(prefix),
+	}
+	err := s3Client.ListObjectsV2PagesWithContext(ctx, listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			etags[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	return etags, err
+}
+
+// deleteCOSObjects deletes the objects of keys, in batches.
+func deleteCOSObjects(ctx context.Context, s3Client *s3.S3, bucketName string, keys map[string]bool) error {
+	var objects []*s3.ObjectIdentifier
+	for key := range keys {
+		objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+	}
+	for len(objects) > 0 {
+		batch := objects
+		if len(batch) > cosDeleteObjectsBatch {
+			batch = batch[:cosDeleteObjectsBatch]
+		}
+		objects = objects[len(batch):]
+
+		out, err := s3Client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{Objects: batch, Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error deleting %d objects of COS bucket (%s): %s", len(batch), bucketName, err)
+		}
+		if len(out.Errors) > 0 {
+			failed := out.Errors[0]
+			return fmt.Errorf("[ERROR] Error deleting %d objects of COS bucket (%s), object (%s): %s", len(out.Errors), bucketName, aws.StringValue(failed.Key), aws.StringValue(failed.Message))
+		}
+	}
+	return nil
+}
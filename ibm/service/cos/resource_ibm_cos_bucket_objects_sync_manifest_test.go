@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCOSContentType(t *testing.T) {
+	contentTypes := map[string]interface{}{".wasm": "application/wasm", "json": "application/vnd.api+json"}
+	cases := []struct {
+		path        string
+		contentType string
+	}{
+		{"logo.png", "image/png"},
+		{"app/module.WASM", "application/wasm"},
+		{"data.json", "application/vnd.api+json"},
+		{"archive.unknown-extension", "application/octet-stream"},
+		{"Makefile", "application/octet-stream"},
+	}
+	for _, c := range cases {
+		if contentType := cosContentType(c.path, contentTypes); contentType != c.contentType {
+			t.Errorf("%s: expected %s, got %s", c.path, c.contentType, contentType)
+		}
+	}
+}
+
+func TestCOSSyncChanges(t *testing.T) {
+	local := map[string]string{"index.html": "etag-1", "style.css": "etag-2", "app.wasm": "etag-3"}
+	remote := map[string]string{"site/index.html": "etag-1", "site/style.css": "etag-old", "site/app.wasm": "etag-3"}
+	oldManifest := map[string]interface{}{"index.html": "etag-1", "style.css": "etag-old", "app.wasm": "etag-3", "old.txt": "etag-4"}
+
+	cases := []struct {
+		name            string
+		remote          map[string]string
+		oldContentTypes map[string]interface{}
+		newContentTypes map[string]interface{}
+		uploads         []string
+	}{
+		{
+			name:    "changed file",
+			remote:  remote,
+			uploads: []string{"style.css"},
+		},
+		{
+			name:    "missing object",
+			remote:  map[string]string{"site/index.html": "etag-1", "site/style.css": "etag-2"},
+			uploads: []string{"app.wasm"},
+		},
+		{
+			name:            "other content type",
+			remote:          remote,
+			newContentTypes: map[string]interface{}{".html": "text/plain"},
+			uploads:         []string{"index.html", "style.css"},
+		},
+		{
+			name:            "same content type",
+			remote:          remote,
+			oldContentTypes: map[string]interface{}{".txt": "text/plain"},
+			newContentTypes: map[string]interface{}{".txt": "text/plain"},
+			uploads:         []string{"style.css"},
+		},
+	}
+	for _, c := range cases {
+		uploads, removed := cosSyncChanges(local, c.remote, "site/", oldManifest, c.oldContentTypes, c.newContentTypes)
+		if !reflect.DeepEqual(uploads, c.uploads) {
+			t.Errorf("%s: expected the uploads %v, got %v", c.name, c.uploads, uploads)
+		}
+		if !reflect.DeepEqual(removed, map[string]bool{"site/old.txt": true}) {
+			t.Errorf("%s: expected the object of the file removed, got %v", c.name, removed)
+		}
+	}
+}
+
+func TestCOSBucketObjectsSyncManifestDiff(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(path, content string) {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html></html>")
+	writeFile("css/style.css", "body {}")
+	writeFile("old.txt", "old")
+
+	manifest, err := cosSyncManifest(dir, 5*mebibyte)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"index.html": md5Hex("<html></html>"), "css/style.css": md5Hex("body {}"), "old.txt": md5Hex("old")}
+	if !reflect.DeepEqual(manifest, expected) {
+		t.Fatalf("expected the manifest %v, got %v", expected, manifest)
+	}
+
+	state := &terraform.InstanceState{
+		ID: "crn:v1:bluemix:public:cloud-object-storage:global:a/1:1:bucket:site:sync:site/:location:us-south",
+		Attributes: map[string]string{
+			"id":                 "crn:v1:bluemix:public:cloud-object-storage:global:a/1:1:bucket:site:sync:site/:location:us-south",
+			"bucket_crn":         "crn:v1:bluemix:public:cloud-object-storage:global:a/1:1:bucket:site",
+			"bucket_location":    "us-south",
+			"endpoint_type":      "public",
+			"source_dir":         dir,
+			"key_prefix":         "site/",
+			"delete_removed":     "false",
+			"part_size":          "5",
+			"upload_concurrency": "4",
+			"manifest.%":         "3",
+		},
+	}
+	for path, etag := range manifest {
+		state.Attributes["manifest."+path] = etag
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"bucket_crn":      "crn:v1:bluemix:public:cloud-object-storage:global:a/1:1:bucket:site",
+		"bucket_location": "us-south",
+		"source_dir":      dir,
+		"key_prefix":      "site/",
+		"part_size":       5,
+	})
+	diff := func() *terraform.InstanceDiff {
+		diff, err := ResourceIBMCOSBucketObjectsSync().Diff(context.Background(), state, config, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return diff
+	}
+
+	if d := diff(); d != nil && len(d.Attributes) > 0 {
+		t.Fatalf("expected no diff for the unchanged files, got %v", d.Attributes)
+	}
+
+	writeFile("css/style.css", "body { margin: 0 }")
+	if err := os.Remove(filepath.Join(dir, "old.txt")); err != nil {
+		t.Fatal(err)
+	}
+	d := diff()
+	if d == nil {
+		t.Fatal("expected a diff for the changed files")
+	}
+	if attr := d.Attributes["manifest.css/style.css"]; attr == nil || attr.New != md5Hex("body { margin: 0 }") {
+		t.Errorf("expected the changed file in the diff, got %+v", attr)
+	}
+	if attr := d.Attributes["manifest.old.txt"]; attr == nil || !attr.NewRemoved {
+		t.Errorf("expected the removed file in the diff, got %+v", attr)
+	}
+	if attr := d.Attributes["manifest.index.html"]; attr != nil && attr.Old != attr.New {
+		t.Errorf("expected the unchanged file not to change, got %+v", attr)
+	}
+}
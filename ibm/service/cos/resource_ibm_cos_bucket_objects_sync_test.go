@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectsSync_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	sourceDir := t.TempDir()
+	writeFile := func(path, content string) {
+		path = filepath.Join(sourceDir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html><body>Acceptance Testing</body></html>")
+	writeFile("css/site.css", "body { margin: 0; }")
+	writeFile("app.wasm", "wasm")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "manifest.%", "3"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "manifest.css/site.css"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_object.wasm", "content_type", "application/wasm"),
+				),
+			},
+			{
+				// The file changed is uploaded and the object of the file
+				// removed is deleted.
+				PreConfig: func() {
+					writeFile("index.html", "<html><body>Acceptance Testing updated</body></html>")
+					if err := os.Remove(filepath.Join(sourceDir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "manifest.%", "2"),
+					resource.TestCheckNoResourceAttr("ibm_cos_bucket_objects_sync.testacc", "manifest.css/site.css"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_object.index", "body", "<html><body>Acceptance Testing updated</body></html>"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsSyncConfig(name string, instanceCRN string, sourceDir string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_objects_sync" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			source_dir      = "%[3]s"
+			key_prefix      = "site/"
+			delete_removed  = true
+			content_types = {
+				".wasm" = "application/wasm"
+			}
+		}
+		data "ibm_cos_bucket_object" "index" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "site/index.html"
+			depends_on      = [ibm_cos_bucket_objects_sync.testacc]
+		}
+		data "ibm_cos_bucket_object" "wasm" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "site/app.wasm"
+			depends_on      = [ibm_cos_bucket_objects_sync.testacc]
+		}`, name, instanceCRN, sourceDir)
+}
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cosErrCodeNoSuchReplicationConfiguration = "ReplicationConfigurationNotFoundError"
+	cosReplicationStatusEnabled              = "Enabled"
+	cosReplicationStatusDisabled             = "Disabled"
+)
+
+func ResourceIBMCOSBucketReplicationRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketReplicationRuleCreate,
+		ReadContext:   resourceIBMCOSBucketReplicationRuleRead,
+		UpdateContext: resourceIBMCOSBucketReplicationRuleUpdate,
+		DeleteContext: resourceIBMCOSBucketReplicationRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"replication_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1000,
+				Description: "The rules replicating the objects of the bucket to destination buckets.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The unique ID of the rule, generated if omitted.",
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Whether the rule is enabled.",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The prefix of the keys of the objects replicated by the rule, all the objects if omitted.",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The priority of the rule among the rules replicating an object to the same destination bucket, the higher the number the higher the priority.",
+						},
+						"deletemarker_replication_status": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the delete markers are replicated.",
+						},
+						"destination_bucket_crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the destination bucket of the rule.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMCOSBucketReplicationRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+
+	if err := resourceIBMCOSBucketReplicationRulePut(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(getCOSBucketConfigID(bucketCRN, "replication", bucketLocation))
+
+	return resourceIBMCOSBucketReplicationRuleRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketReplicationRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN, bucketLocation, err := parseCOSBucketConfigID(d.Id(), "replication")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if _, ok := d.GetOk("endpoint_type"); !ok {
+		d.Set("endpoint_type", "public")
+	}
+
+	s3Client, bucketName, err := cosBucketS3Client(m, bucketCRN, bucketLocation, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out := &cosBucketReplication{}
+	err = cosRequest(ctx, s3Client, "GetBucketReplication", http.MethodGet, "/{Bucket}?replication", &cosBucketInput{Bucket: aws.String(bucketName)}, out)
+	if err != nil {
+		if isCOSErrorCode(err, s3.ErrCodeNoSuchBucket, cosErrCodeNoSuchReplicationConfiguration) {
+			log.Printf("[WARN] The replication configuration of COS bucket (%s) not found, removing it from state", bucketName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the replication configuration of COS bucket (%s): %s", bucketName, err))
+	}
+
+	rules := []map[string]interface{}{}
+	if out.ReplicationConfiguration != nil {
+		for _, rule := range out.ReplicationConfiguration.Rules {
+			r := map[string]interface{}{
+				"rule_id":                         aws.StringValue(rule.ID),
+				"enable":                          aws.StringValue(rule.Status) == cosReplicationStatusEnabled,
+				"priority":                        int(aws.Int64Value(rule.Priority)),
+				"deletemarker_replication_status": false,
+			}
+			if rule.Filter != nil {
+				r["prefix"] = aws.StringValue(rule.Filter.Prefix)
+			}
+			if rule.Destination != nil {
+				r["destination_bucket_crn"] = aws.StringValue(rule.Destination.Bucket)
+			}
+			if rule.DeleteMarkerReplication != nil {
+				r["deletemarker_replication_status"] = aws.StringValue(rule.DeleteMarkerReplication.Status) == cosReplicationStatusEnabled
+			}
+			rules = append(rules, r)
+		}
+	}
+	if err := d.Set("replication_rule", rules); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting replication_rule: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketReplicationRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("replication_rule") {
+		if err := resourceIBMCOSBucketReplicationRulePut(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMCOSBucketReplicationRuleRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketReplicationRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := cosBucketS3Client(m, d.Get("bucket_crn").(string), d.Get("bucket_location").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = cosRequest(ctx, s3Client, "DeleteBucketReplication", http.MethodDelete, "/{Bucket}?replication", &cosBucketInput{Bucket: aws.String(bucketName)}, nil)
+	if err != nil && !isCOSErrorCode(err, s3.ErrCodeNoSuchBucket, cosErrCodeNoSuchReplicationConfiguration) {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the replication configuration of COS bucket (%s): %s", bucketName, err))
+	}
+	return nil
+}
+
+// resourceIBMCOSBucketReplicationRulePut sets the replication configuration
+// of the bucket to the replication rules.
+func resourceIBMCOSBucketReplicationRulePut(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	s3Client, bucketName, err := cosBucketS3Client(m, d.Get("bucket_crn").(string), d.Get("bucket_location").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	configuration := &cosReplicationConfiguration{}
+	for _, v := range d.Get("replication_rule").([]interface{}) {
+		r := v.(map[string]interface{})
+		rule := &cosReplicationRule{
+			Priority:                aws.Int64(int64(r["priority"].(int))),
+			Filter:                  &cosReplicationRuleFilter{Prefix: aws.String(r["prefix"].(string))},
+			Status:                  aws.String(cosReplicationStatusDisabled),
+			Destination:             &cosReplicationDestination{Bucket: aws.String(r["destination_bucket_crn"].(string))},
+			DeleteMarkerReplication: &cosReplicationDeleteMarkerRule{Status: aws.String(cosReplicationStatusDisabled)},
+		}
+		if id := r["rule_id"].(string); id != "" {
+			rule.ID = aws.String(id)
+		}
+		if r["enable"].(bool) {
+			rule.Status = aws.String(cosReplicationStatusEnabled)
+		}
+		if r["deletemarker_replication_status"].(bool) {
+			rule.DeleteMarkerReplication.Status = aws.String(cosReplicationStatusEnabled)
+		}
+		configuration.Rules = append(configuration.Rules, rule)
+	}
+
+	input := &cosBucketReplication{
+		Bucket:                   aws.String(bucketName),
+		ReplicationConfiguration: configuration,
+	}
+	if err := cosRequest(ctx, s3Client, "PutBucketReplication", http.MethodPut, "/{Bucket}?replication", input, nil); err != nil {
+		return fmt.Errorf("[ERROR] Error setting the replication configuration of COS bucket (%s): %s", bucketName, err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketReplicationRule_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketReplicationRuleConfig(name, instanceCRN, "docs/", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_replication_rule.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.testacc", "replication_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.testacc", "replication_rule.0.rule_id", "replicate-docs"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.testacc", "replication_rule.0.prefix", "docs/"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.testacc", "replication_rule.0.deletemarker_replication_status", "false"),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketReplicationRuleConfig(name, instanceCRN, "site/", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.testacc", "replication_rule.0.prefix", "site/"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.testacc", "replication_rule.0.deletemarker_replication_status", "true"),
+				),
+			},
+			{
+				ResourceName:            "ibm_cos_bucket_replication_rule.testacc",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketReplicationRuleConfig(name string, instanceCRN string, prefix string, deleteMarkers bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "source" {
+			bucket_name          = "%[1]s-source"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-south"
+			storage_class        = "standard"
+			object_versioning {
+				enable = true
+			}
+		}
+		resource "ibm_cos_bucket" "destination" {
+			bucket_name          = "%[1]s-destination"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+			object_versioning {
+				enable = true
+			}
+		}
+		resource "ibm_iam_authorization_policy" "replication" {
+			roles                       = ["Writer"]
+			source_service_name         = "cloud-object-storage"
+			source_resource_instance_id = element(split(":", "%[2]s"), 7)
+			source_resource_type        = "bucket"
+			target_service_name         = "cloud-object-storage"
+			target_resource_instance_id = element(split(":", "%[2]s"), 7)
+			target_resource_type        = "bucket"
+		}
+		resource "ibm_cos_bucket_replication_rule" "testacc" {
+			bucket_crn      = ibm_cos_bucket.source.crn
+			bucket_location = ibm_cos_bucket.source.region_location
+			replication_rule {
+				rule_id                         = "replicate-docs"
+				enable                          = true
+				prefix                          = "%[3]s"
+				priority                        = 50
+				deletemarker_replication_status = %[4]t
+				destination_bucket_crn          = ibm_cos_bucket.destination.crn
+			}
+			depends_on = [ibm_iam_authorization_policy.replication]
+		}`, name, instanceCRN, prefix, deleteMarkers)
+}
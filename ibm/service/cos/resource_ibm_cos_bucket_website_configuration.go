@@ -0,0 +1,357 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const cosErrCodeNoSuchWebsiteConfiguration = "NoSuchWebsiteConfiguration"
+
+func ResourceIBMCOSBucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketWebsiteConfigurationCreate,
+		ReadContext:   resourceIBMCOSBucketWebsiteConfigurationRead,
+		UpdateContext: resourceIBMCOSBucketWebsiteConfigurationUpdate,
+		DeleteContext: resourceIBMCOSBucketWebsiteConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"website_configuration": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The static website configuration of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"website_configuration.0.redirect_all_requests_to"},
+							Description:   "The index document of the website.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"suffix": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The suffix appended to the requests of directories, e.g. `index.html`.",
+									},
+								},
+							},
+						},
+						"error_document": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"website_configuration.0.redirect_all_requests_to"},
+							Description:   "The document returned on the 4XX errors.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The key of the error document object.",
+									},
+								},
+							},
+						},
+						"redirect_all_requests_to": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"website_configuration.0.index_document", "website_configuration.0.error_document", "website_configuration.0.routing_rule"},
+							Description:   "The host that all the requests of the website are redirected to.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The host name the requests are redirected to.",
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{"http", "https"}),
+										Description:  "The protocol of the redirections, `http` or `https`, the protocol of the request if omitted.",
+									},
+								},
+							},
+						},
+						"routing_rule": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ConflictsWith: []string{"website_configuration.0.redirect_all_requests_to"},
+							Description:   "The rules redirecting the requests matching their conditions.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Description: "The condition of the redirection, all the requests if omitted.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"http_error_code_returned_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The HTTP error code of the requests redirected, e.g. `404`.",
+												},
+												"key_prefix_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The prefix of the keys of the requests redirected, e.g. `docs/`.",
+												},
+											},
+										},
+									},
+									"redirect": {
+										Type:        schema.TypeList,
+										Required:    true,
+										MaxItems:    1,
+										Description: "The redirection of the requests.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"host_name": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The host name of the redirection.",
+												},
+												"http_redirect_code": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The HTTP redirect code of the redirection, e.g. `301`.",
+												},
+												"protocol": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validate.ValidateAllowedStringValues([]string{"http", "https"}),
+													Description:  "The protocol of the redirection, `http` or `https`.",
+												},
+												"replace_key_prefix_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The prefix replacing key_prefix_equals in the key of the redirection, conflicts with replace_key_with.",
+												},
+												"replace_key_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The key of the redirection, conflicts with replace_key_prefix_with.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"website_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The endpoint of the website of the bucket.",
+			},
+		},
+	}
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+
+	if err := resourceIBMCOSBucketWebsiteConfigurationPut(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(getCOSBucketConfigID(bucketCRN, "website", bucketLocation))
+
+	return resourceIBMCOSBucketWebsiteConfigurationRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN, bucketLocation, err := parseCOSBucketConfigID(d.Id(), "website")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if _, ok := d.GetOk("endpoint_type"); !ok {
+		d.Set("endpoint_type", "public")
+	}
+
+	s3Client, bucketName, err := cosBucketS3Client(m, bucketCRN, bucketLocation, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out, err := s3Client.GetBucketWebsiteWithContext(ctx, &s3.GetBucketWebsiteInput{Bucket: aws.String(bucketName)})
+	if err != nil {
+		if isCOSErrorCode(err, s3.ErrCodeNoSuchBucket, cosErrCodeNoSuchWebsiteConfiguration) {
+			log.Printf("[WARN] The website configuration of COS bucket (%s) not found, removing it from state", bucketName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the website configuration of COS bucket (%s): %s", bucketName, err))
+	}
+
+	website := map[string]interface{}{}
+	if out.IndexDocument != nil {
+		website["index_document"] = []map[string]interface{}{{"suffix": aws.StringValue(out.IndexDocument.Suffix)}}
+	}
+	if out.ErrorDocument != nil {
+		website["error_document"] = []map[string]interface{}{{"key": aws.StringValue(out.ErrorDocument.Key)}}
+	}
+	if out.RedirectAllRequestsTo != nil {
+		website["redirect_all_requests_to"] = []map[string]interface{}{{
+			"host_name": aws.StringValue(out.RedirectAllRequestsTo.HostName),
+			"protocol":  aws.StringValue(out.RedirectAllRequestsTo.Protocol),
+		}}
+	}
+	rules := []map[string]interface{}{}
+	for _, rule := range out.RoutingRules {
+		r := map[string]interface{}{}
+		if rule.Condition != nil {
+			r["condition"] = []map[string]interface{}{{
+				"http_error_code_returned_equals": aws.StringValue(rule.Condition.HttpErrorCodeReturnedEquals),
+				"key_prefix_equals":               aws.StringValue(rule.Condition.KeyPrefixEquals),
+			}}
+		}
+		if rule.Redirect != nil {
+			r["redirect"] = []map[string]interface{}{{
+				"host_name":               aws.StringValue(rule.Redirect.HostName),
+				"http_redirect_code":      aws.StringValue(rule.Redirect.HttpRedirectCode),
+				"protocol":                aws.StringValue(rule.Redirect.Protocol),
+				"replace_key_prefix_with": aws.StringValue(rule.Redirect.ReplaceKeyPrefixWith),
+				"replace_key_with":        aws.StringValue(rule.Redirect.ReplaceKeyWith),
+			}}
+		}
+		rules = append(rules, r)
+	}
+	website["routing_rule"] = rules
+	if err := d.Set("website_configuration", []map[string]interface{}{website}); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting website_configuration: %s", err))
+	}
+	d.Set("website_endpoint", getCosWebsiteEndpoint(bucketName, bucketLocation))
+	return nil
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("website_configuration") {
+		if err := resourceIBMCOSBucketWebsiteConfigurationPut(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMCOSBucketWebsiteConfigurationRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := cosBucketS3Client(m, d.Get("bucket_crn").(string), d.Get("bucket_location").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = s3Client.DeleteBucketWebsiteWithContext(ctx, &s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucketName)})
+	if err != nil && !isCOSErrorCode(err, s3.ErrCodeNoSuchBucket, cosErrCodeNoSuchWebsiteConfiguration) {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the website configuration of COS bucket (%s): %s", bucketName, err))
+	}
+	return nil
+}
+
+// resourceIBMCOSBucketWebsiteConfigurationPut sets the website configuration
+// of the bucket.
+func resourceIBMCOSBucketWebsiteConfigurationPut(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	s3Client, bucketName, err := cosBucketS3Client(m, d.Get("bucket_crn").(string), d.Get("bucket_location").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	website := &s3.WebsiteConfiguration{}
+	if v, ok := d.GetOk("website_configuration.0.index_document.0.suffix"); ok {
+		website.IndexDocument = &s3.IndexDocument{Suffix: aws.String(v.(string))}
+	}
+	if v, ok := d.GetOk("website_configuration.0.error_document.0.key"); ok {
+		website.ErrorDocument = &s3.ErrorDocument{Key: aws.String(v.(string))}
+	}
+	if v, ok := d.GetOk("website_configuration.0.redirect_all_requests_to.0"); ok {
+		r := v.(map[string]interface{})
+		website.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{
+			HostName: aws.String(r["host_name"].(string)),
+			Protocol: cosOptionalString(r["protocol"]),
+		}
+	}
+	for _, v := range d.Get("website_configuration.0.routing_rule").([]interface{}) {
+		r := v.(map[string]interface{})
+		rule := &s3.RoutingRule{}
+		if conditions := r["condition"].([]interface{}); len(conditions) > 0 && conditions[0] != nil {
+			condition := conditions[0].(map[string]interface{})
+			rule.Condition = &s3.Condition{
+				HttpErrorCodeReturnedEquals: cosOptionalString(condition["http_error_code_returned_equals"]),
+				KeyPrefixEquals:             cosOptionalString(condition["key_prefix_equals"]),
+			}
+		}
+		redirect := map[string]interface{}{}
+		if redirects := r["redirect"].([]interface{}); len(redirects) > 0 && redirects[0] != nil {
+			redirect = redirects[0].(map[string]interface{})
+		}
+		if cosOptionalString(redirect["replace_key_prefix_with"]) != nil && cosOptionalString(redirect["replace_key_with"]) != nil {
+			return fmt.Errorf("[ERROR] Error setting the website configuration of COS bucket (%s): replace_key_prefix_with conflicts with replace_key_with", bucketName)
+		}
+		rule.Redirect = &s3.Redirect{
+			HostName:             cosOptionalString(redirect["host_name"]),
+			HttpRedirectCode:     cosOptionalString(redirect["http_redirect_code"]),
+			Protocol:             cosOptionalString(redirect["protocol"]),
+			ReplaceKeyPrefixWith: cosOptionalString(redirect["replace_key_prefix_with"]),
+			ReplaceKeyWith:       cosOptionalString(redirect["replace_key_with"]),
+		}
+		website.RoutingRules = append(website.RoutingRules, rule)
+	}
+
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucketName),
+		WebsiteConfiguration: website,
+	}
+	if _, err := s3Client.PutBucketWebsiteWithContext(ctx, input); err != nil {
+		return fmt.Errorf("[ERROR] Error setting the website configuration of COS bucket (%s): %s", bucketName, err)
+	}
+	return nil
+}
+
+// getCosWebsiteEndpoint returns the endpoint of the website of a bucket.
+func getCosWebsiteEndpoint(bucketName, bucketLocation string) string {
+	return fmt.Sprintf("%s.s3-web.%s.cloud-object-storage.appdomain.cloud", bucketName, bucketLocation)
+}
+
+// cosOptionalString returns the string of v, nil if empty.
+func cosOptionalString(v interface{}) *string {
+	if s, ok := v.(string); ok && s != "" {
+		return aws.String(s)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketWebsiteConfiguration_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketWebsiteConfigurationConfig(name, instanceCRN, "404.html"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_website_configuration.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.testacc", "website_configuration.0.index_document.0.suffix", "index.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.testacc", "website_configuration.0.error_document.0.key", "404.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.testacc", "website_configuration.0.routing_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.testacc", "website_configuration.0.routing_rule.0.redirect.0.replace_key_prefix_with", "documents/"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.testacc", "website_endpoint", fmt.Sprintf("%s.s3-web.us-east.cloud-object-storage.appdomain.cloud", name)),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketWebsiteConfigurationConfig(name, instanceCRN, "error.html"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.testacc", "website_configuration.0.error_document.0.key", "error.html"),
+				),
+			},
+			{
+				ResourceName:            "ibm_cos_bucket_website_configuration.testacc",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketWebsiteConfigurationConfig(name string, instanceCRN string, errorDocument string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_website_configuration" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			website_configuration {
+				index_document {
+					suffix = "index.html"
+				}
+				error_document {
+					key = "%[3]s"
+				}
+				routing_rule {
+					condition {
+						key_prefix_equals = "docs/"
+					}
+					redirect {
+						replace_key_prefix_with = "documents/"
+					}
+				}
+			}
+		}`, name, instanceCRN, errorDocument)
+}
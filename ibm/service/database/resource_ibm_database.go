@@ -19,7 +19,6 @@ import (
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -30,6 +29,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/go-sdk-core/v5/core"
 )
@@ -54,45 +54,6 @@ type userChange struct {
 	Old, New map[string]interface{}
 }
 
-func retry(f func() error) (err error) {
-	attempts := 3
-
-	for i := 0; ; i++ {
-		sleep := time.Duration(10*i*i) * time.Second
-		time.Sleep(sleep)
-
-		err = f()
-		if err == nil {
-			return nil
-		}
-
-		if i == attempts {
-			return err
-		}
-
-		log.Println("retrying after error:", err)
-	}
-}
-func retryTask(f func() (icdv4.Task, error)) (task icdv4.Task, err error) {
-	attempts := 3
-
-	for i := 0; ; i++ {
-		sleep := time.Duration(5*i) * time.Second
-		time.Sleep(sleep)
-
-		task, err = f()
-		if err == nil {
-			return task, nil
-		}
-
-		if i == attempts {
-			return task, nil
-		}
-
-		log.Println("retrying after error:", err)
-	}
-}
-
 func ResourceIBMDatabaseInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMDatabaseInstanceCreate,
@@ -1091,7 +1052,7 @@ func checkMbValue(name string, limits MbLimit, divider int, diff *schema.Resourc
 }
 
 func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
-	err = flex.ResourceTagsCustomizeDiff(diff)
+	err = flex.ResourceTagsCustomizeDiff(diff, meta)
 	if err != nil {
 		return err
 	}
@@ -1312,7 +1273,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	}
 	d.SetId(*instance.ID)
 
-	_, err = waitForDatabaseInstanceCreate(d, meta, *instance.ID)
+	_, err = waitForDatabaseInstanceCreate(context, d, meta, *instance.ID)
 	if err != nil {
 		return diag.FromErr(
 			fmt.Errorf(
@@ -1331,7 +1292,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 				return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
 			}
 
-			err = horizontalScale(d, meta, icdClient)
+			err = horizontalScale(context, d, meta, icdClient)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -1388,7 +1349,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 
 				taskIDLink := *setDeploymentScalingGroupResponse.Task.ID
 
-				_, err = waitForDatabaseTaskComplete(taskIDLink, d, meta, d.Timeout(schema.TimeoutCreate))
+				_, err = waitForDatabaseTaskComplete(context, taskIDLink, d, meta, d.Timeout(schema.TimeoutCreate))
 
 				if err != nil {
 					return diag.FromErr(err)
@@ -1450,7 +1411,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 
 		taskID := *changeUserPasswordResponse.Task.ID
-		_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForDatabaseTaskComplete(context, taskID, d, meta, d.Timeout(schema.TimeoutCreate))
 
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database admin password: %s", err))
@@ -1470,7 +1431,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error updating database whitelist entry: %s", err))
 			}
-			_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+			_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return diag.FromErr(fmt.Errorf(
 					"[ERROR] Error waiting for update of database (%s) whitelist task to complete: %s", icdId, err))
@@ -1488,7 +1449,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database cpu auto_scaling group: %s", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for database (%s) cpu auto_scaling group update task to complete: %s", icdId, err))
@@ -1506,7 +1467,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database disk auto_scaling group: %s", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for database (%s) disk auto_scaling group update task to complete: %s", icdId, err))
@@ -1524,7 +1485,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database memory auto_scaling group: %s", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for database (%s) memory auto_scaling group update task to complete: %s", icdId, err))
@@ -1564,7 +1525,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 
 			taskID := *createDatabaseUserResponse.Task.ID
 
-			_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutCreate))
+			_, err = waitForDatabaseTaskComplete(context, taskID, d, meta, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return diag.FromErr(fmt.Errorf(
 					"[ERROR] Error waiting for update of database (%s) user (%s) create task to complete: %s", d.Id(), userEl["name"], err))
@@ -1783,7 +1744,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response))
 		}
 
-		_, err = waitForDatabaseInstanceUpdate(d, meta)
+		_, err = waitForDatabaseInstanceUpdate(context, d, meta)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for update of resource instance (%s) to complete: %s", d.Id(), err))
@@ -1811,7 +1772,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	icdId := flex.EscapeUrlParm(instanceID)
 
 	if d.HasChange("node_count") {
-		err = horizontalScale(d, meta, icdClient)
+		err = horizontalScale(context, d, meta, icdClient)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1827,7 +1788,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				if err != nil {
 					return diag.FromErr(fmt.Errorf("[ERROR] Error updating database (%s) configuration: %s", icdId, err))
 				}
-				_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(fmt.Errorf(
 						"[ERROR] Error waiting for database (%s) configuration update task to complete: %s", icdId, err))
@@ -1881,7 +1842,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database scaling group: %s", err))
 		}
 
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for database (%s) scaling group update task to complete: %s", icdId, err))
@@ -1955,7 +1916,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 
 				taskIDLink := *setDeploymentScalingGroupResponse.Task.ID
 
-				_, err = waitForDatabaseTaskComplete(taskIDLink, d, meta, d.Timeout(schema.TimeoutCreate))
+				_, err = waitForDatabaseTaskComplete(context, taskIDLink, d, meta, d.Timeout(schema.TimeoutCreate))
 
 				if err != nil {
 					return diag.FromErr(err)
@@ -1976,7 +1937,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database cpu auto_scaling group: %s", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for database (%s) cpu auto_scaling group update task to complete: %s", icdId, err))
@@ -1995,7 +1956,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database disk auto_scaling  group: %s", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for database (%s) disk auto_scaling group update task to complete: %s", icdId, err))
@@ -2014,7 +1975,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database memory auto_scaling  group: %s", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for database (%s) memory auto_scaling group update task to complete: %s", icdId, err))
@@ -2041,7 +2002,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 
 		taskID := *changeUserPasswordResponse.Task.ID
-		_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitForDatabaseTaskComplete(context, taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database admin password: %s", err))
@@ -2075,7 +2036,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				if err != nil {
 					return diag.FromErr(fmt.Errorf("[ERROR] Error updating database whitelist entry %v : %s", wlEntry.Address, err))
 				}
-				_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(fmt.Errorf(
 						"[ERROR] Error waiting for database (%s) whitelist create task to complete for entry %s : %s", icdId, wlEntry.Address, err))
@@ -2097,7 +2058,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				if err != nil {
 					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting database whitelist entry: %s", err))
 				}
-				_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(fmt.Errorf(
 						"[ERROR] Error waiting for database (%s) whitelist delete task to complete for ipAddress %s : %s", icdId, ipAddress, err))
@@ -2166,7 +2127,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 					}
 
 					taskID := *changeUserPasswordResponse.Task.ID
-					_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+					_, err = waitForDatabaseTaskComplete(context, taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
 
 					if err != nil {
 						return diag.FromErr(fmt.Errorf(
@@ -2197,7 +2158,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				}
 
 				taskID := *deleteDatabaseUserResponse.Task.ID
-				_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForDatabaseTaskComplete(context, taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
 
 				if err != nil {
 					return diag.FromErr(fmt.Errorf(
@@ -2229,7 +2190,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				}
 
 				taskID := *createDatabaseUserResponse.Task.ID
-				_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForDatabaseTaskComplete(context, taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(fmt.Errorf(
 						"[ERROR] Error waiting for database (%s) user (%s) create task to complete: %s", instanceID, *userEntry.Username, err))
@@ -2241,7 +2202,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	return resourceIBMDatabaseInstanceRead(context, d, meta)
 }
 
-func horizontalScale(d *schema.ResourceData, meta interface{}, icdClient icdv4.ICDServiceAPI) error {
+func horizontalScale(ctx context.Context, d *schema.ResourceData, meta interface{}, icdClient icdv4.ICDServiceAPI) error {
 	params := icdv4.GroupReq{}
 
 	icdId := flex.EscapeUrlParm(d.Id())
@@ -2257,7 +2218,7 @@ func horizontalScale(d *schema.ResourceData, meta interface{}, icdClient icdv4.I
 	}
 
 	// ScaleOut is handled with an ICD API call, however, the check is is on the instance status
-	_, err = waitForDatabaseInstanceUpdate(d, meta)
+	_, err = waitForDatabaseInstanceUpdate(ctx, d, meta)
 	if err != nil {
 		return fmt.Errorf(
 			"[ERROR] Error waiting for database (%s) horizontal scale to complete: %s", d.Id(), err)
@@ -2369,7 +2330,7 @@ func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.Resour
 		}
 	}
 
-	_, err = waitForDatabaseInstanceDelete(d, meta)
+	_, err = waitForDatabaseInstanceDelete(context, d, meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
@@ -2413,30 +2374,25 @@ func waitForICDReady(meta interface{}, instanceID string) error {
 		return fmt.Errorf("[ERROR] Error getting database client settings: %s", clientErr)
 	}
 
-	// Wait for ICD Interface
-	err := retry(func() (err error) {
-		_, cdbErr := icdClient.Cdbs().GetCdb(icdId)
-		if cdbErr != nil {
-			if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-				return fmt.Errorf("[ERROR] The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", err)
-			}
-			return fmt.Errorf("[ERROR] Error getting database config for: %s with error %s\n", icdId, err)
+	// Wait for ICD Interface, the failed requests are retried by the retry
+	// transport of the client.
+	_, cdbErr := icdClient.Cdbs().GetCdb(icdId)
+	if cdbErr != nil {
+		if apiErr, ok := cdbErr.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			return fmt.Errorf("[ERROR] The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", cdbErr)
 		}
-		return nil
-	})
-	if err != nil {
-		return err
+		return fmt.Errorf("[ERROR] Error getting database config for: %s with error %s\n", icdId, cdbErr)
 	}
 	return nil
 }
 
-func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+func waitForDatabaseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
 	}
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{databaseInstanceProgressStatus, databaseInstanceInactiveStatus, databaseInstanceProvisioningStatus},
 		Target:  []string{databaseInstanceSuccessStatus},
 		Refresh: func() (interface{}, string, error) {
@@ -2455,9 +2411,9 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 			}
 			return *instance, *instance.State, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
 	waitErr := waitForICDReady(meta, instanceID)
@@ -2466,17 +2422,17 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
 	}
 	instanceID := d.Id()
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{databaseInstanceProgressStatus, databaseInstanceInactiveStatus},
 		Target:  []string{databaseInstanceSuccessStatus},
 		Refresh: func() (interface{}, string, error) {
@@ -2495,9 +2451,9 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 			}
 			return *instance, *instance.State, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutUpdate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
 	waitErr := waitForICDReady(meta, instanceID)
@@ -2506,48 +2462,50 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta interface{}, t time.Duration) (bool, error) {
+func waitForDatabaseTaskComplete(ctx context.Context, taskId string, d *schema.ResourceData, meta interface{}, t time.Duration) (bool, error) {
 	icdClient, err := meta.(conns.ClientSession).ICDAPI()
 	if err != nil {
 		return false, fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
 	}
-	delayDuration := 5 * time.Second
 
-	timeout := time.After(t)
-	delay := time.Tick(delayDuration)
-	innerTask := icdv4.Task{}
-
-	for {
-		select {
-		case <-timeout:
-			return false, fmt.Errorf("[Error] Time out waiting for database task to complete")
-		case <-delay:
-			innerTask, err = icdClient.Tasks().GetTask(flex.EscapeUrlParm(taskId))
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("database task %s to complete", taskId),
+		// Completed status could be returned as "" due to interaction between bluemix-go and icd task response
+		// Otherwise Running an queued
+		Target: []string{"completed", ""},
+		Failed: []string{"failed"},
+		Refresh: func() (interface{}, string, error) {
+			innerTask, err := icdClient.Tasks().GetTask(flex.EscapeUrlParm(taskId))
 			if err != nil {
-				return false, fmt.Errorf("[ERROR] The ICD Get task on database update errored: %v", err)
+				return nil, "", fmt.Errorf("[ERROR] The ICD Get task on database update errored: %v", err)
 			}
-			if innerTask.Status == "failed" {
-				return false, fmt.Errorf("[Error] Database task failed")
-			}
-			// Completed status could be returned as "" due to interaction between bluemix-go and icd task response
-			// Otherwise Running an queued
-			if innerTask.Status == "completed" || innerTask.Status == "" {
-				return true, nil
-			}
-		}
+			return innerTask, innerTask.Status, nil
+		},
+		Progress: func(result interface{}) string {
+			return fmt.Sprintf("%d%%", result.(icdv4.Task).ProgressPercent)
+		},
+		Timeout:     t,
+		Delay:       5 * time.Second,
+		Interval:    5 * time.Second,
+		MaxInterval: 5 * time.Second,
+	}
+
+	if _, err := stateConf.Wait(ctx); err != nil {
+		return false, err
 	}
+	return true, nil
 }
 
-func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
 	}
 	instanceID := d.Id()
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{databaseInstanceProgressStatus, databaseInstanceInactiveStatus, databaseInstanceSuccessStatus},
 		Target:  []string{databaseInstanceRemovedStatus, databaseInstanceReclamation},
 		Refresh: func() (interface{}, string, error) {
@@ -2566,12 +2524,12 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 			}
 			return *instance, *instance.State, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutDelete),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
 func filterDatabaseDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
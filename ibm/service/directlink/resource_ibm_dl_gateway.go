@@ -16,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -74,12 +75,12 @@ const (
 
 func ResourceIBMDLGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlGatewayCreate,
-		Read:     resourceIBMdlGatewayRead,
-		Delete:   resourceIBMdlGatewayDelete,
-		Exists:   resourceIBMdlGatewayExists,
-		Update:   resourceIBMdlGatewayUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlGatewayCreate,
+		ReadContext:   resourceIBMdlGatewayRead,
+		DeleteContext: resourceIBMdlGatewayDelete,
+		Exists:        resourceIBMdlGatewayExists,
+		UpdateContext: resourceIBMdlGatewayUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -89,7 +90,7 @@ func ResourceIBMDLGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -480,10 +481,10 @@ func directlinkClient(meta interface{}) (*directlinkv1.DirectLinkV1, error) {
 	return sess, err
 }
 
-func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dtype := d.Get(dlType).(string)
 	createGatewayOptionsModel := &directlinkv1.CreateGatewayOptions{}
@@ -519,7 +520,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("[ERROR] Error creating gateway, %s is a required field", dlCarrierName)
 			log.Printf("%s is a required field", dlCarrierName)
-			return err
+			return diag.FromErr(err)
 		}
 		if _, ok := d.GetOk(dlCrossConnectRouter); ok {
 			crossConnectRouter = d.Get(dlCrossConnectRouter).(string)
@@ -527,7 +528,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("[ERROR] Error creating gateway, %s is a required field", dlCrossConnectRouter)
 			log.Printf("%s is a required field", dlCrossConnectRouter)
-			return err
+			return diag.FromErr(err)
 		}
 		if _, ok := d.GetOk(dlLocationName); ok {
 			locationName = d.Get(dlLocationName).(string)
@@ -535,7 +536,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("[ERROR] Error creating gateway, %s is a required field", dlLocationName)
 			log.Printf("%s is a required field", dlLocationName)
-			return err
+			return diag.FromErr(err)
 		}
 		if _, ok := d.GetOk(dlCustomerName); ok {
 			customerName = d.Get(dlCustomerName).(string)
@@ -543,7 +544,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("[ERROR] Error creating gateway, %s is a required field", dlCustomerName)
 			log.Printf("%s is a required field", dlCustomerName)
-			return err
+			return diag.FromErr(err)
 		}
 		gatewayDedicatedTemplateModel, _ := directLink.NewGatewayTemplateGatewayTypeDedicatedTemplate(bgpAsn, global, metered, name, speed, dtype, carrierName, crossConnectRouter, customerName, locationName)
 
@@ -655,27 +656,27 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 
 		} else {
 			err = fmt.Errorf("[ERROR] Error creating direct link connect gateway, %s is a required field", dlPort)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	gateway, response, err := directLink.CreateGateway(createGatewayOptionsModel)
+	gateway, response, err := directLink.CreateGatewayWithContext(ctx, createGatewayOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create Direct Link Gateway (%s) err %s\n%s", dtype, err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] Create Direct Link Gateway (%s) err %s\n%s", dtype, err, response))
 	}
 	d.SetId(*gateway.ID)
 
 	log.Printf("[INFO] Created Direct Link Gateway (%s Template) : %s", dtype, *gateway.ID)
 	if dtype == "connect" {
 		getPortOptions := directLink.NewGetPortOptions(*gateway.Port.ID)
-		port, response, err := directLink.GetPort(getPortOptions)
+		port, response, err := directLink.GetPortWithContext(ctx, getPortOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting port %s %s", response, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting port %s %s", response, err))
 		}
 		if port != nil && port.ProviderName != nil && !strings.Contains(strings.ToLower(*port.ProviderName), "netbond") && !strings.Contains(strings.ToLower(*port.ProviderName), "megaport") {
-			_, err = isWaitForDirectLinkAvailable(directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForDirectLinkAvailable(ctx, directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -691,16 +692,16 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceIBMdlGatewayRead(d, meta)
+	return resourceIBMdlGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dtype := d.Get(dlType).(string)
 	log.Printf("[INFO] Inside resourceIBMdlGatewayRead: %s", dtype)
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -710,13 +711,13 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[INFO] Calling getgateway api: %s", dtype)
 
-	instance, response, err := directLink.GetGateway(getOptions)
+	instance, response, err := directLink.GetGatewayWithContext(ctx, getOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response))
 	}
 	if instance.Name != nil {
 		d.Set(dlName, *instance.Name)
@@ -853,7 +854,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(dlTags, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/interconnectivity/direct-link")
 	d.Set(flex.ResourceName, *instance.Name)
@@ -886,24 +887,24 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
-func isWaitForDirectLinkAvailable(client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForDirectLinkAvailable(ctx context.Context, client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for direct link (%s) to be provisioned.", id)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", dlGatewayProvisioning},
 		Target:     []string{dlGatewayProvisioningDone, ""},
-		Refresh:    isDirectLinkRefreshFunc(client, id),
+		Refresh:    isDirectLinkRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
-func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
+func isDirectLinkRefreshFunc(ctx context.Context, client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getOptions := &directlinkv1.GetGatewayOptions{
 			ID: &id,
 		}
-		instance, response, err := client.GetGateway(getOptions)
+		instance, response, err := client.GetGatewayWithContext(ctx, getOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
 		}
@@ -914,22 +915,22 @@ func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resou
 	}
 }
 
-func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
 	getOptions := &directlinkv1.GetGatewayOptions{
 		ID: &ID,
 	}
-	instance, detail, err := directLink.GetGateway(getOptions)
+	instance, detail, err := directLink.GetGatewayWithContext(ctx, getOptions)
 
 	if err != nil {
 		log.Printf("Error fetching Direct Link Gateway :%s", detail)
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateGatewayOptionsModel := &directlinkv1.UpdateGatewayOptions{}
@@ -1056,31 +1057,31 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 			updateGatewayOptionsModel.MacsecConfig = nil
 		}
 	}
-	_, response, err := directLink.UpdateGateway(updateGatewayOptionsModel)
+	_, response, err := directLink.UpdateGatewayWithContext(ctx, updateGatewayOptionsModel)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Gateway err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMdlGatewayRead(d, meta)
+	return resourceIBMdlGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMdlGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
 	delOptions := &directlinkv1.DeleteGatewayOptions{
 		ID: &ID,
 	}
-	response, err := directLink.DeleteGateway(delOptions)
+	response, err := directLink.DeleteGatewayWithContext(ctx, delOptions)
 
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting Direct Link Gateway : %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
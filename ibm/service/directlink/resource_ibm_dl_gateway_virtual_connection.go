@@ -4,6 +4,7 @@
 package directlink
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,12 +31,12 @@ const (
 
 func ResourceIBMDLGatewayVC() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlGatewayVCCreate,
-		Read:     resourceIBMdlGatewayVCRead,
-		Delete:   resourceIBMdlGatewayVCDelete,
-		Exists:   resourceIBMdlGatewayVCExists,
-		Update:   resourceIBMdlGatewayVCUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlGatewayVCCreate,
+		ReadContext:   resourceIBMdlGatewayVCRead,
+		DeleteContext: resourceIBMdlGatewayVCDelete,
+		Exists:        resourceIBMdlGatewayVCExists,
+		UpdateContext: resourceIBMdlGatewayVCUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -125,10 +127,10 @@ func ResourceIBMDLGatewayVCValidator() *validate.ResourceValidator {
 
 	return &ibmDLGatewayVCResourceValidator
 }
-func resourceIBMdlGatewayVCCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayVCCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createGatewayVCOptions := &directlinkv1.CreateGatewayVirtualConnectionOptions{}
@@ -145,26 +147,26 @@ func resourceIBMdlGatewayVCCreate(d *schema.ResourceData, meta interface{}) erro
 		createGatewayVCOptions.SetNetworkID(vcNetworkId)
 	}
 
-	gatewayVC, response, err := directLink.CreateGatewayVirtualConnection(createGatewayVCOptions)
+	gatewayVC, response, err := directLink.CreateGatewayVirtualConnectionWithContext(ctx, createGatewayVCOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create Direct Link Gateway (Dedicated) Virtual connection err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *gatewayVC.ID))
 	d.Set(dlVirtualConnectionId, *gatewayVC.ID)
-	return resourceIBMdlGatewayVCRead(d, meta)
+	return resourceIBMdlGatewayVCRead(ctx, d, meta)
 }
 
-func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayVCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -173,13 +175,13 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 	getGatewayVirtualConnectionOptions := &directlinkv1.GetGatewayVirtualConnectionOptions{}
 	getGatewayVirtualConnectionOptions.SetGatewayID(gatewayId)
 	getGatewayVirtualConnectionOptions.SetID(ID)
-	instance, response, err := directLink.GetGatewayVirtualConnection(getGatewayVirtualConnectionOptions)
+	instance, response, err := directLink.GetGatewayVirtualConnectionWithContext(ctx, getGatewayVirtualConnectionOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Directlink Gateway Connection (%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Directlink Gateway Connection (%s): %s\n%s", ID, err, response))
 	}
 
 	if instance.Name != nil {
@@ -205,23 +207,23 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 	getGatewayOptions := &directlinkv1.GetGatewayOptions{
 		ID: &gatewayId,
 	}
-	dlgw, response, err := directLink.GetGateway(getGatewayOptions)
+	dlgw, response, err := directLink.GetGatewayWithContext(ctx, getGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (Dedicated Template): %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (Dedicated Template): %s\n%s", err, response))
 	}
 	d.Set(flex.RelatedCRN, *dlgw.Crn)
 	return nil
 }
 
-func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayVCUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -231,11 +233,11 @@ func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) erro
 		ID: &ID,
 	}
 	getVCOptions.SetGatewayID(gatewayId)
-	_, detail, err := directLink.GetGatewayVirtualConnection(getVCOptions)
+	_, detail, err := directLink.GetGatewayVirtualConnectionWithContext(ctx, getVCOptions)
 
 	if err != nil {
 		log.Printf("Error fetching Direct Link Gateway (Dedicated Template) Virtual Connection:%s", detail)
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateGatewayVCOptions := &directlinkv1.UpdateGatewayVirtualConnectionOptions{}
@@ -248,24 +250,24 @@ func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	_, response, err := directLink.UpdateGatewayVirtualConnection(updateGatewayVCOptions)
+	_, response, err := directLink.UpdateGatewayVirtualConnectionWithContext(ctx, updateGatewayVCOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Gateway (Dedicated) Virtual Connection err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMdlGatewayVCRead(d, meta)
+	return resourceIBMdlGatewayVCRead(ctx, d, meta)
 }
 
-func resourceIBMdlGatewayVCDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayVCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -274,11 +276,11 @@ func resourceIBMdlGatewayVCDelete(d *schema.ResourceData, meta interface{}) erro
 		ID: &ID,
 	}
 	delVCOptions.SetGatewayID(gatewayId)
-	response, err := directLink.DeleteGatewayVirtualConnection(delVCOptions)
+	response, err := directLink.DeleteGatewayVirtualConnectionWithContext(ctx, delVCOptions)
 
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting Direct Link Gateway (Dedicated Template) Virtual Connection: %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
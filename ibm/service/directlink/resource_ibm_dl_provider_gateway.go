@@ -11,6 +11,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -21,15 +22,15 @@ const (
 
 func ResourceIBMDLProviderGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlProviderGatewayCreate,
-		Read:     resourceIBMdlProviderGatewayRead,
-		Delete:   resourceIBMdlProviderGatewayDelete,
-		Exists:   resourceIBMdlProviderGatewayExists,
-		Update:   resourceIBMdlProviderGatewayUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlProviderGatewayCreate,
+		ReadContext:   resourceIBMdlProviderGatewayRead,
+		DeleteContext: resourceIBMdlProviderGatewayDelete,
+		Exists:        resourceIBMdlProviderGatewayExists,
+		UpdateContext: resourceIBMdlProviderGatewayUpdate,
+		Importer:      &schema.ResourceImporter{},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -196,10 +197,10 @@ func ResourceIBMDLProviderGatewayValidator() *validate.ResourceValidator {
 	return &ibmISDLGatewayResourceValidator
 }
 
-func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get(dlName).(string)
 	speed := int64(d.Get(dlSpeedMbps).(int))
@@ -226,10 +227,10 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 
 	}
 
-	gateway, response, err := directLink.CreateProviderGateway(gatewayOptions)
+	gateway, response, err := directLink.CreateProviderGatewayWithContext(ctx, gatewayOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create Direct Link Provider Gateway err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(*gateway.ID)
 
@@ -245,16 +246,16 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	return resourceIBMdlProviderGatewayRead(d, meta)
+	return resourceIBMdlProviderGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dtype := d.Get(dlType).(string)
 	log.Printf("[INFO] Inside resourceIBMdlGatewayRead: %s", dtype)
 
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -263,13 +264,13 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[INFO] Calling getgateway api: %s", dtype)
 
-	instance, response, err := directLink.GetProviderGateway(getOptions)
+	instance, response, err := directLink.GetProviderGatewayWithContext(ctx, getOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response))
 	}
 	if instance.Name != nil {
 		d.Set(dlName, *instance.Name)
@@ -326,7 +327,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 	d.Set(dlTags, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/interconnectivity/direct-link")
 	d.Set(flex.ResourceName, *instance.Name)
@@ -335,11 +336,11 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -347,9 +348,9 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 
 	log.Printf("[INFO] Calling getgateway provider api")
 
-	instance, response, err := directLink.GetProviderGateway(getOptions)
+	instance, response, err := directLink.GetProviderGatewayWithContext(ctx, getOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting provider gateway %s, %s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting provider gateway %s, %s", err, response))
 	}
 
 	updateGatewayOptionsModel := directLink.NewUpdateProviderGatewayOptions(ID)
@@ -387,25 +388,25 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 		vlan := int64(d.Get(dlVlan).(int))
 		updateGatewayOptionsModel.Vlan = &vlan
 	}
-	_, response, err = directLink.UpdateProviderGateway(updateGatewayOptionsModel)
+	_, response, err = directLink.UpdateProviderGatewayWithContext(ctx, updateGatewayOptionsModel)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Provider Gateway  err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMdlProviderGatewayRead(d, meta)
+	return resourceIBMdlProviderGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMdlProviderGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
 	delOptions := directLink.NewDeleteProviderGatewayOptions(ID)
-	_, response, err := directLink.DeleteProviderGateway(delOptions)
+	_, response, err := directLink.DeleteProviderGatewayWithContext(ctx, delOptions)
 	if err != nil {
 		if response != nil && response.StatusCode != 404 {
 			return nil
@@ -92,8 +92,10 @@ func resourceIBMPrivateDNSLocationCreate(context context.Context, d *schema.Reso
 	resolverID := d.Get(pdnsResolverID).(string)
 
 	mk := "private_dns_resource_custom_resolver_location_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(context, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(mk)
 
 	opt := sess.NewAddCustomResolverLocationOptions(instanceID, resolverID)
 
@@ -173,8 +175,10 @@ func resourceIBMPrivateDNSLocationUpdate(context context.Context, d *schema.Reso
 	locationID, resolverID, instanceID, err := flex.ConvertTfToCisThreeVar(d.Id())
 
 	mk := "private_dns_resource_custom_resolver_location_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(context, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(mk)
 
 	updatelocation := sess.NewUpdateCustomResolverLocationOptions(instanceID, resolverID, locationID)
 
@@ -4,6 +4,7 @@
 package dnsservices
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -36,12 +38,12 @@ const (
 
 func ResourceIBMPrivateDNSGLB() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSGLBCreate,
-		Read:     resourceIBMPrivateDNSGLBRead,
-		Update:   resourceIBMPrivateDNSGLBUpdate,
-		Delete:   resourceIBMPrivateDNSGLBDelete,
-		Exists:   resourceIBMPrivateDNSGLBExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSGLBCreate,
+		ReadContext:   resourceIBMPrivateDNSGLBRead,
+		UpdateContext: resourceIBMPrivateDNSGLBUpdate,
+		DeleteContext: resourceIBMPrivateDNSGLBDelete,
+		Exists:        resourceIBMPrivateDNSGLBExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -145,10 +147,10 @@ func ResourceIBMPrivateDNSGLB() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSGLBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	zoneID := d.Get(pdnsZoneID).(string)
@@ -172,32 +174,32 @@ func resourceIBMPrivateDNSGLBCreate(d *schema.ResourceData, meta interface{}) er
 	if AZpools, ok := d.GetOk(pdnsGLBAZPools); ok {
 		expandedAzpools, err := expandPDNSGlbAZPools(AZpools)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		createlbOptions.SetAzPools(expandedAzpools)
 	}
 
-	result, resp, err := sess.CreateLoadBalancer(createlbOptions)
+	result, resp, err := sess.CreateLoadBalancerWithContext(ctx, createlbOptions)
 	if err != nil {
 		log.Printf("create global load balancer failed %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *result.ID))
-	return resourceIBMPrivateDNSGLBRead(d, meta)
+	return resourceIBMPrivateDNSGLBRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	idset := strings.Split(d.Id(), "/")
 
 	getlbOptions := sess.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
-	presponse, resp, err := sess.GetLoadBalancer(getlbOptions)
+	presponse, resp, err := sess.GetLoadBalancerWithContext(ctx, getlbOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching pdns GLB :%s\n%s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching pdns GLB :%s\n%s", err, resp))
 	}
 
 	response := *presponse
@@ -217,10 +219,10 @@ func resourceIBMPrivateDNSGLBRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMPrivateDNSGLBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
@@ -252,35 +254,35 @@ func resourceIBMPrivateDNSGLBUpdate(d *schema.ResourceData, meta interface{}) er
 		if AZpools, ok := d.GetOk(pdnsGLBAZPools); ok {
 			expandedAzpools, err := expandPDNSGlbAZPools(AZpools)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			updatelbOptions.SetAzPools(expandedAzpools)
 		}
 
-		_, detail, err := sess.UpdateLoadBalancer(updatelbOptions)
+		_, detail, err := sess.UpdateLoadBalancerWithContext(ctx, updatelbOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns GLB :%s\n%s", err, detail)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating pdns GLB :%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSGLBRead(d, meta)
+	return resourceIBMPrivateDNSGLBRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
 	deletelbOptions := sess.NewDeleteLoadBalancerOptions(idset[0], idset[1], idset[2])
-	response, err := sess.DeleteLoadBalancer(deletelbOptions)
+	response, err := sess.DeleteLoadBalancerWithContext(ctx, deletelbOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns GLB :%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting pdns GLB :%s\n%s", err, response))
 	}
-	_, err = isWaitForLoadBalancerDeleted(sess, d, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLoadBalancerDeleted(ctx, sess, d, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -344,26 +346,26 @@ func suppressPDNSGlbNameDiff(k, old, new string, d *schema.ResourceData) bool {
 	return false
 }
 
-func isWaitForLoadBalancerDeleted(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func isWaitForLoadBalancerDeleted(ctx context.Context, LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	idset := strings.Split(d.Id(), "/")
 	log.Printf("Waiting for PDNS GLB (%s) to be deleted.", idset[2])
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{pdnsGLBDeleting},
 		Target:     []string{pdnsGLBDeleted},
-		Refresh:    isVLoadBalancerDeleteRefreshFunc(LoadBalancer, d),
+		Refresh:    isVLoadBalancerDeleteRefreshFunc(ctx, LoadBalancer, d),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isVLoadBalancerDeleteRefreshFunc(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.ResourceData) resource.StateRefreshFunc {
+func isVLoadBalancerDeleteRefreshFunc(ctx context.Context, LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.ResourceData) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		idset := strings.Split(d.Id(), "/")
 		getlbOptions := LoadBalancer.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
-		_, response, err := LoadBalancer.GetLoadBalancer(getlbOptions)
+		_, response, err := LoadBalancer.GetLoadBalancerWithContext(ctx, getlbOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return "", pdnsGLBDeleted, nil
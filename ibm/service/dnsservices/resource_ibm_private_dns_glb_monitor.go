@@ -4,6 +4,7 @@
 package dnsservices
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -39,12 +41,12 @@ const (
 
 func ResourceIBMPrivateDNSGLBMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSGLBMonitorCreate,
-		Read:     resourceIBMPrivateDNSGLBMonitorRead,
-		Update:   resourceIBMPrivateDNSGLBMonitorUpdate,
-		Delete:   resourceIBMPrivateDNSGLBMonitorDelete,
-		Exists:   resourceIBMPrivateDNSGLBMonitorExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSGLBMonitorCreate,
+		ReadContext:   resourceIBMPrivateDNSGLBMonitorRead,
+		UpdateContext: resourceIBMPrivateDNSGLBMonitorUpdate,
+		DeleteContext: resourceIBMPrivateDNSGLBMonitorDelete,
+		Exists:        resourceIBMPrivateDNSGLBMonitorExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -220,10 +222,10 @@ func ResourceIBMPrivateDNSGLBMonitorValidator() *validate.ResourceValidator {
 	return &dnsMonitorValidator
 }
 
-func resourceIBMPrivateDNSGLBMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	createMonitorOptions := sess.NewCreateMonitorOptions(instanceID)
@@ -260,7 +262,7 @@ func resourceIBMPrivateDNSGLBMonitorCreate(d *schema.ResourceData, meta interfac
 	if monitorheaders, ok := d.GetOk(pdnsGlbMonitorHeaders); ok {
 		expandedmonitorheaders, err := expandPDNSGLBMonitorsHeader(monitorheaders)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		createMonitorOptions.SetHeadersVar(expandedmonitorheaders)
 	}
@@ -268,13 +270,13 @@ func resourceIBMPrivateDNSGLBMonitorCreate(d *schema.ResourceData, meta interfac
 		createMonitorOptions.SetAllowInsecure((monitorallowinsecure).(bool))
 	}
 
-	response, detail, err := sess.CreateMonitor(createMonitorOptions)
+	response, detail, err := sess.CreateMonitorWithContext(ctx, createMonitorOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns GLB monitor:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns GLB monitor:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", instanceID, *response.ID))
-	return resourceIBMPrivateDNSGLBMonitorRead(d, meta)
+	return resourceIBMPrivateDNSGLBMonitorRead(ctx, d, meta)
 }
 
 func expandPDNSGLBMonitorsHeader(header interface{}) ([]dnssvcsv1.HealthcheckHeader, error) {
@@ -293,17 +295,17 @@ func expandPDNSGLBMonitorsHeader(header interface{}) ([]dnssvcsv1.HealthcheckHea
 	return expandheaders, nil
 }
 
-func resourceIBMPrivateDNSGLBMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	idset := strings.Split(d.Id(), "/")
 
 	getMonitorOptions := sess.NewGetMonitorOptions(idset[0], idset[1])
-	response, detail, err := sess.GetMonitor(getMonitorOptions)
+	response, detail, err := sess.GetMonitorWithContext(ctx, getMonitorOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching pdns GLB Monitor:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching pdns GLB Monitor:%s\n%s", err, detail))
 	}
 	d.Set(pdnsInstanceID, idset[0])
 	d.Set(pdnsGlbMonitorID, response.ID)
@@ -358,10 +360,10 @@ func flattenDataSourceLoadBalancerHeader(header []dnssvcsv1.HealthcheckHeader) i
 	return flattened
 }
 
-func resourceIBMPrivateDNSGLBMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
@@ -414,7 +416,7 @@ func resourceIBMPrivateDNSGLBMonitorUpdate(d *schema.ResourceData, meta interfac
 		if monitorheaders, ok := d.GetOk(pdnsGlbMonitorHeaders); ok {
 			expandedmonitorheaders, err := expandPDNSGLBMonitorsHeader(monitorheaders)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			updateMonitorOptions.SetHeadersVar(expandedmonitorheaders)
 		}
@@ -422,29 +424,29 @@ func resourceIBMPrivateDNSGLBMonitorUpdate(d *schema.ResourceData, meta interfac
 			updateMonitorOptions.SetAllowInsecure((monitorallowinsecure).(bool))
 		}
 
-		_, detail, err := sess.UpdateMonitor(updateMonitorOptions)
+		_, detail, err := sess.UpdateMonitorWithContext(ctx, updateMonitorOptions)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns GLB Monitor:%s\n%s", err, detail)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating pdns GLB Monitor:%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSGLBMonitorRead(d, meta)
+	return resourceIBMPrivateDNSGLBMonitorRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
 
 	DeleteMonitorOptions := sess.NewDeleteMonitorOptions(idset[0], idset[1])
-	response, err := sess.DeleteMonitor(DeleteMonitorOptions)
+	response, err := sess.DeleteMonitorWithContext(ctx, DeleteMonitorOptions)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns GLB Monitor:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting pdns GLB Monitor:%s\n%s", err, response))
 	}
 
 	d.SetId("")
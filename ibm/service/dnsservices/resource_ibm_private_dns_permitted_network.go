@@ -4,12 +4,14 @@
 package dnsservices
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,11 +31,11 @@ var allowedNetworkTypes = []string{
 
 func ResourceIBMPrivateDNSPermittedNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSPermittedNetworkCreate,
-		Read:     resourceIBMPrivateDNSPermittedNetworkRead,
-		Delete:   resourceIBMPrivateDNSPermittedNetworkDelete,
-		Exists:   resourceIBMPrivateDNSPermittedNetworkExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSPermittedNetworkCreate,
+		ReadContext:   resourceIBMPrivateDNSPermittedNetworkRead,
+		DeleteContext: resourceIBMPrivateDNSPermittedNetworkDelete,
+		Exists:        resourceIBMPrivateDNSPermittedNetworkExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -98,10 +100,10 @@ func ResourceIBMPrivateDNSPermittedNetwork() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get(pdnsInstanceID).(string)
@@ -109,39 +111,41 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 	vpcCRN := d.Get(pdnsVpcCRN).(string)
 	nwType := d.Get(pdnsNetworkType).(string)
 	mk := "private_dns_permitted_network_" + instanceID + zoneID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(mk)
 
 	createPermittedNetworkOptions := sess.NewCreatePermittedNetworkOptions(instanceID, zoneID)
 	permittedNetworkCrn, err := sess.NewPermittedNetworkVpc(vpcCRN)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createPermittedNetworkOptions.SetPermittedNetwork(permittedNetworkCrn)
 	createPermittedNetworkOptions.SetType(nwType)
-	response, detail, err := sess.CreatePermittedNetwork(createPermittedNetworkOptions)
+	response, detail, err := sess.CreatePermittedNetworkWithContext(ctx, createPermittedNetworkOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns permitted network:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns permitted network:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
 
-	return resourceIBMPrivateDNSPermittedNetworkRead(d, meta)
+	return resourceIBMPrivateDNSPermittedNetworkRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
 	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	response, detail, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
+	response, detail, err := sess.GetPermittedNetworkWithContext(ctx, getPermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error reading pdns permitted network:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading pdns permitted network:%s\n%s", err, detail))
 	}
 
 	d.Set(pdnsInstanceID, idSet[0])
@@ -156,21 +160,23 @@ func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
 	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(mk)
 	deletePermittedNetworkOptions := sess.NewDeletePermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	_, response, err := sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
+	_, response, err := sess.DeletePermittedNetworkWithContext(ctx, deletePermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns permitted network:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting pdns permitted network:%s\n%s", err, response))
 	}
 
 	d.SetId("")
@@ -189,8 +195,10 @@ func resourceIBMPrivateDNSPermittedNetworkExists(d *schema.ResourceData, meta in
 	}
 
 	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(context.Background(), mk); err != nil {
+		return false, err
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(mk)
 	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
 	_, response, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
 	if err != nil {
@@ -4,6 +4,7 @@
 package dnsservices
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,12 +52,12 @@ func caseDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
 
 func ResourceIBMPrivateDNSResourceRecord() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSResourceRecordCreate,
-		Read:     resourceIBMPrivateDNSResourceRecordRead,
-		Update:   resourceIBMPrivateDNSResourceRecordUpdate,
-		Delete:   resourceIBMPrivateDNSResourceRecordDelete,
-		Exists:   resourceIBMPrivateDNSResourceRecordExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSResourceRecordCreate,
+		ReadContext:   resourceIBMPrivateDNSResourceRecordRead,
+		UpdateContext: resourceIBMPrivateDNSResourceRecordUpdate,
+		DeleteContext: resourceIBMPrivateDNSResourceRecordDelete,
+		Exists:        resourceIBMPrivateDNSResourceRecordExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -198,10 +200,10 @@ func ResourceIBMPrivateDNSResourceRecord() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -238,31 +240,31 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	case "A":
 		resourceRecordAData, err := sess.NewResourceRecordInputRdataRdataARecord(rdata)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record A data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record A data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordAData)
 	case "AAAA":
 		resourceRecordAaaaData, err := sess.NewResourceRecordInputRdataRdataAaaaRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Aaaa data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Aaaa data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordAaaaData)
 	case "CNAME":
 		resourceRecordCnameData, err := sess.NewResourceRecordInputRdataRdataCnameRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Cname data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Cname data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordCnameData)
 	case "PTR":
 		resourceRecordPtrData, err := sess.NewResourceRecordInputRdataRdataPtrRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Ptr data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Ptr data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordPtrData)
 	case "TXT":
 		resourceRecordTxtData, err := sess.NewResourceRecordInputRdataRdataTxtRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Txt data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Txt data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordTxtData)
 	case "MX":
@@ -271,7 +273,7 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 		}
 		resourceRecordMxData, err := sess.NewResourceRecordInputRdataRdataMxRecord(rdata, int64(preference))
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Mx data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Mx data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordMxData)
 	case "SRV":
@@ -286,7 +288,7 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 		}
 		resourceRecordSrvData, err := sess.NewResourceRecordInputRdataRdataSrvRecord(int64(port), int64(priority), rdata, int64(weight))
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Srv data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Srv data:%s", err))
 		}
 		if v, ok := d.GetOk(pdnsSrvService); ok {
 			service = v.(string)
@@ -301,28 +303,30 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	mk := "private_dns_resource_record_" + instanceID + zoneID + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
-	response, detail, err := sess.CreateResourceRecord(createResourceRecordOptions)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(mk)
+	response, detail, err := sess.CreateResourceRecordWithContext(ctx, createResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns resource record:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
 
-	return resourceIBMPrivateDNSResourceRecordRead(d, meta)
+	return resourceIBMPrivateDNSResourceRecordRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSResourceRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSet := strings.Split(d.Id(), "/")
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
-	response, detail, err := sess.GetResourceRecord(getResourceRecordOptions)
+	response, detail, err := sess.GetResourceRecordWithContext(ctx, getResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error reading pdns resource record:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading pdns resource record:%s\n%s", err, detail))
 	}
 
 	// extract the record name by removing zone details
@@ -382,18 +386,20 @@ func resourceIBMPrivateDNSResourceRecordRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSet := strings.Split(d.Id(), "/")
 
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(mk)
 
 	updateResourceRecordOptions := sess.NewUpdateResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 
@@ -417,7 +423,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordAData, err := sess.NewResourceRecordUpdateInputRdataRdataARecord(rdata)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record A data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record A data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordAData)
 
@@ -426,7 +432,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordAaaaData, err := sess.NewResourceRecordUpdateInputRdataRdataAaaaRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record Aaaa data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Aaaa data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordAaaaData)
 
@@ -435,7 +441,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordCnameData, err := sess.NewResourceRecordUpdateInputRdataRdataCnameRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record Cname data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Cname data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordCnameData)
 
@@ -447,7 +453,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordTxtData, err := sess.NewResourceRecordUpdateInputRdataRdataTxtRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record Txt data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Txt data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordTxtData)
 
@@ -458,7 +464,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 			resourceRecordMxData, err := sess.NewResourceRecordUpdateInputRdataRdataMxRecord(rdata, int64(preference))
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record Mx data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Mx data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordMxData)
 
@@ -471,7 +477,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 			resourceRecordSrvData, err := sess.NewResourceRecordUpdateInputRdataRdataSrvRecord(int64(port), int64(priority), rdata, int64(weight))
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record Srv data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Srv data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordSrvData)
 
@@ -481,31 +487,33 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			updateResourceRecordOptions.SetProtocol(protocol)
 		}
 
-		_, detail, err := sess.UpdateResourceRecord(updateResourceRecordOptions)
+		_, detail, err := sess.UpdateResourceRecordWithContext(ctx, updateResourceRecordOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns resource record:%s\n%s", err, detail)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating pdns resource record:%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSResourceRecordRead(d, meta)
+	return resourceIBMPrivateDNSResourceRecordRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSet := strings.Split(d.Id(), "/")
 
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	deleteResourceRecordOptions := sess.NewDeleteResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
-	response, err := sess.DeleteResourceRecord(deleteResourceRecordOptions)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(mk)
+	response, err := sess.DeleteResourceRecordWithContext(ctx, deleteResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns resource record:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting pdns resource record:%s\n%s", err, response))
 	}
 
 	d.SetId("")
@@ -526,8 +534,10 @@ func resourceIBMPrivateDNSResourceRecordExists(d *schema.ResourceData, meta inte
 	randI := fmt.Sprint(rand.Intn(50))
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(context.Background(), mk); err != nil {
+		return false, err
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(mk)
 	_, response, err := sess.GetResourceRecord(getResourceRecordOptions)
 
 	if err != nil {
@@ -4,11 +4,13 @@
 package dnsservices
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,12 +27,12 @@ const (
 
 func ResourceIBMPrivateDNSZone() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSZoneCreate,
-		Read:     resourceIBMPrivateDNSZoneRead,
-		Update:   resourceIBMPrivateDNSZoneUpdate,
-		Delete:   resourceIBMPrivateDNSZoneDelete,
-		Exists:   resourceIBMPrivateDNSZoneExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSZoneCreate,
+		ReadContext:   resourceIBMPrivateDNSZoneRead,
+		UpdateContext: resourceIBMPrivateDNSZoneUpdate,
+		DeleteContext: resourceIBMPrivateDNSZoneDelete,
+		Exists:        resourceIBMPrivateDNSZoneExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -94,10 +96,10 @@ func ResourceIBMPrivateDNSZone() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSZoneCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -119,28 +121,28 @@ func resourceIBMPrivateDNSZoneCreate(d *schema.ResourceData, meta interface{}) e
 	createZoneOptions.SetName(zoneName)
 	createZoneOptions.SetDescription(zoneDescription)
 	createZoneOptions.SetLabel(zoneLabel)
-	response, detail, err := sess.CreateDnszone(createZoneOptions)
+	response, detail, err := sess.CreateDnszoneWithContext(ctx, createZoneOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns zone:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns zone:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *response.InstanceID, *response.ID))
 	d.Set(pdnsZoneID, *response.ID)
 
-	return resourceIBMPrivateDNSZoneRead(d, meta)
+	return resourceIBMPrivateDNSZoneRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSZoneRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
 	getZoneOptions := sess.NewGetDnszoneOptions(idSet[0], idSet[1])
-	response, detail, err := sess.GetDnszone(getZoneOptions)
+	response, detail, err := sess.GetDnszoneWithContext(ctx, getZoneOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching pdns zone:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching pdns zone:%s\n%s", err, detail))
 	}
 
 	d.Set(pdnsZoneID, response.ID)
@@ -155,19 +157,19 @@ func resourceIBMPrivateDNSZoneRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMPrivateDNSZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
 
 	// Check DNS zone is present?
 	getZoneOptions := sess.NewGetDnszoneOptions(idSet[0], idSet[1])
-	_, response, err := sess.GetDnszone(getZoneOptions)
+	_, response, err := sess.GetDnszoneWithContext(ctx, getZoneOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching pdns zone:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching pdns zone:%s\n%s", err, response))
 	}
 
 	// Update DNS zone if attributes has any change
@@ -179,28 +181,28 @@ func resourceIBMPrivateDNSZoneUpdate(d *schema.ResourceData, meta interface{}) e
 		updateZoneOptions.SetDescription(description)
 		updateZoneOptions.SetLabel(label)
 
-		_, detail, err := sess.UpdateDnszone(updateZoneOptions)
+		_, detail, err := sess.UpdateDnszoneWithContext(ctx, updateZoneOptions)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns zone:%s\n%s", err, detail)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating pdns zone:%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSZoneRead(d, meta)
+	return resourceIBMPrivateDNSZoneRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSZoneDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
 
 	deleteZoneOptions := sess.NewDeleteDnszoneOptions(idSet[0], idSet[1])
-	response, err := sess.DeleteDnszone(deleteZoneOptions)
+	response, err := sess.DeleteDnszoneWithContext(ctx, deleteZoneOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns zone:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting pdns zone:%s\n%s", err, response))
 	}
 
 	d.SetId("")
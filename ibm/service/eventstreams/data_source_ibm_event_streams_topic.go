@@ -4,6 +4,7 @@
 package eventstreams
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -50,7 +51,7 @@ func DataSourceIBMEventStreamsTopic() *schema.Resource {
 }
 
 func dataSourceIBMEventStreamsTopicRead(d *schema.ResourceData, meta interface{}) error {
-	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+	adminClient, instanceCRN, err := createSaramaAdminClient(context.Background(), d, meta)
 	if err != nil {
 		log.Printf("[DEBUG]dataSourceIBMEventStreamsTopicRead createSaramaAdminClient err %s", err)
 		return err
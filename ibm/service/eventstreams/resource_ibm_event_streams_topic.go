@@ -4,6 +4,7 @@
 package eventstreams
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -46,12 +48,12 @@ var (
 
 func ResourceIBMEventStreamsTopic() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMEventStreamsTopicExists,
-		Create:   resourceIBMEventStreamsTopicCreate,
-		Read:     resourceIBMEventStreamsTopicRead,
-		Update:   resourceIBMEventStreamsTopicUpdate,
-		Delete:   resourceIBMEventStreamsTopicDelete,
-		Importer: &schema.ResourceImporter{},
+		Exists:        resourceIBMEventStreamsTopicExists,
+		CreateContext: resourceIBMEventStreamsTopicCreate,
+		ReadContext:   resourceIBMEventStreamsTopicRead,
+		UpdateContext: resourceIBMEventStreamsTopicUpdate,
+		DeleteContext: resourceIBMEventStreamsTopicDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"resource_instance_id": {
 				Type:        schema.TypeString,
@@ -94,7 +96,7 @@ func ResourceIBMEventStreamsTopic() *schema.Resource {
 var clientPool = map[string]sarama.ClusterAdmin{}
 
 func resourceIBMEventStreamsTopicExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	adminClient, _, err := createSaramaAdminClient(d, meta)
+	adminClient, _, err := createSaramaAdminClient(context.Background(), d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicExists createSaramaAdminClient err %s", err)
 		return false, err
@@ -120,11 +122,11 @@ func resourceIBMEventStreamsTopicExists(d *schema.ResourceData, meta interface{}
 	return true, nil
 }
 
-func resourceIBMEventStreamsTopicCreate(d *schema.ResourceData, meta interface{}) error {
-	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+func resourceIBMEventStreamsTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, instanceCRN, err := createSaramaAdminClient(ctx, d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicCreate createSaramaAdminClient err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	topicName := d.Get("name").(string)
 	partitions := d.Get("partitions").(int)
@@ -141,34 +143,34 @@ func resourceIBMEventStreamsTopicCreate(d *schema.ResourceData, meta interface{}
 				exists, err := resourceIBMEventStreamsTopicExists(d, meta)
 				if err != nil {
 					log.Printf("[DEBUG] resourceIBMEventStreamsTopicCreate resourceIBMEventStreamsTopicExists err %s", err)
-					return err
+					return diag.FromErr(err)
 				}
 				if exists {
 					d.SetId(getTopicID(instanceCRN, topicName))
-					return resourceIBMEventStreamsTopicRead(d, meta)
+					return resourceIBMEventStreamsTopicRead(ctx, d, meta)
 				}
 			}
 		}
 		log.Printf("[ERROR] resourceIBMEventStreamsTopicCreate CreateTopic err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] resourceIBMEventStreamsTopicCreate CreateTopic: topic is %s, detail is %v", topicName, topicDetail)
 	d.SetId(getTopicID(instanceCRN, topicName))
-	return resourceIBMEventStreamsTopicRead(d, meta)
+	return resourceIBMEventStreamsTopicRead(ctx, d, meta)
 }
 
-func resourceIBMEventStreamsTopicRead(d *schema.ResourceData, meta interface{}) error {
-	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+func resourceIBMEventStreamsTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, instanceCRN, err := createSaramaAdminClient(ctx, d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicRead createSaramaAdminClient err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	topicID := d.Id()
 	topicName := getTopicName(topicID)
 	topics, err := adminClient.ListTopics()
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicRead ListTopics err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	for name, detail := range topics {
 		if name == topicName {
@@ -192,11 +194,11 @@ func resourceIBMEventStreamsTopicRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMEventStreamsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
-	adminClient, _, err := createSaramaAdminClient(d, meta)
+func resourceIBMEventStreamsTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, _, err := createSaramaAdminClient(ctx, d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicUpdate createSaramaAdminClient err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	topicName := d.Get("name").(string)
 	if d.HasChange("partitions") {
@@ -207,7 +209,7 @@ func resourceIBMEventStreamsTopicUpdate(d *schema.ResourceData, meta interface{}
 		err = adminClient.CreatePartitions(topicName, int32(newPartitions), nil, false)
 		if err != nil {
 			log.Printf("[DEBUG]resourceIBMEventStreamsTopicUpdate CreatePartitions err %s", err)
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("partitions", int32(newPartitions))
 		log.Printf("[INFO]resourceIBMEventStreamsTopicUpdate partitions is set to %d", newPartitions)
@@ -218,19 +220,19 @@ func resourceIBMEventStreamsTopicUpdate(d *schema.ResourceData, meta interface{}
 		err = adminClient.AlterConfig(sarama.TopicResource, topicName, configEntries, false)
 		if err != nil {
 			log.Printf("[DEBUG]resourceIBMEventStreamsTopicUpdate AlterConfig err %s", err)
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("config", topicDetail2Config(configEntries))
 		log.Printf("[INFO]resourceIBMEventStreamsTopicUpdate config is set to %v", topicDetail2Config(configEntries))
 	}
-	return resourceIBMEventStreamsTopicRead(d, meta)
+	return resourceIBMEventStreamsTopicRead(ctx, d, meta)
 }
 
-func resourceIBMEventStreamsTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	adminClient, _, err := createSaramaAdminClient(d, meta)
+func resourceIBMEventStreamsTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, _, err := createSaramaAdminClient(ctx, d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicDelete createSaramaAdminClient err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	topicName := d.Get("name").(string)
 	err = adminClient.DeleteTopic(topicName)
@@ -243,14 +245,14 @@ func resourceIBMEventStreamsTopicDelete(d *schema.ResourceData, meta interface{}
 			}
 		}
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicDelete DeleteTopic err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	log.Printf("[INFO]resourceIBMEventStreamsTopicDelete topic %s deleted", topicName)
 	return nil
 }
 
-func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.ClusterAdmin, string, error) {
+func createSaramaAdminClient(ctx context.Context, d *schema.ResourceData, meta interface{}) (sarama.ClusterAdmin, string, error) {
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
 		log.Printf("[DEBUG] createSaramaAdminClient BluemixSession err %s", err)
@@ -274,7 +276,7 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 		}
 		instanceCRN = getInstanceCRN(topicID)
 	}
-	instance, err := getInstanceDetails(instanceCRN, meta)
+	instance, err := getInstanceDetails(ctx, instanceCRN, meta)
 	if err != nil {
 		return nil, "", err
 	}
@@ -4,9 +4,9 @@
 package functions
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"strings"
 
@@ -14,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,12 +27,12 @@ const (
 
 func ResourceIBMFunctionAction() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionActionCreate,
-		Read:     resourceIBMFunctionActionRead,
-		Update:   resourceIBMFunctionActionUpdate,
-		Delete:   resourceIBMFunctionActionDelete,
-		Exists:   resourceIBMFunctionActionExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionActionCreate,
+		ReadContext:   resourceIBMFunctionActionRead,
+		UpdateContext: resourceIBMFunctionActionUpdate,
+		DeleteContext: resourceIBMFunctionActionDelete,
+		Exists:        resourceIBMFunctionActionExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			funcActionName: {
@@ -221,20 +222,20 @@ func ResourceIBMFuncActionValidator() *validate.ResourceValidator {
 	return &ibmFuncActionResourceValidator
 }
 
-func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -244,7 +245,7 @@ func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) e
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diag.FromErr(NewQualifiedNameError(name, err))
 	}
 
 	payload := whisk.Action{
@@ -258,13 +259,13 @@ func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) e
 	userDefinedAnnotations := d.Get("user_defined_annotations").(string)
 	payload.Annotations, err = flex.ExpandAnnotations(userDefinedAnnotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userDefinedParameters := d.Get("user_defined_parameters").(string)
 	payload.Parameters, err = flex.ExpandParameters(userDefinedParameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("limits"); ok {
@@ -280,18 +281,18 @@ func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) e
 	_, _, err = actionService.Insert(&payload, true)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating IBM Cloud Function Action: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating IBM Cloud Function Action: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, qualifiedName.GetEntityName()))
 
-	return resourceIBMFunctionActionRead(d, meta)
+	return resourceIBMFunctionActionRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := ""
@@ -307,23 +308,23 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
 	actionService := wskClient.Actions
 	action, _, err := actionService.Get(actionID, true)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving IBM Cloud Function Action %s : %s", actionID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving IBM Cloud Function Action %s : %s", actionID, err))
 	}
 	d.Set("namespace", namespace)
 	d.Set("limits", flex.FlattenLimits(action.Limits))
@@ -333,13 +334,13 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("action_id", action.Name)
 	annotations, err := flex.FlattenAnnotations(action.Annotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("annotations", annotations)
 	parameters, err := flex.FlattenParameters(action.Parameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("parameters", parameters)
 
@@ -348,42 +349,42 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 	if len(temp) == 2 {
 		pkgName = temp[1]
 		d.Set("name", fmt.Sprintf("%s/%s", pkgName, action.Name))
-		c, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+		c, err := whisk.NewClient(bxSession.Config.HTTPClient, &whisk.Config{
 			Namespace:         wskClient.Namespace,
 			AuthToken:         wskClient.AuthToken,
 			Host:              wskClient.Host,
 			AdditionalHeaders: wskClient.AdditionalHeaders,
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		pkg, _, err := c.Packages.Get(pkgName)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving package IBM Cloud Function package %s : %s", pkgName, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving package IBM Cloud Function package %s : %s", pkgName, err))
 		}
 
 		userAnnotations, err := flex.FlattenAnnotations(flex.FilterInheritedAnnotations(pkg.Annotations, action.Annotations))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.Set("user_defined_annotations", userAnnotations)
 		userParameters, err := flex.FlattenParameters(flex.FilterInheritedParameters(pkg.Parameters, action.Parameters))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("user_defined_parameters", userParameters)
 	} else {
 		d.Set("name", action.Name)
 		userDefinedAnnotations, err := flex.FilterActionAnnotations(action.Annotations)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("user_defined_annotations", userDefinedAnnotations)
 
 		userDefinedParameters, err := flex.FilterActionParameters(action.Parameters)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("user_defined_parameters", userDefinedParameters)
 	}
@@ -399,10 +400,10 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
@@ -410,17 +411,17 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -429,7 +430,7 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(actionID); err != nil {
-		return NewQualifiedNameError(actionID, err)
+		return diag.FromErr(NewQualifiedNameError(actionID, err))
 	}
 
 	payload := whisk.Action{
@@ -448,7 +449,7 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 		var err error
 		payload.Parameters, err = flex.ExpandParameters(d.Get("user_defined_parameters").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -457,7 +458,7 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 		var err error
 		payload.Annotations, err = flex.ExpandAnnotations(d.Get("user_defined_annotations").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -478,17 +479,17 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 		log.Println("[INFO] Update IBM Cloud Function Action")
 		_, _, err = actionService.Insert(&payload, true)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating IBM Cloud Function Action: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating IBM Cloud Function Action: %s", err))
 		}
 	}
 
-	return resourceIBMFunctionActionRead(d, meta)
+	return resourceIBMFunctionActionRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionActionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
@@ -496,16 +497,16 @@ func resourceIBMFunctionActionDelete(d *schema.ResourceData, meta interface{}) e
 
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -513,7 +514,7 @@ func resourceIBMFunctionActionDelete(d *schema.ResourceData, meta interface{}) e
 
 	_, err = actionService.Delete(actionID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting IBM Cloud Function Action: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting IBM Cloud Function Action: %s", err))
 	}
 
 	d.SetId("")
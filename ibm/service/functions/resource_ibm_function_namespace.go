@@ -4,6 +4,7 @@
 package functions
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/functions"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,12 +26,12 @@ const (
 
 func ResourceIBMFunctionNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionNamespaceCreate,
-		Read:     resourceIBMFunctionNamespaceRead,
-		Update:   resourceIBMFunctionNamespaceUpdate,
-		Delete:   resourceIBMFunctionNamespaceDelete,
-		Exists:   resourceIBMFunctionNamespaceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionNamespaceCreate,
+		ReadContext:   resourceIBMFunctionNamespaceRead,
+		UpdateContext: resourceIBMFunctionNamespaceUpdate,
+		DeleteContext: resourceIBMFunctionNamespaceDelete,
+		Exists:        resourceIBMFunctionNamespaceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			funcNamespaceName: {
@@ -80,10 +82,10 @@ func ResourceIBMFuncNamespaceValidator() *validate.ResourceValidator {
 	return &ibmFuncNamespaceResourceValidator
 }
 
-func resourceIBMFunctionNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createNamespaceOptions := functions.CreateNamespaceOptions{}
@@ -102,19 +104,19 @@ func resourceIBMFunctionNamespaceCreate(d *schema.ResourceData, meta interface{}
 
 	namespace, err := functionNamespaceAPI.Namespaces().CreateNamespace(createNamespaceOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Namespace: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Namespace: %s", err))
 	}
 
 	d.SetId(*namespace.ID)
 	log.Printf("[INFO] Created namespace (IAM) : %s", *namespace.Name)
 
-	return resourceIBMFunctionNamespaceRead(d, meta)
+	return resourceIBMFunctionNamespaceRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
@@ -128,7 +130,7 @@ func resourceIBMFunctionNamespaceRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting namesapce (IAM): %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting namesapce (IAM): %s", err))
 	}
 
 	if instance.Name != nil {
@@ -149,10 +151,10 @@ func resourceIBMFunctionNamespaceRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMFunctionNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nsClient, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -170,24 +172,24 @@ func resourceIBMFunctionNamespaceUpdate(d *schema.ResourceData, meta interface{}
 	updateNamespaceOptions.ID = &ID
 	namespace, err := nsClient.Namespaces().UpdateNamespace(updateNamespaceOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Namespace: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Namespace: %s", err))
 	}
 
 	log.Printf("[INFO] Updated namespace (IAM) : %s", *namespace.Name)
 
-	return resourceIBMFunctionNamespaceRead(d, meta)
+	return resourceIBMFunctionNamespaceRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nsClient, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
 	_, err = nsClient.Namespaces().DeleteNamespace(ID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Namespace: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Namespace: %s", err))
 	}
 
 	d.SetId("")
@@ -4,9 +4,9 @@
 package functions
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"strings"
 
@@ -14,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,12 +28,12 @@ const (
 
 func ResourceIBMFunctionPackage() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionPackageCreate,
-		Read:     resourceIBMFunctionPackageRead,
-		Update:   resourceIBMFunctionPackageUpdate,
-		Delete:   resourceIBMFunctionPackageDelete,
-		Exists:   resourceIBMFunctionPackageExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionPackageCreate,
+		ReadContext:   resourceIBMFunctionPackageRead,
+		UpdateContext: resourceIBMFunctionPackageUpdate,
+		DeleteContext: resourceIBMFunctionPackageDelete,
+		Exists:        resourceIBMFunctionPackageExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			funcPkgNamespace: {
@@ -152,20 +153,20 @@ func ResourceIBMFuncPackageValidator() *validate.ResourceValidator {
 	return &ibmFuncPackageResourceValidator
 }
 
-func resourceIBMFunctionPackageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -176,7 +177,7 @@ func resourceIBMFunctionPackageCreate(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diag.FromErr(NewQualifiedNameError(name, err))
 	}
 
 	payload := whisk.Package{
@@ -187,13 +188,13 @@ func resourceIBMFunctionPackageCreate(d *schema.ResourceData, meta interface{})
 	userDefinedAnnotations := d.Get("user_defined_annotations").(string)
 	payload.Annotations, err = flex.ExpandAnnotations(userDefinedAnnotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userDefinedParameters := d.Get("user_defined_parameters").(string)
 	payload.Parameters, err = flex.ExpandParameters(userDefinedParameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if publish, ok := d.GetOk("publish"); ok {
@@ -204,7 +205,7 @@ func resourceIBMFunctionPackageCreate(d *schema.ResourceData, meta interface{})
 	if v, ok := d.GetOk("bind_package_name"); ok {
 		var BindingQualifiedName = new(QualifiedName)
 		if BindingQualifiedName, err = NewQualifiedName(v.(string)); err != nil {
-			return NewQualifiedNameError(v.(string), err)
+			return diag.FromErr(NewQualifiedNameError(v.(string), err))
 		}
 		BindingPayload := whisk.Binding{
 			Name:      BindingQualifiedName.GetEntityName(),
@@ -216,18 +217,18 @@ func resourceIBMFunctionPackageCreate(d *schema.ResourceData, meta interface{})
 	log.Println("[INFO] Creating IBM CLoud Function package")
 	result, _, err := packageService.Insert(&payload, false)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating IBM CLoud Function package: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating IBM CLoud Function package: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, result.Name))
 
-	return resourceIBMFunctionPackageRead(d, meta)
+	return resourceIBMFunctionPackageRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := ""
@@ -243,23 +244,23 @@ func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) er
 
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 	packageService := wskClient.Packages
 
 	pkg, _, err := packageService.Get(packageID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving IBM Cloud Function package %s : %s", packageID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving IBM Cloud Function package %s : %s", packageID, err))
 	}
 	d.Set("package_id", pkg.Name)
 	d.Set("name", pkg.Name)
@@ -268,12 +269,12 @@ func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("version", pkg.Version)
 	annotations, err := flex.FlattenAnnotations(pkg.Annotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("annotations", annotations)
 	parameters, err := flex.FlattenParameters(pkg.Parameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("parameters", parameters)
 	if flex.IsEmpty(*pkg.Binding) {
@@ -283,29 +284,29 @@ func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) er
 
 	} else {
 		d.Set("bind_package_name", fmt.Sprintf("/%s/%s", pkg.Binding.Namespace, pkg.Binding.Name))
-		c, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+		c, err := whisk.NewClient(bxSession.Config.HTTPClient, &whisk.Config{
 			Namespace:         pkg.Binding.Namespace,
 			AuthToken:         wskClient.AuthToken,
 			Host:              wskClient.Host,
 			AdditionalHeaders: wskClient.AdditionalHeaders,
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		bindedPkg, _, err := c.Packages.Get(pkg.Binding.Name)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving Binded IBM Cloud Function package %s : %s", pkg.Binding.Name, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Binded IBM Cloud Function package %s : %s", pkg.Binding.Name, err))
 		}
 
 		userAnnotations, err := flex.FlattenAnnotations(flex.FilterInheritedAnnotations(bindedPkg.Annotations, pkg.Annotations))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("user_defined_annotations", userAnnotations)
 
 		userParameters, err := flex.FlattenParameters(flex.FilterInheritedParameters(bindedPkg.Parameters, pkg.Parameters))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("user_defined_parameters", userParameters)
 	}
@@ -313,26 +314,26 @@ func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMFunctionPackageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
 
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -341,7 +342,7 @@ func resourceIBMFunctionPackageUpdate(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(d.Get("name").(string)); err != nil {
-		return NewQualifiedNameError(d.Get("name").(string), err)
+		return diag.FromErr(NewQualifiedNameError(d.Get("name").(string), err))
 	}
 
 	payload := whisk.Package{
@@ -359,7 +360,7 @@ func resourceIBMFunctionPackageUpdate(d *schema.ResourceData, meta interface{})
 		var err error
 		payload.Parameters, err = flex.ExpandParameters(d.Get("user_defined_parameters").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -368,7 +369,7 @@ func resourceIBMFunctionPackageUpdate(d *schema.ResourceData, meta interface{})
 		var err error
 		payload.Annotations, err = flex.ExpandAnnotations(d.Get("user_defined_annotations").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -377,17 +378,17 @@ func resourceIBMFunctionPackageUpdate(d *schema.ResourceData, meta interface{})
 		log.Println("[INFO] Update IBM Cloud Function Package")
 		_, _, err = packageService.Insert(&payload, true)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating IBM Cloud Function Package: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating IBM Cloud Function Package: %s", err))
 		}
 	}
 
-	return resourceIBMFunctionPackageRead(d, meta)
+	return resourceIBMFunctionPackageRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionPackageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
@@ -395,16 +396,16 @@ func resourceIBMFunctionPackageDelete(d *schema.ResourceData, meta interface{})
 
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -412,7 +413,7 @@ func resourceIBMFunctionPackageDelete(d *schema.ResourceData, meta interface{})
 
 	_, err = packageService.Delete(packageID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting IBM Cloud Function Package: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting IBM Cloud Function Package: %s", err))
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package functions
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,12 +25,12 @@ const (
 
 func ResourceIBMFunctionRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionRuleCreate,
-		Read:     resourceIBMFunctionRuleRead,
-		Update:   resourceIBMFunctionRuleUpdate,
-		Delete:   resourceIBMFunctionRuleDelete,
-		Exists:   resourceIBMFunctionRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionRuleCreate,
+		ReadContext:   resourceIBMFunctionRuleRead,
+		UpdateContext: resourceIBMFunctionRuleUpdate,
+		DeleteContext: resourceIBMFunctionRuleDelete,
+		Exists:        resourceIBMFunctionRuleExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			funcRuleNamespace: {
@@ -128,20 +130,20 @@ func ResourceIBMFuncRuleValidator() *validate.ResourceValidator {
 	return &ibmFuncRuleResourceValidator
 }
 
-func resourceIBMFunctionRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -151,7 +153,7 @@ func resourceIBMFunctionRuleCreate(d *schema.ResourceData, meta interface{}) err
 
 	var qualifiedName = new(QualifiedName)
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diag.FromErr(NewQualifiedNameError(name, err))
 	}
 	trigger := d.Get("trigger_name").(string)
 	action := d.Get("action_name").(string)
@@ -167,18 +169,18 @@ func resourceIBMFunctionRuleCreate(d *schema.ResourceData, meta interface{}) err
 	log.Println("[INFO] Creating IBM Cloud Function rule")
 	result, _, err := ruleService.Insert(&payload, false)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating IBM Cloud Function rule: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating IBM Cloud Function rule: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, result.Name))
 
-	return resourceIBMFunctionRuleRead(d, meta)
+	return resourceIBMFunctionRuleRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := ""
@@ -194,24 +196,24 @@ func resourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) error
 
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
 	ruleService := wskClient.Rules
 	rule, _, err := ruleService.Get(ruleID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving IBM Cloud Function rule %s : %s", ruleID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving IBM Cloud Function rule %s : %s", ruleID, err))
 	}
 
 	d.Set("rule_id", rule.Name)
@@ -229,26 +231,26 @@ func resourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMFunctionRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -257,7 +259,7 @@ func resourceIBMFunctionRuleUpdate(d *schema.ResourceData, meta interface{}) err
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(d.Get("name").(string)); err != nil {
-		return NewQualifiedNameError(d.Get("name").(string), err)
+		return diag.FromErr(NewQualifiedNameError(d.Get("name").(string), err))
 	}
 
 	payload := whisk.Rule{
@@ -282,38 +284,38 @@ func resourceIBMFunctionRuleUpdate(d *schema.ResourceData, meta interface{}) err
 		log.Println("[INFO] Update IBM Cloud Function Rule")
 		result, _, err := ruleService.Insert(&payload, true)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating IBM Cloud Function Rule: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating IBM Cloud Function Rule: %s", err))
 		}
 		_, _, err = ruleService.SetState(result.Name, "active")
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating IBM Cloud Function Rule: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating IBM Cloud Function Rule: %s", err))
 		}
 	}
 
-	return resourceIBMFunctionRuleRead(d, meta)
+	return resourceIBMFunctionRuleRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
 	ruleID := parts[1]
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -321,7 +323,7 @@ func resourceIBMFunctionRuleDelete(d *schema.ResourceData, meta interface{}) err
 
 	_, err = ruleService.Delete(ruleID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting IBM Cloud Function Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting IBM Cloud Function Rule: %s", err))
 	}
 
 	d.SetId("")
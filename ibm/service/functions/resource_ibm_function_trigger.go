@@ -4,15 +4,16 @@
 package functions
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -32,12 +33,12 @@ const (
 
 func ResourceIBMFunctionTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionTriggerCreate,
-		Read:     resourceIBMFunctionTriggerRead,
-		Update:   resourceIBMFunctionTriggerUpdate,
-		Delete:   resourceIBMFunctionTriggerDelete,
-		Exists:   resourceIBMFunctionTriggerExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionTriggerCreate,
+		ReadContext:   resourceIBMFunctionTriggerRead,
+		UpdateContext: resourceIBMFunctionTriggerUpdate,
+		DeleteContext: resourceIBMFunctionTriggerDelete,
+		Exists:        resourceIBMFunctionTriggerExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			funcTriggerNamespace: {
@@ -184,20 +185,20 @@ func ResourceIBMFuncTriggerValidator() *validate.ResourceValidator {
 	return &ibmFuncTriggerResourceValidator
 }
 
-func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -209,7 +210,7 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diag.FromErr(NewQualifiedNameError(name, err))
 	}
 
 	payload := whisk.Trigger{
@@ -220,13 +221,13 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 	userDefinedAnnotations := d.Get("user_defined_annotations").(string)
 	payload.Annotations, err = flex.ExpandAnnotations(userDefinedAnnotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userDefinedParameters := d.Get("user_defined_parameters").(string)
 	payload.Parameters, err = flex.ExpandParameters(userDefinedParameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("feed"); ok {
@@ -244,7 +245,7 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 	log.Println("[INFO] Creating IBM Cloud Function trigger")
 	result, _, err := triggerService.Insert(&payload, false)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating IBM Cloud Function trigger: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating IBM Cloud Function trigger: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, result.Name))
@@ -256,7 +257,7 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 		var err error
 		feedParameters, err := flex.ExpandParameters(parameters)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, value := range feedParameters {
 			feedPayload[value.Key] = value.Value
@@ -266,22 +267,22 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 		if feedQualifiedName, err = NewQualifiedName(actionName); err != nil {
 			_, _, delerr := triggerService.Delete(name)
 			if delerr != nil {
-				return fmt.Errorf("[ERROR] Error creating IBM Cloud Function trigger with feed: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating IBM Cloud Function trigger with feed: %s", err))
 			}
-			return NewQualifiedNameError(actionName, err)
+			return diag.FromErr(NewQualifiedNameError(actionName, err))
 		}
 
 		feedPayload[feedLifeCycleEvent] = feedCreate
 		feedPayload[feedAuthKey] = wskClient.Config.AuthToken
 		feedPayload[feedTriggerName] = fmt.Sprintf("/%s/%s", qualifiedName.GetNamespace(), name)
 
-		c, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+		c, err := whisk.NewClient(bxSession.Config.HTTPClient, &whisk.Config{
 			AuthToken:         wskClient.AuthToken,
 			Host:              wskClient.Host,
 			AdditionalHeaders: wskClient.AdditionalHeaders,
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if feedQualifiedName.GetNamespace() != namespace {
 			c.Config.Namespace = feedQualifiedName.GetNamespace()
@@ -291,22 +292,22 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			_, _, delerr := triggerService.Delete(name)
 			if delerr != nil {
-				return fmt.Errorf("[ERROR] Error creating IBM Cloud Function trigger with feed: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating IBM Cloud Function trigger with feed: %s", err))
 			}
 			d.SetId("")
-			return fmt.Errorf("[ERROR] Error creating IBM Cloud Function trigger with feed: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating IBM Cloud Function trigger with feed: %s", err))
 		}
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, result.Name))
 
-	return resourceIBMFunctionTriggerRead(d, meta)
+	return resourceIBMFunctionTriggerRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := ""
@@ -322,17 +323,17 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -340,7 +341,7 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 
 	trigger, _, err := triggerService.Get(triggerID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err))
 	}
 	d.Set("trigger_id", trigger.Name)
 	d.Set("namespace", namespace)
@@ -349,19 +350,19 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("version", trigger.Version)
 	annotations, err := flex.FlattenAnnotations(trigger.Annotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("annotations", annotations)
 	parameters, err := flex.FlattenParameters(trigger.Parameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("parameters", parameters)
 	d.Set("user_defined_parameters", parameters)
 
 	userDefinedAnnotations, err := flex.FilterTriggerAnnotations(trigger.Annotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("user_defined_annotations", userDefinedAnnotations)
 
@@ -374,25 +375,25 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -403,7 +404,7 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diag.FromErr(NewQualifiedNameError(name, err))
 	}
 
 	payload := whisk.Trigger{
@@ -416,7 +417,7 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 		var err error
 		payload.Parameters, err = flex.ExpandParameters(d.Get("user_defined_parameters").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -425,7 +426,7 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 		var err error
 		payload.Annotations, err = flex.ExpandAnnotations(d.Get("user_defined_annotations").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -435,34 +436,34 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 
 		_, _, err = triggerService.Insert(&payload, true)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating IBM Cloud Function Trigger: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating IBM Cloud Function Trigger: %s", err))
 		}
 	}
 
-	return resourceIBMFunctionTriggerRead(d, meta)
+	return resourceIBMFunctionTriggerRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.CfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	namespace := parts[0]
 	triggerID := parts[1]
 
 	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -470,11 +471,11 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 	fmt.Println(qualifiedName)
 	if qualifiedName, err = NewQualifiedName(triggerID); err != nil {
-		return NewQualifiedNameError(triggerID, err)
+		return diag.FromErr(NewQualifiedNameError(triggerID, err))
 	}
 	trigger, _, err := triggerService.Get(triggerID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err))
 	}
 	found := trigger.Annotations.FindKeyValue("feed")
 	if found >= 0 {
@@ -482,7 +483,7 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 		var feedQualifiedName = new(QualifiedName)
 
 		if feedQualifiedName, err = NewQualifiedName(actionName); err != nil {
-			return NewQualifiedNameError(actionName, err)
+			return diag.FromErr(NewQualifiedNameError(actionName, err))
 		}
 
 		feedPayload := map[string]interface{}{
@@ -491,13 +492,13 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 			feedTriggerName:    fmt.Sprintf("/%s/%s", qualifiedName.GetNamespace(), triggerID),
 		}
 
-		c, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+		c, err := whisk.NewClient(bxSession.Config.HTTPClient, &whisk.Config{
 			AuthToken:         wskClient.AuthToken,
 			Host:              wskClient.Host,
 			AdditionalHeaders: wskClient.AdditionalHeaders,
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if feedQualifiedName.GetNamespace() != namespace {
 			c.Config.Namespace = feedQualifiedName.GetNamespace()
@@ -506,14 +507,14 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 		actionService := c.Actions
 		_, _, err = actionService.Invoke(feedQualifiedName.GetEntityName(), feedPayload, true, true)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error deleting IBM Cloud Function trigger with feed: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting IBM Cloud Function trigger with feed: %s", err))
 
 		}
 	}
 
 	_, _, err = triggerService.Delete(triggerID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting IBM Cloud Function Trigger: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting IBM Cloud Function Trigger: %s", err))
 	}
 
 	d.SetId("")
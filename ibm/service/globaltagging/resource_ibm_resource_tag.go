@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -32,15 +33,15 @@ const (
 
 func ResourceIBMResourceTag() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceTagCreate,
-		Read:     resourceIBMResourceTagRead,
-		Update:   resourceIBMResourceTagUpdate,
-		Delete:   resourceIBMResourceTagDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMResourceTagCreate,
+		ReadContext:   resourceIBMResourceTagRead,
+		UpdateContext: resourceIBMResourceTagUpdate,
+		DeleteContext: resourceIBMResourceTagDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, nil)
 			},
 		),
 
@@ -114,19 +115,19 @@ func ResourceIBMResourceTagValidator() *validate.ResourceValidator {
 	return &ibmResourceTagValidator
 }
 
-func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var rType, tType string
 	resources := []globaltaggingv1.Resource{}
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	accountID := userDetails.UserAccount
 
 	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err))
 	}
 
 	resourceID := d.Get(resourceID).(string)
@@ -165,15 +166,15 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if len(add) > 0 {
-		_, resp, err := gtClient.AttachTag(AttachTagOptions)
+		_, resp, err := gtClient.AttachTagWithContext(ctx, AttachTagOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error attaching resource tags : %v\n%s", resp, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error attaching resource tags : %v\n%s", resp, err))
 		}
 	}
 
 	crn, err := regexp.Compile(crnRegex)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if crn.MatchString(resourceID) {
@@ -182,21 +183,21 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 		d.SetId(fmt.Sprintf("%s/%s", resourceID, resourceType))
 	}
 
-	return resourceIBMResourceTagRead(d, meta)
+	return resourceIBMResourceTagRead(ctx, d, meta)
 }
 
-func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var rID, rType, tType string
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	acctID := userDetails.UserAccount
 
 	crn, err := regexp.Compile(crnRegex)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if crn.MatchString(d.Id()) {
@@ -204,10 +205,10 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 	} else {
 		parts, err := flex.VmIdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if len(parts) < 2 {
-			return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of resourceID/resourceType", d.Id())
+			return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of resourceID/resourceType", d.Id()))
 		}
 		rID = parts[0]
 		rType = parts[1]
@@ -227,7 +228,7 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting resource tags for: %s with error : %s", rID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting resource tags for: %s with error : %s", rID, err))
 	}
 
 	d.Set(resourceID, rID)
@@ -237,12 +238,12 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceTagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var rID, rType, tType string
 
 	crn, err := regexp.Compile(crnRegex)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if crn.MatchString(d.Id()) {
@@ -250,7 +251,7 @@ func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		parts, err := flex.VmIdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rID = parts[0]
 		rType = parts[1]
@@ -264,19 +265,19 @@ func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) erro
 		oldList, newList := d.GetChange(tags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, rID, rType, tType)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error on create of resource tags: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error on create of resource tags: %s", err))
 		}
 	}
 
-	return resourceIBMResourceTagRead(d, meta)
+	return resourceIBMResourceTagRead(ctx, d, meta)
 }
 
-func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var rID, rType string
 
 	crn, err := regexp.Compile(crnRegex)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if crn.MatchString(d.Id()) {
@@ -284,7 +285,7 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		parts, err := flex.VmIdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rID = parts[0]
 		rType = parts[1]
@@ -292,7 +293,7 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 
 	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err))
 	}
 
 	var remove []string
@@ -312,17 +313,17 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 			TagNames:  remove,
 		}
 
-		_, resp, err := gtClient.DetachTag(detachTagOptions)
+		_, resp, err := gtClient.DetachTagWithContext(ctx, detachTagOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error detaching resource tags %v: %s\n%s", remove, err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error detaching resource tags %v: %s\n%s", remove, err, resp))
 		}
 		for _, v := range remove {
 			delTagOptions := &globaltaggingv1.DeleteTagOptions{
 				TagName: flex.PtrToString(v),
 			}
-			_, resp, err := gtClient.DeleteTag(delTagOptions)
+			_, resp, err := gtClient.DeleteTagWithContext(ctx, delTagOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error deleting resource tag %v: %s\n%s", v, err, resp)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error deleting resource tag %v: %s\n%s", v, err, resp))
 			}
 		}
 	}
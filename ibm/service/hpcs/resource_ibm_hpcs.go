@@ -47,7 +47,7 @@ func ResourceIBMHPCS() *schema.Resource {
 				return flex.ImmutableResourceCustomizeDiff([]string{"units", "failover_units", "location", "resource_group_id", "service"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -422,8 +422,8 @@ func resourceIBMHPCSCreate(context context.Context, d *schema.ResourceData, meta
 	if err != nil || instance == nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error when creating HPCS instance: %s with resp code: %s", err, resp))
 	}
-	d.SetId(*instance.ID)                       // Set Resource ID
-	_, err = waitForHPCSInstanceCreate(d, meta) // Wait for Instance to be available
+	d.SetId(*instance.ID)                                // Set Resource ID
+	_, err = waitForHPCSInstanceCreate(context, d, meta) // Wait for Instance to be available
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"[ERROR] Error waiting for create HPCS instance (%s) to be succeeded: %s", d.Id(), err))
@@ -647,7 +647,7 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating HPCS instance: %s with resp code: %s", err, resp))
 		}
 
-		_, err = waitForHPCSInstanceUpdate(d, meta)
+		_, err = waitForHPCSInstanceUpdate(context, d, meta)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for update HPCS instance (%s) to be succeeded: %s", d.Id(), err))
@@ -757,7 +757,7 @@ func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta
 	if error != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting HPCS instance: %s with resp code: %s", error, resp))
 	}
-	_, err = waitForHPCSInstanceDelete(d, meta)
+	_, err = waitForHPCSInstanceDelete(context, d, meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"[ERROR] Error waiting for HPCS instance (%s) to be deleted: %s", d.Id(), err))
@@ -767,7 +767,7 @@ func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta
 
 	return nil
 }
-func waitForHPCSInstanceCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForHPCSInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -798,10 +798,10 @@ func waitForHPCSInstanceCreate(d *schema.ResourceData, meta interface{}) (interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForHPCSInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForHPCSInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -832,10 +832,10 @@ func waitForHPCSInstanceUpdate(d *schema.ResourceData, meta interface{}) (interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForHPCSInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForHPCSInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -865,7 +865,7 @@ func waitForHPCSInstanceDelete(d *schema.ResourceData, meta interface{}) (interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 func resourceIBMHPCSAdminHash(v interface{}) int {
 	var buf bytes.Buffer
@@ -904,9 +904,9 @@ func hsmClient(d *schema.ResourceData, meta interface{}) (tkesdk.CommonInputs, e
 		serviceEndpoint = e.(string)
 	}
 	ci.Region = d.Get("location").(string)
-	ci.ApiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_HPCS_TKE_ENDPOINT"}, "cloud.ibm.com")
+	ci.ApiEndpoint = meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_HPCS_TKE_ENDPOINT", "cloud.ibm.com")
 	if bluemixSession.Config.Visibility == "private" || bluemixSession.Config.Visibility == "public-and-private" || serviceEndpoint == "private-only" {
-		ci.ApiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_HPCS_TKE_ENDPOINT"}, "private.cloud.ibm.com")
+		ci.ApiEndpoint = meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_HPCS_TKE_ENDPOINT", "private.cloud.ibm.com")
 	}
 
 	ci.AuthToken = bluemixSession.Config.IAMAccessToken
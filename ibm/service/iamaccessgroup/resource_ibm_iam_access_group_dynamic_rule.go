@@ -4,23 +4,25 @@
 package iamaccessgroup
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMIAMDynamicRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMDynamicRuleCreate,
-		Read:     resourceIBMIAMDynamicRuleRead,
-		Update:   resourceIBMIAMDynamicRuleUpdate,
-		Delete:   resourceIBMIAMDynamicRuleDelete,
-		Exists:   resourceIBMIAMDynamicRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMDynamicRuleCreate,
+		ReadContext:   resourceIBMIAMDynamicRuleRead,
+		UpdateContext: resourceIBMIAMDynamicRuleUpdate,
+		DeleteContext: resourceIBMIAMDynamicRuleDelete,
+		Exists:        resourceIBMIAMDynamicRuleExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -76,10 +78,10 @@ func ResourceIBMIAMDynamicRule() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMDynamicRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grpID := d.Get("access_group_id").(string)
@@ -112,25 +114,25 @@ func resourceIBMIAMDynamicRuleCreate(d *schema.ResourceData, meta interface{}) e
 		Expiration:    &expiration,
 		Conditions:    conditions,
 	}
-	rule, detailedResponse, err := iamAccessGroupsClient.AddAccessGroupRule(addAccessGroupRuleOptions)
+	rule, detailedResponse, err := iamAccessGroupsClient.AddAccessGroupRuleWithContext(ctx, addAccessGroupRuleOptions)
 	if err != nil || rule == nil {
-		return fmt.Errorf("[ERROR] Error adding rule to Access Group(%s) %s. API Response: %s", grpID, err, detailedResponse)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error adding rule to Access Group(%s) %s. API Response: %s", grpID, err, detailedResponse))
 	}
 	ruleID := rule.ID
 	d.SetId(fmt.Sprintf("%s/%s", grpID, *ruleID))
 
-	return resourceIBMIAMDynamicRuleRead(d, meta)
+	return resourceIBMIAMDynamicRuleRead(ctx, d, meta)
 }
 
-func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grpID := parts[0]
@@ -140,14 +142,14 @@ func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) err
 		AccessGroupID: &grpID,
 		RuleID:        &ruleID,
 	}
-	rule, detailResponse, err := iamAccessGroupsClient.GetAccessGroupRule(getAccessGroupRuleOptions)
+	rule, detailResponse, err := iamAccessGroupsClient.GetAccessGroupRuleWithContext(ctx, getAccessGroupRuleOptions)
 
 	if err != nil || rule == nil {
 		if detailResponse != nil && detailResponse.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		} else {
-			return fmt.Errorf("[ERROR] Error retrieving access group Rules: %s. API Response: %s", err, detailResponse)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group Rules: %s. API Response: %s", err, detailResponse))
 		}
 	}
 
@@ -161,22 +163,22 @@ func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMIAMDynamicRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grpID := parts[0]
 	ruleID := parts[1]
 	getAccessGroupRuleOptions := iamAccessGroupsClient.NewGetAccessGroupRuleOptions(grpID, ruleID)
-	_, detailedResponse, err := iamAccessGroupsClient.GetAccessGroupRule(getAccessGroupRuleOptions)
+	_, detailedResponse, err := iamAccessGroupsClient.GetAccessGroupRuleWithContext(ctx, getAccessGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving access group Rules: %s. API Response: %s", err, detailedResponse)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group Rules: %s. API Response: %s", err, detailedResponse))
 	}
 
 	etag := detailedResponse.GetHeaders().Get("etag")
@@ -202,36 +204,36 @@ func resourceIBMIAMDynamicRuleUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	replaceAccessGroupRuleOption := iamAccessGroupsClient.NewReplaceAccessGroupRuleOptions(grpID, ruleID, etag, expiration, realm, condition)
-	rule, detailedResponse, err := iamAccessGroupsClient.ReplaceAccessGroupRule(replaceAccessGroupRuleOption)
+	rule, detailedResponse, err := iamAccessGroupsClient.ReplaceAccessGroupRuleWithContext(ctx, replaceAccessGroupRuleOption)
 	if err != nil || rule == nil {
-		return fmt.Errorf("[ERROR] Error replacing group(%s) rule(%s). API response: %s", grpID, ruleID, detailedResponse)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error replacing group(%s) rule(%s). API response: %s", grpID, ruleID, detailedResponse))
 	}
 
-	return resourceIBMIAMDynamicRuleRead(d, meta)
+	return resourceIBMIAMDynamicRuleRead(ctx, d, meta)
 
 }
 
-func resourceIBMIAMDynamicRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grpID := parts[0]
 	ruleID := parts[1]
 	removeAccessGroupRuleOptions := iamAccessGroupsClient.NewRemoveAccessGroupRuleOptions(grpID, ruleID)
-	detailedResponse, err := iamAccessGroupsClient.RemoveAccessGroupRule(removeAccessGroupRuleOptions)
+	detailedResponse, err := iamAccessGroupsClient.RemoveAccessGroupRuleWithContext(ctx, removeAccessGroupRuleOptions)
 	if err != nil {
 		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting group(%s) rule(%s). API Response: %s", grpID, ruleID, detailedResponse)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting group(%s) rule(%s). API Response: %s", grpID, ruleID, detailedResponse))
 	}
 
 	return nil
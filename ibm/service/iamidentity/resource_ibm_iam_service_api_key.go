@@ -4,6 +4,7 @@
 package iamidentity
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -13,18 +14,19 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
 
 func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMServiceAPIkeyCreate,
-		Read:     resourceIBMIAMServiceAPIKeyRead,
-		Update:   resourceIBMIAMServiceAPIKeyUpdate,
-		Delete:   resourceIBMIAMServiceAPIKeyDelete,
-		Exists:   resourceIBMIAMServiceAPIKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMServiceAPIkeyCreate,
+		ReadContext:   resourceIBMIAMServiceAPIKeyRead,
+		UpdateContext: resourceIBMIAMServiceAPIKeyUpdate,
+		DeleteContext: resourceIBMIAMServiceAPIKeyDelete,
+		Exists:        resourceIBMIAMServiceAPIKeyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -124,10 +126,10 @@ type APIKey struct {
 	Locked      bool
 }
 
-func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIkeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get("name").(string)
@@ -145,7 +147,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	createAPIKeyOptions.AccountID = &userDetails.UserAccount
 
@@ -164,9 +166,9 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		createAPIKeyOptions.EntityLock = &elockstr
 	}
 
-	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
+	apiKey, response, err := iamIdentityClient.CreateAPIKeyWithContext(ctx, createAPIKeyOptions)
 	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response))
 	}
 
 	d.SetId(*apiKey.ID)
@@ -178,13 +180,13 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+	return resourceIBMIAMServiceAPIKeyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	apiKeyID := d.Id()
 
@@ -192,13 +194,13 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 		ID: &apiKeyID,
 	}
 
-	apiKey, response, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
+	apiKey, response, err := iamIdentityClient.GetAPIKeyWithContext(ctx, getAPIKeyOptions)
 	if err != nil || apiKey == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response))
 	}
 	if apiKey.Name != nil {
 		d.Set("name", *apiKey.Name)
@@ -237,11 +239,11 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	apiKeyID := d.Id()
 
@@ -249,9 +251,9 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 		ID: &apiKeyID,
 	}
 
-	apiKey, resp, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
+	apiKey, resp, err := iamIdentityClient.GetAPIKeyWithContext(ctx, getAPIKeyOptions)
 	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, resp)
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, resp))
 	}
 
 	updateAPIKeyOptions := &iamidentityv1.UpdateAPIKeyOptions{
@@ -273,20 +275,20 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 		hasChange = true
 	}
 	if hasChange {
-		_, response, err := iamIdentityClient.UpdateAPIKey(updateAPIKeyOptions)
+		_, response, err := iamIdentityClient.UpdateAPIKeyWithContext(ctx, updateAPIKeyOptions)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] Error updating Service API Key: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[DEBUG] Error updating Service API Key: %s\n%s", err, response))
 		}
 	}
 
-	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+	return resourceIBMIAMServiceAPIKeyRead(ctx, d, meta)
 
 }
 
-func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	apiKeyID := d.Id()
 
@@ -294,22 +296,22 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 		ID: &apiKeyID,
 	}
 
-	_, response, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
+	_, response, err := iamIdentityClient.GetAPIKeyWithContext(ctx, getAPIKeyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response))
 	}
 
 	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
 		ID: &apiKeyID,
 	}
 
-	resp, err := iamIdentityClient.DeleteAPIKey(deleteAPIKeyOptions)
+	resp, err := iamIdentityClient.DeleteAPIKeyWithContext(ctx, deleteAPIKeyOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp)
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp))
 	}
 	d.SetId("")
 
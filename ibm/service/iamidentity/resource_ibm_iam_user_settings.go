@@ -4,12 +4,14 @@
 package iamidentity
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,12 +22,12 @@ const (
 
 func ResourceIBMIAMUserSettings() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMUserSettingsCreate,
-		Read:     resourceIBMIAMUserSettingsRead,
-		Update:   resourceIBMIAMUserSettingsUpdate,
-		Delete:   resourceIBMIAMUserSettingsDelete,
-		Exists:   resourceIBMIAMUserSettingsExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMUserSettingsCreate,
+		ReadContext:   resourceIBMIAMUserSettingsRead,
+		UpdateContext: resourceIBMIAMUserSettingsUpdate,
+		DeleteContext: resourceIBMIAMUserSettingsDelete,
+		Exists:        resourceIBMIAMUserSettingsExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -47,10 +49,10 @@ func ResourceIBMIAMUserSettings() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMUserSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	client := userManagement.UserInvite()
 
@@ -59,12 +61,12 @@ func resourceIBMIAMUserSettingsCreate(d *schema.ResourceData, meta interface{})
 	//Read from Bluemix UserConfig
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	iamID, err := flex.GetIBMUniqueId(accountID, userEmail, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	UserSettingsPayload := v2.UserSettingOptions{}
@@ -81,12 +83,12 @@ func resourceIBMIAMUserSettingsCreate(d *schema.ResourceData, meta interface{})
 	_, UserSettingError := client.ManageUserSettings(accountID, iamID, UserSettingsPayload)
 
 	if UserSettingError != nil && !strings.Contains(UserSettingError.Error(), "EmptyResponseBody") {
-		return fmt.Errorf("[ERROR] Error occured during user settings: %s", UserSettingError)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error occured during user settings: %s", UserSettingError))
 	}
 
 	d.SetId(userEmail)
 
-	return resourceIBMIAMUserSettingsRead(d, meta)
+	return resourceIBMIAMUserSettingsRead(ctx, d, meta)
 }
 
 func getUserAccountID(d *schema.ResourceData, meta interface{}) (string, error) {
@@ -97,26 +99,26 @@ func getUserAccountID(d *schema.ResourceData, meta interface{}) (string, error)
 	return userDetails.UserAccount, nil
 }
 
-func resourceIBMIAMUserSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	client := userManagement.UserInvite()
 
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	iamID, err := flex.GetIBMUniqueId(accountID, d.Id(), meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	UserSettings, UserSettingError := client.GetUserSettings(accountID, iamID)
 	if UserSettingError != nil {
-		return UserSettingError
+		return diag.FromErr(UserSettingError)
 	}
 
 	iplist := strings.Split(UserSettings.AllowedIPAddresses, ",")
@@ -126,22 +128,22 @@ func resourceIBMIAMUserSettingsRead(d *schema.ResourceData, meta interface{}) er
 
 }
 
-func resourceIBMIAMUserSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// validate change
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	client := userManagement.UserInvite()
 
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	iamID, err := flex.GetIBMUniqueId(accountID, d.Id(), meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hasChanged := false
@@ -163,35 +165,35 @@ func resourceIBMIAMUserSettingsUpdate(d *schema.ResourceData, meta interface{})
 	if hasChanged {
 		_, UserSettingError := client.ManageUserSettings(accountID, iamID, userSettingPayload)
 		if UserSettingError != nil && !strings.Contains(UserSettingError.Error(), "EmptyResponseBody") {
-			return fmt.Errorf("[ERROR] Error occured during user settings: %s", UserSettingError)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error occured during user settings: %s", UserSettingError))
 		}
 	}
 
-	return resourceIBMIAMUserSettingsRead(d, meta)
+	return resourceIBMIAMUserSettingsRead(ctx, d, meta)
 }
 
-func resourceIBMIAMUserSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	client := userManagement.UserInvite()
 
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	iamID, err := flex.GetIBMUniqueId(accountID, d.Id(), meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userSettingPayload := v2.UserSettingOptions{}
 
 	_, UserSettingError := client.ManageUserSettings(accountID, iamID, userSettingPayload)
 	if UserSettingError != nil && !strings.Contains(UserSettingError.Error(), "EmptyResponseBody") {
-		return fmt.Errorf("[ERROR] Error occured during user settings: %s", UserSettingError)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error occured during user settings: %s", UserSettingError))
 	}
 
 	return nil
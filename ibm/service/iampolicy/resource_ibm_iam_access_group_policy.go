@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -11,17 +12,18 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMIAMAccessGroupPolicyCreate,
-		Read:   resourceIBMIAMAccessGroupPolicyRead,
-		Update: resourceIBMIAMAccessGroupPolicyUpdate,
-		Delete: resourceIBMIAMAccessGroupPolicyDelete,
-		Exists: resourceIBMIAMAccessGroupPolicyExists,
+		CreateContext: resourceIBMIAMAccessGroupPolicyCreate,
+		ReadContext:   resourceIBMIAMAccessGroupPolicyRead,
+		UpdateContext: resourceIBMIAMAccessGroupPolicyUpdate,
+		DeleteContext: resourceIBMIAMAccessGroupPolicyDelete,
+		Exists:        resourceIBMIAMAccessGroupPolicyExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				resources, resourceAttributes, err := importAccessGroupPolicy(d, meta)
@@ -196,23 +198,23 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accessGroupId := d.Get("access_group_id").(string)
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var policyOptions iampolicymanagementv1.CreatePolicyOptions
 	policyOptions, err = flex.GeneratePolicyOptions(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Keep configuring the policy options by adding subject part
@@ -251,9 +253,9 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicyWithContext(ctx, createPolicyOptions)
 	if err != nil || accessGroupPolicy == nil {
-		return fmt.Errorf("[ERROR] Error creating access group policy: %s\n%s", err, res)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating access group policy: %s\n%s", err, res))
 	}
 
 	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
@@ -264,9 +266,9 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 		getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -277,27 +279,27 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, res, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
-		return fmt.Errorf("[ERROR] Error fetching access group policy: %s\n%s", err, res)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching access group policy: %s\n%s", err, res))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
 
-	return resourceIBMIAMAccessGroupPolicyRead(d, meta)
+	return resourceIBMIAMAccessGroupPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	accessGroupId := parts[0]
 	accessGroupPolicyId := parts[1]
@@ -312,9 +314,9 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 
 	accessGroupPolicy := &iampolicymanagementv1.Policy{}
 	res := &core.DetailedResponse{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var err error
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 		if err != nil || accessGroupPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -325,15 +327,15 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 	}
 	if err != nil || accessGroupPolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res))
 	}
 
 	retrievedAttribute := flex.GetSubjectAttribute("access_group_id", accessGroupPolicy.Subjects[0])
 	if accessGroupId != *retrievedAttribute {
-		return fmt.Errorf("[ERROR] Policy %s does not belong to access group %s, retrievedAttr: %s", accessGroupPolicyId, accessGroupId, *retrievedAttribute)
+		return diag.FromErr(fmt.Errorf("[ERROR] Policy %s does not belong to access group %s, retrievedAttr: %s", accessGroupPolicyId, accessGroupId, *retrievedAttribute))
 	}
 
 	d.Set("access_group_id", accessGroupId)
@@ -375,29 +377,29 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		accessGroupId := parts[0]
 		accessGroupPolicyId := parts[1]
 
 		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		var policyOptions iampolicymanagementv1.CreatePolicyOptions
 		policyOptions, err = flex.GeneratePolicyOptions(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		accessGroupIdSubject := &iampolicymanagementv1.PolicySubject{
@@ -437,24 +439,24 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		_, res, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, res, err := iamPolicyManagementClient.UpdatePolicyWithContext(ctx, updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating access group policy: %s\n%s", err, res)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating access group policy: %s\n%s", err, res))
 		}
 	}
 
-	return resourceIBMIAMAccessGroupPolicyRead(d, meta)
+	return resourceIBMIAMAccessGroupPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accessGroupPolicyId := parts[1]
@@ -467,9 +469,9 @@ func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interfac
 		deletePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	res, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	res, err := iamPolicyManagementClient.DeletePolicyWithContext(ctx, deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting access group policy: %s\n%s", err, res)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting access group policy: %s\n%s", err, res))
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,17 +13,18 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMIAMAuthorizationPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMAuthorizationPolicyCreate,
-		Read:     resourceIBMIAMAuthorizationPolicyRead,
-		Update:   resourceIBMIAMAuthorizationPolicyUpdate,
-		Delete:   resourceIBMIAMAuthorizationPolicyDelete,
-		Exists:   resourceIBMIAMAuthorizationPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMAuthorizationPolicyCreate,
+		ReadContext:   resourceIBMIAMAuthorizationPolicyRead,
+		UpdateContext: resourceIBMIAMAuthorizationPolicyUpdate,
+		DeleteContext: resourceIBMIAMAuthorizationPolicyDelete,
+		Exists:        resourceIBMIAMAuthorizationPolicyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"source_service_name": {
@@ -186,7 +188,7 @@ func ResourceIBMIAMAuthorizationPolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	var sourceServiceName, targetServiceName string
 	policyType := "authorization"
@@ -195,12 +197,12 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// check subject_attributes exists
@@ -329,17 +331,17 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 		SourceServiceName: &sourceServiceName,
 		PolicyType:        &policyType,
 	}
-	roleList, resp, err := iampapClient.ListRoles(listRoleOptions)
+	roleList, resp, err := iampapClient.ListRolesWithContext(ctx, listRoleOptions)
 
 	if err != nil || roleList == nil {
-		return fmt.Errorf("[ERROR] Error in listing roles %s, %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in listing roles %s, %s", err, resp))
 	}
 
 	policyRoles := flex.MapRoleListToPolicyRoles(*roleList)
 	roles, err := flex.GetRolesFromRoleNames(flex.ExpandStringList(d.Get("roles").([]interface{})), policyRoles)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createPolicyOptions := iampapClient.NewCreatePolicyOptions(
@@ -358,21 +360,21 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	authPolicy, resp, err := iampapClient.CreatePolicy(createPolicyOptions)
+	authPolicy, resp, err := iampapClient.CreatePolicyWithContext(ctx, createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating authorization policy: %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating authorization policy: %s %s", err, resp))
 	}
 
 	d.SetId(*authPolicy.ID)
 
-	return resourceIBMIAMAuthorizationPolicyRead(d, meta)
+	return resourceIBMIAMAuthorizationPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
@@ -383,9 +385,9 @@ func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interfac
 		getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	authorizationPolicy, resp, err := iampapClient.GetPolicy(getPolicyOptions)
+	authorizationPolicy, resp, err := iampapClient.GetPolicyWithContext(ctx, getPolicyOptions)
 	if err != nil || resp == nil {
-		return fmt.Errorf("[ERROR] Error retrieving authorizationPolicy: %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving authorizationPolicy: %s %s", err, resp))
 	}
 	roles := make([]string, len(authorizationPolicy.Roles))
 	for i, role := range authorizationPolicy.Roles {
@@ -418,14 +420,14 @@ func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interfac
 }
 
 // Returns nil, because ibmcloud iam cli authorization policy does not have an update command
-func resourceIBMIAMAuthorizationPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMIAMAuthorizationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	authorizationPolicyID := d.Id()
@@ -438,7 +440,7 @@ func resourceIBMIAMAuthorizationPolicyDelete(d *schema.ResourceData, meta interf
 		deletePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	resp, err := iampapClient.DeletePolicy(deletePolicyOptions)
+	resp, err := iampapClient.DeletePolicyWithContext(ctx, deletePolicyOptions)
 	if err != nil {
 		log.Printf(
 			"Error deleting authorization policy: %s, %s", err, resp)
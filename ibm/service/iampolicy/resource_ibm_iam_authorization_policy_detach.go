@@ -4,19 +4,21 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMIAMAuthorizationPolicyDetach() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMIAMAuthorizationPolicyDetachCreate,
-		Read:   resourceIBMIAMAuthorizationPolicyDetachRead,
-		Delete: resourceIBMIAMAuthorizationPolicyDetachDelete,
-		Exists: resourceIBMIAMAuthorizationPolicyDetachExists,
+		CreateContext: resourceIBMIAMAuthorizationPolicyDetachCreate,
+		ReadContext:   resourceIBMIAMAuthorizationPolicyDetachRead,
+		DeleteContext: resourceIBMIAMAuthorizationPolicyDetachDelete,
+		Exists:        resourceIBMIAMAuthorizationPolicyDetachExists,
 
 		Schema: map[string]*schema.Schema{
 			"authorization_policy_id": {
@@ -29,31 +31,31 @@ func ResourceIBMIAMAuthorizationPolicyDetach() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAuthorizationPolicyDetachCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDetachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	policyID := d.Get("authorization_policy_id").(string)
 
 	deletePolicyOptions := iampapClient.NewDeletePolicyOptions(
 		policyID,
 	)
-	_, err = iampapClient.DeletePolicy(deletePolicyOptions)
+	_, err = iampapClient.DeletePolicyWithContext(ctx, deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error detaching authorization policy: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error detaching authorization policy: %s", err))
 	}
 
 	d.SetId(time.Now().UTC().String())
 
-	return resourceIBMIAMAuthorizationPolicyDetachRead(d, meta)
+	return resourceIBMIAMAuthorizationPolicyDetachRead(ctx, d, meta)
 }
 
-func resourceIBMIAMAuthorizationPolicyDetachRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDetachRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMIAMAuthorizationPolicyDetachDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDetachDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId("")
 
@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,12 +26,12 @@ const (
 
 func ResourceIBMIAMCustomRole() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMCustomRoleCreate,
-		Read:     resourceIBMIAMCustomRoleRead,
-		Update:   resourceIBMIAMCustomRoleUpdate,
-		Delete:   resourceIBMIAMCustomRoleDelete,
-		Exists:   resourceIBMIAMCustomRoleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMCustomRoleCreate,
+		ReadContext:   resourceIBMIAMCustomRoleRead,
+		UpdateContext: resourceIBMIAMCustomRoleUpdate,
+		DeleteContext: resourceIBMIAMCustomRoleDelete,
+		Exists:        resourceIBMIAMCustomRoleExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			iamCRDisplayName: {
@@ -123,10 +125,10 @@ func ResourceIBMIAMCustomRoleValidator() *validate.ResourceValidator {
 	return &ibmIAMCustomRoleResourceValidator
 }
 
-func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get(iamCRDisplayName).(string)
@@ -137,7 +139,7 @@ func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) er
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	roleOptions := &iampolicymanagementv1.CreateRoleOptions{
@@ -149,20 +151,20 @@ func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) er
 		Description: &description,
 	}
 
-	role, response, err := iamPolicyManagementClient.CreateRole(roleOptions)
+	role, response, err := iamPolicyManagementClient.CreateRoleWithContext(ctx, roleOptions)
 	if err != nil || role == nil {
-		return fmt.Errorf("[ERROR] Error creating Custom Roles: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Custom Roles: %s\n%s", err, response))
 	}
 
 	d.SetId(*role.ID)
 
-	return resourceIBMIAMCustomRoleRead(d, meta)
+	return resourceIBMIAMCustomRoleRead(ctx, d, meta)
 }
 
-func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	roleID := d.Id()
@@ -170,13 +172,13 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 		RoleID: &roleID,
 	}
 
-	role, response, err := iamPolicyManagementClient.GetRole(roleOptions)
+	role, response, err := iamPolicyManagementClient.GetRoleWithContext(ctx, roleOptions)
 	if err != nil || role == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error retrieving Custom Roles: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Custom Roles: %s\n%s", err, response))
 	}
 
 	d.Set(iamCRDisplayName, role.DisplayName)
@@ -190,7 +192,7 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set(flex.ResourceCRN, role.CRN)
 	rcontroller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(flex.ResourceControllerURL, rcontroller+"/iam/roles")
@@ -198,11 +200,11 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	roleID := d.Id()
 
@@ -215,13 +217,13 @@ func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) er
 			RoleID: &roleID,
 		}
 
-		role, response, err := iamPolicyManagementClient.GetRole(roleGetOptions)
+		role, response, err := iamPolicyManagementClient.GetRoleWithContext(ctx, roleGetOptions)
 		if err != nil || role == nil {
 			if response != nil && response.StatusCode == 404 {
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Custom Roles: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Custom Roles: %s\n%s", err, response))
 		}
 
 		roleETag := response.Headers.Get("ETag")
@@ -233,19 +235,19 @@ func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) er
 			Actions:     updatedActions,
 		}
 
-		_, response, err = iamPolicyManagementClient.UpdateRole(roleUpdateOptions)
+		_, response, err = iamPolicyManagementClient.UpdateRoleWithContext(ctx, roleUpdateOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Custom Roles: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Custom Roles: %s\n%s", err, response))
 		}
 	}
 
-	return resourceIBMIAMCustomRoleRead(d, meta)
+	return resourceIBMIAMCustomRoleRead(ctx, d, meta)
 }
 
-func resourceIBMIAMCustomRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	roleID := d.Id()
@@ -253,9 +255,9 @@ func resourceIBMIAMCustomRoleDelete(d *schema.ResourceData, meta interface{}) er
 		RoleID: &roleID,
 	}
 
-	response, err := iamPolicyManagementClient.DeleteRole(roleDeleteOptions)
+	response, err := iamPolicyManagementClient.DeleteRoleWithContext(ctx, roleDeleteOptions)
 	if err != nil && !strings.Contains(err.Error(), "404") {
-		return fmt.Errorf("[ERROR] Error deleting Custom Roles: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Custom Roles: %s\n%s", err, response))
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -13,17 +14,18 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMIAMServicePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMIAMServicePolicyCreate,
-		Read:   resourceIBMIAMServicePolicyRead,
-		Update: resourceIBMIAMServicePolicyUpdate,
-		Delete: resourceIBMIAMServicePolicyDelete,
-		Exists: resourceIBMIAMServicePolicyExists,
+		CreateContext: resourceIBMIAMServicePolicyCreate,
+		ReadContext:   resourceIBMIAMServicePolicyRead,
+		UpdateContext: resourceIBMIAMServicePolicyUpdate,
+		DeleteContext: resourceIBMIAMServicePolicyDelete,
+		Exists:        resourceIBMIAMServicePolicyExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				resources, resourceAttributes, err := importServicePolicy(d, meta)
@@ -200,7 +202,7 @@ func ResourceIBMIAMServicePolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServicePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	var iamID string
 	if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
@@ -208,14 +210,14 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 		iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		getServiceIDOptions := iamidentityv1.GetServiceIDOptions{
 			ID: &serviceIDUUID,
 		}
-		serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
+		serviceID, resp, err := iamClient.GetServiceIDWithContext(ctx, &getServiceIDOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp))
 		}
 		iamID = *serviceID.IamID
 	}
@@ -225,12 +227,12 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	policyOptions, err := flex.GeneratePolicyOptions(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subjectAttribute := &iampolicymanagementv1.SubjectAttribute{
@@ -255,7 +257,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions(
@@ -274,9 +276,9 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	servicePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	servicePolicy, res, err := iamPolicyManagementClient.CreatePolicyWithContext(ctx, createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating servicePolicy: %s %s", err, res)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating servicePolicy: %s %s", err, res))
 	}
 
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
@@ -287,9 +289,9 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -301,7 +303,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, res, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 	}
 	if err != nil {
 		if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
@@ -311,7 +313,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 			iamID := v.(string)
 			d.SetId(fmt.Sprintf("%s/%s", iamID, *servicePolicy.ID))
 		}
-		return fmt.Errorf("[ERROR] Error fetching service  policy: %s %s", err, res)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching service  policy: %s %s", err, res))
 	}
 	if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
 		serviceIDUUID := v.(string)
@@ -321,19 +323,19 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		d.SetId(fmt.Sprintf("%s/%s", iamID, *servicePolicy.ID))
 	}
 
-	return resourceIBMIAMServicePolicyRead(d, meta)
+	return resourceIBMIAMServicePolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServicePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serviceIDUUID := parts[0]
 	servicePolicyID := parts[1]
@@ -347,9 +349,9 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 		getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var err error
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 
 		if err != nil || servicePolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -361,10 +363,10 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 	}
 	if err != nil || servicePolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s %s", err, res)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s %s", err, res))
 	}
 	if strings.HasPrefix(serviceIDUUID, "iam-") {
 		d.Set("iam_id", serviceIDUUID)
@@ -408,13 +410,13 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServicePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		servicePolicyID := parts[1]
 
@@ -424,7 +426,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 			iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			getServiceIDOptions := iamidentityv1.GetServiceIDOptions{
 				ID: &serviceIDUUID,
@@ -434,9 +436,9 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 				getServiceIDOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 			}
 
-			serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
+			serviceID, resp, err := iamClient.GetServiceIDWithContext(ctx, &getServiceIDOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp))
 			}
 			iamID = *serviceID.IamID
 		}
@@ -446,12 +448,12 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		createPolicyOptions, err := flex.GeneratePolicyOptions(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		accountIDResourceAttribute := &iampolicymanagementv1.ResourceAttribute{
@@ -475,7 +477,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 		iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
@@ -486,12 +488,12 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, response, err := iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response))
 		}
 
 		servicePolicyETag := response.Headers.Get("ETag")
@@ -512,26 +514,26 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 		if transactionID, ok := d.GetOk("transaction_id"); ok {
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
-		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, _, err = iamPolicyManagementClient.UpdatePolicyWithContext(ctx, updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating service policy: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating service policy: %s", err))
 		}
 
 	}
 
-	return resourceIBMIAMServicePolicyRead(d, meta)
+	return resourceIBMIAMServicePolicyRead(ctx, d, meta)
 
 }
 
-func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServicePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	servicePolicyID := parts[1]
 
@@ -543,9 +545,9 @@ func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{})
 		deletePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	_, err = iamPolicyManagementClient.DeletePolicyWithContext(ctx, deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting service policy: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting service policy: %s", err))
 	}
 
 	d.SetId("")
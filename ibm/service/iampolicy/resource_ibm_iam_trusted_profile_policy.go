@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -13,17 +14,18 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMIAMTrustedProfilePolicyCreate,
-		Read:   resourceIBMIAMTrustedProfilePolicyRead,
-		Update: resourceIBMIAMTrustedProfilePolicyUpdate,
-		Delete: resourceIBMIAMTrustedProfilePolicyDelete,
-		Exists: resourceIBMIAMTrustedProfilePolicyExists,
+		CreateContext: resourceIBMIAMTrustedProfilePolicyCreate,
+		ReadContext:   resourceIBMIAMTrustedProfilePolicyRead,
+		UpdateContext: resourceIBMIAMTrustedProfilePolicyUpdate,
+		DeleteContext: resourceIBMIAMTrustedProfilePolicyDelete,
+		Exists:        resourceIBMIAMTrustedProfilePolicyExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				resources, resourceAttributes, err := importTrustedProfilePolicy(d, meta)
@@ -200,7 +202,7 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMTrustedProfilePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	var iamID string
 	if v, ok := d.GetOk("profile_id"); ok && v != nil {
@@ -208,14 +210,14 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 
 		iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		getProfileOptions := &iamidentityv1.GetProfileOptions{
 			ProfileID: &profileIDUUID,
 		}
-		profileID, resp, err := iamClient.GetProfile(getProfileOptions)
+		profileID, resp, err := iamClient.GetProfileWithContext(ctx, getProfileOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error] Error getting trusted profile ID %s %s", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error] Error getting trusted profile ID %s %s", err, resp))
 		}
 		iamID = *profileID.IamID
 	}
@@ -225,12 +227,12 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	policyOptions, err := flex.GeneratePolicyOptions(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subjectAttribute := &iampolicymanagementv1.SubjectAttribute{
@@ -255,7 +257,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions(
@@ -274,9 +276,9 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	trustedProfilePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	trustedProfilePolicy, res, err := iamPolicyManagementClient.CreatePolicyWithContext(ctx, createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating trustedProfilePolicy: %s %s", err, res)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating trustedProfilePolicy: %s %s", err, res))
 	}
 
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
@@ -287,9 +289,9 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -301,7 +303,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, res, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 	}
 	if err != nil {
 		if v, ok := d.GetOk("profile_id"); ok && v != nil {
@@ -311,7 +313,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 			iamID := v.(string)
 			d.SetId(fmt.Sprintf("%s/%s", iamID, *trustedProfilePolicy.ID))
 		}
-		return fmt.Errorf("[ERROR] Error fetching trusted profile policy: %s %s", err, res)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching trusted profile policy: %s %s", err, res))
 	}
 	if v, ok := d.GetOk("profile_id"); ok && v != nil {
 		profileIDUUID := v.(string)
@@ -321,19 +323,19 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		d.SetId(fmt.Sprintf("%s/%s", iamID, *trustedProfilePolicy.ID))
 	}
 
-	return resourceIBMIAMTrustedProfilePolicyRead(d, meta)
+	return resourceIBMIAMTrustedProfilePolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMTrustedProfilePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	profileIDUUID := parts[0]
 	trustedProfilePolicyID := parts[1]
@@ -346,9 +348,9 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 		getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var err error
-		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 
 		if err != nil || trustedProfilePolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -360,10 +362,10 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 	}
 	if err != nil || trustedProfilePolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, res)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, res))
 	}
 	if strings.HasPrefix(profileIDUUID, "iam-") {
 		d.Set("iam_id", profileIDUUID)
@@ -406,13 +408,13 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMTrustedProfilePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		trustedProfilePolicyID := parts[1]
 
@@ -422,14 +424,14 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 
 			iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			getProfileIDOptions := iamidentityv1.GetProfileOptions{
 				ProfileID: &profileIDUUID,
 			}
-			profileID, resp, err := iamClient.GetProfile(&getProfileIDOptions)
+			profileID, resp, err := iamClient.GetProfileWithContext(ctx, &getProfileIDOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error] Error getting trusted profile ID %s %s", err, resp)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error] Error getting trusted profile ID %s %s", err, resp))
 			}
 			iamID = *profileID.IamID
 		}
@@ -439,12 +441,12 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 
 		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		createPolicyOptions, err := flex.GeneratePolicyOptions(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		accountIDResourceAttribute := &iampolicymanagementv1.ResourceAttribute{
@@ -468,7 +470,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 
 		iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
@@ -479,12 +481,12 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, response, err := iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response))
 		}
 
 		trustedProfilePolicyETag := response.Headers.Get("ETag")
@@ -506,26 +508,26 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, resp, err := iamPolicyManagementClient.UpdatePolicyWithContext(ctx, updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating trusted profile policy: %s: %s", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating trusted profile policy: %s: %s", err, resp))
 		}
 
 	}
 
-	return resourceIBMIAMTrustedProfilePolicyRead(d, meta)
+	return resourceIBMIAMTrustedProfilePolicyRead(ctx, d, meta)
 
 }
 
-func resourceIBMIAMTrustedProfilePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMTrustedProfilePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	trustedProfilePolicyID := parts[1]
 
@@ -537,9 +539,9 @@ func resourceIBMIAMTrustedProfilePolicyDelete(d *schema.ResourceData, meta inter
 		deletePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	resp, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	resp, err := iamPolicyManagementClient.DeletePolicyWithContext(ctx, deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting trusted profile policy: %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting trusted profile policy: %s %s", err, resp))
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -134,12 +136,12 @@ var permissionSets = map[string][]string{NOACCESS: noAccess, VIEWONLY: viewOnly,
 
 func ResourceIBMIAMUserInvite() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMInviteUsers,
-		Read:     resourceIBMIAMGetUsers,
-		Update:   resourceIBMIAMUpdateUserProfile,
-		Delete:   resourceIBMIAMRemoveUser,
-		Exists:   resourceIBMIAMGetUserProfileExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMInviteUsers,
+		ReadContext:   resourceIBMIAMGetUsers,
+		UpdateContext: resourceIBMIAMUpdateUserProfile,
+		DeleteContext: resourceIBMIAMRemoveUser,
+		Exists:        resourceIBMIAMGetUserProfileExists,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 
 			"users": {
@@ -482,10 +484,10 @@ func ResourceIBMIAMUserInvite() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMInviteUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	client := userManagement.UserInvite()
 
@@ -496,7 +498,7 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 		users = append(users, v2.User{Email: user, AccountRole: MEMBER})
 	}
 	if len(users) == 0 {
-		return fmt.Errorf("[ERROR] Users email not provided")
+		return diag.FromErr(fmt.Errorf("[ERROR] Users email not provided"))
 	}
 	var accessGroups = make([]string, 0)
 	if data, ok := d.GetOk("access_groups"); ok {
@@ -507,10 +509,10 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 
 	var accessPolicies []v2.UserPolicy
 	if accessPolicyData, ok := d.GetOk("iam_policy"); ok {
-		accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
+		accessPolicies, err = getPolicies(ctx, d, meta, accessPolicyData.([]interface{}))
 		if err != nil {
 			log.Println("IAM Acess policy: ", err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -529,7 +531,7 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 	}
 	orgRoles, err := getCloudFoundryRoles(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(orgRoles) != 0 {
 		inviteUserPayload.OrganizationRoles = orgRoles
@@ -537,38 +539,38 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 
 	accountID, err := getAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, InviteUserError := client.InviteUsers(accountID, inviteUserPayload)
 	if InviteUserError != nil {
-		return InviteUserError
+		return diag.FromErr(InviteUserError)
 	}
 	d.SetId(time.Now().UTC().String())
-	return resourceIBMIAMUpdateUserProfile(d, meta)
+	return resourceIBMIAMUpdateUserProfile(ctx, d, meta)
 }
 
-func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMGetUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	Client := userManagement.UserInvite()
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	accountID, err := getAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	res, err := Client.ListUsers(accountID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	users := make([]string, 0)
 	invitedUsers := make([]map[string]interface{}, 0, len(res))
@@ -586,7 +588,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		            > acees group level policies
 		********************************************/
 		//Get User level IAM policies
-		policyList, _, err := iamPolicyManagementClient.ListPolicies(&iampolicymanagementv1.ListPoliciesOptions{
+		policyList, _, err := iamPolicyManagementClient.ListPoliciesWithContext(ctx, &iampolicymanagementv1.ListPoliciesOptions{
 			AccountID: core.StringPtr(accountID),
 			IamID:     core.StringPtr(user.IamID),
 			Type:      core.StringPtr("access"),
@@ -594,7 +596,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		policies := policyList.Policies
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving user policies: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving user policies: %s", err))
 		}
 		userPolicies := make([]map[string]interface{}, 0, len(policies))
 		for _, policy := range policies {
@@ -619,21 +621,21 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 			AccountID: &accountID,
 			IamID:     &user.IamID,
 		}
-		retreivedGroups, _, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupOptions)
+		retreivedGroups, _, err := iamAccessGroupsClient.ListAccessGroupsWithContext(ctx, listAccessGroupOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving access groups: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access groups: %s", err))
 		}
 
 		accGroupList := make([]map[string]interface{}, 0, len(retreivedGroups.Groups))
 		//Get the policies for each access group
 		for _, grpData := range retreivedGroups.Groups {
-			policyList, _, err := iamPolicyManagementClient.ListPolicies(&iampolicymanagementv1.ListPoliciesOptions{
+			policyList, _, err := iamPolicyManagementClient.ListPoliciesWithContext(ctx, &iampolicymanagementv1.ListPoliciesOptions{
 				AccountID:     core.StringPtr(accountID),
 				AccessGroupID: core.StringPtr(user.IamID),
 			})
 			accgrpPolicy := policyList.Policies
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error retrieving access group policy: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group policy: %s", err))
 			}
 
 			//Fetch access group policies
@@ -674,11 +676,11 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUpdateUserProfile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// validate change
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	Client := userManagement.UserInvite()
 
@@ -686,7 +688,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 		//var removedUsers, addedUsers []string
 		accountID, err := getAccountID(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ousrs, nusrs := d.GetChange("users")
 		old := ousrs.(*schema.Set)
@@ -702,15 +704,15 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 				users = append(users, v2.User{Email: user, AccountRole: MEMBER})
 			}
 			if len(users) == 0 {
-				return fmt.Errorf("[ERROR] Users email not provided")
+				return diag.FromErr(fmt.Errorf("[ERROR] Users email not provided"))
 			}
 
 			var accessPolicies []v2.UserPolicy
 			if accessPolicyData, ok := d.GetOk("iam_policy"); ok {
-				accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
+				accessPolicies, err = getPolicies(ctx, d, meta, accessPolicyData.([]interface{}))
 				if err != nil {
 					log.Println("IAM Acess policy: ", err.Error())
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
@@ -724,7 +726,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 			infraPermissions := getInfraPermissions(d, meta)
 			orgRoles, err := getCloudFoundryRoles(d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			inviteUserPayload := v2.UserInvite{}
@@ -744,7 +746,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 			}
 			_, InviteUserError := Client.InviteUsers(accountID, inviteUserPayload)
 			if InviteUserError != nil {
-				return InviteUserError
+				return diag.FromErr(InviteUserError)
 			}
 		}
 
@@ -753,30 +755,30 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 			for _, user := range removed {
 				IAMID, err := getUserIAMID(d, meta, user)
 				if err != nil {
-					return fmt.Errorf("[ERROR] User's IAM ID not found: %s", err.Error())
+					return diag.FromErr(fmt.Errorf("[ERROR] User's IAM ID not found: %s", err.Error()))
 				}
 				Err := Client.RemoveUsers(accountID, IAMID)
 				if Err != nil {
 					log.Println("Failed to remove user: ", user)
-					return Err
+					return diag.FromErr(Err)
 				}
 			}
 		}
 
 	}
-	return resourceIBMIAMGetUsers(d, meta)
+	return resourceIBMIAMGetUsers(ctx, d, meta)
 }
 
-func resourceIBMIAMRemoveUser(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMRemoveUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	Client := userManagement.UserInvite()
 
 	accountID, err := getAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	usersSet := d.Get("users").(*schema.Set)
@@ -785,11 +787,11 @@ func resourceIBMIAMRemoveUser(d *schema.ResourceData, meta interface{}) error {
 		IAMID, err := getUserIAMID(d, meta, user)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] User's IAM ID not found: %s", err.Error())
+			return diag.FromErr(fmt.Errorf("[ERROR] User's IAM ID not found: %s", err.Error()))
 		}
 		Err := Client.RemoveUsers(accountID, IAMID)
 		if Err != nil {
-			return Err
+			return diag.FromErr(Err)
 		}
 	}
 	return nil
@@ -892,7 +894,7 @@ func getInfraPermissions(d *schema.ResourceData, meta interface{}) []string {
 }
 
 // getPolicies ...
-func getPolicies(d *schema.ResourceData, meta interface{}, policies []interface{}) ([]v2.UserPolicy, error) {
+func getPolicies(ctx context.Context, d *schema.ResourceData, meta interface{}, policies []interface{}) ([]v2.UserPolicy, error) {
 	var policyList = make([]v2.UserPolicy, 0)
 	for _, policy := range policies {
 		p := policy.(map[string]interface{})
@@ -1029,7 +1031,7 @@ func getPolicies(d *schema.ResourceData, meta interface{}, policies []interface{
 			ServiceName: &serviceName,
 		}
 
-		roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+		roleList, _, err := iamPolicyManagementClient.ListRolesWithContext(ctx, listRoleOptions)
 		roles := flex.MapRoleListToPolicyRoles(*roleList)
 
 		if err != nil {
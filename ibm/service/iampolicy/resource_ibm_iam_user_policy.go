@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -11,17 +12,18 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMIAMUserPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMIAMUserPolicyCreate,
-		Read:   resourceIBMIAMUserPolicyRead,
-		Update: resourceIBMIAMUserPolicyUpdate,
-		Delete: resourceIBMIAMUserPolicyDelete,
-		Exists: resourceIBMIAMUserPolicyExists,
+		CreateContext: resourceIBMIAMUserPolicyCreate,
+		ReadContext:   resourceIBMIAMUserPolicyRead,
+		UpdateContext: resourceIBMIAMUserPolicyUpdate,
+		DeleteContext: resourceIBMIAMUserPolicyDelete,
+		Exists:        resourceIBMIAMUserPolicyExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				resources, resourceAttributes, err := importUserPolicy(d, meta)
@@ -190,31 +192,31 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var policyOptions iampolicymanagementv1.CreatePolicyOptions
 	policyOptions, err = flex.GeneratePolicyOptions(d, meta)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userEmail := d.Get("ibm_id").(string)
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accountID := userDetails.UserAccount
 
 	ibmUniqueID, err := flex.GetIBMUniqueId(accountID, userEmail, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subjectAttribute := &iampolicymanagementv1.SubjectAttribute{
@@ -253,9 +255,9 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	userPolicy, resp, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	userPolicy, resp, err := iamPolicyManagementClient.CreatePolicyWithContext(ctx, createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error creating user policies: %s, %s", err, resp)
+		return diag.FromErr(fmt.Errorf("Error creating user policies: %s, %s", err, resp))
 	}
 
 	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
@@ -266,9 +268,9 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 		getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -280,26 +282,26 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, _, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", userEmail, *userPolicy.ID))
-		return fmt.Errorf("[ERROR] Error fetching user policy: %w", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching user policy: %w", err))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", userEmail, *userPolicy.ID))
 
-	return resourceIBMIAMUserPolicyRead(d, meta)
+	return resourceIBMIAMUserPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userEmail := parts[0]
@@ -315,9 +317,9 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 
 	userPolicy := &iampolicymanagementv1.Policy{}
 	res := &core.DetailedResponse{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var err error
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 
 		if err != nil || userPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -329,10 +331,10 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 	}
 	if err != nil || userPolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving userPolicy: %s %s", err, res)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving userPolicy: %s %s", err, res))
 	}
 	d.Set("ibm_id", userEmail)
 	roles := make([]string, len(userPolicy.Roles))
@@ -370,34 +372,34 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		userEmail := parts[0]
 		userPolicyID := parts[1]
 
 		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		accountID := userDetails.UserAccount
 
 		createPolicyOptions, err := flex.GeneratePolicyOptions(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		ibmUniqueID, err := flex.GetIBMUniqueId(accountID, userEmail, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		accountIDResourceAttribute := &iampolicymanagementv1.ResourceAttribute{
@@ -427,12 +429,12 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, response, err := iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response))
 		}
 
 		userPolicyETag := response.Headers.Get("ETag")
@@ -454,24 +456,24 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, resp, err := iamPolicyManagementClient.UpdatePolicyWithContext(ctx, updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp))
 		}
 	}
-	return resourceIBMIAMUserPolicyRead(d, meta)
+	return resourceIBMIAMUserPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMUserPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	userPolicyID := parts[1]
 
@@ -483,9 +485,9 @@ func resourceIBMIAMUserPolicyDelete(d *schema.ResourceData, meta interface{}) er
 		deletePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	_, err = iamPolicyManagementClient.DeletePolicyWithContext(ctx, deletePolicyOptions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
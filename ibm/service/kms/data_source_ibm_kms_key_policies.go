@@ -181,7 +181,7 @@ func dataSourceIBMKMSKeyPoliciesRead(context context.Context, d *schema.Resource
 		return diag.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(api, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, api, endpointType, extensions)
 	if err != nil {
 		return diag.FromErr(err)
 	}
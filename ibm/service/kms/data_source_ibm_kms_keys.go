@@ -195,7 +195,7 @@ func dataSourceIBMKMSKeysRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(api, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, api, endpointType, extensions)
 	if err != nil {
 		return err
 	}
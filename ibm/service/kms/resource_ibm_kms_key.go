@@ -16,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	kp "github.com/IBM/keyprotect-go-client"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -31,12 +32,12 @@ func suppressKMSInstanceIDDiff(k, old, new string, d *schema.ResourceData) bool
 
 func ResourceIBMKmskey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKmsKeyCreate,
-		Read:     resourceIBMKmsKeyRead,
-		Update:   resourceIBMKmsKeyUpdate,
-		Delete:   resourceIBMKmsKeyDelete,
-		Exists:   resourceIBMKmsKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMKmsKeyCreate,
+		ReadContext:   resourceIBMKmsKeyRead,
+		UpdateContext: resourceIBMKmsKeyUpdate,
+		DeleteContext: resourceIBMKmsKeyDelete,
+		Exists:        resourceIBMKmsKeyExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -161,10 +162,10 @@ func ResourceIBMKmskey() *schema.Resource {
 	}
 }
 
-func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
@@ -177,20 +178,20 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil || instanceData == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp))
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	kpAPI.URL = URL
 
@@ -207,7 +208,7 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		// parse string to required time format
 		expiration_time, err := time.Parse(time.RFC3339, expiration_string)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Invalid time format (the date format follows RFC 3339): %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Invalid time format (the date format follows RFC 3339): %s", err))
 		}
 		expiration = &expiration_time
 	} else {
@@ -219,18 +220,18 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := d.GetOk("payload"); ok {
 			//import standard key
 			payload := v.(string)
-			stkey, err := kpAPI.CreateImportedStandardKey(context.Background(), name, expiration, payload)
+			stkey, err := kpAPI.CreateImportedStandardKey(ctx, name, expiration, payload)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error while creating standard key with payload: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error while creating standard key with payload: %s", err))
 			}
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
 
 		} else {
 			//create standard key
-			stkey, err := kpAPI.CreateStandardKey(context.Background(), name, expiration)
+			stkey, err := kpAPI.CreateStandardKey(ctx, name, expiration)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error while creating standard key: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error while creating standard key: %s", err))
 			}
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
@@ -241,29 +242,29 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 			payload := v.(string)
 			encryptedNonce := d.Get("encrypted_nonce").(string)
 			iv := d.Get("iv_value").(string)
-			stkey, err := kpAPI.CreateImportedRootKey(context.Background(), name, expiration, payload, encryptedNonce, iv)
+			stkey, err := kpAPI.CreateImportedRootKey(ctx, name, expiration, payload, encryptedNonce, iv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error while creating Root key with payload: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error while creating Root key with payload: %s", err))
 			}
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
 
 		} else {
-			stkey, err := kpAPI.CreateRootKey(context.Background(), name, expiration)
+			stkey, err := kpAPI.CreateRootKey(ctx, name, expiration)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error while creating Root key: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error while creating Root key: %s", err))
 			}
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
 		}
 	}
-	return resourceIBMKmsKeyUpdate(d, meta)
+	return resourceIBMKmsKeyUpdate(ctx, d, meta)
 }
 
-func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
@@ -274,34 +275,34 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil || instanceData == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp))
 	}
 	extensions := instanceData.Extensions
 
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	kpAPI.URL = URL
 
 	kpAPI.Config.InstanceID = instanceID
 	// keyid := d.Id()
-	key, err := kpAPI.GetKey(context.Background(), keyid)
+	key, err := kpAPI.GetKey(ctx, keyid)
 	if err != nil {
 		kpError := err.(*kp.Error)
 		if kpError.StatusCode == 404 || kpError.StatusCode == 409 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Get Key failed with error while reading Key: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Get Key failed with error while reading Key: %s", err))
 	} else if key.State == 5 { //Refers to Deleted state of the Key
 		d.SetId("")
 		return nil
@@ -337,7 +338,7 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(flex.ResourceStatus, strconv.Itoa(state))
 	rcontroller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := key.ID
 	crn1 := strings.TrimSuffix(key.CRN, ":key:"+id)
@@ -348,19 +349,19 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
-	return resourceIBMKmsKeyRead(d, meta)
+	return resourceIBMKmsKeyRead(ctx, d, meta)
 
 }
 
-func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
@@ -370,20 +371,20 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil || instanceData == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp))
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	kpAPI.URL = URL
 	kpAPI.Config.InstanceID = instanceID
@@ -393,9 +394,9 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 		Force: force,
 	}
 
-	_, err1 := kpAPI.DeleteKey(context.Background(), keyid, kp.ReturnRepresentation, f)
+	_, err1 := kpAPI.DeleteKey(ctx, keyid, kp.ReturnRepresentation, f)
 	if err1 != nil {
-		return fmt.Errorf("[ERROR] Error while deleting: %s", err1)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while deleting: %s", err1))
 	}
 	d.SetId("")
 	return nil
@@ -427,7 +428,7 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 		return false, fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
 		return false, err
 	}
@@ -447,7 +448,7 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 }
 
 //Construct KMS URL
-func KmsEndpointURL(kpAPI *kp.Client, endpointType string, extensions map[string]interface{}) (*url.URL, error) {
+func KmsEndpointURL(meta interface{}, kpAPI *kp.Client, endpointType string, extensions map[string]interface{}) (*url.URL, error) {
 
 	exturl := extensions["endpoints"].(map[string]interface{})["public"]
 	if endpointType == "private" || strings.Contains(kpAPI.Config.BaseURL, "private") {
@@ -455,7 +456,7 @@ func KmsEndpointURL(kpAPI *kp.Client, endpointType string, extensions map[string
 	}
 	endpointURL := fmt.Sprintf("%s/api/v2/keys", exturl.(string))
 
-	url1 := conns.EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, endpointURL)
+	url1 := meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_KP_API_ENDPOINT", endpointURL)
 	u, err := url.Parse(url1)
 	if err != nil {
 		return nil, fmt.Errorf("[ERROR] Error Parsing KMS EndpointURL")
@@ -9,15 +9,16 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	kp "github.com/IBM/keyprotect-go-client"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMKmskeyAlias() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKmsKeyAliasCreate,
-		Delete:   resourceIBMKmsKeyAliasDelete,
-		Read:     resourceIBMKmsKeyAliasRead,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMKmsKeyAliasCreate,
+		DeleteContext: resourceIBMKmsKeyAliasDelete,
+		ReadContext:   resourceIBMKmsKeyAliasRead,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -59,10 +60,10 @@ func ResourceIBMKmskeyAlias() *schema.Resource {
 	}
 }
 
-func resourceIBMKmsKeyAliasCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
@@ -74,20 +75,20 @@ func resourceIBMKmsKeyAliasCreate(d *schema.ResourceData, meta interface{}) erro
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil || instanceData == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp))
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	kpAPI.URL = URL
 	kpAPI.Config.InstanceID = instanceID
@@ -101,27 +102,27 @@ func resourceIBMKmsKeyAliasCreate(d *schema.ResourceData, meta interface{}) erro
 	if v, ok := d.GetOk("existing_alias"); ok {
 		id = v.(string)
 	}
-	stkey, err := kpAPI.CreateKeyAlias(context.Background(), aliasName, id)
+	stkey, err := kpAPI.CreateKeyAlias(ctx, aliasName, id)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating alias name for the key: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while creating alias name for the key: %s", err))
 	}
-	key, err := kpAPI.GetKey(context.Background(), stkey.KeyID)
+	key, err := kpAPI.GetKey(ctx, stkey.KeyID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Get Key failed with error: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Get Key failed with error: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%s:alias:%s", stkey.Alias, key.CRN))
 
-	return resourceIBMKmsKeyAliasRead(d, meta)
+	return resourceIBMKmsKeyAliasRead(ctx, d, meta)
 }
 
-func resourceIBMKmsKeyAliasRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := strings.Split(d.Id(), ":alias:")
 	if len(id) < 2 {
-		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of keyAlias:alias:keyCRN", d.Id())
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of keyAlias:alias:keyCRN", d.Id()))
 	}
 	crn := id[1]
 	crnData := strings.Split(crn, ":")
@@ -131,31 +132,31 @@ func resourceIBMKmsKeyAliasRead(d *schema.ResourceData, meta interface{}) error
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil || instanceData == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp))
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	kpAPI.URL = URL
 	kpAPI.Config.InstanceID = instanceID
-	key, err := kpAPI.GetKey(context.Background(), keyid)
+	key, err := kpAPI.GetKey(ctx, keyid)
 	if err != nil {
 		kpError := err.(*kp.Error)
 		if kpError.StatusCode == 404 || kpError.StatusCode == 409 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Get Key failed with error while reading policies: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Get Key failed with error while reading policies: %s", err))
 	} else if key.State == 5 { //Refers to Deleted state of the Key
 		d.SetId("")
 		return nil
@@ -172,10 +173,10 @@ func resourceIBMKmsKeyAliasRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMKmsKeyAliasDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := strings.Split(d.Id(), ":alias:")
 	crn := id[1]
@@ -186,30 +187,30 @@ func resourceIBMKmsKeyAliasDelete(d *schema.ResourceData, meta interface{}) erro
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil || instanceData == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp))
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	kpAPI.URL = URL
 	kpAPI.Config.InstanceID = instanceID
-	err1 := kpAPI.DeleteKeyAlias(context.Background(), id[0], keyid)
+	err1 := kpAPI.DeleteKeyAlias(ctx, id[0], keyid)
 	if err1 != nil {
 		kpError := err1.(*kp.Error)
 		if kpError.StatusCode == 404 {
 			return nil
 		} else {
-			return fmt.Errorf(" failed to Destroy alias with error: %s", err1)
+			return diag.FromErr(fmt.Errorf(" failed to Destroy alias with error: %s", err1))
 		}
 	}
 	return nil
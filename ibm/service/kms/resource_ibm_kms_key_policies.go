@@ -202,7 +202,7 @@ func resourceIBMKmsKeyPolicyCreate(context context.Context, d *schema.ResourceDa
 		return diag.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -245,7 +245,7 @@ func resourceIBMKmsKeyPolicyRead(context context.Context, d *schema.ResourceData
 		return diag.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -329,7 +329,7 @@ func resourceIBMKmsKeyPolicyUpdate(context context.Context, d *schema.ResourceDa
 			return diag.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 		}
 		extensions := instanceData.Extensions
-		URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+		URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -342,7 +342,7 @@ func resourceIBMKmsKeyPolicyUpdate(context context.Context, d *schema.ResourceDa
 
 		err = resourceHandlePolicies(context, d, kpAPI, meta, key_id)
 		if err != nil {
-			resourceIBMKmsKeyRead(d, meta)
+			resourceIBMKmsKeyRead(context, d, meta)
 			return diag.Errorf("Could not create policies: %s", err)
 		}
 	}
@@ -12,15 +12,16 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	kp "github.com/IBM/keyprotect-go-client"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMKmskeyRings() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKmsKeyRingCreate,
-		Delete:   resourceIBMKmsKeyRingDelete,
-		Read:     resourceIBMKmsKeyRingRead,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMKmsKeyRingCreate,
+		DeleteContext: resourceIBMKmsKeyRingDelete,
+		ReadContext:   resourceIBMKmsKeyRingRead,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -67,10 +68,10 @@ func ResourceIBMKeyRingValidator() *validate.ResourceValidator {
 	return &ibmKeyRingResourceValidator
 }
 
-func resourceIBMKmsKeyRingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyRingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
@@ -83,33 +84,33 @@ func resourceIBMKmsKeyRingCreate(d *schema.ResourceData, meta interface{}) error
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	instanceCRN := instanceData.CRN
 	if err != nil || instanceData == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp))
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	kpAPI.URL = URL
 	kpAPI.Config.InstanceID = instanceID
 
-	err = kpAPI.CreateKeyRing(context.Background(), keyRingID)
+	err = kpAPI.CreateKeyRing(ctx, keyRingID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating key ring : %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while creating key ring : %s", err))
 	}
 	var keyRing string
-	keyRings, err2 := kpAPI.GetKeyRings(context.Background())
+	keyRings, err2 := kpAPI.GetKeyRings(ctx)
 	if err2 != nil {
-		return fmt.Errorf("[ERROR] Error while fetching key ring : %s", err2)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while fetching key ring : %s", err2))
 	}
 	for _, v := range keyRings.KeyRings {
 		if v.ID == keyRingID {
@@ -120,17 +121,17 @@ func resourceIBMKmsKeyRingCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(fmt.Sprintf("%s:keyRing:%s", keyRing, *instanceCRN))
 
-	return resourceIBMKmsKeyRingRead(d, meta)
+	return resourceIBMKmsKeyRingRead(ctx, d, meta)
 }
 
-func resourceIBMKmsKeyRingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyRingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := strings.Split(d.Id(), ":keyRing:")
 	if len(id) < 2 {
-		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of keyRingID:keyRing:InstanceCRN", d.Id())
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of keyRingID:keyRing:InstanceCRN", d.Id()))
 	}
 	crn := id[1]
 	crnData := strings.Split(crn, ":")
@@ -139,31 +140,31 @@ func resourceIBMKmsKeyRingRead(d *schema.ResourceData, meta interface{}) error {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil || instanceData == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp))
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	kpAPI.URL = URL
 	kpAPI.Config.InstanceID = instanceID
-	_, err = kpAPI.GetKeyRings(context.Background())
+	_, err = kpAPI.GetKeyRings(ctx)
 	if err != nil {
 		kpError := err.(*kp.Error)
 		if kpError.StatusCode == 404 || kpError.StatusCode == 409 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Get Key Rings failed with error: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Get Key Rings failed with error: %s", err))
 	}
 
 	d.Set("instance_id", instanceID)
@@ -176,10 +177,10 @@ func resourceIBMKmsKeyRingRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMKmsKeyRingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyRingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := strings.Split(d.Id(), ":keyRing:")
 	crn := id[1]
@@ -189,30 +190,30 @@ func resourceIBMKmsKeyRingDelete(d *schema.ResourceData, meta interface{}) error
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil || instanceData == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp))
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	kpAPI.URL = URL
 	kpAPI.Config.InstanceID = instanceID
-	err1 := kpAPI.DeleteKeyRing(context.Background(), id[0])
+	err1 := kpAPI.DeleteKeyRing(ctx, id[0])
 	if err1 != nil {
 		kpError := err1.(*kp.Error)
 		if kpError.StatusCode == 404 || kpError.StatusCode == 409 {
 			return nil
 		} else {
-			return fmt.Errorf(" failed to Destroy key ring with error: %s", err1)
+			return diag.FromErr(fmt.Errorf(" failed to Destroy key ring with error: %s", err1))
 		}
 	}
 	return nil
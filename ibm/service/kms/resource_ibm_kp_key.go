@@ -14,17 +14,18 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMkey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKeyCreate,
-		Read:     resourceIBMKeyRead,
-		Update:   resourceIBMKeyUpdate,
-		Delete:   resourceIBMKeyDelete,
-		Exists:   resourceIBMKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMKeyCreate,
+		ReadContext:   resourceIBMKeyRead,
+		UpdateContext: resourceIBMKeyUpdate,
+		DeleteContext: resourceIBMKeyDelete,
+		Exists:        resourceIBMKeyExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -116,10 +117,10 @@ func ResourceIBMkey() *schema.Resource {
 	}
 }
 
-func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := meta.(conns.ClientSession).KeyProtectAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceID := d.Get("key_protect_id").(string)
 	api.Config.InstanceID = instanceID
@@ -131,18 +132,18 @@ func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := d.GetOk("payload"); ok {
 			//import standard key
 			payload := v.(string)
-			stkey, err := api.CreateImportedStandardKey(context.Background(), name, nil, payload)
+			stkey, err := api.CreateImportedStandardKey(ctx, name, nil, payload)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating standard key: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating standard key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		} else {
 			//create standard key
-			stkey, err := api.CreateStandardKey(context.Background(), name, nil)
+			stkey, err := api.CreateStandardKey(ctx, name, nil)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating standard key: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating standard key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		}
@@ -152,17 +153,17 @@ func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
 			payload := v.(string)
 			encryptedNonce := d.Get("encrypted_nonce").(string)
 			iv := d.Get("iv_value").(string)
-			stkey, err := api.CreateImportedRootKey(context.Background(), name, nil, payload, encryptedNonce, iv)
+			stkey, err := api.CreateImportedRootKey(ctx, name, nil, payload, encryptedNonce, iv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating Root key: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating Root key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		} else {
-			stkey, err := api.CreateRootKey(context.Background(), name, nil)
+			stkey, err := api.CreateRootKey(ctx, name, nil)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating Root key: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating Root key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		}
@@ -172,13 +173,13 @@ func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("force_delete", d.Get("force_delete").(bool))
 
-	return resourceIBMKeyRead(d, meta)
+	return resourceIBMKeyRead(ctx, d, meta)
 }
 
-func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := meta.(conns.ClientSession).KeyProtectAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
@@ -187,10 +188,10 @@ func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
 	keyid := crnData[len(crnData)-1]
 	api.Config.InstanceID = instanceID
 	// keyid := d.Id()
-	key, err := api.GetKey(context.Background(), keyid)
+	key, err := api.GetKey(ctx, keyid)
 	if err != nil {
-		return fmt.Errorf(
-			"Get Key failed with error: %s", err)
+		return diag.FromErr(fmt.Errorf(
+			"Get Key failed with error: %s", err))
 	}
 	d.Set("key_id", keyid)
 	d.Set("standard_key", key.Extractable)
@@ -208,7 +209,7 @@ func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	rcontroller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := key.ID
 	crn1 := strings.TrimSuffix(key.CRN, ":key:"+id)
@@ -219,19 +220,19 @@ func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
-	return resourceIBMKeyRead(d, meta)
+	return resourceIBMKeyRead(ctx, d, meta)
 
 }
 
-func resourceIBMKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := meta.(conns.ClientSession).KeyProtectAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
@@ -243,10 +244,10 @@ func resourceIBMKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	f := kp.ForceOpt{
 		Force: force,
 	}
-	_, err1 := api.DeleteKey(context.Background(), keyid, kp.ReturnRepresentation, f)
+	_, err1 := api.DeleteKey(ctx, keyid, kp.ReturnRepresentation, f)
 	if err1 != nil {
-		return fmt.Errorf(
-			"Error while deleting: %s", err1)
+		return diag.FromErr(fmt.Errorf(
+			"Error while deleting: %s", err1))
 	}
 	d.SetId("")
 	return nil
@@ -4,6 +4,7 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"path/filepath"
@@ -135,8 +136,10 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	network := d.Get("network").(bool)
 
 	clusterId := "Cluster_Config_" + name
-	conns.IbmMutexKV.Lock(clusterId)
-	defer conns.IbmMutexKV.Unlock(clusterId)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(context.Background(), clusterId); err != nil {
+		return err
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(clusterId)
 
 	if len(configDir) == 0 {
 		configDir, err = homedir.Dir()
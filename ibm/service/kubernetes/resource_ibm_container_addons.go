@@ -5,28 +5,30 @@ package kubernetes
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 func ResourceIBMContainerAddOns() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerAddOnsCreate,
-		Read:     resourceIBMContainerAddOnsRead,
-		Update:   resourceIBMContainerAddOnsUpdate,
-		Delete:   resourceIBMContainerAddOnsDelete,
-		Exists:   resourceIBMContainerAddOnsExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerAddOnsCreate,
+		ReadContext:   resourceIBMContainerAddOnsRead,
+		UpdateContext: resourceIBMContainerAddOnsUpdate,
+		DeleteContext: resourceIBMContainerAddOnsDelete,
+		Exists:        resourceIBMContainerAddOnsExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -115,16 +117,16 @@ func ResourceIBMContainerAddOns() *schema.Resource {
 		},
 	}
 }
-func resourceIBMContainerAddOnsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := d.Get("cluster").(string)
 	existingAddons, err := addOnAPI.GetAddons(cluster, targetEnv)
@@ -133,20 +135,20 @@ func resourceIBMContainerAddOnsCreate(d *schema.ResourceData, meta interface{})
 	}
 	payload, err := expandAddOns(d, meta, cluster, targetEnv, existingAddons)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting addons from expandAddOns during Create: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in getting addons from expandAddOns during Create: %s", err))
 	}
 	payload.Enable = true
 	_, err = addOnAPI.ConfigureAddons(cluster, &payload, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutCreate)
+	_, err = waitForContainerAddOns(ctx, d, meta, cluster, schema.TimeoutCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for Addon to reach normal during create (%s) : %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for Addon to reach normal during create (%s) : %s", d.Id(), err))
 	}
 	d.SetId(cluster)
 
-	return resourceIBMContainerAddOnsRead(d, meta)
+	return resourceIBMContainerAddOnsRead(ctx, d, meta)
 }
 func expandAddOns(d *schema.ResourceData, meta interface{}, cluster string, targetEnv v1.ClusterTargetHeader, existingAddons []v1.AddOn) (addOns v1.ConfigureAddOns, err error) {
 	addOnSet := d.Get("addons").(*schema.Set).List()
@@ -255,22 +257,22 @@ func updateAddOnVersion(d *schema.ResourceData, meta interface{}, u map[string]i
 
 	return nil
 }
-func resourceIBMContainerAddOnsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := d.Id()
 
 	result, err := addOnAPI.GetAddons(cluster, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("cluster", cluster)
 	addOns, err := flattenAddOns(result)
@@ -314,16 +316,16 @@ func flattenAddOns(result []v1.AddOn) (resp *schema.Set, err error) {
 
 	return schema.NewSet(resourceIBMContainerAddonsHash, addOns), nil
 }
-func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := d.Id()
 
@@ -346,7 +348,7 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 						// This block upgrates addon version if addon has `allowed_upgrade_versions`
 						err := updateAddOnVersion(d, meta, newPack, cluster, targetEnv)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 						ns.Remove(nA)
 						os.Remove(oA)
@@ -364,7 +366,7 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 						rmParams.Enable = false
 						_, err = addOnAPI.ConfigureAddons(cluster, &rmParams, targetEnv)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error uninstalling addon %s  on %s during update : %s", d.Id(), oldPack["name"], err)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error uninstalling addon %s  on %s during update : %s", d.Id(), oldPack["name"], err))
 						}
 						//Install AddOn with new version
 						addParams := v1.ConfigureAddOns{}
@@ -378,13 +380,13 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 						addParams.Enable = true
 						_, err = addOnAPI.ConfigureAddons(cluster, &addParams, targetEnv)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error installing addon %s  on %s during update : %s", d.Id(), newPack["name"], err)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error installing addon %s  on %s during update : %s", d.Id(), newPack["name"], err))
 						}
 						ns.Remove(nA)
 						os.Remove(oA)
 
 					} else {
-						return fmt.Errorf("[ERROR] The given addon is not provided with upgradable or updatable version")
+						return diag.FromErr(fmt.Errorf("[ERROR] The given addon is not provided with upgradable or updatable version"))
 					}
 				}
 			}
@@ -408,7 +410,7 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 			addOnParams.Enable = true
 			_, err = addOnAPI.ConfigureAddons(cluster, &addOnParams, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		if len(remove) > 0 {
@@ -426,50 +428,50 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 			addOnParams.Enable = false
 			_, err = addOnAPI.ConfigureAddons(cluster, &addOnParams, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
-		_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutUpdate)
+		_, err = waitForContainerAddOns(ctx, d, meta, cluster, schema.TimeoutUpdate)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for Addon to reach normal during update (%s) : %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for Addon to reach normal during update (%s) : %s", d.Id(), err))
 		}
 	}
 
-	return resourceIBMContainerAddOnsRead(d, meta)
+	return resourceIBMContainerAddOnsRead(ctx, d, meta)
 }
 
-func resourceIBMContainerAddOnsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := d.Id()
 	payload, err := expandAddOns(d, meta, cluster, targetEnv, nil)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting addons from expandAddOns during Destroy %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in getting addons from expandAddOns during Destroy %s", err))
 	}
 
 	payload.Enable = false
 	_, err = addOnAPI.ConfigureAddons(cluster, &payload, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
-func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, timeout string) (interface{}, error) {
+func waitForContainerAddOns(ctx context.Context, d *schema.ResourceData, meta interface{}, cluster, timeout string) (interface{}, error) {
 	addOnClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return false, err
 	}
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{"pending", "updating", ""},
 		Target:  []string{"normal", "warning", "critical", "available"},
 		Refresh: func() (interface{}, string, error) {
@@ -491,12 +493,12 @@ func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, t
 			}
 			return addOns, "available", nil
 		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(timeout),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 func resourceIBMContainerAddOnsExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 
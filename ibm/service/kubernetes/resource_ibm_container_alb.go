@@ -4,25 +4,27 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 func ResourceIBMContainerALB() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerALBCreate,
-		Read:     resourceIBMContainerALBRead,
-		Update:   resourceIBMContainerALBUpdate,
-		Delete:   resourceIBMContainerALBDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerALBCreate,
+		ReadContext:   resourceIBMContainerALBRead,
+		UpdateContext: resourceIBMContainerALBUpdate,
+		DeleteContext: resourceIBMContainerALBDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -96,10 +98,10 @@ func ResourceIBMContainerALB() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var userIP string
 	var enable, disableDeployment bool
@@ -109,7 +111,7 @@ func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) err
 	} else if v, ok := d.GetOkExists("disable_deployment"); ok {
 		disableDeployment = v.(bool)
 	} else {
-		return fmt.Errorf("[ERROR] Provide either `enable` or `disable_deployment`")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide either `enable` or `disable_deployment`"))
 	}
 
 	numOfInstances := "2"
@@ -125,33 +127,33 @@ func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) err
 		params.ALBIP = userIP
 	}
 
-	_, err = waitForClusterAvailable(d, meta, albID)
+	_, err = waitForClusterAvailable(ctx, d, meta, albID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err))
 	}
 
 	albAPI := albClient.Albs()
 	targetEnv, err := getAlbTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = albAPI.ConfigureALB(albID, params, disableDeployment, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(albID)
-	_, err = waitForContainerALB(d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
+	_, err = waitForContainerALB(ctx, d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create resource alb (%s) : %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for create resource alb (%s) : %s", d.Id(), err))
 	}
 
-	return resourceIBMContainerALBRead(d, meta)
+	return resourceIBMContainerALBRead(ctx, d, meta)
 }
 
-func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	albID := d.Id()
@@ -159,11 +161,11 @@ func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error
 	albAPI := albClient.Albs()
 	targetEnv, err := getAlbTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albConfig, err := albAPI.GetALB(albID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("alb_type", albConfig.ALBType)
@@ -178,10 +180,10 @@ func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMContainerALBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albAPI := albClient.Albs()
 
@@ -196,34 +198,34 @@ func resourceIBMContainerALBUpdate(d *schema.ResourceData, meta interface{}) err
 
 		targetEnv, err := getAlbTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = waitForClusterAvailable(d, meta, albID)
+		_, err = waitForClusterAvailable(ctx, d, meta, albID)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err))
 		}
 
 		err = albAPI.ConfigureALB(albID, params, disableDeployment, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		_, err = waitForContainerALB(d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
+		_, err = waitForContainerALB(ctx, d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for updating resource alb (%s) : %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for updating resource alb (%s) : %s", d.Id(), err))
 		}
 
 	}
-	return resourceIBMContainerALBRead(d, meta)
+	return resourceIBMContainerALBRead(ctx, d, meta)
 }
 
-func waitForContainerALB(d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
+func waitForContainerALB(ctx context.Context, d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
 	albClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return false, err
 	}
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{"pending"},
 		Target:  []string{"active"},
 		Refresh: func() (interface{}, string, error) {
@@ -249,22 +251,22 @@ func waitForContainerALB(d *schema.ResourceData, meta interface{}, albID, timeou
 			}
 			return alb, "active", nil
 		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(timeout),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func resourceIBMContainerALBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 
 	return nil
 }
 
 // WaitForWorkerAvailable Waits for worker creation
-func waitForClusterAvailable(d *schema.ResourceData, meta interface{}, albID string) (interface{}, error) {
+func waitForClusterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, albID string) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -284,16 +286,16 @@ func waitForClusterAvailable(d *schema.ResourceData, meta interface{}, albID str
 
 	log.Printf("Waiting for worker of the cluster (%s) wokers to be available.", ClusterID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    workerStateRefreshFunc(csClient.Workers(), ClusterID, target),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", workerProvisioning},
+		Target:   []string{workerNormal},
+		Refresh:  workerStateRefreshFunc(csClient.Workers(), ClusterID, target),
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 func getAlbTargetHeader(d *schema.ResourceData, meta interface{}) (v1.ClusterTargetHeader, error) {
 	var region string
@@ -4,27 +4,29 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 func ResourceIBMContainerALBCert() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerALBCertCreate,
-		Read:     resourceIBMContainerALBCertRead,
-		Update:   resourceIBMContainerALBCertUpdate,
-		Delete:   resourceIBMContainerALBCertDelete,
-		Exists:   resourceIBMContainerALBCertExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerALBCertCreate,
+		ReadContext:   resourceIBMContainerALBCertRead,
+		UpdateContext: resourceIBMContainerALBCertUpdate,
+		DeleteContext: resourceIBMContainerALBCertDelete,
+		Exists:        resourceIBMContainerALBCertExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -99,10 +101,10 @@ func ResourceIBMContainerALBCert() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerALBCertCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	certCRN := d.Get("cert_crn").(string)
@@ -125,25 +127,25 @@ func resourceIBMContainerALBCertCreate(d *schema.ResourceData, meta interface{})
 	response, err := ingressAPI.CreateIngressSecret(params)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, secretName, response.Namespace))
-	_, err = waitForContainerALBCert(d, meta, schema.TimeoutCreate)
+	_, err = waitForContainerALBCert(ctx, d, meta, schema.TimeoutCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create resource alb cert (%s) : %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for create resource alb cert (%s) : %s", d.Id(), err))
 	}
 
-	return resourceIBMContainerALBCertRead(d, meta)
+	return resourceIBMContainerALBCertRead(ctx, d, meta)
 }
 
-func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := parts[0]
 	secretName := parts[1]
@@ -155,7 +157,7 @@ func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) e
 	ingressAPI := ingressClient.Ingresses()
 	ingressSecretConfig, err := ingressAPI.GetIngressSecret(clusterID, secretName, namespace)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", clusterID, secretName, namespace))
 	d.Set("cluster_id", ingressSecretConfig.Cluster)
@@ -172,17 +174,17 @@ func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMContainerALBCertDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ingressAPI := ingressClient.Ingresses()
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := parts[0]
 	secretName := parts[1]
@@ -198,17 +200,17 @@ func resourceIBMContainerALBCertDelete(d *schema.ResourceData, meta interface{})
 
 	err = ingressAPI.DeleteIngressSecret(params)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, albCertDeletionError := waitForALBCertDelete(d, meta, schema.TimeoutDelete)
+	_, albCertDeletionError := waitForALBCertDelete(ctx, d, meta, schema.TimeoutDelete)
 	if albCertDeletionError != nil {
-		return albCertDeletionError
+		return diag.FromErr(albCertDeletionError)
 	}
 	d.SetId("")
 	return nil
 }
 
-func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
+func waitForALBCertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
@@ -224,7 +226,7 @@ func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout stri
 		namespace = parts[2]
 	}
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{"deleting"},
 		Target:  []string{"deleted"},
 		Refresh: func() (interface{}, string, error) {
@@ -241,22 +243,22 @@ func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout stri
 			}
 			return secret, "deleted", nil
 		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(timeout),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func resourceIBMContainerALBCertUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	secretName := parts[1]
@@ -278,15 +280,15 @@ func resourceIBMContainerALBCertUpdate(d *schema.ResourceData, meta interface{})
 		ingressAPI := ingressClient.Ingresses()
 		_, err = ingressAPI.UpdateIngressSecret(params)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = waitForContainerALBCert(d, meta, schema.TimeoutUpdate)
+		_, err = waitForContainerALBCert(ctx, d, meta, schema.TimeoutUpdate)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for updating resource alb cert (%s) : %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for updating resource alb cert (%s) : %s", d.Id(), err))
 		}
 	}
-	return resourceIBMContainerALBCertRead(d, meta)
+	return resourceIBMContainerALBCertRead(ctx, d, meta)
 }
 
 func resourceIBMContainerALBCertExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -321,7 +323,7 @@ func resourceIBMContainerALBCertExists(d *schema.ResourceData, meta interface{})
 	return ingressSecretConfig.Cluster == clusterID && ingressSecretConfig.Name == secretName, nil
 }
 
-func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
+func waitForContainerALBCert(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
@@ -337,7 +339,7 @@ func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout s
 		namespace = parts[2]
 	}
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{"creating"},
 		Target:  []string{"done"},
 		Refresh: func() (interface{}, string, error) {
@@ -361,10 +363,10 @@ func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout s
 			}
 			return alb, "done", nil
 		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(timeout),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
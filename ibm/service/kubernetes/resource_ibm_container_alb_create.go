@@ -4,10 +4,12 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
@@ -16,11 +18,11 @@ import (
 
 func ResourceIBMContainerAlbCreate() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerClassicAlbCreate,
-		Read:     resourceIBMContainerALBRead,
-		Update:   resourceIBMContainerALBUpdate,
-		Delete:   resourceIBMContainerALBDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerClassicAlbCreate,
+		ReadContext:   resourceIBMContainerALBRead,
+		UpdateContext: resourceIBMContainerALBUpdate,
+		DeleteContext: resourceIBMContainerALBDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -112,11 +114,11 @@ func ResourceIBMContainerAlbCreate() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerClassicAlbCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClassicAlbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Println("resourceIBMContainerClassicAlbCreate")
 	albClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albAPI := albClient.Albs()
 
@@ -165,13 +167,13 @@ func resourceIBMContainerClassicAlbCreate(d *schema.ResourceData, meta interface
 
 	targetEnv, err := getAlbTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//v1.AlbCreateResp
 	albResp, err := albAPI.CreateALB(params, cluster, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating ALb to the cluster %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating ALb to the cluster %s", err))
 	}
 
 	d.SetId(albResp.Alb)
@@ -4,19 +4,21 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMContainerAPIKeyReset() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMContainerAPIKeyResetUpdate,
-		Read:   resourceIBMContainerAPIKeyResetRead,
-		Update: resourceIBMContainerAPIKeyResetUpdate,
-		Delete: resourceIBMContainerAPIKeyResetdelete,
+		CreateContext: resourceIBMContainerAPIKeyResetUpdate,
+		ReadContext:   resourceIBMContainerAPIKeyResetRead,
+		UpdateContext: resourceIBMContainerAPIKeyResetUpdate,
+		DeleteContext: resourceIBMContainerAPIKeyResetdelete,
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -41,28 +43,28 @@ func ResourceIBMContainerAPIKeyReset() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerAPIKeyResetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAPIKeyResetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("reset_api_key") {
 		apikeyClient, err := meta.(conns.ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		apikeyAPI := apikeyClient.Apikeys()
 		region := d.Get("region").(string)
 		targetEnv, err := getClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		targetEnv.Region = region
 		err = apikeyAPI.ResetApiKey(targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if targetEnv.ResourceGroup == "" {
 			defaultRg, err := flex.DefaultResourceGroup(meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			targetEnv.ResourceGroup = defaultRg
 		}
@@ -72,10 +74,10 @@ func resourceIBMContainerAPIKeyResetUpdate(d *schema.ResourceData, meta interfac
 
 	return nil
 }
-func resourceIBMContainerAPIKeyResetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAPIKeyResetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
-func resourceIBMContainerAPIKeyResetdelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAPIKeyResetdelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 	return nil
 }
@@ -4,8 +4,10 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
@@ -15,11 +17,11 @@ import (
 
 func ResourceIBMContainerBindService() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerBindServiceCreate,
-		Read:     resourceIBMContainerBindServiceRead,
-		Update:   resourceIBMContainerBindServiceUpdate,
-		Delete:   resourceIBMContainerBindServiceDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerBindServiceCreate,
+		ReadContext:   resourceIBMContainerBindServiceRead,
+		UpdateContext: resourceIBMContainerBindServiceUpdate,
+		DeleteContext: resourceIBMContainerBindServiceDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name_id": {
@@ -129,10 +131,10 @@ func getClusterTargetHeader(d *schema.ResourceData, meta interface{}) (v1.Cluste
 	return targetEnv, nil
 }
 
-func resourceIBMContainerBindServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerBindServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameID := d.Get("cluster_name_id").(string)
 	namespaceID := d.Get("namespace_id").(string)
@@ -142,7 +144,7 @@ func resourceIBMContainerBindServiceCreate(d *schema.ResourceData, meta interfac
 	} else if serviceInstanceID, ok := d.GetOk("service_instance_id"); ok {
 		serviceInstanceNameID = serviceInstanceID.(string)
 	} else {
-		return fmt.Errorf("[ERROR] Please set either service_instance_name or service_instance_id")
+		return diag.FromErr(fmt.Errorf("[ERROR] Please set either service_instance_name or service_instance_id"))
 	}
 
 	bindService := v1.ServiceBindRequest{
@@ -161,32 +163,32 @@ func resourceIBMContainerBindServiceCreate(d *schema.ResourceData, meta interfac
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, err = csClient.Clusters().BindService(bindService, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", clusterNameID, serviceInstanceNameID, namespaceID))
 
-	return resourceIBMContainerBindServiceRead(d, meta)
+	return resourceIBMContainerBindServiceRead(ctx, d, meta)
 }
 
-func resourceIBMContainerBindServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerBindServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMContainerBindServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerBindServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(parts) < 3 {
-		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of clusterNameID/serviceInstanceNameID/namespaceID", d.Id())
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of clusterNameID/serviceInstanceNameID/namespaceID", d.Id()))
 	}
 	clusterNameID := parts[0]
 	serviceInstanceNameID := parts[1]
@@ -194,12 +196,12 @@ func resourceIBMContainerBindServiceRead(d *schema.ResourceData, meta interface{
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	boundService, err := csClient.Clusters().FindServiceBoundToCluster(clusterNameID, serviceInstanceNameID, namespaceID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("namespace_id", boundService.Namespace)
 
@@ -211,26 +213,26 @@ func resourceIBMContainerBindServiceRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceIBMContainerBindServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerBindServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameID := parts[0]
 	serviceInstanceNameID := parts[1]
 	namespace := parts[2]
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = csClient.Clusters().UnBindService(clusterNameID, namespace, serviceInstanceNameID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error unbinding service: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error unbinding service: %s", err))
 	}
 	return nil
 }
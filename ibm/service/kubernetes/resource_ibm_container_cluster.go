@@ -11,8 +11,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -21,6 +21,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 const (
@@ -52,12 +53,12 @@ const PUBLIC_SUBNET_TYPE = "public"
 
 func ResourceIBMContainerCluster() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerClusterCreate,
-		Read:     resourceIBMContainerClusterRead,
-		Update:   resourceIBMContainerClusterUpdate,
-		Delete:   resourceIBMContainerClusterDelete,
-		Exists:   resourceIBMContainerClusterExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerClusterCreate,
+		ReadContext:   resourceIBMContainerClusterRead,
+		UpdateContext: resourceIBMContainerClusterUpdate,
+		DeleteContext: resourceIBMContainerClusterDelete,
+		Exists:        resourceIBMContainerClusterExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
@@ -67,7 +68,7 @@ func ResourceIBMContainerCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -637,15 +638,15 @@ func ResourceIBMContainerClusterValidator() *validate.ResourceValidator {
 	return &ibmContainerClusterResourceValidator
 }
 
-func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	csClientV2, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get("name").(string)
@@ -695,10 +696,10 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 				params.PrivateEndpointEnabled = v.(bool)
 				params.GatewayEnabled = gatewayEnabled
 			} else {
-				return fmt.Errorf("[ERROR] set private_service_endpoint to true for gateway_enabled clusters")
+				return diag.FromErr(fmt.Errorf("[ERROR] set private_service_endpoint to true for gateway_enabled clusters"))
 			}
 		} else {
-			return fmt.Errorf("[ERROR] set private_service_endpoint to true for gateway_enabled clusters")
+			return diag.FromErr(fmt.Errorf("[ERROR] set private_service_endpoint to true for gateway_enabled clusters"))
 		}
 	}
 	if v, ok := d.GetOk("kube_version"); ok {
@@ -713,45 +714,45 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cls, err := csClient.Clusters().Create(params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(cls.ID)
 
 	targetEnvV2, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if imageSecurityEnabled {
 		err = csClientV2.Clusters().EnableImageSecurityEnforcement(cls.ID, targetEnvV2)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	_, err = waitForClusterMasterAvailable(d, meta)
+	_, err = waitForClusterMasterAvailable(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.Get("wait_till").(string) == oneWorkerNodeReady {
-		_, err = waitForClusterOneWorkerAvailable(d, meta)
+		_, err = waitForClusterOneWorkerAvailable(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.Set("force_delete_storage", d.Get("force_delete_storage").(bool))
 
-	return resourceIBMContainerClusterUpdate(d, meta)
+	return resourceIBMContainerClusterUpdate(ctx, d, meta)
 }
 
-func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wrkAPI := csClient.Workers()
 	workerPoolsAPI := csClient.WorkerPools()
@@ -759,18 +760,18 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
 	cls, err := csClient.Clusters().Find(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving armada cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving armada cluster: %s", err))
 	}
 
 	workerFields, err := wrkAPI.List(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err))
 	}
 	workerCount := 0
 	workers := []map[string]string{}
@@ -790,7 +791,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 	workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var poolName string
 	var poolContains bool
@@ -805,14 +806,14 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	if poolContains {
 		workersByPool, err := wrkAPI.ListByWorkerPool(clusterID, poolName, false, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving workers of default worker pool for cluster: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers of default worker pool for cluster: %s", err))
 		}
 
 		// to get the private and public vlan IDs of the gateway enabled cluster.
 		if poolName == computeWorkerPool {
 			gatewayWorkersByPool, err := wrkAPI.ListByWorkerPool(clusterID, gatewayWorkerpool, false, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error retrieving workers of default worker pool for cluster: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers of default worker pool for cluster: %s", err))
 			}
 			d.Set("public_vlan_id", gatewayWorkersByPool[0].PublicVlan)
 			d.Set("private_vlan_id", gatewayWorkersByPool[0].PrivateVlan)
@@ -845,7 +846,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 		defaultWorkerPool, err := workerPoolsAPI.GetWorkerPool(clusterID, poolName, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("labels", flex.IgnoreSystemLabels(defaultWorkerPool.Labels))
 		zones := defaultWorkerPool.Zones
@@ -861,7 +862,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	albs, err := albsAPI.ListClusterALBs(clusterID, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "The specified cluster is a lite cluster.") && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") && !strings.Contains(err.Error(), "The specified cluster is a free cluster.") {
 
-		return fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", clusterID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", clusterID, err))
 	}
 
 	d.Set("name", cls.Name)
@@ -893,7 +894,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("tags", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("image_security_enforcement", cls.ImageSecurityEnabled)
 	d.Set(flex.ResourceControllerURL, controller+"/kubernetes/clusters")
@@ -904,26 +905,26 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	csClientV2, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnvV2, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subnetAPI := csClient.Subnets()
@@ -947,11 +948,11 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			}
 			err := clusterAPI.Update(clusterID, params, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			_, err = WaitForClusterVersionUpdate(d, meta, targetEnv)
+			_, err = WaitForClusterVersionUpdate(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err))
 			}
 		}
 		// "update_all_workers" deafult is false, enable to true when all worker nodes to be updated
@@ -960,7 +961,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
 			workerFields, err := wrkAPI.List(clusterID, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err))
 			}
 
 			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
@@ -977,13 +978,13 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 					err = wrkAPI.Update(clusterID, w.ID, params, targetEnv)
 					if err != nil {
 						d.Set("patch_version", nil)
-						return fmt.Errorf("[ERROR] Error updating worker %s: %s", w.ID, err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error updating worker %s: %s", w.ID, err))
 					}
 					if waitForWorkerUpdate {
-						_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+						_, err = WaitForWorkerAvailable(ctx, d, meta, targetEnv)
 						if err != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 						}
 					}
 				}
@@ -1022,7 +1023,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			log.Printf(
 				"An error occured during EnableKms (cluster: %s) error: %s", d.Id(), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1038,7 +1039,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		workerPoolsAPI := csClient.WorkerPools()
 		workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		var poolName string
 		var poolContains bool
@@ -1055,15 +1056,15 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			poolSize := d.Get("default_pool_size").(int)
 			err = workerPoolsAPI.ResizeWorkerPool(clusterID, poolName, poolSize, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating the default_pool_size %d: %s", poolSize, err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error updating the default_pool_size %d: %s", poolSize, err))
 			}
 
-			_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+			_, err = WaitForWorkerAvailable(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 			}
 		} else {
-			return fmt.Errorf("[ERROR] The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes")
+			return diag.FromErr(fmt.Errorf("[ERROR] The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes"))
 		}
 	}
 
@@ -1071,7 +1072,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		workerPoolsAPI := csClient.WorkerPools()
 		workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		var poolName string
 		var poolContains bool
@@ -1092,22 +1093,22 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			}
 			err = workerPoolsAPI.UpdateLabelsWorkerPool(clusterID, poolName, labels, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating the labels %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error updating the labels %s", err))
 			}
 
-			_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+			_, err = WaitForWorkerAvailable(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 			}
 		} else {
-			return fmt.Errorf("[ERROR] The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes")
+			return diag.FromErr(fmt.Errorf("[ERROR] The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes"))
 		}
 	}
 	if d.HasChange("taints") {
 		workerPoolsAPI := csClient.WorkerPools()
 		workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		var poolName string
 		var poolContains bool
@@ -1123,18 +1124,18 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			taintParam := expandWorkerPoolTaints(d, meta, clusterID, poolName)
 			targetEnv, err := getVpcClusterTargetHeader(d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			ClusterClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			err = ClusterClient.WorkerPools().UpdateWorkerPoolTaints(taintParam, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating the taints: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error updating the taints: %s", err))
 			}
 		} else {
-			return fmt.Errorf("[ERROR] The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes")
+			return diag.FromErr(fmt.Errorf("[ERROR] The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes"))
 		}
 
 	}
@@ -1167,19 +1168,19 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			count := oldCount - newCount
 			workerFields, err := wrkAPI.List(clusterID, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err))
 			}
 			for i := 0; i < count; i++ {
 				err := wrkAPI.Delete(clusterID, workerFields[i].ID, targetEnv)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting workers of cluster (%s): %s", d.Id(), err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting workers of cluster (%s): %s", d.Id(), err))
 				}
 			}
 		}
 
-		_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+		_, err = WaitForWorkerAvailable(ctx, d, meta, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 		}
 	}
 
@@ -1194,22 +1195,22 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 				if strings.Compare(newPack["version"].(string), oldPack["version"].(string)) != 0 {
 					cluster, err := clusterAPI.Find(clusterID, targetEnv)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err))
 					}
 					if newPack["version"].(string) != strings.Split(cluster.MasterKubeVersion, "_")[0] {
-						return fmt.Errorf("[ERROR] Worker version %s should match the master kube version %s", newPack["version"].(string), strings.Split(cluster.MasterKubeVersion, "_")[0])
+						return diag.FromErr(fmt.Errorf("[ERROR] Worker version %s should match the master kube version %s", newPack["version"].(string), strings.Split(cluster.MasterKubeVersion, "_")[0]))
 					}
 					params := v1.WorkerUpdateParam{
 						Action: "update",
 					}
 					err = wrkAPI.Update(clusterID, oldPack["id"].(string), params, targetEnv)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error updating worker %s: %s", oldPack["id"].(string), err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error updating worker %s: %s", oldPack["id"].(string), err))
 					}
 
-					_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+					_, err = WaitForWorkerAvailable(ctx, d, meta, targetEnv)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 					}
 				}
 			}
@@ -1255,14 +1256,14 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		newSubnet := newSubnets.(*schema.Set)
 		rem := oldSubnet.Difference(newSubnet).List()
 		if len(rem) > 0 {
-			return fmt.Errorf("Subnet(s) %v cannot be deleted", rem)
+			return diag.FromErr(fmt.Errorf("Subnet(s) %v cannot be deleted", rem))
 		}
 		metro := d.Get("datacenter").(string)
 		//from datacenter retrive the metro for filtering the subnets
 		metro = metro[0:3]
 		subnets, err := subnetAPI.List(targetEnv, metro)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, nS := range newSubnet.List() {
 			exists := false
@@ -1274,7 +1275,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			if !exists {
 				err := subnetAPI.AddSubnet(clusterID, nS.(string), targetEnv)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				subnet := getSubnet(subnets, nS.(string))
 				if subnet.Type == PUBLIC_SUBNET_TYPE {
@@ -1284,9 +1285,9 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 	if publicSubnetAdded && d.Get("wait_till").(string) == ingressReady {
-		_, err = WaitForSubnetAvailable(d, meta, targetEnv)
+		_, err = WaitForSubnetAvailable(ctx, d, meta, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for initializing ingress hostname and secret: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for initializing ingress hostname and secret: %s", err))
 		}
 	}
 
@@ -1295,7 +1296,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange("tags")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err))
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, cluster.CRN)
 		if err != nil {
@@ -1317,7 +1318,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return resourceIBMContainerClusterRead(d, meta)
+	return resourceIBMContainerClusterRead(ctx, d, meta)
 }
 
 func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorkers []interface{}, workerInfo []map[string]string) (string, error) {
@@ -1355,30 +1356,30 @@ func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorker
 	return "", fmt.Errorf("[ERROR] Unable to get ID of worker")
 }
 
-func resourceIBMContainerClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := d.Id()
 	forceDeleteStorage := d.Get("force_delete_storage").(bool)
 	err = csClient.Clusters().Delete(clusterID, targetEnv, forceDeleteStorage)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting cluster: %s", err))
 	}
-	_, err = waitForClusterDelete(d, meta)
+	_, err = waitForClusterDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1388,7 +1389,7 @@ func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		return nil, err
 	}
 	clusterID := d.Id()
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{clusterDeletePending},
 		Target:  []string{clusterDeleted},
 		Refresh: func() (interface{}, string, error) {
@@ -1401,17 +1402,16 @@ func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}
 			}
 			return cluster, clusterDeletePending, nil
 		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        60 * time.Second,
-		MinTimeout:   10 * time.Second,
-		PollInterval: 60 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutDelete),
+		Delay:    60 * time.Second,
+		Interval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
 // WaitForClusterAvailable Waits for cluster creation
-func WaitForClusterAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1419,19 +1419,19 @@ func WaitForClusterAvailable(d *schema.ResourceData, meta interface{}, target v1
 	log.Printf("Waiting for cluster (%s) to be available.", d.Id())
 	id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", clusterProvisioning},
-		Target:     []string{clusterNormal},
-		Refresh:    clusterStateRefreshFunc(csClient.Clusters(), id, target),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", clusterProvisioning},
+		Target:   []string{clusterNormal},
+		Refresh:  clusterStateRefreshFunc(csClient.Clusters(), id, target),
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
+func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		clusterFields, err := client.FindWithOutShowResourcesCompatible(instanceID, target)
 		if err != nil {
@@ -1449,7 +1449,7 @@ func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.Cl
 }
 
 // waitForClusterMasterAvailable Waits for cluster creation
-func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterMasterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1460,7 +1460,7 @@ func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (in
 	}
 	clusterID := d.Id()
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{deployRequested, deployInProgress},
 		Target:  []string{ready},
 		Refresh: func() (interface{}, string, error) {
@@ -1474,16 +1474,16 @@ func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (in
 			}
 			return clusterFields, deployInProgress, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
 // waitForClusterOneWorkerAvailable Waits for cluster creation
-func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterOneWorkerAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1494,7 +1494,7 @@ func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{})
 	}
 	clusterID := d.Id()
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{"retry", "deploying", "provisioning"},
 		Target:  []string{normal},
 		Refresh: func() (interface{}, string, error) {
@@ -1533,16 +1533,16 @@ func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{})
 			}
 			return nil, normal, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
 // WaitForWorkerAvailable Waits for worker creation
-func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1550,19 +1550,19 @@ func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.
 	log.Printf("Waiting for worker of the cluster (%s) to be available.", d.Id())
 	id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    workerStateRefreshFunc(csClient.Workers(), id, target),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", workerProvisioning},
+		Target:   []string{workerNormal},
+		Refresh:  workerStateRefreshFunc(csClient.Workers(), id, target),
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
+func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.List(instanceID, target)
 		if err != nil {
@@ -1581,7 +1581,7 @@ func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.Clus
 	}
 }
 
-func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterCreation(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1589,7 +1589,7 @@ func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.
 	log.Printf("Waiting for cluster (%s) to be available.", d.Id())
 	ClusterID := d.Id()
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{"retry", clusterProvisioning},
 		Target:  []string{clusterNormal},
 		Refresh: func() (interface{}, string, error) {
@@ -1608,15 +1608,15 @@ func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.
 			}
 			return workerFields, workerProvisioning, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForSubnetAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1624,19 +1624,19 @@ func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.
 	log.Printf("Waiting for Ingress Subdomain and secret being assigned.")
 	id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    subnetStateRefreshFunc(csClient.Clusters(), id, d, target),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", workerProvisioning},
+		Target:   []string{workerNormal},
+		Refresh:  subnetStateRefreshFunc(csClient.Clusters(), id, d, target),
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
+func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		cluster, err := client.FindWithOutShowResourcesCompatible(instanceID, target)
 		if err != nil {
@@ -1650,7 +1650,7 @@ func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.Res
 }
 
 // WaitForClusterVersionUpdate Waits for cluster creation
-func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1658,20 +1658,20 @@ func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, targe
 	log.Printf("Waiting for cluster (%s) version to be updated.", d.Id())
 	id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending:                   []string{"retry", versionUpdating},
 		Target:                    []string{clusterNormal},
 		Refresh:                   clusterVersionRefreshFunc(csClient.Clusters(), id, d, target),
 		Timeout:                   d.Timeout(schema.TimeoutUpdate),
 		Delay:                     20 * time.Second,
-		MinTimeout:                10 * time.Second,
+		Interval:                  10 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func clusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
+func clusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		clusterFields, err := client.FindWithOutShowResourcesCompatible(instanceID, target)
 		if err != nil {
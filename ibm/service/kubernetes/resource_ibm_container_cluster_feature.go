@@ -4,16 +4,18 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 const (
@@ -25,11 +27,11 @@ const (
 
 func ResourceIBMContainerClusterFeature() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerClusterFeatureCreate,
-		Read:     resourceIBMContainerClusterFeatureRead,
-		Update:   resourceIBMContainerClusterFeatureUpdate,
-		Delete:   resourceIBMContainerClusterFeatureDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerClusterFeatureCreate,
+		ReadContext:   resourceIBMContainerClusterFeatureRead,
+		UpdateContext: resourceIBMContainerClusterFeatureUpdate,
+		DeleteContext: resourceIBMContainerClusterFeatureDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Update: schema.DefaultTimeout(90 * time.Minute),
@@ -91,24 +93,24 @@ func ResourceIBMContainerClusterFeature() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerClusterFeatureCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	cluster := d.Get("cluster").(string)
 	var isOptionSet bool
 
 	if v, ok := d.GetOkExists("private_service_endpoint"); ok {
 		if v.(bool) {
-			err := updateCluster(cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutCreate), d, meta)
+			err := updateCluster(ctx, cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutCreate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
-			return fmt.Errorf("[ERROR] The `private_service_endpoint` can not be disabled")
+			return diag.FromErr(fmt.Errorf("[ERROR] The `private_service_endpoint` can not be disabled"))
 		}
 		d.SetId(cluster)
-		err := reloadCluster(cluster, d.Timeout(schema.TimeoutCreate), d, meta)
+		err := reloadCluster(ctx, cluster, d.Timeout(schema.TimeoutCreate), d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		isOptionSet = true
 	}
@@ -121,25 +123,25 @@ func resourceIBMContainerClusterFeatureCreate(d *schema.ResourceData, meta inter
 			cmd = disablePublicSECmdAction
 		}
 		log.Printf("Started enabling the public ep %s", cmd)
-		err := updateCluster(cluster, cmd, d.Timeout(schema.TimeoutCreate), d, meta)
+		err := updateCluster(ctx, cluster, cmd, d.Timeout(schema.TimeoutCreate), d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(cluster)
-		err = reloadCluster(cluster, d.Timeout(schema.TimeoutCreate), d, meta)
+		err = reloadCluster(ctx, cluster, d.Timeout(schema.TimeoutCreate), d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		isOptionSet = true
 	}
 
 	if !isOptionSet {
-		return fmt.Errorf("[ERROR] Provide either `public_service_endpoint` or  `private_service_endpoint` or both")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide either `public_service_endpoint` or  `private_service_endpoint` or both"))
 	}
-	return resourceIBMContainerClusterFeatureRead(d, meta)
+	return resourceIBMContainerClusterFeatureRead(ctx, d, meta)
 }
 
-func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
+func reloadCluster(ctx context.Context, cluster string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return err
@@ -159,12 +161,12 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 	if v, ok := d.GetOkExists("reload_workers"); ok {
 		if v.(bool) {
 			log.Printf("Waiting for cluster (%s) to be available.", cluster)
-			_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForClusterAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) to become ready: %s", cluster, err)
 			}
 			log.Printf("Waiting for workers (%s) to be available.", cluster)
-			_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForWorkerAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", cluster, err)
 			}
@@ -183,11 +185,11 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 			if err != nil {
 				return err
 			}
-			_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForClusterAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) to become ready: %s", d.Id(), err)
 			}
-			_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForWorkerAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
 			}
@@ -197,7 +199,7 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 	return nil
 }
 
-func updateCluster(cluster, actionCmd string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
+func updateCluster(ctx context.Context, cluster, actionCmd string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return err
@@ -210,12 +212,12 @@ func updateCluster(cluster, actionCmd string, timeout time.Duration, d *schema.R
 		return err
 	}
 	log.Printf("Waiting for cluster (%s) to be available.", cluster)
-	_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+	_, err = WaitForClusterAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for cluster (%s) to become ready: %s", d.Id(), err)
 	}
 	log.Printf("Waiting for workers (%s) to be available.", cluster)
-	_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+	_, err = WaitForWorkerAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
 	}
@@ -229,20 +231,20 @@ func updateCluster(cluster, actionCmd string, timeout time.Duration, d *schema.R
 	return nil
 }
 
-func resourceIBMContainerClusterFeatureRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cls, err := csClient.Clusters().Find(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving armada cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving armada cluster: %s", err))
 	}
 
 	d.Set("cluster", clusterID)
@@ -254,28 +256,28 @@ func resourceIBMContainerClusterFeatureRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceIBMContainerClusterFeatureDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMContainerClusterFeatureUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	cluster := d.Get("cluster").(string)
 	var isOptionSet bool
 	if d.HasChange("private_service_endpoint") {
 		if v, ok := d.GetOkExists("private_service_endpoint"); ok {
 			if v.(bool) {
-				err := updateCluster(cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutUpdate), d, meta)
+				err := updateCluster(ctx, cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutUpdate), d, meta)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else {
-				return fmt.Errorf("[ERROR] The `private_service_endpoint` can not be disabled")
+				return diag.FromErr(fmt.Errorf("[ERROR] The `private_service_endpoint` can not be disabled"))
 			}
-			err := reloadCluster(cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
+			err := reloadCluster(ctx, cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			isOptionSet = true
 		}
@@ -288,27 +290,27 @@ func resourceIBMContainerClusterFeatureUpdate(d *schema.ResourceData, meta inter
 			} else {
 				cmd = disablePublicSECmdAction
 			}
-			err := updateCluster(cluster, cmd, d.Timeout(schema.TimeoutUpdate), d, meta)
+			err := updateCluster(ctx, cluster, cmd, d.Timeout(schema.TimeoutUpdate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = reloadCluster(cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
+			err = reloadCluster(ctx, cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			isOptionSet = true
 		}
 	}
 
 	if !isOptionSet {
-		return fmt.Errorf("[ERROR] Provide either `public_service_endpoint` or  `private_service_endpoint` or both")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide either `public_service_endpoint` or  `private_service_endpoint` or both"))
 	}
 
-	return resourceIBMContainerClusterFeatureRead(d, meta)
+	return resourceIBMContainerClusterFeatureRead(ctx, d, meta)
 }
 
 // WaitForClusterAvailable Waits for cluster creation
-func WaitForClusterAvailableForFeatureUpdate(cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterAvailableForFeatureUpdate(ctx context.Context, cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -316,19 +318,19 @@ func WaitForClusterAvailableForFeatureUpdate(cluster string, timeout time.Durati
 	log.Printf("Waiting for cluster (%s) to be available.", cluster)
 	id := cluster
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", clusterProvisioning},
-		Target:     []string{clusterNormal},
-		Refresh:    clusterStateRefreshFunc(csClient.Clusters(), id, target),
-		Timeout:    timeout,
-		Delay:      60 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", clusterProvisioning},
+		Target:   []string{clusterNormal},
+		Refresh:  clusterStateRefreshFunc(csClient.Clusters(), id, target),
+		Timeout:  timeout,
+		Delay:    60 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func WaitForWorkerAvailableForFeatureUpdate(cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerAvailableForFeatureUpdate(ctx context.Context, cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -336,14 +338,14 @@ func WaitForWorkerAvailableForFeatureUpdate(cluster string, timeout time.Duratio
 	log.Printf("Waiting for worker of the cluster (%s) to be available.", cluster)
 	id := cluster
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    workerStateRefreshFunc(csClient.Workers(), id, target),
-		Timeout:    timeout,
-		Delay:      60 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", workerProvisioning},
+		Target:   []string{workerNormal},
+		Refresh:  workerStateRefreshFunc(csClient.Workers(), id, target),
+		Timeout:  timeout,
+		Delay:    60 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
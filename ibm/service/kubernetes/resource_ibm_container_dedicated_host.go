@@ -12,12 +12,12 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 const (
@@ -392,35 +392,35 @@ func waitForDedicatedHostAvailable(ctx context.Context, dedicatedHostAPI v2.Dedi
 
 	log.Printf("[DEBUG] Waiting for the dedicated host (%s) for hostpool (%s) to be available.", hostID, hostPoolID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{DedicatedHostStateCreatePending, DedicatedHostStateCreating},
-		Target:     []string{DedicatedHostStateCreated},
-		Refresh:    dedicatedHostStateRefreshFunc(dedicatedHostAPI, hostID, hostPoolID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{DedicatedHostStateCreatePending, DedicatedHostStateCreating},
+		Target:   []string{DedicatedHostStateCreated},
+		Refresh:  dedicatedHostStateRefreshFunc(dedicatedHostAPI, hostID, hostPoolID, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
 func waitForDedicatedHostRemove(ctx context.Context, dedicatedHostAPI v2.DedicatedHost, hostID, hostPoolID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 
 	log.Printf("[DEBUG] Waiting for the dedicated host (%s) for hostpool (%s) to be removed.", hostID, hostPoolID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{DedicatedHostStateCreated, DedicatedHostStateDeleting},
-		Target:     []string{DedicatedHostStateDeleted},
-		Refresh:    dedicatedHostStateRefreshFunc(dedicatedHostAPI, hostID, hostPoolID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{DedicatedHostStateCreated, DedicatedHostStateDeleting},
+		Target:   []string{DedicatedHostStateDeleted},
+		Refresh:  dedicatedHostStateRefreshFunc(dedicatedHostAPI, hostID, hostPoolID, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func dedicatedHostStateRefreshFunc(dedicatedHostAPI v2.DedicatedHost, hostID, hostPoolID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
+func dedicatedHostStateRefreshFunc(dedicatedHostAPI v2.DedicatedHost, hostID, hostPoolID string, target v2.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		dedicatedHost, err := dedicatedHostAPI.GetDedicatedHost(hostID, hostPoolID, target)
 		if err != nil {
@@ -438,19 +438,19 @@ func waitForDedicatedHostPlacement(ctx context.Context, dedicatedHostAPI v2.Dedi
 
 	pendingStr := strconv.FormatBool(!placement)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{pendingStr},
-		Target:     []string{placementStr},
-		Refresh:    dedicatedHostPlacementRefreshFunc(dedicatedHostAPI, hostID, hostPoolID, target),
-		Timeout:    timeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{pendingStr},
+		Target:   []string{placementStr},
+		Refresh:  dedicatedHostPlacementRefreshFunc(dedicatedHostAPI, hostID, hostPoolID, target),
+		Timeout:  timeout,
+		Delay:    2 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func dedicatedHostPlacementRefreshFunc(dedicatedHostAPI v2.DedicatedHost, hostID, hostPoolID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
+func dedicatedHostPlacementRefreshFunc(dedicatedHostAPI v2.DedicatedHost, hostID, hostPoolID string, target v2.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		dedicatedHost, err := dedicatedHostAPI.GetDedicatedHost(hostID, hostPoolID, target)
 		if err != nil {
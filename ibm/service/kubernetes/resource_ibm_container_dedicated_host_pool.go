@@ -10,12 +10,12 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 const (
@@ -261,35 +261,35 @@ func waitForDedicatedHostPoolAvailable(ctx context.Context, dedicatedHostPoolAPI
 
 	log.Printf("[DEBUG] Waiting for the dedicated hostpool (%s) to be available.", hostPoolID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{DedicatedHostPoolStateCreating},
-		Target:     []string{DedicatedHostPoolStateCreated},
-		Refresh:    dedicatedHostPoolStateRefreshFunc(dedicatedHostPoolAPI, hostPoolID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{DedicatedHostPoolStateCreating},
+		Target:   []string{DedicatedHostPoolStateCreated},
+		Refresh:  dedicatedHostPoolStateRefreshFunc(dedicatedHostPoolAPI, hostPoolID, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
 func waitForDedicatedHostPoolRemove(ctx context.Context, dedicatedHostPoolAPI v2.DedicatedHostPool, hostPoolID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 
 	log.Printf("[DEBUG] Waiting for the dedicated hostpool (%s) to be removed.", hostPoolID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{DedicatedHostPoolStateCreated, DedicatedHostPoolStateDeleting},
-		Target:     []string{DedicatedHostPoolStateDeleted},
-		Refresh:    dedicatedHostPoolStateRefreshFunc(dedicatedHostPoolAPI, hostPoolID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{DedicatedHostPoolStateCreated, DedicatedHostPoolStateDeleting},
+		Target:   []string{DedicatedHostPoolStateDeleted},
+		Refresh:  dedicatedHostPoolStateRefreshFunc(dedicatedHostPoolAPI, hostPoolID, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func dedicatedHostPoolStateRefreshFunc(dedicatedHostPoolAPI v2.DedicatedHostPool, hostPoolID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
+func dedicatedHostPoolStateRefreshFunc(dedicatedHostPoolAPI v2.DedicatedHostPool, hostPoolID string, target v2.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		dedicatedHostPool, err := dedicatedHostPoolAPI.GetDedicatedHostPool(hostPoolID, target)
 		if err != nil {
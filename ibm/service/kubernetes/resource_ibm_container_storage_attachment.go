@@ -10,8 +10,8 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -124,7 +124,7 @@ func resourceIBMContainerVpcWorkerVolumeAttachmentCreate(context context.Context
 		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", clusterNameorID, workerID, volumeattached.Id))
-	_, attachErr := waitforVolumetoAttach(d, meta)
+	_, attachErr := waitforVolumetoAttach(context, d, meta)
 	if attachErr != nil {
 		return diag.FromErr(attachErr)
 	}
@@ -200,7 +200,7 @@ func resourceIBMContainerVpcWorkerVolumeAttachmentDelete(context context.Context
 		return diag.FromErr(fmt.Errorf("[ERROR] Failed to delete the volume attachment: %s", deleteErr))
 	}
 
-	_, err = waitForStorageAttachmentDelete(d, meta)
+	_, err = waitForStorageAttachmentDelete(context, d, meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for storage attachment (%s) to be deleted: %s", d.Id(), err))
 	}
@@ -241,7 +241,7 @@ func resourceIBMContainerVpcWorkerVolumeAttachmentExists(d *schema.ResourceData,
 	return true, nil
 }
 
-func waitforVolumetoAttach(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitforVolumetoAttach(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -262,7 +262,7 @@ func waitforVolumetoAttach(d *schema.ResourceData, meta interface{}) (interface{
 	workerID := parts[1]
 	volumeAttachmentID := parts[2]
 
-	createStateConf := &resource.StateChangeConf{
+	createStateConf := &waiter.StatusWaiter{
 		Pending: []string{volumeAttaching},
 		Target:  []string{volumeAttached},
 		Refresh: func() (interface{}, string, error) {
@@ -280,13 +280,13 @@ func waitforVolumetoAttach(d *schema.ResourceData, meta interface{}) (interface{
 		},
 		Timeout:                   d.Timeout(schema.TimeoutCreate),
 		Delay:                     10 * time.Second,
-		MinTimeout:                5 * time.Second,
+		Interval:                  5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.Wait(ctx)
 }
 
-func waitForStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForStorageAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -307,7 +307,7 @@ func waitForStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) (i
 	workerID := parts[1]
 	volumeAttachmentID := parts[2]
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{"inprogress"},
 		Target:  []string{"removed"},
 		Refresh: func() (interface{}, string, error) {
@@ -323,10 +323,10 @@ func waitForStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) (i
 			}
 			return volume, "inprogress", nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutDelete),
+		Delay:    10 * time.Second,
+		Interval: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
@@ -4,6 +4,7 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,18 +13,19 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMContainerVpcALB() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcALBEnable,
-		Read:     resourceIBMContainerVpcALBRead,
-		Update:   resourceIBMContainerVpcALBUpdate,
-		Delete:   resourceIBMContainerVpcALBDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcALBEnable,
+		ReadContext:   resourceIBMContainerVpcALBRead,
+		UpdateContext: resourceIBMContainerVpcALBUpdate,
+		DeleteContext: resourceIBMContainerVpcALBDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -100,10 +102,10 @@ func ResourceIBMContainerVpcALB() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerVpcALBEnable(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcALBEnable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var enable, disableDeployment bool
 	albID := d.Get("alb_id").(string)
@@ -112,12 +114,12 @@ func resourceIBMContainerVpcALBEnable(d *schema.ResourceData, meta interface{})
 	} else if v, ok := d.GetOkExists("disable_deployment"); ok {
 		disableDeployment = v.(bool)
 	} else {
-		return fmt.Errorf("[ERROR] Provide either `enable` or `disable_deployment`")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide either `enable` or `disable_deployment`"))
 	}
 
-	_, err = waitForVpcClusterAvailable(d, meta, albID, schema.TimeoutCreate)
+	_, err = waitForVpcClusterAvailable(ctx, d, meta, albID, schema.TimeoutCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for cluster resource availabilty (%s) : %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster resource availabilty (%s) : %s", d.Id(), err))
 	}
 
 	params := v2.AlbConfig{
@@ -128,34 +130,34 @@ func resourceIBMContainerVpcALBEnable(d *schema.ResourceData, meta interface{})
 	albAPI := albClient.Albs()
 	targetEnv, _ := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if enable {
 		err = albAPI.EnableAlb(params, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err = albAPI.DisableAlb(params, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(albID)
-	_, err = waitForVpcContainerALB(d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
+	_, err = waitForVpcContainerALB(ctx, d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create resource alb (%s) : %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for create resource alb (%s) : %s", d.Id(), err))
 	}
 
-	return resourceIBMContainerVpcALBRead(d, meta)
+	return resourceIBMContainerVpcALBRead(ctx, d, meta)
 }
 
-func resourceIBMContainerVpcALBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcALBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	albID := d.Id()
@@ -165,7 +167,7 @@ func resourceIBMContainerVpcALBRead(d *schema.ResourceData, meta interface{}) er
 
 	albConfig, err := albAPI.GetAlb(albID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("alb_type", albConfig.AlbType)
@@ -183,10 +185,10 @@ func resourceIBMContainerVpcALBRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMContainerVpcALBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcALBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albAPI := albClient.Albs()
 
@@ -195,9 +197,9 @@ func resourceIBMContainerVpcALBUpdate(d *schema.ResourceData, meta interface{})
 		disableDeployment := d.Get("disable_deployment").(bool)
 		albID := d.Id()
 
-		_, err = waitForVpcClusterAvailable(d, meta, albID, schema.TimeoutCreate)
+		_, err = waitForVpcClusterAvailable(ctx, d, meta, albID, schema.TimeoutCreate)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for cluster resource availabilty (%s) : %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster resource availabilty (%s) : %s", d.Id(), err))
 		}
 
 		params := v2.AlbConfig{
@@ -210,30 +212,30 @@ func resourceIBMContainerVpcALBUpdate(d *schema.ResourceData, meta interface{})
 		if enable {
 			err = albAPI.EnableAlb(params, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			err = albAPI.DisableAlb(params, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
-		_, err = waitForVpcContainerALB(d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
+		_, err = waitForVpcContainerALB(ctx, d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for updating resource alb (%s) : %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for updating resource alb (%s) : %s", d.Id(), err))
 		}
 
 	}
-	return resourceIBMContainerVpcALBRead(d, meta)
+	return resourceIBMContainerVpcALBRead(ctx, d, meta)
 }
 
-func waitForVpcContainerALB(d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
+func waitForVpcContainerALB(ctx context.Context, d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
 	albClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
 	}
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{"pending"},
 		Target:  []string{"active"},
 		Refresh: func() (interface{}, string, error) {
@@ -256,26 +258,26 @@ func waitForVpcContainerALB(d *schema.ResourceData, meta interface{}, albID, tim
 			}
 			return alb, "active", nil
 		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(timeout),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func resourceIBMContainerVpcALBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcALBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 
 	return nil
 }
 
-func waitForVpcClusterAvailable(d *schema.ResourceData, meta interface{}, albID, timeout string) (interface{}, error) {
+func waitForVpcClusterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, albID, timeout string) (interface{}, error) {
 	albClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
 	}
-	createStateConf := &resource.StateChangeConf{
+	createStateConf := &waiter.StatusWaiter{
 		Pending: []string{deployRequested, deployInProgress},
 		Target:  []string{ready},
 		Refresh: func() (interface{}, string, error) {
@@ -313,9 +315,9 @@ func waitForVpcClusterAvailable(d *schema.ResourceData, meta interface{}, albID,
 			}
 			return albInfo, ready, nil
 		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout:  d.Timeout(timeout),
+		Delay:    10 * time.Second,
+		Interval: 5 * time.Second,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.Wait(ctx)
 }
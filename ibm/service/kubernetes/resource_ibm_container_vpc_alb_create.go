@@ -4,20 +4,22 @@
 package kubernetes
 
 import (
+	"context"
 	"time"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMContainerVpcAlbCreateNew() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcAlbCreate,
-		Read:     resourceIBMContainerVpcALBRead,
-		Update:   resourceIBMContainerVpcALBUpdate,
-		Delete:   resourceIBMContainerVpcALBDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcAlbCreate,
+		ReadContext:   resourceIBMContainerVpcALBRead,
+		UpdateContext: resourceIBMContainerVpcALBUpdate,
+		DeleteContext: resourceIBMContainerVpcALBDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -100,11 +102,11 @@ func ResourceIBMContainerVpcAlbCreateNew() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerVpcAlbCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcAlbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	albClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albAPI := albClient.Albs()
 
@@ -131,7 +133,7 @@ func resourceIBMContainerVpcAlbCreate(d *schema.ResourceData, meta interface{})
 	//v2.AlbCreateResp
 	albResp, err := albAPI.CreateAlb(params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(albResp.Alb)
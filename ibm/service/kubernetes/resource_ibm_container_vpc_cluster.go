@@ -12,8 +12,8 @@ import (
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,6 +23,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 const (
@@ -37,16 +38,16 @@ const (
 
 func ResourceIBMContainerVpcCluster() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcClusterCreate,
-		Read:     resourceIBMContainerVpcClusterRead,
-		Update:   resourceIBMContainerVpcClusterUpdate,
-		Delete:   resourceIBMContainerVpcClusterDelete,
-		Exists:   resourceIBMContainerVpcClusterExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcClusterCreate,
+		ReadContext:   resourceIBMContainerVpcClusterRead,
+		UpdateContext: resourceIBMContainerVpcClusterUpdate,
+		DeleteContext: resourceIBMContainerVpcClusterDelete,
+		Exists:        resourceIBMContainerVpcClusterExists,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -450,13 +451,13 @@ func ResourceIBMContainerVpcClusterValidator() *validate.ResourceValidator {
 	return &ibmContainerVpcClusteresourceValidator
 }
 
-func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	vpcProvider := "vpc-gen2"
 
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	disablePublicServiceEndpoint := d.Get("disable_public_service_endpoint").(bool)
@@ -543,12 +544,12 @@ func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cls, err := csClient.Clusters().Create(params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(cls.ID)
@@ -556,45 +557,45 @@ func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface
 	if imageSecurityEnabled {
 		err = csClient.Clusters().EnableImageSecurityEnforcement(cls.ID, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	switch strings.ToLower(timeoutStage) {
 
 	case strings.ToLower(masterNodeReady):
-		_, err = waitForVpcClusterMasterAvailable(d, meta)
+		_, err = waitForVpcClusterMasterAvailable(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	case strings.ToLower(oneWorkerNodeReady):
-		_, err = waitForVpcClusterOneWorkerAvailable(d, meta)
+		_, err = waitForVpcClusterOneWorkerAvailable(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	case strings.ToLower(ingressReady):
-		_, err = waitForVpcClusterIngressAvailable(d, meta)
+		_, err = waitForVpcClusterIngressAvailable(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
-	return resourceIBMContainerVpcClusterUpdate(d, meta)
+	return resourceIBMContainerVpcClusterUpdate(ctx, d, meta)
 
 }
 
-func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
@@ -604,7 +605,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		oldList, newList := d.GetChange("tags")
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err))
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, cluster.CRN)
 		if err != nil {
@@ -644,7 +645,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		if err != nil {
 			log.Printf(
 				"An error occured during EnableKms (cluster: %s) error: %s", d.Id(), err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -654,7 +655,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		if d.HasChange("kube_version") {
 			ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			var masterVersion string
 			if v, ok := d.GetOk("kube_version"); ok {
@@ -669,31 +670,31 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 			Env, err := getClusterTargetHeader(d, meta)
 
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			Error := ClusterClient.Clusters().Update(clusterID, params, Env)
 			if Error != nil {
-				return Error
+				return diag.FromErr(Error)
 			}
-			_, err = WaitForVpcClusterVersionUpdate(d, meta, targetEnv)
+			_, err = WaitForVpcClusterVersionUpdate(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err))
 			}
 		}
 
 		csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		clusterID := d.Id()
 		cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err))
 		}
 
 		// Update the worker nodes after master node kube-version is updated.
@@ -707,7 +708,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
 			if err != nil {
 				d.Set("patch_version", nil)
-				return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err))
 			}
 
 			for index, worker := range workers {
@@ -724,40 +725,40 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 					// As API returns http response 204 NO CONTENT, error raised will be exempted.
 					if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
 						d.Set("patch_version", nil)
-						return fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err))
 					}
 
 					if waitForWorkerUpdate {
 						//1. wait for worker node to delete
-						_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
+						_, deleteError := waitForWorkerNodetoDelete(ctx, d, meta, targetEnv, worker.ID)
 						if deleteError != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID)
+							return diag.FromErr(fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID))
 						}
 
 						//2. wait for new workerNode
-						_, newWorkerError := waitForNewWorker(d, meta, targetEnv, workersCount)
+						_, newWorkerError := waitForNewWorker(ctx, d, meta, targetEnv, workersCount)
 						if newWorkerError != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Failed to spawn new worker node")
+							return diag.FromErr(fmt.Errorf("[ERROR] Failed to spawn new worker node"))
 						}
 
 						//3. Get new worker node ID and update the map
 						newWorkerID, index, newNodeError := getNewWorkerID(d, meta, targetEnv, workersInfo)
 						if newNodeError != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Unable to find the new worker node info")
+							return diag.FromErr(fmt.Errorf("[ERROR] Unable to find the new worker node info"))
 						}
 
 						delete(workersInfo, worker.ID)
 						workersInfo[newWorkerID] = index
 
 						//4. wait for the worker's version update and normal state
-						_, Err := WaitForVpcClusterWokersVersionUpdate(d, meta, targetEnv, cls.MasterKubeVersion, newWorkerID)
+						_, Err := WaitForVpcClusterWokersVersionUpdate(ctx, d, meta, targetEnv, cls.MasterKubeVersion, newWorkerID)
 						if Err != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf(
-								"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), Err)
+							return diag.FromErr(fmt.Errorf(
+								"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), Err))
 						}
 					}
 				}
@@ -775,14 +776,14 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 		ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().UpdateLabelsWorkerPool(clusterID, "default", labels, Env)
 		if err != nil {
-			return fmt.Errorf(
-				"[ERROR] Error updating the labels: %s", err)
+			return diag.FromErr(fmt.Errorf(
+				"[ERROR] Error updating the labels: %s", err))
 		}
 	}
 	if d.HasChange("taints") {
@@ -790,15 +791,15 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = ClusterClient.WorkerPools().UpdateWorkerPoolTaints(taintParam, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the taints: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the taints: %s", err))
 		}
 	}
 
@@ -806,14 +807,14 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		count := d.Get("worker_count").(int)
 		ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterID, "default", count, Env)
 		if err != nil {
-			return fmt.Errorf(
-				"[ERROR] Error updating the worker_count %d: %s", count, err)
+			return diag.FromErr(fmt.Errorf(
+				"[ERROR] Error updating the worker_count %d: %s", count, err))
 		}
 	}
 	if d.HasChange("zones") && !d.IsNewResource() {
@@ -839,12 +840,12 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 				}
 				err = csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error adding zone to conatiner vpc cluster: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error adding zone to conatiner vpc cluster: %s", err))
 				}
-				_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, "default", d.Timeout(schema.TimeoutCreate), targetEnv)
+				_, err = WaitForWorkerPoolAvailable(ctx, d, meta, clusterID, "default", d.Timeout(schema.TimeoutCreate), targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+					return diag.FromErr(fmt.Errorf(
+						"[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 				}
 
 			}
@@ -854,17 +855,17 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 				oldZone := zone.(map[string]interface{})
 				ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 				err = ClusterClient.WorkerPools().RemoveZone(clusterID, oldZone["name"].(string), "default", Env)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting zone to conatiner vpc cluster: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting zone to conatiner vpc cluster: %s", err))
 				}
-				_, err = WaitForV2WorkerZoneDeleted(clusterID, "default", oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+				_, err = WaitForV2WorkerZoneDeleted(ctx, clusterID, "default", oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", "default", clusterID, err)
+					return diag.FromErr(fmt.Errorf(
+						"[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", "default", clusterID, err))
 				}
 			}
 		}
@@ -890,25 +891,25 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	return resourceIBMContainerVpcClusterRead(d, meta)
+	return resourceIBMContainerVpcClusterRead(ctx, d, meta)
 }
-func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForV2WorkerZoneDeleted(ctx context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
 	}
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{workerDeleteState},
-		Refresh:    workerPoolV2ZoneDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"deleting"},
+		Target:   []string{workerDeleteState},
+		Refresh:  workerPoolV2ZoneDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
-func workerPoolV2ZoneDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID, zone string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
+func workerPoolV2ZoneDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID, zone string, target v2.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, workerPoolNameOrID, true, target)
 		if err != nil {
@@ -925,30 +926,30 @@ func workerPoolV2ZoneDeleteStateRefreshFunc(client v2.Workers, instanceID, worke
 		return workerFields, workerDeleteState, nil
 	}
 }
-func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albsAPI := csClient.Albs()
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
 	cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err))
 	}
 
 	workerPool, err := csClient.WorkerPools().GetWorkerPool(clusterID, "default", targetEnv)
 	if err != nil {
 		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
 			if apiErr.StatusCode() != 404 && !strings.Contains(apiErr.Description(), "The specified worker pool could not be found") {
-				return fmt.Errorf("[ERROR] Error retrieving worker pool of the cluster %s: %s", workerPool.ID, err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving worker pool of the cluster %s: %s", workerPool.ID, err))
 			}
 		}
 	}
@@ -968,7 +969,7 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 
 	albs, err := albsAPI.ListClusterAlbs(clusterID, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") {
-		return fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", clusterID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", clusterID, err))
 	}
 
 	d.Set("name", cls.Name)
@@ -1015,7 +1016,7 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 	d.Set("tags", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/kubernetes/clusters")
 	d.Set(flex.ResourceName, cls.Name)
@@ -1031,15 +1032,15 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := d.Id()
 
@@ -1061,17 +1062,17 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 	forceDeleteStorage := d.Get("force_delete_storage").(bool)
 	err = csClient.Clusters().Delete(clusterID, targetEnv, forceDeleteStorage)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting cluster: %s", err))
 	}
-	_, err = waitForVpcClusterDelete(d, meta)
+	_, err = waitForVpcClusterDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sess1, err := vpcClient(meta)
 	if err == nil {
 		listlbOptions := &vpcv1.ListLoadBalancersOptions{}
-		lbs, response, err1 := sess1.ListLoadBalancers(listlbOptions)
+		lbs, response, err1 := sess1.ListLoadBalancersWithContext(ctx, listlbOptions)
 		if err1 != nil {
 			log.Printf("Error Retrieving vpc load balancers: %s\n%s", err, response)
 		}
@@ -1080,7 +1081,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 				if strings.Contains(*lb.Name, clusterID) {
 					log.Println("Deleting Load Balancer", *lb.Name)
 					id := *lb.ID
-					_, err = isWaitForLBDeleted(sess1, id, d.Timeout(schema.TimeoutDelete))
+					_, err = isWaitForLBDeleted(ctx, sess1, id, d.Timeout(schema.TimeoutDelete))
 					if err != nil {
 						log.Printf("Error waiting for vpc load balancer to be deleted: %s\n", err)
 
@@ -1097,27 +1098,29 @@ func vpcClient(meta interface{}) (*vpcv1.VpcV1, error) {
 	sess, err := meta.(conns.ClientSession).VpcV1API()
 	return sess, err
 }
-func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBDeleted(ctx context.Context, lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", "deleting"},
-		Target:     []string{"done", "failed"},
-		Refresh:    isLBDeleteRefreshFunc(lbc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("load balancer %s to be deleted", id),
+		Pending:     []string{"retry", "deleting"},
+		Target:      []string{"done"},
+		Failed:      []string{"failed"},
+		Refresh:     isLBDeleteRefreshFunc(ctx, lbc, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
-func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLBDeleteRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is lb delete function here")
 		getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
-		lb, response, err := lbc.GetLoadBalancer(getLoadBalancerOptions)
+		lb, response, err := lbc.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lb, "done", nil
@@ -1128,7 +1131,7 @@ func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFun
 	}
 }
 
-func waitForVpcClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1138,7 +1141,7 @@ func waitForVpcClusterDelete(d *schema.ResourceData, meta interface{}) (interfac
 		return nil, err
 	}
 	clusterID := d.Id()
-	deleteStateConf := &resource.StateChangeConf{
+	deleteStateConf := &waiter.StatusWaiter{
 		Pending: []string{clusterDeletePending},
 		Target:  []string{clusterDeleted},
 		Refresh: func() (interface{}, string, error) {
@@ -1151,16 +1154,16 @@ func waitForVpcClusterDelete(d *schema.ResourceData, meta interface{}) (interfac
 			}
 			return cluster, clusterDeletePending, nil
 		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        10 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 5 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutDelete),
+		Delay:       10 * time.Second,
+		Interval:    5 * time.Second,
+		MaxInterval: 5 * time.Second,
 	}
 
-	return deleteStateConf.WaitForState()
+	return deleteStateConf.Wait(ctx)
 }
 
-func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterOneWorkerAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1170,7 +1173,7 @@ func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{
 		return nil, err
 	}
 	clusterID := d.Id()
-	createStateConf := &resource.StateChangeConf{
+	createStateConf := &waiter.StatusWaiter{
 		Pending: []string{deployRequested, deployInProgress},
 		Target:  []string{normal},
 		Refresh: func() (interface{}, string, error) {
@@ -1195,13 +1198,13 @@ func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{
 		},
 		Timeout:                   d.Timeout(schema.TimeoutCreate),
 		Delay:                     10 * time.Second,
-		MinTimeout:                5 * time.Second,
+		Interval:                  5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.Wait(ctx)
 }
 
-func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterMasterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1211,7 +1214,7 @@ func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{})
 		return nil, err
 	}
 	clusterID := d.Id()
-	createStateConf := &resource.StateChangeConf{
+	createStateConf := &waiter.StatusWaiter{
 		Pending: []string{deployRequested, deployInProgress},
 		Target:  []string{ready},
 		Refresh: func() (interface{}, string, error) {
@@ -1229,13 +1232,13 @@ func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{})
 		},
 		Timeout:                   d.Timeout(schema.TimeoutCreate),
 		Delay:                     10 * time.Second,
-		MinTimeout:                5 * time.Second,
+		Interval:                  5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.Wait(ctx)
 }
 
-func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterIngressAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1245,9 +1248,10 @@ func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{})
 		return nil, err
 	}
 	clusterID := d.Id()
-	createStateConf := &resource.StateChangeConf{
-		Pending: []string{deployRequested, deployInProgress},
-		Target:  []string{ready},
+	createStateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("the Ingress subdomain of cluster %s", clusterID),
+		Pending:     []string{deployRequested, deployInProgress},
+		Target:      []string{ready},
 		Refresh: func() (interface{}, string, error) {
 			clusterInfo, clusterInfoErr := csClient.Clusters().GetCluster(clusterID, targetEnv)
 
@@ -1263,10 +1267,10 @@ func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{})
 		},
 		Timeout:                   d.Timeout(schema.TimeoutCreate),
 		Delay:                     10 * time.Second,
-		MinTimeout:                5 * time.Second,
+		Interval:                  5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.Wait(ctx)
 }
 
 func getVpcClusterTargetHeader(d *schema.ResourceData, meta interface{}) (v2.ClusterTargetHeader, error) {
@@ -1304,7 +1308,7 @@ func resourceIBMContainerVpcClusterExists(d *schema.ResourceData, meta interface
 }
 
 // WaitForVpcClusterVersionUpdate Waits for cluster creation
-func WaitForVpcClusterVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForVpcClusterVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1312,20 +1316,20 @@ func WaitForVpcClusterVersionUpdate(d *schema.ResourceData, meta interface{}, ta
 	log.Printf("Waiting for cluster (%s) version to be updated.", d.Id())
 	id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending:                   []string{"retry", versionUpdating},
 		Target:                    []string{clusterNormal},
 		Refresh:                   vpcClusterVersionRefreshFunc(csClient.Clusters(), id, d, target),
 		Timeout:                   d.Timeout(schema.TimeoutUpdate),
 		Delay:                     10 * time.Second,
-		MinTimeout:                10 * time.Second,
+		Interval:                  10 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *schema.ResourceData, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
+func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *schema.ResourceData, target v2.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		cls, err := client.GetCluster(instanceID, target)
 		if err != nil {
@@ -1342,7 +1346,7 @@ func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *sche
 }
 
 // WaitForVpcClusterWokersVersionUpdate Waits for Cluster version Update
-func WaitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, masterVersion, workerID string) (interface{}, error) {
+func WaitForVpcClusterWokersVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, masterVersion, workerID string) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1350,20 +1354,20 @@ func WaitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface
 
 	log.Printf("Waiting for worker (%s) version to be updated.", workerID)
 	clusterID := d.Id()
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending:                   []string{"retry", versionUpdating},
 		Target:                    []string{workerNormal},
 		Refresh:                   vpcClusterWorkersVersionRefreshFunc(csClient.Workers(), workerID, clusterID, d, target, masterVersion),
 		Timeout:                   d.Timeout(schema.TimeoutUpdate),
 		Delay:                     10 * time.Second,
-		MinTimeout:                10 * time.Second,
+		Interval:                  10 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID string, d *schema.ResourceData, target v2.ClusterTargetHeader, masterVersion string) resource.StateRefreshFunc {
+func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID string, d *schema.ResourceData, target v2.ClusterTargetHeader, masterVersion string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		worker, err := client.Get(clusterID, workerID, target)
 		if err != nil {
@@ -1377,7 +1381,7 @@ func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID
 	}
 }
 
-func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
+func waitForWorkerNodetoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
 
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -1385,7 +1389,7 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 	}
 
 	clusterID := d.Id()
-	deleteStateConf := &resource.StateChangeConf{
+	deleteStateConf := &waiter.StatusWaiter{
 		Pending: []string{workerDeletePending},
 		Target:  []string{workerDeleteState},
 		Refresh: func() (interface{}, string, error) {
@@ -1398,22 +1402,22 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 			}
 			return worker, workerDeletePending, nil
 		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        10 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 5 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutDelete),
+		Delay:       10 * time.Second,
+		Interval:    5 * time.Second,
+		MaxInterval: 5 * time.Second,
 	}
-	return deleteStateConf.WaitForState()
+	return deleteStateConf.Wait(ctx)
 }
 
-func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
+func waitForNewWorker(ctx context.Context, d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
 	}
 
 	clusterID := d.Id()
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{"creating"},
 		Target:  []string{"created"},
 		Refresh: func() (interface{}, string, error) {
@@ -1426,12 +1430,12 @@ func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.Clu
 			}
 			return workers, "creating", nil
 		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        10 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 5 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutDelete),
+		Delay:       10 * time.Second,
+		Interval:    5 * time.Second,
+		MaxInterval: 5 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
 func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersInfo map[string]int) (string, int, error) {
@@ -4,12 +4,13 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
@@ -18,6 +19,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 const (
@@ -27,12 +29,12 @@ const (
 func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcWorkerPoolCreate,
-		Update:   resourceIBMContainerVpcWorkerPoolUpdate,
-		Read:     resourceIBMContainerVpcWorkerPoolRead,
-		Delete:   resourceIBMContainerVpcWorkerPoolDelete,
-		Exists:   resourceIBMContainerVpcWorkerPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcWorkerPoolCreate,
+		UpdateContext: resourceIBMContainerVpcWorkerPoolUpdate,
+		ReadContext:   resourceIBMContainerVpcWorkerPoolRead,
+		DeleteContext: resourceIBMContainerVpcWorkerPoolDelete,
+		Exists:        resourceIBMContainerVpcWorkerPoolExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
@@ -189,11 +191,11 @@ func ResourceIBMContainerVPCWorkerPoolValidator() *validate.ResourceValidator {
 	return &containerVPCWorkerPoolTaintsValidator
 }
 
-func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterNameorID := d.Get("cluster").(string)
@@ -252,26 +254,26 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	res, err := workerPoolsAPI.CreateWorkerPool(params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
 
 	//wait for workerpool availability
-	_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
+	_, err = WaitForWorkerPoolAvailable(ctx, d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 	}
 
-	return resourceIBMContainerVpcWorkerPoolUpdate(d, meta)
+	return resourceIBMContainerVpcWorkerPoolUpdate(ctx, d, meta)
 }
 
-func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("labels") && !d.IsNewResource() {
 		clusterNameOrID := d.Get("cluster").(string)
@@ -286,17 +288,17 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().UpdateLabelsWorkerPool(clusterNameOrID, workerPoolName, labels, Env)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the labels: %s", err))
 		}
 	}
 	if d.HasChange("taints") {
@@ -306,15 +308,15 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = ClusterClient.WorkerPools().UpdateWorkerPoolTaints(taintParam, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the taints: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the taints: %s", err))
 		}
 	}
 
@@ -324,17 +326,17 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		count := d.Get("worker_count").(int)
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPoolName, count, Env)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the worker_count %d: %s", count, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the worker_count %d: %s", count, err))
 		}
 	}
 
@@ -343,7 +345,7 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		workerPoolName := d.Get("worker_pool_name").(string)
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		oldList, newList := d.GetChange("zones")
 		if oldList == nil {
@@ -359,7 +361,7 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		if len(add) > 0 {
 			csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			for _, zone := range add {
 				newZone := zone.(map[string]interface{})
@@ -371,11 +373,11 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 				}
 				err = csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error adding zone to conatiner vpc cluster: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error adding zone to conatiner vpc cluster: %s", err))
 				}
-				_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
+				_, err = WaitForWorkerPoolAvailable(ctx, d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 				}
 
 			}
@@ -385,21 +387,21 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 				oldZone := zone.(map[string]interface{})
 				ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 				err = ClusterClient.WorkerPools().RemoveZone(clusterID, oldZone["name"].(string), workerPoolName, Env)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting zone to conatiner vpc cluster: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting zone to conatiner vpc cluster: %s", err))
 				}
-				_, err = WaitForV2WorkerZoneDeleted(clusterID, workerPoolName, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+				_, err = WaitForV2WorkerZoneDeleted(ctx, clusterID, workerPoolName, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err))
 				}
 			}
 		}
 	}
-	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
+	return resourceIBMContainerVpcWorkerPoolRead(ctx, d, meta)
 }
 
 func expandWorkerPoolTaints(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolName string) v2.WorkerPoolTaintRequest {
@@ -433,14 +435,14 @@ func flattenWorkerPoolTaints(taints v2.GetWorkerPoolResponse) []map[string]inter
 	}
 	return taintslist
 }
-func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	workerPoolID := parts[1]
@@ -448,12 +450,12 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var zones = make([]map[string]interface{}, 0)
@@ -469,7 +471,7 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 
 	cls, err := wpClient.Clusters().GetCluster(cluster, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err))
 	}
 
 	d.Set("worker_pool_name", workerPool.PoolName)
@@ -492,20 +494,20 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 	}
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/kubernetes/clusters")
 	return nil
 }
 
-func resourceIBMContainerVpcWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameorID := parts[0]
 	workerPoolNameorID := parts[1]
@@ -513,16 +515,16 @@ func resourceIBMContainerVpcWorkerPoolDelete(d *schema.ResourceData, meta interf
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = workerPoolsAPI.DeleteWorkerPool(clusterNameorID, workerPoolNameorID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = WaitForVpcWorkerDelete(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	_, err = WaitForVpcWorkerDelete(ctx, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err))
 	}
 	d.SetId("")
 	return nil
@@ -566,7 +568,7 @@ func resourceIBMContainerVpcWorkerPoolExists(d *schema.ResourceData, meta interf
 }
 
 // WaitForWorkerPoolAvailable Waits for worker creation
-func WaitForWorkerPoolAvailable(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerPoolAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -574,19 +576,19 @@ func WaitForWorkerPoolAvailable(d *schema.ResourceData, meta interface{}, cluste
 	log.Printf("Waiting for workerpool (%s) to be available.", d.Id())
 	// id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"provision_pending"},
-		Target:     []string{workerDesired},
-		Refresh:    vpcWorkerPoolStateRefreshFunc(wpClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"provision_pending"},
+		Target:   []string{workerDesired},
+		Refresh:  vpcWorkerPoolStateRefreshFunc(wpClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func vpcWorkerPoolStateRefreshFunc(client v2.Workers, instanceID string, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
+func vpcWorkerPoolStateRefreshFunc(client v2.Workers, instanceID string, workerPoolNameOrID string, target v2.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, "", false, target)
 		if err != nil {
@@ -607,25 +609,25 @@ func vpcWorkerPoolStateRefreshFunc(client v2.Workers, instanceID string, workerP
 	}
 }
 
-func WaitForVpcWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForVpcWorkerDelete(ctx context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{workerDeleteState},
-		Refresh:    vpcworkerPoolDeleteStateRefreshFunc(wpClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"deleting"},
+		Target:   []string{workerDeleteState},
+		Refresh:  vpcworkerPoolDeleteStateRefreshFunc(wpClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func vpcworkerPoolDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
+func vpcworkerPoolDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID string, target v2.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, "", true, target)
 		if err != nil {
@@ -4,11 +4,12 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
@@ -16,17 +17,18 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 func ResourceIBMContainerWorkerPool() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerWorkerPoolCreate,
-		Read:     resourceIBMContainerWorkerPoolRead,
-		Update:   resourceIBMContainerWorkerPoolUpdate,
-		Delete:   resourceIBMContainerWorkerPoolDelete,
-		Exists:   resourceIBMContainerWorkerPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerWorkerPoolCreate,
+		ReadContext:   resourceIBMContainerWorkerPoolRead,
+		UpdateContext: resourceIBMContainerWorkerPoolUpdate,
+		DeleteContext: resourceIBMContainerWorkerPoolDelete,
+		Exists:        resourceIBMContainerWorkerPoolExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(90 * time.Minute),
 		},
@@ -196,11 +198,11 @@ func ResourceIBMContainerWorkerPoolValidator() *validate.ResourceValidator {
 	containerWorkerPoolTaintsValidator := validate.ResourceValidator{ResourceName: "ibm_container_worker_pool", Schema: validateSchema}
 	return &containerWorkerPoolTaintsValidator
 }
-func resourceIBMContainerWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterNameorID := d.Get("cluster").(string)
@@ -242,27 +244,27 @@ func resourceIBMContainerWorkerPoolCreate(d *schema.ResourceData, meta interface
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	res, err := workerPoolsAPI.CreateWorkerPool(clusterNameorID, params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
 
-	return resourceIBMContainerWorkerPoolRead(d, meta)
+	return resourceIBMContainerWorkerPoolRead(ctx, d, meta)
 }
 
-func resourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	workerPoolID := parts[1]
@@ -270,12 +272,12 @@ func resourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	machineType := workerPool.MachineType
@@ -304,38 +306,38 @@ func resourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}
 	}
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/kubernetes/clusters")
 	return nil
 }
 
-func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameorID := parts[0]
 	workerPoolNameorID := parts[1]
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("size_per_zone") {
 		err = workerPoolsAPI.ResizeWorkerPool(clusterNameorID, workerPoolNameorID, d.Get("size_per_zone").(int), targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = WaitForWorkerNormal(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		_, err = WaitForWorkerNormal(ctx, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPoolNameorID, clusterNameorID, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPoolNameorID, clusterNameorID, err))
 		}
 	}
 	if d.HasChange("labels") {
@@ -347,12 +349,12 @@ func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface
 		}
 		err = workerPoolsAPI.UpdateLabelsWorkerPool(clusterNameorID, workerPoolNameorID, labels, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = WaitForWorkerNormal(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		_, err = WaitForWorkerNormal(ctx, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPoolNameorID, clusterNameorID, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPoolNameorID, clusterNameorID, err))
 		}
 	}
 	if d.HasChange("taints") {
@@ -360,29 +362,29 @@ func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface
 
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = ClusterClient.WorkerPools().UpdateWorkerPoolTaints(taintParam, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the taints: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the taints: %s", err))
 		}
 	}
 
-	return resourceIBMContainerWorkerPoolRead(d, meta)
+	return resourceIBMContainerWorkerPoolRead(ctx, d, meta)
 }
 
-func resourceIBMContainerWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameorID := parts[0]
 	workerPoolNameorID := parts[1]
@@ -390,16 +392,16 @@ func resourceIBMContainerWorkerPoolDelete(d *schema.ResourceData, meta interface
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = workerPoolsAPI.DeleteWorkerPool(clusterNameorID, workerPoolNameorID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = WaitForWorkerDelete(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+	_, err = WaitForWorkerDelete(ctx, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err))
 	}
 	return nil
 }
@@ -438,25 +440,25 @@ func resourceIBMContainerWorkerPoolExists(d *schema.ResourceData, meta interface
 	return workerPool.ID == workerPoolID, nil
 }
 
-func WaitForWorkerNormal(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerNormal(ctx context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    workerPoolStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", workerProvisioning},
+		Target:   []string{workerNormal},
+		Refresh:  workerPoolStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func workerPoolStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
+func workerPoolStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID string, target v1.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, workerPoolNameOrID, false, target)
 		if err != nil {
@@ -474,25 +476,25 @@ func workerPoolStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrI
 	}
 }
 
-func WaitForWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerDelete(ctx context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{workerDeleteState},
-		Refresh:    workerPoolDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"deleting"},
+		Target:   []string{workerDeleteState},
+		Refresh:  workerPoolDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func workerPoolDeleteStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
+func workerPoolDeleteStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID string, target v1.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, "", true, target)
 		if err != nil {
@@ -4,28 +4,30 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 func ResourceIBMContainerWorkerPoolZoneAttachment() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerWorkerPoolZoneAttachmentCreate,
-		Read:     resourceIBMContainerWorkerPoolZoneAttachmentRead,
-		Update:   resourceIBMContainerWorkerPoolZoneAttachmentUpdate,
-		Delete:   resourceIBMContainerWorkerPoolZoneAttachmentDelete,
-		Exists:   resourceIBMContainerWorkerPoolZoneAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerWorkerPoolZoneAttachmentCreate,
+		ReadContext:   resourceIBMContainerWorkerPoolZoneAttachmentRead,
+		UpdateContext: resourceIBMContainerWorkerPoolZoneAttachmentUpdate,
+		DeleteContext: resourceIBMContainerWorkerPoolZoneAttachmentDelete,
+		Exists:        resourceIBMContainerWorkerPoolZoneAttachmentExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Update: schema.DefaultTimeout(90 * time.Minute),
@@ -97,10 +99,10 @@ func ResourceIBMContainerWorkerPoolZoneAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	zone := d.Get("zone").(string)
@@ -114,7 +116,7 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 	}
 
 	if publicVLAN != "" && privateVLAN == "" {
-		return fmt.Errorf("[ERROR] A private_vlan_id must be specified if a public_vlan_id is specified.")
+		return diag.FromErr(fmt.Errorf("[ERROR] A private_vlan_id must be specified if a public_vlan_id is specified."))
 	}
 
 	workerPoolZoneNetwork := v1.WorkerPoolZoneNetwork{
@@ -133,18 +135,18 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = workerPoolsAPI.AddZone(cluster, workerPool, workerPoolZone, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, workerPool, zone))
 
-	_, err = WaitForWorkerZoneNormal(cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+	_, err = WaitForWorkerZoneNormal(ctx, cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPool, cluster, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPool, cluster, err))
 	}
 
 	var waitTillALBs bool
@@ -153,24 +155,24 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 	}
 
 	if waitTillALBs {
-		_, err = waitForWorkerZoneALB(cluster, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		_, err = waitForWorkerZoneALB(ctx, cluster, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for ALBs in zone (%s) of cluster (%s) to become ready: %s", zone, cluster, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for ALBs in zone (%s) of cluster (%s) to become ready: %s", zone, cluster, err))
 		}
 	}
 
-	return resourceIBMContainerWorkerPoolZoneAttachmentRead(d, meta)
+	return resourceIBMContainerWorkerPoolZoneAttachmentRead(ctx, d, meta)
 
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	workerPool := parts[1]
@@ -179,12 +181,12 @@ func resourceIBMContainerWorkerPoolZoneAttachmentRead(d *schema.ResourceData, me
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerPoolRes, err := workerPoolsAPI.GetWorkerPool(cluster, workerPool, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	zones := workerPoolRes.Zones
 
@@ -204,10 +206,10 @@ func resourceIBMContainerWorkerPoolZoneAttachmentRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerPoolsAPI := csClient.WorkerPools()
@@ -216,37 +218,37 @@ func resourceIBMContainerWorkerPoolZoneAttachmentUpdate(d *schema.ResourceData,
 		privateVLAN := d.Get("private_vlan_id").(string)
 		publicVLAN := d.Get("public_vlan_id").(string)
 		if publicVLAN != "" && privateVLAN == "" {
-			return fmt.Errorf("[ERROR] A private VLAN must be specified if a public VLAN is specified")
+			return diag.FromErr(fmt.Errorf("[ERROR] A private VLAN must be specified if a public VLAN is specified"))
 		}
 		targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cluster := parts[0]
 		workerPool := parts[1]
 		zone := parts[2]
 		err = workerPoolsAPI.UpdateZoneNetwork(cluster, zone, workerPool, privateVLAN, publicVLAN, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMContainerWorkerPoolZoneAttachmentRead(d, meta)
+	return resourceIBMContainerWorkerPoolZoneAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	workerPool := parts[1]
@@ -255,15 +257,15 @@ func resourceIBMContainerWorkerPoolZoneAttachmentDelete(d *schema.ResourceData,
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = workerPoolsAPI.RemoveZone(cluster, zone, workerPool, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = WaitForWorkerZoneDeleted(cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	_, err = WaitForWorkerZoneDeleted(ctx, cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPool, cluster, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPool, cluster, err))
 	}
 
 	return nil
@@ -310,25 +312,25 @@ func resourceIBMContainerWorkerPoolZoneAttachmentExists(d *schema.ResourceData,
 	return zone.ID == zoneID, nil
 }
 
-func WaitForWorkerZoneNormal(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerZoneNormal(ctx context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    workerPoolZoneStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", workerProvisioning},
+		Target:   []string{workerNormal},
+		Refresh:  workerPoolZoneStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func workerPoolZoneStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
+func workerPoolZoneStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, workerPoolNameOrID, false, target)
 		if err != nil {
@@ -348,25 +350,25 @@ func workerPoolZoneStateRefreshFunc(client v1.Workers, instanceID, workerPoolNam
 	}
 }
 
-func WaitForWorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerZoneDeleted(ctx context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{workerDeleteState},
-		Refresh:    workerPoolZoneDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"deleting"},
+		Target:   []string{workerDeleteState},
+		Refresh:  workerPoolZoneDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func workerPoolZoneDeleteStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
+func workerPoolZoneDeleteStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, workerPoolNameOrID, true, target)
 		if err != nil {
@@ -384,25 +386,25 @@ func workerPoolZoneDeleteStateRefreshFunc(client v1.Workers, instanceID, workerP
 	}
 }
 
-func waitForWorkerZoneALB(clusterNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func waitForWorkerZoneALB(ctx context.Context, clusterNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"pending"},
-		Target:     []string{"ready"},
-		Refresh:    workerZoneALBStateRefreshFunc(csClient.Albs(), clusterNameOrID, zone, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"pending"},
+		Target:   []string{"ready"},
+		Refresh:  workerZoneALBStateRefreshFunc(csClient.Albs(), clusterNameOrID, zone, target),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func workerZoneALBStateRefreshFunc(client v1.Albs, instanceID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
+func workerZoneALBStateRefreshFunc(client v1.Albs, instanceID, zone string, target v1.ClusterTargetHeader) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		// Get all ALBs associated with cluster
 		albs, err := client.ListClusterALBs(instanceID, target)
@@ -4,11 +4,13 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 const (
@@ -34,11 +37,11 @@ const (
 
 func ResourceIBMObLogging() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLoggingCreate,
-		Read:     resourceIBMLoggingRead,
-		Update:   resourceIBMLoggingUpdate,
-		Delete:   resourceIBMLoggingDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLoggingCreate,
+		ReadContext:   resourceIBMLoggingRead,
+		UpdateContext: resourceIBMLoggingUpdate,
+		DeleteContext: resourceIBMLoggingDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(45 * time.Minute),
@@ -118,7 +121,7 @@ func ResourceIBMObLogging() *schema.Resource {
 		},
 	}
 }
-func waitForClusterIntegration(d *schema.ResourceData, meta interface{}, clusterID string) (interface{}, error) {
+func waitForClusterIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}, clusterID string) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -128,7 +131,7 @@ func waitForClusterIntegration(d *schema.ResourceData, meta interface{}, cluster
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{deployRequested, deployInProgress},
 		Target:  []string{ready},
 		Refresh: func() (interface{}, string, error) {
@@ -141,17 +144,17 @@ func waitForClusterIntegration(d *schema.ResourceData, meta interface{}, cluster
 			}
 			return clusterFields, deployInProgress, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func resourceIBMLoggingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLoggingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var ingestionkey string
@@ -159,9 +162,9 @@ func resourceIBMLoggingCreate(d *schema.ResourceData, meta interface{}) error {
 
 	//Read cluster ID and logging instanceID
 	clusterName := d.Get(obLoggingCluster).(string)
-	_, err = waitForClusterIntegration(d, meta, clusterName)
+	_, err = waitForClusterIntegration(ctx, d, meta, clusterName)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for master node to be availabe before integrating Logging Instance: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for master node to be availabe before integrating Logging Instance: %s", err))
 	}
 
 	loggingInstanceID := d.Get(obLoggingInstanceID).(string)
@@ -186,11 +189,11 @@ func resourceIBMLoggingCreate(d *schema.ResourceData, meta interface{}) error {
 
 	targetEnv, err := getLoggingTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var logging v2.LoggingCreateResponse
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		var err error
 		logging, err = client.Logging().CreateLoggingConfig(params, targetEnv)
 		if err != nil {
@@ -208,12 +211,12 @@ func resourceIBMLoggingCreate(d *schema.ResourceData, meta interface{}) error {
 		logging, err = client.Logging().CreateLoggingConfig(params, targetEnv)
 	}
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error latching logging instance to cluster: %w", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error latching logging instance to cluster: %w", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterName, logging.InstanceID))
 
-	return resourceIBMLoggingRead(d, meta)
+	return resourceIBMLoggingRead(ctx, d, meta)
 }
 
 func getLoggingTargetHeader(d *schema.ResourceData, meta interface{}) (v2.LoggingTargetHeader, error) {
@@ -235,26 +238,26 @@ func getLoggingTargetHeader(d *schema.ResourceData, meta interface{}) (v2.Loggin
 	return targetEnv, nil
 }
 
-func resourceIBMLoggingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLoggingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(parts) < 2 {
-		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of clusterNameorID/loggingID", d.Id())
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of clusterNameorID/loggingID", d.Id()))
 	}
 	clusterName := parts[0]
 	loggingID := parts[1]
 
 	targetEnv, err := getLoggingTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	config, err := client.Logging().GetLoggingConfig(clusterName, loggingID, targetEnv)
@@ -265,7 +268,7 @@ func resourceIBMLoggingRead(d *schema.ResourceData, meta interface{}) error {
 				return nil
 			}
 		}
-		return fmt.Errorf("[ERROR] Error in GetLoggingConfig: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in GetLoggingConfig: %s", err))
 	}
 
 	d.Set(obLoggingPrivateEndpoint, config.PrivateEndpoint)
@@ -281,26 +284,26 @@ func resourceIBMLoggingRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMLoggingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLoggingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	hasChanged := false
 	idChanged := false
 
 	client, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getLoggingTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	loggingUpdateModel := v2.LoggingUpdateRequest{}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	loggingID := parts[1]
@@ -334,29 +337,29 @@ func resourceIBMLoggingUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, err := client.Logging().UpdateLoggingConfig(loggingUpdateModel, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		} else if idChanged {
 			d.SetId(fmt.Sprintf("%s/%s", clusterName, loggingUpdateModel.NewInstance))
 		}
 	}
 
-	return resourceIBMLoggingRead(d, meta)
+	return resourceIBMLoggingRead(ctx, d, meta)
 }
 
-func resourceIBMLoggingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLoggingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getLoggingTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterName := parts[0]
 	loggingID := parts[1]
@@ -375,7 +378,7 @@ func resourceIBMLoggingDelete(d *schema.ResourceData, meta interface{}) error {
 				return nil
 			}
 		}
-		return fmt.Errorf("[ERROR] Error in DeleteLoggingConfig: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in DeleteLoggingConfig: %s", err))
 	}
 	d.SetId("")
 	return nil
@@ -4,11 +4,13 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -34,11 +36,11 @@ const (
 
 func ResourceIBMObMonitoring() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMMonitoringCreate,
-		Read:     resourceIBMMonitoringRead,
-		Update:   resourceIBMMonitoringUpdate,
-		Delete:   resourceIBMMonitoringDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMMonitoringCreate,
+		ReadContext:   resourceIBMMonitoringRead,
+		UpdateContext: resourceIBMMonitoringUpdate,
+		DeleteContext: resourceIBMMonitoringDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(45 * time.Minute),
@@ -119,11 +121,11 @@ func ResourceIBMObMonitoring() *schema.Resource {
 	}
 }
 
-func resourceIBMMonitoringCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMonitoringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var ingestionkey string
@@ -131,9 +133,9 @@ func resourceIBMMonitoringCreate(d *schema.ResourceData, meta interface{}) error
 
 	//Read cluster ID and sysdif instanceID
 	clusterName := d.Get(obMonitoringCluster).(string)
-	_, err = waitForClusterIntegration(d, meta, clusterName)
+	_, err = waitForClusterIntegration(ctx, d, meta, clusterName)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for master node to be availabe before integrating Monitoring Instance: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for master node to be availabe before integrating Monitoring Instance: %s", err))
 	}
 	sysdigInstanceID := d.Get(obMonitoringInstanceID).(string)
 
@@ -157,11 +159,11 @@ func resourceIBMMonitoringCreate(d *schema.ResourceData, meta interface{}) error
 
 	targetEnv, err := getMonitoringTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var monitoring v2.MonitoringCreateResponse
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		var err error
 		monitoring, err = client.Monitoring().CreateMonitoringConfig(params, targetEnv)
 		if err != nil {
@@ -179,12 +181,12 @@ func resourceIBMMonitoringCreate(d *schema.ResourceData, meta interface{}) error
 		monitoring, err = client.Monitoring().CreateMonitoringConfig(params, targetEnv)
 	}
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error latching monitoring instance to cluster: %w", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error latching monitoring instance to cluster: %w", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterName, monitoring.InstanceID))
 
-	return resourceIBMMonitoringRead(d, meta)
+	return resourceIBMMonitoringRead(ctx, d, meta)
 }
 
 func getMonitoringTargetHeader(d *schema.ResourceData, meta interface{}) (v2.MonitoringTargetHeader, error) {
@@ -206,26 +208,26 @@ func getMonitoringTargetHeader(d *schema.ResourceData, meta interface{}) (v2.Mon
 	return targetEnv, nil
 }
 
-func resourceIBMMonitoringRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMonitoringRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(parts) < 2 {
-		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of clusterNameorID/monitoringID", d.Id())
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of clusterNameorID/monitoringID", d.Id()))
 	}
 	clusterName := parts[0]
 	monitoringID := parts[1]
 
 	targetEnv, err := getMonitoringTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	config, err := client.Monitoring().GetMonitoringConfig(clusterName, monitoringID, targetEnv)
@@ -236,7 +238,7 @@ func resourceIBMMonitoringRead(d *schema.ResourceData, meta interface{}) error {
 				return nil
 			}
 		}
-		return fmt.Errorf("[ERROR] Error in GetMonitoringConfig: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in GetMonitoringConfig: %s", err))
 	}
 
 	d.Set(obMonitoringPrivateEndpoint, config.PrivateEndpoint)
@@ -252,26 +254,26 @@ func resourceIBMMonitoringRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMMonitoringUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMonitoringUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	hasChanged := false
 	idChanged := false
 
 	client, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getMonitoringTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	monitoringUpdateModel := v2.MonitoringUpdateRequest{}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	monitoringID := parts[1]
@@ -305,30 +307,30 @@ func resourceIBMMonitoringUpdate(d *schema.ResourceData, meta interface{}) error
 	if hasChanged {
 		_, err := client.Monitoring().UpdateMonitoringConfig(monitoringUpdateModel, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		} else if idChanged {
 			d.SetId(fmt.Sprintf("%s/%s", clusterName, monitoringUpdateModel.NewInstance))
 		}
 	}
 
-	return resourceIBMMonitoringRead(d, meta)
+	return resourceIBMMonitoringRead(ctx, d, meta)
 }
 
-func resourceIBMMonitoringDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMonitoringDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getMonitoringTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterName := parts[0]
 	monitoringID := parts[1]
@@ -348,7 +350,7 @@ func resourceIBMMonitoringDelete(d *schema.ResourceData, meta interface{}) error
 				return nil
 			}
 		}
-		return fmt.Errorf("[ERROR] Error in DeleteMonitoringConfig: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in DeleteMonitoringConfig: %s", err))
 	}
 	d.SetId("")
 	return nil
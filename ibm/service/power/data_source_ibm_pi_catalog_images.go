@@ -18,7 +18,6 @@ import (
 
 /*
 Datasource to get the list of images that are available when a power instance is created
-
 */
 func DataSourceIBMPICatalogImages() *schema.Resource {
 
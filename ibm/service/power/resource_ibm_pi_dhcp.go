@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -19,6 +18,7 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_service_d_h_c_p"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 func ResourceIBMPIDhcp() *schema.Resource {
@@ -196,7 +196,7 @@ func resourceIBMPIDhcpDelete(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func waitForIBMPIDhcpStatus(ctx context.Context, client *st.IBMPIDhcpClient, dhcpID string, timeout time.Duration) (interface{}, error) {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{PIDhcpStatusBuilding},
 		Target:  []string{PIDhcpStatusActive},
 		Refresh: func() (interface{}, string, error) {
@@ -210,15 +210,15 @@ func waitForIBMPIDhcpStatus(ctx context.Context, client *st.IBMPIDhcpClient, dhc
 			}
 			return dhcpServer, *dhcpServer.Status, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
 func waitForIBMPIDhcpDeleted(ctx context.Context, client *st.IBMPIDhcpClient, dhcpID string, timeout time.Duration) (interface{}, error) {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{PIDhcpDeleting},
 		Target:  []string{PIDhcpDeleted},
 		Refresh: func() (interface{}, string, error) {
@@ -229,9 +229,9 @@ func waitForIBMPIDhcpDeleted(ctx context.Context, client *st.IBMPIDhcpClient, dh
 			}
 			return dhcpServer, PIDhcpDeleting, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
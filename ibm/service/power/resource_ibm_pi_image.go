@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -21,6 +20,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 func ResourceIBMPIImage() *schema.Resource {
@@ -338,19 +338,19 @@ func resourceIBMPIImageDelete(ctx context.Context, d *schema.ResourceData, meta
 func isWaitForIBMPIImageAvailable(ctx context.Context, client *st.IBMPIImageClient, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Power Image (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PIImageQueStatus},
-		Target:     []string{helpers.PIImageActiveStatus},
-		Refresh:    isIBMPIImageRefreshFunc(ctx, client, id),
-		Timeout:    timeout,
-		Delay:      20 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", helpers.PIImageQueStatus},
+		Target:   []string{helpers.PIImageActiveStatus},
+		Refresh:  isIBMPIImageRefreshFunc(ctx, client, id),
+		Timeout:  timeout,
+		Delay:    20 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func isIBMPIImageRefreshFunc(ctx context.Context, client *st.IBMPIImageClient, id string) resource.StateRefreshFunc {
+func isIBMPIImageRefreshFunc(ctx context.Context, client *st.IBMPIImageClient, id string) waiter.RefreshFunc {
 
 	log.Printf("Calling the isIBMPIImageRefreshFunc Refresh Function....")
 	return func() (interface{}, string, error) {
@@ -368,9 +368,11 @@ func isIBMPIImageRefreshFunc(ctx context.Context, client *st.IBMPIImageClient, i
 }
 
 func waitForIBMPIJobCompleted(ctx context.Context, client *st.IBMPIJobClient, jobID string, timeout time.Duration) (interface{}, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{helpers.JobStatusQueued, helpers.JobStatusReadyForProcessing, helpers.JobStatusInProgress, helpers.JobStatusRunning, helpers.JobStatusWaiting},
-		Target:  []string{helpers.JobStatusCompleted, helpers.JobStatusFailed},
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("job %s to complete", jobID),
+		Pending:     []string{helpers.JobStatusQueued, helpers.JobStatusReadyForProcessing, helpers.JobStatusInProgress, helpers.JobStatusRunning, helpers.JobStatusWaiting},
+		Target:      []string{helpers.JobStatusCompleted},
+		Failed:      []string{helpers.JobStatusFailed},
 		Refresh: func() (interface{}, string, error) {
 			job, err := client.Get(jobID)
 			if err != nil {
@@ -387,9 +389,9 @@ func waitForIBMPIJobCompleted(ctx context.Context, client *st.IBMPIJobClient, jo
 			}
 			return job, *job.Status.State, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
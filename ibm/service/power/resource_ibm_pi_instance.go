@@ -6,13 +6,13 @@ package power
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -21,6 +21,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 func ResourceIBMPIInstance() *schema.Resource {
@@ -762,19 +763,19 @@ func isWaitForPIInstanceDeleted(ctx context.Context, client *st.IBMPIInstanceCli
 
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PIInstanceDeleting},
-		Target:     []string{helpers.PIInstanceNotFound},
-		Refresh:    isPIInstanceDeleteRefreshFunc(client, id),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-		Timeout:    10 * time.Minute,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", helpers.PIInstanceDeleting},
+		Target:   []string{helpers.PIInstanceNotFound},
+		Refresh:  isPIInstanceDeleteRefreshFunc(client, id),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
+		Timeout:  10 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id string) resource.StateRefreshFunc {
+func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		pvm, err := client.Get(id)
 		if err != nil {
@@ -793,19 +794,28 @@ func isWaitForPIInstanceAvailable(ctx context.Context, client *st.IBMPIInstanceC
 		queryTimeOut = warningTimeOut
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"PENDING", helpers.PIInstanceBuilding, helpers.PIInstanceHealthWarning},
-		Target:     []string{helpers.PIInstanceAvailable, helpers.PIInstanceHealthOk, "ERROR", ""},
-		Refresh:    isPIInstanceRefreshFunc(client, id, instanceReadyStatus),
-		Delay:      30 * time.Second,
-		MinTimeout: queryTimeOut,
-		Timeout:    120 * time.Minute,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("instance %s to be available", id),
+		Pending:     []string{"PENDING", helpers.PIInstanceBuilding, helpers.PIInstanceHealthWarning},
+		Target:      []string{helpers.PIInstanceAvailable, helpers.PIInstanceHealthOk},
+		Failed:      []string{"ERROR"},
+		Refresh:     isPIInstanceRefreshFunc(client, id, instanceReadyStatus),
+		Delay:       30 * time.Second,
+		Interval:    queryTimeOut,
+		Timeout:     120 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	pvm, err := stateConf.Wait(ctx)
+	var failed *waiter.FailedStatusError
+	if errors.As(err, &failed) {
+		if instance, ok := failed.Result.(*models.PVMInstance); ok && instance.Fault != nil {
+			return pvm, fmt.Errorf("%w: failed to create the lpar: %s", err, instance.Fault.Message)
+		}
+	}
+	return pvm, err
 }
 
-func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, instanceReadyStatus string) resource.StateRefreshFunc {
+func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, instanceReadyStatus string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		pvm, err := client.Get(id)
@@ -817,12 +827,7 @@ func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, instanceReadySt
 			return pvm, helpers.PIInstanceAvailable, nil
 		}
 		if *pvm.Status == "ERROR" {
-			if pvm.Fault != nil {
-				err = fmt.Errorf("failed to create the lpar: %s", pvm.Fault.Message)
-			} else {
-				err = fmt.Errorf("failed to create the lpar")
-			}
-			return pvm, *pvm.Status, err
+			return pvm, *pvm.Status, nil
 		}
 
 		return pvm, helpers.PIInstanceBuilding, nil
@@ -840,19 +845,19 @@ func checkBase64(input string) error {
 func isWaitForPIInstanceStopped(ctx context.Context, client *st.IBMPIInstanceClient, id string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be stopped and powered off ", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"STOPPING", "RESIZE", "VERIFY_RESIZE", helpers.PIInstanceHealthWarning},
-		Target:     []string{"OK", "SHUTOFF"},
-		Refresh:    isPIInstanceRefreshFuncOff(client, id),
-		Delay:      10 * time.Second,
-		MinTimeout: 2 * time.Minute, // This is the time that the client will execute to check the status of the request
-		Timeout:    30 * time.Minute,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"STOPPING", "RESIZE", "VERIFY_RESIZE", helpers.PIInstanceHealthWarning},
+		Target:   []string{"OK", "SHUTOFF"},
+		Refresh:  isPIInstanceRefreshFuncOff(client, id),
+		Delay:    10 * time.Second,
+		Interval: 2 * time.Minute, // This is the time that the client will execute to check the status of the request
+		Timeout:  30 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id string) resource.StateRefreshFunc {
+func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		log.Printf("Calling the check Refresh status of the pvm instance %s", id)
@@ -945,19 +950,19 @@ func performChangeAndReboot(ctx context.Context, client *st.IBMPIInstanceClient,
 func isWaitforPIInstanceUpdate(ctx context.Context, client *st.IBMPIInstanceClient, id string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be SHUTOFF AFTER THE RESIZE Due to DLPAR Operation ", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"RESIZE", "VERIFY_RESIZE"},
-		Target:     []string{"ACTIVE", "SHUTOFF", helpers.PIInstanceHealthOk},
-		Refresh:    isPIInstanceShutAfterResourceChange(client, id),
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Minute,
-		Timeout:    60 * time.Minute,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"RESIZE", "VERIFY_RESIZE"},
+		Target:   []string{"ACTIVE", "SHUTOFF", helpers.PIInstanceHealthOk},
+		Refresh:  isPIInstanceShutAfterResourceChange(client, id),
+		Delay:    10 * time.Second,
+		Interval: 5 * time.Minute,
+		Timeout:  60 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func isPIInstanceShutAfterResourceChange(client *st.IBMPIInstanceClient, id string) resource.StateRefreshFunc {
+func isPIInstanceShutAfterResourceChange(client *st.IBMPIInstanceClient, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		pvm, err := client.Get(id)
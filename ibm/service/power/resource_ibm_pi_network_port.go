@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -18,6 +17,7 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 func ResourceIBMPINetworkPort() *schema.Resource {
@@ -179,19 +179,19 @@ func resourceIBMPINetworkPortDelete(ctx context.Context, d *schema.ResourceData,
 func isWaitForIBMPINetworkPortAvailable(ctx context.Context, client *st.IBMPINetworkClient, id string, networkname string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Power Network (%s) that was created for Network Zone (%s) to be available.", id, networkname)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PINetworkProvisioning},
-		Target:     []string{"DOWN"},
-		Refresh:    isIBMPINetworkPortRefreshFunc(client, id, networkname),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Minute,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", helpers.PINetworkProvisioning},
+		Target:   []string{"DOWN"},
+		Refresh:  isIBMPINetworkPortRefreshFunc(client, id, networkname),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func isIBMPINetworkPortRefreshFunc(client *st.IBMPINetworkClient, id, networkname string) resource.StateRefreshFunc {
+func isIBMPINetworkPortRefreshFunc(client *st.IBMPINetworkClient, id, networkname string) waiter.RefreshFunc {
 
 	log.Printf("Calling the IsIBMPINetwork Refresh Function....with the following id (%s) for network port and following id (%s) for network name and waiting for network to be READY", id, networkname)
 	return func() (interface{}, string, error) {
@@ -13,7 +13,8 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"log"
@@ -37,10 +38,10 @@ SHUTOFF--> ACTIVE
 
 func ResourceIBMPIIOperations() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMPIOperationsCreate,
-		Read:   resourceIBMPIOperationsRead,
-		Update: resourceIBMPIOperationsUpdate,
-		Delete: resourceIBMPIOperationsDelete,
+		CreateContext: resourceIBMPIOperationsCreate,
+		ReadContext:   resourceIBMPIOperationsRead,
+		UpdateContext: resourceIBMPIOperationsUpdate,
+		DeleteContext: resourceIBMPIOperationsDelete,
 		//Exists:   resourceIBMPIOperationsExists,
 		Importer: &schema.ResourceImporter{},
 
@@ -119,12 +120,12 @@ func ResourceIBMPIIOperations() *schema.Resource {
 	}
 }
 
-func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Now in the Power Operations Code")
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	operation := d.Get(helpers.PIInstanceOperationType).(string)
@@ -132,7 +133,7 @@ func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) err
 
 	body := &models.PVMInstanceAction{Action: flex.PtrToString(operation)}
 	log.Printf("Calling the IBM PI Operations [ %s ] with on the instance with name [ %s ]", operation, name)
-	client := st.NewIBMPIInstanceClient(context.Background(), sess, powerinstanceid)
+	client := st.NewIBMPIInstanceClient(ctx, sess, powerinstanceid)
 
 	/*
 		TODO
@@ -142,7 +143,7 @@ func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) err
 	err = client.Action(name, body)
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return fmt.Errorf("[ERROR] Failed to perform the operation on  the instance %v", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Failed to perform the operation on  the instance %v", err))
 
 	} else {
 		log.Printf("Executed the stop operation on the lpar")
@@ -151,9 +152,9 @@ func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) err
 	if operation == "stop" || operation == "immediate-shutdown" {
 		var targetStatus = "SHUTOFF"
 		log.Printf("Calling the check opertion that was invoked [%s]  to check for status [ %s ]", operation, targetStatus)
-		_, err = isWaitForPIInstanceOperationStatus(client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
+		_, err = isWaitForPIInstanceOperationStatus(ctx, client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		} else {
 			log.Printf("Executed the start operation on the lpar")
 		}
@@ -163,31 +164,31 @@ func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) err
 	if operation == "start" || operation == "soft-reboot" || operation == "hard-reboot" {
 		var targetStatus = "ACTIVE"
 		log.Printf("Calling the check opertion that was invoked [%s]  to check for status [ %s ]", operation, targetStatus)
-		_, err = isWaitForPIInstanceOperationStatus(client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
+		_, err = isWaitForPIInstanceOperationStatus(ctx, client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceIBMPIOperationsRead(d, meta)
+	return resourceIBMPIOperationsRead(ctx, d, meta)
 }
 
-func resourceIBMPIOperationsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Calling the PowerOperations Read code..for instance name %s", d.Get(helpers.PIInstanceOperationServerName).(string))
 
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	name := d.Get(helpers.PIInstanceOperationServerName).(string)
-	powerC := st.NewIBMPIInstanceClient(context.Background(), sess, powerinstanceid)
+	powerC := st.NewIBMPIInstanceClient(ctx, sess, powerinstanceid)
 	powervmdata, err := powerC.Get(name)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("status", powervmdata.Status)
@@ -205,12 +206,12 @@ func resourceIBMPIOperationsRead(d *schema.ResourceData, meta interface{}) error
 
 }
 
-func resourceIBMPIOperationsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	return nil
 }
 
-func resourceIBMPIOperationsDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	return nil
 }
@@ -235,24 +236,25 @@ func resourceIBMPIOperationsExists(d *schema.ResourceData, meta interface{}) (bo
 	return instance.PvmInstanceID == &id, nil
 }
 
-func isWaitForPIInstanceOperationStatus(client *st.IBMPIInstanceClient, name string, timeout time.Duration, powerinstanceid, operation, targetstatus string) (interface{}, error) {
+func isWaitForPIInstanceOperationStatus(ctx context.Context, client *st.IBMPIInstanceClient, name string, timeout time.Duration, powerinstanceid, operation, targetstatus string) (interface{}, error) {
 
 	log.Printf("Waiting for the Operation [ %s ] to be performed on the instance with name [ %s ]", operation, name)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"ACTIVE", "SHUTOFF", "WARNING"},
-		Target:     []string{targetstatus},
-		Refresh:    isPIOperationsRefreshFunc(client, name, powerinstanceid, targetstatus),
-		Delay:      1 * time.Minute,
-		MinTimeout: 2 * time.Minute,
-		Timeout:    120 * time.Minute,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("the operation %s on the instance %s", operation, name),
+		Pending:     []string{"ACTIVE", "SHUTOFF", "WARNING"},
+		Target:      []string{targetstatus},
+		Refresh:     isPIOperationsRefreshFunc(client, name, powerinstanceid, targetstatus),
+		Delay:       1 * time.Minute,
+		Interval:    2 * time.Minute,
+		Timeout:     120 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 
 }
 
-func isPIOperationsRefreshFunc(client *st.IBMPIInstanceClient, id, powerinstanceid, targetstatus string) resource.StateRefreshFunc {
+func isPIOperationsRefreshFunc(client *st.IBMPIInstanceClient, id, powerinstanceid, targetstatus string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		log.Printf("Waiting for the target status to be [ %s ]", targetstatus)
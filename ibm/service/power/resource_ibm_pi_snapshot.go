@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -18,6 +17,7 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 func ResourceIBMPISnapshot() *schema.Resource {
@@ -249,19 +249,19 @@ func isWaitForPIInstanceSnapshotAvailable(ctx context.Context, client *st.IBMPIS
 
 	log.Printf("Waiting for PIInstance Snapshot (%s) to be available and active ", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"in_progress", "BUILD"},
-		Target:     []string{"available", "ACTIVE"},
-		Refresh:    isPIInstanceSnapshotRefreshFunc(client, id),
-		Delay:      30 * time.Second,
-		MinTimeout: 2 * time.Minute,
-		Timeout:    timeout,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"in_progress", "BUILD"},
+		Target:   []string{"available", "ACTIVE"},
+		Refresh:  isPIInstanceSnapshotRefreshFunc(client, id),
+		Delay:    30 * time.Second,
+		Interval: 2 * time.Minute,
+		Timeout:  timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func isPIInstanceSnapshotRefreshFunc(client *st.IBMPISnapshotClient, id string) resource.StateRefreshFunc {
+func isPIInstanceSnapshotRefreshFunc(client *st.IBMPISnapshotClient, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		snapshotInfo, err := client.Get(id)
@@ -285,19 +285,19 @@ func isWaitForPIInstanceSnapshotDeleted(ctx context.Context, client *st.IBMPISna
 
 	log.Printf("Waiting for (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PIInstanceDeleting},
-		Target:     []string{"Not Found"},
-		Refresh:    isPIInstanceSnapshotDeleteRefreshFunc(client, id),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-		Timeout:    timeout,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", helpers.PIInstanceDeleting},
+		Target:   []string{"Not Found"},
+		Refresh:  isPIInstanceSnapshotDeleteRefreshFunc(client, id),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
+		Timeout:  timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func isPIInstanceSnapshotDeleteRefreshFunc(client *st.IBMPISnapshotClient, id string) resource.StateRefreshFunc {
+func isPIInstanceSnapshotDeleteRefreshFunc(client *st.IBMPISnapshotClient, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		snapshot, err := client.Get(id)
 		if err != nil {
@@ -11,7 +11,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -20,6 +19,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 func ResourceIBMPIVolume() *schema.Resource {
@@ -321,19 +321,19 @@ func resourceIBMPIVolumeDelete(ctx context.Context, d *schema.ResourceData, meta
 func isWaitForIBMPIVolumeAvailable(ctx context.Context, client *st.IBMPIVolumeClient, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PIVolumeProvisioning},
-		Target:     []string{helpers.PIVolumeProvisioningDone},
-		Refresh:    isIBMPIVolumeRefreshFunc(client, id),
-		Delay:      10 * time.Second,
-		MinTimeout: 2 * time.Minute,
-		Timeout:    timeout,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", helpers.PIVolumeProvisioning},
+		Target:   []string{helpers.PIVolumeProvisioningDone},
+		Refresh:  isIBMPIVolumeRefreshFunc(client, id),
+		Delay:    10 * time.Second,
+		Interval: 2 * time.Minute,
+		Timeout:  timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func isIBMPIVolumeRefreshFunc(client *st.IBMPIVolumeClient, id string) resource.StateRefreshFunc {
+func isIBMPIVolumeRefreshFunc(client *st.IBMPIVolumeClient, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		vol, err := client.Get(id)
 		if err != nil {
@@ -349,18 +349,18 @@ func isIBMPIVolumeRefreshFunc(client *st.IBMPIVolumeClient, id string) resource.
 }
 
 func isWaitForIBMPIVolumeDeleted(ctx context.Context, client *st.IBMPIVolumeClient, id string, timeout time.Duration) (interface{}, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting", helpers.PIVolumeProvisioning},
-		Target:     []string{"deleted"},
-		Refresh:    isIBMPIVolumeDeleteRefreshFunc(client, id),
-		Delay:      10 * time.Second,
-		MinTimeout: 2 * time.Minute,
-		Timeout:    timeout,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"deleting", helpers.PIVolumeProvisioning},
+		Target:   []string{"deleted"},
+		Refresh:  isIBMPIVolumeDeleteRefreshFunc(client, id),
+		Delay:    10 * time.Second,
+		Interval: 2 * time.Minute,
+		Timeout:  timeout,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func isIBMPIVolumeDeleteRefreshFunc(client *st.IBMPIVolumeClient, id string) resource.StateRefreshFunc {
+func isIBMPIVolumeDeleteRefreshFunc(client *st.IBMPIVolumeClient, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		vol, err := client.Get(id)
 		if err != nil {
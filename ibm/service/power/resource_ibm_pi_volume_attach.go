@@ -15,8 +15,8 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volumes"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -173,19 +173,19 @@ func resourceIBMPIVolumeAttachDelete(ctx context.Context, d *schema.ResourceData
 func isWaitForIBMPIVolumeAttachAvailable(ctx context.Context, client *st.IBMPIVolumeClient, id, cloudInstanceID, pvmInstanceID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available for attachment", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PIVolumeProvisioning},
-		Target:     []string{helpers.PIVolumeAllowableAttachStatus},
-		Refresh:    isIBMPIVolumeAttachRefreshFunc(client, id, cloudInstanceID, pvmInstanceID),
-		Delay:      10 * time.Second,
-		MinTimeout: 30 * time.Second,
-		Timeout:    timeout,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", helpers.PIVolumeProvisioning},
+		Target:   []string{helpers.PIVolumeAllowableAttachStatus},
+		Refresh:  isIBMPIVolumeAttachRefreshFunc(client, id, cloudInstanceID, pvmInstanceID),
+		Delay:    10 * time.Second,
+		Interval: 30 * time.Second,
+		Timeout:  timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func isIBMPIVolumeAttachRefreshFunc(client *st.IBMPIVolumeClient, id, cloudInstanceID, pvmInstanceID string) resource.StateRefreshFunc {
+func isIBMPIVolumeAttachRefreshFunc(client *st.IBMPIVolumeClient, id, cloudInstanceID, pvmInstanceID string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		vol, err := client.Get(id)
 		if err != nil {
@@ -203,19 +203,19 @@ func isIBMPIVolumeAttachRefreshFunc(client *st.IBMPIVolumeClient, id, cloudInsta
 func isWaitForIBMPIVolumeDetach(ctx context.Context, client *st.IBMPIVolumeClient, id, cloudInstanceID, pvmInstanceID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available after detachment", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"detaching", helpers.PowerVolumeAttachDeleting},
-		Target:     []string{helpers.PIVolumeProvisioningDone},
-		Refresh:    isIBMPIVolumeDetachRefreshFunc(client, id, cloudInstanceID, pvmInstanceID),
-		Delay:      10 * time.Second,
-		MinTimeout: 30 * time.Second,
-		Timeout:    timeout,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"detaching", helpers.PowerVolumeAttachDeleting},
+		Target:   []string{helpers.PIVolumeProvisioningDone},
+		Refresh:  isIBMPIVolumeDetachRefreshFunc(client, id, cloudInstanceID, pvmInstanceID),
+		Delay:    10 * time.Second,
+		Interval: 30 * time.Second,
+		Timeout:  timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return stateConf.Wait(ctx)
 }
 
-func isIBMPIVolumeDetachRefreshFunc(client *st.IBMPIVolumeClient, id, cloudInstanceID, pvmInstanceID string) resource.StateRefreshFunc {
+func isIBMPIVolumeDetachRefreshFunc(client *st.IBMPIVolumeClient, id, cloudInstanceID, pvmInstanceID string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		vol, err := client.Get(id)
 		if err != nil {
@@ -1,20 +1,22 @@
 package pushnotification
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMPNApplicationChrome() *schema.Resource {
 	return &schema.Resource{
-		Read:     resourceApplicationChromeRead,
-		Create:   resourceApplicationChromeCreate,
-		Update:   resourceApplicationChromeUpdate,
-		Delete:   resourceApplicationChromeDelete,
-		Importer: &schema.ResourceImporter{},
+		ReadContext:   resourceApplicationChromeRead,
+		CreateContext: resourceApplicationChromeCreate,
+		UpdateContext: resourceApplicationChromeUpdate,
+		DeleteContext: resourceApplicationChromeDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -38,17 +40,17 @@ func ResourceIBMPNApplicationChrome() *schema.Resource {
 	}
 }
 
-func resourceApplicationChromeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceApplicationChromeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	pnClient, err := meta.(conns.ClientSession).PushServiceV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serverKey := d.Get("server_key").(string)
 	websiteURL := d.Get("web_site_url").(string)
 	guid := d.Get("guid").(string)
 
-	_, response, err := pnClient.SaveChromeWebConf(&pushservicev1.SaveChromeWebConfOptions{
+	_, response, err := pnClient.SaveChromeWebConfWithContext(ctx, &pushservicev1.SaveChromeWebConfOptions{
 		ApplicationID: &guid,
 		ApiKey:        &serverKey,
 		WebSiteURL:    &websiteURL,
@@ -56,30 +58,30 @@ func resourceApplicationChromeCreate(d *schema.ResourceData, meta interface{}) e
 
 	if err != nil {
 		d.SetId("")
-		return fmt.Errorf("[ERROR] Error configuring chrome web platform: %s with response code  %d", err, response.StatusCode)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error configuring chrome web platform: %s with response code  %d", err, response.StatusCode))
 	}
 	d.SetId(guid)
 
-	return resourceApplicationChromeRead(d, meta)
+	return resourceApplicationChromeRead(ctx, d, meta)
 }
 
-func resourceApplicationChromeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceApplicationChromeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChanges("server_key", "web_site_url") {
-		return resourceApplicationChromeCreate(d, meta)
+		return resourceApplicationChromeCreate(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceApplicationChromeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceApplicationChromeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	pnClient, err := meta.(conns.ClientSession).PushServiceV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	guid := d.Id()
 
-	chromeWebConf, response, err := pnClient.GetChromeWebConf(&pushservicev1.GetChromeWebConfOptions{
+	chromeWebConf, response, err := pnClient.GetChromeWebConfWithContext(ctx, &pushservicev1.GetChromeWebConfOptions{
 		ApplicationID: &guid,
 	})
 
@@ -88,7 +90,7 @@ func resourceApplicationChromeRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error fetching chrome web platform configuration: %s with response code  %d", err, response.StatusCode)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching chrome web platform configuration: %s with response code  %d", err, response.StatusCode))
 	}
 
 	d.SetId(guid)
@@ -100,14 +102,14 @@ func resourceApplicationChromeRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceApplicationChromeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceApplicationChromeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	pnClient, err := meta.(conns.ClientSession).PushServiceV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	guid := d.Get("guid").(string)
 
-	response, err := pnClient.DeleteChromeWebConf(&pushservicev1.DeleteChromeWebConfOptions{
+	response, err := pnClient.DeleteChromeWebConfWithContext(ctx, &pushservicev1.DeleteChromeWebConfOptions{
 		ApplicationID: &guid,
 	})
 
@@ -116,7 +118,7 @@ func resourceApplicationChromeDelete(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting chrome web platform configuration: %s with response code  %d", err, response.StatusCode)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting chrome web platform configuration: %s with response code  %d", err, response.StatusCode))
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package resourcecontroller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -21,12 +23,12 @@ import (
 
 func ResourceIBMResourceKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceKeyCreate,
-		Read:     resourceIBMResourceKeyRead,
-		Update:   resourceIBMResourceKeyUpdate,
-		Delete:   resourceIBMResourceKeyDelete,
-		Exists:   resourceIBMResourceKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMResourceKeyCreate,
+		ReadContext:   resourceIBMResourceKeyRead,
+		UpdateContext: resourceIBMResourceKeyUpdate,
+		DeleteContext: resourceIBMResourceKeyDelete,
+		Exists:        resourceIBMResourceKeyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -190,10 +192,10 @@ func ResourceIBMResourceKey() *schema.Resource {
 	}
 }
 
-func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get("name").(string)
 
@@ -207,7 +209,7 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if instanceID == "" && aliasID == "" {
-		return fmt.Errorf("[ERROR] Provide either `resource_instance_id` or `resource_alias_id`")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide either `resource_instance_id` or `resource_alias_id`"))
 	}
 
 	keyParameters := rc.ResourceKeyPostParameters{}
@@ -224,21 +226,21 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	resourceInstance, sourceCRN, err := getResourceInstanceAndCRN(d, meta)
+	resourceInstance, sourceCRN, err := getResourceInstanceAndCRN(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key when get instance and CRN: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource key when get instance and CRN: %s", err))
 	}
 
 	serviceID := resourceInstance.ResourceID
 
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key when get ResourceCatalogAPI: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource key when get ResourceCatalogAPI: %s", err))
 	}
 
 	service, err := rsCatClient.ResourceCatalog().Get(*serviceID, true)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key when get service: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource key when get service: %s", err))
 	}
 
 	resourceKeyCreate := rc.CreateResourceKeyOptions{
@@ -248,41 +250,41 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	if r, ok := d.GetOk("role"); ok {
 		role := r.(string)
-		serviceRole, err := getRoleFromName(role, service.Name, meta)
+		serviceRole, err := getRoleFromName(ctx, role, service.Name, meta)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating resource key when get role: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource key when get role: %s", err))
 		}
 		keyParameters.SetProperty("role_crn", serviceRole.RoleID)
 		resourceKeyCreate.Role = serviceRole.RoleID
 	}
 
-	resourceKey, resp, err := rsContClient.CreateResourceKey(&resourceKeyCreate)
+	resourceKey, resp, err := rsContClient.CreateResourceKeyWithContext(ctx, &resourceKeyCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource key: %s with resp code: %s", err, resp))
 	}
 
 	d.SetId(*resourceKey.ID)
 
-	return resourceIBMResourceKeyRead(d, meta)
+	return resourceIBMResourceKeyRead(ctx, d, meta)
 }
 
-func resourceIBMResourceKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceKeyID := d.Id()
 	resourceKeyGet := rc.GetResourceKeyOptions{
 		ID: &resourceKeyID,
 	}
 
-	resourceKey, resp, err := rsContClient.GetResourceKey(&resourceKeyGet)
+	resourceKey, resp, err := rsContClient.GetResourceKeyWithContext(ctx, &resourceKeyGet)
 	if err != nil || resourceKey == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource key: %s with resp : %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource key: %s with resp : %s", err, resp))
 	}
 	var credInterface map[string]interface{}
 	cred, _ := json.Marshal(resourceKey.Credentials)
@@ -291,10 +293,10 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 
 	creds, err := json.Marshal(resourceKey.Credentials)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err))
 	}
 	if err = d.Set("credentials_json", string(creds)); err != nil {
-		return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting the credentials json: %s", err))
 	}
 	d.Set("name", *resourceKey.Name)
 	d.Set("status", *resourceKey.State)
@@ -313,7 +315,7 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 				AccountID:   resourceKey.AccountID,
 				ServiceName: &resourceCRN,
 			}
-			roleList, resp, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+			roleList, resp, err := iamPolicyManagementClient.ListRolesWithContext(ctx, listRoleOptions)
 			roles := flex.MapRoleListToPolicyRoles(*roleList)
 			if err == nil && len(roles) > 0 {
 				for _, role := range roles {
@@ -366,10 +368,10 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resourceKeyID := d.Id()
@@ -377,9 +379,9 @@ func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) erro
 		ID: &resourceKeyID,
 	}
 
-	resp, err := rsContClient.DeleteResourceKey(&resourceKeyDelete)
+	resp, err := rsContClient.DeleteResourceKeyWithContext(ctx, &resourceKeyDelete)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting resource key: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting resource key: %s with resp code: %s", err, resp))
 	}
 
 	d.SetId("")
@@ -411,7 +413,7 @@ func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (boo
 	return *resourceKey.ID == resourceKeyID, nil
 }
 
-func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.ResourceInstance, *string, error) {
+func getResourceInstanceAndCRN(ctx context.Context, d *schema.ResourceData, meta interface{}) (*rc.ResourceInstance, *string, error) {
 	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return nil, nil, err
@@ -421,7 +423,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 		resourceInstanceGet := rc.GetResourceInstanceOptions{
 			ID: &insIdString,
 		}
-		instance, resp, err := rsContClient.GetResourceInstance(&resourceInstanceGet)
+		instance, resp, err := rsContClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 		if err != nil {
 			log.Printf("Error when get resource instance in getResourceInstanceAndCRN: %s with resp code: %s", err, resp)
 			return nil, nil, err
@@ -433,7 +435,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 	resourceAliasGet := rc.GetResourceAliasOptions{
 		ID: &aliasID,
 	}
-	alias, resp, err := rsContClient.GetResourceAlias(&resourceAliasGet)
+	alias, resp, err := rsContClient.GetResourceAliasWithContext(ctx, &resourceAliasGet)
 	if err != nil {
 		log.Printf("Error when get resource alias in getResourceInstanceAndCRN: %s with resp code: %s", err, resp)
 		return nil, nil, err
@@ -441,7 +443,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: alias.ResourceInstanceID,
 	}
-	instance, resp, err := rsContClient.GetResourceInstance(&resourceInstanceGet)
+	instance, resp, err := rsContClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil {
 		log.Printf("Error when get resource instance in getResourceInstanceAndCRN: %s with resp code: %s", err, resp)
 		return nil, nil, err
@@ -450,7 +452,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 
 }
 
-func getRoleFromName(roleName, serviceName string, meta interface{}) (iampolicymanagementv1.PolicyRole, error) {
+func getRoleFromName(ctx context.Context, roleName, serviceName string, meta interface{}) (iampolicymanagementv1.PolicyRole, error) {
 
 	role := iampolicymanagementv1.PolicyRole{}
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
@@ -468,7 +470,7 @@ func getRoleFromName(roleName, serviceName string, meta interface{}) (iampolicym
 		ServiceName: &serviceName,
 	}
 
-	roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	roleList, _, err := iamPolicyManagementClient.ListRolesWithContext(ctx, listRoleOptions)
 	if err != nil {
 		return role, err
 	}
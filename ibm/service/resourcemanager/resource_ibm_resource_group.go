@@ -4,22 +4,24 @@
 package resourcemanager
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMResourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceGroupCreate,
-		Read:     resourceIBMResourceGroupRead,
-		Update:   resourceIBMResourceGroupUpdate,
-		Delete:   resourceIBMResourceGroupDelete,
-		Exists:   resourceIBMResourceGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMResourceGroupCreate,
+		ReadContext:   resourceIBMResourceGroupRead,
+		UpdateContext: resourceIBMResourceGroupUpdate,
+		DeleteContext: resourceIBMResourceGroupDelete,
+		Exists:        resourceIBMResourceGroupExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -90,16 +92,16 @@ func ResourceIBMResourceGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get("name").(string)
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	accountID := userDetails.UserAccount
 
@@ -108,34 +110,34 @@ func resourceIBMResourceGroupCreate(d *schema.ResourceData, meta interface{}) er
 		AccountID: &accountID,
 	}
 
-	resourceGroup, resp, err := rMgtClient.CreateResourceGroup(&resourceGroupCreate)
+	resourceGroup, resp, err := rMgtClient.CreateResourceGroupWithContext(ctx, &resourceGroupCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource group: %s with response code  %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource group: %s with response code  %s", err, resp))
 	}
 
 	d.SetId(*resourceGroup.ID)
 
-	return resourceIBMResourceGroupRead(d, meta)
+	return resourceIBMResourceGroupRead(ctx, d, meta)
 }
 
-func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceGroupID := d.Id()
 	resourceGroupGet := rg.GetResourceGroupOptions{
 		ID: &resourceGroupID,
 	}
 
-	resourceGroup, resp, err := rMgtClient.GetResourceGroup(&resourceGroupGet)
+	resourceGroup, resp, err := rMgtClient.GetResourceGroupWithContext(ctx, &resourceGroupGet)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[WARN] Resource Group is not found")
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error retrieving resource group: %s with response code  %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource group: %s with response code  %s", err, resp))
 	}
 
 	d.Set("name", *resourceGroup.Name)
@@ -178,10 +180,10 @@ func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMResourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resourceGroupID := d.Id()
@@ -196,19 +198,19 @@ func resourceIBMResourceGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if hasChange {
-		_, resp, err := rMgtClient.UpdateResourceGroup(&resourceGroupUpdate)
+		_, resp, err := rMgtClient.UpdateResourceGroupWithContext(ctx, &resourceGroupUpdate)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating resource group: %s with response code  %s", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource group: %s with response code  %s", err, resp))
 		}
 
 	}
-	return resourceIBMResourceGroupRead(d, meta)
+	return resourceIBMResourceGroupRead(ctx, d, meta)
 }
 
-func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resourceGroupID := d.Id()
@@ -216,13 +218,13 @@ func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 		ID: &resourceGroupID,
 	}
 
-	resp, err := rMgtClient.DeleteResourceGroup(&resourceGroupDelete)
+	resp, err := rMgtClient.DeleteResourceGroupWithContext(ctx, &resourceGroupDelete)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[WARN] Resource Group is not found")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting resource group: %s with response code  %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting resource group: %s with response code  %s", err, resp))
 	}
 
 	d.SetId("")
@@ -17,6 +17,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -37,10 +38,10 @@ const (
 
 func ResourceIBMSatelliteCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMSatelliteClusterCreate,
-		Read:   resourceIBMSatelliteClusterRead,
-		Update: resourceIBMSatelliteClusterUpdate,
-		Delete: resourceIBMSatelliteClusterDelete,
+		CreateContext: resourceIBMSatelliteClusterCreate,
+		ReadContext:   resourceIBMSatelliteClusterRead,
+		UpdateContext: resourceIBMSatelliteClusterUpdate,
+		DeleteContext: resourceIBMSatelliteClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				ID := d.Id()
@@ -70,7 +71,7 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				return flex.ImmutableResourceCustomizeDiff([]string{"name", "location", "resource_group_id", "crn_token"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -280,17 +281,17 @@ func ResourceIBMSatelliteClusterValidator() *validate.ResourceValidator {
 	return &ibmSatelliteClusteresourceValidator
 }
 
-func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var resourceGrp, clusterId string
 	pathParamsMap := make(map[string]string)
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createClusterOptions := &kubernetesserviceapiv1.CreateSatelliteClusterOptions{}
@@ -311,9 +312,9 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 	//Wait for location to get normal
 	_, ok := d.GetOk("crn_token")
 	if !ok {
-		_, err = waitForLocationNormal(location, d, meta)
+		_, err = waitForLocationNormal(ctx, location, d, meta)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for getting location (%s) to be normal: %s", location, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for getting location (%s) to be normal: %s", location, err))
 		}
 	}
 
@@ -372,16 +373,16 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 		copier.Copy(createRemoteClusterOptions, createClusterOptions)
 		createRemoteClusterOptions.XAuthSupplemental = &crnToken
 
-		instance, response, err := satClient.CreateSatelliteClusterRemote(createRemoteClusterOptions)
+		instance, response, err := satClient.CreateSatelliteClusterRemoteWithContext(ctx, createRemoteClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating Satellite Cluster for remote location: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Satellite Cluster for remote location: %s\n%s", err, response))
 		}
 		clusterId = *instance.ID
 		log.Printf("[INFO] Created ROKS Satellite Cluster for remote location: %s", clusterId)
 	} else {
-		instance, response, err := satClient.CreateSatelliteCluster(createClusterOptions)
+		instance, response, err := satClient.CreateSatelliteClusterWithContext(ctx, createClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating Satellite Cluster: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Satellite Cluster: %s\n%s", err, response))
 		}
 		clusterId = *instance.ID
 		log.Printf("[INFO] Created ROKS Satellite Cluster : %s", clusterId)
@@ -407,15 +408,15 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 						zoneOptions.Headers = pathParamsMap
 					}
 
-					response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
+					response, err := satClient.CreateSatelliteWorkerPoolZoneWithContext(ctx, zoneOptions)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response))
 					}
 				}
 			}
-			_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterId, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
+			_, err = WaitForSatelliteWorkerPoolAvailable(ctx, d, meta, clusterId, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for default workerpool (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for default workerpool (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 	}
@@ -435,9 +436,9 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 			wpots.XAuthResourceGroup = &resourceGrp
 		}
 
-		response, err := satClient.V2SetWorkerPoolLabels(wpots)
+		response, err := satClient.V2SetWorkerPoolLabelsWithContext(ctx, wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response))
 		}
 	}
 
@@ -447,7 +448,7 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 			Cluster: flex.PtrToString(clusterId),
 		}
 
-		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
+		cluster, response, err := satClient.GetClusterWithContext(ctx, getSatClusterOptions)
 		if err != nil || cluster == nil {
 			log.Printf(
 				"Error in retreiving ibm satellite cluster : %s\n%s", err, response)
@@ -462,32 +463,32 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	//Wait for cluster to get warning state
-	_, err = waitForClusterToReady(clusterId, d, meta)
+	_, err = waitForClusterToReady(ctx, clusterId, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for getting cluster (%s) to be warning state: %s", clusterId, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for getting cluster (%s) to be warning state: %s", clusterId, err))
 	}
 
-	return resourceIBMSatelliteClusterRead(d, meta)
+	return resourceIBMSatelliteClusterRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ID := d.Id()
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 		Cluster: &ID,
 	}
 
-	cluster, response, err := satClient.GetCluster(getSatClusterOptions)
+	cluster, response, err := satClient.GetClusterWithContext(ctx, getSatClusterOptions)
 	if err != nil || cluster == nil {
 		if response != nil && response.StatusCode == 404 && strings.Contains(err.Error(), "The specified cluster could not be found") {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", *cluster.Name)
@@ -527,7 +528,7 @@ func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) e
 		Workerpool: &workerPoolID,
 	}
 
-	workerPool, response, err := satClient.GetWorkerPool(getWorkerPoolOptions)
+	workerPool, response, err := satClient.GetWorkerPoolWithContext(ctx, getWorkerPoolOptions)
 	if err != nil || workerPool == nil {
 		log.Printf(
 			"An error occured while retrieving default workerpool : %s\n%s", err, response)
@@ -545,23 +546,23 @@ func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterID := d.Id()
 	workerPoolName := "default"
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	wrkAPI := csClient.Workers()
@@ -579,21 +580,21 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 			}
 			err := clusterAPI.Update(clusterID, params, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			_, err = WaitForSatelliteClusterVersionUpdate(d, meta, targetEnv)
+			_, err = WaitForSatelliteClusterVersionUpdate(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err))
 			}
 		}
 		satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		workerOpts := satClient.NewGetWorkers1Options(clusterID)
-		workerFields, response, err := satClient.GetWorkers1(workerOpts)
+		workerFields, response, err := satClient.GetWorkers1WithContext(ctx, workerOpts)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving workerFields %s: %s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workerFields %s: %s", err, response))
 		}
 
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
@@ -601,9 +602,9 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 			XAuthResourceGroup: &targetEnv.ResourceGroup,
 		}
 
-		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
+		cluster, response, err := satClient.GetClusterWithContext(ctx, getSatClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s, %s", clusterID, err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving cluster %s: %s, %s", clusterID, err, response))
 		}
 		waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
 		if workerFields != nil {
@@ -616,16 +617,16 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 					err = wrkAPI.Update(clusterID, *w.ID, params, targetEnv)
 					if err != nil {
 						d.Set("patch_version", nil)
-						return fmt.Errorf("[ERROR] Error updating worker %s: %s", *w.ID, err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error updating worker %s: %s", *w.ID, err))
 					}
 				}
 			}
 
 			if waitForWorkerUpdate {
-				_, err = WaitForSatelliteWorkerVersionUpdate(d, meta, *cluster.MasterKubeVersion, targetEnv)
+				_, err = WaitForSatelliteWorkerVersionUpdate(ctx, d, meta, *cluster.MasterKubeVersion, targetEnv)
 				if err != nil {
 					d.Set("patch_version", nil)
-					return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to update kube version: %s", clusterID, err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to update kube version: %s", clusterID, err))
 				}
 			}
 		}
@@ -640,9 +641,9 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 			XAuthResourceGroup: &targetEnv.ResourceGroup,
 		}
 
-		response, err := satClient.V2ResizeWorkerPool(resizeOpts)
+		response, err := satClient.V2ResizeWorkerPoolWithContext(ctx, resizeOpts)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the worker pool size %d: %s\n%s", workerCount, err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the worker pool size %d: %s\n%s", workerCount, err, response))
 		}
 	}
 
@@ -660,9 +661,9 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 			Labels:             labels,
 			XAuthResourceGroup: &targetEnv.ResourceGroup,
 		}
-		response, err := satClient.V2SetWorkerPoolLabels(wpots)
+		response, err := satClient.V2SetWorkerPoolLabelsWithContext(ctx, wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response))
 		}
 	}
 
@@ -674,9 +675,9 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 			XAuthResourceGroup: &targetEnv.ResourceGroup,
 		}
 
-		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
+		cluster, response, err := satClient.GetClusterWithContext(ctx, getSatClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s\n%s", clusterID, err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving cluster %s: %s\n%s", clusterID, err, response))
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *cluster.Crn)
 		if err != nil {
@@ -706,14 +707,14 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 					Workerpool: &workerPoolName,
 					ID:         &zID,
 				}
-				response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
+				response, err := satClient.CreateSatelliteWorkerPoolZoneWithContext(ctx, zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response))
 				}
 			}
-			_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutUpdate), targetEnv)
+			_, err = WaitForSatelliteWorkerPoolAvailable(ctx, d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutUpdate), targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 		if len(remove) > 0 {
@@ -725,36 +726,36 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 					PoolidOrName: &workerPoolName,
 					Zoneid:       &zID,
 				}
-				response, err := satClient.RemoveWorkerPoolZone(zoneOptions)
+				response, err := satClient.RemoveWorkerPoolZoneWithContext(ctx, zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response))
 				}
 			}
 		}
 	}
 
-	return resourceIBMSatelliteClusterRead(d, meta)
+	return resourceIBMSatelliteClusterRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	removeClusterOptions := &kubernetesserviceapiv1.RemoveClusterOptions{}
 	name := d.Get("name").(string)
 	removeClusterOptions.IdOrName = &name
 
-	response, err := satClient.RemoveCluster(removeClusterOptions)
+	response, err := satClient.RemoveClusterWithContext(ctx, removeClusterOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response))
 	}
 
 	//Wait for cluster to get delete
-	_, err = waitForClusterToDelete(name, d, meta)
+	_, err = waitForClusterToDelete(ctx, name, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting while deleteing cluster (%s) : %s", name, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting while deleteing cluster (%s) : %s", name, err))
 	}
 
 	log.Printf("[INFO] Deleted satellite cluster : %s", name)
@@ -763,7 +764,7 @@ func resourceIBMSatelliteClusterDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func waitForLocationNormal(location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLocationNormal(ctx context.Context, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -780,8 +781,8 @@ func waitForLocationNormal(location string, d *schema.ResourceData, meta interfa
 			var instance *kubernetesserviceapiv1.MultishiftGetController
 			var response *core.DetailedResponse
 			var err error
-			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-				instance, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+			err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+				instance, response, err = satClient.GetSatelliteLocationWithContext(ctx, getSatLocOptions)
 				if err != nil || instance == nil {
 					if response != nil && response.StatusCode == 404 {
 						return resource.RetryableError(err)
@@ -792,7 +793,7 @@ func waitForLocationNormal(location string, d *schema.ResourceData, meta interfa
 			})
 
 			if conns.IsResourceTimeoutError(err) {
-				instance, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+				instance, response, err = satClient.GetSatelliteLocationWithContext(ctx, getSatLocOptions)
 			}
 
 			if instance != nil {
@@ -808,10 +809,10 @@ func waitForLocationNormal(location string, d *schema.ResourceData, meta interfa
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForClusterToReady(cluster string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterToReady(ctx context.Context, cluster string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -824,7 +825,7 @@ func waitForClusterToReady(cluster string, d *schema.ResourceData, meta interfac
 			getClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 				Cluster: &cluster,
 			}
-			instance, response, err := satClient.GetCluster(getClusterOptions)
+			instance, response, err := satClient.GetClusterWithContext(ctx, getClusterOptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error Getting cluster : %s\n%s", err, response)
 			}
@@ -842,10 +843,10 @@ func waitForClusterToReady(cluster string, d *schema.ResourceData, meta interfac
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForClusterToDelete(cluster string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterToDelete(ctx context.Context, cluster string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -858,7 +859,7 @@ func waitForClusterToDelete(cluster string, d *schema.ResourceData, meta interfa
 			getClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 				Cluster: &cluster,
 			}
-			cluster, response, err := satClient.GetCluster(getClusterOptions)
+			cluster, response, err := satClient.GetClusterWithContext(ctx, getClusterOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return cluster, isClusterDeleteDone, nil
@@ -872,11 +873,11 @@ func waitForClusterToDelete(cluster string, d *schema.ResourceData, meta interfa
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 //  WaitForSatelliteWorkerVersionUpdate Waits for worker creation
-func WaitForSatelliteWorkerVersionUpdate(d *schema.ResourceData, meta interface{}, masterVersion string, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForSatelliteWorkerVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, masterVersion string, target v1.ClusterTargetHeader) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return nil, err
@@ -889,7 +890,7 @@ func WaitForSatelliteWorkerVersionUpdate(d *schema.ResourceData, meta interface{
 			log.Printf("Waiting for workers of the cluster (%s) to update version.", d.Id())
 			id := d.Id()
 			workerOpts := satClient.NewGetWorkers1Options(id)
-			workerFields, _, err := satClient.GetWorkers1(workerOpts)
+			workerFields, _, err := satClient.GetWorkers1WithContext(ctx, workerOpts)
 			if err != nil {
 				return nil, "", err
 			}
@@ -911,11 +912,11 @@ func WaitForSatelliteWorkerVersionUpdate(d *schema.ResourceData, meta interface{
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // WaitForSatelliteClusterVersionUpdate Waits for cluster creation
-func WaitForSatelliteClusterVersionUpdate(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForSatelliteClusterVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -933,7 +934,7 @@ func WaitForSatelliteClusterVersionUpdate(d *schema.ResourceData, meta interface
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func satelliteClusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -4,6 +4,7 @@
 package satellite
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -37,10 +39,10 @@ const (
 
 func ResourceIBMSatelliteClusterWorkerPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMSatelliteClusterWorkerPoolCreate,
-		Read:   resourceIBMSatelliteClusterWorkerPoolRead,
-		Update: resourceIBMSatelliteClusterWorkerPoolUpdate,
-		Delete: resourceIBMSatelliteClusterWorkerPoolDelete,
+		CreateContext: resourceIBMSatelliteClusterWorkerPoolCreate,
+		ReadContext:   resourceIBMSatelliteClusterWorkerPoolRead,
+		UpdateContext: resourceIBMSatelliteClusterWorkerPoolUpdate,
+		DeleteContext: resourceIBMSatelliteClusterWorkerPoolDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				parts, err := flex.IdParts(d.Id())
@@ -180,15 +182,15 @@ func getClusterTargetHeader(d *schema.ResourceData, meta interface{}) (v1.Cluste
 	}
 	return targetEnv, nil
 }
-func resourceIBMSatelliteClusterWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterWorkerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createWorkerPoolOptions := &kubernetesserviceapiv1.CreateSatelliteWorkerPoolOptions{}
@@ -249,33 +251,33 @@ func resourceIBMSatelliteClusterWorkerPoolCreate(d *schema.ResourceData, meta in
 		createWorkerPoolOptions.Isolation = &isolation
 	}
 
-	instance, response, err := satClient.CreateSatelliteWorkerPool(createWorkerPoolOptions)
+	instance, response, err := satClient.CreateSatelliteWorkerPoolWithContext(ctx, createWorkerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite cluster worker pool: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Satellite cluster worker pool: %s\n%s", err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cluster, *instance.WorkerPoolID))
 	log.Printf("[INFO] Created satellite cluster worker pool: %s", *instance.WorkerPoolID)
 
-	_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, cluster, *instance.WorkerPoolID, d.Timeout(schema.TimeoutCreate), targetEnv)
+	_, err = WaitForSatelliteWorkerPoolAvailable(ctx, d, meta, cluster, *instance.WorkerPoolID, d.Timeout(schema.TimeoutCreate), targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 	}
 
-	return resourceIBMSatelliteClusterWorkerPoolRead(d, meta)
+	return resourceIBMSatelliteClusterWorkerPoolRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteClusterWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterWorkerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := parts[0]
 	workerPoolID := parts[1]
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getWorkerPoolOptions := &kubernetesserviceapiv1.GetWorkerPoolOptions{
@@ -283,13 +285,13 @@ func resourceIBMSatelliteClusterWorkerPoolRead(d *schema.ResourceData, meta inte
 		Workerpool: &workerPoolID,
 	}
 
-	workerPool, response, err := satClient.GetWorkerPool(getWorkerPoolOptions)
+	workerPool, response, err := satClient.GetWorkerPoolWithContext(ctx, getWorkerPoolOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", workerPool.PoolName)
@@ -303,22 +305,22 @@ func resourceIBMSatelliteClusterWorkerPoolRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterWorkerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameOrID := parts[0]
 	workerPoolName := parts[1]
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("worker_pool_labels") {
@@ -334,9 +336,9 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 			Workerpool: &workerPoolName,
 			Labels:     labels,
 		}
-		response, err := satClient.V2SetWorkerPoolLabels(wpots)
+		response, err := satClient.V2SetWorkerPoolLabelsWithContext(ctx, wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response))
 		}
 	}
 
@@ -346,17 +348,17 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 		count := d.Get("worker_count").(int)
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPoolName, count, Env)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the worker_count %d: %s", count, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the worker_count %d: %s", count, err))
 		}
 	}
 
@@ -384,14 +386,14 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 					Workerpool: &workerPoolName,
 					ID:         &zID,
 				}
-				response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
+				response, err := satClient.CreateSatelliteWorkerPoolZoneWithContext(ctx, zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response))
 				}
 			}
-			_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
+			_, err = WaitForSatelliteWorkerPoolAvailable(ctx, d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 		if len(remove) > 0 {
@@ -403,32 +405,32 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 					PoolidOrName: &workerPoolName,
 					Zoneid:       &zID,
 				}
-				response, err := satClient.RemoveWorkerPoolZone(zoneOptions)
+				response, err := satClient.RemoveWorkerPoolZoneWithContext(ctx, zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response))
 				}
 			}
 		}
 	}
-	return resourceIBMSatelliteClusterWorkerPoolRead(d, meta)
+	return resourceIBMSatelliteClusterWorkerPoolRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteClusterWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterWorkerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := parts[0]
 	workerPoolID := parts[1]
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	wpOptions := &kubernetesserviceapiv1.RemoveWorkerPoolOptions{
@@ -436,17 +438,17 @@ func resourceIBMSatelliteClusterWorkerPoolDelete(d *schema.ResourceData, meta in
 		PoolidOrName: &workerPoolID,
 	}
 
-	response, err := satClient.RemoveWorkerPool(wpOptions)
+	response, err := satClient.RemoveWorkerPoolWithContext(ctx, wpOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Cluster WorkerPool: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Satellite Cluster WorkerPool: %s\n%s", err, response))
 	}
 
-	_, err = WaitForSatelliteWorkerDelete(clusterID, workerPoolID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	_, err = WaitForSatelliteWorkerDelete(ctx, clusterID, workerPoolID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolID, clusterID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolID, clusterID, err))
 	}
 
 	d.SetId("")
@@ -464,7 +466,7 @@ func getVpcClusterTargetHeader(d *schema.ResourceData, meta interface{}) (v2.Clu
 }
 
 // WaitForSatelliteWorkerPoolAvailable Waits for workerpool deployed
-func WaitForSatelliteWorkerPoolAvailable(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForSatelliteWorkerPoolAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	clusterID := clusterNameOrID
 	workerPoolID := workerPoolNameOrID
 
@@ -482,7 +484,7 @@ func WaitForSatelliteWorkerPoolAvailable(d *schema.ResourceData, meta interface{
 				XAuthResourceGroup: &target.ResourceGroup,
 			}
 
-			workers, response, err := satClient.GetWorkers1(getWorkersOptions)
+			workers, response, err := satClient.GetWorkers1WithContext(ctx, getWorkersOptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s\n%s", err, response)
 			}
@@ -504,10 +506,10 @@ func WaitForSatelliteWorkerPoolAvailable(d *schema.ResourceData, meta interface{
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func WaitForSatelliteWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForSatelliteWorkerDelete(ctx context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return nil, err
@@ -516,16 +518,16 @@ func WaitForSatelliteWorkerDelete(clusterNameOrID, workerPoolNameOrID string, me
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"deleting"},
 		Target:     []string{workerDeleteState},
-		Refresh:    satelliteWorkerPoolDeleteStateRefreshFunc(satClient, clusterNameOrID, workerPoolNameOrID, target),
+		Refresh:    satelliteWorkerPoolDeleteStateRefreshFunc(ctx, satClient, clusterNameOrID, workerPoolNameOrID, target),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func satelliteWorkerPoolDeleteStateRefreshFunc(satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, clusterID, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
+func satelliteWorkerPoolDeleteStateRefreshFunc(ctx context.Context, satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, clusterID, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
 
 	return func() (interface{}, string, error) {
 
@@ -534,7 +536,7 @@ func satelliteWorkerPoolDeleteStateRefreshFunc(satClient *kubernetesserviceapiv1
 			XAuthResourceGroup: &target.ResourceGroup,
 		}
 
-		workerFields, response, err := satClient.GetWorkers1(getWorkersOptions)
+		workerFields, response, err := satClient.GetWorkers1WithContext(ctx, getWorkersOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s\n%s", err, response)
 		}
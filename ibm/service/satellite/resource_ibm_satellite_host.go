@@ -4,6 +4,7 @@
 package satellite
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -36,11 +38,11 @@ const (
 
 func ResourceIBMSatelliteHost() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSatelliteHostCreate,
-		Read:     resourceIBMSatelliteHostRead,
-		Update:   resourceIBMSatelliteHostUpdate,
-		Delete:   resourceIBMSatelliteHostDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSatelliteHostCreate,
+		ReadContext:   resourceIBMSatelliteHostRead,
+		UpdateContext: resourceIBMSatelliteHostUpdate,
+		DeleteContext: resourceIBMSatelliteHostDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(75 * time.Minute),
@@ -121,13 +123,13 @@ func ResourceIBMSatelliteHostValidator() *validate.ResourceValidator {
 	satelliteHostResourceValidator := validate.ResourceValidator{ResourceName: "ibm_satellite_host", Schema: validateSchema}
 	return &satelliteHostResourceValidator
 }
-func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	hostName := d.Get(hostID).(string)
 	location := d.Get(hostLocation).(string)
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hostAssignOptions := &kubernetesserviceapiv1.CreateSatelliteAssignmentOptions{}
@@ -141,9 +143,9 @@ func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) er
 	hostAssignOptions.HostID = flex.PtrToString(hostName)
 
 	//Check host attached to location
-	hostStatus, err := waitForHostAttachment(hostName, location, d, meta)
+	hostStatus, err := waitForHostAttachment(ctx, hostName, location, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for attaching host (%s) to be succeeded: %s", hostName, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for attaching host (%s) to be succeeded: %s", hostName, err))
 	}
 
 	labels := make(map[string]string)
@@ -164,57 +166,57 @@ func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if hostStatus == rsHostReadyStatus {
-		_, response, err := satClient.CreateSatelliteAssignment(hostAssignOptions)
+		_, response, err := satClient.CreateSatelliteAssignmentWithContext(ctx, hostAssignOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Assigning Satellite Host: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Assigning Satellite Host: %s\n%s", err, response))
 		}
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", location, hostName))
 
 	//Wait for host to reach normal state
-	_, err = waitForHostAttachment(hostName, location, d, meta)
+	_, err = waitForHostAttachment(ctx, hostName, location, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for host (%s) to get normal state: %s", hostName, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for host (%s) to get normal state: %s", hostName, err))
 	}
 	wait, ok := d.GetOk("wait_till")
 	if ok && wait.(string) == "location_normal" {
-		_, err = waitForLocationNormal(location, d, meta)
+		_, err = waitForLocationNormal(ctx, location, d, meta)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for getting location (%s) to be normal: %s", location, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for getting location (%s) to be normal: %s", location, err))
 		}
 	}
 
-	return resourceIBMSatelliteHostRead(d, meta)
+	return resourceIBMSatelliteHostRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteHostRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(parts) < 2 {
-		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of location/hostName", d.Id())
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of location/hostName", d.Id()))
 	}
 	location := parts[0]
 	hostName := parts[1]
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
 		Controller: &location,
 	}
-	hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
+	hostList, resp, err := satClient.GetSatelliteHostsWithContext(ctx, hostOptions)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
 		log.Println("resourceIBMSatelliteHostRead : error in getting hostlist :", err, resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, h := range hostList {
@@ -247,17 +249,17 @@ func resourceIBMSatelliteHostRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMSatelliteHostUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	locationName := parts[0]
 	hostID := parts[1]
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateHostOptions := &kubernetesserviceapiv1.UpdateSatelliteHostOptions{}
@@ -271,45 +273,45 @@ func resourceIBMSatelliteHostUpdate(d *schema.ResourceData, meta interface{}) er
 			labels = flex.FlattenHostLabels(l.List())
 			updateHostOptions.Labels = labels
 		}
-		response, err := satClient.UpdateSatelliteHost(updateHostOptions)
+		response, err := satClient.UpdateSatelliteHostWithContext(ctx, updateHostOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Satellite Host: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Satellite Host: %s\n%s", err, response))
 		}
 	}
 
-	return resourceIBMSatelliteHostRead(d, meta)
+	return resourceIBMSatelliteHostRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteHostDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	location := parts[0]
 	hostID := parts[1]
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	removeSatHostOptions := &kubernetesserviceapiv1.RemoveSatelliteHostOptions{}
 	removeSatHostOptions.Controller = &location
 	removeSatHostOptions.HostID = &hostID
 
-	response, err := satClient.RemoveSatelliteHost(removeSatHostOptions)
+	response, err := satClient.RemoveSatelliteHostWithContext(ctx, removeSatHostOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Host: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Satellite Host: %s\n%s", err, response))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func waitForHostAttachment(hostName, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForHostAttachment(ctx context.Context, hostName, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -322,7 +324,7 @@ func waitForHostAttachment(hostName, location string, d *schema.ResourceData, me
 			attachOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
 				Controller: &location,
 			}
-			hostList, resp, err := satClient.GetSatelliteHosts(attachOptions)
+			hostList, resp, err := satClient.GetSatelliteHostsWithContext(ctx, attachOptions)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() != 404 {
 					return nil, "", fmt.Errorf("[ERROR] The satellite host (%s) failed to attached: %v\n%s", hostName, err, resp)
@@ -345,5 +347,5 @@ func waitForHostAttachment(hostName, location string, d *schema.ResourceData, me
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
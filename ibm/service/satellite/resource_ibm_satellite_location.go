@@ -15,6 +15,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,10 +34,10 @@ const (
 
 func ResourceIBMSatelliteLocation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMSatelliteLocationCreate,
-		Read:   resourceIBMSatelliteLocationRead,
-		Update: resourceIBMSatelliteLocationUpdate,
-		Delete: resourceIBMSatelliteLocationDelete,
+		CreateContext: resourceIBMSatelliteLocationCreate,
+		ReadContext:   resourceIBMSatelliteLocationRead,
+		UpdateContext: resourceIBMSatelliteLocationUpdate,
+		DeleteContext: resourceIBMSatelliteLocationDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				ID := d.Id()
@@ -61,7 +62,7 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ImmutableResourceCustomizeDiff([]string{satLocation, sateLocZone, "resource_group_id", "zones"}, diff)
@@ -223,10 +224,10 @@ func ResourceIBMSatelliteLocationValidator() *validate.ResourceValidator {
 	return &ibmSatelliteLocationValidator
 }
 
-func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createSatLocOptions := &kubernetesserviceapiv1.CreateSatelliteLocationOptions{}
@@ -265,9 +266,9 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 		createSatLocOptions.Headers = pathParamsMap
 	}
 
-	instance, response, err := satClient.CreateSatelliteLocation(createSatLocOptions)
+	instance, response, err := satClient.CreateSatelliteLocationWithContext(ctx, createSatLocOptions)
 	if err != nil || instance == nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response))
 	}
 
 	d.SetId(*instance.ID)
@@ -284,32 +285,32 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	//Wait for location to be in ready state
-	_, err = waitForLocationToReady(*instance.ID, d, meta)
+	_, err = waitForLocationToReady(ctx, *instance.ID, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for location (%s) to reach action required state: %s", *instance.ID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for location (%s) to reach action required state: %s", *instance.ID, err))
 	}
 
-	return resourceIBMSatelliteLocationRead(d, meta)
+	return resourceIBMSatelliteLocationRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ID := d.Id()
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
 		Controller: &ID,
 	}
 
-	instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
+	instance, response, err := satClient.GetSatelliteLocationWithContext(ctx, getSatLocOptions)
 	if err != nil || instance == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(satLocation, *instance.Name)
@@ -346,11 +347,11 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ID := d.Id()
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -360,9 +361,9 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 			Controller: &ID,
 		}
 
-		instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
+		instance, response, err := satClient.GetSatelliteLocationWithContext(ctx, getSatLocOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving satellite location: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving satellite location: %s\n%s", err, response))
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -373,32 +374,32 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIBMSatelliteLocationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	removeSatLocOptions := &kubernetesserviceapiv1.RemoveSatelliteLocationOptions{}
 	name := d.Get(satLocation).(string)
 	removeSatLocOptions.Controller = &name
 
-	response, err := satClient.RemoveSatelliteLocation(removeSatLocOptions)
+	response, err := satClient.RemoveSatelliteLocationWithContext(ctx, removeSatLocOptions)
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Location: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Satellite Location: %s\n%s", err, response))
 	}
 
 	//Wait for location to delete
-	_, err = waitForLocationDelete(name, d, meta)
+	_, err = waitForLocationDelete(ctx, name, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for deleting location instance: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for deleting location instance: %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func waitForLocationDelete(location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLocationDelete(ctx context.Context, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -409,7 +410,7 @@ func waitForLocationDelete(location string, d *schema.ResourceData, meta interfa
 		Target:  []string{isLocationDeleteDone},
 		Refresh: func() (interface{}, string, error) {
 			getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationsOptions{}
-			locations, response, err := satClient.GetSatelliteLocations(getSatLocOptions)
+			locations, response, err := satClient.GetSatelliteLocationsWithContext(ctx, getSatLocOptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error Getting locations list to delete : %s\n%s", err, response)
 			}
@@ -433,10 +434,10 @@ func waitForLocationDelete(location string, d *schema.ResourceData, meta interfa
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLocationToReady(ctx context.Context, loc string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -453,8 +454,8 @@ func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}
 			var location *kubernetesserviceapiv1.MultishiftGetController
 			var response *core.DetailedResponse
 			var err error
-			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-				location, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+			err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+				location, response, err = satClient.GetSatelliteLocationWithContext(ctx, getSatLocOptions)
 				if err != nil || location == nil {
 					if response != nil && response.StatusCode == 404 {
 						return resource.RetryableError(err)
@@ -465,7 +466,7 @@ func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}
 			})
 
 			if conns.IsResourceTimeoutError(err) {
-				location, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+				location, response, err = satClient.GetSatelliteLocationWithContext(ctx, getSatLocOptions)
 			}
 
 			if location != nil && *location.State == isLocationDeployFailed {
@@ -482,5 +483,5 @@ func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
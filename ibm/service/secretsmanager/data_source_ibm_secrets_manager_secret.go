@@ -7,10 +7,8 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -243,46 +241,10 @@ func DataSourceIBMSecretsManagerSecretValidator() *validate.ResourceValidator {
 }
 
 func dataSourceIBMSecretsManagerSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	bluemixSession, err := meta.(conns.ClientSession).BluemixSession()
+	secretsManagerClient, err := getSecretsManagerClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	region := bluemixSession.Config.Region
-
-	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV1()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	rContollerClient, err := meta.(conns.ClientSession).ResourceControllerAPIV2()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	instanceID := d.Get("instance_id").(string)
-	endpointType := d.Get("endpoint_type").(string)
-	var smEndpointURL string
-
-	rContollerAPI := rContollerClient.ResourceServiceInstanceV2()
-
-	instanceData, err := rContollerAPI.GetInstance(instanceID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	instanceCRN := instanceData.Crn.String()
-
-	crnData := strings.Split(instanceCRN, ":")
-
-	if crnData[4] == "secrets-manager" {
-		if endpointType == "private" {
-			smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
-		} else {
-			smEndpointURL = "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
-		}
-		smUrl := conns.EnvFallBack([]string{"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"}, smEndpointURL)
-		secretsManagerClient.Service.Options.URL = smUrl
-	} else {
-		return diag.FromErr(fmt.Errorf("[ERROR] Invalid or unsupported service Instance"))
-	}
 
 	secretType := d.Get("secret_type").(string)
 	secretID := d.Get("secret_id").(string)
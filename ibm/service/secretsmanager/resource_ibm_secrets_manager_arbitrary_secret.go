@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSecretsManagerArbitrarySecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerArbitrarySecretCreate,
+		ReadContext:   resourceIBMSecretsManagerArbitrarySecretRead,
+		UpdateContext: resourceIBMSecretsManagerArbitrarySecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretDelete,
+		Importer:      secretsManagerSecretImporter(secretTypeArbitrary),
+		CustomizeDiff: secretsManagerForceNewIfRemoved("expiration_date"),
+
+		Schema: secretsManagerSecretSchema("ibm_secrets_manager_arbitrary_secret", map[string]*schema.Schema{
+			"payload": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret data. Changing it rotates the secret to a new version.",
+			},
+			"expiration_date": expirationDateSchema(),
+		}),
+	}
+}
+
+func ResourceIBMSecretsManagerArbitrarySecretValidator() *validate.ResourceValidator {
+	return secretsManagerSecretValidator("ibm_secrets_manager_arbitrary_secret")
+}
+
+func resourceIBMSecretsManagerArbitrarySecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	expirationDate, err := secretsManagerExpirationDate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secret := &secretsmanagerv1.SecretResource{
+		Name:           core.StringPtr(d.Get("name").(string)),
+		Description:    core.StringPtr(d.Get("description").(string)),
+		Labels:         flex.ExpandStringList(d.Get("labels").([]interface{})),
+		Payload:        core.StringPtr(d.Get("payload").(string)),
+		ExpirationDate: expirationDate,
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secret.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+	if err := resourceIBMSecretsManagerSecretCreate(context, d, meta, secretTypeArbitrary, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerArbitrarySecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerArbitrarySecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := resourceIBMSecretsManagerSecretGet(context, d, meta)
+	if err != nil || secret == nil {
+		return diag.FromErr(err)
+	}
+
+	if payload, ok := secretsManagerSecretData(secret)["payload"].(string); ok {
+		d.Set("payload", payload)
+	} else if secret.Payload != nil {
+		d.Set("payload", secret.Payload)
+	}
+	if secret.ExpirationDate != nil {
+		d.Set("expiration_date", secret.ExpirationDate.String())
+	} else {
+		d.Set("expiration_date", nil)
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerArbitrarySecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("payload") {
+		action := &secretsmanagerv1.SecretActionOneOf{
+			Payload: core.StringPtr(d.Get("payload").(string)),
+		}
+		if err := resourceIBMSecretsManagerSecretRotate(context, d, client, action); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	expirationDate, err := secretsManagerExpirationDate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	metadata := &secretsmanagerv1.SecretMetadata{
+		ExpirationDate: expirationDate,
+	}
+	if err := resourceIBMSecretsManagerSecretUpdateMetadata(context, d, client, metadata); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerArbitrarySecretRead(context, d, meta)
+}
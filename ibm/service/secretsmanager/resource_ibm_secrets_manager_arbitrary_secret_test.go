@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerArbitrarySecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-arbitrary-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerArbitrarySecretConfigBasic(name, "secret-payload"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_arbitrary_secret.secret", "name", name),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_arbitrary_secret.secret", "payload", "secret-payload"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_arbitrary_secret.secret", "labels.#", "1"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_arbitrary_secret.secret", "state", "1"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_arbitrary_secret.secret", "secret_id"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_arbitrary_secret.secret", "secret_group_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerArbitrarySecretConfigBasic(name, "rotated-secret-payload"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_arbitrary_secret.secret", "payload", "rotated-secret-payload"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_arbitrary_secret.secret", "versions_total", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_secrets_manager_arbitrary_secret.secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerArbitrarySecretConfigBasic(name, payload string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_arbitrary_secret" "secret" {
+			instance_id = "%s"
+			name = "%s"
+			labels = ["terraform"]
+			payload = "%s"
+			expiration_date = "2030-01-01T00:00:00Z"
+		}
+	`, acc.SecretsManagerInstanceID, name, payload)
+}
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSecretsManagerIAMCredentialsSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerIAMCredentialsSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerIAMCredentialsSecretRead,
+		UpdateContext: resourceIBMSecretsManagerIAMCredentialsSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretDelete,
+		Importer:      secretsManagerSecretImporter(secretTypeIAMCredentials),
+
+		Schema: secretsManagerSecretSchema("ibm_secrets_manager_iam_credentials_secret", map[string]*schema.Schema{
+			"ttl": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentTTL,
+				Description:      "The time-to-live of the API keys generated for the secret, a number of seconds or a duration, e.g. `3600` or `24h`.",
+			},
+			"access_groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The access groups, up to 10, that define the scope of the service ID generated for the secret.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The service ID whose API keys are generated for the secret, a service ID is generated in the access groups if omitted.",
+			},
+			"reuse_api_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether the API key generated for the secret is reused until the secret expires, a new one being generated on each read otherwise.",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key generated for the secret.",
+			},
+		}),
+	}
+}
+
+func ResourceIBMSecretsManagerIAMCredentialsSecretValidator() *validate.ResourceValidator {
+	return secretsManagerSecretValidator("ibm_secrets_manager_iam_credentials_secret")
+}
+
+func resourceIBMSecretsManagerIAMCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret := &secretsmanagerv1.SecretResource{
+		Name:         core.StringPtr(d.Get("name").(string)),
+		Description:  core.StringPtr(d.Get("description").(string)),
+		Labels:       flex.ExpandStringList(d.Get("labels").([]interface{})),
+		TTL:          d.Get("ttl").(string),
+		AccessGroups: flex.ExpandStringList(d.Get("access_groups").([]interface{})),
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secret.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+	if serviceID, ok := d.GetOk("service_id"); ok {
+		secret.ServiceID = core.StringPtr(serviceID.(string))
+	}
+	if reuseAPIKey, ok := d.GetOk("reuse_api_key"); ok {
+		secret.ReuseAPIKey = core.BoolPtr(reuseAPIKey.(bool))
+	}
+	if err := resourceIBMSecretsManagerSecretCreate(context, d, meta, secretTypeIAMCredentials, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerIAMCredentialsSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerIAMCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := resourceIBMSecretsManagerSecretGet(context, d, meta)
+	if err != nil || secret == nil {
+		return diag.FromErr(err)
+	}
+
+	if secret.TTL != nil {
+		d.Set("ttl", fmt.Sprint(secret.TTL))
+	}
+	if err = d.Set("access_groups", secret.AccessGroups); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting access_groups: %s", err))
+	}
+	if secret.ServiceID != nil {
+		d.Set("service_id", secret.ServiceID)
+	}
+	if secret.ReuseAPIKey != nil {
+		d.Set("reuse_api_key", secret.ReuseAPIKey)
+	}
+	if apiKey, ok := secretsManagerSecretData(secret)["api_key"].(string); ok {
+		d.Set("api_key", apiKey)
+	} else if secret.APIKey != nil {
+		d.Set("api_key", secret.APIKey)
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerIAMCredentialsSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := &secretsmanagerv1.SecretMetadata{
+		TTL: d.Get("ttl").(string),
+	}
+	if err := resourceIBMSecretsManagerSecretUpdateMetadata(context, d, client, metadata); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerIAMCredentialsSecretRead(context, d, meta)
+}
+
+// suppressEquivalentTTL suppresses the diff of the TTLs of the same duration,
+// e.g. 3600 and 1h.
+func suppressEquivalentTTL(k, old, new string, d *schema.ResourceData) bool {
+	oldTTL, ok := parseTTL(old)
+	if !ok {
+		return false
+	}
+	newTTL, ok := parseTTL(new)
+	return ok && oldTTL == newTTL
+}
+
+// parseTTL parses a TTL, a number of seconds or a duration.
+func parseTTL(ttl string) (time.Duration, bool) {
+	if seconds, err := strconv.ParseFloat(ttl, 64); err == nil {
+		return time.Duration(seconds * float64(time.Second)), true
+	}
+	duration, err := time.ParseDuration(ttl)
+	return duration, err == nil
+}
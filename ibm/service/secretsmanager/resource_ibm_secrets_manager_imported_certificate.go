@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSecretsManagerImportedCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerImportedCertificateCreate,
+		ReadContext:   resourceIBMSecretsManagerImportedCertificateRead,
+		UpdateContext: resourceIBMSecretsManagerImportedCertificateUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretDelete,
+		Importer:      secretsManagerSecretImporter(secretTypeImportedCert),
+
+		Schema: secretsManagerSecretSchema("ibm_secrets_manager_imported_certificate", map[string]*schema.Schema{
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentPEM,
+				Description:      "The PEM-encoded certificate. Changing the certificate, the private key or the intermediate certificate imports them as a new version of the secret.",
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressEquivalentPEM,
+				Description:      "The PEM-encoded private key of the certificate.",
+			},
+			"intermediate": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentPEM,
+				Description:      "The PEM-encoded intermediate certificate of the certificate.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The common name of the certificate.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The distinguished name of the issuer of the certificate.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the certificate, in hexadecimal.",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the certificate expires. The date format follows RFC 3339.",
+			},
+		}),
+	}
+}
+
+func ResourceIBMSecretsManagerImportedCertificateValidator() *validate.ResourceValidator {
+	return secretsManagerSecretValidator("ibm_secrets_manager_imported_certificate")
+}
+
+func resourceIBMSecretsManagerImportedCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if _, err := parseCertificate(d.Get("certificate").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	secret := resourceIBMSecretsManagerImportedCertificateData(d)
+	secret["name"] = d.Get("name").(string)
+	secret["description"] = d.Get("description").(string)
+	secret["labels"] = flex.ExpandStringList(d.Get("labels").([]interface{}))
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secret["secret_group_id"] = secretGroupID.(string)
+	}
+	if err := resourceIBMSecretsManagerSecretCreate(context, d, meta, secretTypeImportedCert, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerImportedCertificateRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerImportedCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := resourceIBMSecretsManagerSecretGet(context, d, meta)
+	if err != nil || secret == nil {
+		return diag.FromErr(err)
+	}
+
+	secretData := secretsManagerSecretData(secret)
+	for _, field := range []string{"certificate", "private_key", "intermediate"} {
+		if value, ok := secretData[field].(string); ok {
+			d.Set(field, value)
+		}
+	}
+	if secret.ExpirationDate != nil {
+		d.Set("expiration_date", secret.ExpirationDate.String())
+	}
+	if v, ok := d.GetOk("certificate"); ok {
+		certificate, err := parseCertificate(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("common_name", certificate.Subject.CommonName)
+		d.Set("issuer", certificate.Issuer.String())
+		d.Set("serial_number", fmt.Sprintf("%x", certificate.SerialNumber))
+		if secret.ExpirationDate == nil {
+			d.Set("expiration_date", certificate.NotAfter.UTC().Format(time.RFC3339))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerImportedCertificateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("certificate", "private_key", "intermediate") {
+		if _, err := parseCertificate(d.Get("certificate").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+		action := resourceIBMSecretsManagerImportedCertificateData(d)
+		if err := resourceIBMSecretsManagerSecretRotate(context, d, client, action); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := resourceIBMSecretsManagerSecretUpdateMetadata(context, d, client, &secretsmanagerv1.SecretMetadata{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerImportedCertificateRead(context, d, meta)
+}
+
+// resourceIBMSecretsManagerImportedCertificateData returns the certificate,
+// private key and intermediate certificate of the resource.
+func resourceIBMSecretsManagerImportedCertificateData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"certificate": d.Get("certificate").(string),
+	}
+	if privateKey, ok := d.GetOk("private_key"); ok {
+		data["private_key"] = privateKey.(string)
+	}
+	if intermediate, ok := d.GetOk("intermediate"); ok {
+		data["intermediate"] = intermediate.(string)
+	}
+	return data
+}
+
+// parseCertificate parses the first certificate of a PEM-encoded certificate.
+func parseCertificate(certificate string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("[ERROR] Error parsing certificate: no PEM-encoded certificate found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing certificate: %s", err)
+	}
+	return cert, nil
+}
+
+// suppressEquivalentPEM suppresses the diff of the PEM blocks differing only
+// by their surrounding whitespace.
+func suppressEquivalentPEM(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
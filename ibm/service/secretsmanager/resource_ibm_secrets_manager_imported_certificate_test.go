@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerImportedCertificateBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-imported-certificate-%d", acctest.RandIntRange(10, 100))
+	certificate, privateKey := testAccSelfSignedCertificate(t, "example.com", 1)
+	certificateUpdate, privateKeyUpdate := testAccSelfSignedCertificate(t, "example.com", 2)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerImportedCertificateConfigBasic(name, certificate, privateKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_imported_certificate.certificate", "name", name),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_imported_certificate.certificate", "common_name", "example.com"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_imported_certificate.certificate", "serial_number", "1"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_imported_certificate.certificate", "expiration_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerImportedCertificateConfigBasic(name, certificateUpdate, privateKeyUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_imported_certificate.certificate", "serial_number", "2"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_imported_certificate.certificate", "versions_total", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_secrets_manager_imported_certificate.certificate",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerImportedCertificateConfigBasic(name, certificate, privateKey string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_imported_certificate" "certificate" {
+			instance_id = "%s"
+			name = "%s"
+			certificate = <<EOT
+%sEOT
+			private_key = <<EOT
+%sEOT
+		}
+	`, acc.SecretsManagerInstanceID, name, certificate, privateKey)
+}
+
+// testAccSelfSignedCertificate returns a PEM-encoded self-signed certificate
+// of commonName and its private key.
+func testAccSelfSignedCertificate(t *testing.T, commonName string, serialNumber int64) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serialNumber),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return string(certificate), string(privateKey)
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSecretsManagerKvSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerKvSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerKvSecretRead,
+		UpdateContext: resourceIBMSecretsManagerKvSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretDelete,
+		Importer:      secretsManagerSecretImporter(secretTypeKv),
+
+		Schema: secretsManagerSecretSchema("ibm_secrets_manager_kv_secret", map[string]*schema.Schema{
+			"data": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateFunc:     validate.InvokeValidator("ibm_secrets_manager_kv_secret", "data"),
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				Description:      "The key-value pairs of the secret, a JSON object, e.g. `jsonencode({ user = \"admin\" })`. Changing it rotates the secret to a new version.",
+			},
+		}),
+	}
+}
+
+func ResourceIBMSecretsManagerKvSecretValidator() *validate.ResourceValidator {
+	return secretsManagerSecretValidator("ibm_secrets_manager_kv_secret",
+		validate.ValidateSchema{
+			Identifier:                 "data",
+			ValidateFunctionIdentifier: validate.ValidateJSONString,
+			Type:                       validate.TypeString,
+			Required:                   true})
+}
+
+func resourceIBMSecretsManagerKvSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	payload, err := resourceIBMSecretsManagerKvSecretPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secret := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"labels":      flex.ExpandStringList(d.Get("labels").([]interface{})),
+		"payload":     payload,
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secret["secret_group_id"] = secretGroupID.(string)
+	}
+	if err := resourceIBMSecretsManagerSecretCreate(context, d, meta, secretTypeKv, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerKvSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerKvSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := resourceIBMSecretsManagerSecretGet(context, d, meta)
+	if err != nil || secret == nil {
+		return diag.FromErr(err)
+	}
+
+	if payload, ok := secretsManagerSecretData(secret)["payload"]; ok {
+		data, err := jsonSecretData(payload)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting data: %s", err))
+		}
+		d.Set("data", data)
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerKvSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("data") {
+		payload, err := resourceIBMSecretsManagerKvSecretPayload(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		action := map[string]interface{}{
+			"payload": payload,
+		}
+		if err := resourceIBMSecretsManagerSecretRotate(context, d, client, action); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := resourceIBMSecretsManagerSecretUpdateMetadata(context, d, client, &secretsmanagerv1.SecretMetadata{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerKvSecretRead(context, d, meta)
+}
+
+// resourceIBMSecretsManagerKvSecretPayload returns the key-value pairs of the
+// data argument.
+func resourceIBMSecretsManagerKvSecretPayload(d *schema.ResourceData) (map[string]interface{}, error) {
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("data").(string)), &payload); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing data, it should be a JSON object: %s", err)
+	}
+	return payload, nil
+}
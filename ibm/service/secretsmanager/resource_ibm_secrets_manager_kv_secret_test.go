@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerKvSecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-kv-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerKvSecretConfigBasic(name, "admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_kv_secret.secret", "name", name),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_kv_secret.secret", "data", `{"port":5432,"user":"admin"}`),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_kv_secret.secret", "secret_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerKvSecretConfigBasic(name, "operator"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_kv_secret.secret", "data", `{"port":5432,"user":"operator"}`),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_kv_secret.secret", "versions_total", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_secrets_manager_kv_secret.secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerKvSecretConfigBasic(name, user string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_kv_secret" "secret" {
+			instance_id = "%s"
+			name = "%s"
+			data = jsonencode({
+				user = "%s"
+				port = 5432
+			})
+		}
+	`, acc.SecretsManagerInstanceID, name, user)
+}
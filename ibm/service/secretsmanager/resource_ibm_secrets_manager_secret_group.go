@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSecretsManagerSecretGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerSecretGroupCreate,
+		ReadContext:   resourceIBMSecretsManagerSecretGroupRead,
+		UpdateContext: resourceIBMSecretsManagerSecretGroupUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				endpointType, err := secretsManagerImportEndpointType(d, 2)
+				if err != nil {
+					return nil, err
+				}
+				parts, err := flex.IdParts(d.Id())
+				if err != nil || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+					return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instance_id/secret_group_id", d.Id())
+				}
+				d.Set("instance_id", parts[0])
+				d.Set("endpoint_type", endpointType)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GUID of the Secrets Manager instance.",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validate.InvokeValidator("ibm_secrets_manager_secret_group", "endpoint_type"),
+				Description:  "The endpoint type of the Secrets Manager instance, `public` or `private`.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-readable name of the secret group.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An extended description of the secret group.",
+			},
+			"secret_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The v4 UUID that uniquely identifies the secret group.",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the secret group was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Updates when the metadata of the secret group is modified. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func ResourceIBMSecretsManagerSecretGroupValidator() *validate.ResourceValidator {
+	return secretsManagerSecretValidator("ibm_secrets_manager_secret_group")
+}
+
+func resourceIBMSecretsManagerSecretGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretGroup := secretsmanagerv1.SecretGroupResource{
+		Name: core.StringPtr(d.Get("name").(string)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		secretGroup.Description = core.StringPtr(description.(string))
+	}
+	createSecretGroupOptions := &secretsmanagerv1.CreateSecretGroupOptions{
+		Metadata:  secretsManagerCollectionMetadata(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretGroupJSONConst),
+		Resources: []secretsmanagerv1.SecretGroupResource{secretGroup},
+	}
+	createSecretGroup, response, err := client.CreateSecretGroupWithContext(context, createSecretGroupOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating the secret group: %s\n%s", err, response))
+	}
+	if len(createSecretGroup.Resources) == 0 || createSecretGroup.Resources[0].ID == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating the secret group: no secret group ID returned"))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("instance_id").(string), *createSecretGroup.Resources[0].ID))
+
+	return resourceIBMSecretsManagerSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil || len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instance_id/secret_group_id", d.Id()))
+	}
+	instanceID, secretGroupID := parts[0], parts[1]
+	client, err := getSecretsManagerClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecretGroupOptions := &secretsmanagerv1.GetSecretGroupOptions{
+		ID: core.StringPtr(secretGroupID),
+	}
+	getSecretGroup, response, err := client.GetSecretGroupWithContext(context, getSecretGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the secret group %s: %s\n%s", secretGroupID, err, response))
+	}
+	if len(getSecretGroup.Resources) == 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the secret group %s: no secret group returned", secretGroupID))
+	}
+	secretGroup := getSecretGroup.Resources[0]
+
+	d.Set("instance_id", instanceID)
+	d.Set("secret_group_id", secretGroupID)
+	if err = d.Set("name", secretGroup.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("description", secretGroup.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+	if secretGroup.CreationDate != nil {
+		d.Set("creation_date", secretGroup.CreationDate.String())
+	}
+	if secretGroup.LastUpdateDate != nil {
+		d.Set("last_update_date", secretGroup.LastUpdateDate.String())
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerSecretGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("name", "description") {
+		client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		secretGroupID := d.Get("secret_group_id").(string)
+
+		updateSecretGroupMetadataOptions := &secretsmanagerv1.UpdateSecretGroupMetadataOptions{
+			ID:       core.StringPtr(secretGroupID),
+			Metadata: secretsManagerCollectionMetadata(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretGroupJSONConst),
+			Resources: []secretsmanagerv1.SecretGroupMetadataUpdatable{{
+				Name:        core.StringPtr(d.Get("name").(string)),
+				Description: core.StringPtr(d.Get("description").(string)),
+			}},
+		}
+		_, response, err := client.UpdateSecretGroupMetadataWithContext(context, updateSecretGroupMetadataOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the secret group %s: %s\n%s", secretGroupID, err, response))
+		}
+	}
+
+	return resourceIBMSecretsManagerSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretGroupID := d.Get("secret_group_id").(string)
+
+	deleteSecretGroupOptions := &secretsmanagerv1.DeleteSecretGroupOptions{
+		ID: core.StringPtr(secretGroupID),
+	}
+	response, err := client.DeleteSecretGroupWithContext(context, deleteSecretGroupOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the secret group %s: %s\n%s", secretGroupID, err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerSecretGroupBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-secret-group-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-secret-group-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerSecretGroupConfigBasic(name, "The secrets of the tests."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_group.secret_group", "name", name),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_group.secret_group", "description", "The secrets of the tests."),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_secret_group.secret_group", "secret_group_id"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_secret_group.secret_group", "creation_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerSecretGroupConfigBasic(nameUpdate, "The updated secrets of the tests."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_group.secret_group", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_group.secret_group", "description", "The updated secrets of the tests."),
+				),
+			},
+			{
+				ResourceName:      "ibm_secrets_manager_secret_group.secret_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerSecretGroupConfigBasic(name, description string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_secret_group" "secret_group" {
+			instance_id = "%s"
+			name = "%s"
+			description = "%s"
+		}
+	`, acc.SecretsManagerInstanceID, name, description)
+}
@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSecretsManagerUsernamePasswordSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerUsernamePasswordSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerUsernamePasswordSecretRead,
+		UpdateContext: resourceIBMSecretsManagerUsernamePasswordSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretDelete,
+		Importer:      secretsManagerSecretImporter(secretTypeUsernamePassword),
+		CustomizeDiff: customdiff.Sequence(
+			secretsManagerForceNewIfRemoved("expiration_date"),
+			secretsManagerForceNewIfRemoved("rotation"),
+		),
+
+		Schema: secretsManagerSecretSchema("ibm_secrets_manager_username_password_secret", map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the secret.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password of the secret, generated by Secrets Manager if omitted. Changing it rotates the secret to a new version.",
+			},
+			"expiration_date": expirationDateSchema(),
+			"rotation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The policy rotating the secret automatically, to a new version with a generated password. Removing it replaces the secret, the API not deleting the policies.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The length of the rotation time interval.",
+						},
+						"unit": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_secrets_manager_username_password_secret", "unit"),
+							Description:  "The unit of the rotation time interval, `day` or `month`.",
+						},
+					},
+				},
+			},
+			"next_rotation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation. The date format follows RFC 3339.",
+			},
+		}),
+	}
+}
+
+func ResourceIBMSecretsManagerUsernamePasswordSecretValidator() *validate.ResourceValidator {
+	return secretsManagerSecretValidator("ibm_secrets_manager_username_password_secret",
+		validate.ValidateSchema{
+			Identifier:                 "unit",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "day, month"})
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	expirationDate, err := secretsManagerExpirationDate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secret := &secretsmanagerv1.SecretResource{
+		Name:           core.StringPtr(d.Get("name").(string)),
+		Description:    core.StringPtr(d.Get("description").(string)),
+		Labels:         flex.ExpandStringList(d.Get("labels").([]interface{})),
+		Username:       core.StringPtr(d.Get("username").(string)),
+		ExpirationDate: expirationDate,
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secret.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+	if password, ok := d.GetOk("password"); ok {
+		secret.Password = core.StringPtr(password.(string))
+	}
+	if err := resourceIBMSecretsManagerSecretCreate(context, d, meta, secretTypeUsernamePassword, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, ok := d.GetOk("rotation"); ok {
+		client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := resourceIBMSecretsManagerUsernamePasswordSecretPutPolicy(context, d, client); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerUsernamePasswordSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := resourceIBMSecretsManagerSecretGet(context, d, meta)
+	if err != nil || secret == nil {
+		return diag.FromErr(err)
+	}
+
+	secretData := secretsManagerSecretData(secret)
+	if username, ok := secretData["username"].(string); ok {
+		d.Set("username", username)
+	} else if secret.Username != nil {
+		d.Set("username", secret.Username)
+	}
+	if password, ok := secretData["password"].(string); ok {
+		d.Set("password", password)
+	} else if secret.Password != nil {
+		d.Set("password", secret.Password)
+	}
+	if secret.ExpirationDate != nil {
+		d.Set("expiration_date", secret.ExpirationDate.String())
+	} else {
+		d.Set("expiration_date", nil)
+	}
+	if secret.NextRotationDate != nil {
+		d.Set("next_rotation_date", secret.NextRotationDate.String())
+	} else {
+		d.Set("next_rotation_date", nil)
+	}
+
+	client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretID := d.Get("secret_id").(string)
+	getPolicyOptions := &secretsmanagerv1.GetPolicyOptions{
+		SecretType: core.StringPtr(secretTypeUsernamePassword),
+		ID:         core.StringPtr(secretID),
+		Policy:     core.StringPtr(secretsmanagerv1.GetPolicyOptionsPolicyRotationConst),
+	}
+	getPolicy, response, err := client.GetPolicyWithContext(context, getPolicyOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the rotation policy of the secret %s: %s\n%s", secretID, err, response))
+	}
+	rotation := []map[string]interface{}{}
+	if policies, ok := getPolicy.(*secretsmanagerv1.GetSecretPoliciesOneOf); ok {
+		for _, policy := range policies.Resources {
+			if policy.Rotation != nil && policy.Rotation.Interval != nil && policy.Rotation.Unit != nil {
+				rotation = append(rotation, map[string]interface{}{
+					"interval": *policy.Rotation.Interval,
+					"unit":     *policy.Rotation.Unit,
+				})
+			}
+		}
+	}
+	if err = d.Set("rotation", rotation); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting rotation: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("password") {
+		action := &secretsmanagerv1.SecretActionOneOf{
+			Password: core.StringPtr(d.Get("password").(string)),
+		}
+		if err := resourceIBMSecretsManagerSecretRotate(context, d, client, action); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Removing the rotation block replaces the secret, the API doesn't
+	// delete the policies.
+	if _, ok := d.GetOk("rotation"); ok && d.HasChange("rotation") {
+		if err := resourceIBMSecretsManagerUsernamePasswordSecretPutPolicy(context, d, client); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	expirationDate, err := secretsManagerExpirationDate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	metadata := &secretsmanagerv1.SecretMetadata{
+		ExpirationDate: expirationDate,
+	}
+	if err := resourceIBMSecretsManagerSecretUpdateMetadata(context, d, client, metadata); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerUsernamePasswordSecretRead(context, d, meta)
+}
+
+// resourceIBMSecretsManagerUsernamePasswordSecretPutPolicy sets the rotation
+// policy of the secret to the rotation block.
+func resourceIBMSecretsManagerUsernamePasswordSecretPutPolicy(context context.Context, d *schema.ResourceData, client *secretsmanagerv1.SecretsManagerV1) error {
+	_, _, secretID, err := secretsManagerIDParts(d.Id())
+	if err != nil {
+		return err
+	}
+	rotation := d.Get("rotation.0").(map[string]interface{})
+
+	putPolicyOptions := &secretsmanagerv1.PutPolicyOptions{
+		SecretType: core.StringPtr(secretTypeUsernamePassword),
+		ID:         core.StringPtr(secretID),
+		Policy:     core.StringPtr(secretsmanagerv1.PutPolicyOptionsPolicyRotationConst),
+		Metadata:   secretsManagerCollectionMetadata(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretPolicyJSONConst),
+		Resources: []secretsmanagerv1.SecretPolicyRotation{{
+			Type: core.StringPtr(secretsmanagerv1.SecretPolicyRotationTypeApplicationVndIBMSecretsManagerSecretPolicyJSONConst),
+			Rotation: &secretsmanagerv1.SecretPolicyRotationRotation{
+				Interval: core.Int64Ptr(int64(rotation["interval"].(int))),
+				Unit:     core.StringPtr(rotation["unit"].(string)),
+			},
+		}},
+	}
+	_, response, err := client.PutPolicyWithContext(context, putPolicyOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error setting the rotation policy of the secret %s: %s\n%s", secretID, err, response)
+	}
+	return nil
+}
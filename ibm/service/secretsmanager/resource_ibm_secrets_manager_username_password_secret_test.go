@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerUsernamePasswordSecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-username-password-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerUsernamePasswordSecretConfigBasic(name, "first-Passw0rd", 30, "day"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "name", name),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "username", "admin"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "password", "first-Passw0rd"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "rotation.0.interval", "30"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "rotation.0.unit", "day"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_username_password_secret.secret", "next_rotation_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerUsernamePasswordSecretConfigBasic(name, "second-Passw0rd", 2, "month"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "password", "second-Passw0rd"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "versions_total", "2"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "rotation.0.interval", "2"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "rotation.0.unit", "month"),
+				),
+			},
+			{
+				ResourceName:      "ibm_secrets_manager_username_password_secret.secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerUsernamePasswordSecretConfigBasic(name, password string, interval int, unit string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_username_password_secret" "secret" {
+			instance_id = "%s"
+			name = "%s"
+			username = "admin"
+			password = "%s"
+			rotation {
+				interval = %d
+				unit = "%s"
+			}
+		}
+	`, acc.SecretsManagerInstanceID, name, password, interval, unit)
+}
@@ -0,0 +1,580 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	secretTypeArbitrary        = "arbitrary"
+	secretTypeKv               = "kv"
+	secretTypeUsernamePassword = "username_password"
+	secretTypeIAMCredentials   = "iam_credentials"
+	secretTypeImportedCert     = "imported_cert"
+)
+
+// getSecretsManagerClient returns a client of the Secrets Manager instance
+// instanceID, reached with its public or private endpoint.
+func getSecretsManagerClient(meta interface{}, instanceID, endpointType string) (*secretsmanagerv1.SecretsManagerV1, error) {
+	bluemixSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	region := bluemixSession.Config.Region
+
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV1()
+	if err != nil {
+		return nil, err
+	}
+	rContollerClient, err := meta.(conns.ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return nil, err
+	}
+
+	instanceData, err := rContollerClient.ResourceServiceInstanceV2().GetInstance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	crnData := strings.Split(instanceData.Crn.String(), ":")
+	if len(crnData) < 5 || crnData[4] != "secrets-manager" {
+		return nil, fmt.Errorf("[ERROR] Invalid or unsupported service Instance")
+	}
+
+	var smEndpointURL string
+	if endpointType == "private" {
+		smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
+	} else {
+		smEndpointURL = "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
+	}
+	// The client of the session is shared by the instances.
+	client := secretsManagerClient.Clone()
+	client.Service.Options.URL = meta.(conns.ClientSession).ServiceEndpoint("IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT", smEndpointURL)
+	return client, nil
+}
+
+// secretsManagerIDParts returns the instance ID, the secret type and the
+// secret ID of the ID of a secret, instance_id/secret_type/secret_id.
+func secretsManagerIDParts(id string) (string, string, string, error) {
+	parts, err := flex.IdParts(id)
+	if err != nil || len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instance_id/secret_type/secret_id", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// secretsManagerRequest sends a request of the Secrets Manager API whose body
+// or response the SDK models don't support, e.g. the ones of the kv and
+// imported_cert secrets.
+func secretsManagerRequest(context context.Context, client *secretsmanagerv1.SecretsManagerV1, method, path string, pathParams, query map[string]string, body interface{}) (map[string]interface{}, *core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	if _, err := builder.ResolveRequestURL(client.Service.Options.URL, path, pathParams); err != nil {
+		return nil, nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	for name, value := range query {
+		builder.AddQuery(name, value)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err := builder.SetBodyContentJSON(body); err != nil {
+			return nil, nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+	var result map[string]interface{}
+	response, err := client.Service.Request(request, &result)
+	return result, response, err
+}
+
+// secretsManagerSecretSchema returns the schema of the resources of a secret
+// type, with the arguments and attributes common to the secrets.
+func secretsManagerSecretSchema(resourceName string, s map[string]*schema.Schema) map[string]*schema.Schema {
+	common := map[string]*schema.Schema{
+		"instance_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The GUID of the Secrets Manager instance.",
+		},
+		"endpoint_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "public",
+			ValidateFunc: validate.InvokeValidator(resourceName, "endpoint_type"),
+			Description:  "The endpoint type of the Secrets Manager instance, `public` or `private`.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "A human-readable alias of the secret.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "An extended description of the secret.",
+		},
+		"secret_group_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The ID of the secret group of the secret, the `default` secret group if omitted.",
+		},
+		"labels": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Labels that you can use to filter for secrets in your instance.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"secret_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The v4 UUID that uniquely identifies the secret.",
+		},
+		"crn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Cloud Resource Name (CRN) of the secret.",
+		},
+		"state": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The secret state based on NIST SP 800-57: Pre-activation = 0, Active = 1, Suspended = 2, Deactivated = 3, and Destroyed = 5.",
+		},
+		"state_description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "A text representation of the secret state.",
+		},
+		"creation_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the secret was created. The date format follows RFC 3339.",
+		},
+		"created_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier for the entity that created the secret.",
+		},
+		"last_update_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Updates when the actual secret is modified. The date format follows RFC 3339.",
+		},
+		"versions_total": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of versions of the secret.",
+		},
+		"versions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The metadata of the versions of the secret, a new one being created each time the secret is rotated.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the secret version.",
+					},
+					"creation_date": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The date that the version of the secret was created.",
+					},
+					"created_by": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The unique identifier for the entity that created the secret version.",
+					},
+					"auto_rotated": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Indicates whether the version of the secret was created by automatic rotation.",
+					},
+				},
+			},
+		},
+	}
+	for k, v := range s {
+		common[k] = v
+	}
+	return common
+}
+
+// secretsManagerSecretValidator returns the validator of the resources of a
+// secret type, validating the endpoint type and the arguments of validateSchema.
+func secretsManagerSecretValidator(resourceName string, validateSchema ...validate.ValidateSchema) *validate.ResourceValidator {
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private"})
+
+	resourceValidator := validate.ResourceValidator{ResourceName: resourceName, Schema: validateSchema}
+	return &resourceValidator
+}
+
+// secretsManagerSecretImporter imports the secrets of secretType by their ID,
+// instance_id/secret_type/secret_id, optionally followed by /endpoint_type
+// for the instances only reachable by their private endpoint.
+func secretsManagerSecretImporter(secretType string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			endpointType, err := secretsManagerImportEndpointType(d, 3)
+			if err != nil {
+				return nil, err
+			}
+			instanceID, idSecretType, _, err := secretsManagerIDParts(d.Id())
+			if err != nil {
+				return nil, err
+			}
+			if idSecretType != secretType {
+				return nil, fmt.Errorf("[ERROR] Incorrect ID %s: the secret type should be %s", d.Id(), secretType)
+			}
+			d.Set("instance_id", instanceID)
+			d.Set("endpoint_type", endpointType)
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// secretsManagerImportEndpointType returns the endpoint type ending the import
+// ID of a resource whose ID has n parts, public if omitted, and strips it from
+// the ID.
+func secretsManagerImportEndpointType(d *schema.ResourceData, n int) (string, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != n+1 {
+		return "public", nil
+	}
+	endpointType := parts[n]
+	if endpointType != "public" && endpointType != "private" {
+		return "", fmt.Errorf("[ERROR] Incorrect ID %s: the endpoint type should be public or private", d.Id())
+	}
+	d.SetId(strings.Join(parts[:n], "/"))
+	return endpointType, nil
+}
+
+// secretsManagerForceNewIfRemoved replaces the secret when the argument key is
+// removed, the API ignoring the updates clearing it.
+func secretsManagerForceNewIfRemoved(key string) schema.CustomizeDiffFunc {
+	return customdiff.ForceNewIf(key, func(context context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+		if _, ok := d.GetOk(key); ok {
+			return false
+		}
+		switch old, _ := d.GetChange(key); old := old.(type) {
+		case string:
+			return old != ""
+		case []interface{}:
+			return len(old) > 0
+		}
+		return false
+	})
+}
+
+// secretsManagerCollectionMetadata returns the metadata of a request whose
+// resources are of collectionType.
+func secretsManagerCollectionMetadata(collectionType string) *secretsmanagerv1.CollectionMetadata {
+	return &secretsmanagerv1.CollectionMetadata{
+		CollectionType:  core.StringPtr(collectionType),
+		CollectionTotal: core.Int64Ptr(1),
+	}
+}
+
+// resourceIBMSecretsManagerSecretGet returns the secret of the resource, or
+// nil if it is deleted, in which case the resource is removed from the state.
+func resourceIBMSecretsManagerSecretGet(context context.Context, d *schema.ResourceData, meta interface{}) (*secretsmanagerv1.SecretResource, error) {
+	instanceID, secretType, secretID, err := secretsManagerIDParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	client, err := getSecretsManagerClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	getSecretOptions := &secretsmanagerv1.GetSecretOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(secretID),
+	}
+	getSecret, response, err := client.GetSecretWithContext(context, getSecretOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("[ERROR] Error getting the %s secret %s: %s\n%s", secretType, secretID, err, response)
+	}
+	if len(getSecret.Resources) == 0 {
+		return nil, fmt.Errorf("[ERROR] Error getting the %s secret %s: no secret returned", secretType, secretID)
+	}
+	secret, ok := getSecret.Resources[0].(*secretsmanagerv1.SecretResource)
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] Error getting the %s secret %s: unexpected resource %T", secretType, secretID, getSecret.Resources[0])
+	}
+	// The destroyed secrets are returned until they are purged.
+	if secret.State != nil && *secret.State == 5 {
+		d.SetId("")
+		return nil, nil
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("secret_id", secretID)
+	if err = d.Set("name", secret.Name); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error setting name: %s", err)
+	}
+	if err = d.Set("description", secret.Description); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error setting description: %s", err)
+	}
+	if err = d.Set("secret_group_id", secret.SecretGroupID); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error setting secret_group_id: %s", err)
+	}
+	if err = d.Set("labels", secret.Labels); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error setting labels: %s", err)
+	}
+	d.Set("crn", secret.CRN)
+	d.Set("state", secret.State)
+	d.Set("state_description", secret.StateDescription)
+	d.Set("created_by", secret.CreatedBy)
+	if secret.CreationDate != nil {
+		d.Set("creation_date", secret.CreationDate.String())
+	}
+	if secret.LastUpdateDate != nil {
+		d.Set("last_update_date", secret.LastUpdateDate.String())
+	}
+	versions := []map[string]interface{}{}
+	for _, version := range secret.Versions {
+		versions = append(versions, dataSourceGetSecretResourcesVersionsToMap(version))
+	}
+	d.Set("versions_total", len(versions))
+	if err = d.Set("versions", versions); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error setting versions: %s", err)
+	}
+	return secret, nil
+}
+
+// resourceIBMSecretsManagerSecretUpdateMetadata updates the metadata of the
+// secret of the resource, if changed.
+func resourceIBMSecretsManagerSecretUpdateMetadata(context context.Context, d *schema.ResourceData, client *secretsmanagerv1.SecretsManagerV1, secret *secretsmanagerv1.SecretMetadata) error {
+	if !d.HasChanges("name", "description", "labels", "expiration_date", "ttl") {
+		return nil
+	}
+	_, secretType, secretID, err := secretsManagerIDParts(d.Id())
+	if err != nil {
+		return err
+	}
+	secret.Name = core.StringPtr(d.Get("name").(string))
+	secret.Description = core.StringPtr(d.Get("description").(string))
+	secret.Labels = flex.ExpandStringList(d.Get("labels").([]interface{}))
+
+	updateSecretMetadataOptions := &secretsmanagerv1.UpdateSecretMetadataOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(secretID),
+		Metadata:   secretsManagerCollectionMetadata(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretJSONConst),
+		Resources:  []secretsmanagerv1.SecretMetadata{*secret},
+	}
+	_, response, err := client.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating the metadata of the %s secret %s: %s\n%s", secretType, secretID, err, response)
+	}
+	return nil
+}
+
+// resourceIBMSecretsManagerSecretClient returns the client of the instance of
+// the secret of the resource.
+func resourceIBMSecretsManagerSecretClient(d *schema.ResourceData, meta interface{}) (*secretsmanagerv1.SecretsManagerV1, error) {
+	return getSecretsManagerClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+}
+
+// resourceIBMSecretsManagerSecretCreate creates a secret of secretType whose
+// resource is secret, a *secretsmanagerv1.SecretResource or, for the types
+// the SDK doesn't model, a map.
+func resourceIBMSecretsManagerSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}, secretType string, secret interface{}) error {
+	client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+	if err != nil {
+		return err
+	}
+	metadata := secretsManagerCollectionMetadata(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretJSONConst)
+
+	var secretID string
+	switch secret := secret.(type) {
+	case *secretsmanagerv1.SecretResource:
+		createSecretOptions := &secretsmanagerv1.CreateSecretOptions{
+			SecretType: core.StringPtr(secretType),
+			Metadata:   metadata,
+			Resources:  []secretsmanagerv1.SecretResourceIntf{secret},
+		}
+		createSecret, response, err := client.CreateSecretWithContext(context, createSecretOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating the %s secret: %s\n%s", secretType, err, response)
+		}
+		if len(createSecret.Resources) == 0 {
+			return fmt.Errorf("[ERROR] Error creating the %s secret: no secret returned", secretType)
+		}
+		created, ok := createSecret.Resources[0].(*secretsmanagerv1.SecretResource)
+		if !ok || created.ID == nil {
+			return fmt.Errorf("[ERROR] Error creating the %s secret: no secret ID returned", secretType)
+		}
+		secretID = *created.ID
+	case map[string]interface{}:
+		body := map[string]interface{}{
+			"metadata":  metadata,
+			"resources": []interface{}{secret},
+		}
+		result, response, err := secretsManagerRequest(context, client, core.POST, "/api/v1/secrets/{secret_type}", map[string]string{"secret_type": secretType}, nil, body)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating the %s secret: %s\n%s", secretType, err, response)
+		}
+		secretID = secretsManagerResultID(result)
+		if secretID == "" {
+			return fmt.Errorf("[ERROR] Error creating the %s secret: no secret ID returned", secretType)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", d.Get("instance_id").(string), secretType, secretID))
+	return nil
+}
+
+// resourceIBMSecretsManagerSecretRotate rotates the secret of the resource to
+// a new version holding the secret data of action, a
+// *secretsmanagerv1.SecretActionOneOf or, for the types the SDK doesn't
+// model, a map.
+func resourceIBMSecretsManagerSecretRotate(context context.Context, d *schema.ResourceData, client *secretsmanagerv1.SecretsManagerV1, action interface{}) error {
+	_, secretType, secretID, err := secretsManagerIDParts(d.Id())
+	if err != nil {
+		return err
+	}
+	var response *core.DetailedResponse
+	switch action := action.(type) {
+	case *secretsmanagerv1.SecretActionOneOf:
+		updateSecretOptions := &secretsmanagerv1.UpdateSecretOptions{
+			SecretType:        core.StringPtr(secretType),
+			ID:                core.StringPtr(secretID),
+			Action:            core.StringPtr(secretsmanagerv1.UpdateSecretOptionsActionRotateConst),
+			SecretActionOneOf: action,
+		}
+		_, response, err = client.UpdateSecretWithContext(context, updateSecretOptions)
+	case map[string]interface{}:
+		pathParams := map[string]string{"secret_type": secretType, "id": secretID}
+		query := map[string]string{"action": secretsmanagerv1.UpdateSecretOptionsActionRotateConst}
+		_, response, err = secretsManagerRequest(context, client, core.POST, "/api/v1/secrets/{secret_type}/{id}", pathParams, query, action)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error rotating the %s secret %s: %s\n%s", secretType, secretID, err, response)
+	}
+	return nil
+}
+
+// resourceIBMSecretsManagerSecretDelete deletes the secret of the resource.
+func resourceIBMSecretsManagerSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, secretType, secretID, err := secretsManagerIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := resourceIBMSecretsManagerSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteSecretOptions := &secretsmanagerv1.DeleteSecretOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(secretID),
+	}
+	response, err := client.DeleteSecretWithContext(context, deleteSecretOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the %s secret %s: %s\n%s", secretType, secretID, err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// secretsManagerResultID returns the ID of the first resource of the result
+// of a request.
+func secretsManagerResultID(result map[string]interface{}) string {
+	resources, _ := result["resources"].([]interface{})
+	if len(resources) == 0 {
+		return ""
+	}
+	resource, _ := resources[0].(map[string]interface{})
+	id, _ := resource["id"].(string)
+	return id
+}
+
+// secretsManagerSecretData returns the secret data of a secret.
+func secretsManagerSecretData(secret *secretsmanagerv1.SecretResource) map[string]interface{} {
+	secretData, _ := secret.SecretData.(map[string]interface{})
+	return secretData
+}
+
+// secretsManagerExpirationDate returns the expiration_date argument of a
+// secret, if set.
+func secretsManagerExpirationDate(d *schema.ResourceData) (*strfmt.DateTime, error) {
+	v, ok := d.GetOk("expiration_date")
+	if !ok {
+		return nil, nil
+	}
+	expirationDate, err := core.ParseDateTime(v.(string))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing expiration_date: %s", err)
+	}
+	return &expirationDate, nil
+}
+
+// expirationDateSchema returns the schema of the expiration date of a secret.
+func expirationDateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.IsRFC3339Time,
+		DiffSuppressFunc: suppressEquivalentDateTime,
+		Description:      "The date the secret material expires, in the RFC 3339 format, e.g. `2030-01-01T00:00:00Z`. The secret doesn't expire if omitted. Removing it replaces the secret.",
+	}
+}
+
+// suppressEquivalentDateTime suppresses the diff of the RFC 3339 date times
+// of the same instant, e.g. 2030-01-01T00:00:00Z and 2030-01-01T00:00:00.000Z.
+func suppressEquivalentDateTime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// jsonSecretData returns the JSON of the secret data of a kv secret.
+func jsonSecretData(payload interface{}) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestSecretsManagerSecretImporter(t *testing.T) {
+	cases := []struct {
+		id           string
+		endpointType string
+		fail         bool
+	}{
+		{id: "instance-1/arbitrary/secret-1", endpointType: "public"},
+		{id: "instance-1/arbitrary/secret-1/private", endpointType: "private"},
+		{id: "instance-1/arbitrary/secret-1/public", endpointType: "public"},
+		{id: "instance-1/arbitrary/secret-1/direct", fail: true},
+		{id: "instance-1/kv/secret-1/private", fail: true},
+	}
+	r := ResourceIBMSecretsManagerArbitrarySecret()
+	for _, c := range cases {
+		d := r.Data(nil)
+		d.SetId(c.id)
+		imported, err := r.Importer.StateContext(context.Background(), d, nil)
+		if c.fail {
+			if err == nil {
+				t.Errorf("%s: expected the import to fail", c.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", c.id, err)
+		}
+		d = imported[0]
+		if d.Id() != "instance-1/arbitrary/secret-1" || d.Get("instance_id") != "instance-1" || d.Get("endpoint_type") != c.endpointType {
+			t.Errorf("%s: unexpected ID %s, instance_id %v, endpoint_type %v", c.id, d.Id(), d.Get("instance_id"), d.Get("endpoint_type"))
+		}
+	}
+
+	r = ResourceIBMSecretsManagerSecretGroup()
+	d := r.Data(nil)
+	d.SetId("instance-1/group-1/private")
+	imported, err := r.Importer.StateContext(context.Background(), d, nil)
+	if err != nil || imported[0].Id() != "instance-1/group-1" || imported[0].Get("endpoint_type") != "private" {
+		t.Fatalf("expected the secret group to be imported by its private endpoint, got %v", err)
+	}
+}
+
+func TestSecretsManagerSecretRemovedArguments(t *testing.T) {
+	r := ResourceIBMSecretsManagerUsernamePasswordSecret()
+	state := &terraform.InstanceState{
+		ID: "instance-1/username_password/secret-1",
+		Attributes: map[string]string{
+			"id":                  "instance-1/username_password/secret-1",
+			"instance_id":         "instance-1",
+			"endpoint_type":       "public",
+			"name":                "secret-1",
+			"username":            "admin",
+			"password":            "secret",
+			"expiration_date":     "2030-01-01T00:00:00Z",
+			"rotation.#":          "1",
+			"rotation.0.interval": "1",
+			"rotation.0.unit":     "month",
+		},
+	}
+	config := map[string]interface{}{
+		"instance_id":     "instance-1",
+		"name":            "secret-1",
+		"username":        "admin",
+		"password":        "secret",
+		"expiration_date": "2030-01-01T00:00:00.000Z",
+		"rotation":        []interface{}{map[string]interface{}{"interval": 1, "unit": "month"}},
+	}
+	diff := func(config map[string]interface{}) *terraform.InstanceDiff {
+		state.RawConfig = cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("secret-1")})
+		d, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	if d := diff(config); d != nil && d.RequiresNew() {
+		t.Fatalf("expected the secret to be kept, got %#v", d)
+	}
+	for _, key := range []string{"expiration_date", "rotation"} {
+		removed := map[string]interface{}{}
+		for k, v := range config {
+			if k != key {
+				removed[k] = v
+			}
+		}
+		if d := diff(removed); d == nil || !d.RequiresNew() {
+			t.Errorf("expected removing %s to replace the secret, got %#v", key, d)
+		}
+	}
+}
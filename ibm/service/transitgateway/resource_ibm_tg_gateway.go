@@ -14,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -40,12 +41,12 @@ const (
 
 func ResourceIBMTransitGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayCreate,
-		Read:     resourceIBMTransitGatewayRead,
-		Delete:   resourceIBMTransitGatewayDelete,
-		Exists:   resourceIBMTransitGatewayExists,
-		Update:   resourceIBMTransitGatewayUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayCreate,
+		ReadContext:   resourceIBMTransitGatewayRead,
+		DeleteContext: resourceIBMTransitGatewayDelete,
+		Exists:        resourceIBMTransitGatewayExists,
+		UpdateContext: resourceIBMTransitGatewayUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -55,7 +56,7 @@ func ResourceIBMTransitGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -184,10 +185,10 @@ func transitgatewayClient(meta interface{}) (*transitgatewayapisv1.TransitGatewa
 	return sess, err
 }
 
-func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	location := d.Get(tgLocation).(string)
@@ -206,18 +207,18 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	//log.Println("going to create tgw now with options", *createTransitGatewayOptions.ResourceGroup)
-	tgw, response, err := client.CreateTransitGateway(createTransitGatewayOptions)
+	tgw, response, err := client.CreateTransitGatewayWithContext(ctx, createTransitGatewayOptions)
 
 	if err != nil {
 		log.Printf("[DEBUG] Create Transit Gateway err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(*tgw.ID)
 
-	_, err = isWaitForTransitGatewayAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -229,30 +230,30 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 				"Error on create of transit gateway (%s) tags: %s", d.Id(), err)
 		}
 	}
-	return resourceIBMTransitGatewayRead(d, meta)
+	return resourceIBMTransitGatewayRead(ctx, d, meta)
 }
 
-func isWaitForTransitGatewayAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayAvailable(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isTransitGatewayProvisioning},
 		Target:     []string{isTransitGatewayProvisioningDone, ""},
-		Refresh:    isTransitGatewayRefreshFunc(client, id),
+		Refresh:    isTransitGatewayRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isTransitGatewayRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
+func isTransitGatewayRefreshFunc(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		gettgwoptions := &transitgatewayapisv1.GetTransitGatewayOptions{
 			ID: &id,
 		}
-		transitGateway, response, err := client.GetTransitGateway(gettgwoptions)
+		transitGateway, response, err := client.GetTransitGatewayWithContext(ctx, gettgwoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Transit Gateway: %s\n%s", err, response)
 		}
@@ -265,24 +266,24 @@ func isTransitGatewayRefreshFunc(client *transitgatewayapisv1.TransitGatewayApis
 	}
 }
 
-func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	tgOptions := &transitgatewayapisv1.GetTransitGatewayOptions{}
 	if id != "" {
 		tgOptions.ID = &id
 	}
 
-	tgw, response, err := client.GetTransitGateway(tgOptions)
+	tgw, response, err := client.GetTransitGatewayWithContext(ctx, tgOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*tgw.ID)
@@ -306,7 +307,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(flex.ResourceControllerURL, controller+"/interconnectivity/transit")
@@ -321,22 +322,22 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
 	tgOptions := &transitgatewayapisv1.GetTransitGatewayOptions{
 		ID: &ID,
 	}
-	tgw, resp, err := client.GetTransitGateway(tgOptions)
+	tgw, resp, err := client.GetTransitGatewayWithContext(ctx, tgOptions)
 
 	if err != nil {
 		log.Printf("Error fetching Transit Gateway: %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateTransitGatewayOptions := &transitgatewayapisv1.UpdateTransitGatewayOptions{}
@@ -362,64 +363,64 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	_, response, err := client.UpdateTransitGateway(updateTransitGatewayOptions)
+	_, response, err := client.UpdateTransitGatewayWithContext(ctx, updateTransitGatewayOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update Transit Gateway err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMTransitGatewayRead(d, meta)
+	return resourceIBMTransitGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
 	delOptions := &transitgatewayapisv1.DeleteTransitGatewayOptions{
 		ID: &ID,
 	}
-	response, err := client.DeleteTransitGateway(delOptions)
+	response, err := client.DeleteTransitGatewayWithContext(ctx, delOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Transit Gateway (%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Transit Gateway (%s): %s\n%s", ID, err, response))
 	}
-	_, err = isWaitForTransitGatewayDeleted(client, ID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForTransitGatewayDeleted(ctx, client, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func isWaitForTransitGatewayDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayDeleted(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isTransitGatewayDeleting},
 		Target:     []string{"", isTransitGatewayDeleted},
-		Refresh:    isTransitGatewayDeleteRefreshFunc(client, id),
+		Refresh:    isTransitGatewayDeleteRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isTransitGatewayDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
+func isTransitGatewayDeleteRefreshFunc(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] tg gateway delete function here")
 		gettgwoptions := &transitgatewayapisv1.GetTransitGatewayOptions{
 			ID: &id,
 		}
-		transitGateway, response, err := client.GetTransitGateway(gettgwoptions)
+		transitGateway, response, err := client.GetTransitGatewayWithContext(ctx, gettgwoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return transitGateway, isTransitGatewayDeleted, nil
@@ -4,6 +4,7 @@
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -43,12 +45,12 @@ const (
 
 func ResourceIBMTransitGatewayConnection() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayConnectionCreate,
-		Read:     resourceIBMTransitGatewayConnectionRead,
-		Delete:   resourceIBMTransitGatewayConnectionDelete,
-		Exists:   resourceIBMTransitGatewayConnectionExists,
-		Update:   resourceIBMTransitGatewayConnectionUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayConnectionCreate,
+		ReadContext:   resourceIBMTransitGatewayConnectionRead,
+		DeleteContext: resourceIBMTransitGatewayConnectionDelete,
+		Exists:        resourceIBMTransitGatewayConnectionExists,
+		UpdateContext: resourceIBMTransitGatewayConnectionUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -198,10 +200,10 @@ func ResourceIBMTransitGatewayConnectionValidator() *validate.ResourceValidator
 
 	return &ibmTransitGatewayConnectionResourceValidator
 }
-func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createTransitGatewayConnectionOptions := &transitgatewayapisv1.CreateTransitGatewayConnectionOptions{}
@@ -255,9 +257,9 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 		createTransitGatewayConnectionOptions.SetZone(zoneIdentity)
 	}
 
-	tgConnections, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
+	tgConnections, response, err := client.CreateTransitGatewayConnectionWithContext(ctx, createTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Create Transit Gateway connection err %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Create Transit Gateway connection err %s\n%s", err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *tgConnections.ID))
@@ -265,30 +267,30 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 
 	if tgConnections.NetworkAccountID != nil {
 		d.Set(tgNetworkAccountID, *tgConnections.NetworkAccountID)
-		return resourceIBMTransitGatewayConnectionRead(d, meta)
+		return resourceIBMTransitGatewayConnectionRead(ctx, d, meta)
 	}
-	_, err = isWaitForTransitGatewayConnectionAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayConnectionAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMTransitGatewayConnectionRead(d, meta)
+	return resourceIBMTransitGatewayConnectionRead(ctx, d, meta)
 }
-func isWaitForTransitGatewayConnectionAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionAvailable(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway connection (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isTransitGatewayConnectionPending},
 		Target:     []string{isTransitGatewayConnectionAttached, ""},
-		Refresh:    isTransitGatewayConnectionRefreshFunc(client, id),
+		Refresh:    isTransitGatewayConnectionRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
-func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
+func isTransitGatewayConnectionRefreshFunc(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		parts, err := flex.IdParts(id)
@@ -302,7 +304,7 @@ func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitG
 		getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
 		getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
 		getTransitGatewayConnectionOptions.SetID(ID)
-		tgConnection, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+		tgConnection, response, err := client.GetTransitGatewayConnectionWithContext(ctx, getTransitGatewayConnectionOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
 		}
@@ -313,15 +315,15 @@ func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitG
 		return tgConnection, isTransitGatewayConnectionPending, nil
 	}
 }
-func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -330,13 +332,13 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
 	getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
 	getTransitGatewayConnectionOptions.SetID(ID)
-	instance, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+	instance, response, err := client.GetTransitGatewayConnectionWithContext(ctx, getTransitGatewayConnectionOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response))
 	}
 
 	if instance.Name != nil {
@@ -368,24 +370,24 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	getTransitGatewayOptions := &transitgatewayapisv1.GetTransitGatewayOptions{
 		ID: &gatewayId,
 	}
-	tgw, response, err := client.GetTransitGateway(getTransitGatewayOptions)
+	tgw, response, err := client.GetTransitGatewayWithContext(ctx, getTransitGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Transit Gateway : %s\n%s", err, response))
 	}
 	d.Set(flex.RelatedCRN, *tgw.Crn)
 
 	return nil
 }
 
-func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -396,9 +398,9 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 	}
 	getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
 
-	_, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+	_, response, err := client.GetTransitGatewayConnectionWithContext(ctx, getTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection: %s\n%s", err, response))
 	}
 
 	updateTransitGatewayConnectionOptions := &transitgatewayapisv1.UpdateTransitGatewayConnectionOptions{}
@@ -411,23 +413,23 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
+	_, response, err = client.UpdateTransitGatewayConnectionWithContext(ctx, updateTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection : %s\n%s", err, response))
 	}
 
-	return resourceIBMTransitGatewayConnectionRead(d, meta)
+	return resourceIBMTransitGatewayConnectionRead(ctx, d, meta)
 }
 
-func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -436,40 +438,40 @@ func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta inte
 		ID: &ID,
 	}
 	deleteTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
-	response, err := client.DeleteTransitGatewayConnection(deleteTransitGatewayConnectionOptions)
+	response, err := client.DeleteTransitGatewayConnectionWithContext(ctx, deleteTransitGatewayConnectionOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response))
 	}
-	_, err = isWaitForTransitGatewayConnectionDeleted(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayConnectionDeleted(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func isWaitForTransitGatewayConnectionDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionDeleted(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway Connection(%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isTransitGatewayConnectionDeleting, isTransitGatewayConnectionDetaching},
 		Target:     []string{"", isTransitGatewayConnectionDeleted},
-		Refresh:    isTransitGatewayConnectionDeleteRefreshFunc(client, id),
+		Refresh:    isTransitGatewayConnectionDeleteRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isTransitGatewayConnectionDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
+func isTransitGatewayConnectionDeleteRefreshFunc(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] tg gateway connection delete function here")
 		parts, err := flex.IdParts(id)
@@ -483,7 +485,7 @@ func isTransitGatewayConnectionDeleteRefreshFunc(client *transitgatewayapisv1.Tr
 		getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
 		getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
 		getTransitGatewayConnectionOptions.SetID(ID)
-		tgConnection, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+		tgConnection, response, err := client.GetTransitGatewayConnectionWithContext(ctx, getTransitGatewayConnectionOptions)
 
 		if err != nil {
 
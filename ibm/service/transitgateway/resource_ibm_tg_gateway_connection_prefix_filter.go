@@ -4,22 +4,24 @@
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMTransitGatewayConnectionPrefixFilter() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayConnectionPrefixFilterCreate,
-		Read:     resourceIBMTransitGatewayConnectionPrefixFilterRead,
-		Delete:   resourceIBMTransitGatewayConnectionPrefixFilterDelete,
-		Update:   resourceIBMTransitGatewayConnectionPrefixFilterUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayConnectionPrefixFilterCreate,
+		ReadContext:   resourceIBMTransitGatewayConnectionPrefixFilterRead,
+		DeleteContext: resourceIBMTransitGatewayConnectionPrefixFilterDelete,
+		UpdateContext: resourceIBMTransitGatewayConnectionPrefixFilterUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -100,10 +102,10 @@ func ResourceIBMTransitGatewayConnectionPrefixFilterValidator() *validate.Resour
 	return &ibmTransitGatewayConnectionPrefixFilterResourceValidator
 }
 
-func resourceIBMTransitGatewayConnectionPrefixFilterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionPrefixFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := d.Get(tgGatewayId).(string)
@@ -131,24 +133,24 @@ func resourceIBMTransitGatewayConnectionPrefixFilterCreate(d *schema.ResourceDat
 		createPrefixFilterOptions.SetLe(le)
 	}
 
-	prefixFilter, response, err := client.CreateTransitGatewayConnectionPrefixFilter(createPrefixFilterOptions)
+	prefixFilter, response, err := client.CreateTransitGatewayConnectionPrefixFilterWithContext(ctx, createPrefixFilterOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Create Transit Gateway connection prefix filter err %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Create Transit Gateway connection prefix filter err %s\n%s", err, response))
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", gatewayId, connectionId, *prefixFilter.ID))
 
-	return resourceIBMTransitGatewayConnectionPrefixFilterRead(d, meta)
+	return resourceIBMTransitGatewayConnectionPrefixFilterRead(ctx, d, meta)
 }
 
-func resourceIBMTransitGatewayConnectionPrefixFilterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionPrefixFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -159,13 +161,13 @@ func resourceIBMTransitGatewayConnectionPrefixFilterRead(d *schema.ResourceData,
 	getTransitGatewayConnectionPrefixFilterOptionsModel.SetTransitGatewayID(gatewayId)
 	getTransitGatewayConnectionPrefixFilterOptionsModel.SetID(connectionId)
 	getTransitGatewayConnectionPrefixFilterOptionsModel.SetFilterID(filterId)
-	prefixFilter, response, err := client.GetTransitGatewayConnectionPrefixFilter(getTransitGatewayConnectionPrefixFilterOptionsModel)
+	prefixFilter, response, err := client.GetTransitGatewayConnectionPrefixFilterWithContext(ctx, getTransitGatewayConnectionPrefixFilterOptionsModel)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error while retrieving transit gateway connection prefix filter (%s): %s\n%s", filterId, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while retrieving transit gateway connection prefix filter (%s): %s\n%s", filterId, err, response))
 	}
 
 	d.Set(tgPrefixFilterId, *prefixFilter.ID)
@@ -188,15 +190,15 @@ func resourceIBMTransitGatewayConnectionPrefixFilterRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceIBMTransitGatewayConnectionPrefixFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionPrefixFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	gatewayId := parts[0]
 	connectionId := parts[1]
@@ -238,23 +240,23 @@ func resourceIBMTransitGatewayConnectionPrefixFilterUpdate(d *schema.ResourceDat
 		}
 	}
 
-	_, response, err := client.UpdateTransitGatewayConnectionPrefixFilter(updatePrefixFilterOptions)
+	_, response, err := client.UpdateTransitGatewayConnectionPrefixFilterWithContext(ctx, updatePrefixFilterOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection Prefix Filter (%s): %s\n%s", filterId, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection Prefix Filter (%s): %s\n%s", filterId, err, response))
 	}
 
-	return resourceIBMTransitGatewayConnectionPrefixFilterRead(d, meta)
+	return resourceIBMTransitGatewayConnectionPrefixFilterRead(ctx, d, meta)
 }
 
-func resourceIBMTransitGatewayConnectionPrefixFilterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionPrefixFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	gatewayId := parts[0]
 	connectionId := parts[1]
@@ -265,12 +267,12 @@ func resourceIBMTransitGatewayConnectionPrefixFilterDelete(d *schema.ResourceDat
 	deletePrefixFilterOptions.SetID(connectionId)
 	deletePrefixFilterOptions.SetFilterID(filterId)
 
-	response, err := client.DeleteTransitGatewayConnectionPrefixFilter(deletePrefixFilterOptions)
+	response, err := client.DeleteTransitGatewayConnectionPrefixFilterWithContext(ctx, deletePrefixFilterOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection Prefix Filter(%s): %s\n%s", filterId, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection Prefix Filter(%s): %s\n%s", filterId, err, response))
 	}
 
 	d.SetId("")
@@ -4,12 +4,14 @@
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -23,10 +25,10 @@ const (
 
 func ResourceIBMTransitGatewayRouteReport() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayRouteReportCreate,
-		Read:     resourceIBMTransitGatewayRouteReportRead,
-		Delete:   resourceIBMTransitGatewayRouteReportDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayRouteReportCreate,
+		ReadContext:   resourceIBMTransitGatewayRouteReportRead,
+		DeleteContext: resourceIBMTransitGatewayRouteReportDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -146,10 +148,10 @@ func ResourceIBMTransitGatewayRouteReport() *schema.Resource {
 	}
 }
 
-func resourceIBMTransitGatewayRouteReportCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayRouteReportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createTransitGatewayRouteReportOptions := &transitgatewayapisv1.CreateTransitGatewayRouteReportOptions{}
@@ -157,38 +159,38 @@ func resourceIBMTransitGatewayRouteReportCreate(d *schema.ResourceData, meta int
 	gatewayId := d.Get(tgGatewayId).(string)
 	createTransitGatewayRouteReportOptions.SetTransitGatewayID(gatewayId)
 
-	tgRouteReport, response, err := client.CreateTransitGatewayRouteReport(createTransitGatewayRouteReportOptions)
+	tgRouteReport, response, err := client.CreateTransitGatewayRouteReportWithContext(ctx, createTransitGatewayRouteReportOptions)
 	if err != nil {
-		return fmt.Errorf("Create Transit Gateway Route Report err %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Create Transit Gateway Route Report err %s\n%s", err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *tgRouteReport.ID))
 	d.Set(tgRouteReportId, *tgRouteReport.ID)
 
-	_, err = isWaitForTransitGatewayRouteReportAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayRouteReportAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMTransitGatewayRouteReportRead(d, meta)
+	return resourceIBMTransitGatewayRouteReportRead(ctx, d, meta)
 }
 
-func isWaitForTransitGatewayRouteReportAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayRouteReportAvailable(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway route report (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isTransitGatewayRouteReportPending},
 		Target:     []string{isTransitGatewayRouteReportDone, ""},
-		Refresh:    isTransitGatewayRouteReportRefreshFunc(client, id),
+		Refresh:    isTransitGatewayRouteReportRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isTransitGatewayRouteReportRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
+func isTransitGatewayRouteReportRefreshFunc(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		parts, err := flex.IdParts(id)
 		if err != nil {
@@ -202,7 +204,7 @@ func isTransitGatewayRouteReportRefreshFunc(client *transitgatewayapisv1.Transit
 		getTransitGatewayRouteReportOptions.SetTransitGatewayID(gatewayId)
 		getTransitGatewayRouteReportOptions.SetID(ID)
 
-		routeReport, response, getRouteErr := client.GetTransitGatewayRouteReport(getTransitGatewayRouteReportOptions)
+		routeReport, response, getRouteErr := client.GetTransitGatewayRouteReportWithContext(ctx, getTransitGatewayRouteReportOptions)
 		if getRouteErr != nil {
 			return nil, "", fmt.Errorf("Error Getting Transit Gateway Route Report: %s\n%s", err, response)
 		}
@@ -215,15 +217,15 @@ func isTransitGatewayRouteReportRefreshFunc(client *transitgatewayapisv1.Transit
 	}
 }
 
-func resourceIBMTransitGatewayRouteReportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayRouteReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -232,13 +234,13 @@ func resourceIBMTransitGatewayRouteReportRead(d *schema.ResourceData, meta inter
 	getTransitGatewayRouteReportOptions := &transitgatewayapisv1.GetTransitGatewayRouteReportOptions{}
 	getTransitGatewayRouteReportOptions.SetTransitGatewayID(gatewayId)
 	getTransitGatewayRouteReportOptions.SetID(ID)
-	instance, response, err := client.GetTransitGatewayRouteReport(getTransitGatewayRouteReportOptions)
+	instance, response, err := client.GetTransitGatewayRouteReportWithContext(ctx, getTransitGatewayRouteReportOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Transit Gateway Route Report (%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("Error Getting Transit Gateway Route Report (%s): %s\n%s", ID, err, response))
 	}
 
 	d.Set(tgRouteReportId, *instance.ID)
@@ -335,15 +337,15 @@ func resourceIBMTransitGatewayRouteReportRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceIBMTransitGatewayRouteReportDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayRouteReportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -352,13 +354,13 @@ func resourceIBMTransitGatewayRouteReportDelete(d *schema.ResourceData, meta int
 		ID: &ID,
 	}
 	deleteTransitGatewayRouteReportOptions.SetTransitGatewayID(gatewayId)
-	response, err := client.DeleteTransitGatewayRouteReport(deleteTransitGatewayRouteReportOptions)
+	response, err := client.DeleteTransitGatewayRouteReportWithContext(ctx, deleteTransitGatewayRouteReportOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Transit Gateway Route Report(%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting Transit Gateway Route Report(%s): %s\n%s", ID, err, response))
 	}
 
 	d.SetId("")
@@ -17,10 +17,10 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -100,7 +100,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 		),
@@ -1029,7 +1029,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 	}
 	d.SetId(*bms.ID)
 	log.Printf("[INFO] Bare Metal Server : %s", *bms.ID)
-	_, err = isWaitForBareMetalServerAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForBareMetalServerAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1966,7 +1966,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return err
 		}
-		_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForBareMetalServerAvailable(context, sess, id, d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return err
 		}
@@ -1997,11 +1997,11 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			action = actionOk.(string)
 		}
 		if action == "start" {
-			isBareMetalServerStart(sess, d.Id(), d, 10)
+			isBareMetalServerStart(context, sess, d.Id(), d, 10)
 		} else if action == "stop" {
-			isBareMetalServerStop(sess, d.Id(), d, 10)
+			isBareMetalServerStop(context, sess, d.Id(), d, 10)
 		} else if action == "restart" {
-			isBareMetalServerRestart(sess, d.Id(), d, 10)
+			isBareMetalServerRestart(context, sess, d.Id(), d, 10)
 		}
 	}
 	return nil
@@ -2048,7 +2048,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 		if err != nil && response != nil && response.StatusCode != 204 {
 			return fmt.Errorf("[ERROR] Error stopping Bare Metal Server (%s): %s\n%s", id, err, response)
 		}
-		isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
+		isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutDelete), id, d)
 
 	}
 	options := &vpcv1.DeleteBareMetalServerOptions{
@@ -2058,7 +2058,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Bare Metal Server : %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForBareMetalServerDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -2066,22 +2066,24 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func isWaitForBareMetalServerDeleted(bmsC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForBareMetalServerDeleted(ctx context.Context, bmsC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isBareMetalServerActionDeleting},
-		Target:     []string{"done", "", isBareMetalServerActionDeleted, isBareMetalServerStatusFailed},
-		Refresh:    isBareMetalServerDeleteRefreshFunc(bmsC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("bare metal server %s to be deleted", id),
+		Pending:     []string{"retry", isBareMetalServerActionDeleting},
+		Target:      []string{"done", "", isBareMetalServerActionDeleted},
+		Failed:      []string{isBareMetalServerStatusFailed},
+		Refresh:     isBareMetalServerDeleteRefreshFunc(bmsC, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
@@ -2100,21 +2102,23 @@ func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) resource.S
 	}
 }
 
-func isWaitForBareMetalServerAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerAvailable(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be available.", id)
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
-		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
-		Refresh:    isBareMetalServerRefreshFunc(client, id, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-	return stateConf.WaitForState()
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("bare metal server %s to be running", id),
+		Pending:     []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
+		Target:      []string{isBareMetalServerStatusRunning},
+		Failed:      []string{isBareMetalServerStatusFailed},
+		Refresh:     isBareMetalServerRefreshFunc(client, id, d, communicator),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
+	}
+	return stateConf.Wait(ctx)
 }
 
-func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
+func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
@@ -2168,11 +2172,13 @@ func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.Reso
 	}
 }
 
-func isWaitForBareMetalServerActionStop(bmsC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerActionStop(ctx context.Context, bmsC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isBareMetalServerStatusRunning, isBareMetalServerStatusPending, isBareMetalServerActionStatusStopping},
-		Target:  []string{isBareMetalServerActionStatusStopped, isBareMetalServerStatusFailed, ""},
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("bare metal server %s to stop", id),
+		Pending:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusPending, isBareMetalServerActionStatusStopping},
+		Target:      []string{isBareMetalServerActionStatusStopped, ""},
+		Failed:      []string{isBareMetalServerStatusFailed},
 		Refresh: func() (interface{}, string, error) {
 			getbmsoptions := &vpcv1.GetBareMetalServerOptions{
 				ID: &id,
@@ -2194,12 +2200,12 @@ func isWaitForBareMetalServerActionStop(bmsC *vpcv1.VpcV1, timeout time.Duration
 			}
 			return bms, *bms.Status, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
 func isBareMetalServerRestartStopAction(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
@@ -2228,7 +2234,7 @@ func isBareMetalServerRestartStopAction(bmsC *vpcv1.VpcV1, id string, d *schema.
 	}
 }
 
-func isBareMetalServerStart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerStart(ctx context.Context, bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	createbmsactoptions := &vpcv1.StartBareMetalServerOptions{
 		ID: &id,
 	}
@@ -2239,13 +2245,13 @@ func isBareMetalServerStart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForBareMetalServerAvailable(ctx, bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
-func isBareMetalServerStop(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerStop(ctx context.Context, bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	stoppingType := "soft"
 	createbmsactoptions := &vpcv1.StopBareMetalServerOptions{
 		ID:   &id,
@@ -2258,13 +2264,13 @@ func isBareMetalServerStop(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData,
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server Action stop: %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerActionStop(bmsC, d.Timeout(schema.TimeoutUpdate), d.Id(), d)
+	_, err = isWaitForBareMetalServerActionStop(ctx, bmsC, d.Timeout(schema.TimeoutUpdate), d.Id(), d)
 	if err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
-func isBareMetalServerRestart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerRestart(ctx context.Context, bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	createbmsactoptions := &vpcv1.RestartBareMetalServerOptions{
 		ID: &id,
 	}
@@ -2275,7 +2281,7 @@ func isBareMetalServerRestart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action restart: %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForBareMetalServerAvailable(ctx, bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return nil, err
 	}
@@ -2314,7 +2320,7 @@ func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData
 		if err != nil && response != nil && response.StatusCode != 204 {
 			return fmt.Errorf("[ERROR] Error stopping Bare Metal Server (%s): %s\n%s", id, err, response)
 		}
-		isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
+		isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutDelete), id, d)
 
 	}
 	return nil
@@ -2343,7 +2349,7 @@ func resourceStartServerIfStopped(id, stoppingType string, d *schema.ResourceDat
 			}
 			return fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
 		}
-		_, err = isWaitForBareMetalServerAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForBareMetalServerAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return err
 		}
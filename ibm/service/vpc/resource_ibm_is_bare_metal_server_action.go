@@ -10,9 +10,9 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -145,7 +145,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		_, waitErr := isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutCreate), bareMetalServerId, d)
+		_, waitErr := isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutCreate), bareMetalServerId, d)
 		if waitErr != nil {
 			return diag.FromErr(waitErr)
 		}
@@ -159,7 +159,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+		_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 		if waitErr != nil {
 			return diag.FromErr(waitErr)
 		}
@@ -172,7 +172,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+		_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 		if waitErr != nil {
 			return diag.FromErr(waitErr)
 		}
@@ -259,7 +259,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			_, waitErr := isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutUpdate), bareMetalServerId, d)
+			_, waitErr := isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutUpdate), bareMetalServerId, d)
 			if waitErr != nil {
 				return diag.FromErr(waitErr)
 			}
@@ -272,7 +272,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+			_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 			if waitErr != nil {
 				return diag.FromErr(waitErr)
 			}
@@ -285,7 +285,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+			_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 			if waitErr != nil {
 				return diag.FromErr(waitErr)
 			}
@@ -303,21 +303,23 @@ func resourceIBMISBareMetalServerActionDelete(context context.Context, d *schema
 	return nil
 }
 
-func isWaitForBareMetalServerActionAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerActionAvailable(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be running.", id)
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
-		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
-		Refresh:    isBareMetalServerActionRefreshFunc(client, id, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("bare metal server %s to be running", id),
+		Pending:     []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
+		Target:      []string{isBareMetalServerStatusRunning},
+		Failed:      []string{isBareMetalServerStatusFailed},
+		Refresh:     isBareMetalServerActionRefreshFunc(client, id, d, communicator),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isBareMetalServerActionRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
+func isBareMetalServerActionRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
@@ -13,9 +13,9 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -293,7 +293,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 			if err != nil || res.StatusCode != 204 {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error stopping bare metal server (%s) err %s\n%s", bareMetalServerId, err, response))
 			}
-			_, err = isWaitForBareMetalServerStoppedForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForBareMetalServerStoppedForNIC(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -393,7 +393,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 			return diag.FromErr(err)
 		}
 		log.Printf("[INFO] Bare Metal Server Network Interface : %s", d.Id())
-		_, err = isWaitForBareMetalServerNetworkInterfaceAvailable(sess, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
+		_, err = isWaitForBareMetalServerNetworkInterfaceAvailable(context, sess, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -408,7 +408,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 		if err != nil || res.StatusCode != 204 {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error starting bare metal server (%s) err %s\n%s", bareMetalServerId, err, response))
 		}
-		_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+		_, err = isWaitForBareMetalServerAvailableForNIC(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -523,7 +523,7 @@ func createVlanTypeNetworkInterface(context context.Context, d *schema.ResourceD
 		return err
 	}
 	log.Printf("[INFO] Bare Metal Server Network Interface : %s", d.Id())
-	_, err = isWaitForBareMetalServerNetworkInterfaceAvailable(sess, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForBareMetalServerNetworkInterfaceAvailable(context, sess, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return err
 	}
@@ -883,7 +883,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 				if err != nil || res.StatusCode != 204 {
 					return fmt.Errorf("[ERROR] Error stopping bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
 				}
-				_, err = isWaitForBareMetalServerStoppedForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+				_, err = isWaitForBareMetalServerStoppedForNIC(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 				if err != nil || res.StatusCode != 204 {
 					return err
 				}
@@ -905,7 +905,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Bare Metal Server (%s) network interface (%s) : %s\n%s", bareMetalServerId, nicId, err, response)
 	}
-	_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(sess, bareMetalServerId, nicId, nicType, nicIntf, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(context, sess, bareMetalServerId, nicId, nicType, nicIntf, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -919,7 +919,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 		if err != nil || res.StatusCode != 204 {
 			return fmt.Errorf("[ERROR] Error starting bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
 		}
-		_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+		_, err = isWaitForBareMetalServerAvailableForNIC(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			return err
 		}
@@ -928,21 +928,23 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 	return nil
 }
 
-func isWaitForBareMetalServerNetworkInterfaceDeleted(bmsC *vpcv1.VpcV1, bareMetalServerId, nicId, nicType string, nicIntf vpcv1.BareMetalServerNetworkInterfaceIntf, timeout time.Duration) (interface{}, error) {
+func isWaitForBareMetalServerNetworkInterfaceDeleted(ctx context.Context, bmsC *vpcv1.VpcV1, bareMetalServerId, nicId, nicType string, nicIntf vpcv1.BareMetalServerNetworkInterfaceIntf, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for (%s) / (%s) to be deleted.", bareMetalServerId, nicId)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerNetworkInterfaceAvailable, isBareMetalServerNetworkInterfaceDeleting, isBareMetalServerNetworkInterfacePending},
-		Target:     []string{isBareMetalServerNetworkInterfaceDeleted, isBareMetalServerNetworkInterfaceVlanPending, isBareMetalServerNetworkInterfaceFailed, ""},
-		Refresh:    isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC, bareMetalServerId, nicId, nicType, nicIntf),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("network interface %s of bare metal server %s to be deleted", nicId, bareMetalServerId),
+		Pending:     []string{isBareMetalServerNetworkInterfaceAvailable, isBareMetalServerNetworkInterfaceDeleting, isBareMetalServerNetworkInterfacePending},
+		Target:      []string{isBareMetalServerNetworkInterfaceDeleted, isBareMetalServerNetworkInterfaceVlanPending, ""},
+		Failed:      []string{isBareMetalServerNetworkInterfaceFailed},
+		Refresh:     isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC, bareMetalServerId, nicId, nicType, nicIntf),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareMetalServerId, nicId, nicType string, nicIntf vpcv1.BareMetalServerNetworkInterfaceIntf) resource.StateRefreshFunc {
+func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareMetalServerId, nicId, nicType string, nicIntf vpcv1.BareMetalServerNetworkInterfaceIntf) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getBmsNicOptions := &vpcv1.GetBareMetalServerNetworkInterfaceOptions{
 			BareMetalServerID: &bareMetalServerId,
@@ -971,20 +973,22 @@ func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareM
 	}
 }
 
-func isWaitForBareMetalServerNetworkInterfaceAvailable(client *vpcv1.VpcV1, bareMetalServerId, nicId string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerNetworkInterfaceAvailable(ctx context.Context, client *vpcv1.VpcV1, bareMetalServerId, nicId string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) Network Interface (%s) to be available.", bareMetalServerId, nicId)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerNetworkInterfacePending},
-		Target:     []string{isBareMetalServerNetworkInterfaceAvailable, isBareMetalServerNetworkInterfacePCIPending, isBareMetalServerNetworkInterfaceFailed},
-		Refresh:    isBareMetalServerNetworkInterfaceRefreshFunc(client, bareMetalServerId, nicId, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("network interface %s of bare metal server %s to be available", nicId, bareMetalServerId),
+		Pending:     []string{isBareMetalServerNetworkInterfacePending},
+		Target:      []string{isBareMetalServerNetworkInterfaceAvailable, isBareMetalServerNetworkInterfacePCIPending},
+		Failed:      []string{isBareMetalServerNetworkInterfaceFailed},
+		Refresh:     isBareMetalServerNetworkInterfaceRefreshFunc(client, bareMetalServerId, nicId, d),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isBareMetalServerNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, bareMetalServerId, nicId string, d *schema.ResourceData) resource.StateRefreshFunc {
+func isBareMetalServerNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, bareMetalServerId, nicId string, d *schema.ResourceData) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getBmsNicOptions := &vpcv1.GetBareMetalServerNetworkInterfaceOptions{
 			BareMetalServerID: &bareMetalServerId,
@@ -1049,20 +1053,22 @@ func ParseNICTerraformID(s string) (string, string, error) {
 	return segments[0], segments[1], nil
 }
 
-func isWaitForBareMetalServerAvailableForNIC(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerAvailableForNIC(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be available.", id)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting, "running"},
-		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
-		Refresh:    isBareMetalServerForNICRefreshFunc(client, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("bare metal server %s to be running", id),
+		Pending:     []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting, "running"},
+		Target:      []string{isBareMetalServerStatusRunning},
+		Failed:      []string{isBareMetalServerStatusFailed},
+		Refresh:     isBareMetalServerForNICRefreshFunc(client, id, d),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isBareMetalServerForNICRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
+func isBareMetalServerForNICRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
@@ -1079,20 +1085,20 @@ func isBareMetalServerForNICRefreshFunc(client *vpcv1.VpcV1, id string, d *schem
 	}
 }
 
-func isWaitForBareMetalServerStoppedForNIC(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerStoppedForNIC(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be stopped.", id)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
-		Target:     []string{isBareMetalServerActionStatusStopped},
-		Refresh:    isBareMetalServerForNICStoppedRefreshFunc(client, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
+		Target:   []string{isBareMetalServerActionStatusStopped},
+		Refresh:  isBareMetalServerForNICStoppedRefreshFunc(client, id, d),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isBareMetalServerForNICStoppedRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
+func isBareMetalServerForNICStoppedRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
@@ -12,8 +12,8 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -414,7 +414,7 @@ func resourceIbmIsDedicatedHostCreate(context context.Context, d *schema.Resourc
 
 	d.SetId(*dedicatedHost.ID)
 
-	_, err = isWaitForDedicatedHostAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForDedicatedHostAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -658,7 +658,7 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 		log.Printf("[DEBUG] DeleteDedicatedHostWithContext failed %s\n%s", err, response)
 		return diag.FromErr(err)
 	}
-	_, err = isWaitForDedicatedHostDelete(vpcClient, d, d.Id())
+	_, err = isWaitForDedicatedHostDelete(context, vpcClient, d, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -668,9 +668,9 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 	return nil
 }
 
-func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForDedicatedHostDelete(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{isDedicatedHostDeleting, isDedicatedHostStable},
 		Target:  []string{isDedicatedHostDeleteDone, ""},
 		Refresh: func() (interface{}, string, error) {
@@ -689,30 +689,32 @@ func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData
 			}
 			return dedicatedhost, isDedicatedHostDeleting, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutDelete),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isWaitForDedicatedHostAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForDedicatedHostAvailable(ctx context.Context, instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isDedicatedHostStatusPending, isDedicatedHostUpdating, isDedicatedHostWaiting},
-		Target:     []string{isDedicatedHostFailed, isDedicatedHostStable, isDedicatedHostSuspended},
-		Refresh:    isDedicatedHostRefreshFunc(instanceC, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("dedicated host %s to be available", id),
+		Pending:     []string{isDedicatedHostStatusPending, isDedicatedHostUpdating, isDedicatedHostWaiting},
+		Target:      []string{isDedicatedHostStable, isDedicatedHostSuspended},
+		Failed:      []string{isDedicatedHostFailed},
+		Refresh:     isDedicatedHostRefreshFunc(instanceC, id, d),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isDedicatedHostRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
+func isDedicatedHostRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getinsOptions := &vpcv1.GetDedicatedHostOptions{
 			ID: &id,
@@ -4,10 +4,12 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,11 +17,11 @@ const ()
 
 func ResourceIBMISDedicatedHostDiskManagement() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisDedicatedHostDiskManagementCreate,
-		Read:     resourceIBMisDedicatedHostDiskManagementRead,
-		Update:   resourceIBMisDedicatedHostDiskManagementUpdate,
-		Delete:   resourceIBMisDedicatedHostDiskManagementDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisDedicatedHostDiskManagementCreate,
+		ReadContext:   resourceIBMisDedicatedHostDiskManagementRead,
+		UpdateContext: resourceIBMisDedicatedHostDiskManagementUpdate,
+		DeleteContext: resourceIBMisDedicatedHostDiskManagementDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"dedicated_host": {
@@ -69,10 +71,10 @@ func ResourceIBMISDedicatedHostDiskManagementValidator() *validate.ResourceValid
 	return &ibmISDedicatedHostDiskManagementValidator
 }
 
-func resourceIBMisDedicatedHostDiskManagementCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisDedicatedHostDiskManagementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dedicatedhost := d.Get("dedicated_host").(string)
 	disks := d.Get("disks")
@@ -92,24 +94,24 @@ func resourceIBMisDedicatedHostDiskManagementCreate(d *schema.ResourceData, meta
 
 		dedicatedHostDiskPatch, err := dedicatedHostDiskPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for DedicatedHostDiskPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for DedicatedHostDiskPatch: %s", err))
 		}
 		updateDedicatedHostDiskOptions.SetDedicatedHostDiskPatch(dedicatedHostDiskPatch)
 
-		_, _, err = sess.UpdateDedicatedHostDisk(updateDedicatedHostDiskOptions)
+		_, _, err = sess.UpdateDedicatedHostDiskWithContext(ctx, updateDedicatedHostDiskOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling UpdateDedicatedHostDisk: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling UpdateDedicatedHostDisk: %s", err))
 		}
 
 	}
 	d.SetId(dedicatedhost)
-	return resourceIBMisDedicatedHostDiskManagementRead(d, meta)
+	return resourceIBMisDedicatedHostDiskManagementRead(ctx, d, meta)
 }
 
-func resourceIBMisDedicatedHostDiskManagementUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisDedicatedHostDiskManagementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange("disks") && !d.IsNewResource() {
 
@@ -129,28 +131,28 @@ func resourceIBMisDedicatedHostDiskManagementUpdate(d *schema.ResourceData, meta
 
 			dedicatedHostDiskPatch, err := dedicatedHostDiskPatchModel.AsPatch()
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error calling asPatch for DedicatedHostDiskPatch: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for DedicatedHostDiskPatch: %s", err))
 			}
 			updateDedicatedHostDiskOptions.SetDedicatedHostDiskPatch(dedicatedHostDiskPatch)
 
-			_, response, err := sess.UpdateDedicatedHostDisk(updateDedicatedHostDiskOptions)
+			_, response, err := sess.UpdateDedicatedHostDiskWithContext(ctx, updateDedicatedHostDiskOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating dedicated host disk: %s %s", err, response)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error updating dedicated host disk: %s %s", err, response))
 			}
 
 		}
 
 	}
-	return resourceIBMisDedicatedHostDiskManagementRead(d, meta)
+	return resourceIBMisDedicatedHostDiskManagementRead(ctx, d, meta)
 }
 
-func resourceIBMisDedicatedHostDiskManagementDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisDedicatedHostDiskManagementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMisDedicatedHostDiskManagementRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisDedicatedHostDiskManagementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.Set("dedicated_host", d.Id())
 
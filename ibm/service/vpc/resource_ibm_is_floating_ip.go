@@ -14,9 +14,10 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -38,12 +39,12 @@ const (
 
 func ResourceIBMISFloatingIP() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISFloatingIPCreate,
-		Read:     resourceIBMISFloatingIPRead,
-		Update:   resourceIBMISFloatingIPUpdate,
-		Delete:   resourceIBMISFloatingIPDelete,
-		Exists:   resourceIBMISFloatingIPExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISFloatingIPCreate,
+		ReadContext:   resourceIBMISFloatingIPRead,
+		UpdateContext: resourceIBMISFloatingIPUpdate,
+		DeleteContext: resourceIBMISFloatingIPDelete,
+		Exists:        resourceIBMISFloatingIPExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -53,7 +54,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -289,18 +290,18 @@ func ResourceIBMISFloatingIPValidator() *validate.ResourceValidator {
 	return &ibmISFloatingIPResourceValidator
 }
 
-func resourceIBMISFloatingIPCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := d.Get(isFloatingIPName).(string)
-	err := fipCreate(d, meta, name)
+	err := fipCreate(ctx, d, meta, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISFloatingIPRead(d, meta)
+	return resourceIBMISFloatingIPRead(ctx, d, meta)
 }
 
-func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
+func fipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -339,13 +340,13 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		FloatingIPPrototype: floatingIPPrototype,
 	}
 
-	floatingip, response, err := sess.CreateFloatingIP(createFloatingIPOptions)
+	floatingip, response, err := sess.CreateFloatingIPWithContext(ctx, createFloatingIPOptions)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Floating IP err %s\n%s", err, response)
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
-	_, err = isWaitForInstanceFloatingIP(sess, d.Id(), d)
+	_, err = isWaitForInstanceFloatingIP(ctx, sess, d.Id(), d)
 	if err != nil {
 		return err
 	}
@@ -361,17 +362,17 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	return nil
 }
 
-func resourceIBMISFloatingIPRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := fipGet(d, meta, id)
+	err := fipGet(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
+func fipGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -379,7 +380,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getFloatingIPOptions := &vpcv1.GetFloatingIPOptions{
 		ID: &id,
 	}
-	floatingip, response, err := sess.GetFloatingIP(getFloatingIPOptions)
+	floatingip, response, err := sess.GetFloatingIPWithContext(ctx, getFloatingIPOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -423,16 +424,16 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISFloatingIPUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := fipUpdate(d, meta, id)
+	err := fipUpdate(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISFloatingIPRead(d, meta)
+	return resourceIBMISFloatingIPRead(ctx, d, meta)
 }
 
-func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+func fipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -441,7 +442,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		options := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
-		fip, response, err := sess.GetFloatingIP(options)
+		fip, response, err := sess.GetFloatingIPWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Floating IP: %s\n%s", err, response)
 		}
@@ -481,7 +482,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		options.FloatingIPPatch = floatingIPPatch
 	}
 	if hasChanged {
-		_, response, err := sess.UpdateFloatingIP(options)
+		_, response, err := sess.UpdateFloatingIPWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating vpc Floating IP: %s\n%s", err, response)
 		}
@@ -489,16 +490,16 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISFloatingIPDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := fipDelete(d, meta, id)
+	err := fipDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func fipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -506,7 +507,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getFloatingIpOptions := &vpcv1.GetFloatingIPOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetFloatingIP(getFloatingIpOptions)
+	_, response, err := sess.GetFloatingIPWithContext(ctx, getFloatingIpOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -518,11 +519,11 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.DeleteFloatingIPOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteFloatingIP(options)
+	response, err = sess.DeleteFloatingIPWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Floating IP : %s\n%s", err, response)
 	}
-	_, err = isWaitForFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForFloatingIPDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -554,28 +555,28 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	return true, nil
 }
 
-func isWaitForFloatingIPDeleted(fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForFloatingIPDeleted(ctx context.Context, fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for FloatingIP (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isFloatingIPPending, isFloatingIPDeleting},
-		Target:     []string{"", isFloatingIPDeleted},
-		Refresh:    isFloatingIPDeleteRefreshFunc(fip, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{isFloatingIPPending, isFloatingIPDeleting},
+		Target:   []string{"", isFloatingIPDeleted},
+		Refresh:  isFloatingIPDeleteRefreshFunc(ctx, fip, id),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isFloatingIPDeleteRefreshFunc(ctx context.Context, fip *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] floating ip delete function here")
 		getfipoptions := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
-		FloatingIP, response, err := fip.GetFloatingIP(getfipoptions)
+		FloatingIP, response, err := fip.GetFloatingIPWithContext(ctx, getfipoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return FloatingIP, isFloatingIPDeleted, nil
@@ -586,27 +587,27 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 	}
 }
 
-func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceFloatingIP(ctx context.Context, floatingipC *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for floating IP (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isFloatingIPPending},
-		Target:     []string{isFloatingIPAvailable, ""},
-		Refresh:    isInstanceFloatingIPRefreshFunc(floatingipC, id),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{isFloatingIPPending},
+		Target:   []string{isFloatingIPAvailable, ""},
+		Refresh:  isInstanceFloatingIPRefreshFunc(ctx, floatingipC, id),
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isInstanceFloatingIPRefreshFunc(ctx context.Context, floatingipC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getfipoptions := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
-		instance, response, err := floatingipC.GetFloatingIP(getfipoptions)
+		instance, response, err := floatingipC.GetFloatingIPWithContext(ctx, getfipoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Floating IP for the instance: %s\n%s", err, response)
 		}
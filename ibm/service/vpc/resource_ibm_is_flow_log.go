@@ -13,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -36,12 +37,12 @@ const (
 
 func ResourceIBMISFlowLog() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISFlowLogCreate,
-		Read:     resourceIBMISFlowLogRead,
-		Update:   resourceIBMISFlowLogUpdate,
-		Delete:   resourceIBMISFlowLogDelete,
-		Exists:   resourceIBMISFlowLogExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISFlowLogCreate,
+		ReadContext:   resourceIBMISFlowLogRead,
+		UpdateContext: resourceIBMISFlowLogUpdate,
+		DeleteContext: resourceIBMISFlowLogDelete,
+		Exists:        resourceIBMISFlowLogExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -51,7 +52,7 @@ func ResourceIBMISFlowLog() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -198,10 +199,10 @@ func ResourceIBMISFlowLogValidator() *validate.ResourceValidator {
 	return &ibmISFlowLogValidator
 }
 
-func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFlowLogCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createFlowLogCollectorOptionsModel := &vpcv1.CreateFlowLogCollectorOptions{}
@@ -229,9 +230,9 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 	cloudObjectStorageBucketIdentityModel.Name = &bucketname
 	createFlowLogCollectorOptionsModel.StorageBucket = cloudObjectStorageBucketIdentityModel
 
-	flowlogCollector, response, err := sess.CreateFlowLogCollector(createFlowLogCollectorOptionsModel)
+	flowlogCollector, response, err := sess.CreateFlowLogCollectorWithContext(ctx, createFlowLogCollectorOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Create Flow Log Collector err %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Create Flow Log Collector err %s\n%s", err, response))
 	}
 	d.SetId(*flowlogCollector.ID)
 
@@ -247,14 +248,14 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceIBMISFlowLogRead(d, meta)
+	return resourceIBMISFlowLogRead(ctx, d, meta)
 }
 
-func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFlowLogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -262,9 +263,9 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	getOptions := &vpcv1.GetFlowLogCollectorOptions{
 		ID: &ID,
 	}
-	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
+	flowlogCollector, response, err := sess.GetFlowLogCollectorWithContext(ctx, getOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response))
 	}
 
 	if flowlogCollector.Name != nil {
@@ -314,7 +315,7 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(isFlowLogTags, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(flex.ResourceControllerURL, controller+"/vpc-ext/network/flowLogs")
@@ -330,11 +331,11 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFlowLogUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -342,9 +343,9 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 	getOptions := &vpcv1.GetFlowLogCollectorOptions{
 		ID: &ID,
 	}
-	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
+	flowlogCollector, response, err := sess.GetFlowLogCollectorWithContext(ctx, getOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response))
 	}
 
 	if d.HasChange(isFlowLogTags) {
@@ -368,32 +369,32 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		flowLogCollectorPatch, err := flowLogCollectorPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for FlowLogCollectorPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for FlowLogCollectorPatch: %s", err))
 		}
 		updoptions.FlowLogCollectorPatch = flowLogCollectorPatch
-		_, response, err = sess.UpdateFlowLogCollector(updoptions)
+		_, response, err = sess.UpdateFlowLogCollectorWithContext(ctx, updoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating flow log collector:%s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating flow log collector:%s\n%s", err, response))
 		}
 	}
 
-	return resourceIBMISFlowLogRead(d, meta)
+	return resourceIBMISFlowLogRead(ctx, d, meta)
 }
 
-func resourceIBMISFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFlowLogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ID := d.Id()
 	delOptions := &vpcv1.DeleteFlowLogCollectorOptions{
 		ID: &ID,
 	}
-	response, err := sess.DeleteFlowLogCollector(delOptions)
+	response, err := sess.DeleteFlowLogCollectorWithContext(ctx, delOptions)
 
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("[ERROR] Error deleting flow log collector:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting flow log collector:%s\n%s", err, response))
 	}
 
 	d.SetId("")
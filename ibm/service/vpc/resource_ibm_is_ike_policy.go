@@ -4,12 +4,14 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -31,12 +33,12 @@ const (
 
 func ResourceIBMISIKEPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISIKEPolicyCreate,
-		Read:     resourceIBMISIKEPolicyRead,
-		Update:   resourceIBMISIKEPolicyUpdate,
-		Delete:   resourceIBMISIKEPolicyDelete,
-		Exists:   resourceIBMISIKEPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISIKEPolicyCreate,
+		ReadContext:   resourceIBMISIKEPolicyRead,
+		UpdateContext: resourceIBMISIKEPolicyUpdate,
+		DeleteContext: resourceIBMISIKEPolicyDelete,
+		Exists:        resourceIBMISIKEPolicyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			isIKEName: {
@@ -191,7 +193,7 @@ func ResourceIBMISIKEValidator() *validate.ResourceValidator {
 	return &ibmISIKEResourceValidator
 }
 
-func resourceIBMISIKEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] IKE Policy create")
 	name := d.Get(isIKEName).(string)
@@ -199,14 +201,14 @@ func resourceIBMISIKEPolicyCreate(d *schema.ResourceData, meta interface{}) erro
 	encryptionAlg := d.Get(isIKEEncryptionAlg).(string)
 	dhGroup := int64(d.Get(isIKEDhGroup).(int))
 
-	err := ikepCreate(d, meta, authenticationAlg, encryptionAlg, name, dhGroup)
+	err := ikepCreate(ctx, d, meta, authenticationAlg, encryptionAlg, name, dhGroup)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISIKEPolicyRead(d, meta)
+	return resourceIBMISIKEPolicyRead(ctx, d, meta)
 }
 
-func ikepCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, encryptionAlg, name string, dhGroup int64) error {
+func ikepCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, authenticationAlg, encryptionAlg, name string, dhGroup int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -237,7 +239,7 @@ func ikepCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, enc
 			ID: &rg,
 		}
 	}
-	ike, response, err := sess.CreateIkePolicy(options)
+	ike, response, err := sess.CreateIkePolicyWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] ike policy err %s\n%s", err, response)
 	}
@@ -246,13 +248,13 @@ func ikepCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, enc
 	return nil
 }
 
-func resourceIBMISIKEPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
-	return ikepGet(d, meta, id)
+	return diag.FromErr(ikepGet(ctx, d, meta, id))
 }
 
-func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
+func ikepGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -260,7 +262,7 @@ func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getikepoptions := &vpcv1.GetIkePolicyOptions{
 		ID: &id,
 	}
-	ike, response, err := sess.GetIkePolicy(getikepoptions)
+	ike, response, err := sess.GetIkePolicyWithContext(ctx, getikepoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -305,17 +307,17 @@ func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISIKEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
-	err := ikepUpdate(d, meta, id)
+	err := ikepUpdate(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISIKEPolicyRead(d, meta)
+	return resourceIBMISIKEPolicyRead(ctx, d, meta)
 }
 
-func ikepUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+func ikepUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -344,7 +346,7 @@ func ikepUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		options.IkePolicyPatch = ikePolicyPatch
 
-		_, response, err := sess.UpdateIkePolicy(options)
+		_, response, err := sess.UpdateIkePolicyWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error on update of IKE Policy(%s): %s\n%s", id, err, response)
 		}
@@ -352,13 +354,13 @@ func ikepUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISIKEPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
-	return ikepDelete(d, meta, id)
+	return diag.FromErr(ikepDelete(ctx, d, meta, id))
 }
 
-func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func ikepDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -366,7 +368,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getikepoptions := &vpcv1.GetIkePolicyOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetIkePolicy(getikepoptions)
+	_, response, err := sess.GetIkePolicyWithContext(ctx, getikepoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -378,7 +380,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteIkePolicyOptions := &vpcv1.DeleteIkePolicyOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteIkePolicy(deleteIkePolicyOptions)
+	response, err = sess.DeleteIkePolicyWithContext(ctx, deleteIkePolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting IKE Policy(%s): %s\n%s", id, err, response)
 	}
@@ -12,9 +12,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -44,12 +45,12 @@ const (
 
 func ResourceIBMISImage() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISImageCreate,
-		Read:     resourceIBMISImageRead,
-		Update:   resourceIBMISImageUpdate,
-		Delete:   resourceIBMISImageDelete,
-		Exists:   resourceIBMISImageExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISImageCreate,
+		ReadContext:   resourceIBMISImageRead,
+		UpdateContext: resourceIBMISImageUpdate,
+		DeleteContext: resourceIBMISImageDelete,
+		Exists:        resourceIBMISImageExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -59,7 +60,7 @@ func ResourceIBMISImage() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -227,7 +228,7 @@ func ResourceIBMISImageValidator() *validate.ResourceValidator {
 	return &ibmISImageResourceValidator
 }
 
-func resourceIBMISImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] Image create")
 	href := d.Get(isImageHref).(string)
@@ -236,21 +237,21 @@ func resourceIBMISImageCreate(d *schema.ResourceData, meta interface{}) error {
 	volume := d.Get(isImageVolume).(string)
 
 	if volume != "" {
-		err := imgCreateByVolume(d, meta, name, volume)
+		err := imgCreateByVolume(ctx, d, meta, name, volume)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := imgCreateByFile(d, meta, href, name, operatingSystem)
+		err := imgCreateByFile(ctx, d, meta, href, name, operatingSystem)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMISImageRead(d, meta)
+	return resourceIBMISImageRead(ctx, d, meta)
 }
 
-func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, operatingSystem string) error {
+func imgCreateByFile(ctx context.Context, d *schema.ResourceData, meta interface{}, href, name, operatingSystem string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -284,13 +285,13 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	options := &vpcv1.CreateImageOptions{
 		ImagePrototype: imagePrototype,
 	}
-	image, response, err := sess.CreateImage(options)
+	image, response, err := sess.CreateImageWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
-	_, err = isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -305,7 +306,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	}
 	return nil
 }
-func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume string) error {
+func imgCreateByVolume(ctx context.Context, d *schema.ResourceData, meta interface{}, name, volume string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -320,7 +321,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	options := &vpcv1.GetVolumeOptions{
 		ID: &volume,
 	}
-	vol, response, err := sess.GetVolume(options)
+	vol, response, err := sess.GetVolumeWithContext(ctx, options)
 	if err != nil || vol == nil {
 		return fmt.Errorf("[ERROR] Error retrieving Volume (%s) details: %s\n%s", volume, err, response)
 	}
@@ -332,7 +333,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &insId,
 	}
-	instance, response, err := sess.GetInstance(getinsOptions)
+	instance, response, err := sess.GetInstanceWithContext(ctx, getinsOptions)
 	if err != nil || instance == nil {
 		return fmt.Errorf("[ERROR] Error retrieving Instance (%s) to which the source_volume (%s) is attached : %s\n%s", insId, volume, err, response)
 	}
@@ -342,16 +343,16 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 			InstanceID: &insId,
 			Type:       &actiontype,
 		}
-		_, response, err = sess.CreateInstanceAction(createinsactoptions)
+		_, response, err = sess.CreateInstanceActionWithContext(ctx, createinsactoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error stopping Instance (%s) to which the source_volume (%s) is attached  : %s\n%s", insId, volume, err, response)
 		}
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), insId, d)
+		_, err = isWaitForInstanceActionStop(ctx, sess, d.Timeout(schema.TimeoutCreate), insId, d)
 		if err != nil {
 			return err
 		}
 	} else if *instance.Status != "stopped" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), insId, d)
+		_, err = isWaitForInstanceActionStop(ctx, sess, d.Timeout(schema.TimeoutCreate), insId, d)
 		if err != nil {
 			return err
 		}
@@ -373,13 +374,13 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	imagOptions := &vpcv1.CreateImageOptions{
 		ImagePrototype: imagePrototype,
 	}
-	image, response, err := sess.CreateImage(imagOptions)
+	image, response, err := sess.CreateImageWithContext(ctx, imagOptions)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
-	_, err = isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -395,31 +396,36 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	return nil
 }
 
-func isWaitForImageAvailable(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageAvailable(ctx context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isImageProvisioning},
-		Target:     []string{isImageProvisioningDone, ""},
-		Refresh:    isImageRefreshFunc(imageC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("image %s to be available", id),
+		Pending:     []string{"retry", isImageProvisioning},
+		Target:      []string{isImageProvisioningDone, ""},
+		Failed:      []string{"failed"},
+		Refresh:     isImageRefreshFunc(ctx, imageC, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
-func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isImageRefreshFunc(ctx context.Context, imageC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getimgoptions := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
-		image, response, err := imageC.GetImage(getimgoptions)
+		image, response, err := imageC.GetImageWithContext(ctx, getimgoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Image: %s\n%s", err, response)
 		}
 
-		if *image.Status == "available" || *image.Status == "failed" {
+		if *image.Status == "failed" {
+			return image, *image.Status, nil
+		}
+		if *image.Status == "available" {
 			return image, isImageProvisioningDone, nil
 		}
 
@@ -427,7 +433,7 @@ func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFun
 	}
 }
 
-func resourceIBMISImageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	name := ""
@@ -437,15 +443,15 @@ func resourceIBMISImageUpdate(d *schema.ResourceData, meta interface{}) error {
 		name = d.Get(isImageName).(string)
 		hasChanged = true
 	}
-	err := imgUpdate(d, meta, id, name, hasChanged)
+	err := imgUpdate(ctx, d, meta, id, name, hasChanged)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISImageRead(d, meta)
+	return resourceIBMISImageRead(ctx, d, meta)
 }
 
-func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func imgUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -454,7 +460,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		options := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
-		image, response, err := sess.GetImage(options)
+		image, response, err := sess.GetImageWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Image IP: %s\n%s", err, response)
 		}
@@ -477,7 +483,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 			return fmt.Errorf("[ERROR] Error calling asPatch for ImagePatch: %s", err)
 		}
 		options.ImagePatch = imagePatch
-		_, response, err := sess.UpdateImage(options)
+		_, response, err := sess.UpdateImageWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error on update of resource vpc Image: %s\n%s", err, response)
 		}
@@ -485,17 +491,17 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	return nil
 }
 
-func resourceIBMISImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
-	err := imgGet(d, meta, id)
+	err := imgGet(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
+func imgGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -503,7 +509,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.GetImageOptions{
 		ID: &id,
 	}
-	image, response, err := sess.GetImage(options)
+	image, response, err := sess.GetImageWithContext(ctx, options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -564,17 +570,17 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
-	err := imgDelete(d, meta, id)
+	err := imgDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func imgDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -582,7 +588,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getImageOptions := &vpcv1.GetImageOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetImage(getImageOptions)
+	_, response, err := sess.GetImageWithContext(ctx, getImageOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -593,11 +599,11 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.DeleteImageOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteImage(options)
+	response, err = sess.DeleteImageWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Image : %s\n%s", err, response)
 	}
-	_, err = isWaitForImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForImageDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -605,28 +611,28 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForImageDeleted(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageDeleted(ctx context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isImageDeleting},
-		Target:     []string{"", isImageDeleted},
-		Refresh:    isImageDeleteRefreshFunc(imageC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", isImageDeleting},
+		Target:   []string{"", isImageDeleted},
+		Refresh:  isImageDeleteRefreshFunc(ctx, imageC, id),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isImageDeleteRefreshFunc(ctx context.Context, imageC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is image delete function here")
 		getimgoptions := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
-		image, response, err := imageC.GetImage(getimgoptions)
+		image, response, err := imageC.GetImageWithContext(ctx, getimgoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return image, isImageDeleted, nil
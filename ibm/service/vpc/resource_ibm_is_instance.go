@@ -14,9 +14,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -110,11 +111,11 @@ const (
 
 func ResourceIBMISInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMisInstanceCreate,
-		Read:   resourceIBMisInstanceRead,
-		Update: resourceIBMisInstanceUpdate,
-		Delete: resourceIBMisInstanceDelete,
-		Exists: resourceIBMisInstanceExists,
+		CreateContext: resourceIBMisInstanceCreate,
+		ReadContext:   resourceIBMisInstanceRead,
+		UpdateContext: resourceIBMisInstanceUpdate,
+		DeleteContext: resourceIBMisInstanceDelete,
+		Exists:        resourceIBMisInstanceExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) (result []*schema.ResourceData, err error) {
 				log.Printf("[INFO] Instance (%s) importing", d.Id())
@@ -161,7 +162,7 @@ func ResourceIBMISInstance() *schema.Resource {
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 		),
 
@@ -918,7 +919,7 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 	return &ibmISInstanceValidator
 }
 
-func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
+func instanceCreateByImage(ctx context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1237,7 +1238,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := sess.CreateInstanceWithContext(ctx, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -1247,7 +1248,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return err
 	}
@@ -1264,7 +1265,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 	return nil
 }
 
-func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image, template string) error {
+func instanceCreateByTemplate(ctx context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image, template string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1590,7 +1591,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := sess.CreateInstanceWithContext(ctx, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -1604,7 +1605,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		d.Set(isInstanceMetadataServiceEnabled, instance.MetadataService.Enabled)
 	}
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return err
 	}
@@ -1621,7 +1622,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 	return nil
 }
 
-func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
+func instanceCreateByVolume(ctx context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1943,7 +1944,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := sess.CreateInstanceWithContext(ctx, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -1953,7 +1954,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return err
 	}
@@ -1970,7 +1971,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	return nil
 }
 
-func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	profile := d.Get(isInstanceProfile).(string)
 	name := d.Get(isInstanceName).(string)
@@ -1981,53 +1982,55 @@ func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	template := d.Get(isInstanceSourceTemplate).(string)
 
 	if snapshot != "" {
-		err := instanceCreateByVolume(d, meta, profile, name, vpcID, zone)
+		err := instanceCreateByVolume(ctx, d, meta, profile, name, vpcID, zone)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if template != "" {
-		err := instanceCreateByTemplate(d, meta, profile, name, vpcID, zone, image, template)
+		err := instanceCreateByTemplate(ctx, d, meta, profile, name, vpcID, zone, image, template)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := instanceCreateByImage(d, meta, profile, name, vpcID, zone, image)
+		err := instanceCreateByImage(ctx, d, meta, profile, name, vpcID, zone, image)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMisInstanceUpdate(d, meta)
+	return resourceIBMisInstanceUpdate(ctx, d, meta)
 }
 
-func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceAvailable(ctx context.Context, instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) to be available.", id)
 
 	communicator := make(chan interface{})
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isInstanceProvisioning},
-		Target:     []string{isInstanceStatusRunning, "available", "failed", ""},
-		Refresh:    isInstanceRefreshFunc(instanceC, id, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("instance %s to be available", id),
+		Pending:     []string{"retry", isInstanceProvisioning},
+		Target:      []string{isInstanceStatusRunning, "available", ""},
+		Failed:      []string{"failed"},
+		Refresh:     isInstanceRefreshFunc(ctx, instanceC, id, d, communicator),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStartAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStartAction(ctx, instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
+func isInstanceRefreshFunc(ctx context.Context, instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &id,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
 		}
@@ -2077,7 +2080,7 @@ func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Resource
 	}
 }
 
-func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
+func isRestartStartAction(ctx context.Context, instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
 	subticker := time.NewTicker(time.Duration(forceTimeout) * time.Minute)
 	//subticker := time.NewTicker(time.Duration(forceTimeout) * time.Second)
 	for {
@@ -2090,19 +2093,19 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err := instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
 				communicator <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
 				return
 			}
 			waitTimeout := time.Duration(1) * time.Minute
-			_, _ = isWaitForInstanceActionStop(instanceC, waitTimeout, id, d)
+			_, _ = isWaitForInstanceActionStop(ctx, instanceC, waitTimeout, id, d)
 			actiontype = "start"
 			createinsactoptions = &vpcv1.CreateInstanceActionOptions{
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
 				communicator <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
 				return
@@ -2115,18 +2118,18 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 		}
 	}
 }
-func resourceIBMisInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	ID := d.Id()
 
-	err := instanceGet(d, meta, ID)
+	err := instanceGet(ctx, d, meta, ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
+func instanceGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -2137,7 +2140,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getinsIniOptions := &vpcv1.GetInstanceInitializationOptions{
 		ID: &id,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -2145,7 +2148,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		return fmt.Errorf("[ERROR] Error getting Instance: %s\n%s", err, response)
 	}
-	instanceInitialization, response, err := instanceC.GetInstanceInitialization(getinsIniOptions)
+	instanceInitialization, response, err := instanceC.GetInstanceInitializationWithContext(ctx, getinsIniOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting Instance initialization details: %s\n%s", err, response)
 	}
@@ -2225,7 +2228,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			SubnetID: instance.PrimaryNetworkInterface.Subnet.ID,
 			ID:       instance.PrimaryNetworkInterface.PrimaryIP.ID,
 		}
-		insRip, response, err := instanceC.GetSubnetReservedIP(getripoptions)
+		insRip, response, err := instanceC.GetSubnetReservedIPWithContext(ctx, getripoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the instance network interface(%s): %s\n%s", *instance.PrimaryNetworkInterface.PrimaryIP.ID, *instance.PrimaryNetworkInterface.ID, err, response)
 		}
@@ -2238,7 +2241,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			InstanceID: &id,
 			ID:         instance.PrimaryNetworkInterface.ID,
 		}
-		insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
+		insnic, response, err := instanceC.GetInstanceNetworkInterfaceWithContext(ctx, getnicoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
 		}
@@ -2292,7 +2295,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 					SubnetID: intfc.Subnet.ID,
 					ID:       intfc.PrimaryIP.ID,
 				}
-				insRip, response, err := instanceC.GetSubnetReservedIP(getripoptions)
+				insRip, response, err := instanceC.GetSubnetReservedIPWithContext(ctx, getripoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the instance network interface(%s): %s\n%s", *intfc.PrimaryIP.ID, *intfc.ID, err, response)
 				}
@@ -2305,7 +2308,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 					InstanceID: &id,
 					ID:         intfc.ID,
 				}
-				insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
+				insnic, response, err := instanceC.GetInstanceNetworkInterfaceWithContext(ctx, getnicoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
 				}
@@ -2377,7 +2380,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			options := &vpcv1.GetVolumeOptions{
 				ID: instance.BootVolumeAttachment.Volume.ID,
 			}
-			vol, response, err := instanceC.GetVolume(options)
+			vol, response, err := instanceC.GetVolumeWithContext(ctx, options)
 			if err != nil {
 				log.Printf("Error Getting Boot Volume (%s): %s\n%s", id, err, response)
 			}
@@ -2441,7 +2444,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func instanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -2470,13 +2473,13 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		updateVolumeOptions.VolumePatch = volPatchModelAsPatch
 
-		vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
+		vol, res, err := instanceC.UpdateVolumeWithContext(ctx, updateVolumeOptions)
 
 		if vol == nil || err != nil {
 			return (fmt.Errorf("[ERROR] Error encountered while expanding boot volume of instance %s/n%s", err, res))
 		}
 
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForVolumeAvailable(ctx, instanceC, volId, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
@@ -2494,14 +2497,14 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			InstanceID: &id,
 			Type:       &actiontype,
 		}
-		_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+		_, response, err := instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
 			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStop(ctx, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
 			return err
 		}
@@ -2531,7 +2534,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		updateOptions.InstancePatch = instancePatch
 
-		_, _, err = instanceC.UpdateInstance(updateOptions)
+		_, _, err = instanceC.UpdateInstanceWithContext(ctx, updateOptions)
 		if err != nil {
 			return err
 		}
@@ -2541,14 +2544,14 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			InstanceID: &id,
 			Type:       &actiontype,
 		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+		_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
 			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 		}
-		_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStart(ctx, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
 			return err
 		}
@@ -2561,7 +2564,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			getinsOptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsOptions)
+			instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
 			}
@@ -2580,17 +2583,17 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				force := instanceActionForceIntf.(bool)
 				createinsactoptions.Force = &force
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 			}
 			if actiontype == "stop" {
-				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+				_, err = isWaitForInstanceActionStop(ctx, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 				if err != nil {
 					return err
 				}
 			} else if actiontype == "start" || actiontype == "reboot" {
-				_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+				_, err = isWaitForInstanceActionStart(ctx, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 				if err != nil {
 					return err
 				}
@@ -2619,11 +2622,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					},
 					DeleteVolumeOnInstanceDelete: &volautoDelete,
 				}
-				vol, _, err := instanceC.CreateInstanceVolumeAttachment(createvolattoptions)
+				vol, _, err := instanceC.CreateInstanceVolumeAttachmentWithContext(ctx, createvolattoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while attaching volume %q for instance %s: %q", add[i], d.Id(), err)
 				}
-				_, err = isWaitForInstanceVolumeAttached(instanceC, d, id, *vol.ID)
+				_, err = isWaitForInstanceVolumeAttached(ctx, instanceC, d, id, *vol.ID)
 				if err != nil {
 					return err
 				}
@@ -2635,7 +2638,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				listvolattoptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
 					InstanceID: &id,
 				}
-				vols, _, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
+				vols, _, err := instanceC.ListInstanceVolumeAttachmentsWithContext(ctx, listvolattoptions)
 				if err != nil {
 					return err
 				}
@@ -2645,11 +2648,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							InstanceID: &id,
 							ID:         vol.ID,
 						}
-						_, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
+						_, err := instanceC.DeleteInstanceVolumeAttachmentWithContext(ctx, delvolattoptions)
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while removing volume %q for instance %s: %q", remove[i], d.Id(), err)
 						}
-						_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+						_, err = isWaitForInstanceVolumeDetached(ctx, instanceC, d, d.Id(), *vol.ID)
 						if err != nil {
 							return err
 						}
@@ -2673,11 +2676,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					SecurityGroupID: &add[i],
 					ID:              &networkID,
 				}
-				_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
+				_, response, err := instanceC.CreateSecurityGroupTargetBindingWithContext(ctx, createsgnicoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return err
 				}
@@ -2691,11 +2694,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					SecurityGroupID: &remove[i],
 					ID:              &networkID,
 				}
-				response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
+				response, err := instanceC.DeleteSecurityGroupTargetBindingWithContext(ctx, deletesgnicoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return err
 				}
@@ -2724,7 +2727,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[ERROR] Error calling reserved ip as patch \n%s", err)
 		}
 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
-		_, response, err := instanceC.UpdateSubnetReservedIP(updateripoptions)
+		_, response, err := instanceC.UpdateSubnetReservedIPWithContext(ctx, updateripoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response)
 		}
@@ -2749,11 +2752,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updatepnicfoptions.NetworkInterfacePatch = networkInterfacePatch
 
-		_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
+		_, response, err := instanceC.UpdateInstanceNetworkInterfaceWithContext(ctx, updatepnicfoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error while updating name %s for primary network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return err
 		}
@@ -2790,7 +2793,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					return fmt.Errorf("[ERROR] Error calling reserved ip as patch \n%s", err)
 				}
 				updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
-				_, response, err := instanceC.UpdateSubnetReservedIP(updateripoptions)
+				_, response, err := instanceC.UpdateSubnetReservedIPWithContext(ctx, updateripoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response)
 				}
@@ -2810,11 +2813,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							SecurityGroupID: &add[i],
 							ID:              &networkID,
 						}
-						_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
+						_, response, err := instanceC.CreateSecurityGroupTargetBindingWithContext(ctx, createsgnicoptions)
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							return err
 						}
@@ -2829,11 +2832,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							SecurityGroupID: &remove[i],
 							ID:              &networkID,
 						}
-						response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
+						response, err := instanceC.DeleteSecurityGroupTargetBindingWithContext(ctx, deletesgnicoptions)
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							return err
 						}
@@ -2862,7 +2865,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				updatepnicfoptions.NetworkInterfacePatch = networkInterfacePatch
 
-				_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
+				_, response, err := instanceC.UpdateInstanceNetworkInterfaceWithContext(ctx, updatepnicfoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while updating name %s for network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
 				}
@@ -2889,7 +2892,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updnetoptions.InstancePatch = instancePatch
 
-		_, _, err = instanceC.UpdateInstance(updnetoptions)
+		_, _, err = instanceC.UpdateInstanceWithContext(ctx, updnetoptions)
 		if err != nil {
 			return err
 		}
@@ -2910,7 +2913,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updnetoptions.InstancePatch = instancePatch
 
-		_, _, err = instanceC.UpdateInstance(updnetoptions)
+		_, _, err = instanceC.UpdateInstanceWithContext(ctx, updnetoptions)
 		if err != nil {
 			return err
 		}
@@ -2932,7 +2935,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updatedoptions.InstancePatch = instancePatch
 
-		_, _, err = instanceC.UpdateInstance(updatedoptions)
+		_, _, err = instanceC.UpdateInstanceWithContext(ctx, updatedoptions)
 		if err != nil {
 			return err
 		}
@@ -2954,7 +2957,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updatedoptions.InstancePatch = instancePatch
 
-		_, _, err = instanceC.UpdateInstance(updatedoptions)
+		_, _, err = instanceC.UpdateInstanceWithContext(ctx, updatedoptions)
 		if err != nil {
 			return err
 		}
@@ -2965,7 +2968,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &id,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				d.SetId("")
@@ -2980,14 +2983,14 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return nil
 				}
 				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 			}
-			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+			_, err = isWaitForInstanceActionStop(ctx, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 			if err != nil {
 				return err
 			}
@@ -3010,7 +3013,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updnetoptions.InstancePatch = instancePatch
 
-		_, response, err = instanceC.UpdateInstance(updnetoptions)
+		_, response, err = instanceC.UpdateInstanceWithContext(ctx, updnetoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error in UpdateInstancePatch: %s\n%s", err, response)
 		}
@@ -3020,14 +3023,14 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			InstanceID: &id,
 			Type:       &actiontype,
 		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+		_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
 			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return err
 		}
@@ -3037,7 +3040,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
 	}
@@ -3052,17 +3055,17 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	err := instanceUpdate(d, meta)
+	err := instanceUpdate(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMisInstanceRead(d, meta)
+	return resourceIBMisInstanceRead(ctx, d, meta)
 }
 
-func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func instanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -3072,7 +3075,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	_, response, err := instanceC.GetInstance(getinsOptions)
+	_, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -3089,21 +3092,21 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			InstanceID: &id,
 			Type:       &actiontype,
 		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+		_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
 			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
+		_, err = isWaitForInstanceActionStop(ctx, instanceC, d.Timeout(schema.TimeoutDelete), id, d)
 		if err != nil {
 			return err
 		}
 		listvolattoptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
 			InstanceID: &id,
 		}
-		vols, response, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
+		vols, response, err := instanceC.ListInstanceVolumeAttachmentsWithContext(ctx, listvolattoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Listing volume attachments to the instance: %s\n%s", err, response)
 		}
@@ -3113,11 +3116,11 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 					InstanceID: &id,
 					ID:         vol.ID,
 				}
-				_, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
+				_, err := instanceC.DeleteInstanceVolumeAttachmentWithContext(ctx, delvolattoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while removing volume Attachment %q for instance %s: %q", *vol.ID, d.Id(), err)
 				}
-				_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+				_, err = isWaitForInstanceVolumeDetached(ctx, instanceC, d, d.Id(), *vol.ID)
 				if err != nil {
 					return err
 				}
@@ -3130,17 +3133,17 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteinstanceOptions := &vpcv1.DeleteInstanceOptions{
 		ID: &id,
 	}
-	_, err = instanceC.DeleteInstance(deleteinstanceOptions)
+	_, err = instanceC.DeleteInstanceWithContext(ctx, deleteinstanceOptions)
 	if err != nil {
 		return err
 	}
 	if cleanDelete {
-		_, err = isWaitForInstanceDelete(instanceC, d, d.Id())
+		_, err = isWaitForInstanceDelete(ctx, instanceC, d, d.Id())
 		if err != nil {
 			return err
 		}
 		if _, ok := d.GetOk(isInstanceBootVolume); ok {
-			_, err = isWaitForVolumeDeleted(instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
+			_, err = isWaitForVolumeDeleted(ctx, instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				return err
 			}
@@ -3149,12 +3152,12 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
-	err := instanceDelete(d, meta, id)
+	err := instanceDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -3188,16 +3191,16 @@ func resourceIBMisInstanceExists(d *schema.ResourceData, meta interface{}) (bool
 
 }
 
-func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForInstanceDelete(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{isInstanceDeleting, isInstanceAvailable},
 		Target:  []string{isInstanceDeleteDone, ""},
 		Refresh: func() (interface{}, string, error) {
 			getinsoptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
+			instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return instance, isInstanceDeleteDone, nil
@@ -3209,24 +3212,26 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 			}
 			return instance, isInstanceDeleting, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutDelete),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceActionStop(ctx context.Context, instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
-		Target:  []string{isInstanceActionStatusStopped, isInstanceStatusFailed, ""},
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("instance %s to stop", id),
+		Pending:     []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
+		Target:      []string{isInstanceActionStatusStopped, ""},
+		Failed:      []string{isInstanceStatusFailed},
 		Refresh: func() (interface{}, string, error) {
 			getinsoptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
+			instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsoptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
 			}
@@ -3234,38 +3239,38 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			case data := <-communicator:
 				return nil, "", data.(error)
 			default:
-				fmt.Println("no message sent")
 			}
 			if *instance.Status == isInstanceStatusFailed {
 				// let know the isRestartStopAction() to stop
 				close(communicator)
-				return instance, *instance.Status, fmt.Errorf("[ERROR] The  instance %s failed to stop: %v", id, err)
 			}
 			return instance, *instance.Status, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStopAction(ctx, instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceActionStart(ctx context.Context, instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceActionStatusStopped, isInstanceStatusPending, isInstanceActionStatusStopping, isInstanceStatusStarting, isInstanceStatusRestarting},
-		Target:  []string{isInstanceStatusRunning, isInstanceStatusFailed, ""},
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("instance %s to start", id),
+		Pending:     []string{isInstanceActionStatusStopped, isInstanceStatusPending, isInstanceActionStatusStopping, isInstanceStatusStarting, isInstanceStatusRestarting},
+		Target:      []string{isInstanceStatusRunning, ""},
+		Failed:      []string{isInstanceStatusFailed},
 		Refresh: func() (interface{}, string, error) {
 			getinsoptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
+			instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsoptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
 			}
@@ -3273,29 +3278,27 @@ func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			case data := <-communicator:
 				return nil, "", data.(error)
 			default:
-				fmt.Println("no message sent")
 			}
 			if *instance.Status == isInstanceStatusFailed {
 				// let know the isRestartStopAction() to stop
 				close(communicator)
-				return instance, *instance.Status, fmt.Errorf("[ERROR] The  instance %s failed to start: %v", id, err)
 			}
 			return instance, *instance.Status, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStopAction(ctx, instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
+func isRestartStopAction(ctx context.Context, instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
 	subticker := time.NewTicker(time.Duration(forceTimeout) * time.Minute)
 	//subticker := time.NewTicker(time.Duration(forceTimeout) * time.Second)
 	for {
@@ -3308,7 +3311,7 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err := instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
 				communicator <- fmt.Errorf("[ERROR] Error retrying instance action stop: %s\n%s", err, response)
 				return
@@ -3322,28 +3325,28 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 	}
 }
 
-func isWaitForInstanceVolumeAttached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeAttached(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) volume (%s) to be attached.", id, volID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isInstanceVolumeAttaching},
-		Target:     []string{isInstanceVolumeAttached, ""},
-		Refresh:    isInstanceVolumeRefreshFunc(instanceC, id, volID),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{isInstanceVolumeAttaching},
+		Target:   []string{isInstanceVolumeAttached, ""},
+		Refresh:  isInstanceVolumeRefreshFunc(ctx, instanceC, id, volID),
+		Timeout:  d.Timeout(schema.TimeoutUpdate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resource.StateRefreshFunc {
+func isInstanceVolumeRefreshFunc(ctx context.Context, instanceC *vpcv1.VpcV1, id, volID string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getvolattoptions := &vpcv1.GetInstanceVolumeAttachmentOptions{
 			InstanceID: &id,
 			ID:         &volID,
 		}
-		vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
+		vol, response, err := instanceC.GetInstanceVolumeAttachmentWithContext(ctx, getvolattoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Attaching volume: %s\n%s", err, response)
 		}
@@ -3356,9 +3359,9 @@ func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resou
 	}
 }
 
-func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeDetached(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
 		Target:  []string{isInstanceDeleteDone, ""},
 		Refresh: func() (interface{}, string, error) {
@@ -3366,7 +3369,7 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 				InstanceID: &id,
 				ID:         &volID,
 			}
-			vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
+			vol, response, err := instanceC.GetInstanceVolumeAttachmentWithContext(ctx, getvolattoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return vol, isInstanceDeleteDone, nil
@@ -3378,12 +3381,12 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 			}
 			return vol, isInstanceVolumeDetaching, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutUpdate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
 func resourceIbmIsInstanceInstanceDiskToMap(instanceDisk vpcv1.InstanceDisk) map[string]interface{} {
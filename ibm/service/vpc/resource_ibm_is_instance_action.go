@@ -151,12 +151,12 @@ func resourceIBMISInstanceActionCreate(context context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response))
 	}
 	if actiontype == "stop" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
+		_, err = isWaitForInstanceActionStop(context, sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	} else if actiontype == "start" || actiontype == "reboot" {
-		_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
+		_, err = isWaitForInstanceActionStart(context, sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -239,12 +239,12 @@ func resourceIBMISInstanceActionUpdate(context context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response))
 	}
 	if actiontype == "stop" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStop(context, sess, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	} else if actiontype == "start" || actiontype == "reboot" {
-		_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStart(context, sess, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
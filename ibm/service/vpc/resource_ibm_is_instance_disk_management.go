@@ -4,10 +4,12 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,11 +17,11 @@ const ()
 
 func ResourceIBMISInstanceDiskManagement() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisInstanceDiskManagementCreate,
-		Read:     resourceIBMisInstanceDiskManagementRead,
-		Update:   resourceIBMisInstanceDiskManagementUpdate,
-		Delete:   resourceIBMisInstanceDiskManagementDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisInstanceDiskManagementCreate,
+		ReadContext:   resourceIBMisInstanceDiskManagementRead,
+		UpdateContext: resourceIBMisInstanceDiskManagementUpdate,
+		DeleteContext: resourceIBMisInstanceDiskManagementDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"instance": {
@@ -69,10 +71,10 @@ func ResourceIBMISInstanceDiskManagementValidator() *validate.ResourceValidator
 	return &ibmISInstanceDiskManagementValidator
 }
 
-func resourceIBMisInstanceDiskManagementCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDiskManagementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instance := d.Get("instance").(string)
 	disks := d.Get("disks")
@@ -93,24 +95,24 @@ func resourceIBMisInstanceDiskManagementCreate(d *schema.ResourceData, meta inte
 
 		instanceDiskPatch, err := instanceDiskPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for InstanceDiskPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for InstanceDiskPatch: %s", err))
 		}
 		updateInstanceDiskOptions.SetInstanceDiskPatch(instanceDiskPatch)
 
-		_, response, err := sess.UpdateInstanceDisk(updateInstanceDiskOptions)
+		_, response, err := sess.UpdateInstanceDiskWithContext(ctx, updateInstanceDiskOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling UpdateInstanceDisk: %s %s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling UpdateInstanceDisk: %s %s", err, response))
 		}
 
 	}
 	d.SetId(instance)
-	return resourceIBMisInstanceDiskManagementRead(d, meta)
+	return resourceIBMisInstanceDiskManagementRead(ctx, d, meta)
 }
 
-func resourceIBMisInstanceDiskManagementUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDiskManagementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange("disks") && !d.IsNewResource() {
 
@@ -131,27 +133,27 @@ func resourceIBMisInstanceDiskManagementUpdate(d *schema.ResourceData, meta inte
 
 			instanceDiskPatch, err := instanceDiskPatchModel.AsPatch()
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error calling asPatch for InstanceDiskPatch: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for InstanceDiskPatch: %s", err))
 			}
 			updateInstanceDiskOptions.SetInstanceDiskPatch(instanceDiskPatch)
 
-			_, _, err = sess.UpdateInstanceDisk(updateInstanceDiskOptions)
+			_, _, err = sess.UpdateInstanceDiskWithContext(ctx, updateInstanceDiskOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating instance disk: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error updating instance disk: %s", err))
 			}
 
 		}
 	}
-	return resourceIBMisInstanceDiskManagementRead(d, meta)
+	return resourceIBMisInstanceDiskManagementRead(ctx, d, meta)
 }
 
-func resourceIBMisInstanceDiskManagementDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDiskManagementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMisInstanceDiskManagementRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDiskManagementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.Set("instance", d.Id())
 
@@ -13,9 +13,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -30,16 +31,16 @@ const (
 
 func ResourceIBMISInstanceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISInstanceGroupCreate,
-		Read:     resourceIBMISInstanceGroupRead,
-		Update:   resourceIBMISInstanceGroupUpdate,
-		Delete:   resourceIBMISInstanceGroupDelete,
-		Exists:   resourceIBMISInstanceGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISInstanceGroupCreate,
+		ReadContext:   resourceIBMISInstanceGroupRead,
+		UpdateContext: resourceIBMISInstanceGroupUpdate,
+		DeleteContext: resourceIBMISInstanceGroupDelete,
+		Exists:        resourceIBMISInstanceGroupExists,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -190,7 +191,7 @@ func ResourceIBMISInstanceGroupValidator() *validate.ResourceValidator {
 	return &ibmISInstanceGroupResourceValidator
 }
 
-func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := d.Get("name").(string)
 	instanceTemplate := d.Get("instance_template").(string)
@@ -199,7 +200,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var subnetIDs []vpcv1.SubnetIdentityIntf
@@ -243,15 +244,15 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 		instanceGroupOptions.ApplicationPort = &applicatioPort
 	}
 
-	instanceGroup, response, err := sess.CreateInstanceGroup(&instanceGroupOptions)
+	instanceGroup, response, err := sess.CreateInstanceGroupWithContext(ctx, &instanceGroupOptions)
 	if err != nil || instanceGroup == nil {
-		return fmt.Errorf("[ERROR] Error Creating InstanceGroup: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating InstanceGroup: %s\n%s", err, response))
 	}
 	d.SetId(*instanceGroup.ID)
 
-	_, healthError := waitForHealthyInstanceGroup(d.Id(), meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(ctx, d.Id(), meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
-		return healthError
+		return diag.FromErr(healthError)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -264,14 +265,14 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return resourceIBMISInstanceGroupRead(d, meta)
+	return resourceIBMISInstanceGroupRead(ctx, d, meta)
 
 }
 
-func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var changed bool
@@ -281,9 +282,9 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("tags") {
 		instanceGroupID := d.Id()
 		getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
-		instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+		instanceGroup, response, err := sess.GetInstanceGroupWithContext(ctx, &getInstanceGroupOptions)
 		if err != nil || instanceGroup == nil {
-			return fmt.Errorf("[ERROR] Error getting instance group: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting instance group: %s\n%s", err, response))
 		}
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN)
@@ -340,38 +341,38 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 		instanceGroupUpdateOptions.ID = &instanceGroupID
 		instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupPatch: %s", err))
 		}
 		instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
-		_, response, err := sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
+		_, response, err := sess.UpdateInstanceGroupWithContext(ctx, &instanceGroupUpdateOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating InstanceGroup: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Updating InstanceGroup: %s\n%s", err, response))
 		}
 
 		// wait for instance group health update with update timeout configured.
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(ctx, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
-			return healthError
+			return diag.FromErr(healthError)
 		}
 	}
-	return resourceIBMISInstanceGroupRead(d, meta)
+	return resourceIBMISInstanceGroupRead(ctx, d, meta)
 }
 
-func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceGroupID := d.Id()
 	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
-	instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+	instanceGroup, response, err := sess.GetInstanceGroupWithContext(ctx, &getInstanceGroupOptions)
 	if err != nil || instanceGroup == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response))
 	}
 	d.Set("name", *instanceGroup.Name)
 	d.Set("instance_template", *instanceGroup.InstanceTemplate.ID)
@@ -409,21 +410,21 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func getLBStatus(sess *vpcv1.VpcV1, lbId string) (string, error) {
+func getLBStatus(ctx context.Context, sess *vpcv1.VpcV1, lbId string) (string, error) {
 	getlboptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbId,
 	}
-	lb, response, err := sess.GetLoadBalancer(getlboptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 	if err != nil || lb == nil {
 		return "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
 	return *lb.ProvisioningStatus, nil
 }
 
-func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := d.Id()
 
@@ -432,12 +433,12 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 
 	// First, get the instance
 	igOpts := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
-	instanceGroup, response, err := sess.GetInstanceGroup(&igOpts)
+	instanceGroup, response, err := sess.GetInstanceGroupWithContext(ctx, &igOpts)
 	if err != nil || instanceGroup == nil {
 		if response != nil && response.StatusCode == 404 {
-			return fmt.Errorf("Instance Group with id:[%s] not found!!", instanceGroupID)
+			return diag.FromErr(fmt.Errorf("Instance Group with id:[%s] not found!!", instanceGroupID))
 		}
-		return fmt.Errorf("Internal Error fetching info for instance group [%s]", instanceGroupID)
+		return diag.FromErr(fmt.Errorf("Internal Error fetching info for instance group [%s]", instanceGroupID))
 	}
 	// Inorder to delete instance group, need to update membership count to 0
 	zeroMembers := int64(0)
@@ -447,18 +448,18 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	instanceGroupPatchModel.MembershipCount = &zeroMembers
 	instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error calling asPatch for ImagePatch: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for ImagePatch: %s", err))
 	}
 
 	instanceGroupUpdateOptions.ID = &instanceGroupID
 	instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
-	_, response, err = sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
+	_, response, err = sess.UpdateInstanceGroupWithContext(ctx, &instanceGroupUpdateOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating instanceGroup's instance count to 0 : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating instanceGroup's instance count to 0 : %s\n%s", err, response))
 	}
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+	_, healthError := waitForHealthyInstanceGroup(ctx, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 	if healthError != nil {
-		return healthError
+		return diag.FromErr(healthError)
 	}
 
 	// If there is any load balancer, please check if it is active
@@ -468,39 +469,39 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 		loadBalancerID := strings.Split(loadBalancerPool, "/")[5]
 
 		// Now check if the load balancer is in active state or not
-		lbStatus, err := getLBStatus(sess, loadBalancerID)
+		lbStatus, err := getLBStatus(ctx, sess, loadBalancerID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if lbStatus != "active" {
 			log.Printf("Load Balancer [%s] is not active....Waiting it to be active!\n", loadBalancerID)
-			_, err := isWaitForLBAvailable(sess, loadBalancerID, d.Timeout(schema.TimeoutDelete))
+			_, err := isWaitForLBAvailable(ctx, sess, loadBalancerID, d.Timeout(schema.TimeoutDelete))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			lbStatus, err = getLBStatus(sess, loadBalancerID)
+			lbStatus, err = getLBStatus(ctx, sess, loadBalancerID)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			if lbStatus != "active" {
-				return fmt.Errorf("LoadBalancer [%s] is not active yet! Current Load Balancer status is [%s]", loadBalancerID, lbStatus)
+				return diag.FromErr(fmt.Errorf("LoadBalancer [%s] is not active yet! Current Load Balancer status is [%s]", loadBalancerID, lbStatus))
 			}
 		}
 	}
 
 	deleteInstanceGroupOptions := vpcv1.DeleteInstanceGroupOptions{ID: &instanceGroupID}
-	response, Err := sess.DeleteInstanceGroup(&deleteInstanceGroupOptions)
+	response, Err := sess.DeleteInstanceGroupWithContext(ctx, &deleteInstanceGroupOptions)
 	if Err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting the InstanceGroup: %s\n%s", Err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting the InstanceGroup: %s\n%s", Err, response))
 	}
 
-	_, deleteError := waitForInstanceGroupDelete(d, meta)
+	_, deleteError := waitForInstanceGroupDelete(ctx, d, meta)
 	if deleteError != nil {
-		return deleteError
+		return diag.FromErr(deleteError)
 	}
 	return nil
 }
@@ -522,7 +523,7 @@ func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{})
 	return true, nil
 }
 
-func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeout time.Duration) (interface{}, error) {
+func waitForHealthyInstanceGroup(ctx context.Context, instanceGroupID string, meta interface{}, timeout time.Duration) (interface{}, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return nil, err
@@ -530,11 +531,11 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 
 	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 
-	healthStateConf := &resource.StateChangeConf{
+	healthStateConf := &waiter.StatusWaiter{
 		Pending: []string{SCALING},
 		Target:  []string{HEALTHY},
 		Refresh: func() (interface{}, string, error) {
-			instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+			instanceGroup, response, err := sess.GetInstanceGroupWithContext(ctx, &getInstanceGroupOptions)
 			if err != nil || instanceGroup == nil {
 				return nil, SCALING, fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
 			}
@@ -545,18 +546,17 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 			}
 			return instanceGroup, *instanceGroup.Status, nil
 		},
-		Timeout:      timeout,
-		Delay:        20 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 10 * time.Second,
+		Timeout:  timeout,
+		Delay:    20 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return healthStateConf.Wait(ctx)
 
 }
 
-func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
-	healthStateConf := &resource.StateChangeConf{
+func waitForInstanceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	healthStateConf := &waiter.StatusWaiter{
 		Pending: []string{HEALTHY},
 		Target:  []string{DELETING},
 		Refresh: func() (interface{}, string, error) {
@@ -566,12 +566,11 @@ func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (inter
 			}
 			return resp, DELETING, err
 		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        20 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutDelete),
+		Delay:    20 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return healthStateConf.Wait(ctx)
 
 }
@@ -4,22 +4,24 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMISInstanceGroupManager() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISInstanceGroupManagerCreate,
-		Read:     resourceIBMISInstanceGroupManagerRead,
-		Update:   resourceIBMISInstanceGroupManagerUpdate,
-		Delete:   resourceIBMISInstanceGroupManagerDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISInstanceGroupManagerCreate,
+		ReadContext:   resourceIBMISInstanceGroupManagerRead,
+		UpdateContext: resourceIBMISInstanceGroupManagerUpdate,
+		DeleteContext: resourceIBMISInstanceGroupManagerDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -179,14 +181,14 @@ func ResourceIBMISInstanceGroupManagerValidator() *validate.ResourceValidator {
 	return &ibmISInstanceGroupManagerResourceValidator
 }
 
-func resourceIBMISInstanceGroupManagerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	instanceGroupID := d.Get("instance_group").(string)
 	managerType := d.Get("manager_type").(string)
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if managerType == "scheduled" {
@@ -207,9 +209,9 @@ func resourceIBMISInstanceGroupManagerCreate(d *schema.ResourceData, meta interf
 			InstanceGroupID:               &instanceGroupID,
 			InstanceGroupManagerPrototype: &instanceGroupManagerPrototype,
 		}
-		instanceGroupManagerIntf, response, err := sess.CreateInstanceGroupManager(&createInstanceGroupManagerOptions)
+		instanceGroupManagerIntf, response, err := sess.CreateInstanceGroupManagerWithContext(ctx, &createInstanceGroupManagerOptions)
 		if err != nil || instanceGroupManagerIntf == nil {
-			return fmt.Errorf("[ERROR] Error creating InstanceGroup manager: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating InstanceGroup manager: %s\n%s", err, response))
 		}
 		instanceGroupManager := instanceGroupManagerIntf.(*vpcv1.InstanceGroupManager)
 		d.SetId(fmt.Sprintf("%s/%s", instanceGroupID, *instanceGroupManager.ID))
@@ -254,14 +256,14 @@ func resourceIBMISInstanceGroupManagerCreate(d *schema.ResourceData, meta interf
 			InstanceGroupManagerPrototype: &instanceGroupManagerPrototype,
 		}
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
+		_, healthError := waitForHealthyInstanceGroup(ctx, instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 		if healthError != nil {
-			return healthError
+			return diag.FromErr(healthError)
 		}
 
-		instanceGroupManagerIntf, response, err := sess.CreateInstanceGroupManager(&createInstanceGroupManagerOptions)
+		instanceGroupManagerIntf, response, err := sess.CreateInstanceGroupManagerWithContext(ctx, &createInstanceGroupManagerOptions)
 		if err != nil || instanceGroupManagerIntf == nil {
-			return fmt.Errorf("[ERROR] Error creating InstanceGroup manager: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating InstanceGroup manager: %s\n%s", err, response))
 		}
 		instanceGroupManager := instanceGroupManagerIntf.(*vpcv1.InstanceGroupManager)
 
@@ -269,14 +271,14 @@ func resourceIBMISInstanceGroupManagerCreate(d *schema.ResourceData, meta interf
 
 	}
 
-	return resourceIBMISInstanceGroupManagerRead(d, meta)
+	return resourceIBMISInstanceGroupManagerRead(ctx, d, meta)
 
 }
 
-func resourceIBMISInstanceGroupManagerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	managerType := d.Get("manager_type").(string)
@@ -325,7 +327,7 @@ func resourceIBMISInstanceGroupManagerUpdate(d *schema.ResourceData, meta interf
 	if changed {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		instanceGroupID := parts[0]
 		instanceGroupManagerID := parts[1]
@@ -333,32 +335,32 @@ func resourceIBMISInstanceGroupManagerUpdate(d *schema.ResourceData, meta interf
 		updateInstanceGroupManagerOptions.InstanceGroupID = &instanceGroupID
 		instanceGroupManagerPatch, err := instanceGroupManagerPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupManagerPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupManagerPatch: %s", err))
 		}
 		updateInstanceGroupManagerOptions.InstanceGroupManagerPatch = instanceGroupManagerPatch
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(ctx, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
-			return healthError
+			return diag.FromErr(healthError)
 		}
 
-		_, response, err := sess.UpdateInstanceGroupManager(&updateInstanceGroupManagerOptions)
+		_, response, err := sess.UpdateInstanceGroupManagerWithContext(ctx, &updateInstanceGroupManagerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating InstanceGroup manager: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating InstanceGroup manager: %s\n%s", err, response))
 		}
 	}
-	return resourceIBMISInstanceGroupManagerRead(d, meta)
+	return resourceIBMISInstanceGroupManagerRead(ctx, d, meta)
 }
 
-func resourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupManagerID := parts[1]
@@ -367,13 +369,13 @@ func resourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interfac
 		ID:              &instanceGroupManagerID,
 		InstanceGroupID: &instanceGroupID,
 	}
-	instanceGroupManagerIntf, response, err := sess.GetInstanceGroupManager(&getInstanceGroupManagerOptions)
+	instanceGroupManagerIntf, response, err := sess.GetInstanceGroupManagerWithContext(ctx, &getInstanceGroupManagerOptions)
 	if err != nil || instanceGroupManagerIntf == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager: %s\n%s", err, response))
 	}
 	instanceGroupManager := instanceGroupManagerIntf.(*vpcv1.InstanceGroupManager)
 
@@ -422,15 +424,15 @@ func resourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceIBMISInstanceGroupManagerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupManagerID := parts[1]
@@ -440,18 +442,18 @@ func resourceIBMISInstanceGroupManagerDelete(d *schema.ResourceData, meta interf
 		InstanceGroupID: &instanceGroupID,
 	}
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
+	_, healthError := waitForHealthyInstanceGroup(ctx, instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
-		return healthError
+		return diag.FromErr(healthError)
 	}
 
-	response, err := sess.DeleteInstanceGroupManager(&deleteInstanceGroupManagerOptions)
+	response, err := sess.DeleteInstanceGroupManagerWithContext(ctx, &deleteInstanceGroupManagerOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting the InstanceGroup Manager: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting the InstanceGroup Manager: %s\n%s", err, response))
 	}
 	return nil
 }
@@ -4,23 +4,25 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMISInstanceGroupManagerPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISInstanceGroupManagerPolicyCreate,
-		Read:     resourceIBMISInstanceGroupManagerPolicyRead,
-		Update:   resourceIBMISInstanceGroupManagerPolicyUpdate,
-		Delete:   resourceIBMISInstanceGroupManagerPolicyDelete,
-		Exists:   resourceIBMISInstanceGroupManagerPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISInstanceGroupManagerPolicyCreate,
+		ReadContext:   resourceIBMISInstanceGroupManagerPolicyRead,
+		UpdateContext: resourceIBMISInstanceGroupManagerPolicyUpdate,
+		DeleteContext: resourceIBMISInstanceGroupManagerPolicyDelete,
+		Exists:        resourceIBMISInstanceGroupManagerPolicyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -105,13 +107,13 @@ func ResourceIBMISInstanceGroupManagerPolicyValidator() *validate.ResourceValida
 	return &ibmISInstanceGroupManagerPolicyResourceValidator
 }
 
-func resourceIBMISInstanceGroupManagerPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceGroupID := d.Get("instance_group").(string)
 	instanceGroupManagerID := d.Get("instance_group_manager").(string)
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceGroupManagerPolicyPrototype := vpcv1.InstanceGroupManagerPolicyPrototype{}
@@ -133,30 +135,32 @@ func resourceIBMISInstanceGroupManagerPolicyCreate(d *schema.ResourceData, meta
 	}
 
 	isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-	conns.IbmMutexKV.Lock(isInsGrpKey)
-	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isInsGrpKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isInsGrpKey)
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(ctx, instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
-		return healthError
+		return diag.FromErr(healthError)
 	}
 
-	data, response, err := sess.CreateInstanceGroupManagerPolicy(&createInstanceGroupManagerPolicyOptions)
+	data, response, err := sess.CreateInstanceGroupManagerPolicyWithContext(ctx, &createInstanceGroupManagerPolicyOptions)
 	if err != nil || data == nil {
-		return fmt.Errorf("[ERROR] Error Creating InstanceGroup Manager Policy: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating InstanceGroup Manager Policy: %s\n%s", err, response))
 	}
 	instanceGroupManagerPolicy := data.(*vpcv1.InstanceGroupManagerPolicy)
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceGroupID, instanceGroupManagerID, *instanceGroupManagerPolicy.ID))
 
-	return resourceIBMISInstanceGroupManagerPolicyRead(d, meta)
+	return resourceIBMISInstanceGroupManagerPolicyRead(ctx, d, meta)
 
 }
 
-func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var changed bool
@@ -183,7 +187,7 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta
 	if changed {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		instanceGroupID := parts[0]
 		instanceGroupManagerID := parts[1]
@@ -194,31 +198,33 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta
 		updateInstanceGroupManagerPolicyOptions.InstanceGroupManagerID = &instanceGroupManagerID
 
 		isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-		conns.IbmMutexKV.Lock(isInsGrpKey)
-		defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+		if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isInsGrpKey); err != nil {
+			return diag.FromErr(err)
+		}
+		defer meta.(conns.ClientSession).MutexKV().Unlock(isInsGrpKey)
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(ctx, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
-			return healthError
+			return diag.FromErr(healthError)
 		}
 
-		_, response, err := sess.UpdateInstanceGroupManagerPolicy(&updateInstanceGroupManagerPolicyOptions)
+		_, response, err := sess.UpdateInstanceGroupManagerPolicyWithContext(ctx, &updateInstanceGroupManagerPolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating InstanceGroup Manager Policy: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Updating InstanceGroup Manager Policy: %s\n%s", err, response))
 		}
 	}
-	return resourceIBMISInstanceGroupManagerPolicyRead(d, meta)
+	return resourceIBMISInstanceGroupManagerPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupManagerID := parts[1]
@@ -229,13 +235,13 @@ func resourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta in
 		InstanceGroupID:        &instanceGroupID,
 		InstanceGroupManagerID: &instanceGroupManagerID,
 	}
-	data, response, err := sess.GetInstanceGroupManagerPolicy(&getInstanceGroupManagerPolicyOptions)
+	data, response, err := sess.GetInstanceGroupManagerPolicyWithContext(ctx, &getInstanceGroupManagerPolicyOptions)
 	if err != nil || data == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Policy: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Policy: %s\n%s", err, response))
 	}
 	instanceGroupManagerPolicy := data.(*vpcv1.InstanceGroupManagerPolicy)
 	d.Set("name", *instanceGroupManagerPolicy.Name)
@@ -249,14 +255,14 @@ func resourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceIBMISInstanceGroupManagerPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupManagerID := parts[1]
@@ -269,21 +275,23 @@ func resourceIBMISInstanceGroupManagerPolicyDelete(d *schema.ResourceData, meta
 	}
 
 	isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-	conns.IbmMutexKV.Lock(isInsGrpKey)
-	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isInsGrpKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isInsGrpKey)
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
+	_, healthError := waitForHealthyInstanceGroup(ctx, instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
-		return healthError
+		return diag.FromErr(healthError)
 	}
 
-	response, err := sess.DeleteInstanceGroupManagerPolicy(&deleteInstanceGroupManagerPolicyOptions)
+	response, err := sess.DeleteInstanceGroupManagerPolicyWithContext(ctx, &deleteInstanceGroupManagerPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting the InstanceGroup Manager Policy: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting the InstanceGroup Manager Policy: %s\n%s", err, response))
 	}
 	return nil
 }
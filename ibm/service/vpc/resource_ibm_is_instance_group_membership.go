@@ -4,11 +4,13 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -30,11 +32,11 @@ const (
 
 func ResourceIBMISInstanceGroupMembership() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISInstanceGroupMembershipUpdate,
-		Read:     resourceIBMISInstanceGroupMembershipRead,
-		Update:   resourceIBMISInstanceGroupMembershipUpdate,
-		Delete:   resourceIBMISInstanceGroupMembershipDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISInstanceGroupMembershipUpdate,
+		ReadContext:   resourceIBMISInstanceGroupMembershipRead,
+		UpdateContext: resourceIBMISInstanceGroupMembershipUpdate,
+		DeleteContext: resourceIBMISInstanceGroupMembershipDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -162,10 +164,10 @@ func ResourceIBMISInstanceGroupMembershipValidator() *validate.ResourceValidator
 	return &ibmISInstanceGroupMembershipResourceValidator
 }
 
-func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceGroupID := d.Get(isInstanceGroup).(string)
@@ -176,16 +178,16 @@ func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta int
 		InstanceGroupID: &instanceGroupID,
 	}
 
-	instanceGroupMembership, response, err := sess.GetInstanceGroupMembership(&getInstanceGroupMembershipOptions)
+	instanceGroupMembership, response, err := sess.GetInstanceGroupMembershipWithContext(ctx, &getInstanceGroupMembershipOptions)
 	if err != nil || instanceGroupMembership == nil {
-		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership: %s\n%s", err, response))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", instanceGroupID, instanceGroupMembershipID))
 
 	if v, ok := d.GetOk(isInstanceGroupMemershipActionDelete); ok {
 		actionDelete := v.(bool)
 		if actionDelete {
-			return resourceIBMISInstanceGroupMembershipDelete(d, meta)
+			return resourceIBMISInstanceGroupMembershipDelete(ctx, d, meta)
 		}
 	}
 
@@ -201,27 +203,27 @@ func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta int
 			updateInstanceGroupMembershipOptions.InstanceGroupID = &instanceGroupID
 			instanceGroupMembershipPatch, err := instanceGroupMembershipPatchModel.AsPatch()
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupMembershipPatch: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupMembershipPatch: %s", err))
 			}
 			updateInstanceGroupMembershipOptions.InstanceGroupMembershipPatch = instanceGroupMembershipPatch
-			_, response, err := sess.UpdateInstanceGroupMembership(&updateInstanceGroupMembershipOptions)
+			_, response, err := sess.UpdateInstanceGroupMembershipWithContext(ctx, &updateInstanceGroupMembershipOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating InstanceGroup Membership: %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error updating InstanceGroup Membership: %s\n%s", err, response))
 			}
 		}
 	}
-	return resourceIBMISInstanceGroupMembershipRead(d, meta)
+	return resourceIBMISInstanceGroupMembershipRead(ctx, d, meta)
 }
 
-func resourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupMembershipID := parts[1]
@@ -230,13 +232,13 @@ func resourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta inter
 		ID:              &instanceGroupMembershipID,
 		InstanceGroupID: &instanceGroupID,
 	}
-	instanceGroupMembership, response, err := sess.GetInstanceGroupMembership(&getInstanceGroupMembershipOptions)
+	instanceGroupMembership, response, err := sess.GetInstanceGroupMembershipWithContext(ctx, &getInstanceGroupMembershipOptions)
 	if err != nil || instanceGroupMembership == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership: %s\n%s", err, response))
 	}
 	d.Set(isInstanceGroupMemershipDeleteInstanceOnMembershipDelete, *instanceGroupMembership.DeleteInstanceOnMembershipDelete)
 	d.Set(isInstanceGroupMembership, *instanceGroupMembership.ID)
@@ -270,15 +272,15 @@ func resourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceIBMISInstanceGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupMembershipID := parts[1]
@@ -287,13 +289,13 @@ func resourceIBMISInstanceGroupMembershipDelete(d *schema.ResourceData, meta int
 		ID:              &instanceGroupMembershipID,
 		InstanceGroupID: &instanceGroupID,
 	}
-	response, err := sess.DeleteInstanceGroupMembership(&deleteInstanceGroupMembershipOptions)
+	response, err := sess.DeleteInstanceGroupMembershipWithContext(ctx, &deleteInstanceGroupMembershipOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting the InstanceGroup Membership: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting the InstanceGroup Membership: %s\n%s", err, response))
 	}
 	return nil
 }
@@ -12,8 +12,8 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -338,8 +338,10 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	}
 
 	isNICKey := "instance_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(context, isNICKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isNICKey)
 
 	networkInterface, response, err := vpcClient.CreateInstanceNetworkInterfaceWithContext(context, createInstanceNetworkInterfaceOptions)
 	if err != nil {
@@ -350,7 +352,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	d.SetId(fmt.Sprintf("%s/%s", *createInstanceNetworkInterfaceOptions.InstanceID, *networkInterface.ID))
 	d.Set("network_interface", *networkInterface.ID)
 
-	_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error occured while waiting for network interface %s", err))
 	}
@@ -370,14 +372,14 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 			d.Set(isInstanceNicFloatingIP, "")
 			return diag.FromErr(fmt.Errorf("[DEBUG] Error adding Floating IP to network interface %s\n%s", err, response))
 		}
-		_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error occured while waiting for network interface %s", err))
 		}
 
 	}
 
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -595,7 +597,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 				if err != nil {
 					return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response))
 				}
-				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -612,7 +614,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 				if err != nil {
 					return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response))
 				}
-				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -622,8 +624,10 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 	}
 	if hasChange {
 		isNICKey := "instance_key_" + instance_id
-		conns.IbmMutexKV.Lock(isNICKey)
-		defer conns.IbmMutexKV.Unlock(isNICKey)
+		if err := meta.(conns.ClientSession).MutexKV().Lock(context, isNICKey); err != nil {
+			return diag.FromErr(err)
+		}
+		defer meta.(conns.ClientSession).MutexKV().Unlock(isNICKey)
 		updateInstanceNetworkInterfaceOptions.NetworkInterfacePatch, _ = patchVals.AsPatch()
 		_, response, err := vpcClient.UpdateInstanceNetworkInterfaceWithContext(context, updateInstanceNetworkInterfaceOptions)
 		if err != nil {
@@ -685,11 +689,11 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 
 	}
 
-	_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error occured while waiting for network interface %s", err))
 	}
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -711,8 +715,10 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	instance_id := parts[0]
 	network_intf_id := parts[1]
 	isNICKey := "instance_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(context, isNICKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isNICKey)
 
 	deleteInstanceNetworkInterfaceOptions.SetInstanceID(instance_id)
 	deleteInstanceNetworkInterfaceOptions.SetID(network_intf_id)
@@ -723,12 +729,12 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 		return diag.FromErr(fmt.Errorf("DeleteInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
 	}
 
-	_, err = isWaitForNetworkInterfaceDelete(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceDelete(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error occured while waiting for network interface %s", err))
 	}
 
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -738,22 +744,24 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	return nil
 }
 
-func isWaitForNetworkInterfaceAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForNetworkInterfaceAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isNetworkInterfacePending},
-		Target:     []string{isNetworkInterfaceAvailable, isNetworkInterfaceFailed},
-		Refresh:    isNetworkInterfaceRefreshFunc(vpcClient, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("network interface %s to be available", id),
+		Pending:     []string{isNetworkInterfacePending},
+		Target:      []string{isNetworkInterfaceAvailable},
+		Failed:      []string{isNetworkInterfaceFailed},
+		Refresh:     isNetworkInterfaceRefreshFunc(vpcClient, id, d),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
+func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getInstanceNetworkInterfaceOptions := &vpcv1.GetInstanceNetworkInterfaceOptions{}
@@ -778,22 +786,22 @@ func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.
 	}
 }
 
-func isWaitForNetworkInterfaceDelete(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForNetworkInterfaceDelete(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isNetworkInterfacePending, isNetworkInterfaceDeleting, isNetworkInterfaceAvailable},
-		Target:     []string{isNetworkInterfaceDeleted},
-		Refresh:    isNetworkInterfaceRefreshDeleteFunc(vpcClient, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{isNetworkInterfacePending, isNetworkInterfaceDeleting, isNetworkInterfaceAvailable},
+		Target:   []string{isNetworkInterfaceDeleted},
+		Refresh:  isNetworkInterfaceRefreshDeleteFunc(vpcClient, id, d),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isNetworkInterfaceRefreshDeleteFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
+func isNetworkInterfaceRefreshDeleteFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getInstanceNetworkInterfaceOptions := &vpcv1.GetInstanceNetworkInterfaceOptions{}
@@ -13,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -62,17 +63,17 @@ const (
 
 func ResourceIBMISInstanceTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisInstanceTemplateCreate,
-		Read:     resourceIBMisInstanceTemplateRead,
-		Update:   resourceIBMisInstanceTemplateUpdate,
-		Delete:   resourceIBMisInstanceTemplateDelete,
-		Exists:   resourceIBMisInstanceTemplateExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisInstanceTemplateCreate,
+		ReadContext:   resourceIBMisInstanceTemplateRead,
+		UpdateContext: resourceIBMisInstanceTemplateUpdate,
+		DeleteContext: resourceIBMisInstanceTemplateDelete,
+		Exists:        resourceIBMisInstanceTemplateExists,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 
@@ -526,48 +527,48 @@ func ResourceIBMISInstanceTemplateValidator() *validate.ResourceValidator {
 	return &ibmISInstanceTemplateValidator
 }
 
-func resourceIBMisInstanceTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	profile := d.Get(isInstanceTemplateProfile).(string)
 	name := d.Get(isInstanceTemplateName).(string)
 	vpcID := d.Get(isInstanceTemplateVPC).(string)
 	zone := d.Get(isInstanceTemplateZone).(string)
 	image := d.Get(isInstanceTemplateImage).(string)
 
-	err := instanceTemplateCreate(d, meta, profile, name, vpcID, zone, image)
+	err := instanceTemplateCreate(ctx, d, meta, profile, name, vpcID, zone, image)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMisInstanceTemplateRead(d, meta)
+	return resourceIBMisInstanceTemplateRead(ctx, d, meta)
 }
 
-func resourceIBMisInstanceTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ID := d.Id()
-	err := instanceTemplateGet(d, meta, ID)
+	err := instanceTemplateGet(ctx, d, meta, ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceIBMisInstanceTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	ID := d.Id()
 
-	err := instanceTemplateDelete(d, meta, ID)
+	err := instanceTemplateDelete(ctx, d, meta, ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceIBMisInstanceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	err := instanceTemplateUpdate(d, meta)
+	err := instanceTemplateUpdate(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMisInstanceTemplateRead(d, meta)
+	return resourceIBMisInstanceTemplateRead(ctx, d, meta)
 }
 
 func resourceIBMisInstanceTemplateExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -579,7 +580,7 @@ func resourceIBMisInstanceTemplateExists(d *schema.ResourceData, meta interface{
 	return ok, err
 }
 
-func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
+func instanceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -963,7 +964,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 		InstanceTemplatePrototype: instanceproto,
 	}
 
-	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
+	instanceIntf, response, err := sess.CreateInstanceTemplateWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating InstanceTemplate: %s\n%s", err, response)
 	}
@@ -972,7 +973,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 	return nil
 }
 
-func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) error {
+func instanceTemplateGet(ctx context.Context, d *schema.ResourceData, meta interface{}, ID string) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -980,7 +981,7 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 	getinsOptions := &vpcv1.GetInstanceTemplateOptions{
 		ID: &ID,
 	}
-	instanceIntf, response, err := instanceC.GetInstanceTemplate(getinsOptions)
+	instanceIntf, response, err := instanceC.GetInstanceTemplateWithContext(ctx, getinsOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Instance template: %s\n%s", err, response)
 	}
@@ -1232,7 +1233,7 @@ func resourceIbmIsInstanceTemplateInstancePlacementTargetPrototypeToMap(instance
 	return instancePlacementTargetPrototypeMap
 }
 
-func instanceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func instanceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1254,7 +1255,7 @@ func instanceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updnetoptions.InstanceTemplatePatch = instanceTemplatePatch
 
-		_, _, err = instanceC.UpdateInstanceTemplate(updnetoptions)
+		_, _, err = instanceC.UpdateInstanceTemplateWithContext(ctx, updnetoptions)
 		if err != nil {
 			return err
 		}
@@ -1262,7 +1263,7 @@ func instanceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func instanceTemplateDelete(d *schema.ResourceData, meta interface{}, ID string) error {
+func instanceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, ID string) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1271,7 +1272,7 @@ func instanceTemplateDelete(d *schema.ResourceData, meta interface{}, ID string)
 	deleteinstanceTemplateOptions := &vpcv1.DeleteInstanceTemplateOptions{
 		ID: &ID,
 	}
-	_, err = instanceC.DeleteInstanceTemplate(deleteinstanceTemplateOptions)
+	_, err = instanceC.DeleteInstanceTemplateWithContext(ctx, deleteinstanceTemplateOptions)
 	if err != nil {
 		return err
 	}
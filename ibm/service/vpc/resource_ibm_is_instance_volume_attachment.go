@@ -14,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -36,12 +37,12 @@ const (
 
 func ResourceIBMISInstanceVolumeAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisInstanceVolumeAttachmentCreate,
-		Read:     resourceIBMisInstanceVolumeAttachmentRead,
-		Update:   resourceIBMisInstanceVolumeAttachmentUpdate,
-		Delete:   resourceIBMisInstanceVolumeAttachmentDelete,
-		Exists:   resourceIBMisInstanceVolumeAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisInstanceVolumeAttachmentCreate,
+		ReadContext:   resourceIBMisInstanceVolumeAttachmentRead,
+		UpdateContext: resourceIBMisInstanceVolumeAttachmentUpdate,
+		DeleteContext: resourceIBMisInstanceVolumeAttachmentDelete,
+		Exists:        resourceIBMisInstanceVolumeAttachmentExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -240,7 +241,7 @@ func ResourceIBMISInstanceVolumeAttachmentValidator() *validate.ResourceValidato
 	return &ibmISInstanceVolumeAttachmentValidator
 }
 
-func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, instanceId string) error {
+func instanceVolAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceId string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -280,7 +281,7 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 		}
 		var snapCapacity int64
 		if volSnapshotStr != "" {
-			snapshotGet, _, err := sess.GetSnapshot(&vpcv1.GetSnapshotOptions{
+			snapshotGet, _, err := sess.GetSnapshotWithContext(ctx, &vpcv1.GetSnapshotOptions{
 				ID: &volSnapshotStr,
 			})
 			if err != nil {
@@ -332,16 +333,18 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isInstanceKey); err != nil {
+		return err
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isInstanceKey)
 
-	instanceVolAtt, response, err := sess.CreateInstanceVolumeAttachment(instanceVolAttproto)
+	instanceVolAtt, response, err := sess.CreateInstanceVolumeAttachmentWithContext(ctx, instanceVolAttproto)
 	if err != nil {
 		log.Printf("[DEBUG] Instance volume attachment create err %s\n%s", err, response)
 		return fmt.Errorf("[ERROR] Error while attaching volume for instance %s: %q", instanceId, err)
 	}
 	d.SetId(makeTerraformVolAttID(instanceId, *instanceVolAtt.ID))
-	_, err = isWaitForInstanceVolumeAttached(sess, d, instanceId, *instanceVolAtt.ID)
+	_, err = isWaitForInstanceVolumeAttached(ctx, sess, d, instanceId, *instanceVolAtt.ID)
 	if err != nil {
 		return err
 	}
@@ -349,28 +352,28 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 	return nil
 }
 
-func resourceIBMisInstanceVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceId := d.Get(isInstanceId).(string)
-	err := instanceVolAttachmentCreate(d, meta, instanceId)
+	err := instanceVolAttachmentCreate(ctx, d, meta, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMisInstanceVolumeAttachmentRead(d, meta)
+	return resourceIBMisInstanceVolumeAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMisInstanceVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceVolumeAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceID, id, err := parseVolAttTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = instanceVolumeAttachmentGet(d, meta, instanceID, id)
+	err = instanceVolumeAttachmentGet(ctx, d, meta, instanceID, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func instanceVolumeAttachmentGet(d *schema.ResourceData, meta interface{}, instanceId, id string) error {
+func instanceVolumeAttachmentGet(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceId, id string) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -380,7 +383,7 @@ func instanceVolumeAttachmentGet(d *schema.ResourceData, meta interface{}, insta
 		InstanceID: &instanceId,
 		ID:         &id,
 	}
-	volumeAtt, response, err := instanceC.GetInstanceVolumeAttachment(getinsVolAttOptions)
+	volumeAtt, response, err := instanceC.GetInstanceVolumeAttachmentWithContext(ctx, getinsVolAttOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -412,7 +415,7 @@ func instanceVolumeAttachmentGet(d *schema.ResourceData, meta interface{}, insta
 	getVolOptions := &vpcv1.GetVolumeOptions{
 		ID: &volId,
 	}
-	volumeDetail, _, err := instanceC.GetVolume(getVolOptions)
+	volumeDetail, _, err := instanceC.GetVolumeWithContext(ctx, getVolOptions)
 	if err != nil || volumeDetail == nil {
 		return fmt.Errorf("[ERROR] Error while getting volume details of volume %s ", id)
 	}
@@ -430,7 +433,7 @@ func instanceVolumeAttachmentGet(d *schema.ResourceData, meta interface{}, insta
 	return nil
 }
 
-func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
+func instanceVolAttUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -465,7 +468,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updateInstanceVolAttOptions.VolumeAttachmentPatch = volAttNamePatchModelAsPatch
 
-		instanceVolAttUpdate, response, err := instanceC.UpdateInstanceVolumeAttachment(updateInstanceVolAttOptions)
+		instanceVolAttUpdate, response, err := instanceC.UpdateInstanceVolumeAttachmentWithContext(ctx, updateInstanceVolAttOptions)
 		if err != nil || instanceVolAttUpdate == nil {
 			log.Printf("[DEBUG] Instance volume attachment updation err %s\n%s", err, response)
 			return err
@@ -486,7 +489,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[ERROR] Error calling asPatch for VolumePatch: %s", err)
 		}
 		voloptions.VolumePatch = volumePatch
-		_, response, err := instanceC.UpdateVolume(voloptions)
+		_, response, err := instanceC.UpdateVolumeWithContext(ctx, voloptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating volume name : %s\n%s", err, response)
 		}
@@ -504,7 +507,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &insId,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 		if err != nil || instance == nil {
 			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", insId, err, response)
 		}
@@ -515,11 +518,11 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 				InstanceID: &insId,
 				Type:       &actiontype,
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", insId, err, response)
 			}
-			_, err = isWaitForInstanceAvailable(instanceC, insId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForInstanceAvailable(ctx, instanceC, insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				return err
 			}
@@ -547,11 +550,11 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[ERROR] Error calling asPatch for volumeProfilePatch: %s", err)
 		}
 		updateVolumeProfileOptions.VolumePatch = volumeProfilePatch
-		_, response, err = instanceC.UpdateVolume(updateVolumeProfileOptions)
+		_, response, err = instanceC.UpdateVolumeWithContext(ctx, updateVolumeProfileOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating volume profile/iops: %s\n%s", err, response)
 		}
-		isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+		isWaitForVolumeAvailable(ctx, instanceC, volId, d.Timeout(schema.TimeoutCreate))
 	}
 
 	// capacity update
@@ -561,7 +564,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		getvolumeoptions := &vpcv1.GetVolumeOptions{
 			ID: &volId,
 		}
-		vol, response, err := instanceC.GetVolume(getvolumeoptions)
+		vol, response, err := instanceC.GetVolumeWithContext(ctx, getvolumeoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Getting Volume (%s): %s\n%s", id, err, response)
 		}
@@ -573,7 +576,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &instanceId,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 		if err != nil || instance == nil {
 			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", instanceId, err, response)
 		}
@@ -583,11 +586,11 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 				InstanceID: &instanceId,
 				Type:       &actiontype,
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", instanceId, err, response)
 			}
-			_, err = isWaitForInstanceAvailable(instanceC, instanceId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForInstanceAvailable(ctx, instanceC, instanceId, d.Timeout(schema.TimeoutCreate), d)
 			return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", instanceId, err, response)
 		}
 		capacity := int64(d.Get(isVolumeCapacity).(int))
@@ -601,11 +604,11 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[ERROR] Error calling asPatch for volumeCapacityPatchModel: %s", err)
 		}
 		updateVolumeOptions.VolumePatch = volumeCapacityPatch
-		_, response, err = instanceC.UpdateVolume(updateVolumeOptions)
+		_, response, err = instanceC.UpdateVolumeWithContext(ctx, updateVolumeOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating volume capacity: %s\n%s", err, response)
 		}
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(ctx, instanceC, volId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -613,16 +616,16 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMisInstanceVolumeAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceVolumeAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	err := instanceVolAttUpdate(d, meta)
+	err := instanceVolAttUpdate(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMisInstanceVolumeAttachmentRead(d, meta)
+	return resourceIBMisInstanceVolumeAttachmentRead(ctx, d, meta)
 }
 
-func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId, id, volId string, volDelete bool) error {
+func instanceVolAttDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceId, id, volId string, volDelete bool) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -634,14 +637,16 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isInstanceKey); err != nil {
+		return err
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isInstanceKey)
 
-	_, err = instanceC.DeleteInstanceVolumeAttachment(deleteInstanceVolAttOptions)
+	_, err = instanceC.DeleteInstanceVolumeAttachmentWithContext(ctx, deleteInstanceVolAttOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while deleting volume attachment (%s) from instance (%s) : %q", id, instanceId, err)
 	}
-	_, err = isWaitForInstanceVolumeDetached(instanceC, d, instanceId, id)
+	_, err = isWaitForInstanceVolumeDetached(ctx, instanceC, d, instanceId, id)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while deleting volume attachment (%s) from instance (%s) on wait : %q", id, instanceId, err)
@@ -650,11 +655,11 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 		deleteVolumeOptions := &vpcv1.DeleteVolumeOptions{
 			ID: &volId,
 		}
-		response, err := instanceC.DeleteVolume(deleteVolumeOptions)
+		response, err := instanceC.DeleteVolumeWithContext(ctx, deleteVolumeOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error while deleting volume : %s\n%s", err, response)
 		}
-		_, err = isWaitForVolumeDeleted(instanceC, volId, d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForVolumeDeleted(ctx, instanceC, volId, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -662,10 +667,10 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 	return nil
 }
 
-func resourceIBMisInstanceVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceVolumeAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceId, id, err := parseVolAttTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	volDelete := false
@@ -677,9 +682,9 @@ func resourceIBMisInstanceVolumeAttachmentDelete(d *schema.ResourceData, meta in
 		volId = volIdOk.(string)
 	}
 
-	err = instanceVolAttDelete(d, meta, instanceId, id, volId, volDelete)
+	err = instanceVolAttDelete(ctx, d, meta, instanceId, id, volId, volDelete)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
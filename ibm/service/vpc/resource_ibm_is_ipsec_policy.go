@@ -4,12 +4,14 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -30,12 +32,12 @@ const (
 
 func ResourceIBMISIPSecPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISIPSecPolicyCreate,
-		Read:     resourceIBMISIPSecPolicyRead,
-		Update:   resourceIBMISIPSecPolicyUpdate,
-		Delete:   resourceIBMISIPSecPolicyDelete,
-		Exists:   resourceIBMISIPSecPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISIPSecPolicyCreate,
+		ReadContext:   resourceIBMISIPSecPolicyRead,
+		UpdateContext: resourceIBMISIPSecPolicyUpdate,
+		DeleteContext: resourceIBMISIPSecPolicyDelete,
+		Exists:        resourceIBMISIPSecPolicyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			isIpSecName: {
@@ -182,7 +184,7 @@ func ResourceIBMISIPSECValidator() *validate.ResourceValidator {
 	return &ibmISIPSECResourceValidator
 }
 
-func resourceIBMISIPSecPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] Ip Sec create")
 	name := d.Get(isIpSecName).(string)
@@ -190,14 +192,14 @@ func resourceIBMISIPSecPolicyCreate(d *schema.ResourceData, meta interface{}) er
 	encryptionAlg := d.Get(isIpSecEncryptionAlg).(string)
 	pfs := d.Get(isIpSecPFS).(string)
 
-	err := ipsecpCreate(d, meta, authenticationAlg, encryptionAlg, name, pfs)
+	err := ipsecpCreate(ctx, d, meta, authenticationAlg, encryptionAlg, name, pfs)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISIPSecPolicyRead(d, meta)
+	return resourceIBMISIPSecPolicyRead(ctx, d, meta)
 }
 
-func ipsecpCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, encryptionAlg, name, pfs string) error {
+func ipsecpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, authenticationAlg, encryptionAlg, name, pfs string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -223,7 +225,7 @@ func ipsecpCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, e
 			ID: &rg,
 		}
 	}
-	ipSec, response, err := sess.CreateIpsecPolicy(options)
+	ipSec, response, err := sess.CreateIpsecPolicyWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] ipSec policy err %s\n%s", err, response)
 	}
@@ -232,13 +234,13 @@ func ipsecpCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, e
 	return nil
 }
 
-func resourceIBMISIPSecPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
-	return ipsecpGet(d, meta, id)
+	return diag.FromErr(ipsecpGet(ctx, d, meta, id))
 }
 
-func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
+func ipsecpGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -246,7 +248,7 @@ func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getIpsecPolicyOptions := &vpcv1.GetIpsecPolicyOptions{
 		ID: &id,
 	}
-	ipSec, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
+	ipSec, response, err := sess.GetIpsecPolicyWithContext(ctx, getIpsecPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -291,18 +293,18 @@ func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISIPSecPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
-	err := ipsecpUpdate(d, meta, id)
+	err := ipsecpUpdate(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISIPSecPolicyRead(d, meta)
+	return resourceIBMISIPSecPolicyRead(ctx, d, meta)
 }
 
-func ipsecpUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+func ipsecpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -331,7 +333,7 @@ func ipsecpUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		options.IPsecPolicyPatch = ipsecPolicyPatch
 
-		_, response, err := sess.UpdateIpsecPolicy(options)
+		_, response, err := sess.UpdateIpsecPolicyWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error on update of IPSEC Policy(%s): %s\n%s", id, err, response)
 		}
@@ -339,13 +341,13 @@ func ipsecpUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISIPSecPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
-	return ipsecpDelete(d, meta, id)
+	return diag.FromErr(ipsecpDelete(ctx, d, meta, id))
 }
 
-func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func ipsecpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -353,7 +355,7 @@ func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getIpsecPolicyOptions := &vpcv1.GetIpsecPolicyOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
+	_, response, err := sess.GetIpsecPolicyWithContext(ctx, getIpsecPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -364,7 +366,7 @@ func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteIpsecPolicyOptions := &vpcv1.DeleteIpsecPolicyOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteIpsecPolicy(deleteIpsecPolicyOptions)
+	response, err = sess.DeleteIpsecPolicyWithContext(ctx, deleteIpsecPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting IPSEC Policy(%s): %s\n%s", id, err, response)
 	}
@@ -12,9 +12,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -46,12 +47,12 @@ const (
 
 func ResourceIBMISLB() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBCreate,
-		Read:     resourceIBMISLBRead,
-		Update:   resourceIBMISLBUpdate,
-		Delete:   resourceIBMISLBDelete,
-		Exists:   resourceIBMISLBExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBCreate,
+		ReadContext:   resourceIBMISLBRead,
+		UpdateContext: resourceIBMISLBUpdate,
+		DeleteContext: resourceIBMISLBDelete,
+		Exists:        resourceIBMISLBExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -62,7 +63,7 @@ func ResourceIBMISLB() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -292,7 +293,7 @@ func ResourceIBMISLBValidator() *validate.ResourceValidator {
 	return &ibmISLBResourceValidator
 }
 
-func resourceIBMISLBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := d.Get(isLBName).(string)
 	subnets := d.Get(isLBSubnets).(*schema.Set)
@@ -322,15 +323,15 @@ func resourceIBMISLBCreate(d *schema.ResourceData, meta interface{}) error {
 		rg = grp.(string)
 	}
 
-	err := lbCreate(d, meta, name, lbType, rg, subnets, isPublic, isLogging, securityGroups)
+	err := lbCreate(ctx, d, meta, name, lbType, rg, subnets, isPublic, isLogging, securityGroups)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBRead(d, meta)
+	return resourceIBMISLBRead(ctx, d, meta)
 }
 
-func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string, subnets *schema.Set, isPublic, isLogging bool, securityGroups *schema.Set) error {
+func lbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, lbType, rg string, subnets *schema.Set, isPublic, isLogging bool, securityGroups *schema.Set) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -391,13 +392,13 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 		options.Logging = loadBalancerLogging
 	}
 
-	lb, response, err := sess.CreateLoadBalancer(options)
+	lb, response, err := sess.CreateLoadBalancerWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating Load Balancer err %s\n%s", err, response)
 	}
 	d.SetId(*lb.ID)
 	log.Printf("[INFO] Load Balancer : %s", *lb.ID)
-	_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -413,18 +414,18 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 	return nil
 }
 
-func resourceIBMISLBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
-	err := lbGet(d, meta, id)
+	err := lbGet(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
+func lbGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -432,7 +433,7 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &id,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -550,7 +551,7 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISLBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	name := ""
@@ -577,15 +578,15 @@ func resourceIBMISLBUpdate(d *schema.ResourceData, meta interface{}) error {
 		hasChangedSecurityGroups = true
 	}
 
-	err := lbUpdate(d, meta, id, name, hasChanged, isLogging, hasChangedLog, hasChangedSecurityGroups, remove, add)
+	err := lbUpdate(ctx, d, meta, id, name, hasChanged, isLogging, hasChangedLog, hasChangedSecurityGroups, remove, add)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBRead(d, meta)
+	return resourceIBMISLBRead(ctx, d, meta)
 }
 
-func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool, isLogging bool, hasChangedLog bool, hasChangedSecurityGroups bool, remove, add []string) error {
+func lbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool, isLogging bool, hasChangedLog bool, hasChangedSecurityGroups bool, remove, add []string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -594,7 +595,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
-		lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+		lb, response, err := sess.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
 		}
@@ -618,7 +619,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		}
 		updateLoadBalancerOptions.LoadBalancerPatch = loadBalancerPatch
 
-		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
+		_, response, err := sess.UpdateLoadBalancerWithContext(ctx, updateLoadBalancerOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating vpc Load Balancer : %s\n%s", err, response)
 		}
@@ -642,7 +643,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		}
 		updateLoadBalancerOptions.LoadBalancerPatch = loadBalancerPatch
 
-		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
+		_, response, err := sess.UpdateLoadBalancerWithContext(ctx, updateLoadBalancerOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating vpc Load Balancer : %s\n%s", err, response)
 		}
@@ -655,11 +656,11 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 				createSecurityGroupTargetBindingOptions := &vpcv1.CreateSecurityGroupTargetBindingOptions{}
 				createSecurityGroupTargetBindingOptions.SecurityGroupID = &securityGroupID
 				createSecurityGroupTargetBindingOptions.ID = &id
-				_, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
+				_, response, err := sess.CreateSecurityGroupTargetBindingWithContext(ctx, createSecurityGroupTargetBindingOptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while creating Security Group Target Binding %s\n%s", err, response)
 				}
-				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForLBAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return err
 				}
@@ -672,7 +673,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 					SecurityGroupID: &securityGroupID,
 					ID:              &id,
 				}
-				_, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+				_, response, err := sess.GetSecurityGroupTargetWithContext(ctx, getSecurityGroupTargetOptions)
 				if err != nil {
 					if response != nil && response.StatusCode == 404 {
 						continue
@@ -680,11 +681,11 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 					return fmt.Errorf("[ERROR] Error Getting Security Group Target for this load balancer (%s): %s\n%s", securityGroupID, err, response)
 				}
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(securityGroupID, id)
-				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
+				response, err = sess.DeleteSecurityGroupTargetBindingWithContext(ctx, deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error Deleting Security Group Target for this load balancer : %s\n%s", err, response)
 				}
-				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForLBAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return err
 				}
@@ -694,19 +695,19 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 	return nil
 }
 
-func resourceIBMISLBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
-	err := lbDelete(d, meta, id)
+	err := lbDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func lbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -715,7 +716,7 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	_, response, err := sess.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -727,11 +728,11 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteLoadBalancerOptions := &vpcv1.DeleteLoadBalancerOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteLoadBalancer(deleteLoadBalancerOptions)
+	response, err = sess.DeleteLoadBalancerWithContext(ctx, deleteLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting vpc load balancer : %s\n%s", err, response)
 	}
-	_, err = isWaitForLBDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -739,28 +740,30 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBDeleted(ctx context.Context, lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBDeleting},
-		Target:     []string{isLBDeleted, "failed"},
-		Refresh:    isLBDeleteRefreshFunc(lbc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("load balancer %s to be deleted", id),
+		Pending:     []string{"retry", isLBDeleting},
+		Target:      []string{isLBDeleted},
+		Failed:      []string{"failed"},
+		Refresh:     isLBDeleteRefreshFunc(ctx, lbc, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLBDeleteRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is lb delete function here")
 		getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
-		lb, response, err := lbc.GetLoadBalancer(getLoadBalancerOptions)
+		lb, response, err := lbc.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lb, isLBDeleted, nil
@@ -797,33 +800,38 @@ func lbExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 	return true, nil
 }
 
-func isWaitForLBAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBAvailable(ctx context.Context, sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBProvisioning, "update_pending"},
-		Target:     []string{isLBProvisioningDone, ""},
-		Refresh:    isLBRefreshFunc(sess, lbId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("load balancer %s to be active", lbId),
+		Pending:     []string{"retry", isLBProvisioning, "update_pending"},
+		Target:      []string{isLBProvisioningDone, ""},
+		Failed:      []string{"failed"},
+		Refresh:     isLBRefreshFunc(ctx, sess, lbId),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
+func isLBRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, lbId string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getlboptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &lbId,
 		}
-		lb, response, err := sess.GetLoadBalancer(getlboptions)
+		lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 		}
 
-		if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
+		if *lb.ProvisioningStatus == "failed" {
+			return lb, *lb.ProvisioningStatus, nil
+		}
+		if *lb.ProvisioningStatus == "active" {
 			return lb, isLBProvisioningDone, nil
 		}
 
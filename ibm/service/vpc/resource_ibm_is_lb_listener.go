@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,8 +13,9 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -40,12 +42,12 @@ const (
 
 func ResourceIBMISLBListener() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBListenerCreate,
-		Read:     resourceIBMISLBListenerRead,
-		Update:   resourceIBMISLBListenerUpdate,
-		Delete:   resourceIBMISLBListenerDelete,
-		Exists:   resourceIBMISLBListenerExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBListenerCreate,
+		ReadContext:   resourceIBMISLBListenerRead,
+		UpdateContext: resourceIBMISLBListenerUpdate,
+		DeleteContext: resourceIBMISLBListenerDelete,
+		Exists:        resourceIBMISLBListenerExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -194,7 +196,7 @@ func ResourceIBMISLBListenerValidator() *validate.ResourceValidator {
 	return &ibmISLBListenerResourceValidator
 }
 
-func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] LB Listener create")
 	lbID := d.Get(isLBListenerLBID).(string)
@@ -206,7 +208,7 @@ func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) err
 	if pool, ok := d.GetOk(isLBListenerDefaultPool); ok {
 		lbPool, err := getPoolId(pool.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		defPool = lbPool
 	}
@@ -240,18 +242,20 @@ func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-	err := lbListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, listener, uri, port, portMin, portMax, connLimit, httpStatusCode)
+	err := lbListenerCreate(ctx, d, meta, lbID, protocol, defPool, certificateCRN, listener, uri, port, portMin, portMax, connLimit, httpStatusCode)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBListenerRead(d, meta)
+	return resourceIBMISLBListenerRead(ctx, d, meta)
 }
 
-func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol, defPool, certificateCRN, listener, uri string, port, portMin, portMax, connLimit, httpStatusCode int64) error {
+func lbListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, protocol, defPool, certificateCRN, listener, uri string, port, portMin, portMax, connLimit, httpStatusCode int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -265,7 +269,7 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	getlboptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getlboptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 
 	if err != nil || lb == nil {
 		return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
@@ -335,21 +339,21 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	if connLimit > int64(0) {
 		options.ConnectionLimit = &connLimit
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	lbListener, response, err := sess.CreateLoadBalancerListener(options)
+	lbListener, response, err := sess.CreateLoadBalancerListenerWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating Load Balanacer Listener err %s\n%s", err, response)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
-	_, err = isWaitForLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBListenerAvailable(ctx, sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", lbID, err)
 	}
@@ -358,34 +362,39 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	return nil
 }
 
-func isWaitForLBListenerAvailable(sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerAvailable(ctx context.Context, sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer Listener(%s) to be available.", lbListenerID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerProvisioningDone, ""},
-		Refresh:    isLBListenerRefreshFunc(sess, lbID, lbListenerID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("load balancer listener %s to be active", lbListenerID),
+		Pending:     []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:      []string{isLBListenerProvisioningDone, ""},
+		Failed:      []string{"failed"},
+		Refresh:     isLBListenerRefreshFunc(ctx, sess, lbID, lbListenerID),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
+func isLBListenerRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, lbID, lbListenerID string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getLoadBalancerListenerOptions := &vpcv1.GetLoadBalancerListenerOptions{
 			LoadBalancerID: &lbID,
 			ID:             &lbListenerID,
 		}
-		lblis, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
+		lblis, response, err := sess.GetLoadBalancerListenerWithContext(ctx, getLoadBalancerListenerOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Listener: %s\n%s", err, response)
 		}
 
-		if *lblis.ProvisioningStatus == "active" || *lblis.ProvisioningStatus == "failed" {
+		if *lblis.ProvisioningStatus == "failed" {
+			return lblis, *lblis.ProvisioningStatus, nil
+		}
+		if *lblis.ProvisioningStatus == "active" {
 			return lblis, isLBListenerProvisioningDone, nil
 		}
 
@@ -393,25 +402,25 @@ func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resou
 	}
 }
 
-func resourceIBMISLBListenerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
 	lbListenerID := parts[1]
 
-	err = lbListenerGet(d, meta, lbID, lbListenerID)
+	err = lbListenerGet(ctx, d, meta, lbID, lbListenerID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
+func lbListenerGet(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -420,7 +429,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 		LoadBalancerID: &lbID,
 		ID:             &lbListenerID,
 	}
-	lbListener, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
+	lbListener, response, err := sess.GetLoadBalancerListenerWithContext(ctx, getLoadBalancerListenerOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -461,7 +470,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
@@ -469,25 +478,25 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 	return nil
 }
 
-func resourceIBMISLBListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
 	lbListenerID := parts[1]
 
-	err = lbListenerUpdate(d, meta, lbID, lbListenerID)
+	err = lbListenerUpdate(ctx, d, meta, lbID, lbListenerID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBListenerRead(d, meta)
+	return resourceIBMISLBListenerRead(ctx, d, meta)
 }
 
-func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
+func lbListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -595,26 +604,28 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		updateLoadBalancerListenerOptions.LoadBalancerListenerPatch = loadBalancerListenerPatch
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+			return err
+		}
+		defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
-		_, response, err := sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
+		_, response, err := sess.UpdateLoadBalancerListenerWithContext(ctx, updateLoadBalancerListenerOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Load Balancer Listener : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBListenerAvailable(ctx, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer (%s) to become ready: %s", lbID, err)
@@ -623,29 +634,31 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	return nil
 }
 
-func resourceIBMISLBListenerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
 	lbListenerID := parts[1]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-	err = lbListenerDelete(d, meta, lbID, lbListenerID)
+	err = lbListenerDelete(ctx, d, meta, lbID, lbListenerID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
+func lbListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -654,7 +667,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		LoadBalancerID: &lbID,
 		ID:             &lbListenerID,
 	}
-	_, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
+	_, response, err := sess.GetLoadBalancerListenerWithContext(ctx, getLoadBalancerListenerOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -663,7 +676,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		}
 		return fmt.Errorf("[ERROR] Error Getting vpc load balancer listener(%s): %s\n%s", lbListenerID, err, response)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -671,15 +684,15 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		LoadBalancerID: &lbID,
 		ID:             &lbListenerID,
 	}
-	response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
+	response, err = sess.DeleteLoadBalancerListenerWithContext(ctx, deleteLoadBalancerListenerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
-	_, err = isWaitForLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBListenerDeleted(ctx, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to be active: %s", lbID, err)
 	}
@@ -688,28 +701,28 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	return nil
 }
 
-func isWaitForLBListenerDeleted(lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerDeleted(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbListenerID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerDeleting, "delete_pending"},
-		Target:     []string{isLBListenerDeleted, ""},
-		Refresh:    isLBListenerDeleteRefreshFunc(lbc, lbID, lbListenerID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", isLBListenerDeleting, "delete_pending"},
+		Target:   []string{isLBListenerDeleted, ""},
+		Refresh:  isLBListenerDeleteRefreshFunc(ctx, lbc, lbID, lbListenerID),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
+func isLBListenerDeleteRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbListenerID string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getLoadBalancerListenerOptions := &vpcv1.GetLoadBalancerListenerOptions{
 			LoadBalancerID: &lbID,
 			ID:             &lbListenerID,
 		}
-		lbLis, response, err := lbc.GetLoadBalancerListener(getLoadBalancerListenerOptions)
+		lbLis, response, err := lbc.GetLoadBalancerListenerWithContext(ctx, getLoadBalancerListenerOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lbLis, isLBListenerDeleted, nil
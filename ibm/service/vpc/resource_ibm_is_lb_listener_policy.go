@@ -13,9 +13,10 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -52,12 +53,12 @@ const (
 
 func ResourceIBMISLBListenerPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBListenerPolicyCreate,
-		Read:     resourceIBMISLBListenerPolicyRead,
-		Update:   resourceIBMISLBListenerPolicyUpdate,
-		Delete:   resourceIBMISLBListenerPolicyDelete,
-		Exists:   resourceIBMISLBListenerPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBListenerPolicyCreate,
+		ReadContext:   resourceIBMISLBListenerPolicyRead,
+		UpdateContext: resourceIBMISLBListenerPolicyUpdate,
+		DeleteContext: resourceIBMISLBListenerPolicyDelete,
+		Exists:        resourceIBMISLBListenerPolicyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
@@ -287,7 +288,7 @@ func ResourceIBMISLBListenerPolicyValidator() *validate.ResourceValidator {
 	return &ibmISLBListenerPolicyResourceValidator
 }
 
-func resourceIBMISLBListenerPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	//Get the Load balancer ID
 	lbID := d.Get(isLBListenerPolicyLBID).(string)
@@ -295,7 +296,7 @@ func resourceIBMISLBListenerPolicyCreate(d *schema.ResourceData, meta interface{
 	//User can set listener id as combination of lbID/listenerID, parse and get the listenerID
 	listenerID, err := getListenerID(d.Get(isLBListenerPolicyListenerID).(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	action := d.Get(isLBListenerPolicyAction).(string)
@@ -307,12 +308,12 @@ func resourceIBMISLBListenerPolicyCreate(d *schema.ResourceData, meta interface{
 		name = n.(string)
 	}
 
-	err = lbListenerPolicyCreate(d, meta, lbID, listenerID, action, name, priority)
+	err = lbListenerPolicyCreate(ctx, d, meta, lbID, listenerID, action, name, priority)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBListenerPolicyRead(d, meta)
+	return resourceIBMISLBListenerPolicyRead(ctx, d, meta)
 }
 
 func getListenerID(id string) (string, error) {
@@ -341,7 +342,7 @@ func getPoolID(id string) (string, error) {
 
 }
 
-func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) error {
+func lbListenerPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) error {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -472,51 +473,53 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+		return err
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	policy, response, err := sess.CreateLoadBalancerListenerPolicy(options)
+	policy, response, err := sess.CreateLoadBalancerListenerPolicyWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, listenerID, *(policy.ID)))
 
-	_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func isWaitForLbAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyPending},
-		Target:     []string{isLBProvisioningDone},
-		Refresh:    isLbRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{isLBListenerPolicyPending},
+		Target:   []string{isLBProvisioningDone},
+		Refresh:  isLbRefreshFunc(ctx, vpc, id),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLbRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getLbOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
 
-		lb, _, err := vpc.GetLoadBalancer(getLbOptions)
+		lb, _, err := vpc.GetLoadBalancerWithContext(ctx, getLbOptions)
 		if err != nil {
 			return nil, "", err
 		}
@@ -529,21 +532,23 @@ func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	}
 }
 
-func isWaitForLbListenerPolicyAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerProvisioningDone},
-		Refresh:    isLbListenerPolicyRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+func isWaitForLbListenerPolicyAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("load balancer listener policy %s to be active", id),
+		Pending:     []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:      []string{isLBListenerProvisioningDone},
+		Failed:      []string{isLBListenerPolicyFailed},
+		Refresh:     isLbListenerPolicyRefreshFunc(ctx, vpc, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLbListenerPolicyRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		parts, err := flex.IdParts(id)
@@ -561,13 +566,16 @@ func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRe
 			ID:             &policyID,
 		}
 
-		policy, _, err := vpc.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
+		policy, _, err := vpc.GetLoadBalancerListenerPolicyWithContext(ctx, getLbListenerPolicyOptions)
 
 		if err != nil {
 			return policy, "", err
 		}
 
-		if *policy.ProvisioningStatus == isLBListenerPolicyAvailable || *policy.ProvisioningStatus == isLBListenerPolicyFailed {
+		if *policy.ProvisioningStatus == isLBListenerPolicyFailed {
+			return policy, *policy.ProvisioningStatus, nil
+		}
+		if *policy.ProvisioningStatus == isLBListenerPolicyAvailable {
 			return policy, isLBListenerProvisioningDone, nil
 		}
 
@@ -575,21 +583,21 @@ func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRe
 	}
 }
 
-func resourceIBMISLBListenerPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	ID := d.Id()
 	parts, err := flex.IdParts(ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
 	listenerID := parts[1]
 	policyID := parts[2]
 
-	err = lbListenerPolicyGet(d, meta, lbID, listenerID, policyID)
+	err = lbListenerPolicyGet(ctx, d, meta, lbID, listenerID, policyID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -638,26 +646,26 @@ func lbListenerPolicyExists(d *schema.ResourceData, meta interface{}, ID string)
 	}
 	return true, nil
 }
-func resourceIBMISLBListenerPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
 	listenerID := parts[1]
 	policyID := parts[2]
 
-	err = lbListenerPolicyUpdate(d, meta, lbID, listenerID, policyID)
+	err = lbListenerPolicyUpdate(ctx, d, meta, lbID, listenerID, policyID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBListenerPolicyRead(d, meta)
+	return resourceIBMISLBListenerPolicyRead(ctx, d, meta)
 }
 
-func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
+func lbListenerPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -775,20 +783,22 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+			return err
+		}
+		defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
-		_, response, err := sess.UpdateLoadBalancerListenerPolicy(&updatePolicyOptions)
+		_, response, err := sess.UpdateLoadBalancerListenerPolicyWithContext(ctx, &updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating in policy : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -796,12 +806,12 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 	return nil
 }
 
-func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	//Retrieve lbId, listenerId and policyID
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -809,12 +819,14 @@ func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{
 	policyID := parts[2]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-	err = lbListenerPolicyDelete(d, meta, lbID, listenerID, policyID)
+	err = lbListenerPolicyDelete(ctx, d, meta, lbID, listenerID, policyID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -822,7 +834,7 @@ func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{
 
 }
 
-func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
+func lbListenerPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -836,7 +848,7 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 	}
 
 	//Getting lb listener policy
-	_, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
+	_, response, err := sess.GetLoadBalancerListenerPolicyWithContext(ctx, getLbListenerPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -850,37 +862,39 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 		ID:             &ID,
 	}
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	response, err = sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
+	response, err = sess.DeleteLoadBalancerListenerPolicyWithContext(ctx, deleteLbListenerPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error in lbListenerPolicyDelete: %s\n%s", err, response)
 	}
-	_, err = isWaitForLbListnerPolicyDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyDeleted(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting},
-		Target:     []string{isLBListenerPolicyFailed, isLBListenerPolicyDeleted},
-		Refresh:    isLbListenerPolicyDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+func isWaitForLbListnerPolicyDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("load balancer listener policy %s to be deleted", id),
+		Pending:     []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting},
+		Target:      []string{isLBListenerPolicyDeleted},
+		Failed:      []string{isLBListenerPolicyFailed},
+		Refresh:     isLbListenerPolicyDeleteRefreshFunc(ctx, vpc, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLbListenerPolicyDeleteRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		//Retrieve lbId, listenerId and policyID
@@ -900,7 +914,7 @@ func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.S
 		}
 
 		//Getting lb listener policy
-		policy, response, err := vpc.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
+		policy, response, err := vpc.GetLoadBalancerListenerPolicyWithContext(ctx, getLbListenerPolicyOptions)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
@@ -912,7 +926,7 @@ func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.S
 	}
 }
 
-func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listenerID, id string) error {
+func lbListenerPolicyGet(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, id string) error {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -927,7 +941,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 	}
 
 	//Getting lb listener policy
-	policy, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
+	policy, response, err := sess.GetLoadBalancerListenerPolicyWithContext(ctx, getLbListenerPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -959,7 +973,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 			}
 
 			//Getting lb listener policy rule
-			rule, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+			rule, response, err := sess.GetLoadBalancerListenerPolicyRuleWithContext(ctx, getLbListenerPolicyRuleOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					d.SetId("")
@@ -1013,7 +1027,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
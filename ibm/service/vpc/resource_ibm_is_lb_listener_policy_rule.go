@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -11,7 +12,8 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -39,12 +41,12 @@ const (
 
 func ResourceIBMISLBListenerPolicyRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBListenerPolicyRuleCreate,
-		Read:     resourceIBMISLBListenerPolicyRuleRead,
-		Update:   resourceIBMISLBListenerPolicyRuleUpdate,
-		Delete:   resourceIBMISLBListenerPolicyRuleDelete,
-		Exists:   resourceIBMISLBListenerPolicyRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBListenerPolicyRuleCreate,
+		ReadContext:   resourceIBMISLBListenerPolicyRuleRead,
+		UpdateContext: resourceIBMISLBListenerPolicyRuleUpdate,
+		DeleteContext: resourceIBMISLBListenerPolicyRuleDelete,
+		Exists:        resourceIBMISLBListenerPolicyRuleExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -183,19 +185,19 @@ func ResourceIBMISLBListenerPolicyRuleValidator() *validate.ResourceValidator {
 	return &ibmISLBListenerPolicyRuleResourceValidator
 }
 
-func resourceIBMISLBListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	//Read lb, listerner, policy IDs
 	var field string
 	lbID := d.Get(isLBListenerPolicyRuleLBID).(string)
 	listenerID, err := getLbListenerID(d.Get(isLBListenerPolicyRuleListenerID).(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	policyID, err := getLbPolicyID(d.Get(isLBListenerPolicyRulePolicyID).(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	condition := d.Get(isLBListenerPolicyRulecondition).(string)
@@ -205,12 +207,12 @@ func resourceIBMISLBListenerPolicyRuleCreate(d *schema.ResourceData, meta interf
 		field = n.(string)
 	}
 
-	err = lbListenerPolicyRuleCreate(d, meta, lbID, listenerID, policyID, condition, ty, value, field)
+	err = lbListenerPolicyRuleCreate(ctx, d, meta, lbID, listenerID, policyID, condition, ty, value, field)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBListenerPolicyRuleRead(d, meta)
+	return resourceIBMISLBListenerPolicyRuleRead(ctx, d, meta)
 }
 
 func getLbListenerID(id string) (string, error) {
@@ -244,7 +246,7 @@ func vpcSdkClient(meta interface{}) (*vpcv1.VpcV1, error) {
 	return sess, err
 }
 
-func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, condition, ty, value, field string) error {
+func lbListenerPolicyRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, condition, ty, value, field string) error {
 
 	sess, err := vpcSdkClient(meta)
 	if err != nil {
@@ -262,23 +264,25 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+		return err
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-	_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLoadbalancerAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	rule, response, err := sess.CreateLoadBalancerListenerPolicyRule(options)
+	rule, response, err := sess.CreateLoadBalancerListenerPolicyRuleWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", lbID, listenerID, policyID, *(rule.ID)))
 
-	_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyRuleAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -286,28 +290,28 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 	return nil
 }
 
-func isWaitForLoadbalancerAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLoadbalancerAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyRulePending},
-		Target:     []string{isLBProvisioningDone},
-		Refresh:    isLoadbalancerRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{isLBListenerPolicyRulePending},
+		Target:   []string{isLBProvisioningDone},
+		Refresh:  isLoadbalancerRefreshFunc(ctx, vpc, id),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLoadbalancerRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getLbOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
 
-		lb, _, err := vpc.GetLoadBalancer(getLbOptions)
+		lb, _, err := vpc.GetLoadBalancerWithContext(ctx, getLbOptions)
 		if err != nil {
 			return nil, "", err
 		}
@@ -320,21 +324,23 @@ func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefres
 	}
 }
 
-func isWaitForLbListenerPolicyRuleAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyRuleAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerPolicyRuleProvisioningDone},
-		Refresh:    isLbListenerPolicyRuleRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("load balancer listener policy rule %s to be available", id),
+		Pending:     []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:      []string{isLBListenerPolicyRuleProvisioningDone},
+		Failed:      []string{isLBListenerPolicyRuleFailed},
+		Refresh:     isLbListenerPolicyRuleRefreshFunc(ctx, vpc, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLbListenerPolicyRuleRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		parts, err := flex.IdParts(id)
@@ -354,13 +360,16 @@ func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.Sta
 			ID:             &ruleID,
 		}
 
-		rule, _, err := vpc.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+		rule, _, err := vpc.GetLoadBalancerListenerPolicyRuleWithContext(ctx, getLbListenerPolicyRuleOptions)
 
 		if err != nil {
 			return rule, "", err
 		}
 
-		if *rule.ProvisioningStatus == isLBListenerPolicyRuleAvailable || *rule.ProvisioningStatus == isLBListenerPolicyRuleFailed {
+		if *rule.ProvisioningStatus == isLBListenerPolicyRuleFailed {
+			return rule, *rule.ProvisioningStatus, nil
+		}
+		if *rule.ProvisioningStatus == isLBListenerPolicyRuleAvailable {
 			return rule, isLBListenerPolicyRuleProvisioningDone, nil
 		}
 
@@ -368,12 +377,12 @@ func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.Sta
 	}
 }
 
-func resourceIBMISLBListenerPolicyRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	ID := d.Id()
 	parts, err := flex.IdParts(ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -381,9 +390,9 @@ func resourceIBMISLBListenerPolicyRuleRead(d *schema.ResourceData, meta interfac
 	policyID := parts[2]
 	ruleID := parts[3]
 
-	err = lbListenerPolicyRuleGet(d, meta, lbID, listenerID, policyID, ruleID)
+	err = lbListenerPolicyRuleGet(ctx, d, meta, lbID, listenerID, policyID, ruleID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -433,11 +442,11 @@ func lbListenerPolicyRuleExists(d *schema.ResourceData, meta interface{}, ID str
 	}
 	return true, nil
 }
-func resourceIBMISLBListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -445,15 +454,15 @@ func resourceIBMISLBListenerPolicyRuleUpdate(d *schema.ResourceData, meta interf
 	policyID := parts[2]
 	ruleID := parts[3]
 
-	err = lbListenerPolicyRuleUpdate(d, meta, lbID, listenerID, policyID, ruleID)
+	err = lbListenerPolicyRuleUpdate(ctx, d, meta, lbID, listenerID, policyID, ruleID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBListenerPolicyRuleRead(d, meta)
+	return resourceIBMISLBListenerPolicyRuleRead(ctx, d, meta)
 }
 
-func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
+func lbListenerPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
 	sess, err := vpcSdkClient(meta)
 	if err != nil {
 		return err
@@ -499,21 +508,23 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 		updatePolicyRuleOptions.LoadBalancerListenerPolicyRulePatch = loadBalancerListenerPolicyRulePatch
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+			return err
+		}
+		defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-		_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLoadbalancerAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 
-		_, response, err := sess.UpdateLoadBalancerListenerPolicyRule(&updatePolicyRuleOptions)
+		_, response, err := sess.UpdateLoadBalancerListenerPolicyRuleWithContext(ctx, &updatePolicyRuleOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating in policy : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyRuleAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -521,12 +532,12 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 	return nil
 }
 
-func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	//Retrieve lbId, listenerId and policyID
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -535,12 +546,14 @@ func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interf
 	ruleID := parts[3]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-	err = lbListenerPolicyRuleDelete(d, meta, lbID, listenerID, policyID, ruleID)
+	err = lbListenerPolicyRuleDelete(ctx, d, meta, lbID, listenerID, policyID, ruleID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -548,7 +561,7 @@ func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interf
 
 }
 
-func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
+func lbListenerPolicyRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
 
 	sess, err := vpcSdkClient(meta)
 	if err != nil {
@@ -564,7 +577,7 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 	}
 
 	//Getting lb listener policy
-	_, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+	_, response, err := sess.GetLoadBalancerListenerPolicyRuleWithContext(ctx, getLbListenerPolicyRuleOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -579,31 +592,33 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 		PolicyID:       &policyID,
 		ID:             &ID,
 	}
-	response, err = sess.DeleteLoadBalancerListenerPolicyRule(deleteLbListenerPolicyRuleOptions)
+	response, err = sess.DeleteLoadBalancerListenerPolicyRuleWithContext(ctx, deleteLbListenerPolicyRuleOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error in lbListenerPolicyRuleDelete: %s\n%s", err, response)
 	}
-	_, err = isWaitForLbListnerPolicyRuleDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyRuleDeleted(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyRuleDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListnerPolicyRuleDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting},
-		Target:     []string{isLBListenerPolicyRuleDeleted, isLBListenerPolicyRuleFailed},
-		Refresh:    isLbListenerPolicyRuleDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("load balancer listener policy rule %s to be deleted", id),
+		Pending:     []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting},
+		Target:      []string{isLBListenerPolicyRuleDeleted},
+		Failed:      []string{isLBListenerPolicyRuleFailed},
+		Refresh:     isLbListenerPolicyRuleDeleteRefreshFunc(ctx, vpc, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLbListenerPolicyRuleDeleteRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		//Retrieve lbId, listenerId and policyID
@@ -625,7 +640,7 @@ func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resour
 		}
 
 		//Getting lb listener policy
-		rule, response, err := vpc.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+		rule, response, err := vpc.GetLoadBalancerListenerPolicyRuleWithContext(ctx, getLbListenerPolicyRuleOptions)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
@@ -637,7 +652,7 @@ func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resour
 	}
 }
 
-func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, id string) error {
+func lbListenerPolicyRuleGet(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, id string) error {
 
 	sess, err := vpcSdkClient(meta)
 	if err != nil {
@@ -653,7 +668,7 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 	}
 
 	//Getting lb listener policy
-	rule, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+	rule, response, err := sess.GetLoadBalancerListenerPolicyRuleWithContext(ctx, getLbListenerPolicyRuleOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -675,7 +690,7 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
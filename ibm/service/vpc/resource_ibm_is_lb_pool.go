@@ -12,9 +12,10 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -46,12 +47,12 @@ const (
 
 func ResourceIBMISLBPool() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBPoolCreate,
-		Read:     resourceIBMISLBPoolRead,
-		Update:   resourceIBMISLBPoolUpdate,
-		Delete:   resourceIBMISLBPoolDelete,
-		Exists:   resourceIBMISLBPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBPoolCreate,
+		ReadContext:   resourceIBMISLBPoolRead,
+		UpdateContext: resourceIBMISLBPoolUpdate,
+		DeleteContext: resourceIBMISLBPoolDelete,
+		Exists:        resourceIBMISLBPoolExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -247,7 +248,7 @@ func ResourceIBMISLBPoolValidator() *validate.ResourceValidator {
 	return &ibmISLBPoolResourceValidator
 }
 
-func resourceIBMISLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] LB Pool create")
 	name := d.Get(isLBPoolName).(string)
@@ -280,24 +281,26 @@ func resourceIBMISLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
 		healthMonitorPort = int64(hmp.(int))
 	}
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-	err := lbPoolCreate(d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, pProtocol, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
+	err := lbPoolCreate(ctx, d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, pProtocol, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBPoolRead(d, meta)
+	return resourceIBMISLBPoolRead(ctx, d, meta)
 }
 
-func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, pProtocol string, healthDelay, maxRetries, healthTimeOut, healthMonitorPort int64) error {
+func lbPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, pProtocol string, healthDelay, maxRetries, healthTimeOut, healthMonitorPort int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -331,7 +334,7 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	if pProtocol != "" {
 		options.ProxyProtocol = &pProtocol
 	}
-	lbPool, response, err := sess.CreateLoadBalancerPool(options)
+	lbPool, response, err := sess.CreateLoadBalancerPoolWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] lbpool create err: %s\n%s", err, response)
 	}
@@ -339,12 +342,12 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
 	log.Printf("[INFO] lbpool : %s", *lbPool.ID)
 
-	_, err = isWaitForLBPoolActive(sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", *lbPool.ID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -352,25 +355,25 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	return nil
 }
 
-func resourceIBMISLBPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
 	lbPoolID := parts[1]
 
-	err = lbPoolGet(d, meta, lbID, lbPoolID)
+	err = lbPoolGet(ctx, d, meta, lbID, lbPoolID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
+func lbPoolGet(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -380,7 +383,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 		ID:             &lbPoolID,
 	}
 
-	lbPool, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
+	lbPool, response, err := sess.GetLoadBalancerPoolWithContext(ctx, getLoadBalancerPoolOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -427,7 +430,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
@@ -435,25 +438,25 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 	return nil
 }
 
-func resourceIBMISLBPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
 	lbPoolID := parts[1]
 
-	err = lbPoolUpdate(d, meta, lbID, lbPoolID)
+	err = lbPoolUpdate(ctx, d, meta, lbID, lbPoolID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBPoolRead(d, meta)
+	return resourceIBMISLBPoolRead(ctx, d, meta)
 }
 
-func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
+func lbPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -526,15 +529,17 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		loadBalancerPoolPatchModel.Protocol = &protocol
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
-		_, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+			return err
+		}
+		defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
+		_, err := isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
@@ -549,18 +554,18 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		}
 		updateLoadBalancerPoolOptions.LoadBalancerPoolPatch = LoadBalancerPoolPatch
 
-		_, response, err := sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
+		_, response, err := sess.UpdateLoadBalancerPoolWithContext(ctx, updateLoadBalancerPoolOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Load Balancer Pool : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -569,29 +574,31 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	return nil
 }
 
-func resourceIBMISLBPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
 	lbPoolID := parts[1]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-	err = lbPoolDelete(d, meta, lbID, lbPoolID)
+	err = lbPoolDelete(ctx, d, meta, lbID, lbPoolID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
+func lbPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -600,7 +607,7 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		LoadBalancerID: &lbID,
 		ID:             &lbPoolID,
 	}
-	_, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
+	_, response, err := sess.GetLoadBalancerPoolWithContext(ctx, getLoadBalancerPoolOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -608,12 +615,12 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		}
 		return fmt.Errorf("[ERROR] Error Getting vpc load balancer pool(%s): %s\n%s", lbPoolID, err, response)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
@@ -622,16 +629,16 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		LoadBalancerID: &lbID,
 		ID:             &lbPoolID,
 	}
-	response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
+	response, err = sess.DeleteLoadBalancerPoolWithContext(ctx, deleteLoadBalancerPoolOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
-	_, err = isWaitForLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolDeleted(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is deleted: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -677,34 +684,39 @@ func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	return true, nil
 }
 
-func isWaitForLBPoolActive(sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolActive(ctx context.Context, sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool (%s) to be available.", lbPoolId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolCreatePending, isLBPoolUpdatePending, isLBPoolMaintainancePending},
-		Target:     []string{isLBPoolActive, ""},
-		Refresh:    isLBPoolRefreshFunc(sess, lbId, lbPoolId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("load balancer pool %s to be active", lbPoolId),
+		Pending:     []string{isLBPoolCreatePending, isLBPoolUpdatePending, isLBPoolMaintainancePending},
+		Target:      []string{isLBPoolActive, ""},
+		Failed:      []string{isLBPoolFailed},
+		Refresh:     isLBPoolRefreshFunc(ctx, sess, lbId, lbPoolId),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
+func isLBPoolRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, lbId, lbPoolId string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getlbpOptions := &vpcv1.GetLoadBalancerPoolOptions{
 			LoadBalancerID: &lbId,
 			ID:             &lbPoolId,
 		}
-		lbPool, response, err := sess.GetLoadBalancerPool(getlbpOptions)
+		lbPool, response, err := sess.GetLoadBalancerPoolWithContext(ctx, getlbpOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool: %s\n%s", err, response)
 		}
 
-		if *lbPool.ProvisioningStatus == isLBPoolActive || *lbPool.ProvisioningStatus == isLBPoolFailed {
+		if *lbPool.ProvisioningStatus == isLBPoolFailed {
+			return lbPool, *lbPool.ProvisioningStatus, nil
+		}
+		if *lbPool.ProvisioningStatus == isLBPoolActive {
 			return lbPool, isLBPoolActive, nil
 		}
 
@@ -712,29 +724,29 @@ func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.Stat
 	}
 }
 
-func isWaitForLBPoolDeleted(lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolDeleted(ctx context.Context, lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolUpdatePending, isLBPoolMaintainancePending, isLBPoolDeletePending},
-		Target:     []string{isLBPoolDeleteDone, ""},
-		Refresh:    isLBPoolDeleteRefreshFunc(lbc, lbId, lbPoolId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{isLBPoolUpdatePending, isLBPoolMaintainancePending, isLBPoolDeletePending},
+		Target:   []string{isLBPoolDeleteDone, ""},
+		Refresh:  isLBPoolDeleteRefreshFunc(ctx, lbc, lbId, lbPoolId),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
+func isLBPoolDeleteRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, lbId, lbPoolId string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is lb pool delete function here")
 		getlbpOptions := &vpcv1.GetLoadBalancerPoolOptions{
 			LoadBalancerID: &lbId,
 			ID:             &lbPoolId,
 		}
-		lbPool, response, err := lbc.GetLoadBalancerPool(getlbpOptions)
+		lbPool, response, err := lbc.GetLoadBalancerPoolWithContext(ctx, getlbpOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lbPool, isLBPoolDeleteDone, nil
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,8 +13,9 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -34,12 +36,12 @@ const (
 
 func ResourceIBMISLBPoolMember() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBPoolMemberCreate,
-		Read:     resourceIBMISLBPoolMemberRead,
-		Update:   resourceIBMISLBPoolMemberUpdate,
-		Delete:   resourceIBMISLBPoolMemberDelete,
-		Exists:   resourceIBMISLBPoolMemberExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBPoolMemberCreate,
+		ReadContext:   resourceIBMISLBPoolMemberRead,
+		UpdateContext: resourceIBMISLBPoolMemberUpdate,
+		DeleteContext: resourceIBMISLBPoolMemberDelete,
+		Exists:        resourceIBMISLBPoolMemberExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -151,12 +153,12 @@ func ResourceIBMISLBPoolMemberValidator() *validate.ResourceValidator {
 	return &ibmISLBResourceValidator
 }
 
-func resourceIBMISLBPoolMemberCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] LB Pool create")
 	lbPoolID, err := getPoolId(d.Get(isLBPoolID).(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := d.Get(isLBID).(string)
@@ -166,28 +168,30 @@ func resourceIBMISLBPoolMemberCreate(d *schema.ResourceData, meta interface{}) e
 	var weight int64
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-	err = lbpMemberCreate(d, meta, lbID, lbPoolID, port64, weight)
+	err = lbpMemberCreate(ctx, d, meta, lbID, lbPoolID, port64, weight)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBPoolMemberRead(d, meta)
+	return resourceIBMISLBPoolMemberRead(ctx, d, meta)
 }
 
-func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string, port, weight int64) error {
+func lbpMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string, port, weight int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -216,7 +220,7 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 		options.Weight = &weight
 	}
 
-	lbPoolMember, response, err := sess.CreateLoadBalancerPoolMember(options)
+	lbPoolMember, response, err := sess.CreateLoadBalancerPoolMemberWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] lbpool member create err: %s\n%s", err, response)
 	}
@@ -224,17 +228,17 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, lbPoolID, *lbPoolMember.ID))
 	log.Printf("[INFO] lbpool member : %s", *lbPoolMember.ID)
 
-	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -242,22 +246,22 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	return nil
 }
 
-func isWaitForLBPoolMemberAvailable(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolMemberAvailable(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool member(%s) to be available.", lbPoolMemID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBPoolMemberActive, ""},
-		Refresh:    isLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"create_pending", "update_pending", "maintenance_pending"},
+		Target:   []string{isLBPoolMemberActive, ""},
+		Refresh:  isLBPoolMemberRefreshFunc(ctx, lbc, lbID, lbPoolID, lbPoolMemID),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
+func isLBPoolMemberRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getlbpmoptions := &vpcv1.GetLoadBalancerPoolMemberOptions{
@@ -265,7 +269,7 @@ func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID str
 			PoolID:         &lbPoolID,
 			ID:             &lbPoolMemID,
 		}
-		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
+		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMemberWithContext(ctx, getlbpmoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member: %s\n%s", err, response)
 		}
@@ -278,31 +282,31 @@ func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID str
 	}
 }
 
-func resourceIBMISLBPoolMemberRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(parts) < 3 {
-		return fmt.Errorf(
-			"The id should contain loadbalancer Id, loadbalancer pool Id and loadbalancer poolmemebr Id")
+		return diag.FromErr(fmt.Errorf(
+			"The id should contain loadbalancer Id, loadbalancer pool Id and loadbalancer poolmemebr Id"))
 	}
 
 	lbID := parts[0]
 	lbPoolID := parts[1]
 	lbPoolMemID := parts[2]
 
-	err = lbpmemberGet(d, meta, lbID, lbPoolID, lbPoolMemID)
+	err = lbpmemberGet(ctx, d, meta, lbID, lbPoolID, lbPoolMemID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
+func lbpmemberGet(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -312,7 +316,7 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 		PoolID:         &lbPoolID,
 		ID:             &lbPoolMemID,
 	}
-	lbPoolMem, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
+	lbPoolMem, response, err := sess.GetLoadBalancerPoolMemberWithContext(ctx, getlbpmoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -338,7 +342,7 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
@@ -346,26 +350,26 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 	return nil
 }
 
-func resourceIBMISLBPoolMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
 	lbPoolID := parts[1]
 	lbPoolMemID := parts[2]
 
-	err = lbpmemberUpdate(d, meta, lbID, lbPoolID, lbPoolMemID)
+	err = lbpmemberUpdate(ctx, d, meta, lbID, lbPoolID, lbPoolMemID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISLBPoolMemberRead(d, meta)
+	return resourceIBMISLBPoolMemberRead(ctx, d, meta)
 }
 
-func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
+func lbpmemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -377,21 +381,23 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		weight := int64(d.Get(isLBPoolMemberWeight).(int))
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+			return err
+		}
+		defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -428,22 +434,22 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		}
 		updatelbpmoptions.LoadBalancerPoolMemberPatch = loadBalancerPoolMemberPatch
 
-		_, response, err := sess.UpdateLoadBalancerPoolMember(updatelbpmoptions)
+		_, response, err := sess.UpdateLoadBalancerPoolMemberWithContext(ctx, updatelbpmoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Load Balancer Pool Member: %s\n%s", err, response)
 		}
-		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -452,11 +458,11 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	return nil
 }
 
-func resourceIBMISLBPoolMemberDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -464,18 +470,20 @@ func resourceIBMISLBPoolMemberDelete(d *schema.ResourceData, meta interface{}) e
 	lbPoolMemID := parts[2]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isLBKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isLBKey)
 
-	err = lbpmemberDelete(d, meta, lbID, lbPoolID, lbPoolMemID)
+	err = lbpmemberDelete(ctx, d, meta, lbID, lbPoolID, lbPoolMemID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
+func lbpmemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -486,7 +494,7 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		PoolID:         &lbPoolID,
 		ID:             &lbPoolMemID,
 	}
-	_, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
+	_, response, err := sess.GetLoadBalancerPoolMemberWithContext(ctx, getlbpmoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -494,17 +502,17 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		}
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member: %s\n%s", err, response)
 	}
-	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -514,22 +522,22 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		PoolID:         &lbPoolID,
 		ID:             &lbPoolMemID,
 	}
-	response, err = sess.DeleteLoadBalancerPoolMember(dellbpmoptions)
+	response, err = sess.DeleteLoadBalancerPoolMemberWithContext(ctx, dellbpmoptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Load Balancer Pool Member: %s\n%s", err, response)
 	}
 
-	_, err = isWaitForLBPoolMemberDeleted(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolMemberDeleted(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -538,22 +546,22 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	return nil
 }
 
-func isWaitForLBPoolMemberDeleted(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolMemberDeleted(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolMemID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolMemberDeletePending},
-		Target:     []string{isLBPoolMemberDeleted, ""},
-		Refresh:    isDeleteLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{isLBPoolMemberDeletePending},
+		Target:   []string{isLBPoolMemberDeleted, ""},
+		Refresh:  isDeleteLBPoolMemberRefreshFunc(ctx, lbc, lbID, lbPoolID, lbPoolMemID),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isDeleteLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
+func isDeleteLBPoolMemberRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getlbpmoptions := &vpcv1.GetLoadBalancerPoolMemberOptions{
@@ -561,7 +569,7 @@ func isDeleteLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMem
 			PoolID:         &lbPoolID,
 			ID:             &lbPoolMemID,
 		}
-		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
+		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMemberWithContext(ctx, getlbpmoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lbPoolMem, isLBPoolMemberDeleted, nil
@@ -13,6 +13,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -27,11 +28,11 @@ const (
 
 func ResourceIBMISNetworkACLRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISNetworkACLRuleCreate,
-		Read:     resourceIBMISNetworkACLRuleRead,
-		Update:   resourceIBMISNetworkACLRuleUpdate,
-		Delete:   resourceIBMISNetworkACLRuleDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISNetworkACLRuleCreate,
+		ReadContext:   resourceIBMISNetworkACLRuleRead,
+		UpdateContext: resourceIBMISNetworkACLRuleUpdate,
+		DeleteContext: resourceIBMISNetworkACLRuleDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -40,7 +41,7 @@ func ResourceIBMISNetworkACLRule() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -328,18 +329,18 @@ func ResourceIBMISNetworkACLRuleValidator() *validate.ResourceValidator {
 	return &ibmISNetworkACLRuleResourceValidator
 }
 
-func resourceIBMISNetworkACLRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nwACLID := d.Get(isNwACLID).(string)
 
-	err := nwaclRuleCreate(d, meta, nwACLID)
+	err := nwaclRuleCreate(ctx, d, meta, nwACLID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISNetworkACLRuleRead(d, meta)
+	return resourceIBMISNetworkACLRuleRead(ctx, d, meta)
 
 }
 
-func nwaclRuleCreate(d *schema.ResourceData, meta interface{}, nwACLID string) error {
+func nwaclRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, nwACLID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -441,7 +442,7 @@ func nwaclRuleCreate(d *schema.ResourceData, meta interface{}, nwACLID string) e
 		NetworkACLID:            &nwACLID,
 		NetworkACLRulePrototype: ruleTemplate,
 	}
-	nwaclRule, response, err := sess.CreateNetworkACLRule(createNetworkAclRuleOptions)
+	nwaclRule, response, err := sess.CreateNetworkACLRuleWithContext(ctx, createNetworkAclRuleOptions)
 	if err != nil || nwaclRule == nil {
 		return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
 	}
@@ -452,30 +453,30 @@ func nwaclRuleCreate(d *schema.ResourceData, meta interface{}, nwACLID string) e
 	return nil
 }
 
-func resourceIBMISNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nwACLID, ruleId, err := parseNwACLTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	getNetworkAclRuleOptions := &vpcv1.GetNetworkACLRuleOptions{
 		NetworkACLID: &nwACLID,
 		ID:           &ruleId,
 	}
-	nwaclRule, response, err := sess.GetNetworkACLRule(getNetworkAclRuleOptions)
+	nwaclRule, response, err := sess.GetNetworkACLRuleWithContext(ctx, getNetworkAclRuleOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Network ACL Rule (%s) : %s\n%s", ruleId, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Network ACL Rule (%s) : %s\n%s", ruleId, err, response))
 	}
 	err = nwaclRuleGet(d, meta, nwACLID, nwaclRule)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -576,18 +577,18 @@ func nwaclRuleGet(d *schema.ResourceData, meta interface{}, nwACLID string, nwac
 	return nil
 }
 
-func resourceIBMISNetworkACLRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	nwACLId, ruleId, err := parseNwACLTerraformID(id)
 
-	err = nwaclRuleUpdate(d, meta, ruleId, nwACLId)
+	err = nwaclRuleUpdate(ctx, d, meta, ruleId, nwACLId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISNetworkACLRuleRead(d, meta)
+	return resourceIBMISNetworkACLRuleRead(ctx, d, meta)
 }
 
-func nwaclRuleUpdate(d *schema.ResourceData, meta interface{}, id, nwACLId string) error {
+func nwaclRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id, nwACLId string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -745,7 +746,7 @@ func nwaclRuleUpdate(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 			return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLOptionsPatch : %s", err)
 		}
 		updateNetworkACLRuleOptions.NetworkACLRulePatch = updateNetworkACLOptionsPatch
-		_, response, err := sess.UpdateNetworkACLRule(updateNetworkACLRuleOptions)
+		_, response, err := sess.UpdateNetworkACLRuleWithContext(ctx, updateNetworkACLRuleOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Network ACL Rule : %s\n%s", err, response)
 		}
@@ -753,21 +754,21 @@ func nwaclRuleUpdate(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 	return nil
 }
 
-func resourceIBMISNetworkACLRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nwACLID, ruleId, err := parseNwACLTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = nwaclRuleDelete(d, meta, ruleId, nwACLID)
+	err = nwaclRuleDelete(ctx, d, meta, ruleId, nwACLID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func nwaclRuleDelete(d *schema.ResourceData, meta interface{}, id, nwACLId string) error {
+func nwaclRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id, nwACLId string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -777,7 +778,7 @@ func nwaclRuleDelete(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 		NetworkACLID: &nwACLId,
 		ID:           &id,
 	}
-	_, response, err := sess.GetNetworkACLRule(getNetworkAclRuleOptions)
+	_, response, err := sess.GetNetworkACLRuleWithContext(ctx, getNetworkAclRuleOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -791,7 +792,7 @@ func nwaclRuleDelete(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 		NetworkACLID: &nwACLId,
 		ID:           &id,
 	}
-	response, err = sess.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
+	response, err = sess.DeleteNetworkACLRuleWithContext(ctx, deleteNetworkAclRuleOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Network ACL Rule : %s\n%s", err, response)
 	}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -49,12 +50,12 @@ const (
 
 func ResourceIBMISNetworkACL() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISNetworkACLCreate,
-		Read:     resourceIBMISNetworkACLRead,
-		Update:   resourceIBMISNetworkACLUpdate,
-		Delete:   resourceIBMISNetworkACLDelete,
-		Exists:   resourceIBMISNetworkACLExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISNetworkACLCreate,
+		ReadContext:   resourceIBMISNetworkACLRead,
+		UpdateContext: resourceIBMISNetworkACLUpdate,
+		DeleteContext: resourceIBMISNetworkACLDelete,
+		Exists:        resourceIBMISNetworkACLExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -63,7 +64,7 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -376,18 +377,18 @@ func ResourceIBMISNetworkACLValidator() *validate.ResourceValidator {
 	return &ibmISNetworkACLResourceValidator
 }
 
-func resourceIBMISNetworkACLCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := d.Get(isNetworkACLName).(string)
-	err := nwaclCreate(d, meta, name)
+	err := nwaclCreate(ctx, d, meta, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISNetworkACLRead(d, meta)
+	return resourceIBMISNetworkACLRead(ctx, d, meta)
 
 }
 
-func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
+func nwaclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -426,7 +427,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		NetworkACLPrototype: nwaclTemplate,
 	}
 
-	nwacl, response, err := sess.CreateNetworkACL(options)
+	nwacl, response, err := sess.CreateNetworkACLWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG]Error while creating Network ACL err %s\n%s", err, response)
 	}
@@ -435,12 +436,12 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	nwaclid := *nwacl.ID
 
 	//Remove default rules
-	err = clearRules(sess, nwaclid)
+	err = clearRules(ctx, sess, nwaclid)
 	if err != nil {
 		return err
 	}
 
-	err = createInlineRules(sess, nwaclid, rules)
+	err = createInlineRules(ctx, sess, nwaclid, rules)
 	if err != nil {
 		return err
 	}
@@ -456,16 +457,16 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	return nil
 }
 
-func resourceIBMISNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := nwaclGet(d, meta, id)
+	err := nwaclGet(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
+func nwaclGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -473,7 +474,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getNetworkAclOptions := &vpcv1.GetNetworkACLOptions{
 		ID: &id,
 	}
-	nwacl, response, err := sess.GetNetworkACL(getNetworkAclOptions)
+	nwacl, response, err := sess.GetNetworkACLWithContext(ctx, getNetworkAclOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -585,7 +586,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISNetworkACLUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
 	name := ""
@@ -596,14 +597,14 @@ func resourceIBMISNetworkACLUpdate(d *schema.ResourceData, meta interface{}) err
 		hasChanged = true
 	}
 
-	err := nwaclUpdate(d, meta, id, name, hasChanged)
+	err := nwaclUpdate(ctx, d, meta, id, name, hasChanged)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISNetworkACLRead(d, meta)
+	return resourceIBMISNetworkACLRead(ctx, d, meta)
 }
 
-func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func nwaclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -621,7 +622,7 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 			return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLPatch: %s", err)
 		}
 		updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
-		_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
+		_, response, err := sess.UpdateNetworkACLWithContext(ctx, updateNetworkACLOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) : %s\n%s", id, err, response)
 		}
@@ -640,12 +641,12 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 			return err
 		}
 		//Delete all existing rules
-		err = clearRules(sess, id)
+		err = clearRules(ctx, sess, id)
 		if err != nil {
 			return err
 		}
 		//Create the rules as per the def
-		err = createInlineRules(sess, id, rules)
+		err = createInlineRules(ctx, sess, id, rules)
 		if err != nil {
 			return err
 		}
@@ -653,18 +654,18 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 	return nil
 }
 
-func resourceIBMISNetworkACLDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := nwaclDelete(d, meta, id)
+	err := nwaclDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func nwaclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -673,7 +674,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getNetworkAclOptions := &vpcv1.GetNetworkACLOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetNetworkACL(getNetworkAclOptions)
+	_, response, err := sess.GetNetworkACLWithContext(ctx, getNetworkAclOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -686,7 +687,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteNetworkAclOptions := &vpcv1.DeleteNetworkACLOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteNetworkACL(deleteNetworkAclOptions)
+	response, err = sess.DeleteNetworkACLWithContext(ctx, deleteNetworkAclOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Network ACL : %s\n%s", err, response)
 	}
@@ -725,7 +726,7 @@ func checkNetworkACLNil(ptr *int64) int {
 	return int(*ptr)
 }
 
-func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
+func clearRules(ctx context.Context, nwaclC *vpcv1.VpcV1, nwaclid string) error {
 	start := ""
 	allrecs := []vpcv1.NetworkACLRuleItemIntf{}
 	for {
@@ -735,7 +736,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 		if start != "" {
 			listNetworkAclRulesOptions.Start = &start
 		}
-		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkAclRulesOptions)
+		rawrules, response, err := nwaclC.ListNetworkACLRulesWithContext(ctx, listNetworkAclRulesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Listing network ACL rules : %s\n%s", err, response)
 		}
@@ -762,7 +763,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 			deleteNetworkAclRuleOptions.ID = rule.ID
 		}
 
-		response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
+		response, err := nwaclC.DeleteNetworkACLRuleWithContext(ctx, deleteNetworkAclRuleOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Deleting network ACL rule : %s\n%s", err, response)
 		}
@@ -793,7 +794,7 @@ func validateInlineRules(rules []interface{}) error {
 	return nil
 }
 
-func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
+func createInlineRules(ctx context.Context, nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
 	before := ""
 
 	for i := 0; i <= len(rules)-1; i++ {
@@ -892,7 +893,7 @@ func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{})
 			NetworkACLID:            &nwaclid,
 			NetworkACLRulePrototype: ruleTemplate,
 		}
-		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
+		_, response, err := nwaclC.CreateNetworkACLRuleWithContext(ctx, createNetworkAclRuleOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
 		}
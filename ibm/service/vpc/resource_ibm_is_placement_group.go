@@ -12,9 +12,9 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -50,7 +50,7 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
@@ -189,7 +189,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 
 	d.SetId(*placementGroup.ID)
 
-	_, err = isWaitForPlacementGroupAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForPlacementGroupAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for placement group to be available %s", err))
 	}
@@ -342,7 +342,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 	response, err := vpcClient.DeletePlacementGroupWithContext(context, deletePlacementGroupOptions)
 	if err != nil {
 		if response.StatusCode == 409 {
-			_, err = isWaitForPlacementGroupDeleteRetry(vpcClient, d, d.Id())
+			_, err = isWaitForPlacementGroupDeleteRetry(context, vpcClient, d, d.Id())
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s", err))
 			}
@@ -350,7 +350,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s\n%s", err, response))
 		}
 	}
-	_, err = isWaitForPlacementGroupDelete(vpcClient, d, d.Id())
+	_, err = isWaitForPlacementGroupDelete(context, vpcClient, d, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -359,9 +359,9 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 	return nil
 }
 
-func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForPlacementGroupDelete(ctx context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{isPlacementGroupDeleting, isPlacementGroupStable, isPlacementGroupPending, isPlacementGroupWaiting, isPlacementGroupUpdating},
 		Target:  []string{isPlacementGroupDeleteDone, ""},
 		Refresh: func() (interface{}, string, error) {
@@ -383,17 +383,17 @@ func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceDat
 			}
 			return placementGroup, isPlacementGroupDeleting, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutDelete),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForPlacementGroupDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{isPlacementGroupResourcesAttached},
 		Target:  []string{isPlacementGroupDeleting, isPlacementGroupDeleteDone, ""},
 		Refresh: func() (interface{}, string, error) {
@@ -412,30 +412,32 @@ func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.Resour
 			}
 			return response, isPlacementGroupDeleting, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutDelete),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isWaitForPlacementGroupAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForPlacementGroupAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for placement group (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isPlacementGroupPending, isPlacementGroupWaiting, isPlacementGroupUpdating},
-		Target:     []string{isPlacementGroupFailed, isPlacementGroupStable, isPlacementGroupSuspended, ""},
-		Refresh:    isPlacementGroupRefreshFunc(vpcClient, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("placement group %s to be available", id),
+		Pending:     []string{isPlacementGroupPending, isPlacementGroupWaiting, isPlacementGroupUpdating},
+		Target:      []string{isPlacementGroupStable, isPlacementGroupSuspended, ""},
+		Failed:      []string{isPlacementGroupFailed},
+		Refresh:     isPlacementGroupRefreshFunc(vpcClient, id, d),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isPlacementGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
+func isPlacementGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getinsOptions := &vpcv1.GetPlacementGroupOptions{
 			ID: &id,
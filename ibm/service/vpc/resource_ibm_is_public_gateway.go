@@ -12,9 +12,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -37,12 +38,12 @@ const (
 
 func ResourceIBMISPublicGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISPublicGatewayCreate,
-		Read:     resourceIBMISPublicGatewayRead,
-		Update:   resourceIBMISPublicGatewayUpdate,
-		Delete:   resourceIBMISPublicGatewayDelete,
-		Exists:   resourceIBMISPublicGatewayExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISPublicGatewayCreate,
+		ReadContext:   resourceIBMISPublicGatewayRead,
+		UpdateContext: resourceIBMISPublicGatewayUpdate,
+		DeleteContext: resourceIBMISPublicGatewayDelete,
+		Exists:        resourceIBMISPublicGatewayExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -51,7 +52,7 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -173,10 +174,10 @@ func ResourceIBMISPublicGatewayValidator() *validate.ResourceValidator {
 	return &ibmISPublicGatewayResourceValidator
 }
 
-func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get(isPublicGatewayName).(string)
@@ -214,16 +215,16 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	publicgw, response, err := sess.CreatePublicGateway(options)
+	publicgw, response, err := sess.CreatePublicGatewayWithContext(ctx, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Public Gateway %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while creating Public Gateway %s\n%s", err, response))
 	}
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
 
-	_, err = isWaitForPublicGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForPublicGatewayAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -235,30 +236,30 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 				"Error on create of vpc public gateway (%s) tags: %s", d.Id(), err)
 		}
 	}
-	return resourceIBMISPublicGatewayRead(d, meta)
+	return resourceIBMISPublicGatewayRead(ctx, d, meta)
 }
 
-func isWaitForPublicGatewayAvailable(publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayAvailable(ctx context.Context, publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isPublicGatewayProvisioning},
-		Target:     []string{isPublicGatewayProvisioningDone, ""},
-		Refresh:    isPublicGatewayRefreshFunc(publicgwC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", isPublicGatewayProvisioning},
+		Target:   []string{isPublicGatewayProvisioningDone, ""},
+		Refresh:  isPublicGatewayRefreshFunc(ctx, publicgwC, id),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isPublicGatewayRefreshFunc(ctx context.Context, publicgwC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
-		publicgw, response, err := publicgwC.GetPublicGateway(getPublicGatewayOptions)
+		publicgw, response, err := publicgwC.GetPublicGatewayWithContext(ctx, getPublicGatewayOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
 		}
@@ -271,22 +272,22 @@ func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.Stat
 	}
 }
 
-func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 		ID: &id,
 	}
-	publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
+	publicgw, response, err := sess.GetPublicGatewayWithContext(ctx, getPublicGatewayOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response))
 	}
 	d.Set(isPublicGatewayName, *publicgw.Name)
 	if publicgw.FloatingIP != nil {
@@ -308,7 +309,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 	d.Set(isPublicGatewayTags, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/vpc-ext/network/publicGateways")
 	d.Set(flex.ResourceName, *publicgw.Name)
@@ -322,10 +323,10 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
@@ -340,9 +341,9 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
-		publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
+		publicgw, response, err := sess.GetPublicGatewayWithContext(ctx, getPublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response))
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
@@ -360,73 +361,73 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		}
 		PublicGatewayPatch, err := PublicGatewayPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for PublicGatewayPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for PublicGatewayPatch: %s", err))
 		}
 		updatePublicGatewayOptions.PublicGatewayPatch = PublicGatewayPatch
-		_, response, err := sess.UpdatePublicGateway(updatePublicGatewayOptions)
+		_, response, err := sess.UpdatePublicGatewayWithContext(ctx, updatePublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Public Gateway  : %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Public Gateway  : %s\n%s", err, response))
 		}
 	}
-	return resourceIBMISPublicGatewayRead(d, meta)
+	return resourceIBMISPublicGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
 	getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
+	_, response, err := sess.GetPublicGatewayWithContext(ctx, getPublicGatewayOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Public Gateway (%s): %s\n%s", id, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Public Gateway (%s): %s\n%s", id, err, response))
 	}
 
 	deletePublicGatewayOptions := &vpcv1.DeletePublicGatewayOptions{
 		ID: &id,
 	}
-	response, err = sess.DeletePublicGateway(deletePublicGatewayOptions)
+	response, err = sess.DeletePublicGatewayWithContext(ctx, deletePublicGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Public Gateway : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Public Gateway : %s\n%s", err, response))
 	}
-	_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForPublicGatewayDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func isWaitForPublicGatewayDeleted(pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayDeleted(ctx context.Context, pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isPublicGatewayDeleting},
-		Target:     []string{isPublicGatewayDeleted, ""},
-		Refresh:    isPublicGatewayDeleteRefreshFunc(pg, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", isPublicGatewayDeleting},
+		Target:   []string{isPublicGatewayDeleted, ""},
+		Refresh:  isPublicGatewayDeleteRefreshFunc(ctx, pg, id),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isPublicGatewayDeleteRefreshFunc(ctx context.Context, pg *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is pubic gateway delete function here")
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
-		pgw, response, err := pg.GetPublicGateway(getPublicGatewayOptions)
+		pgw, response, err := pg.GetPublicGatewayWithContext(ctx, getPublicGatewayOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return pgw, isPublicGatewayDeleted, nil
@@ -13,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -29,16 +30,16 @@ const (
 func ResourceIBMISSecurityGroup() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMISSecurityGroupCreate,
-		Read:     resourceIBMISSecurityGroupRead,
-		Update:   resourceIBMISSecurityGroupUpdate,
-		Delete:   resourceIBMISSecurityGroupDelete,
-		Exists:   resourceIBMISSecurityGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSecurityGroupCreate,
+		ReadContext:   resourceIBMISSecurityGroupRead,
+		UpdateContext: resourceIBMISSecurityGroupUpdate,
+		DeleteContext: resourceIBMISSecurityGroupDelete,
+		Exists:        resourceIBMISSecurityGroupExists,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -144,10 +145,10 @@ func ResourceIBMISSecurityGroupValidator() *validate.ResourceValidator {
 	return &ibmISSecurityGroupResourceValidator
 }
 
-func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	vpc := d.Get(isSecurityGroupVPC).(string)
 
@@ -167,9 +168,9 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 		name = nm.(string)
 		createSecurityGroupOptions.Name = &name
 	}
-	sg, response, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
+	sg, response, err := sess.CreateSecurityGroupWithContext(ctx, createSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Security Group %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while creating Security Group %s\n%s", err, response))
 	}
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
@@ -181,26 +182,26 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 				"Error while creating Security Group tags : %s\n%s", *sg.ID, err)
 		}
 	}
-	return resourceIBMISSecurityGroupRead(d, meta)
+	return resourceIBMISSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
 		ID: &id,
 	}
-	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	group, response, err := sess.GetSecurityGroupWithContext(ctx, getSecurityGroupOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Security Group : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Security Group : %s\n%s", err, response))
 	}
 	tags, err := flex.GetTagsUsingCRN(meta, *group.CRN)
 	if err != nil {
@@ -307,7 +308,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 	}
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/vpc-ext/network/securityGroups")
 	d.Set(flex.ResourceName, *group.Name)
@@ -315,10 +316,10 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	name := ""
@@ -337,7 +338,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		name = d.Get(isSecurityGroupName).(string)
 		hasChanged = true
 	} else {
-		return resourceIBMISSecurityGroupRead(d, meta)
+		return resourceIBMISSecurityGroupRead(ctx, d, meta)
 	}
 
 	if hasChanged {
@@ -349,34 +350,34 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		}
 		securityGroupPatch, err := securityGroupPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupPatch: %s", err))
 		}
 		updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
-		_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
+		_, response, err := sess.UpdateSecurityGroupWithContext(ctx, updateSecurityGroupOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Security Group : %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Security Group : %s\n%s", err, response))
 		}
 	}
-	return resourceIBMISSecurityGroupRead(d, meta)
+	return resourceIBMISSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	_, response, err := sess.GetSecurityGroupWithContext(ctx, getSecurityGroupOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group (%s): %s\n%s", id, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Security Group (%s): %s\n%s", id, err, response))
 	}
 
 	start := ""
@@ -385,9 +386,9 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 	for {
 		listSecurityGroupTargetsOptions := sess.NewListSecurityGroupTargetsOptions(id)
 
-		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
+		groups, response, err := sess.ListSecurityGroupTargetsWithContext(ctx, listSecurityGroupTargetsOptions)
 		if err != nil || groups == nil {
-			return fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response))
 		}
 		if *groups.TotalCount == int64(0) {
 			break
@@ -408,9 +409,9 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 			if securityGroupTargetReference != nil && securityGroupTargetReference.ID != nil {
 
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(id, *securityGroupTargetReference.ID)
-				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
+				response, err = sess.DeleteSecurityGroupTargetBindingWithContext(ctx, deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting security group target binding while deleting security group : %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting security group target binding while deleting security group : %s\n%s", err, response))
 				}
 
 			}
@@ -420,9 +421,9 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 	deleteSecurityGroupOptions := &vpcv1.DeleteSecurityGroupOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteSecurityGroup(deleteSecurityGroupOptions)
+	response, err = sess.DeleteSecurityGroupWithContext(ctx, deleteSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Security Group : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Security Group : %s\n%s", err, response))
 	}
 	d.SetId("")
 	return nil
@@ -4,11 +4,13 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -35,11 +37,11 @@ const (
 
 func ResourceIBMISSecurityGroupNetworkInterfaceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate,
-		Read:     resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead,
-		Delete:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete,
-		Exists:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate,
+		ReadContext:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead,
+		DeleteContext: resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete,
+		Exists:        resourceIBMISSecurityGroupNetworkInterfaceAttachmentExists,
+		Importer:      &schema.ResourceImporter{},
 
 		DeprecationMessage: "Resource ibm_is_security_group_network_interface_attachment is deprecated. Use ibm_is_security_group_target to attach a network interface to a security group",
 
@@ -154,10 +156,10 @@ func ResourceIBMISSecurityGroupNetworkInterfaceAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sgID := d.Get(isSGNICAGroupId).(string)
 	nicID := d.Get(isSGNICANicId).(string)
@@ -166,23 +168,23 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate(d *schema.Resour
 		SecurityGroupID: &sgID,
 		ID:              &nicID,
 	}
-	_, response, err := sess.CreateSecurityGroupTargetBinding(options)
+	_, response, err := sess.CreateSecurityGroupTargetBindingWithContext(ctx, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating SecurityGroup NetworkInterface Binding %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while creating SecurityGroup NetworkInterface Binding %s\n%s", err, response))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", sgID, nicID))
-	return resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d, meta)
+	return resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(ctx, d, meta)
 
 }
 
-func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sgID := parts[0]
 	nicID := parts[1]
@@ -191,13 +193,13 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.Resource
 		SecurityGroupID: &sgID,
 		ID:              &nicID,
 	}
-	secGroupTarget, response, err := sess.GetSecurityGroupTarget(getSecurityGroupNetworkInterfaceOptions)
+	secGroupTarget, response, err := sess.GetSecurityGroupTargetWithContext(ctx, getSecurityGroupNetworkInterfaceOptions)
 	if err != nil || secGroupTarget == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting target(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting target(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response))
 	}
 	instance_id := strings.Split(*secGroupTarget.(*vpcv1.SecurityGroupTargetReference).Href, "/")[5]
 	net_interf_id := *secGroupTarget.(*vpcv1.SecurityGroupTargetReference).ID
@@ -205,9 +207,9 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.Resource
 		InstanceID: &instance_id,
 		ID:         &net_interf_id,
 	}
-	instanceNic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
+	instanceNic, response, err := sess.GetInstanceNetworkInterfaceWithContext(ctx, getnicoptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s %s\n%s", instance_id, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s %s\n%s", instance_id, err, response))
 	}
 	d.Set(isSGNICAGroupId, sgID)
 	d.Set(isSGNICANicId, nicID)
@@ -246,22 +248,22 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.Resource
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
 		ID: &sgID,
 	}
-	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	sg, response, err := sess.GetSecurityGroupWithContext(ctx, getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Security Group : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Security Group : %s\n%s", err, response))
 	}
 	d.Set(flex.RelatedCRN, *sg.CRN)
 	return nil
 }
 
-func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sgID := parts[0]
@@ -271,22 +273,22 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(d *schema.Resour
 		SecurityGroupID: &sgID,
 		ID:              &nicID,
 	}
-	_, response, err := sess.GetSecurityGroupTarget(getSecurityGroupNetworkInterfaceOptions)
+	_, response, err := sess.GetSecurityGroupTargetWithContext(ctx, getSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response))
 	}
 
 	removeSecurityGroupNetworkInterfaceOptions := &vpcv1.DeleteSecurityGroupTargetBindingOptions{
 		SecurityGroupID: &sgID,
 		ID:              &nicID,
 	}
-	response, err = sess.DeleteSecurityGroupTargetBinding(removeSecurityGroupNetworkInterfaceOptions)
+	response, err = sess.DeleteSecurityGroupTargetBindingWithContext(ctx, removeSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response))
 	}
 	d.SetId("")
 	return nil
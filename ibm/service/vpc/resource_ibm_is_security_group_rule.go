@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -35,12 +37,12 @@ const (
 func ResourceIBMISSecurityGroupRule() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMISSecurityGroupRuleCreate,
-		Read:     resourceIBMISSecurityGroupRuleRead,
-		Update:   resourceIBMISSecurityGroupRuleUpdate,
-		Delete:   resourceIBMISSecurityGroupRuleDelete,
-		Exists:   resourceIBMISSecurityGroupRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSecurityGroupRuleCreate,
+		ReadContext:   resourceIBMISSecurityGroupRuleRead,
+		UpdateContext: resourceIBMISSecurityGroupRuleUpdate,
+		DeleteContext: resourceIBMISSecurityGroupRuleDelete,
+		Exists:        resourceIBMISSecurityGroupRuleExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -227,28 +229,30 @@ func ResourceIBMISSecurityGroupRuleValidator() *validate.ResourceValidator {
 	return &ibmISSecurityGroupRuleResourceValidator
 }
 
-func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	parsed, sgTemplate, _, err := parseIBMISSecurityGroupRuleDictionary(d, "create", sess)
+	parsed, sgTemplate, _, err := parseIBMISSecurityGroupRuleDictionary(ctx, d, "create", sess)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isSecurityGroupRuleKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isSecurityGroupRuleKey)
 
 	options := &vpcv1.CreateSecurityGroupRuleOptions{
 		SecurityGroupID:            &parsed.secgrpID,
 		SecurityGroupRulePrototype: sgTemplate,
 	}
 
-	rule, response, err := sess.CreateSecurityGroupRule(options)
+	rule, response, err := sess.CreateSecurityGroupRuleWithContext(ctx, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Security Group Rule %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while creating Security Group Rule %s\n%s", err, response))
 	}
 	switch reflect.TypeOf(rule).String() {
 	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
@@ -273,38 +277,38 @@ func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface
 			d.SetId(tfID)
 		}
 	}
-	return resourceIBMISSecurityGroupRuleRead(d, meta)
+	return resourceIBMISSecurityGroupRuleRead(ctx, d, meta)
 }
 
-func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	secgrpID, ruleID, err := parseISTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
 		SecurityGroupID: &secgrpID,
 		ID:              &ruleID,
 	}
-	sgrule, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
+	sgrule, response, err := sess.GetSecurityGroupRuleWithContext(ctx, getSecurityGroupRuleOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response))
 	}
 	d.Set(isSecurityGroupID, secgrpID)
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
 		ID: &secgrpID,
 	}
-	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	sg, response, err := sess.GetSecurityGroupWithContext(ctx, getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Security Group : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Security Group : %s\n%s", err, response))
 	}
 	d.Set(flex.RelatedCRN, *sg.CRN)
 	switch reflect.TypeOf(sgrule).String() {
@@ -404,63 +408,67 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIBMISSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	parsed, _, sgTemplate, err := parseIBMISSecurityGroupRuleDictionary(d, "update", sess)
+	parsed, _, sgTemplate, err := parseIBMISSecurityGroupRuleDictionary(ctx, d, "update", sess)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isSecurityGroupRuleKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isSecurityGroupRuleKey)
 
 	updateSecurityGroupRuleOptions := sgTemplate
-	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
+	_, response, err := sess.UpdateSecurityGroupRuleWithContext(ctx, updateSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Security Group Rule : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Security Group Rule : %s\n%s", err, response))
 	}
-	return resourceIBMISSecurityGroupRuleRead(d, meta)
+	return resourceIBMISSecurityGroupRuleRead(ctx, d, meta)
 }
 
-func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	secgrpID, ruleID, err := parseISTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isSecurityGroupRuleKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isSecurityGroupRuleKey)
 
 	getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
 		SecurityGroupID: &secgrpID,
 		ID:              &ruleID,
 	}
-	_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
+	_, response, err := sess.GetSecurityGroupRuleWithContext(ctx, getSecurityGroupRuleOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response))
 	}
 
 	deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
 		SecurityGroupID: &secgrpID,
 		ID:              &ruleID,
 	}
-	response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+	response, err = sess.DeleteSecurityGroupRuleWithContext(ctx, deleteSecurityGroupRuleOptions)
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("[ERROR] Error Deleting Security Group Rule : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Security Group Rule : %s\n%s", err, response))
 	}
 	d.SetId("")
 	return nil
@@ -534,7 +542,7 @@ func inferRemoteSecurityGroup(s string) (address, cidr, id string, err error) {
 	}
 }
 
-func parseIBMISSecurityGroupRuleDictionary(d *schema.ResourceData, tag string, sess *vpcv1.VpcV1) (*parsedIBMISSecurityGroupRuleDictionary, *vpcv1.SecurityGroupRulePrototype, *vpcv1.UpdateSecurityGroupRuleOptions, error) {
+func parseIBMISSecurityGroupRuleDictionary(ctx context.Context, d *schema.ResourceData, tag string, sess *vpcv1.VpcV1) (*parsedIBMISSecurityGroupRuleDictionary, *vpcv1.SecurityGroupRulePrototype, *vpcv1.UpdateSecurityGroupRuleOptions, error) {
 	parsed := &parsedIBMISSecurityGroupRuleDictionary{}
 	sgTemplate := &vpcv1.SecurityGroupRulePrototype{}
 	sgTemplateUpdate := &vpcv1.UpdateSecurityGroupRuleOptions{}
@@ -594,7 +602,7 @@ func parseIBMISSecurityGroupRuleDictionary(d *schema.ResourceData, tag string, s
 			getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
 				ID: &parsed.remoteSecGrpID,
 			}
-			sg, res, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+			sg, res, err := sess.GetSecurityGroupWithContext(ctx, getSecurityGroupOptions)
 			if err != nil || sg == nil {
 				if res != nil && res.StatusCode == 404 {
 					return nil, nil, nil, err
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,8 +12,9 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,11 +26,11 @@ const (
 func ResourceIBMISSecurityGroupTarget() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMISSecurityGroupTargetCreate,
-		Read:     resourceIBMISSecurityGroupTargetRead,
-		Delete:   resourceIBMISSecurityGroupTargetDelete,
-		Exists:   resourceIBMISSecurityGroupTargetExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSecurityGroupTargetCreate,
+		ReadContext:   resourceIBMISSecurityGroupTargetRead,
+		DeleteContext: resourceIBMISSecurityGroupTargetDelete,
+		Exists:        resourceIBMISSecurityGroupTargetExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -97,11 +99,11 @@ func ResourceIBMISSecurityGroupTargetValidator() *validate.ResourceValidator {
 	return &ibmISSecurityGroupResourceValidator
 }
 
-func resourceIBMISSecurityGroupTargetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	securityGroupID := d.Get("security_group").(string)
@@ -111,34 +113,34 @@ func resourceIBMISSecurityGroupTargetCreate(d *schema.ResourceData, meta interfa
 	createSecurityGroupTargetBindingOptions.SecurityGroupID = &securityGroupID
 	createSecurityGroupTargetBindingOptions.ID = &targetID
 
-	sg, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
+	sg, response, err := sess.CreateSecurityGroupTargetBindingWithContext(ctx, createSecurityGroupTargetBindingOptions)
 	if err != nil || sg == nil {
-		return fmt.Errorf("[ERROR] Error while creating Security Group Target Binding %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while creating Security Group Target Binding %s\n%s", err, response))
 	}
 	sgtarget := sg.(*vpcv1.SecurityGroupTargetReference)
 	d.SetId(fmt.Sprintf("%s/%s", securityGroupID, *sgtarget.ID))
 	crn := sgtarget.CRN
 	if crn != nil && *crn != "" && strings.Contains(*crn, "load-balancer") {
 		lbid := sgtarget.ID
-		_, errsgt := isWaitForLbSgTargetCreateAvailable(sess, *lbid, d.Timeout(schema.TimeoutCreate))
+		_, errsgt := isWaitForLbSgTargetCreateAvailable(ctx, sess, *lbid, d.Timeout(schema.TimeoutCreate))
 		if errsgt != nil {
-			return errsgt
+			return diag.FromErr(errsgt)
 		}
 	}
 
-	return resourceIBMISSecurityGroupTargetRead(d, meta)
+	return resourceIBMISSecurityGroupTargetRead(ctx, d, meta)
 }
 
-func resourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	securityGroupID := parts[0]
 	securityGroupTargetID := parts[1]
@@ -148,13 +150,13 @@ func resourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interface
 		ID:              &securityGroupTargetID,
 	}
 
-	data, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+	data, response, err := sess.GetSecurityGroupTargetWithContext(ctx, getSecurityGroupTargetOptions)
 	if err != nil || data == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Security Group Target : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Security Group Target : %s\n%s", err, response))
 	}
 
 	target := data.(*vpcv1.SecurityGroupTargetReference)
@@ -167,15 +169,15 @@ func resourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	securityGroupID := parts[0]
 	securityGroupTargetID := parts[1]
@@ -184,27 +186,27 @@ func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interfa
 		SecurityGroupID: &securityGroupID,
 		ID:              &securityGroupTargetID,
 	}
-	sgt, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+	sgt, response, err := sess.GetSecurityGroupTargetWithContext(ctx, getSecurityGroupTargetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group Targets (%s): %s\n%s", securityGroupID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Security Group Targets (%s): %s\n%s", securityGroupID, err, response))
 	}
 
 	deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(securityGroupID, securityGroupTargetID)
-	response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
+	response, err = sess.DeleteSecurityGroupTargetBindingWithContext(ctx, deleteSecurityGroupTargetBindingOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Security Group Targets : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Security Group Targets : %s\n%s", err, response))
 	}
 	securityGroupTargetReference := sgt.(*vpcv1.SecurityGroupTargetReference)
 	crn := securityGroupTargetReference.CRN
 	if crn != nil && *crn != "" && strings.Contains(*crn, "load-balancer") {
 		lbid := securityGroupTargetReference.ID
-		_, errsgt := isWaitForLBRemoveAvailable(sess, sgt, *lbid, securityGroupID, securityGroupTargetID, d.Timeout(schema.TimeoutDelete))
+		_, errsgt := isWaitForLBRemoveAvailable(ctx, sess, sgt, *lbid, securityGroupID, securityGroupTargetID, d.Timeout(schema.TimeoutDelete))
 		if errsgt != nil {
-			return errsgt
+			return diag.FromErr(errsgt)
 		}
 	}
 	d.SetId("")
@@ -242,42 +244,47 @@ func resourceIBMISSecurityGroupTargetExists(d *schema.ResourceData, meta interfa
 
 }
 
-func isWaitForLBRemoveAvailable(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBRemoveAvailable(ctx context.Context, sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("[INFO] Waiting for load balancer binding (%s) to be removed.", lbId)
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
+		Description:    fmt.Sprintf("load balancer %s to be active after the removal of security group target %s", lbId, securityGroupTargetID),
 		Pending:        []string{isLBProvisioning},
 		Target:         []string{isLBProvisioningDone},
-		Refresh:        isLBRemoveRefreshFunc(sess, sgt, lbId, securityGroupID, securityGroupTargetID),
+		Failed:         []string{"failed"},
+		Refresh:        isLBRemoveRefreshFunc(ctx, sess, sgt, lbId, securityGroupID, securityGroupTargetID),
 		Timeout:        timeout,
 		Delay:          10 * time.Second,
-		MinTimeout:     10 * time.Second,
+		Interval:       10 * time.Second,
 		NotFoundChecks: 1,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLBRemoveRefreshFunc(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string) resource.StateRefreshFunc {
+func isLBRemoveRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getSecurityGroupTargetOptions := &vpcv1.GetSecurityGroupTargetOptions{
 			SecurityGroupID: &securityGroupID,
 			ID:              &securityGroupTargetID,
 		}
-		_, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+		_, response, err := sess.GetSecurityGroupTargetWithContext(ctx, getSecurityGroupTargetOptions)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				getlboptions := &vpcv1.GetLoadBalancerOptions{
 					ID: &lbId,
 				}
-				lb, response, err := sess.GetLoadBalancer(getlboptions)
+				lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 				if err != nil {
 					return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 				}
 
-				if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
+				if *lb.ProvisioningStatus == "failed" {
+					return sgt, *lb.ProvisioningStatus, nil
+				}
+				if *lb.ProvisioningStatus == "active" {
 					return sgt, isLBProvisioningDone, nil
 				} else {
 					return sgt, isLBProvisioning, nil
@@ -289,33 +296,38 @@ func isLBRemoveRefreshFunc(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetRefer
 	}
 }
 
-func isWaitForLbSgTargetCreateAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbSgTargetCreateAvailable(ctx context.Context, sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBProvisioning, "update_pending"},
-		Target:     []string{isLBProvisioningDone, ""},
-		Refresh:    isLBSgTargetRefreshFunc(sess, lbId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("load balancer %s to be active", lbId),
+		Pending:     []string{"retry", isLBProvisioning, "update_pending"},
+		Target:      []string{isLBProvisioningDone, ""},
+		Failed:      []string{"failed"},
+		Refresh:     isLBSgTargetRefreshFunc(ctx, sess, lbId),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isLBSgTargetRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
+func isLBSgTargetRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, lbId string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getlboptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &lbId,
 		}
-		lb, response, err := sess.GetLoadBalancer(getlboptions)
+		lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 		}
 
-		if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
+		if *lb.ProvisioningStatus == "failed" {
+			return lb, *lb.ProvisioningStatus, nil
+		}
+		if *lb.ProvisioningStatus == "active" {
 			return lb, isLBProvisioningDone, nil
 		}
 
@@ -11,9 +11,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -46,12 +47,12 @@ const (
 
 func ResourceIBMSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSnapshotCreate,
-		Read:     resourceIBMISSnapshotRead,
-		Update:   resourceIBMISSnapshotUpdate,
-		Delete:   resourceIBMISSnapshotDelete,
-		Exists:   resourceIBMISSnapshotExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSnapshotCreate,
+		ReadContext:   resourceIBMISSnapshotRead,
+		UpdateContext: resourceIBMISSnapshotUpdate,
+		DeleteContext: resourceIBMISSnapshotDelete,
+		Exists:        resourceIBMISSnapshotExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -60,7 +61,7 @@ func ResourceIBMSnapshot() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -170,10 +171,10 @@ func ResourceIBMISSnapshotValidator() *validate.ResourceValidator {
 	return &ibmISSnapshotResourceValidator
 }
 
-func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &vpcv1.CreateSnapshotOptions{}
 	snapshotprototypeoptions := &vpcv1.SnapshotPrototypeSnapshotBySourceVolume{}
@@ -197,44 +198,46 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Snapshot create")
 
-	snapshot, response, err := sess.CreateSnapshot(options)
+	snapshot, response, err := sess.CreateSnapshotWithContext(ctx, options)
 	if err != nil || snapshot == nil {
-		return fmt.Errorf("[ERROR] Error creating Snapshot %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Snapshot %s\n%s", err, response))
 	}
 
 	d.SetId(*snapshot.ID)
 	log.Printf("[INFO] Snapshot : %s", *snapshot.ID)
 
-	_, err = isWaitForSnapshotAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSnapshotAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISSnapshotRead(d, meta)
+	return resourceIBMISSnapshotRead(ctx, d, meta)
 }
 
-func isWaitForSnapshotAvailable(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotAvailable(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isSnapshotPending},
-		Target:     []string{isSnapshotAvailable, isSnapshotFailed},
-		Refresh:    isSnapshotRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("snapshot %s to be available", id),
+		Pending:     []string{isSnapshotPending},
+		Target:      []string{isSnapshotAvailable},
+		Failed:      []string{isSnapshotFailed},
+		Refresh:     isSnapshotRefreshFunc(ctx, sess, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSnapshotRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
 		}
@@ -249,16 +252,16 @@ func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFu
 	}
 }
 
-func resourceIBMISSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := snapshotGet(d, meta, id)
+	err := snapshotGet(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
+func snapshotGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -266,7 +269,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 		ID: &id,
 	}
-	snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+	snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -302,7 +305,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
 	name := ""
@@ -312,14 +315,14 @@ func resourceIBMISSnapshotUpdate(d *schema.ResourceData, meta interface{}) error
 		name = d.Get(isSnapshotName).(string)
 		hasChanged = true
 	}
-	err := snapshotUpdate(d, meta, id, name, hasChanged)
+	err := snapshotUpdate(ctx, d, meta, id, name, hasChanged)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISSnapshotRead(d, meta)
+	return resourceIBMISSnapshotRead(ctx, d, meta)
 }
 
-func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func snapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -336,11 +339,11 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 			return fmt.Errorf("[ERROR] Error calling asPatch for SnapshotPatch: %s", err)
 		}
 		updateSnapshotOptions.SnapshotPatch = snapshotPatch
-		_, response, err := sess.UpdateSnapshot(updateSnapshotOptions)
+		_, response, err := sess.UpdateSnapshotWithContext(ctx, updateSnapshotOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating Snapshot : %s\n%s", err, response)
 		}
-		_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForSnapshotUpdate(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -348,26 +351,28 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 	return nil
 }
 
-func isWaitForSnapshotUpdate(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotUpdate(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isSnapshotUpdating},
-		Target:     []string{isSnapshotAvailable, isSnapshotFailed},
-		Refresh:    isSnapshotUpdateRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-	return stateConf.WaitForState()
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("snapshot %s to be updated", id),
+		Pending:     []string{isSnapshotUpdating},
+		Target:      []string{isSnapshotAvailable},
+		Failed:      []string{isSnapshotFailed},
+		Refresh:     isSnapshotUpdateRefreshFunc(ctx, sess, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
+	}
+	return stateConf.Wait(ctx)
 }
 
-func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSnapshotUpdateRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
 		}
@@ -382,17 +387,17 @@ func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 	}
 }
 
-func resourceIBMISSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := snapshotDelete(d, meta, id)
+	err := snapshotDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func snapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -401,7 +406,7 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetSnapshot(getSnapshotOptions)
+	_, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -413,11 +418,11 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteSnapshotOptions := &vpcv1.DeleteSnapshotOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteSnapshot(deleteSnapshotOptions)
+	response, err = sess.DeleteSnapshotWithContext(ctx, deleteSnapshotOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting Snapshot : %s\n%s", err, response)
 	}
-	_, err = isWaitForSnapshotDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSnapshotDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -425,28 +430,30 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForSnapshotDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotDeleted(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isSnapshotDeleting},
-		Target:     []string{isSnapshotDeleted, isSnapshotFailed},
-		Refresh:    isSnapshotDeleteRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("snapshot %s to be deleted", id),
+		Pending:     []string{isSnapshotDeleting},
+		Target:      []string{isSnapshotDeleted},
+		Failed:      []string{isSnapshotFailed},
+		Refresh:     isSnapshotDeleteRefreshFunc(ctx, sess, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isSnapshotDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSnapshotDeleteRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] Refresh function for Snapshot delete.")
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return snapshot, isSnapshotDeleted, nil
@@ -15,6 +15,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/crypto/ssh"
@@ -33,16 +34,16 @@ const (
 
 func ResourceIBMISSSHKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSSHKeyCreate,
-		Read:     resourceIBMISSSHKeyRead,
-		Update:   resourceIBMISSSHKeyUpdate,
-		Delete:   resourceIBMISSSHKeyDelete,
-		Exists:   resourceIBMISSSHKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSSHKeyCreate,
+		ReadContext:   resourceIBMISSSHKeyRead,
+		UpdateContext: resourceIBMISSSHKeyUpdate,
+		DeleteContext: resourceIBMISSSHKeyDelete,
+		Exists:        resourceIBMISSSHKeyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -157,20 +158,20 @@ func ResourceIBMISSHKeyValidator() *validate.ResourceValidator {
 	return &ibmISSSHKeyResourceValidator
 }
 
-func resourceIBMISSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] Key create")
 	name := d.Get(isKeyName).(string)
 	publickey := d.Get(isKeyPublicKey).(string)
 
-	err := keyCreate(d, meta, name, publickey)
+	err := keyCreate(ctx, d, meta, name, publickey)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISSSHKeyRead(d, meta)
+	return resourceIBMISSSHKeyRead(ctx, d, meta)
 }
 
-func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string) error {
+func keyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, publickey string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -187,7 +188,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 		}
 	}
 
-	key, response, err := sess.CreateKey(options)
+	key, response, err := sess.CreateKeyWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create SSH Key %s\n%s", err, response)
 	}
@@ -206,18 +207,18 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 	return nil
 }
 
-func resourceIBMISSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
 
-	err := keyGet(d, meta, id)
+	err := keyGet(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
+func keyGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -225,7 +226,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.GetKeyOptions{
 		ID: &id,
 	}
-	key, response, err := sess.GetKey(options)
+	key, response, err := sess.GetKeyWithContext(ctx, options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -259,7 +260,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	name := ""
@@ -270,14 +271,14 @@ func resourceIBMISSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		hasChanged = true
 	}
 
-	err := keyUpdate(d, meta, id, name, hasChanged)
+	err := keyUpdate(ctx, d, meta, id, name, hasChanged)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISSSHKeyRead(d, meta)
+	return resourceIBMISSSHKeyRead(ctx, d, meta)
 }
 
-func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func keyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -286,7 +287,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		options := &vpcv1.GetKeyOptions{
 			ID: &id,
 		}
-		key, response, err := sess.GetKey(options)
+		key, response, err := sess.GetKeyWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting SSH Key : %s\n%s", err, response)
 		}
@@ -309,7 +310,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 			return fmt.Errorf("[ERROR] Error calling asPatch for KeyPatch: %s", err)
 		}
 		options.KeyPatch = keyPatch
-		_, response, err := sess.UpdateKey(options)
+		_, response, err := sess.UpdateKeyWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating vpc SSH Key: %s\n%s", err, response)
 		}
@@ -317,17 +318,17 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	return nil
 }
 
-func resourceIBMISSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
-	err := keyDelete(d, meta, id)
+	err := keyDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func keyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -336,7 +337,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getKeyOptions := &vpcv1.GetKeyOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetKey(getKeyOptions)
+	_, response, err := sess.GetKeyWithContext(ctx, getKeyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -347,7 +348,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.DeleteKeyOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteKey(options)
+	response, err = sess.DeleteKeyWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting SSH Key : %s\n%s", err, response)
 	}
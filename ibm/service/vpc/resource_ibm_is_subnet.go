@@ -14,10 +14,11 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,12 +51,12 @@ const (
 
 func ResourceIBMISSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSubnetCreate,
-		Read:     resourceIBMISSubnetRead,
-		Update:   resourceIBMISSubnetUpdate,
-		Delete:   resourceIBMISSubnetDelete,
-		Exists:   resourceIBMISSubnetExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSubnetCreate,
+		ReadContext:   resourceIBMISSubnetRead,
+		UpdateContext: resourceIBMISSubnetUpdate,
+		DeleteContext: resourceIBMISSubnetDelete,
+		Exists:        resourceIBMISSubnetExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -64,7 +65,7 @@ func ResourceIBMISSubnet() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -261,7 +262,7 @@ func ResourceIBMISSubnetValidator() *validate.ResourceValidator {
 	return &ibmISSubnetResourceValidator
 }
 
-func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := d.Get(isSubnetName).(string)
 	vpc := d.Get(isSubnetVPC).(string)
@@ -278,15 +279,17 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		ipv4addrcount64 = int64(ipv4addrcount)
 	}
 	if ipv4cidr == "" && ipv4addrcount == 0 {
-		return fmt.Errorf("%s or %s need to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
+		return diag.FromErr(fmt.Errorf("%s or %s need to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount))
 	}
 
 	if ipv4cidr != "" && ipv4addrcount != 0 {
-		return fmt.Errorf("only one of %s or %s needs to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
+		return diag.FromErr(fmt.Errorf("only one of %s or %s needs to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount))
 	}
 	isSubnetKey := "subnet_key_" + vpc + "_" + zone
-	conns.IbmMutexKV.Lock(isSubnetKey)
-	defer conns.IbmMutexKV.Unlock(isSubnetKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isSubnetKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isSubnetKey)
 
 	acl := ""
 	if nwacl, ok := d.GetOk(isSubnetNetworkACL); ok {
@@ -304,15 +307,15 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		rtID = rt.(string)
 	}
 
-	err := subnetCreate(d, meta, name, vpc, zone, ipv4cidr, acl, gw, rtID, ipv4addrcount64)
+	err := subnetCreate(ctx, d, meta, name, vpc, zone, ipv4cidr, acl, gw, rtID, ipv4addrcount64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISSubnetRead(d, meta)
+	return resourceIBMISSubnetRead(ctx, d, meta)
 }
 
-func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw, rtID string, ipv4addrcount64 int64) error {
+func subnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw, rtID string, ipv4addrcount64 int64) error {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -361,14 +364,14 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	createSubnetOptions := &vpcv1.CreateSubnetOptions{
 		SubnetPrototype: subnetTemplate,
 	}
-	subnet, response, err := sess.CreateSubnet(createSubnetOptions)
+	subnet, response, err := sess.CreateSubnetWithContext(ctx, createSubnetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Subnet err %s\n%s", err, response)
 		return fmt.Errorf("[ERROR] Error while creating Subnet %s\n%v", err, response)
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
-	_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -394,32 +397,37 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	return nil
 }
 
-func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetAvailable(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isSubnetProvisioning},
-		Target:     []string{isSubnetProvisioningDone, ""},
-		Refresh:    isSubnetRefreshFunc(subnetC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("subnet %s to be available", id),
+		Pending:     []string{"retry", isSubnetProvisioning},
+		Target:      []string{isSubnetProvisioningDone, ""},
+		Failed:      []string{"failed"},
+		Refresh:     isSubnetRefreshFunc(ctx, subnetC, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSubnetRefreshFunc(ctx context.Context, subnetC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getSubnetOptions := &vpcv1.GetSubnetOptions{
 			ID: &id,
 		}
-		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
+		subnet, response, err := subnetC.GetSubnetWithContext(ctx, getSubnetOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Subnet : %s\n%s", err, response)
 		}
 
-		if *subnet.Status == "available" || *subnet.Status == "failed" {
+		if *subnet.Status == "failed" {
+			return subnet, *subnet.Status, nil
+		}
+		if *subnet.Status == "available" {
 			return subnet, isSubnetProvisioningDone, nil
 		}
 
@@ -427,18 +435,18 @@ func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshF
 	}
 }
 
-func resourceIBMISSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
 
-	err := subnetGet(d, meta, id)
+	err := subnetGet(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
+func subnetGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -446,7 +454,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getSubnetOptions := &vpcv1.GetSubnetOptions{
 		ID: &id,
 	}
-	subnet, response, err := sess.GetSubnet(getSubnetOptions)
+	subnet, response, err := sess.GetSubnetWithContext(ctx, getSubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -507,7 +515,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
 	if d.HasChange(isSubnetTags) {
@@ -528,15 +536,15 @@ func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	err := subnetUpdate(d, meta, id)
+	err := subnetUpdate(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISSubnetRead(d, meta)
+	return resourceIBMISSubnetRead(ctx, d, meta)
 }
 
-func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+func subnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -564,11 +572,11 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
 				ID: &id,
 			}
-			response, err := sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
+			response, err := sess.UnsetSubnetPublicGatewayWithContext(ctx, unsetSubnetPublicGatewayOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -579,11 +587,11 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 					ID: &gw,
 				},
 			}
-			_, response, err := sess.SetSubnetPublicGateway(setSubnetPublicGatewayOptions)
+			_, response, err := sess.SetSubnetPublicGatewayWithContext(ctx, setSubnetPublicGatewayOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Attaching public gateway to the subnet : %s\n%s", err, response)
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -615,7 +623,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		updateSubnetOptions.SubnetPatch = subnetPatch
 		updateSubnetOptions.ID = &id
-		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
+		_, response, err := sess.UpdateSubnetWithContext(ctx, updateSubnetOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Subnet : %s\n%s", err, response)
 		}
@@ -623,19 +631,19 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
-	err := subnetDelete(d, meta, id)
+	err := subnetDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func subnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -643,7 +651,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getSubnetOptions := &vpcv1.GetSubnetOptions{
 		ID: &id,
 	}
-	subnet, response, err := sess.GetSubnet(getSubnetOptions)
+	subnet, response, err := sess.GetSubnetWithContext(ctx, getSubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -654,11 +662,11 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
 			ID: &id,
 		}
-		_, err = sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
+		_, err = sess.UnsetSubnetPublicGatewayWithContext(ctx, unsetSubnetPublicGatewayOptions)
 		if err != nil {
 			return err
 		}
-		_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -666,11 +674,11 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteSubnetOptions := &vpcv1.DeleteSubnetOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteSubnet(deleteSubnetOptions)
+	response, err = sess.DeleteSubnetWithContext(ctx, deleteSubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 409 {
 			log.Printf("[DEBUG] Delete subnet response status code: 409 conflict, provider will try again. %s", err)
-			_, err = isWaitForSubnetDeleteRetry(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+			_, err = isWaitForSubnetDeleteRetry(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Deleting Subnet : %s", err)
 			}
@@ -678,7 +686,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			return fmt.Errorf("[ERROR] Error Deleting Subnet : %s\n%s", err, response)
 		}
 	}
-	_, err = isWaitForSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSubnetDeleted(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -686,9 +694,9 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("[DEBUG] Retrying subnet (%s) delete", id)
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.StatusWaiter{
 		Pending: []string{isSubnetInUse},
 		Target:  []string{isSubnetDeleting, isSubnetDeleted, ""},
 		Refresh: func() (interface{}, string, error) {
@@ -696,7 +704,7 @@ func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.
 				ID: &id,
 			}
 			log.Printf("[DEBUG] Retrying subnet (%s) delete", id)
-			response, err := vpcClient.DeleteSubnet(deleteSubnetOptions)
+			response, err := vpcClient.DeleteSubnetWithContext(ctx, deleteSubnetOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 409 {
 					return response, isSubnetInUse, nil
@@ -707,35 +715,35 @@ func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.
 			}
 			return response, isSubnetDeleting, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleted(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isSubnetDeleting},
-		Target:     []string{isSubnetDeleted, ""},
-		Refresh:    isSubnetDeleteRefreshFunc(subnetC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", isSubnetDeleting},
+		Target:   []string{isSubnetDeleted, ""},
+		Refresh:  isSubnetDeleteRefreshFunc(ctx, subnetC, id),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSubnetDeleteRefreshFunc(ctx context.Context, subnetC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is subnet delete function here")
 		getSubnetOptions := &vpcv1.GetSubnetOptions{
 			ID: &id,
 		}
-		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
+		subnet, response, err := subnetC.GetSubnetWithContext(ctx, getSubnetOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return subnet, isSubnetDeleted, nil
@@ -4,12 +4,14 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,12 +22,12 @@ const (
 
 func ResourceIBMISSubnetNetworkACLAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSubnetNetworkACLAttachmentCreate,
-		Read:     resourceIBMISSubnetNetworkACLAttachmentRead,
-		Update:   resourceIBMISSubnetNetworkACLAttachmentUpdate,
-		Delete:   resourceIBMISSubnetNetworkACLAttachmentDelete,
-		Exists:   resourceIBMISSubnetNetworkACLAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSubnetNetworkACLAttachmentCreate,
+		ReadContext:   resourceIBMISSubnetNetworkACLAttachmentRead,
+		UpdateContext: resourceIBMISSubnetNetworkACLAttachmentUpdate,
+		DeleteContext: resourceIBMISSubnetNetworkACLAttachmentDelete,
+		Exists:        resourceIBMISSubnetNetworkACLAttachmentExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -194,10 +196,10 @@ func ResourceIBMISSubnetNetworkACLAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subnet := d.Get(isSubnetID).(string)
@@ -211,37 +213,37 @@ func resourceIBMISSubnetNetworkACLAttachmentCreate(d *schema.ResourceData, meta
 	replaceSubnetNetworkACLOptionsModel := new(vpcv1.ReplaceSubnetNetworkACLOptions)
 	replaceSubnetNetworkACLOptionsModel.ID = &subnet
 	replaceSubnetNetworkACLOptionsModel.NetworkACLIdentity = networkACLIdentityModel
-	resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
+	resultACL, response, err := sess.ReplaceSubnetNetworkACLWithContext(ctx, replaceSubnetNetworkACLOptionsModel)
 
 	if err != nil {
 		log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-		return fmt.Errorf("[ERROR] Error while attaching a network ACL to a subnet %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching a network ACL to a subnet %s\n%s", err, response))
 	}
 	d.SetId(subnet)
 	log.Printf("[INFO] Network ACL : %s", *resultACL.ID)
 	log.Printf("[INFO] Subnet ID : %s", subnet)
 
-	return resourceIBMISSubnetNetworkACLAttachmentRead(d, meta)
+	return resourceIBMISSubnetNetworkACLAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getSubnetNetworkACLOptionsModel := &vpcv1.GetSubnetNetworkACLOptions{
 		ID: &id,
 	}
-	nwacl, response, err := sess.GetSubnetNetworkACL(getSubnetNetworkACLOptionsModel)
+	nwacl, response, err := sess.GetSubnetNetworkACLWithContext(ctx, getSubnetNetworkACLOptionsModel)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting subnet's (%s) attached network ACL: %s\n%s", id, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting subnet's (%s) attached network ACL: %s\n%s", id, err, response))
 	}
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLCRN, *nwacl.CRN)
@@ -334,11 +336,11 @@ func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isNetworkACLID) {
 		subnet := d.Get(isSubnetID).(string)
@@ -352,38 +354,38 @@ func resourceIBMISSubnetNetworkACLAttachmentUpdate(d *schema.ResourceData, meta
 		replaceSubnetNetworkACLOptionsModel := new(vpcv1.ReplaceSubnetNetworkACLOptions)
 		replaceSubnetNetworkACLOptionsModel.ID = &subnet
 		replaceSubnetNetworkACLOptionsModel.NetworkACLIdentity = networkACLIdentityModel
-		resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
+		resultACL, response, err := sess.ReplaceSubnetNetworkACLWithContext(ctx, replaceSubnetNetworkACLOptionsModel)
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-			return fmt.Errorf("[ERROR] Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching a network ACL to a subnet %s\n%s", err, response))
 		}
 		log.Printf("[INFO] Updated subnet %s with Network ACL : %s", subnet, *resultACL.ID)
 
 		d.SetId(subnet)
-		return resourceIBMISSubnetNetworkACLAttachmentRead(d, meta)
+		return resourceIBMISSubnetNetworkACLAttachmentRead(ctx, d, meta)
 	}
 
-	return resourceIBMISSubnetNetworkACLAttachmentRead(d, meta)
+	return resourceIBMISSubnetNetworkACLAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// Set the subnet with VPC default network ACL
 	getSubnetOptions := &vpcv1.GetSubnetOptions{
 		ID: &id,
 	}
-	subnet, response, err := sess.GetSubnet(getSubnetOptions)
+	subnet, response, err := sess.GetSubnetWithContext(ctx, getSubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response))
 	}
 	// Fetch VPC
 	vpcID := *subnet.VPC.ID
@@ -391,13 +393,13 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 	getvpcOptions := &vpcv1.GetVPCOptions{
 		ID: &vpcID,
 	}
-	vpc, response, err := sess.GetVPC(getvpcOptions)
+	vpc, response, err := sess.GetVPCWithContext(ctx, getvpcOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response))
 	}
 
 	// Fetch default network ACL
@@ -411,11 +413,11 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 		replaceSubnetNetworkACLOptionsModel := new(vpcv1.ReplaceSubnetNetworkACLOptions)
 		replaceSubnetNetworkACLOptionsModel.ID = &id
 		replaceSubnetNetworkACLOptionsModel.NetworkACLIdentity = networkACLIdentityModel
-		resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
+		resultACL, response, err := sess.ReplaceSubnetNetworkACLWithContext(ctx, replaceSubnetNetworkACLOptionsModel)
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-			return fmt.Errorf("[ERROR] Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching a network ACL to a subnet %s\n%s", err, response))
 		}
 		log.Printf("[INFO] Updated subnet %s with VPC default Network ACL : %s", id, *resultACL.ID)
 	} else {
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -11,9 +12,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,12 +29,12 @@ const (
 
 func ResourceIBMISReservedIP() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISReservedIPCreate,
-		Read:     resourceIBMISReservedIPRead,
-		Update:   resourceIBMISReservedIPUpdate,
-		Delete:   resourceIBMISReservedIPDelete,
-		Exists:   resourceIBMISReservedIPExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISReservedIPCreate,
+		ReadContext:   resourceIBMISReservedIPRead,
+		UpdateContext: resourceIBMISReservedIPUpdate,
+		DeleteContext: resourceIBMISReservedIPDelete,
+		Exists:        resourceIBMISReservedIPExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -134,10 +136,10 @@ func ResourceIBMISSubnetReservedIPValidator() *validate.ResourceValidator {
 }
 
 // resourceIBMISReservedIPCreate Creates a reserved IP given a subnet ID
-func resourceIBMISReservedIPCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISReservedIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subnetID := d.Get(isSubNetID).(string)
@@ -166,30 +168,30 @@ func resourceIBMISReservedIPCreate(d *schema.ResourceData, meta interface{}) err
 			ID: &targetId,
 		}
 	}
-	rip, response, err := sess.CreateSubnetReservedIP(options)
+	rip, response, err := sess.CreateSubnetReservedIPWithContext(ctx, options)
 	if err != nil || response == nil || rip == nil {
-		return fmt.Errorf("[ERROR] Error creating the reserved IP: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating the reserved IP: %s\n%s", err, response))
 	}
 
 	// Set id for the reserved IP as combination of subnet ID and reserved IP ID
 	d.SetId(fmt.Sprintf("%s/%s", subnetID, *rip.ID))
-	_, err = isWaitForReservedIpAvailable(sess, subnetID, *rip.ID, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForReservedIpAvailable(ctx, sess, subnetID, *rip.ID, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for the reserved IP to be available: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the reserved IP to be available: %s", err))
 	}
 
-	return resourceIBMISReservedIPRead(d, meta)
+	return resourceIBMISReservedIPRead(ctx, d, meta)
 }
 
-func resourceIBMISReservedIPRead(d *schema.ResourceData, meta interface{}) error {
-	rip, err := get(d, meta)
+func resourceIBMISReservedIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rip, err := get(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	allIDs, err := flex.IdParts(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] The ID can not be split into subnet ID and reserved IP ID. %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] The ID can not be split into subnet ID and reserved IP ID. %s", err))
 	}
 	subnetID := allIDs[0]
 
@@ -240,7 +242,7 @@ func resourceIBMISReservedIPRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMISReservedIPUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISReservedIPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	// For updating the name
 	nameChanged := d.HasChange(isReservedIPName)
@@ -249,12 +251,12 @@ func resourceIBMISReservedIPUpdate(d *schema.ResourceData, meta interface{}) err
 	if nameChanged || autoDeleteChanged {
 		sess, err := vpcClient(meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		allIDs, err := flex.IdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		subnetID := allIDs[0]
 		reservedIPID := allIDs[1]
@@ -278,24 +280,24 @@ func resourceIBMISReservedIPUpdate(d *schema.ResourceData, meta interface{}) err
 
 		reservedIPPatch, err := patch.AsPatch()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the reserved IP %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the reserved IP %s", err))
 		}
 
 		options.ReservedIPPatch = reservedIPPatch
 
-		_, response, err := sess.UpdateSubnetReservedIP(options)
+		_, response, err := sess.UpdateSubnetReservedIPWithContext(ctx, options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the reserved IP %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the reserved IP %s\n%s", err, response))
 		}
 	}
-	return resourceIBMISReservedIPRead(d, meta)
+	return resourceIBMISReservedIPRead(ctx, d, meta)
 }
 
-func resourceIBMISReservedIPDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISReservedIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	rip, err := get(d, meta)
+	rip, err := get(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err == nil && rip == nil {
 		// If there is no such reserved IP, it can not be deleted
@@ -304,25 +306,25 @@ func resourceIBMISReservedIPDelete(d *schema.ResourceData, meta interface{}) err
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	allIDs, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	subnetID := allIDs[0]
 	reservedIPID := allIDs[1]
 	deleteOptions := sess.NewDeleteSubnetReservedIPOptions(subnetID, reservedIPID)
-	response, err := sess.DeleteSubnetReservedIP(deleteOptions)
+	response, err := sess.DeleteSubnetReservedIPWithContext(ctx, deleteOptions)
 	if err != nil || response == nil {
-		return fmt.Errorf("[ERROR] Error deleting the reserverd ip %s in subnet %s, %s\n%s", reservedIPID, subnetID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the reserverd ip %s in subnet %s, %s\n%s", reservedIPID, subnetID, err, response))
 	}
 	d.SetId("")
 	return nil
 }
 
 func resourceIBMISReservedIPExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	rip, err := get(d, meta)
+	rip, err := get(context.Background(), d, meta)
 	if err != nil {
 		return false, err
 	}
@@ -333,7 +335,7 @@ func resourceIBMISReservedIPExists(d *schema.ResourceData, meta interface{}) (bo
 }
 
 // get is a generic function that gets the reserved ip given subnet id and reserved ip
-func get(d *schema.ResourceData, meta interface{}) (*vpcv1.ReservedIP, error) {
+func get(ctx context.Context, d *schema.ResourceData, meta interface{}) (*vpcv1.ReservedIP, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return nil, err
@@ -342,7 +344,7 @@ func get(d *schema.ResourceData, meta interface{}) (*vpcv1.ReservedIP, error) {
 	subnetID := allIDs[0]
 	reservedIPID := allIDs[1]
 	options := sess.NewGetSubnetReservedIPOptions(subnetID, reservedIPID)
-	rip, response, err := sess.GetSubnetReservedIP(options)
+	rip, response, err := sess.GetSubnetReservedIPWithContext(ctx, options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -353,26 +355,28 @@ func get(d *schema.ResourceData, meta interface{}) (*vpcv1.ReservedIP, error) {
 	return rip, nil
 }
 
-func isWaitForReservedIpAvailable(sess *vpcv1.VpcV1, subnetid, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForReservedIpAvailable(ctx context.Context, sess *vpcv1.VpcV1, subnetid, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for reseved ip (%s/%s) to be available.", subnetid, id)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"pending"},
-		Target:     []string{"done", "failed", ""},
-		Refresh:    isReserveIpRefreshFunc(sess, subnetid, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("reserved IP %s of subnet %s to be available", id, subnetid),
+		Pending:     []string{"pending"},
+		Target:      []string{"done", ""},
+		Failed:      []string{"failed"},
+		Refresh:     isReserveIpRefreshFunc(ctx, sess, subnetid, id, d),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isReserveIpRefreshFunc(sess *vpcv1.VpcV1, subnetid, id string, d *schema.ResourceData) resource.StateRefreshFunc {
+func isReserveIpRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, subnetid, id string, d *schema.ResourceData) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getreservedipOptions := &vpcv1.GetSubnetReservedIPOptions{
 			ID:       &id,
 			SubnetID: &subnetid,
 		}
-		rsip, response, err := sess.GetSubnetReservedIP(getreservedipOptions)
+		rsip, response, err := sess.GetSubnetReservedIPWithContext(ctx, getreservedipOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting reserved ip(%s/%s) : %s\n%s", subnetid, id, err, response)
 		}
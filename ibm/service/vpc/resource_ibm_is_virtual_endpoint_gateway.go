@@ -12,10 +12,11 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -46,16 +47,16 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 	targetNameFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetName)
 	targetCRNFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetCRN)
 	return &schema.Resource{
-		Create:   resourceIBMisVirtualEndpointGatewayCreate,
-		Read:     resourceIBMisVirtualEndpointGatewayRead,
-		Update:   resourceIBMisVirtualEndpointGatewayUpdate,
-		Delete:   resourceIBMisVirtualEndpointGatewayDelete,
-		Exists:   resourceIBMisVirtualEndpointGatewayExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisVirtualEndpointGatewayCreate,
+		ReadContext:   resourceIBMisVirtualEndpointGatewayRead,
+		UpdateContext: resourceIBMisVirtualEndpointGatewayUpdate,
+		DeleteContext: resourceIBMisVirtualEndpointGatewayDelete,
+		Exists:        resourceIBMisVirtualEndpointGatewayExists,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -238,10 +239,10 @@ func ResourceIBMISEndpointGatewayValidator() *validate.ResourceValidator {
 	return &ibmEndpointGatewayResourceValidator
 }
 
-func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get(isVirtualEndpointGatewayName).(string)
@@ -300,10 +301,10 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 		opt.SetResourceGroup(resourceGroupOpt)
 
 	}
-	result, response, err := sess.CreateEndpointGateway(opt)
+	result, response, err := sess.CreateEndpointGatewayWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Create Endpoint Gateway failed: %v", response)
-		return fmt.Errorf("Create Endpoint Gateway failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Create Endpoint Gateway failed %s\n%s", err, response))
 	}
 
 	d.SetId(*result.ID)
@@ -316,13 +317,13 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 				"Error on create of VPE (%s) tags: %s", d.Id(), err)
 		}
 	}
-	return resourceIBMisVirtualEndpointGatewayRead(d, meta)
+	return resourceIBMisVirtualEndpointGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange(isVirtualEndpointGatewayName) {
@@ -333,10 +334,10 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		endpointGatewayPatchModel.Name = core.StringPtr(name)
 		endpointGatewayPatchModelAsPatch, _ := endpointGatewayPatchModel.AsPatch()
 		opt := sess.NewUpdateEndpointGatewayOptions(d.Id(), endpointGatewayPatchModelAsPatch)
-		_, response, err := sess.UpdateEndpointGateway(opt)
+		_, response, err := sess.UpdateEndpointGatewayWithContext(ctx, opt)
 		if err != nil {
 			log.Printf("Update Endpoint Gateway failed: %v", response)
-			return fmt.Errorf("Update Endpoint Gateway failed : %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Update Endpoint Gateway failed : %s\n%s", err, response))
 		}
 
 	}
@@ -353,13 +354,13 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 				createSecurityGroupTargetBindingOptions := &vpcv1.CreateSecurityGroupTargetBindingOptions{}
 				createSecurityGroupTargetBindingOptions.SecurityGroupID = &sgId
 				createSecurityGroupTargetBindingOptions.ID = &id
-				_, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
+				_, response, err := sess.CreateSecurityGroupTargetBindingWithContext(ctx, createSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("Error while creating Security Group Target Binding %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("Error while creating Security Group Target Binding %s\n%s", err, response))
 				}
-				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualEndpointGatewayAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -369,21 +370,21 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 					SecurityGroupID: &sgId,
 					ID:              &id,
 				}
-				_, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+				_, response, err := sess.GetSecurityGroupTargetWithContext(ctx, getSecurityGroupTargetOptions)
 				if err != nil {
 					if response != nil && response.StatusCode == 404 {
 						continue
 					}
-					return fmt.Errorf("Error Getting Security Group Target for this endpoint gateway (%s): %s\n%s", sgId, err, response)
+					return diag.FromErr(fmt.Errorf("Error Getting Security Group Target for this endpoint gateway (%s): %s\n%s", sgId, err, response))
 				}
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(sgId, id)
-				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
+				response, err = sess.DeleteSecurityGroupTargetBindingWithContext(ctx, deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("Error Deleting Security Group Target for this endpoint gateway : %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("Error Deleting Security Group Target for this endpoint gateway : %s\n%s", err, response))
 				}
-				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualEndpointGatewayAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -391,9 +392,9 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 	}
 	if d.HasChange(isVirtualEndpointGatewayTags) {
 		opt := sess.NewGetEndpointGatewayOptions(d.Id())
-		result, response, err := sess.GetEndpointGateway(opt)
+		result, response, err := sess.GetEndpointGatewayWithContext(ctx, opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting VPE: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting VPE: %s\n%s", err, response))
 		}
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *result.CRN)
@@ -402,24 +403,24 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 				"Error on update of VPE (%s) tags: %s", d.Id(), err)
 		}
 	}
-	return resourceIBMisVirtualEndpointGatewayRead(d, meta)
+	return resourceIBMisVirtualEndpointGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// read option
 	opt := sess.NewGetEndpointGatewayOptions(d.Id())
-	result, response, err := sess.GetEndpointGateway(opt)
+	result, response, err := sess.GetEndpointGatewayWithContext(ctx, opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
 		log.Printf("Get Endpoint Gateway failed: %v", response)
-		return fmt.Errorf("Get Endpoint Gateway failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Get Endpoint Gateway failed %s\n%s", err, response))
 	}
 	d.Set(isVirtualEndpointGatewayName, result.Name)
 	d.Set(isVirtualEndpointGatewayHealthState, result.HealthState)
@@ -455,26 +456,28 @@ func flattenDataSourceSecurityGroups(securityGroupList []vpcv1.SecurityGroupRefe
 	return securitygroupList
 }
 
-func isWaitForVirtualEndpointGatewayAvailable(sess *vpcv1.VpcV1, endPointGatewayId string, timeout time.Duration) (interface{}, error) {
+func isWaitForVirtualEndpointGatewayAvailable(ctx context.Context, sess *vpcv1.VpcV1, endPointGatewayId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for virtual endpoint gateway (%s) to be available.", endPointGatewayId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"waiting", "pending", "updating"},
-		Target:     []string{"stable", "failed", ""},
-		Refresh:    isVirtualEndpointGatewayRefreshFunc(sess, endPointGatewayId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("virtual endpoint gateway %s to be available", endPointGatewayId),
+		Pending:     []string{"waiting", "pending", "updating"},
+		Target:      []string{"stable", ""},
+		Failed:      []string{"failed"},
+		Refresh:     isVirtualEndpointGatewayRefreshFunc(ctx, sess, endPointGatewayId),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isVirtualEndpointGatewayRefreshFunc(sess *vpcv1.VpcV1, endPointGatewayId string) resource.StateRefreshFunc {
+func isVirtualEndpointGatewayRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, endPointGatewayId string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 
 		opt := sess.NewGetEndpointGatewayOptions(endPointGatewayId)
-		result, response, err := sess.GetEndpointGateway(opt)
+		result, response, err := sess.GetEndpointGatewayWithContext(ctx, opt)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil, "", fmt.Errorf("Error Getting Virtual Endpoint Gateway : %s\n%s", err, response)
@@ -487,17 +490,17 @@ func isVirtualEndpointGatewayRefreshFunc(sess *vpcv1.VpcV1, endPointGatewayId st
 	}
 }
 
-func resourceIBMisVirtualEndpointGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	opt := sess.NewDeleteEndpointGatewayOptions(d.Id())
-	response, err := sess.DeleteEndpointGateway(opt)
+	response, err := sess.DeleteEndpointGatewayWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Delete Endpoint Gateway failed: %v", response)
-		return fmt.Errorf("Delete Endpoint Gateway failed : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Delete Endpoint Gateway failed : %s\n%s", err, response))
 	}
 	return nil
 }
@@ -4,12 +4,14 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,11 +31,11 @@ const (
 
 func ResourceIBMISEndpointGatewayIP() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisVirtualEndpointGatewayIPCreate,
-		Read:     resourceIBMisVirtualEndpointGatewayIPRead,
-		Delete:   resourceIBMisVirtualEndpointGatewayIPDelete,
-		Exists:   resourceIBMisVirtualEndpointGatewayIPExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisVirtualEndpointGatewayIPCreate,
+		ReadContext:   resourceIBMisVirtualEndpointGatewayIPRead,
+		DeleteContext: resourceIBMisVirtualEndpointGatewayIPDelete,
+		Exists:        resourceIBMisVirtualEndpointGatewayIPExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -107,39 +109,39 @@ func ResourceIBMISEndpointGatewayIP() *schema.Resource {
 	}
 }
 
-func resourceIBMisVirtualEndpointGatewayIPCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	gatewayID := d.Get(isVirtualEndpointGatewayID).(string)
 	ipID := d.Get(isVirtualEndpointGatewayIPID).(string)
 	opt := sess.NewAddEndpointGatewayIPOptions(gatewayID, ipID)
-	_, response, err := sess.AddEndpointGatewayIP(opt)
+	_, response, err := sess.AddEndpointGatewayIPWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Add Endpoint Gateway failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, ipID))
-	return resourceIBMisVirtualEndpointGatewayIPRead(d, meta)
+	return resourceIBMisVirtualEndpointGatewayIPRead(ctx, d, meta)
 }
 
-func resourceIBMisVirtualEndpointGatewayIPRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	gatewayID := parts[0]
 	ipID := parts[1]
 	opt := sess.NewGetEndpointGatewayIPOptions(gatewayID, ipID)
-	result, response, err := sess.GetEndpointGatewayIP(opt)
+	result, response, err := sess.GetEndpointGatewayIPWithContext(ctx, opt)
 	if err != nil {
 		log.Printf("Get Endpoint Gateway IP failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(isVirtualEndpointGatewayIPID, result.ID)
 	d.Set(isVirtualEndpointGatewayIPName, result.Name)
@@ -152,22 +154,22 @@ func resourceIBMisVirtualEndpointGatewayIPRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIBMisVirtualEndpointGatewayIPDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	gatewayID := parts[0]
 	ipID := parts[1]
 	opt := sess.NewRemoveEndpointGatewayIPOptions(gatewayID, ipID)
-	response, err := sess.RemoveEndpointGatewayIP(opt)
+	response, err := sess.RemoveEndpointGatewayIPWithContext(ctx, opt)
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Remove Endpoint Gateway IP failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -12,9 +12,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -45,12 +46,12 @@ const (
 
 func ResourceIBMISVolume() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVolumeCreate,
-		Read:     resourceIBMISVolumeRead,
-		Update:   resourceIBMISVolumeUpdate,
-		Delete:   resourceIBMISVolumeDelete,
-		Exists:   resourceIBMISVolumeExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVolumeCreate,
+		ReadContext:   resourceIBMISVolumeRead,
+		UpdateContext: resourceIBMISVolumeUpdate,
+		DeleteContext: resourceIBMISVolumeDelete,
+		Exists:        resourceIBMISVolumeExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -60,7 +61,7 @@ func ResourceIBMISVolume() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -271,7 +272,7 @@ func ResourceIBMISVolumeValidator() *validate.ResourceValidator {
 	return &ibmISVolumeResourceValidator
 }
 
-func resourceIBMISVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	volName := d.Get(isVolumeName).(string)
 	profile := d.Get(isVolumeProfileName).(string)
@@ -283,15 +284,15 @@ func resourceIBMISVolumeCreate(d *schema.ResourceData, meta interface{}) error {
 		volCapacity = 100
 	}
 
-	err := volCreate(d, meta, volName, profile, zone, volCapacity)
+	err := volCreate(ctx, d, meta, volName, profile, zone, volCapacity)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMISVolumeRead(d, meta)
+	return resourceIBMISVolumeRead(ctx, d, meta)
 }
 
-func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone string, volCapacity int64) error {
+func volCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, volName, profile, zone string, volCapacity int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -329,13 +330,13 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		volTemplate.Iops = &iops
 	}
 
-	vol, response, err := sess.CreateVolume(options)
+	vol, response, err := sess.CreateVolumeWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create volume err %s\n%s", err, response)
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
-	_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVolumeAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -351,17 +352,17 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 	return nil
 }
 
-func resourceIBMISVolumeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
-	err := volGet(d, meta, id)
+	err := volGet(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func volGet(d *schema.ResourceData, meta interface{}, id string) error {
+func volGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -369,7 +370,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.GetVolumeOptions{
 		ID: &id,
 	}
-	vol, response, err := sess.GetVolume(options)
+	vol, response, err := sess.GetVolumeWithContext(ctx, options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -432,7 +433,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	name := ""
@@ -448,21 +449,21 @@ func resourceIBMISVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 		hasNameChanged = true
 	}
 
-	err := volUpdate(d, meta, id, name, hasNameChanged, delete)
+	err := volUpdate(ctx, d, meta, id, name, hasNameChanged, delete)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISVolumeRead(d, meta)
+	return resourceIBMISVolumeRead(ctx, d, meta)
 }
 
-func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNameChanged, delete bool) error {
+func volUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasNameChanged, delete bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
 	}
 	var capacity int64
 	if delete {
-		deleteAllSnapshots(sess, id)
+		deleteAllSnapshots(ctx, sess, id)
 	}
 
 	// tags update
@@ -470,7 +471,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		options := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
-		vol, response, err := sess.GetVolume(options)
+		vol, response, err := sess.GetVolumeWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Volume : %s\n%s", err, response)
 		}
@@ -495,8 +496,8 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			return fmt.Errorf("[ERROR] Error calling asPatch for volumeNamePatch: %s", err)
 		}
 		options.VolumePatch = volumeNamePatch
-		_, _, err = sess.UpdateVolume(options)
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, _, err = sess.UpdateVolumeWithContext(ctx, options)
+		_, err = isWaitForVolumeAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -509,7 +510,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		getvoloptions := &vpcv1.GetVolumeOptions{
 			ID: &volId,
 		}
-		vol, response, err := sess.GetVolume(getvoloptions)
+		vol, response, err := sess.GetVolumeWithContext(ctx, getvoloptions)
 		if err != nil || vol == nil {
 			return fmt.Errorf("[ERROR] Error retrieving Volume (%s) details: %s\n%s", volId, err, response)
 		}
@@ -521,7 +522,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &insId,
 		}
-		instance, response, err := sess.GetInstance(getinsOptions)
+		instance, response, err := sess.GetInstanceWithContext(ctx, getinsOptions)
 		if err != nil || instance == nil {
 			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) to which the volume (%s) is attached : %s\n%s", insId, volId, err, response)
 		}
@@ -531,11 +532,11 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 				InstanceID: &insId,
 				Type:       &actiontype,
 			}
-			_, response, err = sess.CreateInstanceAction(createinsactoptions)
+			_, response, err = sess.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error starting Instance (%s) to which the volume (%s) is attached  : %s\n%s", insId, volId, err, response)
 			}
-			_, err = isWaitForInstanceAvailable(sess, insId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForInstanceAvailable(ctx, sess, insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				return err
 			}
@@ -559,8 +560,8 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			return fmt.Errorf("[ERROR] Error calling asPatch for VolumeProfilePatch: %s", err)
 		}
 		options.VolumePatch = volumeProfilePatch
-		_, response, err = sess.UpdateVolume(options)
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, response, err = sess.UpdateVolumeWithContext(ctx, options)
+		_, err = isWaitForVolumeAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -572,7 +573,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		getvolumeoptions := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
-		vol, response, err := sess.GetVolume(getvolumeoptions)
+		vol, response, err := sess.GetVolumeWithContext(ctx, getvolumeoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				d.SetId("")
@@ -587,7 +588,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: insId,
 		}
-		instance, response, err := sess.GetInstance(getinsOptions)
+		instance, response, err := sess.GetInstanceWithContext(ctx, getinsOptions)
 		if err != nil || instance == nil {
 			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", *insId, err, response)
 		}
@@ -597,11 +598,11 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 				InstanceID: insId,
 				Type:       &actiontype,
 			}
-			_, response, err = sess.CreateInstanceAction(createinsactoptions)
+			_, response, err = sess.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", *insId, err, response)
 			}
-			_, err = isWaitForInstanceAvailable(sess, *insId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForInstanceAvailable(ctx, sess, *insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				return err
 			}
@@ -615,11 +616,11 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			return fmt.Errorf("[ERROR] Error calling asPatch for volumeCapacityPatch: %s", err)
 		}
 		options.VolumePatch = volumeCapacityPatch
-		_, response, err = sess.UpdateVolume(options)
+		_, response, err = sess.UpdateVolumeWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating vpc volume: %s\n%s", err, response)
 		}
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -628,17 +629,17 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 	return nil
 }
 
-func resourceIBMISVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
-	err := volDelete(d, meta, id)
+	err := volDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func volDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -647,7 +648,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getvoloptions := &vpcv1.GetVolumeOptions{
 		ID: &id,
 	}
-	volDetails, response, err := sess.GetVolume(getvoloptions)
+	volDetails, response, err := sess.GetVolumeWithContext(ctx, getvoloptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -661,11 +662,11 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 				InstanceID: volAtt.Instance.ID,
 				ID:         volAtt.ID,
 			}
-			_, err := sess.DeleteInstanceVolumeAttachment(deleteVolumeAttachment)
+			_, err := sess.DeleteInstanceVolumeAttachmentWithContext(ctx, deleteVolumeAttachment)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error while removing volume attachment %q for instance %s: %q", *volAtt.ID, *volAtt.Instance.ID, err)
 			}
-			_, err = isWaitForInstanceVolumeDetached(sess, d, d.Id(), *volAtt.ID)
+			_, err = isWaitForInstanceVolumeDetached(ctx, sess, d, d.Id(), *volAtt.ID)
 			if err != nil {
 				return err
 			}
@@ -676,11 +677,11 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.DeleteVolumeOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteVolume(options)
+	response, err = sess.DeleteVolumeWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting Volume : %s\n%s", err, response)
 	}
-	_, err = isWaitForVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVolumeDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -688,27 +689,27 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeDeleted(ctx context.Context, vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVolumeDeleting},
-		Target:     []string{"done", ""},
-		Refresh:    isVolumeDeleteRefreshFunc(vol, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", isVolumeDeleting},
+		Target:   []string{"done", ""},
+		Refresh:  isVolumeDeleteRefreshFunc(ctx, vol, id),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVolumeDeleteRefreshFunc(ctx context.Context, vol *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		volgetoptions := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
-		vol, response, err := vol.GetVolume(volgetoptions)
+		vol, response, err := vol.GetVolumeWithContext(ctx, volgetoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return vol, isVolumeDeleted, nil
@@ -745,27 +746,27 @@ func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	return true, nil
 }
 
-func isWaitForVolumeAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeAvailable(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVolumeProvisioning},
-		Target:     []string{isVolumeProvisioningDone, ""},
-		Refresh:    isVolumeRefreshFunc(client, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", isVolumeProvisioning},
+		Target:   []string{isVolumeProvisioningDone, ""},
+		Refresh:  isVolumeRefreshFunc(ctx, client, id),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVolumeRefreshFunc(ctx context.Context, client *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		volgetoptions := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
-		vol, response, err := client.GetVolume(volgetoptions)
+		vol, response, err := client.GetVolumeWithContext(ctx, volgetoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting volume: %s\n%s", err, response)
 		}
@@ -778,10 +779,10 @@ func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFu
 	}
 }
 
-func deleteAllSnapshots(sess *vpcv1.VpcV1, id string) error {
+func deleteAllSnapshots(ctx context.Context, sess *vpcv1.VpcV1, id string) error {
 	delete_all_snapshots := new(vpcv1.DeleteSnapshotsOptions)
 	delete_all_snapshots.SourceVolumeID = &id
-	response, err := sess.DeleteSnapshots(delete_all_snapshots)
+	response, err := sess.DeleteSnapshotsWithContext(ctx, delete_all_snapshots)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting snapshots from volume %s\n%s", err, response)
 	}
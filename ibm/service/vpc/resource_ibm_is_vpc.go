@@ -12,12 +12,13 @@ import (
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 const (
@@ -62,12 +63,12 @@ const (
 
 func ResourceIBMISVPC() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPCCreate,
-		Read:     resourceIBMISVPCRead,
-		Update:   resourceIBMISVPCUpdate,
-		Delete:   resourceIBMISVPCDelete,
-		Exists:   resourceIBMISVPCExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPCCreate,
+		ReadContext:   resourceIBMISVPCRead,
+		UpdateContext: resourceIBMISVPCUpdate,
+		DeleteContext: resourceIBMISVPCDelete,
+		Exists:        resourceIBMISVPCExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -76,7 +77,7 @@ func ResourceIBMISVPC() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -431,7 +432,7 @@ func ResourceIBMISVPCValidator() *validate.ResourceValidator {
 	return &ibmISVPCResourceValidator
 }
 
-func resourceIBMISVPCCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] VPC create")
 	name := d.Get(isVPCName).(string)
@@ -449,14 +450,14 @@ func resourceIBMISVPCCreate(d *schema.ResourceData, meta interface{}) error {
 	if grp, ok := d.GetOk(isVPCResourceGroup); ok {
 		rg = grp.(string)
 	}
-	err := vpcCreate(d, meta, name, apm, rg, isClassic)
+	err := vpcCreate(ctx, d, meta, name, apm, rg, isClassic)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISVPCRead(d, meta)
+	return resourceIBMISVPCRead(ctx, d, meta)
 }
 
-func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, isClassic bool) error {
+func vpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, apm, rg string, isClassic bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -474,26 +475,26 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	}
 	options.ClassicAccess = &isClassic
 
-	vpc, response, err := sess.CreateVPC(options)
+	vpc, response, err := sess.CreateVPCWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating VPC %s ", flex.BeautifyError(err, response))
 	}
 	d.SetId(*vpc.ID)
 
 	if defaultSGName, ok := d.GetOk(isVPCDefaultSecurityGroupName); ok {
-		sgNameUpdate(sess, *vpc.DefaultSecurityGroup.ID, defaultSGName.(string))
+		sgNameUpdate(ctx, sess, *vpc.DefaultSecurityGroup.ID, defaultSGName.(string))
 	}
 
 	if defaultRTName, ok := d.GetOk(isVPCDefaultRoutingTableName); ok {
-		rtNameUpdate(sess, *vpc.ID, *vpc.DefaultRoutingTable.ID, defaultRTName.(string))
+		rtNameUpdate(ctx, sess, *vpc.ID, *vpc.DefaultRoutingTable.ID, defaultRTName.(string))
 	}
 
 	if defaultACLName, ok := d.GetOk(isVPCDefaultNetworkACLName); ok {
-		nwaclNameUpdate(sess, *vpc.DefaultNetworkACL.ID, defaultACLName.(string))
+		nwaclNameUpdate(ctx, sess, *vpc.DefaultNetworkACL.ID, defaultACLName.(string))
 	}
 
 	log.Printf("[INFO] VPC : %s", *vpc.ID)
-	_, err = isWaitForVPCAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVPCAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -509,27 +510,29 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	return nil
 }
 
-func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isVPCPending},
-		Target:     []string{isVPCAvailable, isVPCFailed},
-		Refresh:    isVPCRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("VPC %s to be available", id),
+		Pending:     []string{isVPCPending},
+		Target:      []string{isVPCAvailable},
+		Failed:      []string{isVPCFailed},
+		Refresh:     isVPCRefreshFunc(ctx, vpc, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVPCRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := vpc.GetVPC(getvpcOptions)
+		vpc, response, err := vpc.GetVPCWithContext(ctx, getvpcOptions)
 		if err != nil {
 			return nil, isVPCFailed, fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
 		}
@@ -542,16 +545,16 @@ func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	}
 }
 
-func resourceIBMISVPCRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := vpcGet(d, meta, id)
+	err := vpcGet(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
+func vpcGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -559,7 +562,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getvpcOptions := &vpcv1.GetVPCOptions{
 		ID: &id,
 	}
-	vpc, response, err := sess.GetVPC(getvpcOptions)
+	vpc, response, err := sess.GetVPCWithContext(ctx, getvpcOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -631,7 +634,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		if start != "" {
 			options.Start = &start
 		}
-		s, response, err := sess.ListSubnets(options)
+		s, response, err := sess.ListSubnetsWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
 		}
@@ -662,7 +665,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	listSgOptions := &vpcv1.ListSecurityGroupsOptions{
 		VPCID: &vpcid,
 	}
-	sgs, _, err := sess.ListSecurityGroups(listSgOptions)
+	sgs, _, err := sess.ListSecurityGroupsWithContext(ctx, listSgOptions)
 	if err != nil {
 		return err
 	}
@@ -779,7 +782,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISVPCUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
 	name := ""
@@ -789,14 +792,14 @@ func resourceIBMISVPCUpdate(d *schema.ResourceData, meta interface{}) error {
 		name = d.Get(isVPCName).(string)
 		hasChanged = true
 	}
-	err := vpcUpdate(d, meta, id, name, hasChanged)
+	err := vpcUpdate(ctx, d, meta, id, name, hasChanged)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISVPCRead(d, meta)
+	return resourceIBMISVPCRead(ctx, d, meta)
 }
 
-func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func vpcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -805,7 +808,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := sess.GetVPC(getvpcOptions)
+		vpc, response, err := sess.GetVPCWithContext(ctx, getvpcOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
 		}
@@ -819,17 +822,17 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 
 	if d.HasChange(isVPCDefaultSecurityGroupName) {
 		if defaultSGName, ok := d.GetOk(isVPCDefaultSecurityGroupName); ok {
-			sgNameUpdate(sess, d.Get(isVPCDefaultSecurityGroup).(string), defaultSGName.(string))
+			sgNameUpdate(ctx, sess, d.Get(isVPCDefaultSecurityGroup).(string), defaultSGName.(string))
 		}
 	}
 	if d.HasChange(isVPCDefaultRoutingTableName) {
 		if defaultRTName, ok := d.GetOk(isVPCDefaultRoutingTableName); ok {
-			rtNameUpdate(sess, id, d.Get(isVPCDefaultRoutingTable).(string), defaultRTName.(string))
+			rtNameUpdate(ctx, sess, id, d.Get(isVPCDefaultRoutingTable).(string), defaultRTName.(string))
 		}
 	}
 	if d.HasChange(isVPCDefaultNetworkACLName) {
 		if defaultACLName, ok := d.GetOk(isVPCDefaultNetworkACLName); ok {
-			nwaclNameUpdate(sess, d.Get(isVPCDefaultNetworkACL).(string), defaultACLName.(string))
+			nwaclNameUpdate(ctx, sess, d.Get(isVPCDefaultNetworkACL).(string), defaultACLName.(string))
 		}
 	}
 
@@ -845,7 +848,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 			return fmt.Errorf("[ERROR] Error calling asPatch for VPCPatch: %s", err)
 		}
 		updateVpcOptions.VPCPatch = vpcPatch
-		_, response, err := sess.UpdateVPC(updateVpcOptions)
+		_, response, err := sess.UpdateVPCWithContext(ctx, updateVpcOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating VPC : %s\n%s", err, response)
 		}
@@ -853,17 +856,17 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	return nil
 }
 
-func resourceIBMISVPCDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	err := vpcDelete(d, meta, id)
+	err := vpcDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func vpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -872,7 +875,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getVpcOptions := &vpcv1.GetVPCOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetVPC(getVpcOptions)
+	_, response, err := sess.GetVPCWithContext(ctx, getVpcOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -884,11 +887,11 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deletevpcOptions := &vpcv1.DeleteVPCOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteVPC(deletevpcOptions)
+	response, err = sess.DeleteVPCWithContext(ctx, deletevpcOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting VPC : %s\n%s", err, response)
 	}
-	_, err = isWaitForVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPCDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -896,34 +899,39 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPCDeleting},
-		Target:     []string{isVPCDeleted, isVPCFailed},
-		Refresh:    isVPCDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("VPC %s to be deleted", id),
+		Pending:     []string{"retry", isVPCDeleting},
+		Target:      []string{isVPCDeleted},
+		Failed:      []string{isVPCFailed},
+		Refresh:     isVPCDeleteRefreshFunc(ctx, vpc, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVPCDeleteRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is vpc delete function here")
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := vpc.GetVPC(getvpcOptions)
+		vpc, response, err := vpc.GetVPCWithContext(ctx, getvpcOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return vpc, isVPCDeleted, nil
 			}
 			return nil, isVPCFailed, fmt.Errorf("[ERROR] The VPC %s failed to delete: %s\n%s", id, err, response)
 		}
+		if *vpc.Status == isVPCFailed {
+			return vpc, isVPCFailed, nil
+		}
 
 		return vpc, isVPCDeleting, nil
 	}
@@ -960,7 +968,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 // 	return conns.String(buf.String())
 // }
 
-func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
+func nwaclNameUpdate(ctx context.Context, sess *vpcv1.VpcV1, id, name string) error {
 	updateNetworkACLOptions := &vpcv1.UpdateNetworkACLOptions{
 		ID: &id,
 	}
@@ -972,14 +980,14 @@ func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 		return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLPatch: %s", err)
 	}
 	updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
-	_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
+	_, response, err := sess.UpdateNetworkACLWithContext(ctx, updateNetworkACLOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) name : %s\n%s", id, err, response)
 	}
 	return nil
 }
 
-func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
+func sgNameUpdate(ctx context.Context, sess *vpcv1.VpcV1, id, name string) error {
 	updateSecurityGroupOptions := &vpcv1.UpdateSecurityGroupOptions{
 		ID: &id,
 	}
@@ -991,14 +999,14 @@ func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 		return fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupPatch: %s", err)
 	}
 	updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
-	_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
+	_, response, err := sess.UpdateSecurityGroupWithContext(ctx, updateSecurityGroupOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Updating Security Group name : %s\n%s", err, response)
 	}
 	return nil
 }
 
-func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
+func rtNameUpdate(ctx context.Context, sess *vpcv1.VpcV1, vpcID, id, name string) error {
 	updateVpcRoutingTableOptions := new(vpcv1.UpdateVPCRoutingTableOptions)
 	updateVpcRoutingTableOptions.VPCID = &vpcID
 	updateVpcRoutingTableOptions.ID = &id
@@ -1009,7 +1017,7 @@ func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
 		return fmt.Errorf("[ERROR] Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr)
 	}
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
-	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
+	_, response, err := sess.UpdateVPCRoutingTableWithContext(ctx, updateVpcRoutingTableOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Updating Routing table name %s\n%s", err, response)
 	}
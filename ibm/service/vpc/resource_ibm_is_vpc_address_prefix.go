@@ -4,12 +4,14 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,12 +27,12 @@ const (
 
 func ResourceIBMISVpcAddressPrefix() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVpcAddressPrefixCreate,
-		Read:     resourceIBMISVpcAddressPrefixRead,
-		Update:   resourceIBMISVpcAddressPrefixUpdate,
-		Delete:   resourceIBMISVpcAddressPrefixDelete,
-		Exists:   resourceIBMISVpcAddressPrefixExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVpcAddressPrefixCreate,
+		ReadContext:   resourceIBMISVpcAddressPrefixRead,
+		UpdateContext: resourceIBMISVpcAddressPrefixUpdate,
+		DeleteContext: resourceIBMISVpcAddressPrefixDelete,
+		Exists:        resourceIBMISVpcAddressPrefixExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			isVPCAddressPrefixPrefixName: {
@@ -120,7 +122,7 @@ func ResourceIBMISAddressPrefixValidator() *validate.ResourceValidator {
 	return &ibmISAddressPrefixResourceValidator
 }
 
-func resourceIBMISVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	isDefault := false
 	prefixName := d.Get(isVPCAddressPrefixPrefixName).(string)
@@ -132,17 +134,19 @@ func resourceIBMISVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{
 	}
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isVPCAddressPrefixKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isVPCAddressPrefixKey)
 
-	err := vpcAddressPrefixCreate(d, meta, prefixName, zoneName, cidr, vpcID, isDefault)
+	err := vpcAddressPrefixCreate(ctx, d, meta, prefixName, zoneName, cidr, vpcID, isDefault)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISVpcAddressPrefixRead(d, meta)
+	return resourceIBMISVpcAddressPrefixRead(ctx, d, meta)
 }
 
-func vpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone, cidr, vpcID string, isDefault bool) error {
+func vpcAddressPrefixCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, zone, cidr, vpcID string, isDefault bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -156,7 +160,7 @@ func vpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone
 			Name: &zone,
 		},
 	}
-	addrPrefix, response, err := sess.CreateVPCAddressPrefix(options)
+	addrPrefix, response, err := sess.CreateVPCAddressPrefixWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating VPC Address Prefix %s\n%s", err, response)
 	}
@@ -167,23 +171,23 @@ func vpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone
 	return nil
 }
 
-func resourceIBMISVpcAddressPrefixRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vpcID := parts[0]
 	addrPrefixID := parts[1]
-	error := vpcAddressPrefixGet(d, meta, vpcID, addrPrefixID)
+	error := vpcAddressPrefixGet(ctx, d, meta, vpcID, addrPrefixID)
 	if error != nil {
-		return error
+		return diag.FromErr(error)
 	}
 
 	return nil
 }
 
-func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPrefixID string) error {
+func vpcAddressPrefixGet(ctx context.Context, d *schema.ResourceData, meta interface{}, vpcID, addrPrefixID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -192,7 +196,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 		VPCID: &vpcID,
 		ID:    &addrPrefixID,
 	}
-	addrPrefix, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
+	addrPrefix, response, err := sess.GetVPCAddressPrefixWithContext(ctx, getvpcAddressPrefixOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -212,7 +216,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 	getVPCOptions := &vpcv1.GetVPCOptions{
 		ID: &vpcID,
 	}
-	vpc, response, err := sess.GetVPC(getVPCOptions)
+	vpc, response, err := sess.GetVPCWithContext(ctx, getVPCOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting VPC : %s\n%s", err, response)
 	}
@@ -221,7 +225,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 	return nil
 }
 
-func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := ""
 	isDefault := false
@@ -230,14 +234,16 @@ func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	vpcID := parts[0]
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isVPCAddressPrefixKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isVPCAddressPrefixKey)
 
 	if d.HasChange(isVPCAddressPrefixPrefixName) {
 		name = d.Get(isVPCAddressPrefixPrefixName).(string)
@@ -247,15 +253,15 @@ func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{
 		isDefault = d.Get(isVPCAddressPrefixDefault).(bool)
 		hasIsDefaultChanged = true
 	}
-	error := vpcAddressPrefixUpdate(d, meta, vpcID, addrPrefixID, name, isDefault, hasNameChanged, hasIsDefaultChanged)
+	error := vpcAddressPrefixUpdate(ctx, d, meta, vpcID, addrPrefixID, name, isDefault, hasNameChanged, hasIsDefaultChanged)
 	if error != nil {
-		return error
+		return diag.FromErr(error)
 	}
 
-	return resourceIBMISVpcAddressPrefixRead(d, meta)
+	return resourceIBMISVpcAddressPrefixRead(ctx, d, meta)
 }
 
-func vpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, addrPrefixID, name string, isDefault, hasNameChanged, hasIsDefaultChanged bool) error {
+func vpcAddressPrefixUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, vpcID, addrPrefixID, name string, isDefault, hasNameChanged, hasIsDefaultChanged bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -278,7 +284,7 @@ func vpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, add
 			return fmt.Errorf("[ERROR] Error calling asPatch for AddressPrefixPatch: %s", err)
 		}
 		updatevpcAddressPrefixoptions.AddressPrefixPatch = addressPrefixPatch
-		_, response, err := sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
+		_, response, err := sess.UpdateVPCAddressPrefixWithContext(ctx, updatevpcAddressPrefixoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating VPC Address Prefix: %s\n%s", err, response)
 		}
@@ -286,29 +292,31 @@ func vpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, add
 	return nil
 }
 
-func resourceIBMISVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	vpcID := parts[0]
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	if err := meta.(conns.ClientSession).MutexKV().Lock(ctx, isVPCAddressPrefixKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer meta.(conns.ClientSession).MutexKV().Unlock(isVPCAddressPrefixKey)
 
-	error := vpcAddressPrefixDelete(d, meta, vpcID, addrPrefixID)
+	error := vpcAddressPrefixDelete(ctx, d, meta, vpcID, addrPrefixID)
 	if error != nil {
-		return error
+		return diag.FromErr(error)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, addrPrefixID string) error {
+func vpcAddressPrefixDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, vpcID, addrPrefixID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -318,7 +326,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		VPCID: &vpcID,
 		ID:    &addrPrefixID,
 	}
-	_, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
+	_, response, err := sess.GetVPCAddressPrefixWithContext(ctx, getvpcAddressPrefixOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -330,7 +338,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		VPCID: &vpcID,
 		ID:    &addrPrefixID,
 	}
-	response, err = sess.DeleteVPCAddressPrefix(deletevpcAddressPrefixOptions)
+	response, err = sess.DeleteVPCAddressPrefixWithContext(ctx, deletevpcAddressPrefixOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
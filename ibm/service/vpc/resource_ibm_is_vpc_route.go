@@ -4,14 +4,16 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -34,12 +36,12 @@ const (
 
 func ResourceIBMISVpcRoute() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVpcRouteCreate,
-		Read:     resourceIBMISVpcRouteRead,
-		Update:   resourceIBMISVpcRouteUpdate,
-		Delete:   resourceIBMISVpcRouteDelete,
-		Exists:   resourceIBMISVpcRouteExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVpcRouteCreate,
+		ReadContext:   resourceIBMISVpcRouteRead,
+		UpdateContext: resourceIBMISVpcRouteUpdate,
+		DeleteContext: resourceIBMISVpcRouteDelete,
+		Exists:        resourceIBMISVpcRouteExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -121,21 +123,21 @@ func ResourceIBMISRouteValidator() *validate.ResourceValidator {
 	return &ibmISRouteResourceValidator
 }
 
-func resourceIBMISVpcRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	routeName := d.Get(isVPCRouteName).(string)
 	zoneName := d.Get(isVPCRouteLocation).(string)
 	cidr := d.Get(isVPCRouteDestinationCIDR).(string)
 	vpcID := d.Get(isVPCRouteVPCID).(string)
 	nextHop := d.Get(isVPCRouteNextHop).(string)
-	err := vpcRouteCreate(d, meta, routeName, zoneName, cidr, vpcID, nextHop)
+	err := vpcRouteCreate(ctx, d, meta, routeName, zoneName, cidr, vpcID, nextHop)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISVpcRouteRead(d, meta)
+	return resourceIBMISVpcRouteRead(ctx, d, meta)
 }
 
-func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneName, cidr, vpcID, nextHop string) error {
+func vpcRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, routeName, zoneName, cidr, vpcID, nextHop string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -151,7 +153,7 @@ func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneNam
 			Name: &zoneName,
 		},
 	}
-	route, response, err := sess.CreateVPCRoute(createRouteOptions)
+	route, response, err := sess.CreateVPCRouteWithContext(ctx, createRouteOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating VPC Route err %s\n%s", err, response)
 	}
@@ -159,24 +161,26 @@ func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneNam
 
 	d.SetId(fmt.Sprintf("%s/%s", vpcID, routeID))
 
-	_, err = isWaitForRouteStable(sess, d, vpcID, routeID)
+	_, err = isWaitForRouteStable(ctx, sess, d, vpcID, routeID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
+func isWaitForRouteStable(ctx context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isRouteStatusPending, isRouteStatusUpdating},
-		Target:  []string{isRouteStatusStable, isRouteStatusFailed},
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("route %s of VPC %s to be stable", routeID, vpcID),
+		Pending:     []string{isRouteStatusPending, isRouteStatusUpdating},
+		Target:      []string{isRouteStatusStable},
+		Failed:      []string{isRouteStatusFailed},
 		Refresh: func() (interface{}, string, error) {
 			getVpcRouteOptions := &vpcv1.GetVPCRouteOptions{
 				VPCID: &vpcID,
 				ID:    &routeID,
 			}
-			route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
+			route, response, err := sess.GetVPCRouteWithContext(ctx, getVpcRouteOptions)
 			if err != nil {
 				return route, "", fmt.Errorf("[ERROR] Error Getting VPC Route: %s\n%s", err, response)
 			}
@@ -186,31 +190,31 @@ func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, rout
 			}
 			return route, *route.LifecycleState, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func resourceIBMISVpcRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vpcID := parts[0]
 	routeID := parts[1]
-	error := vpcRouteGet(d, meta, vpcID, routeID)
+	error := vpcRouteGet(ctx, d, meta, vpcID, routeID)
 	if error != nil {
-		return error
+		return diag.FromErr(error)
 	}
 	return nil
 }
 
-func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
+func vpcRouteGet(ctx context.Context, d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -219,7 +223,7 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 		VPCID: &vpcID,
 		ID:    &routeID,
 	}
-	route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
+	route, response, err := sess.GetVPCRouteWithContext(ctx, getVpcRouteOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -239,7 +243,7 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 	getVPCOptions := &vpcv1.GetVPCOptions{
 		ID: &vpcID,
 	}
-	vpc, response, err := sess.GetVPC(getVPCOptions)
+	vpc, response, err := sess.GetVPCWithContext(ctx, getVPCOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting VPC : %s\n%s", err, response)
 	}
@@ -248,14 +252,14 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 	return nil
 }
 
-func resourceIBMISVpcRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := ""
 	hasChanged := false
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vpcID := parts[0]
@@ -265,15 +269,15 @@ func resourceIBMISVpcRouteUpdate(d *schema.ResourceData, meta interface{}) error
 		hasChanged = true
 	}
 
-	error := vpcRouteUpdate(d, meta, vpcID, routeID, name, hasChanged)
+	error := vpcRouteUpdate(ctx, d, meta, vpcID, routeID, name, hasChanged)
 	if error != nil {
-		return error
+		return diag.FromErr(error)
 	}
 
-	return resourceIBMISVpcRouteRead(d, meta)
+	return resourceIBMISVpcRouteRead(ctx, d, meta)
 }
 
-func vpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, routeID, name string, hasChanged bool) error {
+func vpcRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, vpcID, routeID, name string, hasChanged bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -291,7 +295,7 @@ func vpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, routeID, na
 			return fmt.Errorf("[ERROR] Error calling asPatch for RoutePatch: %s", err)
 		}
 		updateVpcRouteOptions.RoutePatch = routePatch
-		_, response, err := sess.UpdateVPCRoute(updateVpcRouteOptions)
+		_, response, err := sess.UpdateVPCRouteWithContext(ctx, updateVpcRouteOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating VPC Route: %s\n%s", err, response)
 		}
@@ -299,23 +303,23 @@ func vpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, routeID, na
 	return nil
 }
 
-func resourceIBMISVpcRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	vpcID := parts[0]
 	routeID := parts[1]
-	error := vpcRouteDelete(d, meta, vpcID, routeID)
+	error := vpcRouteDelete(ctx, d, meta, vpcID, routeID)
 	if error != nil {
-		return error
+		return diag.FromErr(error)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
+func vpcRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -325,7 +329,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 		VPCID: &vpcID,
 		ID:    &routeID,
 	}
-	_, response, err := sess.GetVPCRoute(getVpcRouteOptions)
+	_, response, err := sess.GetVPCRouteWithContext(ctx, getVpcRouteOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -336,11 +340,11 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 		VPCID: &vpcID,
 		ID:    &routeID,
 	}
-	response, err = sess.DeleteVPCRoute(deleteRouteOptions)
+	response, err = sess.DeleteVPCRouteWithContext(ctx, deleteRouteOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting VPC Route: %s\n%s", err, response)
 	}
-	_, err = isWaitForVPCRouteDeleted(sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPCRouteDeleted(ctx, sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -348,18 +352,20 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 	return nil
 }
 
-func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCRouteDeleted(ctx context.Context, sess *vpcv1.VpcV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for VPC Route (%s) to be deleted.", routeID)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"retry", isRouteStatusDeleting},
-		Target:  []string{isRouteStatusDeleted, isRouteStatusFailed},
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("route %s of VPC %s to be deleted", routeID, vpcID),
+		Pending:     []string{"retry", isRouteStatusDeleting},
+		Target:      []string{isRouteStatusDeleted},
+		Failed:      []string{isRouteStatusFailed},
 		Refresh: func() (interface{}, string, error) {
 			getVpcRouteOptions := &vpcv1.GetVPCRouteOptions{
 				VPCID: &vpcID,
 				ID:    &routeID,
 			}
-			route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
+			route, response, err := sess.GetVPCRouteWithContext(ctx, getVpcRouteOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return route, isRouteStatusDeleted, nil
@@ -368,12 +374,12 @@ func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout
 			}
 			return route, isRouteStatusDeleting, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
 func resourceIBMISVpcRouteExists(d *schema.ResourceData, meta interface{}) (bool, error) {
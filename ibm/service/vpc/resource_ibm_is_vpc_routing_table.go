@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -37,12 +39,12 @@ const (
 
 func ResourceIBMISVPCRoutingTable() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPCRoutingTableCreate,
-		Read:     resourceIBMISVPCRoutingTableRead,
-		Update:   resourceIBMISVPCRoutingTableUpdate,
-		Delete:   resourceIBMISVPCRoutingTableDelete,
-		Exists:   resourceIBMISVPCRoutingTableExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPCRoutingTableCreate,
+		ReadContext:   resourceIBMISVPCRoutingTableRead,
+		UpdateContext: resourceIBMISVPCRoutingTableUpdate,
+		DeleteContext: resourceIBMISVPCRoutingTableDelete,
+		Exists:        resourceIBMISVPCRoutingTableExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -164,10 +166,10 @@ func ResourceIBMISVPCRoutingTableValidator() *validate.ResourceValidator {
 	return &ibmISVPCRoutingTableValidator
 }
 
-func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vpcID := d.Get(rtVpcID).(string)
@@ -187,32 +189,32 @@ func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}
 		routeVPCZoneIngress := d.Get(rtRouteVPCZoneIngress).(bool)
 		createVpcRoutingTableOptions.RouteVPCZoneIngress = &routeVPCZoneIngress
 	}
-	routeTable, response, err := sess.CreateVPCRoutingTable(createVpcRoutingTableOptions)
+	routeTable, response, err := sess.CreateVPCRoutingTableWithContext(ctx, createVpcRoutingTableOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create VPC Routing table err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", vpcID, *routeTable.ID))
 
-	return resourceIBMISVPCRoutingTableRead(d, meta)
+	return resourceIBMISVPCRoutingTableRead(ctx, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
 	getVpcRoutingTableOptions := sess.NewGetVPCRoutingTableOptions(idSet[0], idSet[1])
-	routeTable, response, err := sess.GetVPCRoutingTable(getVpcRoutingTableOptions)
+	routeTable, response, err := sess.GetVPCRoutingTableWithContext(ctx, getVpcRoutingTableOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC Routing table: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting VPC Routing table: %s\n%s", err, response))
 	}
 
 	d.Set(rtID, routeTable.ID)
@@ -241,10 +243,10 @@ func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -272,30 +274,30 @@ func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}
 	}
 	routingTablePatchModelAsPatch, asPatchErr := routingTablePatchModel.AsPatch()
 	if asPatchErr != nil {
-		return fmt.Errorf("[ERROR] Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr))
 	}
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
-	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
+	_, response, err := sess.UpdateVPCRoutingTableWithContext(ctx, updateVpcRoutingTableOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update VPC Routing table err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISVPCRoutingTableRead(d, meta)
+	return resourceIBMISVPCRoutingTableRead(ctx, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
 
 	deleteTableOptions := sess.NewDeleteVPCRoutingTableOptions(idSet[0], idSet[1])
-	response, err := sess.DeleteVPCRoutingTable(deleteTableOptions)
+	response, err := sess.DeleteVPCRoutingTableWithContext(ctx, deleteTableOptions)
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting VPC Routing table : %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
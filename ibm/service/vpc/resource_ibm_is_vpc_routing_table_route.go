@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,12 +29,12 @@ const (
 
 func ResourceIBMISVPCRoutingTableRoute() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPCRoutingTableRouteCreate,
-		Read:     resourceIBMISVPCRoutingTableRouteRead,
-		Update:   resourceIBMISVPCRoutingTableRouteUpdate,
-		Delete:   resourceIBMISVPCRoutingTableRouteDelete,
-		Exists:   resourceIBMISVPCRoutingTableRouteExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPCRoutingTableRouteCreate,
+		ReadContext:   resourceIBMISVPCRoutingTableRouteRead,
+		UpdateContext: resourceIBMISVPCRoutingTableRouteUpdate,
+		DeleteContext: resourceIBMISVPCRoutingTableRouteDelete,
+		Exists:        resourceIBMISVPCRoutingTableRouteExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -143,10 +145,10 @@ func ResourceIBMISVPCRoutingTableRouteValidator() *validate.ResourceValidator {
 	return &ibmVPCRoutingTableRouteValidator
 }
 
-func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vpcID := d.Get(rtVpcID).(string)
@@ -186,32 +188,32 @@ func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interf
 		createVpcRoutingTableRouteOptions.SetName(routeName)
 	}
 
-	route, response, err := sess.CreateVPCRoutingTableRoute(createVpcRoutingTableRouteOptions)
+	route, response, err := sess.CreateVPCRoutingTableRouteWithContext(ctx, createVpcRoutingTableRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create VPC Routing table route err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", vpcID, tableID, *route.ID))
 	d.Set(rID, *route.ID)
-	return resourceIBMISVPCRoutingTableRouteRead(d, meta)
+	return resourceIBMISVPCRoutingTableRouteRead(ctx, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
 	getVpcRoutingTableRouteOptions := sess.NewGetVPCRoutingTableRouteOptions(idSet[0], idSet[1], idSet[2])
-	route, response, err := sess.GetVPCRoutingTableRoute(getVpcRoutingTableRouteOptions)
+	route, response, err := sess.GetVPCRoutingTableRouteWithContext(ctx, getVpcRoutingTableRouteOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC Routing table route: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting VPC Routing table route: %s\n%s", err, response))
 	}
 
 	d.Set(rID, *route.ID)
@@ -236,10 +238,10 @@ func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceIBMISVPCRoutingTableRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -254,32 +256,32 @@ func resourceIBMISVPCRoutingTableRouteUpdate(d *schema.ResourceData, meta interf
 		routePatchModelAsPatch, patchErr := routePatchModel.AsPatch()
 
 		if patchErr != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for VPC Routing Table Route Patch: %s", patchErr)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for VPC Routing Table Route Patch: %s", patchErr))
 		}
 
 		updateVpcRoutingTableRouteOptions.RoutePatch = routePatchModelAsPatch
-		_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
+		_, response, err := sess.UpdateVPCRoutingTableRouteWithContext(ctx, updateVpcRoutingTableRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] Update VPC Routing table route err %s\n%s", err, response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMISVPCRoutingTableRouteRead(d, meta)
+	return resourceIBMISVPCRoutingTableRouteRead(ctx, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
 	deleteVpcRoutingTableRouteOptions := sess.NewDeleteVPCRoutingTableRouteOptions(idSet[0], idSet[1], idSet[2])
-	response, err := sess.DeleteVPCRoutingTableRoute(deleteVpcRoutingTableRouteOptions)
+	response, err := sess.DeleteVPCRoutingTableRouteWithContext(ctx, deleteVpcRoutingTableRouteOptions)
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting VPC Routing table route : %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// failedVPCClient returns a client of a VPC API whose VPCs are all failed.
+func failedVPCClient(t *testing.T) *vpcv1.VpcV1 {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": "r006-1", "name": "vpc-1", "status": %q}`, isVPCFailed)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestWaitForVPCFailed(t *testing.T) {
+	waits := map[string]func(context.Context, *vpcv1.VpcV1, string, time.Duration) (interface{}, error){
+		"available": isWaitForVPCAvailable,
+		"deleted":   isWaitForVPCDeleted,
+	}
+	for name, wait := range waits {
+		wait := wait
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			_, err := wait(context.Background(), failedVPCClient(t), "r006-1", time.Minute)
+			var failed *waiter.FailedStatusError
+			if !errors.As(err, &failed) || failed.Status != isVPCFailed {
+				t.Fatalf("expected the failed VPC to fail the wait, got %v", err)
+			}
+		})
+	}
+}
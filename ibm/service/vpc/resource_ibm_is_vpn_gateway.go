@@ -12,9 +12,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -40,12 +41,12 @@ const (
 
 func ResourceIBMISVPNGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPNGatewayCreate,
-		Read:     resourceIBMISVPNGatewayRead,
-		Update:   resourceIBMISVPNGatewayUpdate,
-		Delete:   resourceIBMISVPNGatewayDelete,
-		Exists:   resourceIBMISVPNGatewayExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPNGatewayCreate,
+		ReadContext:   resourceIBMISVPNGatewayRead,
+		UpdateContext: resourceIBMISVPNGatewayUpdate,
+		DeleteContext: resourceIBMISVPNGatewayDelete,
+		Exists:        resourceIBMISVPNGatewayExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -54,7 +55,7 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -242,21 +243,21 @@ func ResourceIBMISVPNGatewayValidator() *validate.ResourceValidator {
 	return &ibmISVPNGatewayResourceValidator
 }
 
-func resourceIBMISVPNGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] VPNGateway create")
 	name := d.Get(isVPNGatewayName).(string)
 	subnetID := d.Get(isVPNGatewaySubnet).(string)
 	mode := d.Get(isVPNGatewayMode).(string)
 
-	err := vpngwCreate(d, meta, name, subnetID, mode)
+	err := vpngwCreate(ctx, d, meta, name, subnetID, mode)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISVPNGatewayRead(d, meta)
+	return resourceIBMISVPNGatewayRead(ctx, d, meta)
 }
 
-func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode string) error {
+func vpngwCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, subnetID, mode string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -279,13 +280,13 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 		}
 	}
 
-	vpnGatewayIntf, response, err := sess.CreateVPNGateway(options)
+	vpnGatewayIntf, response, err := sess.CreateVPNGatewayWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create vpc VPN Gateway %s\n%s", err, response)
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
-	_, err = isWaitForVpnGatewayAvailable(sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVpnGatewayAvailable(ctx, sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -305,33 +306,38 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 	return nil
 }
 
-func isWaitForVpnGatewayAvailable(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayAvailable(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for vpn gateway (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayProvisioning},
-		Target:     []string{isVPNGatewayProvisioningDone, ""},
-		Refresh:    isVpnGatewayRefreshFunc(vpnGateway, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Description: fmt.Sprintf("VPN gateway %s to be available", id),
+		Pending:     []string{"retry", isVPNGatewayProvisioning},
+		Target:      []string{isVPNGatewayProvisioningDone, ""},
+		Failed:      []string{"failed"},
+		Refresh:     isVpnGatewayRefreshFunc(ctx, vpnGateway, id),
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		Interval:    10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVpnGatewayRefreshFunc(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
-		vpnGatewayIntf, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
+		vpnGatewayIntf, response, err := vpnGateway.GetVPNGatewayWithContext(ctx, getVpnGatewayOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Vpn Gateway: %s\n%s", err, response)
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
-		if *vpnGateway.Status == "available" || *vpnGateway.Status == "failed" || *vpnGateway.Status == "running" {
+		if *vpnGateway.Status == "failed" {
+			return vpnGateway, *vpnGateway.Status, nil
+		}
+		if *vpnGateway.Status == "available" || *vpnGateway.Status == "running" {
 			return vpnGateway, isVPNGatewayProvisioningDone, nil
 		}
 
@@ -339,17 +345,17 @@ func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateR
 	}
 }
 
-func resourceIBMISVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
-	err := vpngwGet(d, meta, id)
+	err := vpngwGet(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
+func vpngwGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -357,7 +363,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 		ID: &id,
 	}
-	vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
+	vpnGatewayIntf, response, err := sess.GetVPNGatewayWithContext(ctx, getVpnGatewayOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -429,7 +435,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	name := ""
 	hasChanged := false
@@ -439,14 +445,14 @@ func resourceIBMISVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		hasChanged = true
 	}
 
-	err := vpngwUpdate(d, meta, id, name, hasChanged)
+	err := vpngwUpdate(ctx, d, meta, id, name, hasChanged)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISVPNGatewayRead(d, meta)
+	return resourceIBMISVPNGatewayRead(ctx, d, meta)
 }
 
-func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func vpngwUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -455,7 +461,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
-		vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
+		vpnGatewayIntf, response, err := sess.GetVPNGatewayWithContext(ctx, getVpnGatewayOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Volume : %s\n%s", err, response)
 		}
@@ -480,7 +486,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 			return fmt.Errorf("[ERROR] Error calling asPatch for VPNGatewayPatch: %s", err)
 		}
 		options.VPNGatewayPatch = vpnGatewayPatch
-		_, response, err := sess.UpdateVPNGateway(options)
+		_, response, err := sess.UpdateVPNGatewayWithContext(ctx, options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating vpc Vpn Gateway: %s\n%s", err, response)
 		}
@@ -488,18 +494,18 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 	return nil
 }
 
-func resourceIBMISVPNGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
 
-	err := vpngwDelete(d, meta, id)
+	err := vpngwDelete(ctx, d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func vpngwDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -508,7 +514,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
+	_, response, err := sess.GetVPNGatewayWithContext(ctx, getVpnGatewayOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -519,11 +525,11 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.DeleteVPNGatewayOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteVPNGateway(options)
+	response, err = sess.DeleteVPNGatewayWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Vpn Gateway : %s\n%s", err, response)
 	}
-	_, err = isWaitForVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVpnGatewayDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -531,27 +537,27 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForVpnGatewayDeleted(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayDeleted(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGateway (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayDeleting},
-		Target:     []string{isVPNGatewayDeleted, ""},
-		Refresh:    isVpnGatewayDeleteRefreshFunc(vpnGateway, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", isVPNGatewayDeleting},
+		Target:   []string{isVPNGatewayDeleted, ""},
+		Refresh:  isVpnGatewayDeleteRefreshFunc(ctx, vpnGateway, id),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVpnGatewayDeleteRefreshFunc(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
-		vpngw, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
+		vpngw, response, err := vpnGateway.GetVPNGatewayWithContext(ctx, getVpnGatewayOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayDeleted, nil
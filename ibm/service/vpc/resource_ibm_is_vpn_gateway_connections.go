@@ -4,14 +4,16 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -43,12 +45,12 @@ const (
 
 func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPNGatewayConnectionCreate,
-		Read:     resourceIBMISVPNGatewayConnectionRead,
-		Update:   resourceIBMISVPNGatewayConnectionUpdate,
-		Delete:   resourceIBMISVPNGatewayConnectionDelete,
-		Exists:   resourceIBMISVPNGatewayConnectionExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPNGatewayConnectionCreate,
+		ReadContext:   resourceIBMISVPNGatewayConnectionRead,
+		UpdateContext: resourceIBMISVPNGatewayConnectionUpdate,
+		DeleteContext: resourceIBMISVPNGatewayConnectionDelete,
+		Exists:        resourceIBMISVPNGatewayConnectionExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -247,7 +249,7 @@ func ResourceIBMISVPNGatewayConnectionValidator() *validate.ResourceValidator {
 	return &ibmISVPNGatewayConnectionResourceValidator
 }
 
-func resourceIBMISVPNGatewayConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] VPNGatewayConnection create")
 	name := d.Get(isVPNGatewayConnectionName).(string)
@@ -278,14 +280,14 @@ func resourceIBMISVPNGatewayConnectionCreate(d *schema.ResourceData, meta interf
 		action = "none"
 	}
 
-	err := vpngwconCreate(d, meta, name, gatewayID, peerAddress, prephasedKey, action, interval, timeout, stateUp)
+	err := vpngwconCreate(ctx, d, meta, name, gatewayID, peerAddress, prephasedKey, action, interval, timeout, stateUp)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISVPNGatewayConnectionRead(d, meta)
+	return resourceIBMISVPNGatewayConnectionRead(ctx, d, meta)
 }
 
-func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, peerAddress, prephasedKey, action string, interval, timeout int64, stateUp bool) error {
+func vpngwconCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, gatewayID, peerAddress, prephasedKey, action string, interval, timeout int64, stateUp bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -335,7 +337,7 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 		vpnGatewayConnectionPrototypeModel.IpsecPolicy = nil
 	}
 
-	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
+	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnectionWithContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
 	}
@@ -345,24 +347,24 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 	return nil
 }
 
-func resourceIBMISVPNGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gID := parts[0]
 	gConnID := parts[1]
 
-	err = vpngwconGet(d, meta, gID, gConnID)
+	err = vpngwconGet(ctx, d, meta, gID, gConnID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
+func vpngwconGet(ctx context.Context, d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -371,7 +373,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 		VPNGatewayID: &gID,
 		ID:           &gConnID,
 	}
-	vpnGatewayConnectionIntf, response, err := sess.GetVPNGatewayConnection(options)
+	vpnGatewayConnectionIntf, response, err := sess.GetVPNGatewayConnectionWithContext(ctx, options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -437,7 +439,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	getVPNGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 		ID: &gID,
 	}
-	vpngatewayIntf, response, err := sess.GetVPNGateway(getVPNGatewayOptions)
+	vpngatewayIntf, response, err := sess.GetVPNGatewayWithContext(ctx, getVPNGatewayOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting VPN Gateway : %s\n%s", err, response)
 	}
@@ -446,24 +448,24 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	return nil
 }
 
-func resourceIBMISVPNGatewayConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	hasChanged := false
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gID := parts[0]
 	gConnID := parts[1]
-	err = vpngwconUpdate(d, meta, gID, gConnID, hasChanged)
+	err = vpngwconUpdate(ctx, d, meta, gID, gConnID, hasChanged)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISVPNGatewayConnectionRead(d, meta)
+	return resourceIBMISVPNGatewayConnectionRead(ctx, d, meta)
 }
 
-func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID string, hasChanged bool) error {
+func vpngwconUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, gID, gConnID string, hasChanged bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -538,7 +540,7 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 			return fmt.Errorf("[ERROR] Error calling asPatch for VPNGatewayConnectionPatch: %s", err)
 		}
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
-		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
+		_, response, err := sess.UpdateVPNGatewayConnectionWithContext(ctx, updateVpnGatewayConnectionOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating Vpn Gateway Connection: %s\n%s", err, response)
 		}
@@ -546,24 +548,24 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	return nil
 }
 
-func resourceIBMISVPNGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gID := parts[0]
 	gConnID := parts[1]
 
-	err = vpngwconDelete(d, meta, gID, gConnID)
+	err = vpngwconDelete(ctx, d, meta, gID, gConnID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
+func vpngwconDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -572,7 +574,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		VPNGatewayID: &gID,
 		ID:           &gConnID,
 	}
-	_, response, err := sess.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
+	_, response, err := sess.GetVPNGatewayConnectionWithContext(ctx, getVpnGatewayConnectionOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -586,12 +588,12 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		VPNGatewayID: &gID,
 		ID:           &gConnID,
 	}
-	response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
+	response, err = sess.DeleteVPNGatewayConnectionWithContext(ctx, deleteVpnGatewayConnectionOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
 	}
 
-	_, err = isWaitForVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPNGatewayConnectionDeleted(ctx, sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for Vpn Gateway Connection (%s) is deleted: %s", gConnID, err)
 	}
@@ -600,28 +602,28 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	return nil
 }
 
-func isWaitForVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPNGatewayConnectionDeleted(ctx context.Context, vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGatewayConnection (%s) to be deleted.", gConnID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayConnectionDeleting},
-		Target:     []string{"", isVPNGatewayConnectionDeleted},
-		Refresh:    isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection, gID, gConnID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.StatusWaiter{
+		Pending:  []string{"retry", isVPNGatewayConnectionDeleting},
+		Target:   []string{"", isVPNGatewayConnectionDeleted},
+		Refresh:  isVPNGatewayConnectionDeleteRefreshFunc(ctx, vpnGatewayConnection, gID, gConnID),
+		Timeout:  timeout,
+		Delay:    10 * time.Second,
+		Interval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.Wait(ctx)
 }
 
-func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string) resource.StateRefreshFunc {
+func isVPNGatewayConnectionDeleteRefreshFunc(ctx context.Context, vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string) waiter.RefreshFunc {
 	return func() (interface{}, string, error) {
 		getVpnGatewayConnectionOptions := &vpcv1.GetVPNGatewayConnectionOptions{
 			VPNGatewayID: &gID,
 			ID:           &gConnID,
 		}
-		vpngwcon, response, err := vpnGatewayConnection.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
+		vpngwcon, response, err := vpnGatewayConnection.GetVPNGatewayConnectionWithContext(ctx, getVpnGatewayConnectionOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayConnectionDeleted, nil
@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Type of constraints between the parameters of a resource.
+type RuleType int
+
+const (
+	// Exactly one of the Identifiers must be set, when the condition of the
+	// rule holds if it has one.
+	ExactlyOneOf RuleType = iota
+	// All of the Identifiers must be set when the condition of the rule holds.
+	RequiredWith
+	// The Identifiers can only have one of the AllowedValues when the condition of the rule holds.
+	AllowedValuesWhen
+)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (rt RuleType) MarshalText() ([]byte, error) {
+	return []byte(rt.String()), nil
+}
+
+// Use Stringer tool to generate this later.
+func (i RuleType) String() string {
+	return [...]string{"ExactlyOneOf", "RequiredWith", "AllowedValuesWhen"}[i]
+}
+
+// ValidateRule is used to describe a constraint between the parameters of a
+// resource. A parameter is set when it is configured with a value other than
+// the zero value of its type, as for ResourceData.GetOk.
+type ValidateRule struct {
+
+	// The parameters constrained by the rule. These are parameter names, or
+	// paths of parameters of blocks with one element.
+	// Ex: image, instance_template, boot_volume.0.snapshot in ibm_is_instance resource
+	Identifiers []string
+
+	Type RuleType
+
+	// The condition of RequiredWith and AllowedValuesWhen rules, optional for
+	// ExactlyOneOf rules: the parameter When is set, to one of WhenValues if
+	// they are given.
+	When       string `json:",omitempty"`
+	WhenValues string `json:",omitempty"` //Comma separated list of strings.
+
+	AllowedValues string `json:",omitempty"` //Comma separated list of strings.
+}
+
+// InvokeCustomizeDiff returns the CustomizeDiff function checking the Rules of
+// the resource in the validator dictionary, so that the configurations
+// violating them fail when they are planned. Rules depending on values that are
+// not known yet are checked once the values are known.
+func InvokeCustomizeDiff(resourceName string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		resourceItem, ok := validatorDict.ResourceValidatorDictionary[resourceName]
+		if !ok || resourceItem == nil {
+			return nil
+		}
+		return ValidateRules(diff.GetRawConfig(), resourceItem.Rules)
+	}
+}
+
+// ValidateRules checks the rules against the configuration of a resource, and
+// returns the error of the first rule it violates.
+func ValidateRules(config cty.Value, rules []ValidateRule) error {
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	for _, rule := range rules {
+		if err := rule.validate(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rule ValidateRule) validate(config cty.Value) error {
+	switch rule.Type {
+	case ExactlyOneOf:
+		if rule.When != "" && !rule.applies(config) {
+			return nil
+		}
+		var set []string
+		for _, identifier := range rule.Identifiers {
+			_, isSet, known := configValue(config, identifier)
+			if !known {
+				return nil
+			}
+			if isSet {
+				set = append(set, identifier)
+			}
+		}
+		if len(set) == 0 {
+			return fmt.Errorf("[ERROR] One of %s must be set", quoteList(rule.Identifiers, ", "))
+		}
+		if len(set) > 1 {
+			return fmt.Errorf("[ERROR] Only one of %s can be set, got %s", quoteList(rule.Identifiers, ", "), quoteList(set, " and "))
+		}
+	case RequiredWith:
+		if !rule.applies(config) {
+			return nil
+		}
+		var missing []string
+		for _, identifier := range rule.Identifiers {
+			if _, isSet, known := configValue(config, identifier); known && !isSet {
+				missing = append(missing, identifier)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("[ERROR] %s must be set when %s", quoteList(missing, ", "), rule.condition())
+		}
+	case AllowedValuesWhen:
+		if !rule.applies(config) {
+			return nil
+		}
+		allowedValues := splitList(rule.AllowedValues)
+		for _, identifier := range rule.Identifiers {
+			value, isSet, known := configValue(config, identifier)
+			if known && isSet && !stringInSlice(value, allowedValues) {
+				return fmt.Errorf("[ERROR] %q must be %s when %s, got %q", identifier, quoteList(allowedValues, " or "), rule.condition(), value)
+			}
+		}
+	}
+	return nil
+}
+
+// applies returns whether the condition of the rule is known to hold.
+func (rule ValidateRule) applies(config cty.Value) bool {
+	value, isSet, known := configValue(config, rule.When)
+	if !known || !isSet {
+		return false
+	}
+	return rule.WhenValues == "" || stringInSlice(value, splitList(rule.WhenValues))
+}
+
+func (rule ValidateRule) condition() string {
+	if rule.WhenValues == "" {
+		return fmt.Sprintf("%q is set", rule.When)
+	}
+	return fmt.Sprintf("%q is %s", rule.When, quoteList(splitList(rule.WhenValues), " or "))
+}
+
+// configValue returns the value of the parameter at path in the configuration
+// of a resource, formatted as a string for primitive types, whether the
+// parameter is set, and whether this is known.
+func configValue(config cty.Value, path string) (string, bool, bool) {
+	v := config
+	for _, step := range strings.Split(path, ".") {
+		if !v.IsKnown() {
+			return "", false, false
+		}
+		if v.IsNull() {
+			return "", false, true
+		}
+		ty := v.Type()
+		switch {
+		case ty.IsObjectType():
+			if !ty.HasAttribute(step) {
+				return "", false, true
+			}
+			v = v.GetAttr(step)
+		case ty.IsMapType():
+			if !v.HasIndex(cty.StringVal(step)).True() {
+				return "", false, true
+			}
+			v = v.Index(cty.StringVal(step))
+		case ty.IsListType() || ty.IsTupleType():
+			i, err := strconv.Atoi(step)
+			if err != nil || i < 0 || i >= v.LengthInt() {
+				return "", false, true
+			}
+			v = v.Index(cty.NumberIntVal(int64(i)))
+		default:
+			// The elements of sets can't be addressed by a path.
+			return "", false, false
+		}
+	}
+	if !v.IsKnown() {
+		return "", false, false
+	}
+	if v.IsNull() {
+		return "", false, true
+	}
+	ty := v.Type()
+	switch {
+	case ty == cty.String:
+		s := v.AsString()
+		return s, s != "", true
+	case ty == cty.Number:
+		f := v.AsBigFloat()
+		return f.Text('f', -1), f.Sign() != 0, true
+	case ty == cty.Bool:
+		return strconv.FormatBool(v.True()), v.True(), true
+	case ty.IsCollectionType() || ty.IsTupleType():
+		return "", v.LengthInt() > 0, true
+	default:
+		return "", true, true
+	}
+}
+
+func splitList(list string) []string {
+	arr := strings.Split(list, ",")
+	for i, ele := range arr {
+		arr[i] = strings.TrimSpace(ele)
+	}
+	return arr
+}
+
+func quoteList(list []string, sep string) string {
+	quoted := make([]string, len(list))
+	for i, s := range list {
+		quoted[i] = strconv.Quote(s)
+	}
+	return strings.Join(quoted, sep)
+}
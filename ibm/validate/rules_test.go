@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestConfigValue(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"name":     cty.StringVal("vsi"),
+		"image":    cty.StringVal(""),
+		"count":    cty.NumberIntVal(2),
+		"zero":     cty.NumberIntVal(0),
+		"enabled":  cty.False,
+		"keys":     cty.ListVal([]cty.Value{cty.StringVal("key")}),
+		"no_keys":  cty.ListValEmpty(cty.String),
+		"unknown":  cty.UnknownVal(cty.String),
+		"null":     cty.NullVal(cty.String),
+		"set":      cty.SetVal([]cty.Value{cty.StringVal("a")}),
+		"metadata": cty.MapVal(map[string]cty.Value{"env": cty.StringVal("prod")}),
+		"boot_volume": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"snapshot": cty.StringVal("r006-snapshot"),
+			"name":     cty.UnknownVal(cty.String),
+		})}),
+		"no_volume": cty.ListValEmpty(cty.Object(map[string]cty.Type{"snapshot": cty.String})),
+	})
+
+	cases := []struct {
+		path         string
+		value        string
+		isSet, known bool
+	}{
+		{"name", "vsi", true, true},
+		{"image", "", false, true},
+		{"count", "2", true, true},
+		{"zero", "0", false, true},
+		{"enabled", "false", false, true},
+		{"keys", "", true, true},
+		{"no_keys", "", false, true},
+		{"unknown", "", false, false},
+		{"null", "", false, true},
+		{"missing", "", false, true},
+		{"set.0", "", false, false},
+		{"metadata.env", "prod", true, true},
+		{"metadata.team", "", false, true},
+		{"boot_volume.0.snapshot", "r006-snapshot", true, true},
+		{"boot_volume.0.name", "", false, false},
+		{"boot_volume.1.snapshot", "", false, true},
+		{"boot_volume.first.snapshot", "", false, true},
+		{"no_volume.0.snapshot", "", false, true},
+	}
+	for _, c := range cases {
+		value, isSet, known := configValue(config, c.path)
+		if value != c.value || isSet != c.isSet || known != c.known {
+			t.Errorf("%s: expected (%q, %t, %t), got (%q, %t, %t)", c.path, c.value, c.isSet, c.known, value, isSet, known)
+		}
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	exactlyOne := ValidateRule{Type: ExactlyOneOf, Identifiers: []string{"image", "boot_volume.0.snapshot"}}
+	exactlyOneWhen := ValidateRule{Type: ExactlyOneOf, Identifiers: []string{"port", "protocol"}, When: "target"}
+	requiredWith := ValidateRule{Type: RequiredWith, Identifiers: []string{"security_certificate_id"}, When: "type", WhenValues: "SSL"}
+	requiredWhenSet := ValidateRule{Type: RequiredWith, Identifiers: []string{"port", "protocol"}, When: "target"}
+	allowedValues := ValidateRule{Type: AllowedValuesWhen, Identifiers: []string{"type"}, When: "security_certificate_id", AllowedValues: "SSL"}
+
+	config := func(attrs map[string]cty.Value) cty.Value {
+		values := map[string]cty.Value{
+			"image":                   cty.NullVal(cty.String),
+			"boot_volume":             cty.ListValEmpty(cty.Object(map[string]cty.Type{"snapshot": cty.String})),
+			"type":                    cty.NullVal(cty.String),
+			"security_certificate_id": cty.NullVal(cty.Number),
+			"target":                  cty.NullVal(cty.String),
+			"port":                    cty.NullVal(cty.Number),
+			"protocol":                cty.NullVal(cty.String),
+		}
+		for k, v := range attrs {
+			values[k] = v
+		}
+		return cty.ObjectVal(values)
+	}
+	bootVolume := func(snapshot cty.Value) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"snapshot": snapshot})})
+	}
+
+	cases := []struct {
+		name   string
+		rule   ValidateRule
+		config cty.Value
+		err    string
+	}{
+		{"exactly one of, first set", exactlyOne, config(map[string]cty.Value{"image": cty.StringVal("r006-image")}), ""},
+		{"exactly one of, block path set", exactlyOne, config(map[string]cty.Value{"boot_volume": bootVolume(cty.StringVal("r006-snapshot"))}), ""},
+		{"exactly one of, none set", exactlyOne, config(nil), `One of "image", "boot_volume.0.snapshot" must be set`},
+		{"exactly one of, both set", exactlyOne, config(map[string]cty.Value{"image": cty.StringVal("r006-image"), "boot_volume": bootVolume(cty.StringVal("r006-snapshot"))}), `Only one of "image", "boot_volume.0.snapshot" can be set, got "image" and "boot_volume.0.snapshot"`},
+		{"exactly one of, unknown", exactlyOne, config(map[string]cty.Value{"boot_volume": bootVolume(cty.UnknownVal(cty.String))}), ""},
+		{"exactly one of when set", exactlyOneWhen, config(map[string]cty.Value{"target": cty.StringVal("10.0.0.1")}), `One of "port", "protocol" must be set`},
+		{"exactly one of when set, not set", exactlyOneWhen, config(nil), ""},
+		{"required with, condition holds", requiredWith, config(map[string]cty.Value{"type": cty.StringVal("SSL")}), `"security_certificate_id" must be set when "type" is "SSL"`},
+		{"required with, set", requiredWith, config(map[string]cty.Value{"type": cty.StringVal("SSL"), "security_certificate_id": cty.NumberIntVal(42)}), ""},
+		{"required with, other value", requiredWith, config(map[string]cty.Value{"type": cty.StringVal("HTTP")}), ""},
+		{"required with, unknown condition", requiredWith, config(map[string]cty.Value{"type": cty.UnknownVal(cty.String)}), ""},
+		{"required with, unknown value", requiredWith, config(map[string]cty.Value{"type": cty.StringVal("SSL"), "security_certificate_id": cty.UnknownVal(cty.Number)}), ""},
+		{"required when set", requiredWhenSet, config(map[string]cty.Value{"target": cty.StringVal("10.0.0.1"), "port": cty.NumberIntVal(443)}), `"protocol" must be set when "target" is set`},
+		{"required when set, not set", requiredWhenSet, config(nil), ""},
+		{"allowed values when, allowed", allowedValues, config(map[string]cty.Value{"type": cty.StringVal("SSL"), "security_certificate_id": cty.NumberIntVal(42)}), ""},
+		{"allowed values when, not allowed", allowedValues, config(map[string]cty.Value{"type": cty.StringVal("HTTP"), "security_certificate_id": cty.NumberIntVal(42)}), `"type" must be "SSL" when "security_certificate_id" is set, got "HTTP"`},
+		{"allowed values when, condition unset", allowedValues, config(map[string]cty.Value{"type": cty.StringVal("HTTP")}), ""},
+		{"allowed values when, unknown value", allowedValues, config(map[string]cty.Value{"type": cty.UnknownVal(cty.String), "security_certificate_id": cty.NumberIntVal(42)}), ""},
+		{"unknown configuration", exactlyOne, cty.UnknownVal(cty.DynamicPseudoType), ""},
+		{"null configuration", exactlyOne, cty.NullVal(cty.DynamicPseudoType), ""},
+	}
+	for _, c := range cases {
+		err := ValidateRules(c.config, []ValidateRule{c.rule})
+		switch {
+		case c.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+		case c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)):
+			t.Errorf("%s: expected an error containing %s, got %v", c.name, c.err, err)
+		}
+	}
+}
@@ -1145,6 +1145,10 @@ type ResourceValidator struct {
 
 	// Array of validator objects. Each object refers to one parameter in the resource provider.
 	Schema []ValidateSchema
+
+	// Constraints between the parameters of the resource, checked when the resource is planned.
+	// The resource enables them with CustomizeDiff: validate.InvokeCustomizeDiff(ResourceName).
+	Rules []ValidateRule `json:",omitempty"`
 }
 
 type ValidatorDict struct {
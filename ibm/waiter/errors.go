@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package waiter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TimeoutError is returned when the target status isn't reached in time.
+type TimeoutError struct {
+	Description string
+	Timeout     time.Duration
+	// LastStatus and LastResult are the last status observed and its object,
+	// if any.
+	LastStatus string
+	LastResult interface{}
+	Polls      int
+	Expected   []string
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("[ERROR] Timeout after %s waiting for %s, %s after %d polls, expected %s",
+		e.Timeout, e.Description, lastStatus(observation{result: e.LastResult, status: e.LastStatus}), e.Polls, expected(e.Expected))
+}
+
+// FailedStatusError is returned when a terminal failure status is observed.
+type FailedStatusError struct {
+	Description string
+	Status      string
+	Result      interface{}
+}
+
+func (e *FailedStatusError) Error() string {
+	return fmt.Sprintf("[ERROR] Error waiting for %s: the status is %q", e.Description, e.Status)
+}
+
+// UnexpectedStatusError is returned when a status is neither pending nor a
+// target one.
+type UnexpectedStatusError struct {
+	Description string
+	Status      string
+	Expected    []string
+}
+
+func (e *UnexpectedStatusError) Error() string {
+	return fmt.Sprintf("[ERROR] Error waiting for %s: unexpected status %q, expected %s", e.Description, e.Status, expected(e.Expected))
+}
+
+// NotFoundError is returned when the object isn't found for too many polls in
+// a row.
+type NotFoundError struct {
+	Description string
+	// LastStatus is the status observed before the object stopped being
+	// found, if any.
+	LastStatus string
+	Polls      int
+}
+
+func (e *NotFoundError) Error() string {
+	if e.LastStatus == "" {
+		return fmt.Sprintf("[ERROR] Error waiting for %s: not found after %d polls", e.Description, e.Polls)
+	}
+	return fmt.Sprintf("[ERROR] Error waiting for %s: not found after %d polls, the last observed status is %q", e.Description, e.Polls, e.LastStatus)
+}
+
+func expected(statuses []string) string {
+	if len(statuses) == 0 {
+		return "the deletion"
+	}
+	quoted := make([]string, len(statuses))
+	for i, s := range statuses {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return strings.Join(quoted, " or ")
+}
@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package waiter polls the status of the objects changed by the resources
+// until they reach a target status, for the resources to share one polling
+// loop with the same backoff, progress logging and errors.
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// defaultInterval is the first interval between the polls of a
+	// StatusWaiter without an Interval.
+	defaultInterval = 500 * time.Millisecond
+	// defaultMaxInterval is the interval between the polls the backoff stops
+	// at, unless the Interval of the StatusWaiter is longer.
+	defaultMaxInterval = 10 * time.Second
+	// defaultNotFoundChecks is the number of polls not finding the object
+	// after which a StatusWaiter fails.
+	defaultNotFoundChecks = 20
+)
+
+// RefreshFunc returns the object waited for and its status. It returns a nil
+// object if the object isn't found, and an error to stop the wait.
+//
+// The object is returned by StatusWaiter.Wait, for the callers to assert its
+// type, e.g. *vpcv1.Instance.
+type RefreshFunc = resource.StateRefreshFunc
+
+// StatusWaiter waits for the status returned by Refresh to reach one of the
+// Target statuses.
+//
+// The interval between the polls starts at Interval and doubles after each
+// poll, up to MaxInterval.
+type StatusWaiter struct {
+	// Description is what is waited for, e.g. "instance 0717-... to stop",
+	// used in the logs and the errors.
+	Description string
+	// Pending are the statuses expected before a Target one. Any other status
+	// fails the wait, unless Pending is empty.
+	Pending []string
+	// Target are the statuses waited for.
+	Target []string
+	// Failed are the terminal statuses, failing the wait as soon as one is
+	// observed.
+	Failed []string
+	// Refresh polls the status.
+	Refresh RefreshFunc
+	// Progress optionally describes the progress of the object returned by
+	// Refresh, e.g. "45%", logged with its status.
+	Progress func(result interface{}) string
+
+	// Timeout is the time after which the wait fails. 0 waits as long as the
+	// context isn't done.
+	Timeout time.Duration
+	// Delay is the time waited before the first poll.
+	Delay time.Duration
+	// Interval is the first interval between the polls, 500 milliseconds by
+	// default.
+	Interval time.Duration
+	// MaxInterval is the longest interval between the polls, 10 seconds or
+	// Interval by default, whichever is longer.
+	MaxInterval time.Duration
+	// ContinuousTargetOccurence is the number of polls in a row that must
+	// observe a Target status, 1 by default.
+	ContinuousTargetOccurence int
+	// NotFoundChecks is the number of polls in a row not finding the object
+	// after which the wait fails, 20 by default. Not finding the object ends
+	// the wait if Target is empty, e.g. for a deletion.
+	NotFoundChecks int
+}
+
+// observation is the last status observed by a StatusWaiter.
+type observation struct {
+	result interface{}
+	status string
+	polls  int
+}
+
+// Wait polls the status until it reaches a Target status, and returns the
+// last object returned by Refresh. It fails with the error returned by
+// Refresh, or one of a *TimeoutError, a *FailedStatusError, an
+// *UnexpectedStatusError or a *NotFoundError.
+func (w *StatusWaiter) Wait(ctx context.Context) (interface{}, error) {
+	start := time.Now()
+	var deadline <-chan time.Time
+	if w.Timeout > 0 {
+		timer := time.NewTimer(w.Timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+	description := w.description()
+	log.Printf("[DEBUG] Waiting for %s", description)
+
+	interval, maxInterval := w.intervals()
+	sleep := w.Delay
+	last := observation{}
+	targetOccurence, notFound := 0, 0
+	for {
+		if sleep > 0 {
+			timer := time.NewTimer(sleep)
+			select {
+			case <-timer.C:
+			case <-deadline:
+				timer.Stop()
+				return last.result, w.timeoutError(description, last)
+			case <-ctx.Done():
+				timer.Stop()
+				return last.result, fmt.Errorf("[ERROR] Stopped waiting for %s after %d polls, %s: %w", description, last.polls, lastStatus(last), ctx.Err())
+			}
+		}
+
+		result, status, err := w.Refresh()
+		last.polls++
+		if err != nil {
+			return result, err
+		}
+
+		if result == nil {
+			// The object isn't found.
+			notFound++
+			if len(w.Target) == 0 {
+				targetOccurence++
+				if targetOccurence >= w.continuousTargetOccurence() {
+					log.Printf("[INFO] Done waiting for %s after %s: not found", description, elapsed(start))
+					return nil, nil
+				}
+			} else if notFound > w.notFoundChecks() {
+				return last.result, &NotFoundError{Description: description, LastStatus: last.status, Polls: last.polls}
+			}
+		} else {
+			notFound = 0
+			if status != last.status || last.result == nil {
+				log.Printf("[INFO] Waiting for %s: the status is %s after %s", description, w.statusProgress(result, status), elapsed(start))
+			} else {
+				log.Printf("[DEBUG] Waiting for %s: the status is still %s after %s", description, w.statusProgress(result, status), elapsed(start))
+			}
+			last.result, last.status = result, status
+
+			switch {
+			case contains(w.Target, status):
+				targetOccurence++
+				if targetOccurence >= w.continuousTargetOccurence() {
+					log.Printf("[INFO] Done waiting for %s after %s: the status is %s", description, elapsed(start), status)
+					return result, nil
+				}
+			case contains(w.Failed, status):
+				return result, &FailedStatusError{Description: description, Status: status, Result: result}
+			case len(w.Pending) > 0 && !contains(w.Pending, status):
+				return result, &UnexpectedStatusError{Description: description, Status: status, Expected: append(append([]string{}, w.Pending...), w.Target...)}
+			default:
+				targetOccurence = 0
+			}
+		}
+
+		// The interval doesn't grow while the target status must be observed
+		// again.
+		sleep = interval
+		if targetOccurence == 0 && interval < maxInterval {
+			interval *= 2
+			if interval > maxInterval {
+				interval = maxInterval
+			}
+		}
+	}
+}
+
+func (w *StatusWaiter) description() string {
+	if w.Description != "" {
+		return w.Description
+	}
+	if len(w.Target) == 0 {
+		return "the deletion"
+	}
+	return "the status " + strings.Join(w.Target, " or ")
+}
+
+func (w *StatusWaiter) intervals() (time.Duration, time.Duration) {
+	interval := w.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	maxInterval := w.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultMaxInterval
+		if interval > maxInterval {
+			maxInterval = interval
+		}
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+	return interval, maxInterval
+}
+
+func (w *StatusWaiter) continuousTargetOccurence() int {
+	if w.ContinuousTargetOccurence > 0 {
+		return w.ContinuousTargetOccurence
+	}
+	return 1
+}
+
+func (w *StatusWaiter) notFoundChecks() int {
+	if w.NotFoundChecks > 0 {
+		return w.NotFoundChecks
+	}
+	return defaultNotFoundChecks
+}
+
+func (w *StatusWaiter) statusProgress(result interface{}, status string) string {
+	if status == "" {
+		status = `""`
+	}
+	if w.Progress == nil {
+		return status
+	}
+	if progress := w.Progress(result); progress != "" {
+		return fmt.Sprintf("%s (%s)", status, progress)
+	}
+	return status
+}
+
+func (w *StatusWaiter) timeoutError(description string, last observation) error {
+	return &TimeoutError{
+		Description: description,
+		Timeout:     w.Timeout,
+		LastStatus:  last.status,
+		LastResult:  last.result,
+		Polls:       last.polls,
+		Expected:    w.Target,
+	}
+}
+
+func lastStatus(last observation) string {
+	if last.result == nil {
+		return "no status observed"
+	}
+	return fmt.Sprintf("the last observed status is %q", last.status)
+}
+
+func elapsed(start time.Time) time.Duration {
+	return time.Since(start).Round(time.Second)
+}
+
+func contains(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
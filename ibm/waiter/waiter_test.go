@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+type instance struct {
+	status string
+}
+
+// statuses returns a RefreshFunc returning the statuses in turn, then the last
+// one. An empty status stands for an object not found.
+func statuses(polls *int, s ...string) RefreshFunc {
+	return func() (interface{}, string, error) {
+		i := *polls
+		*polls++
+		if i >= len(s) {
+			i = len(s) - 1
+		}
+		if s[i] == "" {
+			return nil, "", nil
+		}
+		return &instance{status: s[i]}, s[i], nil
+	}
+}
+
+func TestStatusWaiter(t *testing.T) {
+	polls := 0
+	w := &StatusWaiter{
+		Description: "instance i-1 to stop",
+		Pending:     []string{"running", "stopping"},
+		Target:      []string{"stopped"},
+		Failed:      []string{"failed"},
+		Refresh:     statuses(&polls, "running", "stopping", "stopping", "stopped"),
+		Timeout:     time.Second,
+		Interval:    time.Millisecond,
+	}
+	result, err := w.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait failed: %s", err)
+	}
+	if result.(*instance).status != "stopped" || polls != 4 {
+		t.Fatalf("expected the stopped instance after 4 polls, got %v after %d polls", result, polls)
+	}
+}
+
+func TestStatusWaiterFailures(t *testing.T) {
+	refreshErr := errors.New("[ERROR] Error getting instance")
+	cases := map[string]struct {
+		waiter StatusWaiter
+		check  func(error) bool
+		want   string
+	}{
+		"failed": {
+			waiter: StatusWaiter{Pending: []string{"stopping"}, Target: []string{"stopped"}, Failed: []string{"failed"}},
+			check:  func(err error) bool { e, ok := err.(*FailedStatusError); return ok && e.Status == "failed" },
+			want:   `the status is "failed"`,
+		},
+		"unexpected": {
+			waiter: StatusWaiter{Pending: []string{"stopping"}, Target: []string{"stopped"}},
+			check:  func(err error) bool { _, ok := err.(*UnexpectedStatusError); return ok },
+			want:   `unexpected status "failed", expected "stopping" or "stopped"`,
+		},
+		"timeout": {
+			waiter: StatusWaiter{Target: []string{"stopped"}, Timeout: 20 * time.Millisecond},
+			check: func(err error) bool {
+				e, ok := err.(*TimeoutError)
+				return ok && e.LastStatus == "failed" && e.LastResult.(*instance).status == "failed"
+			},
+			want: `Timeout after 20ms waiting for instance i-1 to stop, the last observed status is "failed"`,
+		},
+		"refresh error": {
+			waiter: StatusWaiter{
+				Target:  []string{"stopped"},
+				Refresh: func() (interface{}, string, error) { return nil, "", refreshErr },
+			},
+			check: func(err error) bool { return err == refreshErr },
+			want:  "Error getting instance",
+		},
+	}
+	for name, c := range cases {
+		polls := 0
+		w := c.waiter
+		w.Description = "instance i-1 to stop"
+		w.Interval = time.Millisecond
+		if w.Refresh == nil {
+			w.Refresh = statuses(&polls, "stopping", "failed")
+		}
+		_, err := w.Wait(context.Background())
+		if err == nil || !c.check(err) || !strings.Contains(err.Error(), c.want) {
+			t.Errorf("%s: expected an error containing %q, got %#v", name, c.want, err)
+		}
+	}
+}
+
+func TestStatusWaiterNotFound(t *testing.T) {
+	polls := 0
+	w := &StatusWaiter{
+		Pending:  []string{"deleting"},
+		Refresh:  statuses(&polls, "deleting", "deleting", ""),
+		Interval: time.Millisecond,
+	}
+	if result, err := w.Wait(context.Background()); result != nil || err != nil {
+		t.Fatalf("expected the deletion to be done, got %v, %v", result, err)
+	}
+
+	polls = 0
+	w = &StatusWaiter{
+		Target:         []string{"available"},
+		Refresh:        statuses(&polls, "pending", ""),
+		Interval:       time.Millisecond,
+		NotFoundChecks: 2,
+	}
+	_, err := w.Wait(context.Background())
+	if e, ok := err.(*NotFoundError); !ok || e.LastStatus != "pending" || polls != 4 {
+		t.Fatalf("expected a not found error after 4 polls, got %v after %d polls", err, polls)
+	}
+}
+
+func TestStatusWaiterContinuousTargetOccurence(t *testing.T) {
+	polls := 0
+	var times []time.Time
+	refresh := statuses(&polls, "deploying", "ready", "deploying", "ready")
+	w := &StatusWaiter{
+		Target: []string{"ready"},
+		Refresh: func() (interface{}, string, error) {
+			times = append(times, time.Now())
+			return refresh()
+		},
+		Interval:                  time.Millisecond,
+		MaxInterval:               4 * time.Millisecond,
+		ContinuousTargetOccurence: 3,
+	}
+	if _, err := w.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait failed: %s", err)
+	}
+	if polls != 6 {
+		t.Fatalf("expected the target status to be observed 3 times in a row, got %d polls", polls)
+	}
+	// The interval doubles up to MaxInterval, and stays the same while the
+	// target status is observed again.
+	for i, min := range []time.Duration{time.Millisecond, 2 * time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 4 * time.Millisecond} {
+		if d := times[i+1].Sub(times[i]); d < min {
+			t.Errorf("expected an interval of at least %s before poll %d, got %s", min, i+2, d)
+		}
+	}
+}
+
+func TestStatusWaiterContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	polls := 0
+	w := &StatusWaiter{
+		Description: "cluster c-1 to be ready",
+		Target:      []string{"ready"},
+		Refresh: func() (interface{}, string, error) {
+			polls++
+			if polls == 2 {
+				cancel()
+			}
+			return &instance{status: "deploying"}, "deploying", nil
+		},
+		Progress: func(result interface{}) string { return fmt.Sprintf("poll %d", polls) },
+		Interval: time.Millisecond,
+	}
+	_, err := w.Wait(ctx)
+	if !errors.Is(err, context.Canceled) || !strings.Contains(err.Error(), `cluster c-1 to be ready after 2 polls, the last observed status is "deploying"`) {
+		t.Fatalf("expected the wait to stop, got %v", err)
+	}
+}
@@ -1,7 +1,12 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
 	"log"
+	"os"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
@@ -9,8 +14,50 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "schema-export" {
+		if err := schemaExport(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	log.Println("IBM Cloud Provider version", version.Version, version.VersionPrerelease, version.GitCommit)
+	providerServer, err := provider.ProviderServer(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
 	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: provider.Provider,
+		GRPCProviderFunc: providerServer,
 	})
 }
+
+// schemaExport writes the provider schema and the validators as JSON, for the
+// tools validating configurations offline.
+func schemaExport(args []string) error {
+	flags := flag.NewFlagSet("schema-export", flag.ContinueOnError)
+	output := flags.String("output", "", "The file the JSON is written to, the standard output by default.")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s schema-export [-output FILE]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Writes the schema of the provider, its resources and data sources, and the validators of their arguments as JSON.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err == flag.ErrHelp {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// The provider logs while building its schema.
+	log.SetOutput(io.Discard)
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return provider.ExportSchema(context.Background(), w)
+}